@@ -8,6 +8,7 @@ package v1
 
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/cvele/employee-service/api/auth/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -26,32 +27,5456 @@ const (
 
 // Employee message - tenant_id is NOT exposed, it's managed internally
 type Employee struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // UUID v4 as string
+	Emails    []string               `protobuf:"bytes,2,rep,name=emails,proto3" json:"emails,omitempty"` // All email addresses for this employee
+	FirstName string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Incremented by every change; pass it to UpdateEmployee (or as the HTTP
+	// ETag in If-Match) to update this state of the employee
+	Version int64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// approved, or pending while the employee waits for review
+	ReviewStatus string `protobuf:"bytes,8,opt,name=review_status,json=reviewStatus,proto3" json:"review_status,omitempty"`
+	// Department the employee belongs to; empty when unassigned
+	DepartmentId string `protobuf:"bytes,9,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	// Manager the employee reports to; empty when it has none
+	ManagerId string `protobuf:"bytes,10,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	// Job title; empty when unset
+	Title string `protobuf:"bytes,11,opt,name=title,proto3" json:"title,omitempty"`
+	// Tags grouping the employee, lowercase and sorted
+	Tags []string `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Phone numbers of the employee in E.164 format
+	PhoneNumbers []*PhoneNumber `protobuf:"bytes,13,rep,name=phone_numbers,json=phoneNumbers,proto3" json:"phone_numbers,omitempty"`
+	// Postal address; unset when the employee has none
+	Address *PostalAddress `protobuf:"bytes,14,opt,name=address,proto3" json:"address,omitempty"`
+	// Whether a photo was uploaded; see GetEmployeePhotoURL
+	HasPhoto bool `protobuf:"varint,15,opt,name=has_photo,json=hasPhoto,proto3" json:"has_photo,omitempty"`
+	// IDs of the employee in external systems such as an HRIS, by system
+	// (e.g. workday, bamboohr)
+	ExternalIds map[string]string `protobuf:"bytes,16,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The email to contact the employee at, one of emails
+	PrimaryEmail string `protobuf:"bytes,17,opt,name=primary_email,json=primaryEmail,proto3" json:"primary_email,omitempty"`
+	// False once the employee was deactivated, e.g. when offboarded
+	Active bool `protobuf:"varint,18,opt,name=active,proto3" json:"active,omitempty"`
+	// When the employee was deactivated; unset while active
+	DeactivatedAt *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"`
+	// Whether the employee was flagged stale and not changed since
+	Stale bool `protobuf:"varint,20,opt,name=stale,proto3" json:"stale,omitempty"`
+	// When the employee was flagged stale; unset unless stale
+	StaleSince *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=stale_since,json=staleSince,proto3" json:"stale_since,omitempty"`
+	// The emails that bounced or were complained about; mail to them is not
+	// delivered
+	UndeliverableEmails []*UndeliverableEmail `protobuf:"bytes,22,rep,name=undeliverable_emails,json=undeliverableEmails,proto3" json:"undeliverable_emails,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Employee) Reset() {
+	*x = Employee{}
+	mi := &file_employee_v1_employee_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Employee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Employee) ProtoMessage() {}
+
+func (x *Employee) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Employee.ProtoReflect.Descriptor instead.
+func (*Employee) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Employee) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Employee) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *Employee) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Employee) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Employee) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Employee) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Employee) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Employee) GetReviewStatus() string {
+	if x != nil {
+		return x.ReviewStatus
+	}
+	return ""
+}
+
+func (x *Employee) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *Employee) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *Employee) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Employee) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Employee) GetPhoneNumbers() []*PhoneNumber {
+	if x != nil {
+		return x.PhoneNumbers
+	}
+	return nil
+}
+
+func (x *Employee) GetAddress() *PostalAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Employee) GetHasPhoto() bool {
+	if x != nil {
+		return x.HasPhoto
+	}
+	return false
+}
+
+func (x *Employee) GetExternalIds() map[string]string {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *Employee) GetPrimaryEmail() string {
+	if x != nil {
+		return x.PrimaryEmail
+	}
+	return ""
+}
+
+func (x *Employee) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Employee) GetDeactivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivatedAt
+	}
+	return nil
+}
+
+func (x *Employee) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *Employee) GetStaleSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StaleSince
+	}
+	return nil
+}
+
+func (x *Employee) GetUndeliverableEmails() []*UndeliverableEmail {
+	if x != nil {
+		return x.UndeliverableEmails
+	}
+	return nil
+}
+
+// UndeliverableEmail is an employee email the mail provider reported
+type UndeliverableEmail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// bounce or complaint
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// When the first bounce or complaint occurred
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeliverableEmail) Reset() {
+	*x = UndeliverableEmail{}
+	mi := &file_employee_v1_employee_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeliverableEmail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeliverableEmail) ProtoMessage() {}
+
+func (x *UndeliverableEmail) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeliverableEmail.ProtoReflect.Descriptor instead.
+func (*UndeliverableEmail) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UndeliverableEmail) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UndeliverableEmail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UndeliverableEmail) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// PostalAddress is the postal address of an employee
+type PostalAddress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Line1 string                 `protobuf:"bytes,1,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2 string                 `protobuf:"bytes,2,opt,name=line2,proto3" json:"line2,omitempty"`
+	City  string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	// State, province or county
+	Region     string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode string `protobuf:"bytes,5,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// ISO 3166-1 alpha-2 country code, e.g. DE; returned uppercase
+	Country       string `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostalAddress) Reset() {
+	*x = PostalAddress{}
+	mi := &file_employee_v1_employee_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostalAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostalAddress) ProtoMessage() {}
+
+func (x *PostalAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostalAddress.ProtoReflect.Descriptor instead.
+func (*PostalAddress) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PostalAddress) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// PhoneNumber is a phone number of an employee
+type PhoneNumber struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// mobile, work, home or other; defaults to other
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Phone number, normalized to E.164 (e.g. +14155550123). Spaces, dots,
+	// dashes and parentheses are removed and a leading 00 replaces the +.
+	Number        string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PhoneNumber) Reset() {
+	*x = PhoneNumber{}
+	mi := &file_employee_v1_employee_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhoneNumber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhoneNumber) ProtoMessage() {}
+
+func (x *PhoneNumber) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhoneNumber.ProtoReflect.Descriptor instead.
+func (*PhoneNumber) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PhoneNumber) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PhoneNumber) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+// Create Employee
+type CreateEmployeeRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Emails    []string               `protobuf:"bytes,1,rep,name=emails,proto3" json:"emails,omitempty"`
+	FirstName string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// Makes retries safe: a request repeating the key of an earlier create
+	// returns the original employee instead of creating another one. Keys are
+	// kept per tenant for a day. Over HTTP the Idempotency-Key header may be
+	// used instead.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Schedules the create for a future time, e.g. the start date of a new
+	// hire. The employee is only created, and visible, from then on.
+	EffectiveAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	// Department of the tenant to assign the employee to
+	DepartmentId string `protobuf:"bytes,6,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	// Employee of the tenant the employee reports to
+	ManagerId string `protobuf:"bytes,7,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	// Job title
+	Title string `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	// Phone numbers of the employee
+	PhoneNumbers []*PhoneNumber `protobuf:"bytes,9,rep,name=phone_numbers,json=phoneNumbers,proto3" json:"phone_numbers,omitempty"`
+	// Postal address of the employee
+	Address *PostalAddress `protobuf:"bytes,10,opt,name=address,proto3" json:"address,omitempty"`
+	// IDs of the employee in external systems, by system; an ID belongs to at
+	// most one employee of the tenant per system
+	ExternalIds   map[string]string `protobuf:"bytes,11,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmployeeRequest) Reset() {
+	*x = CreateEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmployeeRequest) ProtoMessage() {}
+
+func (x *CreateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateEmployeeRequest) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *CreateEmployeeRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *CreateEmployeeRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetPhoneNumbers() []*PhoneNumber {
+	if x != nil {
+		return x.PhoneNumbers
+	}
+	return nil
+}
+
+func (x *CreateEmployeeRequest) GetAddress() *PostalAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreateEmployeeRequest) GetExternalIds() map[string]string {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+type CreateEmployeeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created employee; unset when the create is scheduled
+	Employee *Employee `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// Set instead of employee when effective_at is in the future
+	ScheduledChange *ScheduledChange `protobuf:"bytes,2,opt,name=scheduled_change,json=scheduledChange,proto3" json:"scheduled_change,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateEmployeeResponse) Reset() {
+	*x = CreateEmployeeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmployeeResponse) ProtoMessage() {}
+
+func (x *CreateEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*CreateEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *CreateEmployeeResponse) GetScheduledChange() *ScheduledChange {
+	if x != nil {
+		return x.ScheduledChange
+	}
+	return nil
+}
+
+// Update Employee
+type CreateOrUpdateEmployeeByEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Email identifying the employee
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// Required to create an employee; an existing employee keeps the names
+	// that are not set
+	FirstName     *string `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
+	LastName      *string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrUpdateEmployeeByEmailRequest) Reset() {
+	*x = CreateOrUpdateEmployeeByEmailRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrUpdateEmployeeByEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrUpdateEmployeeByEmailRequest) ProtoMessage() {}
+
+func (x *CreateOrUpdateEmployeeByEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrUpdateEmployeeByEmailRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrUpdateEmployeeByEmailRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateOrUpdateEmployeeByEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateOrUpdateEmployeeByEmailRequest) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+func (x *CreateOrUpdateEmployeeByEmailRequest) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+type CreateOrUpdateEmployeeByEmailResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Employee *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// True when the employee was created, false when an existing one was
+	// updated or already matched
+	Created       bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrUpdateEmployeeByEmailResponse) Reset() {
+	*x = CreateOrUpdateEmployeeByEmailResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrUpdateEmployeeByEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrUpdateEmployeeByEmailResponse) ProtoMessage() {}
+
+func (x *CreateOrUpdateEmployeeByEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrUpdateEmployeeByEmailResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrUpdateEmployeeByEmailResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateOrUpdateEmployeeByEmailResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *CreateOrUpdateEmployeeByEmailResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type UpdateEmployeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional fields - only validated if set
+	Emails    []string `protobuf:"bytes,2,rep,name=emails,proto3" json:"emails,omitempty"`
+	FirstName *string  `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
+	LastName  *string  `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	// Version of the employee the update is based on. Required; over HTTP it
+	// can be sent as If-Match instead. The update is rejected with
+	// VERSION_MISMATCH (ABORTED) when the employee changed since.
+	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// Schedules the update for a future time. Scheduled updates apply to the
+	// employee as it is then, so version is not required.
+	EffectiveAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	// Moves the employee to this department of the tenant; an empty string
+	// removes the employee from its department
+	DepartmentId *string `protobuf:"bytes,7,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	// Makes the employee report to this employee of the tenant; an empty
+	// string removes its manager. The manager cannot be the employee itself
+	// or one of its reports.
+	ManagerId *string `protobuf:"bytes,8,opt,name=manager_id,json=managerId,proto3,oneof" json:"manager_id,omitempty"`
+	// Job title
+	Title *string `protobuf:"bytes,9,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// Replaces the phone numbers of the employee; leaving it empty keeps them
+	PhoneNumbers []*PhoneNumber `protobuf:"bytes,10,rep,name=phone_numbers,json=phoneNumbers,proto3" json:"phone_numbers,omitempty"`
+	// Removes all phone numbers of the employee; cannot be combined with
+	// phone_numbers
+	ClearPhoneNumbers bool `protobuf:"varint,11,opt,name=clear_phone_numbers,json=clearPhoneNumbers,proto3" json:"clear_phone_numbers,omitempty"`
+	// Replaces the postal address of the employee
+	Address *PostalAddress `protobuf:"bytes,12,opt,name=address,proto3" json:"address,omitempty"`
+	// Removes the postal address of the employee; cannot be combined with
+	// address
+	ClearAddress bool `protobuf:"varint,13,opt,name=clear_address,json=clearAddress,proto3" json:"clear_address,omitempty"`
+	// Sets the IDs of the employee in these external systems, keeping those
+	// of other systems; an empty ID removes the employee's ID in its system
+	ExternalIds   map[string]string `protobuf:"bytes,14,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmployeeRequest) Reset() {
+	*x = UpdateEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployeeRequest) ProtoMessage() {}
+
+func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeRequest) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateEmployeeRequest) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeRequest) GetDepartmentId() string {
+	if x != nil && x.DepartmentId != nil {
+		return *x.DepartmentId
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetManagerId() string {
+	if x != nil && x.ManagerId != nil {
+		return *x.ManagerId
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetPhoneNumbers() []*PhoneNumber {
+	if x != nil {
+		return x.PhoneNumbers
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeRequest) GetClearPhoneNumbers() bool {
+	if x != nil {
+		return x.ClearPhoneNumbers
+	}
+	return false
+}
+
+func (x *UpdateEmployeeRequest) GetAddress() *PostalAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeRequest) GetClearAddress() bool {
+	if x != nil {
+		return x.ClearAddress
+	}
+	return false
+}
+
+func (x *UpdateEmployeeRequest) GetExternalIds() map[string]string {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+type UpdateEmployeeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated employee; unset when the update is scheduled
+	Employee *Employee `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// Set instead of employee when effective_at is in the future
+	ScheduledChange *ScheduledChange `protobuf:"bytes,2,opt,name=scheduled_change,json=scheduledChange,proto3" json:"scheduled_change,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateEmployeeResponse) Reset() {
+	*x = UpdateEmployeeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployeeResponse) ProtoMessage() {}
+
+func (x *UpdateEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeResponse) GetScheduledChange() *ScheduledChange {
+	if x != nil {
+		return x.ScheduledChange
+	}
+	return nil
+}
+
+// Delete Employee
+type DeleteEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployeeRequest) Reset() {
+	*x = DeleteEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployeeRequest) ProtoMessage() {}
+
+func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployeeResponse) Reset() {
+	*x = DeleteEmployeeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployeeResponse) ProtoMessage() {}
+
+func (x *DeleteEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteEmployeeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Get Employee by ID
+type GetEmployeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// For an id merged into another employee, return moved_to instead of
+	// EMPLOYEE_NOT_FOUND
+	FollowMerges  bool `protobuf:"varint,2,opt,name=follow_merges,json=followMerges,proto3" json:"follow_merges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeRequest) Reset() {
+	*x = GetEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeRequest) ProtoMessage() {}
+
+func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetEmployeeRequest) GetFollowMerges() bool {
+	if x != nil {
+		return x.FollowMerges
+	}
+	return false
+}
+
+type GetEmployeeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unset when moved_to is set
+	Employee *Employee `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// With follow_merges, the ID of the employee a merged away id survives
+	// as, following all merges as ResolveMergedEmployee does
+	MovedTo       string `protobuf:"bytes,2,opt,name=moved_to,json=movedTo,proto3" json:"moved_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeResponse) Reset() {
+	*x = GetEmployeeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeResponse) ProtoMessage() {}
+
+func (x *GetEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*GetEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *GetEmployeeResponse) GetMovedTo() string {
+	if x != nil {
+		return x.MovedTo
+	}
+	return ""
+}
+
+// Get Employee by Email
+type GetEmployeeByEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeByEmailRequest) Reset() {
+	*x = GetEmployeeByEmailRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeByEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeByEmailRequest) ProtoMessage() {}
+
+func (x *GetEmployeeByEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeByEmailRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeeByEmailRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetEmployeeByEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type GetEmployeeByEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeByEmailResponse) Reset() {
+	*x = GetEmployeeByEmailResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeByEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeByEmailResponse) ProtoMessage() {}
+
+func (x *GetEmployeeByEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeByEmailResponse.ProtoReflect.Descriptor instead.
+func (*GetEmployeeByEmailResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetEmployeeByEmailResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+// Get Employee By External ID
+type GetEmployeeByExternalIDRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// External system, e.g. workday
+	System string `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	// ID of the employee in the system
+	ExternalId    string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeByExternalIDRequest) Reset() {
+	*x = GetEmployeeByExternalIDRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeByExternalIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeByExternalIDRequest) ProtoMessage() {}
+
+func (x *GetEmployeeByExternalIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeByExternalIDRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeeByExternalIDRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetEmployeeByExternalIDRequest) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *GetEmployeeByExternalIDRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type GetEmployeeByExternalIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeByExternalIDResponse) Reset() {
+	*x = GetEmployeeByExternalIDResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeByExternalIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeByExternalIDResponse) ProtoMessage() {}
+
+func (x *GetEmployeeByExternalIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeByExternalIDResponse.ProtoReflect.Descriptor instead.
+func (*GetEmployeeByExternalIDResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetEmployeeByExternalIDResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+// Employee Exists
+type EmployeeExistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeExistsRequest) Reset() {
+	*x = EmployeeExistsRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeExistsRequest) ProtoMessage() {}
+
+func (x *EmployeeExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeExistsRequest.ProtoReflect.Descriptor instead.
+func (*EmployeeExistsRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EmployeeExistsRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type EmployeeExistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exists        bool                   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeExistsResponse) Reset() {
+	*x = EmployeeExistsResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeExistsResponse) ProtoMessage() {}
+
+func (x *EmployeeExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeExistsResponse.ProtoReflect.Descriptor instead.
+func (*EmployeeExistsResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EmployeeExistsResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+// List Employees
+type ListEmployeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page defaults to 1 if 0 or not set (handled in business logic)
+	Page *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	// page_size defaults to 20 if 0 or not set (handled in business logic)
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// name_prefix matches employees whose first name, last name or full name
+	// starts with it, case-insensitively
+	NamePrefix string `protobuf:"bytes,5,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	// email_domain matches employees with an email at the domain, e.g.
+	// example.com
+	EmailDomain string `protobuf:"bytes,6,opt,name=email_domain,json=emailDomain,proto3" json:"email_domain,omitempty"`
+	// email_contains matches employees with an email containing it,
+	// case-insensitively; at least 3 characters
+	EmailContains string `protobuf:"bytes,7,opt,name=email_contains,json=emailContains,proto3" json:"email_contains,omitempty"`
+	// updated_since returns only employees created or changed at or after it,
+	// oldest change first, for incremental sync. Deletions are not listed;
+	// follow the audit log or events for those.
+	UpdatedSince *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`
+	// department_id matches employees of the department
+	DepartmentId *string `protobuf:"bytes,9,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	// tags matches employees having all of the tags, e.g.
+	// ?tags=contractor&tags=remote
+	Tags []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	// active_only leaves out deactivated employees; defaults to true
+	ActiveOnly *bool `protobuf:"varint,11,opt,name=active_only,json=activeOnly,proto3,oneof" json:"active_only,omitempty"`
+	// stale lists only stale employees when true, and only the others when
+	// false
+	Stale         *bool `protobuf:"varint,12,opt,name=stale,proto3,oneof" json:"stale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployeesRequest) Reset() {
+	*x = ListEmployeesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeesRequest) ProtoMessage() {}
+
+func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ListEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListEmployeesRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListEmployeesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEmployeesRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListEmployeesRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListEmployeesRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetEmailDomain() string {
+	if x != nil {
+		return x.EmailDomain
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetEmailContains() string {
+	if x != nil {
+		return x.EmailContains
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetUpdatedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return nil
+}
+
+func (x *ListEmployeesRequest) GetDepartmentId() string {
+	if x != nil && x.DepartmentId != nil {
+		return *x.DepartmentId
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListEmployeesRequest) GetActiveOnly() bool {
+	if x != nil && x.ActiveOnly != nil {
+		return *x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListEmployeesRequest) GetStale() bool {
+	if x != nil && x.Stale != nil {
+		return *x.Stale
+	}
+	return false
+}
+
+type ListEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployeesResponse) Reset() {
+	*x = ListEmployeesResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeesResponse) ProtoMessage() {}
+
+func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ListEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListEmployeesResponse) GetEmployees() []*Employee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+func (x *ListEmployeesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListEmployeesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEmployeesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Count Employees - takes the filters of ListEmployeesRequest
+type CountEmployeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	NamePrefix    string                 `protobuf:"bytes,3,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	EmailDomain   string                 `protobuf:"bytes,4,opt,name=email_domain,json=emailDomain,proto3" json:"email_domain,omitempty"`
+	EmailContains string                 `protobuf:"bytes,5,opt,name=email_contains,json=emailContains,proto3" json:"email_contains,omitempty"`
+	UpdatedSince  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`
+	DepartmentId  *string                `protobuf:"bytes,7,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	ActiveOnly    *bool                  `protobuf:"varint,9,opt,name=active_only,json=activeOnly,proto3,oneof" json:"active_only,omitempty"`
+	Stale         *bool                  `protobuf:"varint,10,opt,name=stale,proto3,oneof" json:"stale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountEmployeesRequest) Reset() {
+	*x = CountEmployeesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountEmployeesRequest) ProtoMessage() {}
+
+func (x *CountEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*CountEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CountEmployeesRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *CountEmployeesRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *CountEmployeesRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *CountEmployeesRequest) GetEmailDomain() string {
+	if x != nil {
+		return x.EmailDomain
+	}
+	return ""
+}
+
+func (x *CountEmployeesRequest) GetEmailContains() string {
+	if x != nil {
+		return x.EmailContains
+	}
+	return ""
+}
+
+func (x *CountEmployeesRequest) GetUpdatedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return nil
+}
+
+func (x *CountEmployeesRequest) GetDepartmentId() string {
+	if x != nil && x.DepartmentId != nil {
+		return *x.DepartmentId
+	}
+	return ""
+}
+
+func (x *CountEmployeesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CountEmployeesRequest) GetActiveOnly() bool {
+	if x != nil && x.ActiveOnly != nil {
+		return *x.ActiveOnly
+	}
+	return false
+}
+
+func (x *CountEmployeesRequest) GetStale() bool {
+	if x != nil && x.Stale != nil {
+		return *x.Stale
+	}
+	return false
+}
+
+type CountEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountEmployeesResponse) Reset() {
+	*x = CountEmployeesResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountEmployeesResponse) ProtoMessage() {}
+
+func (x *CountEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*CountEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CountEmployeesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Export Employees (HTTP only): GET /api/v1/employees:export streams every
+// employee of the tenant as CSV, NDJSON, Parquet or XLSX
+type ExportEmployeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// csv (default), ndjson, parquet or xlsx
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// tags matches employees having all of the tags, e.g.
+	// ?tags=contractor&tags=remote
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// active_only leaves out deactivated employees; defaults to true
+	ActiveOnly *bool `protobuf:"varint,5,opt,name=active_only,json=activeOnly,proto3,oneof" json:"active_only,omitempty"`
+	// stale exports only stale employees when true, and only the others when
+	// false
+	Stale *bool `protobuf:"varint,6,opt,name=stale,proto3,oneof" json:"stale,omitempty"`
+	// name_prefix, email_domain, email_contains and department_id filter like
+	// those of ListEmployeesRequest
+	NamePrefix    string  `protobuf:"bytes,7,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	EmailDomain   string  `protobuf:"bytes,8,opt,name=email_domain,json=emailDomain,proto3" json:"email_domain,omitempty"`
+	EmailContains string  `protobuf:"bytes,9,opt,name=email_contains,json=emailContains,proto3" json:"email_contains,omitempty"`
+	DepartmentId  *string `protobuf:"bytes,10,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	// updated_since exports only employees created or changed at or after it;
+	// they are still exported oldest first
+	UpdatedSince  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEmployeesRequest) Reset() {
+	*x = ExportEmployeesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEmployeesRequest) ProtoMessage() {}
+
+func (x *ExportEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ExportEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportEmployeesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ExportEmployeesRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ExportEmployeesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ExportEmployeesRequest) GetActiveOnly() bool {
+	if x != nil && x.ActiveOnly != nil {
+		return *x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ExportEmployeesRequest) GetStale() bool {
+	if x != nil && x.Stale != nil {
+		return *x.Stale
+	}
+	return false
+}
+
+func (x *ExportEmployeesRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetEmailDomain() string {
+	if x != nil {
+		return x.EmailDomain
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetEmailContains() string {
+	if x != nil {
+		return x.EmailContains
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetDepartmentId() string {
+	if x != nil && x.DepartmentId != nil {
+		return *x.DepartmentId
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetUpdatedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return nil
+}
+
+// Merge Employees
+type MergeEmployeesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PrimaryEmail   string                 `protobuf:"bytes,1,opt,name=primary_email,json=primaryEmail,proto3" json:"primary_email,omitempty"`
+	SecondaryEmail string                 `protobuf:"bytes,2,opt,name=secondary_email,json=secondaryEmail,proto3" json:"secondary_email,omitempty"`
+	// Validate the merge and return its outcome without performing it
+	ValidateOnly  bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeEmployeesRequest) Reset() {
+	*x = MergeEmployeesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeEmployeesRequest) ProtoMessage() {}
+
+func (x *MergeEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*MergeEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MergeEmployeesRequest) GetPrimaryEmail() string {
+	if x != nil {
+		return x.PrimaryEmail
+	}
+	return ""
+}
+
+func (x *MergeEmployeesRequest) GetSecondaryEmail() string {
+	if x != nil {
+		return x.SecondaryEmail
+	}
+	return ""
+}
+
+func (x *MergeEmployeesRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type MergeEmployeesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The merged primary employee; for validate_only, what it would look like.
+	// Unset when the merge awaits approval.
+	Employee *Employee `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// Pass to UnmergeEmployees to undo the merge; empty for validate_only
+	MergeId string `protobuf:"bytes,2,opt,name=merge_id,json=mergeId,proto3" json:"merge_id,omitempty"`
+	// The secondary employee deleted by the merge
+	Secondary *Employee `protobuf:"bytes,3,opt,name=secondary,proto3" json:"secondary,omitempty"`
+	// Name fields (first_name, last_name) in which the secondary employee
+	// differs from the primary employee; the primary's values are kept
+	NameConflicts []string `protobuf:"bytes,4,rep,name=name_conflicts,json=nameConflicts,proto3" json:"name_conflicts,omitempty"`
+	// Set instead of employee when the merge needs the approval of a second
+	// user, see ApproveMerge
+	PendingApproval *MergeApproval `protobuf:"bytes,5,opt,name=pending_approval,json=pendingApproval,proto3" json:"pending_approval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeEmployeesResponse) Reset() {
+	*x = MergeEmployeesResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeEmployeesResponse) ProtoMessage() {}
+
+func (x *MergeEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*MergeEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MergeEmployeesResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *MergeEmployeesResponse) GetMergeId() string {
+	if x != nil {
+		return x.MergeId
+	}
+	return ""
+}
+
+func (x *MergeEmployeesResponse) GetSecondary() *Employee {
+	if x != nil {
+		return x.Secondary
+	}
+	return nil
+}
+
+func (x *MergeEmployeesResponse) GetNameConflicts() []string {
+	if x != nil {
+		return x.NameConflicts
+	}
+	return nil
+}
+
+func (x *MergeEmployeesResponse) GetPendingApproval() *MergeApproval {
+	if x != nil {
+		return x.PendingApproval
+	}
+	return nil
+}
+
+// Merge Employees By ID
+type MergeEmployeesByIdRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PrimaryId   string                 `protobuf:"bytes,1,opt,name=primary_id,json=primaryId,proto3" json:"primary_id,omitempty"`
+	SecondaryId string                 `protobuf:"bytes,2,opt,name=secondary_id,json=secondaryId,proto3" json:"secondary_id,omitempty"`
+	// Validate the merge and return its outcome without performing it
+	ValidateOnly  bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeEmployeesByIdRequest) Reset() {
+	*x = MergeEmployeesByIdRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeEmployeesByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeEmployeesByIdRequest) ProtoMessage() {}
+
+func (x *MergeEmployeesByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeEmployeesByIdRequest.ProtoReflect.Descriptor instead.
+func (*MergeEmployeesByIdRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MergeEmployeesByIdRequest) GetPrimaryId() string {
+	if x != nil {
+		return x.PrimaryId
+	}
+	return ""
+}
+
+func (x *MergeEmployeesByIdRequest) GetSecondaryId() string {
+	if x != nil {
+		return x.SecondaryId
+	}
+	return ""
+}
+
+func (x *MergeEmployeesByIdRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+// A merge of two employees held for the approval of a second user
+type MergeApproval struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PrimaryId   string                 `protobuf:"bytes,2,opt,name=primary_id,json=primaryId,proto3" json:"primary_id,omitempty"`
+	SecondaryId string                 `protobuf:"bytes,3,opt,name=secondary_id,json=secondaryId,proto3" json:"secondary_id,omitempty"`
+	// pending, approved, rejected or failed
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// The criteria the merge met, e.g. "history_entries"
+	Reasons []string `protobuf:"bytes,5,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// Audit entries and emails of both employees when the merge was requested
+	HistoryEntries int64                  `protobuf:"varint,6,opt,name=history_entries,json=historyEntries,proto3" json:"history_entries,omitempty"`
+	Emails         int32                  `protobuf:"varint,7,opt,name=emails,proto3" json:"emails,omitempty"`
+	RequestedBy    string                 `protobuf:"bytes,8,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The user who approved or rejected the merge
+	DecidedBy string                 `protobuf:"bytes,10,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	// The merge performed on approval, for UnmergeEmployees
+	MergeId string `protobuf:"bytes,12,opt,name=merge_id,json=mergeId,proto3" json:"merge_id,omitempty"`
+	// Why the approved merge could not be performed
+	Error         string `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeApproval) Reset() {
+	*x = MergeApproval{}
+	mi := &file_employee_v1_employee_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeApproval) ProtoMessage() {}
+
+func (x *MergeApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeApproval.ProtoReflect.Descriptor instead.
+func (*MergeApproval) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MergeApproval) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MergeApproval) GetPrimaryId() string {
+	if x != nil {
+		return x.PrimaryId
+	}
+	return ""
+}
+
+func (x *MergeApproval) GetSecondaryId() string {
+	if x != nil {
+		return x.SecondaryId
+	}
+	return ""
+}
+
+func (x *MergeApproval) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MergeApproval) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *MergeApproval) GetHistoryEntries() int64 {
+	if x != nil {
+		return x.HistoryEntries
+	}
+	return 0
+}
+
+func (x *MergeApproval) GetEmails() int32 {
+	if x != nil {
+		return x.Emails
+	}
+	return 0
+}
+
+func (x *MergeApproval) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *MergeApproval) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MergeApproval) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *MergeApproval) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *MergeApproval) GetMergeId() string {
+	if x != nil {
+		return x.MergeId
+	}
+	return ""
+}
+
+func (x *MergeApproval) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// List Merge Approvals
+type ListMergeApprovalsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page defaults to 1 if 0 or not set
+	Page *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	// page_size defaults to 20 if 0 or not set
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Only approvals with this status (default pending)
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMergeApprovalsRequest) Reset() {
+	*x = ListMergeApprovalsRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMergeApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMergeApprovalsRequest) ProtoMessage() {}
+
+func (x *ListMergeApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMergeApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListMergeApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListMergeApprovalsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListMergeApprovalsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMergeApprovalsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListMergeApprovalsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MergeApprovals []*MergeApproval       `protobuf:"bytes,1,rep,name=merge_approvals,json=mergeApprovals,proto3" json:"merge_approvals,omitempty"`
+	Total          int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListMergeApprovalsResponse) Reset() {
+	*x = ListMergeApprovalsResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMergeApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMergeApprovalsResponse) ProtoMessage() {}
+
+func (x *ListMergeApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMergeApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListMergeApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListMergeApprovalsResponse) GetMergeApprovals() []*MergeApproval {
+	if x != nil {
+		return x.MergeApprovals
+	}
+	return nil
+}
+
+func (x *ListMergeApprovalsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListMergeApprovalsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMergeApprovalsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Approve Merge
+type ApproveMergeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveMergeRequest) Reset() {
+	*x = ApproveMergeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveMergeRequest) ProtoMessage() {}
+
+func (x *ApproveMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveMergeRequest.ProtoReflect.Descriptor instead.
+func (*ApproveMergeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ApproveMergeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ApproveMergeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MergeApproval *MergeApproval         `protobuf:"bytes,1,opt,name=merge_approval,json=mergeApproval,proto3" json:"merge_approval,omitempty"`
+	// The performed merge
+	Merge         *MergeEmployeesResponse `protobuf:"bytes,2,opt,name=merge,proto3" json:"merge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveMergeResponse) Reset() {
+	*x = ApproveMergeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveMergeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveMergeResponse) ProtoMessage() {}
+
+func (x *ApproveMergeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveMergeResponse.ProtoReflect.Descriptor instead.
+func (*ApproveMergeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ApproveMergeResponse) GetMergeApproval() *MergeApproval {
+	if x != nil {
+		return x.MergeApproval
+	}
+	return nil
+}
+
+func (x *ApproveMergeResponse) GetMerge() *MergeEmployeesResponse {
+	if x != nil {
+		return x.Merge
+	}
+	return nil
+}
+
+// Reject Merge
+type RejectMergeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectMergeRequest) Reset() {
+	*x = RejectMergeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectMergeRequest) ProtoMessage() {}
+
+func (x *RejectMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectMergeRequest.ProtoReflect.Descriptor instead.
+func (*RejectMergeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RejectMergeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RejectMergeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MergeApproval *MergeApproval         `protobuf:"bytes,1,opt,name=merge_approval,json=mergeApproval,proto3" json:"merge_approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectMergeResponse) Reset() {
+	*x = RejectMergeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectMergeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectMergeResponse) ProtoMessage() {}
+
+func (x *RejectMergeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectMergeResponse.ProtoReflect.Descriptor instead.
+func (*RejectMergeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RejectMergeResponse) GetMergeApproval() *MergeApproval {
+	if x != nil {
+		return x.MergeApproval
+	}
+	return nil
+}
+
+// Unmerge Employees
+type UnmergeEmployeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MergeId       string                 `protobuf:"bytes,1,opt,name=merge_id,json=mergeId,proto3" json:"merge_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmergeEmployeesRequest) Reset() {
+	*x = UnmergeEmployeesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmergeEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmergeEmployeesRequest) ProtoMessage() {}
+
+func (x *UnmergeEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmergeEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*UnmergeEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UnmergeEmployeesRequest) GetMergeId() string {
+	if x != nil {
+		return x.MergeId
+	}
+	return ""
+}
+
+type UnmergeEmployeesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The primary employee without the emails moved back
+	Primary *Employee `protobuf:"bytes,1,opt,name=primary,proto3" json:"primary,omitempty"`
+	// The recreated secondary employee, with its original ID
+	Secondary     *Employee `protobuf:"bytes,2,opt,name=secondary,proto3" json:"secondary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmergeEmployeesResponse) Reset() {
+	*x = UnmergeEmployeesResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmergeEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmergeEmployeesResponse) ProtoMessage() {}
+
+func (x *UnmergeEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmergeEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*UnmergeEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UnmergeEmployeesResponse) GetPrimary() *Employee {
+	if x != nil {
+		return x.Primary
+	}
+	return nil
+}
+
+func (x *UnmergeEmployeesResponse) GetSecondary() *Employee {
+	if x != nil {
+		return x.Secondary
+	}
+	return nil
+}
+
+// Export Employee Data
+type ExportEmployeeDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEmployeeDataRequest) Reset() {
+	*x = ExportEmployeeDataRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEmployeeDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEmployeeDataRequest) ProtoMessage() {}
+
+func (x *ExportEmployeeDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEmployeeDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportEmployeeDataRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExportEmployeeDataRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// A recorded change of the exported employee
+type EmployeeDataAuditEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// One of create, update, delete, merge, unmerge, approve, deactivate,
+	// reactivate
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	// User ID of the caller that performed the change
+	ActorId   string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	RequestId string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ClientIp  string `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	// The employee before the change; unset for creates
+	Before *Employee `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	// The employee after the change; unset for deletes and merges away
+	After     *Employee              `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// User ID and tenant of the admin that performed the change acting as
+	// actor_id; empty unless impersonated
+	ImpersonatorId       string `protobuf:"bytes,9,opt,name=impersonator_id,json=impersonatorId,proto3" json:"impersonator_id,omitempty"`
+	ImpersonatorTenantId string `protobuf:"bytes,10,opt,name=impersonator_tenant_id,json=impersonatorTenantId,proto3" json:"impersonator_tenant_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *EmployeeDataAuditEntry) Reset() {
+	*x = EmployeeDataAuditEntry{}
+	mi := &file_employee_v1_employee_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeDataAuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeDataAuditEntry) ProtoMessage() {}
+
+func (x *EmployeeDataAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeDataAuditEntry.ProtoReflect.Descriptor instead.
+func (*EmployeeDataAuditEntry) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EmployeeDataAuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EmployeeDataAuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *EmployeeDataAuditEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *EmployeeDataAuditEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *EmployeeDataAuditEntry) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *EmployeeDataAuditEntry) GetBefore() *Employee {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *EmployeeDataAuditEntry) GetAfter() *Employee {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *EmployeeDataAuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EmployeeDataAuditEntry) GetImpersonatorId() string {
+	if x != nil {
+		return x.ImpersonatorId
+	}
+	return ""
+}
+
+func (x *EmployeeDataAuditEntry) GetImpersonatorTenantId() string {
+	if x != nil {
+		return x.ImpersonatorTenantId
+	}
+	return ""
+}
+
+// An event about the exported employee sent to a webhook
+type EmployeeDataEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the audit entry the event was sent for
+	EventId   string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType string `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	WebhookId string `protobuf:"bytes,3,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// URL of the webhook the event was sent to; empty once it is deleted
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Delivery status, as in webhook delivery logs
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeDataEvent) Reset() {
+	*x = EmployeeDataEvent{}
+	mi := &file_employee_v1_employee_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeDataEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeDataEvent) ProtoMessage() {}
+
+func (x *EmployeeDataEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeDataEvent.ProtoReflect.Descriptor instead.
+func (*EmployeeDataEvent) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *EmployeeDataEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EmployeeDataEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *EmployeeDataEvent) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *EmployeeDataEvent) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *EmployeeDataEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EmployeeDataEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EmployeeDataEvent) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+type ExportEmployeeDataResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// Unset once the employee was deleted or merged away
+	Employee *Employee `protobuf:"bytes,2,opt,name=employee,proto3" json:"employee,omitempty"`
+	// The audit history of the employee, newest first
+	AuditEntries []*EmployeeDataAuditEntry `protobuf:"bytes,3,rep,name=audit_entries,json=auditEntries,proto3" json:"audit_entries,omitempty"`
+	// The webhook events sent about the employee, oldest first
+	Events        []*EmployeeDataEvent   `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	ExportedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEmployeeDataResponse) Reset() {
+	*x = ExportEmployeeDataResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEmployeeDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEmployeeDataResponse) ProtoMessage() {}
+
+func (x *ExportEmployeeDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEmployeeDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportEmployeeDataResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExportEmployeeDataResponse) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ExportEmployeeDataResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *ExportEmployeeDataResponse) GetAuditEntries() []*EmployeeDataAuditEntry {
+	if x != nil {
+		return x.AuditEntries
+	}
+	return nil
+}
+
+func (x *ExportEmployeeDataResponse) GetEvents() []*EmployeeDataEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ExportEmployeeDataResponse) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+// Resolve Merged Employee
+type ResolveMergedEmployeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An employee ID, possibly of an employee merged into another
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveMergedEmployeeRequest) Reset() {
+	*x = ResolveMergedEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveMergedEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMergedEmployeeRequest) ProtoMessage() {}
+
+func (x *ResolveMergedEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMergedEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*ResolveMergedEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ResolveMergedEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// A merge followed from the ID of its secondary employee to its primary
+type MergeRedirect struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MergeId string                 `protobuf:"bytes,1,opt,name=merge_id,json=mergeId,proto3" json:"merge_id,omitempty"`
+	// The ID of the secondary employee, removed by the merge
+	FromId string `protobuf:"bytes,2,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	// The ID of the primary employee the secondary was merged into
+	ToId          string                 `protobuf:"bytes,3,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	MergedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeRedirect) Reset() {
+	*x = MergeRedirect{}
+	mi := &file_employee_v1_employee_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeRedirect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeRedirect) ProtoMessage() {}
+
+func (x *MergeRedirect) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeRedirect.ProtoReflect.Descriptor instead.
+func (*MergeRedirect) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MergeRedirect) GetMergeId() string {
+	if x != nil {
+		return x.MergeId
+	}
+	return ""
+}
+
+func (x *MergeRedirect) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
+}
+
+func (x *MergeRedirect) GetToId() string {
+	if x != nil {
+		return x.ToId
+	}
+	return ""
+}
+
+func (x *MergeRedirect) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type ResolveMergedEmployeeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The surviving employee
+	Employee *Employee `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// The merges followed, in order; empty when id is the employee's own ID
+	Redirects     []*MergeRedirect `protobuf:"bytes,2,rep,name=redirects,proto3" json:"redirects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveMergedEmployeeResponse) Reset() {
+	*x = ResolveMergedEmployeeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveMergedEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMergedEmployeeResponse) ProtoMessage() {}
+
+func (x *ResolveMergedEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMergedEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*ResolveMergedEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ResolveMergedEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *ResolveMergedEmployeeResponse) GetRedirects() []*MergeRedirect {
+	if x != nil {
+		return x.Redirects
+	}
+	return nil
+}
+
+// Find Duplicate Candidates
+type FindDuplicateCandidatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Candidates scoring lower are left out
+	MinScore float64 `protobuf:"fixed64,1,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	// limit defaults to 100 if 0 or not set (handled in business logic)
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateCandidatesRequest) Reset() {
+	*x = FindDuplicateCandidatesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateCandidatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateCandidatesRequest) ProtoMessage() {}
+
+func (x *FindDuplicateCandidatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateCandidatesRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicateCandidatesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FindDuplicateCandidatesRequest) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *FindDuplicateCandidatesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// A pair of employees that are likely the same person
+type DuplicateCandidate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The older employee, suggested as primary_id of MergeEmployeesById
+	Primary   *Employee `protobuf:"bytes,1,opt,name=primary,proto3" json:"primary,omitempty"`
+	Secondary *Employee `protobuf:"bytes,2,opt,name=secondary,proto3" json:"secondary,omitempty"`
+	// In (0, 1]; higher is more likely a duplicate
+	Score float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	// Matched reasons: same_name, same_email_local_part, similar_name
+	Reasons       []string `protobuf:"bytes,4,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateCandidate) Reset() {
+	*x = DuplicateCandidate{}
+	mi := &file_employee_v1_employee_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCandidate) ProtoMessage() {}
+
+func (x *DuplicateCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCandidate.ProtoReflect.Descriptor instead.
+func (*DuplicateCandidate) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DuplicateCandidate) GetPrimary() *Employee {
+	if x != nil {
+		return x.Primary
+	}
+	return nil
+}
+
+func (x *DuplicateCandidate) GetSecondary() *Employee {
+	if x != nil {
+		return x.Secondary
+	}
+	return nil
+}
+
+func (x *DuplicateCandidate) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DuplicateCandidate) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type FindDuplicateCandidatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Highest score first
+	Candidates    []*DuplicateCandidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateCandidatesResponse) Reset() {
+	*x = FindDuplicateCandidatesResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateCandidatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateCandidatesResponse) ProtoMessage() {}
+
+func (x *FindDuplicateCandidatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateCandidatesResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicateCandidatesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *FindDuplicateCandidatesResponse) GetCandidates() []*DuplicateCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// Diff Employees
+type DiffEmployeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdA           string                 `protobuf:"bytes,1,opt,name=id_a,json=idA,proto3" json:"id_a,omitempty"`
+	IdB           string                 `protobuf:"bytes,2,opt,name=id_b,json=idB,proto3" json:"id_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffEmployeesRequest) Reset() {
+	*x = DiffEmployeesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffEmployeesRequest) ProtoMessage() {}
+
+func (x *DiffEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*DiffEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DiffEmployeesRequest) GetIdA() string {
+	if x != nil {
+		return x.IdA
+	}
+	return ""
+}
+
+func (x *DiffEmployeesRequest) GetIdB() string {
+	if x != nil {
+		return x.IdB
+	}
+	return ""
+}
+
+// A compared field of two employees
+type EmployeeFieldDiff struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Field name as in Employee; external IDs are compared per system as
+	// external_ids.<system>
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The values rendered as text, empty when unset; lists are comma
+	// separated
+	ValueA        string `protobuf:"bytes,2,opt,name=value_a,json=valueA,proto3" json:"value_a,omitempty"`
+	ValueB        string `protobuf:"bytes,3,opt,name=value_b,json=valueB,proto3" json:"value_b,omitempty"`
+	Equal         bool   `protobuf:"varint,4,opt,name=equal,proto3" json:"equal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeFieldDiff) Reset() {
+	*x = EmployeeFieldDiff{}
+	mi := &file_employee_v1_employee_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeFieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeFieldDiff) ProtoMessage() {}
+
+func (x *EmployeeFieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeFieldDiff.ProtoReflect.Descriptor instead.
+func (*EmployeeFieldDiff) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *EmployeeFieldDiff) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *EmployeeFieldDiff) GetValueA() string {
+	if x != nil {
+		return x.ValueA
+	}
+	return ""
+}
+
+func (x *EmployeeFieldDiff) GetValueB() string {
+	if x != nil {
+		return x.ValueB
+	}
+	return ""
+}
+
+func (x *EmployeeFieldDiff) GetEqual() bool {
+	if x != nil {
+		return x.Equal
+	}
+	return false
+}
+
+// The emails of two employees, each list in the order of the employee
+type EmployeeEmailDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Common        []string               `protobuf:"bytes,1,rep,name=common,proto3" json:"common,omitempty"`
+	OnlyA         []string               `protobuf:"bytes,2,rep,name=only_a,json=onlyA,proto3" json:"only_a,omitempty"`
+	OnlyB         []string               `protobuf:"bytes,3,rep,name=only_b,json=onlyB,proto3" json:"only_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeEmailDiff) Reset() {
+	*x = EmployeeEmailDiff{}
+	mi := &file_employee_v1_employee_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeEmailDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeEmailDiff) ProtoMessage() {}
+
+func (x *EmployeeEmailDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeEmailDiff.ProtoReflect.Descriptor instead.
+func (*EmployeeEmailDiff) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *EmployeeEmailDiff) GetCommon() []string {
+	if x != nil {
+		return x.Common
+	}
+	return nil
+}
+
+func (x *EmployeeEmailDiff) GetOnlyA() []string {
+	if x != nil {
+		return x.OnlyA
+	}
+	return nil
+}
+
+func (x *EmployeeEmailDiff) GetOnlyB() []string {
+	if x != nil {
+		return x.OnlyB
+	}
+	return nil
+}
+
+// The history of a compared employee
+type EmployeeHistorySummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of audit entries of the employee
+	AuditEntries int64 `protobuf:"varint,1,opt,name=audit_entries,json=auditEntries,proto3" json:"audit_entries,omitempty"`
+	// The latest audit entry; unset without entries
+	LastAction    string                 `protobuf:"bytes,2,opt,name=last_action,json=lastAction,proto3" json:"last_action,omitempty"`
+	LastActorId   string                 `protobuf:"bytes,3,opt,name=last_actor_id,json=lastActorId,proto3" json:"last_actor_id,omitempty"`
+	LastChangedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_changed_at,json=lastChangedAt,proto3" json:"last_changed_at,omitempty"`
+	// Number of jobs in the employment history
+	Jobs          int64 `protobuf:"varint,5,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeHistorySummary) Reset() {
+	*x = EmployeeHistorySummary{}
+	mi := &file_employee_v1_employee_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeHistorySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeHistorySummary) ProtoMessage() {}
+
+func (x *EmployeeHistorySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeHistorySummary.ProtoReflect.Descriptor instead.
+func (*EmployeeHistorySummary) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *EmployeeHistorySummary) GetAuditEntries() int64 {
+	if x != nil {
+		return x.AuditEntries
+	}
+	return 0
+}
+
+func (x *EmployeeHistorySummary) GetLastAction() string {
+	if x != nil {
+		return x.LastAction
+	}
+	return ""
+}
+
+func (x *EmployeeHistorySummary) GetLastActorId() string {
+	if x != nil {
+		return x.LastActorId
+	}
+	return ""
+}
+
+func (x *EmployeeHistorySummary) GetLastChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastChangedAt
+	}
+	return nil
+}
+
+func (x *EmployeeHistorySummary) GetJobs() int64 {
+	if x != nil {
+		return x.Jobs
+	}
+	return 0
+}
+
+type DiffEmployeesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeA *Employee              `protobuf:"bytes,1,opt,name=employee_a,json=employeeA,proto3" json:"employee_a,omitempty"`
+	EmployeeB *Employee              `protobuf:"bytes,2,opt,name=employee_b,json=employeeB,proto3" json:"employee_b,omitempty"`
+	// Every compared field, equal or not, in a fixed order
+	Fields        []*EmployeeFieldDiff    `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Emails        *EmployeeEmailDiff      `protobuf:"bytes,4,opt,name=emails,proto3" json:"emails,omitempty"`
+	HistoryA      *EmployeeHistorySummary `protobuf:"bytes,5,opt,name=history_a,json=historyA,proto3" json:"history_a,omitempty"`
+	HistoryB      *EmployeeHistorySummary `protobuf:"bytes,6,opt,name=history_b,json=historyB,proto3" json:"history_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffEmployeesResponse) Reset() {
+	*x = DiffEmployeesResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffEmployeesResponse) ProtoMessage() {}
+
+func (x *DiffEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*DiffEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DiffEmployeesResponse) GetEmployeeA() *Employee {
+	if x != nil {
+		return x.EmployeeA
+	}
+	return nil
+}
+
+func (x *DiffEmployeesResponse) GetEmployeeB() *Employee {
+	if x != nil {
+		return x.EmployeeB
+	}
+	return nil
+}
+
+func (x *DiffEmployeesResponse) GetFields() []*EmployeeFieldDiff {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *DiffEmployeesResponse) GetEmails() *EmployeeEmailDiff {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *DiffEmployeesResponse) GetHistoryA() *EmployeeHistorySummary {
+	if x != nil {
+		return x.HistoryA
+	}
+	return nil
+}
+
+func (x *DiffEmployeesResponse) GetHistoryB() *EmployeeHistorySummary {
+	if x != nil {
+		return x.HistoryB
+	}
+	return nil
+}
+
+// Watch Employees
+type WatchEmployeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Resume after the change that carried this token; unset starts from now
+	ResumeToken   *string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3,oneof" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEmployeesRequest) Reset() {
+	*x = WatchEmployeesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEmployeesRequest) ProtoMessage() {}
+
+func (x *WatchEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*WatchEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *WatchEmployeesRequest) GetResumeToken() string {
+	if x != nil && x.ResumeToken != nil {
+		return *x.ResumeToken
+	}
+	return ""
+}
+
+type WatchEmployeesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Checkpoint of this change, pass to WatchEmployees to resume after it
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// One of create, update, delete, merge, unmerge, approve, deactivate,
+	// reactivate
+	Action     string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	EmployeeId string `protobuf:"bytes,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// State after the change; unset for deletes
+	Employee *Employee `protobuf:"bytes,4,opt,name=employee,proto3" json:"employee,omitempty"`
+	// State before the change; unset for creates
+	Previous      *Employee              `protobuf:"bytes,5,opt,name=previous,proto3" json:"previous,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEmployeesResponse) Reset() {
+	*x = WatchEmployeesResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEmployeesResponse) ProtoMessage() {}
+
+func (x *WatchEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*WatchEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *WatchEmployeesResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchEmployeesResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *WatchEmployeesResponse) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *WatchEmployeesResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *WatchEmployeesResponse) GetPrevious() *Employee {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *WatchEmployeesResponse) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// List Pending Employees
+type ListPendingEmployeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page defaults to 1 if 0 or not set
+	Page *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	// page_size defaults to 20 if 0 or not set
+	PageSize      *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingEmployeesRequest) Reset() {
+	*x = ListPendingEmployeesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingEmployeesRequest) ProtoMessage() {}
+
+func (x *ListPendingEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListPendingEmployeesRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListPendingEmployeesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+// Approve Employee
+type ApproveEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveEmployeeRequest) Reset() {
+	*x = ApproveEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveEmployeeRequest) ProtoMessage() {}
+
+func (x *ApproveEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*ApproveEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ApproveEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ApproveEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveEmployeeResponse) Reset() {
+	*x = ApproveEmployeeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveEmployeeResponse) ProtoMessage() {}
+
+func (x *ApproveEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*ApproveEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ApproveEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+// Reject Employee
+type RejectEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectEmployeeRequest) Reset() {
+	*x = RejectEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectEmployeeRequest) ProtoMessage() {}
+
+func (x *RejectEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*RejectEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RejectEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RejectEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectEmployeeResponse) Reset() {
+	*x = RejectEmployeeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectEmployeeResponse) ProtoMessage() {}
+
+func (x *RejectEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*RejectEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RejectEmployeeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// A create or update applied at effective_at by the scheduler
+type ScheduledChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// create or update
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// The employee to update, or the ID the employee will be created with
+	EmployeeId string `protobuf:"bytes,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// The requested values; unset fields of an update are left unchanged
+	Emails      []string               `protobuf:"bytes,4,rep,name=emails,proto3" json:"emails,omitempty"`
+	FirstName   string                 `protobuf:"bytes,5,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName    string                 `protobuf:"bytes,6,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	EffectiveAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	// pending, applied, failed or cancelled
+	Status string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	// Why the change failed
+	Error     string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedBy string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AppliedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+	// The requested department; unset when the change keeps the department
+	DepartmentId *string `protobuf:"bytes,13,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	// The requested manager; unset when the change keeps the manager
+	ManagerId *string `protobuf:"bytes,14,opt,name=manager_id,json=managerId,proto3,oneof" json:"manager_id,omitempty"`
+	// The requested job title; empty when the change keeps the title
+	Title string `protobuf:"bytes,15,opt,name=title,proto3" json:"title,omitempty"`
+	// The requested phone numbers; empty when the change keeps them or, for
+	// an update with clear_phone_numbers, removes them
+	PhoneNumbers []*PhoneNumber `protobuf:"bytes,16,rep,name=phone_numbers,json=phoneNumbers,proto3" json:"phone_numbers,omitempty"`
+	// The requested postal address; unset when the change keeps it or, for an
+	// update with clear_address, removes it
+	Address *PostalAddress `protobuf:"bytes,17,opt,name=address,proto3" json:"address,omitempty"`
+	// The requested external IDs; for an update an empty ID removes the ID in
+	// its system
+	ExternalIds   map[string]string `protobuf:"bytes,18,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledChange) Reset() {
+	*x = ScheduledChange{}
+	mi := &file_employee_v1_employee_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledChange) ProtoMessage() {}
+
+func (x *ScheduledChange) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledChange.ProtoReflect.Descriptor instead.
+func (*ScheduledChange) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ScheduledChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *ScheduledChange) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *ScheduledChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScheduledChange) GetAppliedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return nil
+}
+
+func (x *ScheduledChange) GetDepartmentId() string {
+	if x != nil && x.DepartmentId != nil {
+		return *x.DepartmentId
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetManagerId() string {
+	if x != nil && x.ManagerId != nil {
+		return *x.ManagerId
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetPhoneNumbers() []*PhoneNumber {
+	if x != nil {
+		return x.PhoneNumbers
+	}
+	return nil
+}
+
+func (x *ScheduledChange) GetAddress() *PostalAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ScheduledChange) GetExternalIds() map[string]string {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+// List Scheduled Changes
+type ListScheduledChangesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page defaults to 1 if 0 or not set
+	Page *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	// page_size defaults to 20 if 0 or not set
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Only changes with this status (default pending)
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Only changes of this employee
+	EmployeeId    *string `protobuf:"bytes,4,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledChangesRequest) Reset() {
+	*x = ListScheduledChangesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledChangesRequest) ProtoMessage() {}
+
+func (x *ListScheduledChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledChangesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListScheduledChangesRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListScheduledChangesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListScheduledChangesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListScheduledChangesRequest) GetEmployeeId() string {
+	if x != nil && x.EmployeeId != nil {
+		return *x.EmployeeId
+	}
+	return ""
+}
+
+type ListScheduledChangesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledChanges []*ScheduledChange     `protobuf:"bytes,1,rep,name=scheduled_changes,json=scheduledChanges,proto3" json:"scheduled_changes,omitempty"`
+	Total            int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page             int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize         int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListScheduledChangesResponse) Reset() {
+	*x = ListScheduledChangesResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledChangesResponse) ProtoMessage() {}
+
+func (x *ListScheduledChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledChangesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListScheduledChangesResponse) GetScheduledChanges() []*ScheduledChange {
+	if x != nil {
+		return x.ScheduledChanges
+	}
+	return nil
+}
+
+func (x *ListScheduledChangesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListScheduledChangesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListScheduledChangesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Cancel Scheduled Change
+type CancelScheduledChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledChangeRequest) Reset() {
+	*x = CancelScheduledChangeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledChangeRequest) ProtoMessage() {}
+
+func (x *CancelScheduledChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledChangeRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledChangeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CancelScheduledChangeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelScheduledChangeResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledChange *ScheduledChange       `protobuf:"bytes,1,opt,name=scheduled_change,json=scheduledChange,proto3" json:"scheduled_change,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CancelScheduledChangeResponse) Reset() {
+	*x = CancelScheduledChangeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledChangeResponse) ProtoMessage() {}
+
+func (x *CancelScheduledChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledChangeResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledChangeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CancelScheduledChangeResponse) GetScheduledChange() *ScheduledChange {
+	if x != nil {
+		return x.ScheduledChange
+	}
+	return nil
+}
+
+// List Direct Reports
+type ListDirectReportsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ManagerId string                 `protobuf:"bytes,1,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	// page defaults to 1 if 0 or not set
+	Page *int32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	// page_size defaults to 20 if 0 or not set
+	PageSize      *int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDirectReportsRequest) Reset() {
+	*x = ListDirectReportsRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDirectReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDirectReportsRequest) ProtoMessage() {}
+
+func (x *ListDirectReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDirectReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListDirectReportsRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListDirectReportsRequest) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *ListDirectReportsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListDirectReportsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+// Get Management Chain
+type GetManagementChainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManagementChainRequest) Reset() {
+	*x = GetManagementChainRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManagementChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagementChainRequest) ProtoMessage() {}
+
+func (x *GetManagementChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManagementChainRequest.ProtoReflect.Descriptor instead.
+func (*GetManagementChainRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetManagementChainRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+type GetManagementChainResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The managers above the employee, its direct manager first; empty when
+	// the employee has no manager
+	Managers      []*Employee `protobuf:"bytes,1,rep,name=managers,proto3" json:"managers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManagementChainResponse) Reset() {
+	*x = GetManagementChainResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManagementChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagementChainResponse) ProtoMessage() {}
+
+func (x *GetManagementChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManagementChainResponse.ProtoReflect.Descriptor instead.
+func (*GetManagementChainResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetManagementChainResponse) GetManagers() []*Employee {
+	if x != nil {
+		return x.Managers
+	}
+	return nil
+}
+
+// List Employment History
+type ListEmploymentHistoryRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// page defaults to 1 if 0 or not set
+	Page *int32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	// page_size defaults to 20 if 0 or not set
+	PageSize      *int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmploymentHistoryRequest) Reset() {
+	*x = ListEmploymentHistoryRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmploymentHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmploymentHistoryRequest) ProtoMessage() {}
+
+func (x *ListEmploymentHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmploymentHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListEmploymentHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListEmploymentHistoryRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ListEmploymentHistoryRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListEmploymentHistoryRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+// EmploymentHistoryEntry is a job an employee held
+type EmploymentHistoryEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// Job title; empty when unset
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// Department of the job; empty when unassigned
+	DepartmentId string `protobuf:"bytes,4,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	// Manager of the job; empty when the employee had none
+	ManagerId string `protobuf:"bytes,5,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	// When the job started
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// When the job ended; unset for the current job
+	EffectiveTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmploymentHistoryEntry) Reset() {
+	*x = EmploymentHistoryEntry{}
+	mi := &file_employee_v1_employee_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmploymentHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmploymentHistoryEntry) ProtoMessage() {}
+
+func (x *EmploymentHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmploymentHistoryEntry.ProtoReflect.Descriptor instead.
+func (*EmploymentHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *EmploymentHistoryEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EmploymentHistoryEntry) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *EmploymentHistoryEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EmploymentHistoryEntry) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *EmploymentHistoryEntry) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *EmploymentHistoryEntry) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *EmploymentHistoryEntry) GetEffectiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+type ListEmploymentHistoryResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Entries       []*EmploymentHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         int64                     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                     `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                     `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmploymentHistoryResponse) Reset() {
+	*x = ListEmploymentHistoryResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmploymentHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmploymentHistoryResponse) ProtoMessage() {}
+
+func (x *ListEmploymentHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmploymentHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListEmploymentHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListEmploymentHistoryResponse) GetEntries() []*EmploymentHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListEmploymentHistoryResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListEmploymentHistoryResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEmploymentHistoryResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Add Tag
+type AddTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Letters, digits, hyphens and underscores; stored lowercase
+	Tag           string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTagRequest) Reset() {
+	*x = AddTagRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTagRequest) ProtoMessage() {}
+
+func (x *AddTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTagRequest.ProtoReflect.Descriptor instead.
+func (*AddTagRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AddTagRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type AddTagResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Employee *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// False when the employee already had the tag
+	Added         bool `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTagResponse) Reset() {
+	*x = AddTagResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTagResponse) ProtoMessage() {}
+
+func (x *AddTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTagResponse.ProtoReflect.Descriptor instead.
+func (*AddTagResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AddTagResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *AddTagResponse) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+// Remove Tag
+type RemoveTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTagRequest) Reset() {
+	*x = RemoveTagRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTagRequest) ProtoMessage() {}
+
+func (x *RemoveTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTagRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTagRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RemoveTagRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type RemoveTagResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Employee *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// False when the employee did not have the tag
+	Removed       bool `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTagResponse) Reset() {
+	*x = RemoveTagResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTagResponse) ProtoMessage() {}
+
+func (x *RemoveTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTagResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTagResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RemoveTagResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *RemoveTagResponse) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+// Add Secondary Email
+type AddSecondaryEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSecondaryEmailRequest) Reset() {
+	*x = AddSecondaryEmailRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSecondaryEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSecondaryEmailRequest) ProtoMessage() {}
+
+func (x *AddSecondaryEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSecondaryEmailRequest.ProtoReflect.Descriptor instead.
+func (*AddSecondaryEmailRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AddSecondaryEmailRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddSecondaryEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type AddSecondaryEmailResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Employee *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// False when the employee already had the email
+	Added         bool `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSecondaryEmailResponse) Reset() {
+	*x = AddSecondaryEmailResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSecondaryEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSecondaryEmailResponse) ProtoMessage() {}
+
+func (x *AddSecondaryEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSecondaryEmailResponse.ProtoReflect.Descriptor instead.
+func (*AddSecondaryEmailResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AddSecondaryEmailResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *AddSecondaryEmailResponse) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+// Remove Secondary Email
+type RemoveSecondaryEmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // UUID v4 as string
-	Emails        []string               `protobuf:"bytes,2,rep,name=emails,proto3" json:"emails,omitempty"` // All email addresses for this employee
-	FirstName     string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSecondaryEmailRequest) Reset() {
+	*x = RemoveSecondaryEmailRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSecondaryEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSecondaryEmailRequest) ProtoMessage() {}
+
+func (x *RemoveSecondaryEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSecondaryEmailRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSecondaryEmailRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RemoveSecondaryEmailRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveSecondaryEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RemoveSecondaryEmailResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Employee *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// False when the employee did not have the email
+	Removed       bool `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSecondaryEmailResponse) Reset() {
+	*x = RemoveSecondaryEmailResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSecondaryEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSecondaryEmailResponse) ProtoMessage() {}
+
+func (x *RemoveSecondaryEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSecondaryEmailResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSecondaryEmailResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RemoveSecondaryEmailResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *RemoveSecondaryEmailResponse) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+// Set Primary Email
+type SetPrimaryEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// One of the employee's emails
+	Email         string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryEmailRequest) Reset() {
+	*x = SetPrimaryEmailRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryEmailRequest) ProtoMessage() {}
+
+func (x *SetPrimaryEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryEmailRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryEmailRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SetPrimaryEmailRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetPrimaryEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type SetPrimaryEmailResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Employee *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// False when the email already was the primary email
+	Changed       bool `protobuf:"varint,2,opt,name=changed,proto3" json:"changed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Employee) Reset() {
-	*x = Employee{}
-	mi := &file_employee_v1_employee_proto_msgTypes[0]
+func (x *SetPrimaryEmailResponse) Reset() {
+	*x = SetPrimaryEmailResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Employee) String() string {
+func (x *SetPrimaryEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Employee) ProtoMessage() {}
+func (*SetPrimaryEmailResponse) ProtoMessage() {}
 
-func (x *Employee) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[0]
+func (x *SetPrimaryEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -62,78 +5487,101 @@ func (x *Employee) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Employee.ProtoReflect.Descriptor instead.
-func (*Employee) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use SetPrimaryEmailResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryEmailResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *Employee) GetId() string {
+func (x *SetPrimaryEmailResponse) GetEmployee() *Employee {
 	if x != nil {
-		return x.Id
+		return x.Employee
 	}
-	return ""
+	return nil
 }
 
-func (x *Employee) GetEmails() []string {
+func (x *SetPrimaryEmailResponse) GetChanged() bool {
 	if x != nil {
-		return x.Emails
+		return x.Changed
 	}
-	return nil
+	return false
 }
 
-func (x *Employee) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+// Upload Employee Photo
+type UploadEmployeePhotoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// JPEG, PNG or WebP image of at most 5 MiB; base64-encoded over HTTP
+	Photo         []byte `protobuf:"bytes,2,opt,name=photo,proto3" json:"photo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Employee) GetLastName() string {
+func (x *UploadEmployeePhotoRequest) Reset() {
+	*x = UploadEmployeePhotoRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadEmployeePhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadEmployeePhotoRequest) ProtoMessage() {}
+
+func (x *UploadEmployeePhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[80]
 	if x != nil {
-		return x.LastName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Employee) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use UploadEmployeePhotoRequest.ProtoReflect.Descriptor instead.
+func (*UploadEmployeePhotoRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UploadEmployeePhotoRequest) GetId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *Employee) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *UploadEmployeePhotoRequest) GetPhoto() []byte {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Photo
 	}
 	return nil
 }
 
-// Create Employee
-type CreateEmployeeRequest struct {
+type UploadEmployeePhotoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Emails        []string               `protobuf:"bytes,1,rep,name=emails,proto3" json:"emails,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEmployeeRequest) Reset() {
-	*x = CreateEmployeeRequest{}
-	mi := &file_employee_v1_employee_proto_msgTypes[1]
+func (x *UploadEmployeePhotoResponse) Reset() {
+	*x = UploadEmployeePhotoResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEmployeeRequest) String() string {
+func (x *UploadEmployeePhotoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEmployeeRequest) ProtoMessage() {}
+func (*UploadEmployeePhotoResponse) ProtoMessage() {}
 
-func (x *CreateEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[1]
+func (x *UploadEmployeePhotoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -144,54 +5592,87 @@ func (x *CreateEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*CreateEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use UploadEmployeePhotoResponse.ProtoReflect.Descriptor instead.
+func (*UploadEmployeePhotoResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *CreateEmployeeRequest) GetEmails() []string {
+func (x *UploadEmployeePhotoResponse) GetEmployee() *Employee {
 	if x != nil {
-		return x.Emails
+		return x.Employee
 	}
 	return nil
 }
 
-func (x *CreateEmployeeRequest) GetFirstName() string {
+// Get Employee Photo URL
+type GetEmployeePhotoURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeePhotoURLRequest) Reset() {
+	*x = GetEmployeePhotoURLRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeePhotoURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeePhotoURLRequest) ProtoMessage() {}
+
+func (x *GetEmployeePhotoURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[82]
 	if x != nil {
-		return x.FirstName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateEmployeeRequest) GetLastName() string {
+// Deprecated: Use GetEmployeePhotoURLRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeePhotoURLRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetEmployeePhotoURLRequest) GetId() string {
 	if x != nil {
-		return x.LastName
+		return x.Id
 	}
 	return ""
 }
 
-type CreateEmployeeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+type GetEmployeePhotoURLResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pre-signed URL of the photo
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEmployeeResponse) Reset() {
-	*x = CreateEmployeeResponse{}
-	mi := &file_employee_v1_employee_proto_msgTypes[2]
+func (x *GetEmployeePhotoURLResponse) Reset() {
+	*x = GetEmployeePhotoURLResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEmployeeResponse) String() string {
+func (x *GetEmployeePhotoURLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEmployeeResponse) ProtoMessage() {}
+func (*GetEmployeePhotoURLResponse) ProtoMessage() {}
 
-func (x *CreateEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[2]
+func (x *GetEmployeePhotoURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -202,45 +5683,55 @@ func (x *CreateEmployeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEmployeeResponse.ProtoReflect.Descriptor instead.
-func (*CreateEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetEmployeePhotoURLResponse.ProtoReflect.Descriptor instead.
+func (*GetEmployeePhotoURLResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *CreateEmployeeResponse) GetEmployee() *Employee {
+func (x *GetEmployeePhotoURLResponse) GetUrl() string {
 	if x != nil {
-		return x.Employee
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetEmployeePhotoURLResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-// Update Employee
-type UpdateEmployeeRequest struct {
+// List Employees As Of
+type ListEmployeesAsOfRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Optional fields - only validated if set
-	Emails        []string `protobuf:"bytes,2,rep,name=emails,proto3" json:"emails,omitempty"`
-	FirstName     *string  `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
-	LastName      *string  `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	// Point in time the employees are listed at
+	AsOf     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Page     *int32                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Lists only employees of the department at as_of
+	DepartmentId *string `protobuf:"bytes,4,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	// Lists only employees having all of these tags at as_of
+	Tags          []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEmployeeRequest) Reset() {
-	*x = UpdateEmployeeRequest{}
-	mi := &file_employee_v1_employee_proto_msgTypes[3]
+func (x *ListEmployeesAsOfRequest) Reset() {
+	*x = ListEmployeesAsOfRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEmployeeRequest) String() string {
+func (x *ListEmployeesAsOfRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEmployeeRequest) ProtoMessage() {}
+func (*ListEmployeesAsOfRequest) ProtoMessage() {}
 
-func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[3]
+func (x *ListEmployeesAsOfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,61 +5742,72 @@ func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListEmployeesAsOfRequest.ProtoReflect.Descriptor instead.
+func (*ListEmployeesAsOfRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *UpdateEmployeeRequest) GetId() string {
+func (x *ListEmployeesAsOfRequest) GetAsOf() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.AsOf
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateEmployeeRequest) GetEmails() []string {
-	if x != nil {
-		return x.Emails
+func (x *ListEmployeesAsOfRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateEmployeeRequest) GetFirstName() string {
-	if x != nil && x.FirstName != nil {
-		return *x.FirstName
+func (x *ListEmployeesAsOfRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateEmployeeRequest) GetLastName() string {
-	if x != nil && x.LastName != nil {
-		return *x.LastName
+func (x *ListEmployeesAsOfRequest) GetDepartmentId() string {
+	if x != nil && x.DepartmentId != nil {
+		return *x.DepartmentId
 	}
 	return ""
 }
 
-type UpdateEmployeeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+func (x *ListEmployeesAsOfRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListEmployeesAsOfResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Employees as they were at as_of, oldest first
+	Employees     []*Employee `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	Total         int64       `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32       `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32       `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEmployeeResponse) Reset() {
-	*x = UpdateEmployeeResponse{}
-	mi := &file_employee_v1_employee_proto_msgTypes[4]
+func (x *ListEmployeesAsOfResponse) Reset() {
+	*x = ListEmployeesAsOfResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEmployeeResponse) String() string {
+func (x *ListEmployeesAsOfResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEmployeeResponse) ProtoMessage() {}
+func (*ListEmployeesAsOfResponse) ProtoMessage() {}
 
-func (x *UpdateEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[4]
+func (x *ListEmployeesAsOfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,41 +5818,62 @@ func (x *UpdateEmployeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEmployeeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListEmployeesAsOfResponse.ProtoReflect.Descriptor instead.
+func (*ListEmployeesAsOfResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *UpdateEmployeeResponse) GetEmployee() *Employee {
+func (x *ListEmployeesAsOfResponse) GetEmployees() []*Employee {
 	if x != nil {
-		return x.Employee
+		return x.Employees
 	}
 	return nil
 }
 
-// Delete Employee
-type DeleteEmployeeRequest struct {
+func (x *ListEmployeesAsOfResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListEmployeesAsOfResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEmployeesAsOfResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Deactivate Employee
+type DeactivateEmployeeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEmployeeRequest) Reset() {
-	*x = DeleteEmployeeRequest{}
-	mi := &file_employee_v1_employee_proto_msgTypes[5]
+func (x *DeactivateEmployeeRequest) Reset() {
+	*x = DeactivateEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEmployeeRequest) String() string {
+func (x *DeactivateEmployeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEmployeeRequest) ProtoMessage() {}
+func (*DeactivateEmployeeRequest) ProtoMessage() {}
 
-func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[5]
+func (x *DeactivateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,40 +5884,42 @@ func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DeactivateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *DeleteEmployeeRequest) GetId() string {
+func (x *DeactivateEmployeeRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteEmployeeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+type DeactivateEmployeeResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Employee *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// False when the employee already was deactivated
+	Changed       bool `protobuf:"varint,2,opt,name=changed,proto3" json:"changed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEmployeeResponse) Reset() {
-	*x = DeleteEmployeeResponse{}
-	mi := &file_employee_v1_employee_proto_msgTypes[6]
+func (x *DeactivateEmployeeResponse) Reset() {
+	*x = DeactivateEmployeeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEmployeeResponse) String() string {
+func (x *DeactivateEmployeeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEmployeeResponse) ProtoMessage() {}
+func (*DeactivateEmployeeResponse) ProtoMessage() {}
 
-func (x *DeleteEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[6]
+func (x *DeactivateEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,41 +5930,48 @@ func (x *DeleteEmployeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEmployeeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use DeactivateEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *DeleteEmployeeResponse) GetSuccess() bool {
+func (x *DeactivateEmployeeResponse) GetEmployee() *Employee {
 	if x != nil {
-		return x.Success
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *DeactivateEmployeeResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
 	}
 	return false
 }
 
-// Get Employee by ID
-type GetEmployeeRequest struct {
+// Reactivate Employee
+type ReactivateEmployeeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeeRequest) Reset() {
-	*x = GetEmployeeRequest{}
-	mi := &file_employee_v1_employee_proto_msgTypes[7]
+func (x *ReactivateEmployeeRequest) Reset() {
+	*x = ReactivateEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeeRequest) String() string {
+func (x *ReactivateEmployeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeeRequest) ProtoMessage() {}
-
-func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[7]
+func (*ReactivateEmployeeRequest) ProtoMessage() {}
+
+func (x *ReactivateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,40 +5982,42 @@ func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*GetEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ReactivateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetEmployeeRequest) GetId() string {
+func (x *ReactivateEmployeeRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetEmployeeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+type ReactivateEmployeeResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Employee *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// False when the employee already was active
+	Changed       bool `protobuf:"varint,2,opt,name=changed,proto3" json:"changed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeeResponse) Reset() {
-	*x = GetEmployeeResponse{}
-	mi := &file_employee_v1_employee_proto_msgTypes[8]
+func (x *ReactivateEmployeeResponse) Reset() {
+	*x = ReactivateEmployeeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeeResponse) String() string {
+func (x *ReactivateEmployeeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeeResponse) ProtoMessage() {}
+func (*ReactivateEmployeeResponse) ProtoMessage() {}
 
-func (x *GetEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[8]
+func (x *ReactivateEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -494,41 +6028,52 @@ func (x *GetEmployeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeeResponse.ProtoReflect.Descriptor instead.
-func (*GetEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ReactivateEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GetEmployeeResponse) GetEmployee() *Employee {
+func (x *ReactivateEmployeeResponse) GetEmployee() *Employee {
 	if x != nil {
 		return x.Employee
 	}
 	return nil
 }
 
-// Get Employee by Email
-type GetEmployeeByEmailRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+func (x *ReactivateEmployeeResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+// Report Email Bounces
+type EmailBounce struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// bounce for a permanent bounce, complaint for a spam complaint. Transient
+	// (soft) bounces should not be reported.
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeeByEmailRequest) Reset() {
-	*x = GetEmployeeByEmailRequest{}
-	mi := &file_employee_v1_employee_proto_msgTypes[9]
+func (x *EmailBounce) Reset() {
+	*x = EmailBounce{}
+	mi := &file_employee_v1_employee_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeeByEmailRequest) String() string {
+func (x *EmailBounce) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeeByEmailRequest) ProtoMessage() {}
+func (*EmailBounce) ProtoMessage() {}
 
-func (x *GetEmployeeByEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[9]
+func (x *EmailBounce) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,40 +6084,54 @@ func (x *GetEmployeeByEmailRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeeByEmailRequest.ProtoReflect.Descriptor instead.
-func (*GetEmployeeByEmailRequest) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use EmailBounce.ProtoReflect.Descriptor instead.
+func (*EmailBounce) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GetEmployeeByEmailRequest) GetEmail() string {
+func (x *EmailBounce) GetEmail() string {
 	if x != nil {
 		return x.Email
 	}
 	return ""
 }
 
-type GetEmployeeByEmailResponse struct {
+func (x *EmailBounce) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EmailBounce) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type ReportEmailBouncesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	Bounces       []*EmailBounce         `protobuf:"bytes,1,rep,name=bounces,proto3" json:"bounces,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeeByEmailResponse) Reset() {
-	*x = GetEmployeeByEmailResponse{}
-	mi := &file_employee_v1_employee_proto_msgTypes[10]
+func (x *ReportEmailBouncesRequest) Reset() {
+	*x = ReportEmailBouncesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeeByEmailResponse) String() string {
+func (x *ReportEmailBouncesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeeByEmailResponse) ProtoMessage() {}
+func (*ReportEmailBouncesRequest) ProtoMessage() {}
 
-func (x *GetEmployeeByEmailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[10]
+func (x *ReportEmailBouncesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -583,46 +6142,41 @@ func (x *GetEmployeeByEmailResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeeByEmailResponse.ProtoReflect.Descriptor instead.
-func (*GetEmployeeByEmailResponse) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ReportEmailBouncesRequest.ProtoReflect.Descriptor instead.
+func (*ReportEmailBouncesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *GetEmployeeByEmailResponse) GetEmployee() *Employee {
+func (x *ReportEmailBouncesRequest) GetBounces() []*EmailBounce {
 	if x != nil {
-		return x.Employee
+		return x.Bounces
 	}
 	return nil
 }
 
-// List Employees
-type ListEmployeesRequest struct {
+type ReportEmailBouncesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// page defaults to 1 if 0 or not set (handled in business logic)
-	Page *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	// page_size defaults to 20 if 0 or not set (handled in business logic)
-	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
-	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// The emails newly marked undeliverable
+	MarkedEmails  []string `protobuf:"bytes,1,rep,name=marked_emails,json=markedEmails,proto3" json:"marked_emails,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEmployeesRequest) Reset() {
-	*x = ListEmployeesRequest{}
-	mi := &file_employee_v1_employee_proto_msgTypes[11]
+func (x *ReportEmailBouncesResponse) Reset() {
+	*x = ReportEmailBouncesResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEmployeesRequest) String() string {
+func (x *ReportEmailBouncesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEmployeesRequest) ProtoMessage() {}
+func (*ReportEmailBouncesResponse) ProtoMessage() {}
 
-func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[11]
+func (x *ReportEmailBouncesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,64 +6187,47 @@ func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEmployeesRequest.ProtoReflect.Descriptor instead.
-func (*ListEmployeesRequest) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *ListEmployeesRequest) GetPage() int32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
-	}
-	return 0
-}
-
-func (x *ListEmployeesRequest) GetPageSize() int32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
-	}
-	return 0
-}
-
-func (x *ListEmployeesRequest) GetCreatedAfter() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAfter
-	}
-	return nil
+// Deprecated: Use ReportEmailBouncesResponse.ProtoReflect.Descriptor instead.
+func (*ReportEmailBouncesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *ListEmployeesRequest) GetCreatedBefore() *timestamppb.Timestamp {
+func (x *ReportEmailBouncesResponse) GetMarkedEmails() []string {
 	if x != nil {
-		return x.CreatedBefore
+		return x.MarkedEmails
 	}
 	return nil
 }
 
-type ListEmployeesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+// Validate Employee
+type ValidateEmployeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The payload to validate, as sent to CreateEmployee. Its field
+	// constraints are checked by ValidateEmployee itself, so that they are
+	// returned as violations instead of failing the request.
+	Employee *CreateEmployeeRequest `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	// Validates the payload as an update of this employee: its own emails and
+	// external IDs are not taken by another employee
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEmployeesResponse) Reset() {
-	*x = ListEmployeesResponse{}
-	mi := &file_employee_v1_employee_proto_msgTypes[12]
+func (x *ValidateEmployeeRequest) Reset() {
+	*x = ValidateEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEmployeesResponse) String() string {
+func (x *ValidateEmployeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEmployeesResponse) ProtoMessage() {}
+func (*ValidateEmployeeRequest) ProtoMessage() {}
 
-func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[12]
+func (x *ValidateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,63 +6238,56 @@ func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEmployeesResponse.ProtoReflect.Descriptor instead.
-func (*ListEmployeesResponse) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ValidateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*ValidateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *ListEmployeesResponse) GetEmployees() []*Employee {
+func (x *ValidateEmployeeRequest) GetEmployee() *CreateEmployeeRequest {
 	if x != nil {
-		return x.Employees
+		return x.Employee
 	}
 	return nil
 }
 
-func (x *ListEmployeesResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListEmployeesResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListEmployeesResponse) GetPageSize() int32 {
+func (x *ValidateEmployeeRequest) GetId() string {
 	if x != nil {
-		return x.PageSize
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-// Merge Employees
-type MergeEmployeesRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	PrimaryEmail   string                 `protobuf:"bytes,1,opt,name=primary_email,json=primaryEmail,proto3" json:"primary_email,omitempty"`
-	SecondaryEmail string                 `protobuf:"bytes,2,opt,name=secondary_email,json=secondaryEmail,proto3" json:"secondary_email,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// A problem found validating a field of an employee
+type ValidationIssue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path of the field, e.g. emails[1] or external_ids["workday"], empty for
+	// the employee as a whole
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The error reason the write would fail with, e.g.
+	// EMPLOYEE_ALREADY_EXISTS, the rule of a violated field constraint, e.g.
+	// string.email, or the kind of a warning: EMAIL_NORMALIZED,
+	// REVIEW_REQUIRED or QUOTA_EXCEEDED
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MergeEmployeesRequest) Reset() {
-	*x = MergeEmployeesRequest{}
-	mi := &file_employee_v1_employee_proto_msgTypes[13]
+func (x *ValidationIssue) Reset() {
+	*x = ValidationIssue{}
+	mi := &file_employee_v1_employee_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeEmployeesRequest) String() string {
+func (x *ValidationIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeEmployeesRequest) ProtoMessage() {}
+func (*ValidationIssue) ProtoMessage() {}
 
-func (x *MergeEmployeesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[13]
+func (x *ValidationIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,47 +6298,57 @@ func (x *MergeEmployeesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeEmployeesRequest.ProtoReflect.Descriptor instead.
-func (*MergeEmployeesRequest) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ValidationIssue.ProtoReflect.Descriptor instead.
+func (*ValidationIssue) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *MergeEmployeesRequest) GetPrimaryEmail() string {
+func (x *ValidationIssue) GetField() string {
 	if x != nil {
-		return x.PrimaryEmail
+		return x.Field
 	}
 	return ""
 }
 
-func (x *MergeEmployeesRequest) GetSecondaryEmail() string {
+func (x *ValidationIssue) GetCode() string {
 	if x != nil {
-		return x.SecondaryEmail
+		return x.Code
 	}
 	return ""
 }
 
-type MergeEmployeesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+func (x *ValidationIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidateEmployeeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True without violations
+	Valid         bool               `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Violations    []*ValidationIssue `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	Warnings      []*ValidationIssue `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MergeEmployeesResponse) Reset() {
-	*x = MergeEmployeesResponse{}
-	mi := &file_employee_v1_employee_proto_msgTypes[14]
+func (x *ValidateEmployeeResponse) Reset() {
+	*x = ValidateEmployeeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeEmployeesResponse) String() string {
+func (x *ValidateEmployeeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeEmployeesResponse) ProtoMessage() {}
+func (*ValidateEmployeeResponse) ProtoMessage() {}
 
-func (x *MergeEmployeesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[14]
+func (x *ValidateEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,14 +6359,28 @@ func (x *MergeEmployeesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeEmployeesResponse.ProtoReflect.Descriptor instead.
-func (*MergeEmployeesResponse) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ValidateEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*ValidateEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *MergeEmployeesResponse) GetEmployee() *Employee {
+func (x *ValidateEmployeeResponse) GetValid() bool {
 	if x != nil {
-		return x.Employee
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateEmployeeResponse) GetViolations() []*ValidationIssue {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *ValidateEmployeeResponse) GetWarnings() []*ValidationIssue {
+	if x != nil {
+		return x.Warnings
 	}
 	return nil
 }
@@ -835,7 +6389,7 @@ var File_employee_v1_employee_proto protoreflect.FileDescriptor
 
 const file_employee_v1_employee_proto_rawDesc = "" +
 	"\n" +
-	"\x1aemployee/v1/employee.proto\x12\vemployee.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\"\xe4\x01\n" +
+	"\x1aemployee/v1/employee.proto\x12\vemployee.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x12auth/v1/auth.proto\"\xd5\a\n" +
 	"\bEmployee\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06emails\x18\x02 \x03(\tR\x06emails\x12\x1d\n" +
@@ -845,65 +6399,594 @@ const file_employee_v1_employee_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbc\x01\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\x12#\n" +
+	"\rreview_status\x18\b \x01(\tR\freviewStatus\x12#\n" +
+	"\rdepartment_id\x18\t \x01(\tR\fdepartmentId\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\n" +
+	" \x01(\tR\tmanagerId\x12\x14\n" +
+	"\x05title\x18\v \x01(\tR\x05title\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12=\n" +
+	"\rphone_numbers\x18\r \x03(\v2\x18.employee.v1.PhoneNumberR\fphoneNumbers\x124\n" +
+	"\aaddress\x18\x0e \x01(\v2\x1a.employee.v1.PostalAddressR\aaddress\x12\x1b\n" +
+	"\thas_photo\x18\x0f \x01(\bR\bhasPhoto\x12I\n" +
+	"\fexternal_ids\x18\x10 \x03(\v2&.employee.v1.Employee.ExternalIdsEntryR\vexternalIds\x12#\n" +
+	"\rprimary_email\x18\x11 \x01(\tR\fprimaryEmail\x12\x16\n" +
+	"\x06active\x18\x12 \x01(\bR\x06active\x12A\n" +
+	"\x0edeactivated_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\rdeactivatedAt\x12\x14\n" +
+	"\x05stale\x18\x14 \x01(\bR\x05stale\x12;\n" +
+	"\vstale_since\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"staleSince\x12R\n" +
+	"\x14undeliverable_emails\x18\x16 \x03(\v2\x1f.employee.v1.UndeliverableEmailR\x13undeliverableEmails\x1a>\n" +
+	"\x10ExternalIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"t\n" +
+	"\x12UndeliverableEmail\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\xeb\x01\n" +
+	"\rPostalAddress\x12 \n" +
+	"\x05line1\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x05line1\x12\x1e\n" +
+	"\x05line2\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01R\x05line2\x12\x1d\n" +
+	"\x04city\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04city\x12\x1f\n" +
+	"\x06region\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18dR\x06region\x12(\n" +
+	"\vpostal_code\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18\x14R\n" +
+	"postalCode\x12.\n" +
+	"\acountry\x18\x06 \x01(\tB\x14\xbaH\x11r\x0f2\r^[A-Za-z]{2}$R\acountry\"h\n" +
+	"\vPhoneNumber\x126\n" +
+	"\x04type\x18\x01 \x01(\tB\"\xbaH\x1fr\x1dR\x00R\x06mobileR\x04workR\x04homeR\x05otherR\x04type\x12!\n" +
+	"\x06number\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18 R\x06number\"\x91\a\n" +
 	"\x15CreateEmployeeRequest\x12-\n" +
 	"\x06emails\x18\x01 \x03(\tB\x15\xbaH\x12\x92\x01\x0f\b\x01\x10\n" +
 	"\"\tr\a\x10\x03\x18\xff\x01`\x01R\x06emails\x12:\n" +
 	"\n" +
 	"first_name\x18\x02 \x01(\tB\x1b\xbaH\x18r\x16\x10\x01\x18d2\x10^[a-zA-Z\\s\\-']+$R\tfirstName\x128\n" +
-	"\tlast_name\x18\x03 \x01(\tB\x1b\xbaH\x18r\x16\x10\x01\x18d2\x10^[a-zA-Z\\s\\-']+$R\blastName\"K\n" +
+	"\tlast_name\x18\x03 \x01(\tB\x1b\xbaH\x18r\x16\x10\x01\x18d2\x10^[a-zA-Z\\s\\-']+$R\blastName\x121\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x0eidempotencyKey\x12=\n" +
+	"\feffective_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12|\n" +
+	"\rdepartment_id\x18\x06 \x01(\tBW\xbaHTrR2P^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})?$R\fdepartmentId\x12v\n" +
+	"\n" +
+	"manager_id\x18\a \x01(\tBW\xbaHTrR2P^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})?$R\tmanagerId\x12\x1d\n" +
+	"\x05title\x18\b \x01(\tB\a\xbaH\x04r\x02\x18dR\x05title\x12G\n" +
+	"\rphone_numbers\x18\t \x03(\v2\x18.employee.v1.PhoneNumberB\b\xbaH\x05\x92\x01\x02\x10\n" +
+	"R\fphoneNumbers\x124\n" +
+	"\aaddress\x18\n" +
+	" \x01(\v2\x1a.employee.v1.PostalAddressR\aaddress\x12\x8c\x01\n" +
+	"\fexternal_ids\x18\v \x03(\v23.employee.v1.CreateEmployeeRequest.ExternalIdsEntryB4\xbaH1\x9a\x01.\x10\x14\"!r\x1f2\x1d^[a-zA-Z][a-zA-Z0-9_-]{0,31}$*\ar\x05\x10\x01\x18\xff\x01R\vexternalIds\x1a>\n" +
+	"\x10ExternalIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x94\x01\n" +
 	"\x16CreateEmployeeResponse\x121\n" +
-	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"\xfb\x01\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12G\n" +
+	"\x10scheduled_change\x18\x02 \x01(\v2\x1c.employee.v1.ScheduledChangeR\x0fscheduledChange\"\xe7\x01\n" +
+	"$CreateOrUpdateEmployeeByEmailRequest\x12\"\n" +
+	"\x05email\x18\x01 \x01(\tB\f\xbaH\tr\a\x10\x03\x18\xff\x01`\x01R\x05email\x12?\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tB\x1b\xbaH\x18r\x16\x10\x01\x18d2\x10^[a-zA-Z\\s\\-']+$H\x00R\tfirstName\x88\x01\x01\x12=\n" +
+	"\tlast_name\x18\x03 \x01(\tB\x1b\xbaH\x18r\x16\x10\x01\x18d2\x10^[a-zA-Z\\s\\-']+$H\x01R\blastName\x88\x01\x01B\r\n" +
+	"\v_first_nameB\f\n" +
+	"\n" +
+	"_last_name\"t\n" +
+	"%CreateOrUpdateEmployeeByEmailResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"\xd0\b\n" +
 	"\x15UpdateEmployeeRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12+\n" +
 	"\x06emails\x18\x02 \x03(\tB\x13\xbaH\x10\x92\x01\r\x10\n" +
 	"\"\tr\a\x10\x03\x18\xff\x01`\x01R\x06emails\x12?\n" +
 	"\n" +
 	"first_name\x18\x03 \x01(\tB\x1b\xbaH\x18r\x16\x10\x01\x18d2\x10^[a-zA-Z\\s\\-']+$H\x00R\tfirstName\x88\x01\x01\x12=\n" +
-	"\tlast_name\x18\x04 \x01(\tB\x1b\xbaH\x18r\x16\x10\x01\x18d2\x10^[a-zA-Z\\s\\-']+$H\x01R\blastName\x88\x01\x01B\r\n" +
+	"\tlast_name\x18\x04 \x01(\tB\x1b\xbaH\x18r\x16\x10\x01\x18d2\x10^[a-zA-Z\\s\\-']+$H\x01R\blastName\x88\x01\x01\x12!\n" +
+	"\aversion\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aversion\x12=\n" +
+	"\feffective_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12\x81\x01\n" +
+	"\rdepartment_id\x18\a \x01(\tBW\xbaHTrR2P^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})?$H\x02R\fdepartmentId\x88\x01\x01\x12{\n" +
+	"\n" +
+	"manager_id\x18\b \x01(\tBW\xbaHTrR2P^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})?$H\x03R\tmanagerId\x88\x01\x01\x12$\n" +
+	"\x05title\x18\t \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dH\x04R\x05title\x88\x01\x01\x12G\n" +
+	"\rphone_numbers\x18\n" +
+	" \x03(\v2\x18.employee.v1.PhoneNumberB\b\xbaH\x05\x92\x01\x02\x10\n" +
+	"R\fphoneNumbers\x12.\n" +
+	"\x13clear_phone_numbers\x18\v \x01(\bR\x11clearPhoneNumbers\x124\n" +
+	"\aaddress\x18\f \x01(\v2\x1a.employee.v1.PostalAddressR\aaddress\x12#\n" +
+	"\rclear_address\x18\r \x01(\bR\fclearAddress\x12\x8a\x01\n" +
+	"\fexternal_ids\x18\x0e \x03(\v23.employee.v1.UpdateEmployeeRequest.ExternalIdsEntryB2\xbaH/\x9a\x01,\x10\x14\"!r\x1f2\x1d^[a-zA-Z][a-zA-Z0-9_-]{0,31}$*\x05r\x03\x18\xff\x01R\vexternalIds\x1a>\n" +
+	"\x10ExternalIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
 	"\v_first_nameB\f\n" +
 	"\n" +
-	"_last_name\"K\n" +
+	"_last_nameB\x10\n" +
+	"\x0e_department_idB\r\n" +
+	"\v_manager_idB\b\n" +
+	"\x06_title\"\x94\x01\n" +
 	"\x16UpdateEmployeeResponse\x121\n" +
-	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"1\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12G\n" +
+	"\x10scheduled_change\x18\x02 \x01(\v2\x1c.employee.v1.ScheduledChangeR\x0fscheduledChange\"1\n" +
 	"\x15DeleteEmployeeRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"2\n" +
 	"\x16DeleteEmployeeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\".\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"S\n" +
 	"\x12GetEmployeeRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"H\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
+	"\rfollow_merges\x18\x02 \x01(\bR\ffollowMerges\"c\n" +
 	"\x13GetEmployeeResponse\x121\n" +
-	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"1\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x19\n" +
+	"\bmoved_to\x18\x02 \x01(\tR\amovedTo\"1\n" +
 	"\x19GetEmployeeByEmailRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"O\n" +
 	"\x1aGetEmployeeByEmailResponse\x121\n" +
-	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"\xff\x01\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"\x8b\x01\n" +
+	"\x1eGetEmployeeByExternalIDRequest\x12<\n" +
+	"\x06system\x18\x01 \x01(\tB$\xbaH!r\x1f2\x1d^[a-zA-Z][a-zA-Z0-9_-]{0,31}$R\x06system\x12+\n" +
+	"\vexternal_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\n" +
+	"externalId\"T\n" +
+	"\x1fGetEmployeeByExternalIDResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\";\n" +
+	"\x15EmployeeExistsRequest\x12\"\n" +
+	"\x05email\x18\x01 \x01(\tB\f\xbaH\tr\a\x10\x03\x18\xff\x01`\x01R\x05email\"0\n" +
+	"\x16EmployeeExistsResponse\x12\x16\n" +
+	"\x06exists\x18\x01 \x01(\bR\x06exists\"\x8d\x05\n" +
 	"\x14ListEmployeesRequest\x12!\n" +
 	"\x04page\x18\x01 \x01(\x05B\b\xbaH\x05\x1a\x03\x18\x90NH\x00R\x04page\x88\x01\x01\x12)\n" +
 	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dH\x01R\bpageSize\x88\x01\x01\x12?\n" +
 	"\rcreated_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
-	"\x0ecreated_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBeforeB\a\n" +
+	"\x0ecreated_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12(\n" +
+	"\vname_prefix\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18dR\n" +
+	"namePrefix\x12+\n" +
+	"\femail_domain\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\vemailDomain\x12/\n" +
+	"\x0eemail_contains\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\remailContains\x12?\n" +
+	"\rupdated_since\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedSince\x122\n" +
+	"\rdepartment_id\x18\t \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x02R\fdepartmentId\x88\x01\x01\x12\"\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tB\x0e\xbaH\v\x92\x01\b\x10\n" +
+	"\"\x04r\x02\x182R\x04tags\x12$\n" +
+	"\vactive_only\x18\v \x01(\bH\x03R\n" +
+	"activeOnly\x88\x01\x01\x12\x19\n" +
+	"\x05stale\x18\f \x01(\bH\x04R\x05stale\x88\x01\x01B\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
-	"_page_size\"\x93\x01\n" +
+	"_page_sizeB\x10\n" +
+	"\x0e_department_idB\x0e\n" +
+	"\f_active_onlyB\b\n" +
+	"\x06_stale\"\x93\x01\n" +
 	"\x15ListEmployeesResponse\x123\n" +
 	"\temployees\x18\x01 \x03(\v2\x15.employee.v1.EmployeeR\temployees\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x81\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xa9\x04\n" +
+	"\x15CountEmployeesRequest\x12?\n" +
+	"\rcreated_after\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12(\n" +
+	"\vname_prefix\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18dR\n" +
+	"namePrefix\x12+\n" +
+	"\femail_domain\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\vemailDomain\x12/\n" +
+	"\x0eemail_contains\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\remailContains\x12?\n" +
+	"\rupdated_since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedSince\x122\n" +
+	"\rdepartment_id\x18\a \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\fdepartmentId\x88\x01\x01\x12\"\n" +
+	"\x04tags\x18\b \x03(\tB\x0e\xbaH\v\x92\x01\b\x10\n" +
+	"\"\x04r\x02\x182R\x04tags\x12$\n" +
+	"\vactive_only\x18\t \x01(\bH\x01R\n" +
+	"activeOnly\x88\x01\x01\x12\x19\n" +
+	"\x05stale\x18\n" +
+	" \x01(\bH\x02R\x05stale\x88\x01\x01B\x10\n" +
+	"\x0e_department_idB\x0e\n" +
+	"\f_active_onlyB\b\n" +
+	"\x06_stale\".\n" +
+	"\x16CountEmployeesResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\"\xe7\x04\n" +
+	"\x16ExportEmployeesRequest\x12;\n" +
+	"\x06format\x18\x01 \x01(\tB#\xbaH r\x1eR\x00R\x03csvR\x06ndjsonR\aparquetR\x04xlsxR\x06format\x12?\n" +
+	"\rcreated_after\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12\"\n" +
+	"\x04tags\x18\x04 \x03(\tB\x0e\xbaH\v\x92\x01\b\x10\n" +
+	"\"\x04r\x02\x182R\x04tags\x12$\n" +
+	"\vactive_only\x18\x05 \x01(\bH\x00R\n" +
+	"activeOnly\x88\x01\x01\x12\x19\n" +
+	"\x05stale\x18\x06 \x01(\bH\x01R\x05stale\x88\x01\x01\x12(\n" +
+	"\vname_prefix\x18\a \x01(\tB\a\xbaH\x04r\x02\x18dR\n" +
+	"namePrefix\x12+\n" +
+	"\femail_domain\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\vemailDomain\x12/\n" +
+	"\x0eemail_contains\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\remailContains\x122\n" +
+	"\rdepartment_id\x18\n" +
+	" \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x02R\fdepartmentId\x88\x01\x01\x12?\n" +
+	"\rupdated_since\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedSinceB\x0e\n" +
+	"\f_active_onlyB\b\n" +
+	"\x06_staleB\x10\n" +
+	"\x0e_department_id\"\xa6\x01\n" +
 	"\x15MergeEmployeesRequest\x121\n" +
 	"\rprimary_email\x18\x01 \x01(\tB\f\xbaH\tr\a\x10\x03\x18\xff\x01`\x01R\fprimaryEmail\x125\n" +
-	"\x0fsecondary_email\x18\x02 \x01(\tB\f\xbaH\tr\a\x10\x03\x18\xff\x01`\x01R\x0esecondaryEmail\"K\n" +
+	"\x0fsecondary_email\x18\x02 \x01(\tB\f\xbaH\tr\a\x10\x03\x18\xff\x01`\x01R\x0esecondaryEmail\x12#\n" +
+	"\rvalidate_only\x18\x03 \x01(\bR\fvalidateOnly\"\x89\x02\n" +
 	"\x16MergeEmployeesResponse\x121\n" +
-	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee2\xf2\x06\n" +
-	"\x0fEmployeeService\x12w\n" +
-	"\x0eCreateEmployee\x12\".employee.v1.CreateEmployeeRequest\x1a#.employee.v1.CreateEmployeeResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/employees\x12|\n" +
-	"\x0eUpdateEmployee\x12\".employee.v1.UpdateEmployeeRequest\x1a#.employee.v1.UpdateEmployeeResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/v1/employees/{id}\x12y\n" +
-	"\x0eDeleteEmployee\x12\".employee.v1.DeleteEmployeeRequest\x1a#.employee.v1.DeleteEmployeeResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/employees/{id}\x12q\n" +
-	"\rListEmployees\x12!.employee.v1.ListEmployeesRequest\x1a\".employee.v1.ListEmployeesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/employees\x12p\n" +
-	"\vGetEmployee\x12\x1f.employee.v1.GetEmployeeRequest\x1a .employee.v1.GetEmployeeResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/employees/{id}\x12\x88\x01\n" +
-	"\x12GetEmployeeByEmail\x12&.employee.v1.GetEmployeeByEmailRequest\x1a'.employee.v1.GetEmployeeByEmailResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/employees:byEmail\x12}\n" +
-	"\x0eMergeEmployees\x12\".employee.v1.MergeEmployeesRequest\x1a#.employee.v1.MergeEmployeesResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/employees/mergeBT\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x19\n" +
+	"\bmerge_id\x18\x02 \x01(\tR\amergeId\x123\n" +
+	"\tsecondary\x18\x03 \x01(\v2\x15.employee.v1.EmployeeR\tsecondary\x12%\n" +
+	"\x0ename_conflicts\x18\x04 \x03(\tR\rnameConflicts\x12E\n" +
+	"\x10pending_approval\x18\x05 \x01(\v2\x1a.employee.v1.MergeApprovalR\x0fpendingApproval\"\x96\x01\n" +
+	"\x19MergeEmployeesByIdRequest\x12'\n" +
+	"\n" +
+	"primary_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprimaryId\x12+\n" +
+	"\fsecondary_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vsecondaryId\x12#\n" +
+	"\rvalidate_only\x18\x03 \x01(\bR\fvalidateOnly\"\xbd\x03\n" +
+	"\rMergeApproval\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"primary_id\x18\x02 \x01(\tR\tprimaryId\x12!\n" +
+	"\fsecondary_id\x18\x03 \x01(\tR\vsecondaryId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x18\n" +
+	"\areasons\x18\x05 \x03(\tR\areasons\x12'\n" +
+	"\x0fhistory_entries\x18\x06 \x01(\x03R\x0ehistoryEntries\x12\x16\n" +
+	"\x06emails\x18\a \x01(\x05R\x06emails\x12!\n" +
+	"\frequested_by\x18\b \x01(\tR\vrequestedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\n" +
+	" \x01(\tR\tdecidedBy\x129\n" +
+	"\n" +
+	"decided_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x12\x19\n" +
+	"\bmerge_id\x18\f \x01(\tR\amergeId\x12\x14\n" +
+	"\x05error\x18\r \x01(\tR\x05error\"\xc6\x01\n" +
+	"\x19ListMergeApprovalsRequest\x12!\n" +
+	"\x04page\x18\x01 \x01(\x05B\b\xbaH\x05\x1a\x03\x18\x90NH\x00R\x04page\x88\x01\x01\x12)\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dH\x01R\bpageSize\x88\x01\x01\x12D\n" +
+	"\x06status\x18\x03 \x01(\tB,\xbaH)r'R\x00R\apendingR\bapprovedR\brejectedR\x06failedR\x06statusB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"\xa8\x01\n" +
+	"\x1aListMergeApprovalsResponse\x12C\n" +
+	"\x0fmerge_approvals\x18\x01 \x03(\v2\x1a.employee.v1.MergeApprovalR\x0emergeApprovals\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"/\n" +
+	"\x13ApproveMergeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x94\x01\n" +
+	"\x14ApproveMergeResponse\x12A\n" +
+	"\x0emerge_approval\x18\x01 \x01(\v2\x1a.employee.v1.MergeApprovalR\rmergeApproval\x129\n" +
+	"\x05merge\x18\x02 \x01(\v2#.employee.v1.MergeEmployeesResponseR\x05merge\".\n" +
+	"\x12RejectMergeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"X\n" +
+	"\x13RejectMergeResponse\x12A\n" +
+	"\x0emerge_approval\x18\x01 \x01(\v2\x1a.employee.v1.MergeApprovalR\rmergeApproval\">\n" +
+	"\x17UnmergeEmployeesRequest\x12#\n" +
+	"\bmerge_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amergeId\"\x80\x01\n" +
+	"\x18UnmergeEmployeesResponse\x12/\n" +
+	"\aprimary\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\aprimary\x123\n" +
+	"\tsecondary\x18\x02 \x01(\v2\x15.employee.v1.EmployeeR\tsecondary\"5\n" +
+	"\x19ExportEmployeeDataRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x8d\x03\n" +
+	"\x16EmployeeDataAuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\x12\x1b\n" +
+	"\tclient_ip\x18\x05 \x01(\tR\bclientIp\x12-\n" +
+	"\x06before\x18\x06 \x01(\v2\x15.employee.v1.EmployeeR\x06before\x12+\n" +
+	"\x05after\x18\a \x01(\v2\x15.employee.v1.EmployeeR\x05after\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12'\n" +
+	"\x0fimpersonator_id\x18\t \x01(\tR\x0eimpersonatorId\x124\n" +
+	"\x16impersonator_tenant_id\x18\n" +
+	" \x01(\tR\x14impersonatorTenantId\"\x9c\x02\n" +
+	"\x11EmployeeDataEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x03 \x01(\tR\twebhookId\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fdelivered_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\"\xaf\x02\n" +
+	"\x1aExportEmployeeDataResponse\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x121\n" +
+	"\bemployee\x18\x02 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12H\n" +
+	"\raudit_entries\x18\x03 \x03(\v2#.employee.v1.EmployeeDataAuditEntryR\fauditEntries\x126\n" +
+	"\x06events\x18\x04 \x03(\v2\x1e.employee.v1.EmployeeDataEventR\x06events\x12;\n" +
+	"\vexported_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\"8\n" +
+	"\x1cResolveMergedEmployeeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x91\x01\n" +
+	"\rMergeRedirect\x12\x19\n" +
+	"\bmerge_id\x18\x01 \x01(\tR\amergeId\x12\x17\n" +
+	"\afrom_id\x18\x02 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x03 \x01(\tR\x04toId\x127\n" +
+	"\tmerged_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\"\x8c\x01\n" +
+	"\x1dResolveMergedEmployeeResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x128\n" +
+	"\tredirects\x18\x02 \x03(\v2\x1a.employee.v1.MergeRedirectR\tredirects\"x\n" +
+	"\x1eFindDuplicateCandidatesRequest\x124\n" +
+	"\tmin_score\x18\x01 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\bminScore\x12 \n" +
+	"\x05limit\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a(\x00R\x05limit\"\xaa\x01\n" +
+	"\x12DuplicateCandidate\x12/\n" +
+	"\aprimary\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\aprimary\x123\n" +
+	"\tsecondary\x18\x02 \x01(\v2\x15.employee.v1.EmployeeR\tsecondary\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x12\x18\n" +
+	"\areasons\x18\x04 \x03(\tR\areasons\"b\n" +
+	"\x1fFindDuplicateCandidatesResponse\x12?\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2\x1f.employee.v1.DuplicateCandidateR\n" +
+	"candidates\"P\n" +
+	"\x14DiffEmployeesRequest\x12\x1b\n" +
+	"\x04id_a\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x03idA\x12\x1b\n" +
+	"\x04id_b\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x03idB\"q\n" +
+	"\x11EmployeeFieldDiff\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x17\n" +
+	"\avalue_a\x18\x02 \x01(\tR\x06valueA\x12\x17\n" +
+	"\avalue_b\x18\x03 \x01(\tR\x06valueB\x12\x14\n" +
+	"\x05equal\x18\x04 \x01(\bR\x05equal\"Y\n" +
+	"\x11EmployeeEmailDiff\x12\x16\n" +
+	"\x06common\x18\x01 \x03(\tR\x06common\x12\x15\n" +
+	"\x06only_a\x18\x02 \x03(\tR\x05onlyA\x12\x15\n" +
+	"\x06only_b\x18\x03 \x03(\tR\x05onlyB\"\xda\x01\n" +
+	"\x16EmployeeHistorySummary\x12#\n" +
+	"\raudit_entries\x18\x01 \x01(\x03R\fauditEntries\x12\x1f\n" +
+	"\vlast_action\x18\x02 \x01(\tR\n" +
+	"lastAction\x12\"\n" +
+	"\rlast_actor_id\x18\x03 \x01(\tR\vlastActorId\x12B\n" +
+	"\x0flast_changed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastChangedAt\x12\x12\n" +
+	"\x04jobs\x18\x05 \x01(\x03R\x04jobs\"\xf7\x02\n" +
+	"\x15DiffEmployeesResponse\x124\n" +
+	"\n" +
+	"employee_a\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\temployeeA\x124\n" +
+	"\n" +
+	"employee_b\x18\x02 \x01(\v2\x15.employee.v1.EmployeeR\temployeeB\x126\n" +
+	"\x06fields\x18\x03 \x03(\v2\x1e.employee.v1.EmployeeFieldDiffR\x06fields\x126\n" +
+	"\x06emails\x18\x04 \x01(\v2\x1e.employee.v1.EmployeeEmailDiffR\x06emails\x12@\n" +
+	"\thistory_a\x18\x05 \x01(\v2#.employee.v1.EmployeeHistorySummaryR\bhistoryA\x12@\n" +
+	"\thistory_b\x18\x06 \x01(\v2#.employee.v1.EmployeeHistorySummaryR\bhistoryB\"Z\n" +
+	"\x15WatchEmployeesRequest\x120\n" +
+	"\fresume_token\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01H\x00R\vresumeToken\x88\x01\x01B\x0f\n" +
+	"\r_resume_token\"\x97\x02\n" +
+	"\x16WatchEmployeesResponse\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1f\n" +
+	"\vemployee_id\x18\x03 \x01(\tR\n" +
+	"employeeId\x121\n" +
+	"\bemployee\x18\x04 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x121\n" +
+	"\bprevious\x18\x05 \x01(\v2\x15.employee.v1.EmployeeR\bprevious\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x82\x01\n" +
+	"\x1bListPendingEmployeesRequest\x12!\n" +
+	"\x04page\x18\x01 \x01(\x05B\b\xbaH\x05\x1a\x03\x18\x90NH\x00R\x04page\x88\x01\x01\x12)\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dH\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"2\n" +
+	"\x16ApproveEmployeeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"L\n" +
+	"\x17ApproveEmployeeResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"1\n" +
+	"\x15RejectEmployeeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"2\n" +
+	"\x16RejectEmployeeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc2\x06\n" +
+	"\x0fScheduledChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12\x1f\n" +
+	"\vemployee_id\x18\x03 \x01(\tR\n" +
+	"employeeId\x12\x16\n" +
+	"\x06emails\x18\x04 \x03(\tR\x06emails\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x05 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x06 \x01(\tR\blastName\x12=\n" +
+	"\feffective_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"applied_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tappliedAt\x12(\n" +
+	"\rdepartment_id\x18\r \x01(\tH\x00R\fdepartmentId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"manager_id\x18\x0e \x01(\tH\x01R\tmanagerId\x88\x01\x01\x12\x14\n" +
+	"\x05title\x18\x0f \x01(\tR\x05title\x12=\n" +
+	"\rphone_numbers\x18\x10 \x03(\v2\x18.employee.v1.PhoneNumberR\fphoneNumbers\x124\n" +
+	"\aaddress\x18\x11 \x01(\v2\x1a.employee.v1.PostalAddressR\aaddress\x12P\n" +
+	"\fexternal_ids\x18\x12 \x03(\v2-.employee.v1.ScheduledChange.ExternalIdsEntryR\vexternalIds\x1a>\n" +
+	"\x10ExternalIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x10\n" +
+	"\x0e_department_idB\r\n" +
+	"\v_manager_id\"\x88\x02\n" +
+	"\x1bListScheduledChangesRequest\x12!\n" +
+	"\x04page\x18\x01 \x01(\x05B\b\xbaH\x05\x1a\x03\x18\x90NH\x00R\x04page\x88\x01\x01\x12)\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dH\x01R\bpageSize\x88\x01\x01\x12D\n" +
+	"\x06status\x18\x03 \x01(\tB,\xbaH)r'R\x00R\apendingR\aappliedR\x06failedR\tcancelledR\x06status\x12.\n" +
+	"\vemployee_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x02R\n" +
+	"employeeId\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\x0e\n" +
+	"\f_employee_id\"\xb0\x01\n" +
+	"\x1cListScheduledChangesResponse\x12I\n" +
+	"\x11scheduled_changes\x18\x01 \x03(\v2\x1c.employee.v1.ScheduledChangeR\x10scheduledChanges\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"8\n" +
+	"\x1cCancelScheduledChangeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"h\n" +
+	"\x1dCancelScheduledChangeResponse\x12G\n" +
+	"\x10scheduled_change\x18\x01 \x01(\v2\x1c.employee.v1.ScheduledChangeR\x0fscheduledChange\"\xa8\x01\n" +
+	"\x18ListDirectReportsRequest\x12'\n" +
+	"\n" +
+	"manager_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tmanagerId\x12!\n" +
+	"\x04page\x18\x02 \x01(\x05B\b\xbaH\x05\x1a\x03\x18\x90NH\x00R\x04page\x88\x01\x01\x12)\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dH\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"F\n" +
+	"\x19GetManagementChainRequest\x12)\n" +
+	"\vemployee_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"employeeId\"O\n" +
+	"\x1aGetManagementChainResponse\x121\n" +
+	"\bmanagers\x18\x01 \x03(\v2\x15.employee.v1.EmployeeR\bmanagers\"\xae\x01\n" +
+	"\x1cListEmploymentHistoryRequest\x12)\n" +
+	"\vemployee_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"employeeId\x12!\n" +
+	"\x04page\x18\x02 \x01(\x05B\b\xbaH\x05\x1a\x03\x18\x90NH\x00R\x04page\x88\x01\x01\x12)\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dH\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"\xa5\x02\n" +
+	"\x16EmploymentHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12#\n" +
+	"\rdepartment_id\x18\x04 \x01(\tR\fdepartmentId\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x05 \x01(\tR\tmanagerId\x12A\n" +
+	"\x0eeffective_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12=\n" +
+	"\feffective_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveTo\"\xa5\x01\n" +
+	"\x1dListEmploymentHistoryResponse\x12=\n" +
+	"\aentries\x18\x01 \x03(\v2#.employee.v1.EmploymentHistoryEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"c\n" +
+	"\rAddTagRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x128\n" +
+	"\x03tag\x18\x02 \x01(\tB&\xbaH#r!\x10\x01\x1822\x1b^[a-zA-Z0-9][a-zA-Z0-9_-]*$R\x03tag\"Y\n" +
+	"\x0eAddTagResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x14\n" +
+	"\x05added\x18\x02 \x01(\bR\x05added\"I\n" +
+	"\x10RemoveTagRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1b\n" +
+	"\x03tag\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x03tag\"`\n" +
+	"\x11RemoveTagResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x18\n" +
+	"\aremoved\x18\x02 \x01(\bR\aremoved\"X\n" +
+	"\x18AddSecondaryEmailRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\"\n" +
+	"\x05email\x18\x02 \x01(\tB\f\xbaH\tr\a\x10\x03\x18\xff\x01`\x01R\x05email\"d\n" +
+	"\x19AddSecondaryEmailResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x14\n" +
+	"\x05added\x18\x02 \x01(\bR\x05added\"Y\n" +
+	"\x1bRemoveSecondaryEmailRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12 \n" +
+	"\x05email\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x03\x18\xff\x01R\x05email\"k\n" +
+	"\x1cRemoveSecondaryEmailResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x18\n" +
+	"\aremoved\x18\x02 \x01(\bR\aremoved\"V\n" +
+	"\x16SetPrimaryEmailRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\"\n" +
+	"\x05email\x18\x02 \x01(\tB\f\xbaH\tr\a\x10\x03\x18\xff\x01`\x01R\x05email\"f\n" +
+	"\x17SetPrimaryEmailResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x18\n" +
+	"\achanged\x18\x02 \x01(\bR\achanged\"Z\n" +
+	"\x1aUploadEmployeePhotoRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\"\n" +
+	"\x05photo\x18\x02 \x01(\fB\f\xbaH\tz\a\x10\x01\x18\x80\x80\xc0\x02R\x05photo\"P\n" +
+	"\x1bUploadEmployeePhotoResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"6\n" +
+	"\x1aGetEmployeePhotoURLRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"j\n" +
+	"\x1bGetEmployeePhotoURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xa2\x02\n" +
+	"\x18ListEmployeesAsOfRequest\x127\n" +
+	"\x05as_of\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x04asOf\x12!\n" +
+	"\x04page\x18\x02 \x01(\x05B\b\xbaH\x05\x1a\x03\x18\x90NH\x00R\x04page\x88\x01\x01\x12)\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dH\x01R\bpageSize\x88\x01\x01\x122\n" +
+	"\rdepartment_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x02R\fdepartmentId\x88\x01\x01\x12\"\n" +
+	"\x04tags\x18\x05 \x03(\tB\x0e\xbaH\v\x92\x01\b\x10\n" +
+	"\"\x04r\x02\x182R\x04tagsB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\x10\n" +
+	"\x0e_department_id\"\x97\x01\n" +
+	"\x19ListEmployeesAsOfResponse\x123\n" +
+	"\temployees\x18\x01 \x03(\v2\x15.employee.v1.EmployeeR\temployees\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"5\n" +
+	"\x19DeactivateEmployeeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"i\n" +
+	"\x1aDeactivateEmployeeResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x18\n" +
+	"\achanged\x18\x02 \x01(\bR\achanged\"5\n" +
+	"\x19ReactivateEmployeeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"i\n" +
+	"\x1aReactivateEmployeeResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12\x18\n" +
+	"\achanged\x18\x02 \x01(\bR\achanged\"\xa6\x01\n" +
+	"\vEmailBounce\x12 \n" +
+	"\x05email\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05email\x120\n" +
+	"\x06reason\x18\x02 \x01(\tB\x18\xbaH\x15r\x13R\x06bounceR\tcomplaintR\x06reason\x12C\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"occurredAt\"\\\n" +
+	"\x19ReportEmailBouncesRequest\x12?\n" +
+	"\abounces\x18\x01 \x03(\v2\x18.employee.v1.EmailBounceB\v\xbaH\b\x92\x01\x05\b\x01\x10\xf4\x03R\abounces\"A\n" +
+	"\x1aReportEmailBouncesResponse\x12#\n" +
+	"\rmarked_emails\x18\x01 \x03(\tR\fmarkedEmails\"\xca\x01\n" +
+	"\x17ValidateEmployeeRequest\x12F\n" +
+	"\bemployee\x18\x01 \x01(\v2\".employee.v1.CreateEmployeeRequestB\x06\xbaH\x03\xd8\x01\x03R\bemployee\x12g\n" +
+	"\x02id\x18\x02 \x01(\tBW\xbaHTrR2P^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})?$R\x02id\"U\n" +
+	"\x0fValidationIssue\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa8\x01\n" +
+	"\x18ValidateEmployeeResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12<\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2\x1c.employee.v1.ValidationIssueR\n" +
+	"violations\x128\n" +
+	"\bwarnings\x18\x03 \x03(\v2\x1c.employee.v1.ValidationIssueR\bwarnings2\xae2\n" +
+	"\x0fEmployeeService\x12\x8a\x01\n" +
+	"\x0eCreateEmployee\x12\".employee.v1.CreateEmployeeRequest\x1a#.employee.v1.CreateEmployeeResponse\"/\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/employees\x12\xbe\x01\n" +
+	"\x1dCreateOrUpdateEmployeeByEmail\x121.employee.v1.CreateOrUpdateEmployeeByEmailRequest\x1a2.employee.v1.CreateOrUpdateEmployeeByEmailResponse\"6\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/employees:upsert\x12\x8f\x01\n" +
+	"\x0eUpdateEmployee\x12\".employee.v1.UpdateEmployeeRequest\x1a#.employee.v1.UpdateEmployeeResponse\"4\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/v1/employees/{id}\x12\x8c\x01\n" +
+	"\x0eDeleteEmployee\x12\".employee.v1.DeleteEmployeeRequest\x1a#.employee.v1.DeleteEmployeeResponse\"1\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/employees/{id}\x12\x83\x01\n" +
+	"\rListEmployees\x12!.employee.v1.ListEmployeesRequest\x1a\".employee.v1.ListEmployeesResponse\"+\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/employees\x12\x8c\x01\n" +
+	"\x0eCountEmployees\x12\".employee.v1.CountEmployeesRequest\x1a#.employee.v1.CountEmployeesResponse\"1\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/employees:count\x12\x82\x01\n" +
+	"\vGetEmployee\x12\x1f.employee.v1.GetEmployeeRequest\x1a .employee.v1.GetEmployeeResponse\"0\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/employees/{id}\x12\x9a\x01\n" +
+	"\x12GetEmployeeByEmail\x12&.employee.v1.GetEmployeeByEmailRequest\x1a'.employee.v1.GetEmployeeByEmailResponse\"3\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/employees:byEmail\x12\xae\x01\n" +
+	"\x17GetEmployeeByExternalID\x12+.employee.v1.GetEmployeeByExternalIDRequest\x1a,.employee.v1.GetEmployeeByExternalIDResponse\"8\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/employees:byExternalId\x12\x9c\x01\n" +
+	"\x12ExportEmployeeData\x12&.employee.v1.ExportEmployeeDataRequest\x1a'.employee.v1.ExportEmployeeDataResponse\"5\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/employees/{id}/data\x12\x8d\x01\n" +
+	"\x0eEmployeeExists\x12\".employee.v1.EmployeeExistsRequest\x1a#.employee.v1.EmployeeExistsResponse\"2\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/employees:exists\x12\x90\x01\n" +
+	"\x0eMergeEmployees\x12\".employee.v1.MergeEmployeesRequest\x1a#.employee.v1.MergeEmployeesResponse\"5\x8a\xb5\x18\x0femployees:merge\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/employees/merge\x12\x9d\x01\n" +
+	"\x12MergeEmployeesById\x12&.employee.v1.MergeEmployeesByIdRequest\x1a#.employee.v1.MergeEmployeesResponse\":\x8a\xb5\x18\x0femployees:merge\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/employees/merge:byId\x12\x98\x01\n" +
+	"\x10UnmergeEmployees\x12$.employee.v1.UnmergeEmployeesRequest\x1a%.employee.v1.UnmergeEmployeesResponse\"7\x8a\xb5\x18\x0femployees:merge\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/employees/unmerge\x12\x99\x01\n" +
+	"\x12ListMergeApprovals\x12&.employee.v1.ListMergeApprovalsRequest\x1a'.employee.v1.ListMergeApprovalsResponse\"2\x8a\xb5\x18\x0femployees:merge\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/merge-approvals\x12\x97\x01\n" +
+	"\fApproveMerge\x12 .employee.v1.ApproveMergeRequest\x1a!.employee.v1.ApproveMergeResponse\"B\x8a\xb5\x18\x0femployees:merge\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/merge-approvals/{id}:approve\x12\x93\x01\n" +
+	"\vRejectMerge\x12\x1f.employee.v1.RejectMergeRequest\x1a .employee.v1.RejectMergeResponse\"A\x8a\xb5\x18\x0femployees:merge\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/merge-approvals/{id}:reject\x12\xa8\x01\n" +
+	"\x15ResolveMergedEmployee\x12).employee.v1.ResolveMergedEmployeeRequest\x1a*.employee.v1.ResolveMergedEmployeeResponse\"8\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/employees/{id}/resolve\x12\xac\x01\n" +
+	"\x17FindDuplicateCandidates\x12+.employee.v1.FindDuplicateCandidatesRequest\x1a,.employee.v1.FindDuplicateCandidatesResponse\"6\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/employees/duplicates\x12\x88\x01\n" +
+	"\rDiffEmployees\x12!.employee.v1.DiffEmployeesRequest\x1a\".employee.v1.DiffEmployeesResponse\"0\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/employees:diff\x12o\n" +
+	"\x0eWatchEmployees\x12\".employee.v1.WatchEmployeesRequest\x1a#.employee.v1.WatchEmployeesResponse\"\x12\x8a\xb5\x18\x0eemployees:read0\x01\x12\x99\x01\n" +
+	"\x14ListPendingEmployees\x12(.employee.v1.ListPendingEmployeesRequest\x1a\".employee.v1.ListEmployeesResponse\"3\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/employees/pending\x12\x9a\x01\n" +
+	"\x0fApproveEmployee\x12#.employee.v1.ApproveEmployeeRequest\x1a$.employee.v1.ApproveEmployeeResponse\"<\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/employees/{id}:approve\x12\x96\x01\n" +
+	"\x0eRejectEmployee\x12\".employee.v1.RejectEmployeeRequest\x1a#.employee.v1.RejectEmployeeResponse\";\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/employees/{id}:reject\x12\xa0\x01\n" +
+	"\x14ListScheduledChanges\x12(.employee.v1.ListScheduledChangesRequest\x1a).employee.v1.ListScheduledChangesResponse\"3\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/scheduled-changes\x12\xb3\x01\n" +
+	"\x15CancelScheduledChange\x12).employee.v1.CancelScheduledChangeRequest\x1a*.employee.v1.CancelScheduledChangeResponse\"C\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/scheduled-changes/{id}:cancel\x12\xa0\x01\n" +
+	"\x11ListDirectReports\x12%.employee.v1.ListDirectReportsRequest\x1a\".employee.v1.ListEmployeesResponse\"@\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02(\x12&/api/v1/employees/{manager_id}/reports\x12\xa9\x01\n" +
+	"\x12GetManagementChain\x12&.employee.v1.GetManagementChainRequest\x1a'.employee.v1.GetManagementChainResponse\"B\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02*\x12(/api/v1/employees/{employee_id}/managers\x12\xb1\x01\n" +
+	"\x15ListEmploymentHistory\x12).employee.v1.ListEmploymentHistoryRequest\x1a*.employee.v1.ListEmploymentHistoryResponse\"A\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02)\x12'/api/v1/employees/{employee_id}/history\x12|\n" +
+	"\x06AddTag\x12\x1a.employee.v1.AddTagRequest\x1a\x1b.employee.v1.AddTagResponse\"9\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/employees/{id}/tags\x12\x88\x01\n" +
+	"\tRemoveTag\x12\x1d.employee.v1.RemoveTagRequest\x1a\x1e.employee.v1.RemoveTagResponse\"<\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02#*!/api/v1/employees/{id}/tags/{tag}\x12\x9f\x01\n" +
+	"\x11AddSecondaryEmail\x12%.employee.v1.AddSecondaryEmailRequest\x1a&.employee.v1.AddSecondaryEmailResponse\";\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/employees/{id}/emails\x12\xad\x01\n" +
+	"\x14RemoveSecondaryEmail\x12(.employee.v1.RemoveSecondaryEmailRequest\x1a).employee.v1.RemoveSecondaryEmailResponse\"@\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02'*%/api/v1/employees/{id}/emails/{email}\x12\xa0\x01\n" +
+	"\x0fSetPrimaryEmail\x12#.employee.v1.SetPrimaryEmailRequest\x1a$.employee.v1.SetPrimaryEmailResponse\"B\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/employees/{id}/primary-email\x12\x94\x01\n" +
+	"\x11ListEmployeesAsOf\x12%.employee.v1.ListEmployeesAsOfRequest\x1a&.employee.v1.ListEmployeesAsOfResponse\"0\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/employees:asOf\x12\xa4\x01\n" +
+	"\x13UploadEmployeePhoto\x12'.employee.v1.UploadEmployeePhotoRequest\x1a(.employee.v1.UploadEmployeePhotoResponse\":\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/employees/{id}/photo\x12\xa4\x01\n" +
+	"\x13GetEmployeePhotoURL\x12'.employee.v1.GetEmployeePhotoURLRequest\x1a(.employee.v1.GetEmployeePhotoURLResponse\":\x8a\xb5\x18\x0eemployees:read\x82\xd3\xe4\x93\x02\"\x12 /api/v1/employees/{id}/photo:url\x12\xa6\x01\n" +
+	"\x12DeactivateEmployee\x12&.employee.v1.DeactivateEmployeeRequest\x1a'.employee.v1.DeactivateEmployeeResponse\"?\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/employees/{id}:deactivate\x12\xa6\x01\n" +
+	"\x12ReactivateEmployee\x12&.employee.v1.ReactivateEmployeeRequest\x1a'.employee.v1.ReactivateEmployeeResponse\"?\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/employees/{id}:reactivate\x12\xa5\x01\n" +
+	"\x12ReportEmailBounces\x12&.employee.v1.ReportEmailBouncesRequest\x1a'.employee.v1.ReportEmailBouncesResponse\">\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/employees:report-bounces\x12\x99\x01\n" +
+	"\x10ValidateEmployee\x12$.employee.v1.ValidateEmployeeRequest\x1a%.employee.v1.ValidateEmployeeResponse\"8\x8a\xb5\x18\x0femployees:write\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/employees:validateBT\n" +
 	"\x1adev.kratos.api.employee.v1B\x0fEmployeeProtoV1P\x01Z#employee-service/api/employee/v1;v1b\x06proto3"
 
 var (
@@ -918,55 +7001,298 @@ func file_employee_v1_employee_proto_rawDescGZIP() []byte {
 	return file_employee_v1_employee_proto_rawDescData
 }
 
-var file_employee_v1_employee_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_employee_v1_employee_proto_msgTypes = make([]protoimpl.MessageInfo, 100)
 var file_employee_v1_employee_proto_goTypes = []any{
-	(*Employee)(nil),                   // 0: employee.v1.Employee
-	(*CreateEmployeeRequest)(nil),      // 1: employee.v1.CreateEmployeeRequest
-	(*CreateEmployeeResponse)(nil),     // 2: employee.v1.CreateEmployeeResponse
-	(*UpdateEmployeeRequest)(nil),      // 3: employee.v1.UpdateEmployeeRequest
-	(*UpdateEmployeeResponse)(nil),     // 4: employee.v1.UpdateEmployeeResponse
-	(*DeleteEmployeeRequest)(nil),      // 5: employee.v1.DeleteEmployeeRequest
-	(*DeleteEmployeeResponse)(nil),     // 6: employee.v1.DeleteEmployeeResponse
-	(*GetEmployeeRequest)(nil),         // 7: employee.v1.GetEmployeeRequest
-	(*GetEmployeeResponse)(nil),        // 8: employee.v1.GetEmployeeResponse
-	(*GetEmployeeByEmailRequest)(nil),  // 9: employee.v1.GetEmployeeByEmailRequest
-	(*GetEmployeeByEmailResponse)(nil), // 10: employee.v1.GetEmployeeByEmailResponse
-	(*ListEmployeesRequest)(nil),       // 11: employee.v1.ListEmployeesRequest
-	(*ListEmployeesResponse)(nil),      // 12: employee.v1.ListEmployeesResponse
-	(*MergeEmployeesRequest)(nil),      // 13: employee.v1.MergeEmployeesRequest
-	(*MergeEmployeesResponse)(nil),     // 14: employee.v1.MergeEmployeesResponse
-	(*timestamppb.Timestamp)(nil),      // 15: google.protobuf.Timestamp
+	(*Employee)(nil),                              // 0: employee.v1.Employee
+	(*UndeliverableEmail)(nil),                    // 1: employee.v1.UndeliverableEmail
+	(*PostalAddress)(nil),                         // 2: employee.v1.PostalAddress
+	(*PhoneNumber)(nil),                           // 3: employee.v1.PhoneNumber
+	(*CreateEmployeeRequest)(nil),                 // 4: employee.v1.CreateEmployeeRequest
+	(*CreateEmployeeResponse)(nil),                // 5: employee.v1.CreateEmployeeResponse
+	(*CreateOrUpdateEmployeeByEmailRequest)(nil),  // 6: employee.v1.CreateOrUpdateEmployeeByEmailRequest
+	(*CreateOrUpdateEmployeeByEmailResponse)(nil), // 7: employee.v1.CreateOrUpdateEmployeeByEmailResponse
+	(*UpdateEmployeeRequest)(nil),                 // 8: employee.v1.UpdateEmployeeRequest
+	(*UpdateEmployeeResponse)(nil),                // 9: employee.v1.UpdateEmployeeResponse
+	(*DeleteEmployeeRequest)(nil),                 // 10: employee.v1.DeleteEmployeeRequest
+	(*DeleteEmployeeResponse)(nil),                // 11: employee.v1.DeleteEmployeeResponse
+	(*GetEmployeeRequest)(nil),                    // 12: employee.v1.GetEmployeeRequest
+	(*GetEmployeeResponse)(nil),                   // 13: employee.v1.GetEmployeeResponse
+	(*GetEmployeeByEmailRequest)(nil),             // 14: employee.v1.GetEmployeeByEmailRequest
+	(*GetEmployeeByEmailResponse)(nil),            // 15: employee.v1.GetEmployeeByEmailResponse
+	(*GetEmployeeByExternalIDRequest)(nil),        // 16: employee.v1.GetEmployeeByExternalIDRequest
+	(*GetEmployeeByExternalIDResponse)(nil),       // 17: employee.v1.GetEmployeeByExternalIDResponse
+	(*EmployeeExistsRequest)(nil),                 // 18: employee.v1.EmployeeExistsRequest
+	(*EmployeeExistsResponse)(nil),                // 19: employee.v1.EmployeeExistsResponse
+	(*ListEmployeesRequest)(nil),                  // 20: employee.v1.ListEmployeesRequest
+	(*ListEmployeesResponse)(nil),                 // 21: employee.v1.ListEmployeesResponse
+	(*CountEmployeesRequest)(nil),                 // 22: employee.v1.CountEmployeesRequest
+	(*CountEmployeesResponse)(nil),                // 23: employee.v1.CountEmployeesResponse
+	(*ExportEmployeesRequest)(nil),                // 24: employee.v1.ExportEmployeesRequest
+	(*MergeEmployeesRequest)(nil),                 // 25: employee.v1.MergeEmployeesRequest
+	(*MergeEmployeesResponse)(nil),                // 26: employee.v1.MergeEmployeesResponse
+	(*MergeEmployeesByIdRequest)(nil),             // 27: employee.v1.MergeEmployeesByIdRequest
+	(*MergeApproval)(nil),                         // 28: employee.v1.MergeApproval
+	(*ListMergeApprovalsRequest)(nil),             // 29: employee.v1.ListMergeApprovalsRequest
+	(*ListMergeApprovalsResponse)(nil),            // 30: employee.v1.ListMergeApprovalsResponse
+	(*ApproveMergeRequest)(nil),                   // 31: employee.v1.ApproveMergeRequest
+	(*ApproveMergeResponse)(nil),                  // 32: employee.v1.ApproveMergeResponse
+	(*RejectMergeRequest)(nil),                    // 33: employee.v1.RejectMergeRequest
+	(*RejectMergeResponse)(nil),                   // 34: employee.v1.RejectMergeResponse
+	(*UnmergeEmployeesRequest)(nil),               // 35: employee.v1.UnmergeEmployeesRequest
+	(*UnmergeEmployeesResponse)(nil),              // 36: employee.v1.UnmergeEmployeesResponse
+	(*ExportEmployeeDataRequest)(nil),             // 37: employee.v1.ExportEmployeeDataRequest
+	(*EmployeeDataAuditEntry)(nil),                // 38: employee.v1.EmployeeDataAuditEntry
+	(*EmployeeDataEvent)(nil),                     // 39: employee.v1.EmployeeDataEvent
+	(*ExportEmployeeDataResponse)(nil),            // 40: employee.v1.ExportEmployeeDataResponse
+	(*ResolveMergedEmployeeRequest)(nil),          // 41: employee.v1.ResolveMergedEmployeeRequest
+	(*MergeRedirect)(nil),                         // 42: employee.v1.MergeRedirect
+	(*ResolveMergedEmployeeResponse)(nil),         // 43: employee.v1.ResolveMergedEmployeeResponse
+	(*FindDuplicateCandidatesRequest)(nil),        // 44: employee.v1.FindDuplicateCandidatesRequest
+	(*DuplicateCandidate)(nil),                    // 45: employee.v1.DuplicateCandidate
+	(*FindDuplicateCandidatesResponse)(nil),       // 46: employee.v1.FindDuplicateCandidatesResponse
+	(*DiffEmployeesRequest)(nil),                  // 47: employee.v1.DiffEmployeesRequest
+	(*EmployeeFieldDiff)(nil),                     // 48: employee.v1.EmployeeFieldDiff
+	(*EmployeeEmailDiff)(nil),                     // 49: employee.v1.EmployeeEmailDiff
+	(*EmployeeHistorySummary)(nil),                // 50: employee.v1.EmployeeHistorySummary
+	(*DiffEmployeesResponse)(nil),                 // 51: employee.v1.DiffEmployeesResponse
+	(*WatchEmployeesRequest)(nil),                 // 52: employee.v1.WatchEmployeesRequest
+	(*WatchEmployeesResponse)(nil),                // 53: employee.v1.WatchEmployeesResponse
+	(*ListPendingEmployeesRequest)(nil),           // 54: employee.v1.ListPendingEmployeesRequest
+	(*ApproveEmployeeRequest)(nil),                // 55: employee.v1.ApproveEmployeeRequest
+	(*ApproveEmployeeResponse)(nil),               // 56: employee.v1.ApproveEmployeeResponse
+	(*RejectEmployeeRequest)(nil),                 // 57: employee.v1.RejectEmployeeRequest
+	(*RejectEmployeeResponse)(nil),                // 58: employee.v1.RejectEmployeeResponse
+	(*ScheduledChange)(nil),                       // 59: employee.v1.ScheduledChange
+	(*ListScheduledChangesRequest)(nil),           // 60: employee.v1.ListScheduledChangesRequest
+	(*ListScheduledChangesResponse)(nil),          // 61: employee.v1.ListScheduledChangesResponse
+	(*CancelScheduledChangeRequest)(nil),          // 62: employee.v1.CancelScheduledChangeRequest
+	(*CancelScheduledChangeResponse)(nil),         // 63: employee.v1.CancelScheduledChangeResponse
+	(*ListDirectReportsRequest)(nil),              // 64: employee.v1.ListDirectReportsRequest
+	(*GetManagementChainRequest)(nil),             // 65: employee.v1.GetManagementChainRequest
+	(*GetManagementChainResponse)(nil),            // 66: employee.v1.GetManagementChainResponse
+	(*ListEmploymentHistoryRequest)(nil),          // 67: employee.v1.ListEmploymentHistoryRequest
+	(*EmploymentHistoryEntry)(nil),                // 68: employee.v1.EmploymentHistoryEntry
+	(*ListEmploymentHistoryResponse)(nil),         // 69: employee.v1.ListEmploymentHistoryResponse
+	(*AddTagRequest)(nil),                         // 70: employee.v1.AddTagRequest
+	(*AddTagResponse)(nil),                        // 71: employee.v1.AddTagResponse
+	(*RemoveTagRequest)(nil),                      // 72: employee.v1.RemoveTagRequest
+	(*RemoveTagResponse)(nil),                     // 73: employee.v1.RemoveTagResponse
+	(*AddSecondaryEmailRequest)(nil),              // 74: employee.v1.AddSecondaryEmailRequest
+	(*AddSecondaryEmailResponse)(nil),             // 75: employee.v1.AddSecondaryEmailResponse
+	(*RemoveSecondaryEmailRequest)(nil),           // 76: employee.v1.RemoveSecondaryEmailRequest
+	(*RemoveSecondaryEmailResponse)(nil),          // 77: employee.v1.RemoveSecondaryEmailResponse
+	(*SetPrimaryEmailRequest)(nil),                // 78: employee.v1.SetPrimaryEmailRequest
+	(*SetPrimaryEmailResponse)(nil),               // 79: employee.v1.SetPrimaryEmailResponse
+	(*UploadEmployeePhotoRequest)(nil),            // 80: employee.v1.UploadEmployeePhotoRequest
+	(*UploadEmployeePhotoResponse)(nil),           // 81: employee.v1.UploadEmployeePhotoResponse
+	(*GetEmployeePhotoURLRequest)(nil),            // 82: employee.v1.GetEmployeePhotoURLRequest
+	(*GetEmployeePhotoURLResponse)(nil),           // 83: employee.v1.GetEmployeePhotoURLResponse
+	(*ListEmployeesAsOfRequest)(nil),              // 84: employee.v1.ListEmployeesAsOfRequest
+	(*ListEmployeesAsOfResponse)(nil),             // 85: employee.v1.ListEmployeesAsOfResponse
+	(*DeactivateEmployeeRequest)(nil),             // 86: employee.v1.DeactivateEmployeeRequest
+	(*DeactivateEmployeeResponse)(nil),            // 87: employee.v1.DeactivateEmployeeResponse
+	(*ReactivateEmployeeRequest)(nil),             // 88: employee.v1.ReactivateEmployeeRequest
+	(*ReactivateEmployeeResponse)(nil),            // 89: employee.v1.ReactivateEmployeeResponse
+	(*EmailBounce)(nil),                           // 90: employee.v1.EmailBounce
+	(*ReportEmailBouncesRequest)(nil),             // 91: employee.v1.ReportEmailBouncesRequest
+	(*ReportEmailBouncesResponse)(nil),            // 92: employee.v1.ReportEmailBouncesResponse
+	(*ValidateEmployeeRequest)(nil),               // 93: employee.v1.ValidateEmployeeRequest
+	(*ValidationIssue)(nil),                       // 94: employee.v1.ValidationIssue
+	(*ValidateEmployeeResponse)(nil),              // 95: employee.v1.ValidateEmployeeResponse
+	nil,                                           // 96: employee.v1.Employee.ExternalIdsEntry
+	nil,                                           // 97: employee.v1.CreateEmployeeRequest.ExternalIdsEntry
+	nil,                                           // 98: employee.v1.UpdateEmployeeRequest.ExternalIdsEntry
+	nil,                                           // 99: employee.v1.ScheduledChange.ExternalIdsEntry
+	(*timestamppb.Timestamp)(nil),                 // 100: google.protobuf.Timestamp
 }
 var file_employee_v1_employee_proto_depIdxs = []int32{
-	15, // 0: employee.v1.Employee.created_at:type_name -> google.protobuf.Timestamp
-	15, // 1: employee.v1.Employee.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: employee.v1.CreateEmployeeResponse.employee:type_name -> employee.v1.Employee
-	0,  // 3: employee.v1.UpdateEmployeeResponse.employee:type_name -> employee.v1.Employee
-	0,  // 4: employee.v1.GetEmployeeResponse.employee:type_name -> employee.v1.Employee
-	0,  // 5: employee.v1.GetEmployeeByEmailResponse.employee:type_name -> employee.v1.Employee
-	15, // 6: employee.v1.ListEmployeesRequest.created_after:type_name -> google.protobuf.Timestamp
-	15, // 7: employee.v1.ListEmployeesRequest.created_before:type_name -> google.protobuf.Timestamp
-	0,  // 8: employee.v1.ListEmployeesResponse.employees:type_name -> employee.v1.Employee
-	0,  // 9: employee.v1.MergeEmployeesResponse.employee:type_name -> employee.v1.Employee
-	1,  // 10: employee.v1.EmployeeService.CreateEmployee:input_type -> employee.v1.CreateEmployeeRequest
-	3,  // 11: employee.v1.EmployeeService.UpdateEmployee:input_type -> employee.v1.UpdateEmployeeRequest
-	5,  // 12: employee.v1.EmployeeService.DeleteEmployee:input_type -> employee.v1.DeleteEmployeeRequest
-	11, // 13: employee.v1.EmployeeService.ListEmployees:input_type -> employee.v1.ListEmployeesRequest
-	7,  // 14: employee.v1.EmployeeService.GetEmployee:input_type -> employee.v1.GetEmployeeRequest
-	9,  // 15: employee.v1.EmployeeService.GetEmployeeByEmail:input_type -> employee.v1.GetEmployeeByEmailRequest
-	13, // 16: employee.v1.EmployeeService.MergeEmployees:input_type -> employee.v1.MergeEmployeesRequest
-	2,  // 17: employee.v1.EmployeeService.CreateEmployee:output_type -> employee.v1.CreateEmployeeResponse
-	4,  // 18: employee.v1.EmployeeService.UpdateEmployee:output_type -> employee.v1.UpdateEmployeeResponse
-	6,  // 19: employee.v1.EmployeeService.DeleteEmployee:output_type -> employee.v1.DeleteEmployeeResponse
-	12, // 20: employee.v1.EmployeeService.ListEmployees:output_type -> employee.v1.ListEmployeesResponse
-	8,  // 21: employee.v1.EmployeeService.GetEmployee:output_type -> employee.v1.GetEmployeeResponse
-	10, // 22: employee.v1.EmployeeService.GetEmployeeByEmail:output_type -> employee.v1.GetEmployeeByEmailResponse
-	14, // 23: employee.v1.EmployeeService.MergeEmployees:output_type -> employee.v1.MergeEmployeesResponse
-	17, // [17:24] is the sub-list for method output_type
-	10, // [10:17] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	100, // 0: employee.v1.Employee.created_at:type_name -> google.protobuf.Timestamp
+	100, // 1: employee.v1.Employee.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 2: employee.v1.Employee.phone_numbers:type_name -> employee.v1.PhoneNumber
+	2,   // 3: employee.v1.Employee.address:type_name -> employee.v1.PostalAddress
+	96,  // 4: employee.v1.Employee.external_ids:type_name -> employee.v1.Employee.ExternalIdsEntry
+	100, // 5: employee.v1.Employee.deactivated_at:type_name -> google.protobuf.Timestamp
+	100, // 6: employee.v1.Employee.stale_since:type_name -> google.protobuf.Timestamp
+	1,   // 7: employee.v1.Employee.undeliverable_emails:type_name -> employee.v1.UndeliverableEmail
+	100, // 8: employee.v1.UndeliverableEmail.since:type_name -> google.protobuf.Timestamp
+	100, // 9: employee.v1.CreateEmployeeRequest.effective_at:type_name -> google.protobuf.Timestamp
+	3,   // 10: employee.v1.CreateEmployeeRequest.phone_numbers:type_name -> employee.v1.PhoneNumber
+	2,   // 11: employee.v1.CreateEmployeeRequest.address:type_name -> employee.v1.PostalAddress
+	97,  // 12: employee.v1.CreateEmployeeRequest.external_ids:type_name -> employee.v1.CreateEmployeeRequest.ExternalIdsEntry
+	0,   // 13: employee.v1.CreateEmployeeResponse.employee:type_name -> employee.v1.Employee
+	59,  // 14: employee.v1.CreateEmployeeResponse.scheduled_change:type_name -> employee.v1.ScheduledChange
+	0,   // 15: employee.v1.CreateOrUpdateEmployeeByEmailResponse.employee:type_name -> employee.v1.Employee
+	100, // 16: employee.v1.UpdateEmployeeRequest.effective_at:type_name -> google.protobuf.Timestamp
+	3,   // 17: employee.v1.UpdateEmployeeRequest.phone_numbers:type_name -> employee.v1.PhoneNumber
+	2,   // 18: employee.v1.UpdateEmployeeRequest.address:type_name -> employee.v1.PostalAddress
+	98,  // 19: employee.v1.UpdateEmployeeRequest.external_ids:type_name -> employee.v1.UpdateEmployeeRequest.ExternalIdsEntry
+	0,   // 20: employee.v1.UpdateEmployeeResponse.employee:type_name -> employee.v1.Employee
+	59,  // 21: employee.v1.UpdateEmployeeResponse.scheduled_change:type_name -> employee.v1.ScheduledChange
+	0,   // 22: employee.v1.GetEmployeeResponse.employee:type_name -> employee.v1.Employee
+	0,   // 23: employee.v1.GetEmployeeByEmailResponse.employee:type_name -> employee.v1.Employee
+	0,   // 24: employee.v1.GetEmployeeByExternalIDResponse.employee:type_name -> employee.v1.Employee
+	100, // 25: employee.v1.ListEmployeesRequest.created_after:type_name -> google.protobuf.Timestamp
+	100, // 26: employee.v1.ListEmployeesRequest.created_before:type_name -> google.protobuf.Timestamp
+	100, // 27: employee.v1.ListEmployeesRequest.updated_since:type_name -> google.protobuf.Timestamp
+	0,   // 28: employee.v1.ListEmployeesResponse.employees:type_name -> employee.v1.Employee
+	100, // 29: employee.v1.CountEmployeesRequest.created_after:type_name -> google.protobuf.Timestamp
+	100, // 30: employee.v1.CountEmployeesRequest.created_before:type_name -> google.protobuf.Timestamp
+	100, // 31: employee.v1.CountEmployeesRequest.updated_since:type_name -> google.protobuf.Timestamp
+	100, // 32: employee.v1.ExportEmployeesRequest.created_after:type_name -> google.protobuf.Timestamp
+	100, // 33: employee.v1.ExportEmployeesRequest.created_before:type_name -> google.protobuf.Timestamp
+	100, // 34: employee.v1.ExportEmployeesRequest.updated_since:type_name -> google.protobuf.Timestamp
+	0,   // 35: employee.v1.MergeEmployeesResponse.employee:type_name -> employee.v1.Employee
+	0,   // 36: employee.v1.MergeEmployeesResponse.secondary:type_name -> employee.v1.Employee
+	28,  // 37: employee.v1.MergeEmployeesResponse.pending_approval:type_name -> employee.v1.MergeApproval
+	100, // 38: employee.v1.MergeApproval.created_at:type_name -> google.protobuf.Timestamp
+	100, // 39: employee.v1.MergeApproval.decided_at:type_name -> google.protobuf.Timestamp
+	28,  // 40: employee.v1.ListMergeApprovalsResponse.merge_approvals:type_name -> employee.v1.MergeApproval
+	28,  // 41: employee.v1.ApproveMergeResponse.merge_approval:type_name -> employee.v1.MergeApproval
+	26,  // 42: employee.v1.ApproveMergeResponse.merge:type_name -> employee.v1.MergeEmployeesResponse
+	28,  // 43: employee.v1.RejectMergeResponse.merge_approval:type_name -> employee.v1.MergeApproval
+	0,   // 44: employee.v1.UnmergeEmployeesResponse.primary:type_name -> employee.v1.Employee
+	0,   // 45: employee.v1.UnmergeEmployeesResponse.secondary:type_name -> employee.v1.Employee
+	0,   // 46: employee.v1.EmployeeDataAuditEntry.before:type_name -> employee.v1.Employee
+	0,   // 47: employee.v1.EmployeeDataAuditEntry.after:type_name -> employee.v1.Employee
+	100, // 48: employee.v1.EmployeeDataAuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	100, // 49: employee.v1.EmployeeDataEvent.created_at:type_name -> google.protobuf.Timestamp
+	100, // 50: employee.v1.EmployeeDataEvent.delivered_at:type_name -> google.protobuf.Timestamp
+	0,   // 51: employee.v1.ExportEmployeeDataResponse.employee:type_name -> employee.v1.Employee
+	38,  // 52: employee.v1.ExportEmployeeDataResponse.audit_entries:type_name -> employee.v1.EmployeeDataAuditEntry
+	39,  // 53: employee.v1.ExportEmployeeDataResponse.events:type_name -> employee.v1.EmployeeDataEvent
+	100, // 54: employee.v1.ExportEmployeeDataResponse.exported_at:type_name -> google.protobuf.Timestamp
+	100, // 55: employee.v1.MergeRedirect.merged_at:type_name -> google.protobuf.Timestamp
+	0,   // 56: employee.v1.ResolveMergedEmployeeResponse.employee:type_name -> employee.v1.Employee
+	42,  // 57: employee.v1.ResolveMergedEmployeeResponse.redirects:type_name -> employee.v1.MergeRedirect
+	0,   // 58: employee.v1.DuplicateCandidate.primary:type_name -> employee.v1.Employee
+	0,   // 59: employee.v1.DuplicateCandidate.secondary:type_name -> employee.v1.Employee
+	45,  // 60: employee.v1.FindDuplicateCandidatesResponse.candidates:type_name -> employee.v1.DuplicateCandidate
+	100, // 61: employee.v1.EmployeeHistorySummary.last_changed_at:type_name -> google.protobuf.Timestamp
+	0,   // 62: employee.v1.DiffEmployeesResponse.employee_a:type_name -> employee.v1.Employee
+	0,   // 63: employee.v1.DiffEmployeesResponse.employee_b:type_name -> employee.v1.Employee
+	48,  // 64: employee.v1.DiffEmployeesResponse.fields:type_name -> employee.v1.EmployeeFieldDiff
+	49,  // 65: employee.v1.DiffEmployeesResponse.emails:type_name -> employee.v1.EmployeeEmailDiff
+	50,  // 66: employee.v1.DiffEmployeesResponse.history_a:type_name -> employee.v1.EmployeeHistorySummary
+	50,  // 67: employee.v1.DiffEmployeesResponse.history_b:type_name -> employee.v1.EmployeeHistorySummary
+	0,   // 68: employee.v1.WatchEmployeesResponse.employee:type_name -> employee.v1.Employee
+	0,   // 69: employee.v1.WatchEmployeesResponse.previous:type_name -> employee.v1.Employee
+	100, // 70: employee.v1.WatchEmployeesResponse.occurred_at:type_name -> google.protobuf.Timestamp
+	0,   // 71: employee.v1.ApproveEmployeeResponse.employee:type_name -> employee.v1.Employee
+	100, // 72: employee.v1.ScheduledChange.effective_at:type_name -> google.protobuf.Timestamp
+	100, // 73: employee.v1.ScheduledChange.created_at:type_name -> google.protobuf.Timestamp
+	100, // 74: employee.v1.ScheduledChange.applied_at:type_name -> google.protobuf.Timestamp
+	3,   // 75: employee.v1.ScheduledChange.phone_numbers:type_name -> employee.v1.PhoneNumber
+	2,   // 76: employee.v1.ScheduledChange.address:type_name -> employee.v1.PostalAddress
+	99,  // 77: employee.v1.ScheduledChange.external_ids:type_name -> employee.v1.ScheduledChange.ExternalIdsEntry
+	59,  // 78: employee.v1.ListScheduledChangesResponse.scheduled_changes:type_name -> employee.v1.ScheduledChange
+	59,  // 79: employee.v1.CancelScheduledChangeResponse.scheduled_change:type_name -> employee.v1.ScheduledChange
+	0,   // 80: employee.v1.GetManagementChainResponse.managers:type_name -> employee.v1.Employee
+	100, // 81: employee.v1.EmploymentHistoryEntry.effective_from:type_name -> google.protobuf.Timestamp
+	100, // 82: employee.v1.EmploymentHistoryEntry.effective_to:type_name -> google.protobuf.Timestamp
+	68,  // 83: employee.v1.ListEmploymentHistoryResponse.entries:type_name -> employee.v1.EmploymentHistoryEntry
+	0,   // 84: employee.v1.AddTagResponse.employee:type_name -> employee.v1.Employee
+	0,   // 85: employee.v1.RemoveTagResponse.employee:type_name -> employee.v1.Employee
+	0,   // 86: employee.v1.AddSecondaryEmailResponse.employee:type_name -> employee.v1.Employee
+	0,   // 87: employee.v1.RemoveSecondaryEmailResponse.employee:type_name -> employee.v1.Employee
+	0,   // 88: employee.v1.SetPrimaryEmailResponse.employee:type_name -> employee.v1.Employee
+	0,   // 89: employee.v1.UploadEmployeePhotoResponse.employee:type_name -> employee.v1.Employee
+	100, // 90: employee.v1.GetEmployeePhotoURLResponse.expires_at:type_name -> google.protobuf.Timestamp
+	100, // 91: employee.v1.ListEmployeesAsOfRequest.as_of:type_name -> google.protobuf.Timestamp
+	0,   // 92: employee.v1.ListEmployeesAsOfResponse.employees:type_name -> employee.v1.Employee
+	0,   // 93: employee.v1.DeactivateEmployeeResponse.employee:type_name -> employee.v1.Employee
+	0,   // 94: employee.v1.ReactivateEmployeeResponse.employee:type_name -> employee.v1.Employee
+	100, // 95: employee.v1.EmailBounce.occurred_at:type_name -> google.protobuf.Timestamp
+	90,  // 96: employee.v1.ReportEmailBouncesRequest.bounces:type_name -> employee.v1.EmailBounce
+	4,   // 97: employee.v1.ValidateEmployeeRequest.employee:type_name -> employee.v1.CreateEmployeeRequest
+	94,  // 98: employee.v1.ValidateEmployeeResponse.violations:type_name -> employee.v1.ValidationIssue
+	94,  // 99: employee.v1.ValidateEmployeeResponse.warnings:type_name -> employee.v1.ValidationIssue
+	4,   // 100: employee.v1.EmployeeService.CreateEmployee:input_type -> employee.v1.CreateEmployeeRequest
+	6,   // 101: employee.v1.EmployeeService.CreateOrUpdateEmployeeByEmail:input_type -> employee.v1.CreateOrUpdateEmployeeByEmailRequest
+	8,   // 102: employee.v1.EmployeeService.UpdateEmployee:input_type -> employee.v1.UpdateEmployeeRequest
+	10,  // 103: employee.v1.EmployeeService.DeleteEmployee:input_type -> employee.v1.DeleteEmployeeRequest
+	20,  // 104: employee.v1.EmployeeService.ListEmployees:input_type -> employee.v1.ListEmployeesRequest
+	22,  // 105: employee.v1.EmployeeService.CountEmployees:input_type -> employee.v1.CountEmployeesRequest
+	12,  // 106: employee.v1.EmployeeService.GetEmployee:input_type -> employee.v1.GetEmployeeRequest
+	14,  // 107: employee.v1.EmployeeService.GetEmployeeByEmail:input_type -> employee.v1.GetEmployeeByEmailRequest
+	16,  // 108: employee.v1.EmployeeService.GetEmployeeByExternalID:input_type -> employee.v1.GetEmployeeByExternalIDRequest
+	37,  // 109: employee.v1.EmployeeService.ExportEmployeeData:input_type -> employee.v1.ExportEmployeeDataRequest
+	18,  // 110: employee.v1.EmployeeService.EmployeeExists:input_type -> employee.v1.EmployeeExistsRequest
+	25,  // 111: employee.v1.EmployeeService.MergeEmployees:input_type -> employee.v1.MergeEmployeesRequest
+	27,  // 112: employee.v1.EmployeeService.MergeEmployeesById:input_type -> employee.v1.MergeEmployeesByIdRequest
+	35,  // 113: employee.v1.EmployeeService.UnmergeEmployees:input_type -> employee.v1.UnmergeEmployeesRequest
+	29,  // 114: employee.v1.EmployeeService.ListMergeApprovals:input_type -> employee.v1.ListMergeApprovalsRequest
+	31,  // 115: employee.v1.EmployeeService.ApproveMerge:input_type -> employee.v1.ApproveMergeRequest
+	33,  // 116: employee.v1.EmployeeService.RejectMerge:input_type -> employee.v1.RejectMergeRequest
+	41,  // 117: employee.v1.EmployeeService.ResolveMergedEmployee:input_type -> employee.v1.ResolveMergedEmployeeRequest
+	44,  // 118: employee.v1.EmployeeService.FindDuplicateCandidates:input_type -> employee.v1.FindDuplicateCandidatesRequest
+	47,  // 119: employee.v1.EmployeeService.DiffEmployees:input_type -> employee.v1.DiffEmployeesRequest
+	52,  // 120: employee.v1.EmployeeService.WatchEmployees:input_type -> employee.v1.WatchEmployeesRequest
+	54,  // 121: employee.v1.EmployeeService.ListPendingEmployees:input_type -> employee.v1.ListPendingEmployeesRequest
+	55,  // 122: employee.v1.EmployeeService.ApproveEmployee:input_type -> employee.v1.ApproveEmployeeRequest
+	57,  // 123: employee.v1.EmployeeService.RejectEmployee:input_type -> employee.v1.RejectEmployeeRequest
+	60,  // 124: employee.v1.EmployeeService.ListScheduledChanges:input_type -> employee.v1.ListScheduledChangesRequest
+	62,  // 125: employee.v1.EmployeeService.CancelScheduledChange:input_type -> employee.v1.CancelScheduledChangeRequest
+	64,  // 126: employee.v1.EmployeeService.ListDirectReports:input_type -> employee.v1.ListDirectReportsRequest
+	65,  // 127: employee.v1.EmployeeService.GetManagementChain:input_type -> employee.v1.GetManagementChainRequest
+	67,  // 128: employee.v1.EmployeeService.ListEmploymentHistory:input_type -> employee.v1.ListEmploymentHistoryRequest
+	70,  // 129: employee.v1.EmployeeService.AddTag:input_type -> employee.v1.AddTagRequest
+	72,  // 130: employee.v1.EmployeeService.RemoveTag:input_type -> employee.v1.RemoveTagRequest
+	74,  // 131: employee.v1.EmployeeService.AddSecondaryEmail:input_type -> employee.v1.AddSecondaryEmailRequest
+	76,  // 132: employee.v1.EmployeeService.RemoveSecondaryEmail:input_type -> employee.v1.RemoveSecondaryEmailRequest
+	78,  // 133: employee.v1.EmployeeService.SetPrimaryEmail:input_type -> employee.v1.SetPrimaryEmailRequest
+	84,  // 134: employee.v1.EmployeeService.ListEmployeesAsOf:input_type -> employee.v1.ListEmployeesAsOfRequest
+	80,  // 135: employee.v1.EmployeeService.UploadEmployeePhoto:input_type -> employee.v1.UploadEmployeePhotoRequest
+	82,  // 136: employee.v1.EmployeeService.GetEmployeePhotoURL:input_type -> employee.v1.GetEmployeePhotoURLRequest
+	86,  // 137: employee.v1.EmployeeService.DeactivateEmployee:input_type -> employee.v1.DeactivateEmployeeRequest
+	88,  // 138: employee.v1.EmployeeService.ReactivateEmployee:input_type -> employee.v1.ReactivateEmployeeRequest
+	91,  // 139: employee.v1.EmployeeService.ReportEmailBounces:input_type -> employee.v1.ReportEmailBouncesRequest
+	93,  // 140: employee.v1.EmployeeService.ValidateEmployee:input_type -> employee.v1.ValidateEmployeeRequest
+	5,   // 141: employee.v1.EmployeeService.CreateEmployee:output_type -> employee.v1.CreateEmployeeResponse
+	7,   // 142: employee.v1.EmployeeService.CreateOrUpdateEmployeeByEmail:output_type -> employee.v1.CreateOrUpdateEmployeeByEmailResponse
+	9,   // 143: employee.v1.EmployeeService.UpdateEmployee:output_type -> employee.v1.UpdateEmployeeResponse
+	11,  // 144: employee.v1.EmployeeService.DeleteEmployee:output_type -> employee.v1.DeleteEmployeeResponse
+	21,  // 145: employee.v1.EmployeeService.ListEmployees:output_type -> employee.v1.ListEmployeesResponse
+	23,  // 146: employee.v1.EmployeeService.CountEmployees:output_type -> employee.v1.CountEmployeesResponse
+	13,  // 147: employee.v1.EmployeeService.GetEmployee:output_type -> employee.v1.GetEmployeeResponse
+	15,  // 148: employee.v1.EmployeeService.GetEmployeeByEmail:output_type -> employee.v1.GetEmployeeByEmailResponse
+	17,  // 149: employee.v1.EmployeeService.GetEmployeeByExternalID:output_type -> employee.v1.GetEmployeeByExternalIDResponse
+	40,  // 150: employee.v1.EmployeeService.ExportEmployeeData:output_type -> employee.v1.ExportEmployeeDataResponse
+	19,  // 151: employee.v1.EmployeeService.EmployeeExists:output_type -> employee.v1.EmployeeExistsResponse
+	26,  // 152: employee.v1.EmployeeService.MergeEmployees:output_type -> employee.v1.MergeEmployeesResponse
+	26,  // 153: employee.v1.EmployeeService.MergeEmployeesById:output_type -> employee.v1.MergeEmployeesResponse
+	36,  // 154: employee.v1.EmployeeService.UnmergeEmployees:output_type -> employee.v1.UnmergeEmployeesResponse
+	30,  // 155: employee.v1.EmployeeService.ListMergeApprovals:output_type -> employee.v1.ListMergeApprovalsResponse
+	32,  // 156: employee.v1.EmployeeService.ApproveMerge:output_type -> employee.v1.ApproveMergeResponse
+	34,  // 157: employee.v1.EmployeeService.RejectMerge:output_type -> employee.v1.RejectMergeResponse
+	43,  // 158: employee.v1.EmployeeService.ResolveMergedEmployee:output_type -> employee.v1.ResolveMergedEmployeeResponse
+	46,  // 159: employee.v1.EmployeeService.FindDuplicateCandidates:output_type -> employee.v1.FindDuplicateCandidatesResponse
+	51,  // 160: employee.v1.EmployeeService.DiffEmployees:output_type -> employee.v1.DiffEmployeesResponse
+	53,  // 161: employee.v1.EmployeeService.WatchEmployees:output_type -> employee.v1.WatchEmployeesResponse
+	21,  // 162: employee.v1.EmployeeService.ListPendingEmployees:output_type -> employee.v1.ListEmployeesResponse
+	56,  // 163: employee.v1.EmployeeService.ApproveEmployee:output_type -> employee.v1.ApproveEmployeeResponse
+	58,  // 164: employee.v1.EmployeeService.RejectEmployee:output_type -> employee.v1.RejectEmployeeResponse
+	61,  // 165: employee.v1.EmployeeService.ListScheduledChanges:output_type -> employee.v1.ListScheduledChangesResponse
+	63,  // 166: employee.v1.EmployeeService.CancelScheduledChange:output_type -> employee.v1.CancelScheduledChangeResponse
+	21,  // 167: employee.v1.EmployeeService.ListDirectReports:output_type -> employee.v1.ListEmployeesResponse
+	66,  // 168: employee.v1.EmployeeService.GetManagementChain:output_type -> employee.v1.GetManagementChainResponse
+	69,  // 169: employee.v1.EmployeeService.ListEmploymentHistory:output_type -> employee.v1.ListEmploymentHistoryResponse
+	71,  // 170: employee.v1.EmployeeService.AddTag:output_type -> employee.v1.AddTagResponse
+	73,  // 171: employee.v1.EmployeeService.RemoveTag:output_type -> employee.v1.RemoveTagResponse
+	75,  // 172: employee.v1.EmployeeService.AddSecondaryEmail:output_type -> employee.v1.AddSecondaryEmailResponse
+	77,  // 173: employee.v1.EmployeeService.RemoveSecondaryEmail:output_type -> employee.v1.RemoveSecondaryEmailResponse
+	79,  // 174: employee.v1.EmployeeService.SetPrimaryEmail:output_type -> employee.v1.SetPrimaryEmailResponse
+	85,  // 175: employee.v1.EmployeeService.ListEmployeesAsOf:output_type -> employee.v1.ListEmployeesAsOfResponse
+	81,  // 176: employee.v1.EmployeeService.UploadEmployeePhoto:output_type -> employee.v1.UploadEmployeePhotoResponse
+	83,  // 177: employee.v1.EmployeeService.GetEmployeePhotoURL:output_type -> employee.v1.GetEmployeePhotoURLResponse
+	87,  // 178: employee.v1.EmployeeService.DeactivateEmployee:output_type -> employee.v1.DeactivateEmployeeResponse
+	89,  // 179: employee.v1.EmployeeService.ReactivateEmployee:output_type -> employee.v1.ReactivateEmployeeResponse
+	92,  // 180: employee.v1.EmployeeService.ReportEmailBounces:output_type -> employee.v1.ReportEmailBouncesResponse
+	95,  // 181: employee.v1.EmployeeService.ValidateEmployee:output_type -> employee.v1.ValidateEmployeeResponse
+	141, // [141:182] is the sub-list for method output_type
+	100, // [100:141] is the sub-list for method input_type
+	100, // [100:100] is the sub-list for extension type_name
+	100, // [100:100] is the sub-list for extension extendee
+	0,   // [0:100] is the sub-list for field type_name
 }
 
 func init() { file_employee_v1_employee_proto_init() }
@@ -974,15 +7300,26 @@ func file_employee_v1_employee_proto_init() {
 	if File_employee_v1_employee_proto != nil {
 		return
 	}
-	file_employee_v1_employee_proto_msgTypes[3].OneofWrappers = []any{}
-	file_employee_v1_employee_proto_msgTypes[11].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[6].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[8].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[20].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[22].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[24].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[29].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[52].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[54].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[59].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[60].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[64].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[67].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[84].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_employee_v1_employee_proto_rawDesc), len(file_employee_v1_employee_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   100,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
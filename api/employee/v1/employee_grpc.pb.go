@@ -19,13 +19,47 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EmployeeService_CreateEmployee_FullMethodName     = "/employee.v1.EmployeeService/CreateEmployee"
-	EmployeeService_UpdateEmployee_FullMethodName     = "/employee.v1.EmployeeService/UpdateEmployee"
-	EmployeeService_DeleteEmployee_FullMethodName     = "/employee.v1.EmployeeService/DeleteEmployee"
-	EmployeeService_ListEmployees_FullMethodName      = "/employee.v1.EmployeeService/ListEmployees"
-	EmployeeService_GetEmployee_FullMethodName        = "/employee.v1.EmployeeService/GetEmployee"
-	EmployeeService_GetEmployeeByEmail_FullMethodName = "/employee.v1.EmployeeService/GetEmployeeByEmail"
-	EmployeeService_MergeEmployees_FullMethodName     = "/employee.v1.EmployeeService/MergeEmployees"
+	EmployeeService_CreateEmployee_FullMethodName                = "/employee.v1.EmployeeService/CreateEmployee"
+	EmployeeService_CreateOrUpdateEmployeeByEmail_FullMethodName = "/employee.v1.EmployeeService/CreateOrUpdateEmployeeByEmail"
+	EmployeeService_UpdateEmployee_FullMethodName                = "/employee.v1.EmployeeService/UpdateEmployee"
+	EmployeeService_DeleteEmployee_FullMethodName                = "/employee.v1.EmployeeService/DeleteEmployee"
+	EmployeeService_ListEmployees_FullMethodName                 = "/employee.v1.EmployeeService/ListEmployees"
+	EmployeeService_CountEmployees_FullMethodName                = "/employee.v1.EmployeeService/CountEmployees"
+	EmployeeService_GetEmployee_FullMethodName                   = "/employee.v1.EmployeeService/GetEmployee"
+	EmployeeService_GetEmployeeByEmail_FullMethodName            = "/employee.v1.EmployeeService/GetEmployeeByEmail"
+	EmployeeService_GetEmployeeByExternalID_FullMethodName       = "/employee.v1.EmployeeService/GetEmployeeByExternalID"
+	EmployeeService_ExportEmployeeData_FullMethodName            = "/employee.v1.EmployeeService/ExportEmployeeData"
+	EmployeeService_EmployeeExists_FullMethodName                = "/employee.v1.EmployeeService/EmployeeExists"
+	EmployeeService_MergeEmployees_FullMethodName                = "/employee.v1.EmployeeService/MergeEmployees"
+	EmployeeService_MergeEmployeesById_FullMethodName            = "/employee.v1.EmployeeService/MergeEmployeesById"
+	EmployeeService_UnmergeEmployees_FullMethodName              = "/employee.v1.EmployeeService/UnmergeEmployees"
+	EmployeeService_ListMergeApprovals_FullMethodName            = "/employee.v1.EmployeeService/ListMergeApprovals"
+	EmployeeService_ApproveMerge_FullMethodName                  = "/employee.v1.EmployeeService/ApproveMerge"
+	EmployeeService_RejectMerge_FullMethodName                   = "/employee.v1.EmployeeService/RejectMerge"
+	EmployeeService_ResolveMergedEmployee_FullMethodName         = "/employee.v1.EmployeeService/ResolveMergedEmployee"
+	EmployeeService_FindDuplicateCandidates_FullMethodName       = "/employee.v1.EmployeeService/FindDuplicateCandidates"
+	EmployeeService_DiffEmployees_FullMethodName                 = "/employee.v1.EmployeeService/DiffEmployees"
+	EmployeeService_WatchEmployees_FullMethodName                = "/employee.v1.EmployeeService/WatchEmployees"
+	EmployeeService_ListPendingEmployees_FullMethodName          = "/employee.v1.EmployeeService/ListPendingEmployees"
+	EmployeeService_ApproveEmployee_FullMethodName               = "/employee.v1.EmployeeService/ApproveEmployee"
+	EmployeeService_RejectEmployee_FullMethodName                = "/employee.v1.EmployeeService/RejectEmployee"
+	EmployeeService_ListScheduledChanges_FullMethodName          = "/employee.v1.EmployeeService/ListScheduledChanges"
+	EmployeeService_CancelScheduledChange_FullMethodName         = "/employee.v1.EmployeeService/CancelScheduledChange"
+	EmployeeService_ListDirectReports_FullMethodName             = "/employee.v1.EmployeeService/ListDirectReports"
+	EmployeeService_GetManagementChain_FullMethodName            = "/employee.v1.EmployeeService/GetManagementChain"
+	EmployeeService_ListEmploymentHistory_FullMethodName         = "/employee.v1.EmployeeService/ListEmploymentHistory"
+	EmployeeService_AddTag_FullMethodName                        = "/employee.v1.EmployeeService/AddTag"
+	EmployeeService_RemoveTag_FullMethodName                     = "/employee.v1.EmployeeService/RemoveTag"
+	EmployeeService_AddSecondaryEmail_FullMethodName             = "/employee.v1.EmployeeService/AddSecondaryEmail"
+	EmployeeService_RemoveSecondaryEmail_FullMethodName          = "/employee.v1.EmployeeService/RemoveSecondaryEmail"
+	EmployeeService_SetPrimaryEmail_FullMethodName               = "/employee.v1.EmployeeService/SetPrimaryEmail"
+	EmployeeService_ListEmployeesAsOf_FullMethodName             = "/employee.v1.EmployeeService/ListEmployeesAsOf"
+	EmployeeService_UploadEmployeePhoto_FullMethodName           = "/employee.v1.EmployeeService/UploadEmployeePhoto"
+	EmployeeService_GetEmployeePhotoURL_FullMethodName           = "/employee.v1.EmployeeService/GetEmployeePhotoURL"
+	EmployeeService_DeactivateEmployee_FullMethodName            = "/employee.v1.EmployeeService/DeactivateEmployee"
+	EmployeeService_ReactivateEmployee_FullMethodName            = "/employee.v1.EmployeeService/ReactivateEmployee"
+	EmployeeService_ReportEmailBounces_FullMethodName            = "/employee.v1.EmployeeService/ReportEmailBounces"
+	EmployeeService_ValidateEmployee_FullMethodName              = "/employee.v1.EmployeeService/ValidateEmployee"
 )
 
 // EmployeeServiceClient is the client API for EmployeeService service.
@@ -37,18 +71,119 @@ type EmployeeServiceClient interface {
 	// Creates a new employee
 	CreateEmployee(ctx context.Context, in *CreateEmployeeRequest, opts ...grpc.CallOption) (*CreateEmployeeResponse, error)
 	// Updates an existing employee
+	// Creates an employee owning email, or updates the employee that already
+	// owns it, atomically; meant for idempotent syncs from an HRIS
+	CreateOrUpdateEmployeeByEmail(ctx context.Context, in *CreateOrUpdateEmployeeByEmailRequest, opts ...grpc.CallOption) (*CreateOrUpdateEmployeeByEmailResponse, error)
 	UpdateEmployee(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*UpdateEmployeeResponse, error)
 	// Deletes an employee
 	DeleteEmployee(ctx context.Context, in *DeleteEmployeeRequest, opts ...grpc.CallOption) (*DeleteEmployeeResponse, error)
 	// Lists employees with pagination and filtering
 	// Use query parameters: ?page=1&page_size=20&email=...
 	ListEmployees(ctx context.Context, in *ListEmployeesRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error)
+	// Counts the employees ListEmployees would list, without returning them
+	CountEmployees(ctx context.Context, in *CountEmployeesRequest, opts ...grpc.CallOption) (*CountEmployeesResponse, error)
 	// Gets an employee by ID
 	GetEmployee(ctx context.Context, in *GetEmployeeRequest, opts ...grpc.CallOption) (*GetEmployeeResponse, error)
 	// Gets an employee by email (deprecated - use ListEmployees with email param)
 	GetEmployeeByEmail(ctx context.Context, in *GetEmployeeByEmailRequest, opts ...grpc.CallOption) (*GetEmployeeByEmailResponse, error)
-	// Merges two employees by email
+	// Gets the employee with an ID in an external system such as an HRIS
+	GetEmployeeByExternalID(ctx context.Context, in *GetEmployeeByExternalIDRequest, opts ...grpc.CallOption) (*GetEmployeeByExternalIDResponse, error)
+	// Exports everything stored about an employee as one document, to answer
+	// data-subject access requests: the employee, its audit history and the
+	// webhook events sent about it
+	ExportEmployeeData(ctx context.Context, in *ExportEmployeeDataRequest, opts ...grpc.CallOption) (*ExportEmployeeDataResponse, error)
+	// Reports whether an email belongs to an employee, without returning the
+	// employee. Emails of employees pending review count as taken.
+	EmployeeExists(ctx context.Context, in *EmployeeExistsRequest, opts ...grpc.CallOption) (*EmployeeExistsResponse, error)
+	// Merges two employees by email. With validate_only the merge is only
+	// previewed.
 	MergeEmployees(ctx context.Context, in *MergeEmployeesRequest, opts ...grpc.CallOption) (*MergeEmployeesResponse, error)
+	// Merges two employees by ID. With validate_only the merge is only
+	// previewed.
+	MergeEmployeesById(ctx context.Context, in *MergeEmployeesByIdRequest, opts ...grpc.CallOption) (*MergeEmployeesResponse, error)
+	// Undoes a merge, recreating the secondary employee and moving its emails
+	// back from the primary employee
+	UnmergeEmployees(ctx context.Context, in *UnmergeEmployeesRequest, opts ...grpc.CallOption) (*UnmergeEmployeesResponse, error)
+	// Lists the merges awaiting the approval of a second user
+	ListMergeApprovals(ctx context.Context, in *ListMergeApprovalsRequest, opts ...grpc.CallOption) (*ListMergeApprovalsResponse, error)
+	// Approves a merge awaiting approval, performing it. The merge must be
+	// approved by another user than the one requesting it, acting as
+	// themselves rather than impersonated.
+	ApproveMerge(ctx context.Context, in *ApproveMergeRequest, opts ...grpc.CallOption) (*ApproveMergeResponse, error)
+	// Rejects a merge awaiting approval; the employees are left unchanged
+	RejectMerge(ctx context.Context, in *RejectMergeRequest, opts ...grpc.CallOption) (*RejectMergeResponse, error)
+	// Follows the merges an employee ID was merged away by to the surviving
+	// employee, for systems still keyed by the ID of a secondary employee
+	ResolveMergedEmployee(ctx context.Context, in *ResolveMergedEmployeeRequest, opts ...grpc.CallOption) (*ResolveMergedEmployeeResponse, error)
+	// Scans the tenant for likely duplicate employees and returns scored
+	// candidate pairs to merge
+	FindDuplicateCandidates(ctx context.Context, in *FindDuplicateCandidatesRequest, opts ...grpc.CallOption) (*FindDuplicateCandidatesResponse, error)
+	// Compares two employees field by field, with their emails and a summary
+	// of their histories, to decide on a merge
+	DiffEmployees(ctx context.Context, in *DiffEmployeesRequest, opts ...grpc.CallOption) (*DiffEmployeesResponse, error)
+	// Streams changes to employees of the caller's tenant (gRPC only).
+	// Every change carries a resume_token; after a disconnect, calling again
+	// with the last received token replays the missed changes from the audit
+	// history before switching to live tailing. A token whose change has been
+	// archived fails with FAILED_PRECONDITION (RESUME_TOKEN_EXPIRED).
+	WatchEmployees(ctx context.Context, in *WatchEmployeesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEmployeesResponse], error)
+	// Lists the employees pending review, oldest first
+	ListPendingEmployees(ctx context.Context, in *ListPendingEmployeesRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error)
+	// Approves an employee pending review, making it visible and publishing
+	// its created event
+	ApproveEmployee(ctx context.Context, in *ApproveEmployeeRequest, opts ...grpc.CallOption) (*ApproveEmployeeResponse, error)
+	// Rejects an employee pending review, deleting it
+	RejectEmployee(ctx context.Context, in *RejectEmployeeRequest, opts ...grpc.CallOption) (*RejectEmployeeResponse, error)
+	// Lists the creates and updates scheduled with effective_at, next due first
+	ListScheduledChanges(ctx context.Context, in *ListScheduledChangesRequest, opts ...grpc.CallOption) (*ListScheduledChangesResponse, error)
+	// Cancels a scheduled change that has not been applied yet
+	CancelScheduledChange(ctx context.Context, in *CancelScheduledChangeRequest, opts ...grpc.CallOption) (*CancelScheduledChangeResponse, error)
+	// Lists the employees reporting directly to a manager, newest first
+	ListDirectReports(ctx context.Context, in *ListDirectReportsRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error)
+	// Returns the managers above an employee, its direct manager first and
+	// the top of the hierarchy last
+	GetManagementChain(ctx context.Context, in *GetManagementChainRequest, opts ...grpc.CallOption) (*GetManagementChainResponse, error)
+	// Lists the jobs an employee held, the current one first. A job is the
+	// title, department and manager of the employee over a period; a new one
+	// starts whenever any of them changes.
+	ListEmploymentHistory(ctx context.Context, in *ListEmploymentHistoryRequest, opts ...grpc.CallOption) (*ListEmploymentHistoryResponse, error)
+	// Tags an employee, e.g. contractor or remote; tagging an employee again
+	// with the same tag is a no-op
+	AddTag(ctx context.Context, in *AddTagRequest, opts ...grpc.CallOption) (*AddTagResponse, error)
+	// Removes a tag from an employee; removing a tag the employee does not
+	// have is a no-op
+	RemoveTag(ctx context.Context, in *RemoveTagRequest, opts ...grpc.CallOption) (*RemoveTagResponse, error)
+	// Adds an email to an employee as a secondary email; adding an email the
+	// employee already has is a no-op
+	AddSecondaryEmail(ctx context.Context, in *AddSecondaryEmailRequest, opts ...grpc.CallOption) (*AddSecondaryEmailResponse, error)
+	// Removes a secondary email from an employee; removing an email the
+	// employee does not have is a no-op. The primary email cannot be removed.
+	RemoveSecondaryEmail(ctx context.Context, in *RemoveSecondaryEmailRequest, opts ...grpc.CallOption) (*RemoveSecondaryEmailResponse, error)
+	// Makes one of an employee's emails its primary email; the previous
+	// primary email stays one of its emails. Emails marked undeliverable
+	// cannot become primary (EMAIL_UNDELIVERABLE).
+	SetPrimaryEmail(ctx context.Context, in *SetPrimaryEmailRequest, opts ...grpc.CallOption) (*SetPrimaryEmailResponse, error)
+	// Lists the employees as they were at a point in time, e.g. for the
+	// headcount on Jan 1, reconstructed from the audit log
+	ListEmployeesAsOf(ctx context.Context, in *ListEmployeesAsOfRequest, opts ...grpc.CallOption) (*ListEmployeesAsOfResponse, error)
+	// Uploads the photo of an employee, a JPEG, PNG or WebP image, replacing
+	// the previous one
+	UploadEmployeePhoto(ctx context.Context, in *UploadEmployeePhotoRequest, opts ...grpc.CallOption) (*UploadEmployeePhotoResponse, error)
+	// Returns a pre-signed URL the photo of an employee can be downloaded
+	// from until it expires
+	GetEmployeePhotoURL(ctx context.Context, in *GetEmployeePhotoURLRequest, opts ...grpc.CallOption) (*GetEmployeePhotoURLResponse, error)
+	// Deactivates an employee, e.g. when offboarded. The employee is kept and
+	// stays readable, but is left out of listings unless active_only is false.
+	DeactivateEmployee(ctx context.Context, in *DeactivateEmployeeRequest, opts ...grpc.CallOption) (*DeactivateEmployeeResponse, error)
+	// Reactivates a deactivated employee
+	ReactivateEmployee(ctx context.Context, in *ReactivateEmployeeRequest, opts ...grpc.CallOption) (*ReactivateEmployeeResponse, error)
+	// Reports bounces and complaints of the mail provider, marking the emails
+	// undeliverable. Emails of no employee are ignored.
+	ReportEmailBounces(ctx context.Context, in *ReportEmailBouncesRequest, opts ...grpc.CallOption) (*ReportEmailBouncesResponse, error)
+	// Validates an employee payload as CreateEmployee would, or as an update
+	// of an existing employee, without storing anything. Every problem is
+	// returned at once: violations would fail the write, warnings would not.
+	ValidateEmployee(ctx context.Context, in *ValidateEmployeeRequest, opts ...grpc.CallOption) (*ValidateEmployeeResponse, error)
 }
 
 type employeeServiceClient struct {
@@ -69,6 +204,16 @@ func (c *employeeServiceClient) CreateEmployee(ctx context.Context, in *CreateEm
 	return out, nil
 }
 
+func (c *employeeServiceClient) CreateOrUpdateEmployeeByEmail(ctx context.Context, in *CreateOrUpdateEmployeeByEmailRequest, opts ...grpc.CallOption) (*CreateOrUpdateEmployeeByEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrUpdateEmployeeByEmailResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_CreateOrUpdateEmployeeByEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *employeeServiceClient) UpdateEmployee(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*UpdateEmployeeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateEmployeeResponse)
@@ -86,244 +231,1391 @@ func (c *employeeServiceClient) DeleteEmployee(ctx context.Context, in *DeleteEm
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *employeeServiceClient) ListEmployees(ctx context.Context, in *ListEmployeesRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) CountEmployees(ctx context.Context, in *CountEmployeesRequest, opts ...grpc.CallOption) (*CountEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountEmployeesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_CountEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetEmployee(ctx context.Context, in *GetEmployeeRequest, opts ...grpc.CallOption) (*GetEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmployeeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetEmployeeByEmail(ctx context.Context, in *GetEmployeeByEmailRequest, opts ...grpc.CallOption) (*GetEmployeeByEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmployeeByEmailResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetEmployeeByEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetEmployeeByExternalID(ctx context.Context, in *GetEmployeeByExternalIDRequest, opts ...grpc.CallOption) (*GetEmployeeByExternalIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmployeeByExternalIDResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetEmployeeByExternalID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ExportEmployeeData(ctx context.Context, in *ExportEmployeeDataRequest, opts ...grpc.CallOption) (*ExportEmployeeDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportEmployeeDataResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ExportEmployeeData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) EmployeeExists(ctx context.Context, in *EmployeeExistsRequest, opts ...grpc.CallOption) (*EmployeeExistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployeeExistsResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_EmployeeExists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) MergeEmployees(ctx context.Context, in *MergeEmployeesRequest, opts ...grpc.CallOption) (*MergeEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeEmployeesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_MergeEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) MergeEmployeesById(ctx context.Context, in *MergeEmployeesByIdRequest, opts ...grpc.CallOption) (*MergeEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeEmployeesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_MergeEmployeesById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) UnmergeEmployees(ctx context.Context, in *UnmergeEmployeesRequest, opts ...grpc.CallOption) (*UnmergeEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmergeEmployeesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_UnmergeEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ListMergeApprovals(ctx context.Context, in *ListMergeApprovalsRequest, opts ...grpc.CallOption) (*ListMergeApprovalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMergeApprovalsResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListMergeApprovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ApproveMerge(ctx context.Context, in *ApproveMergeRequest, opts ...grpc.CallOption) (*ApproveMergeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveMergeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ApproveMerge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) RejectMerge(ctx context.Context, in *RejectMergeRequest, opts ...grpc.CallOption) (*RejectMergeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectMergeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_RejectMerge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ResolveMergedEmployee(ctx context.Context, in *ResolveMergedEmployeeRequest, opts ...grpc.CallOption) (*ResolveMergedEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveMergedEmployeeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ResolveMergedEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) FindDuplicateCandidates(ctx context.Context, in *FindDuplicateCandidatesRequest, opts ...grpc.CallOption) (*FindDuplicateCandidatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindDuplicateCandidatesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_FindDuplicateCandidates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) DiffEmployees(ctx context.Context, in *DiffEmployeesRequest, opts ...grpc.CallOption) (*DiffEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffEmployeesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_DiffEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) WatchEmployees(ctx context.Context, in *WatchEmployeesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEmployeesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EmployeeService_ServiceDesc.Streams[0], EmployeeService_WatchEmployees_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEmployeesRequest, WatchEmployeesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_WatchEmployeesClient = grpc.ServerStreamingClient[WatchEmployeesResponse]
+
+func (c *employeeServiceClient) ListPendingEmployees(ctx context.Context, in *ListPendingEmployeesRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListPendingEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ApproveEmployee(ctx context.Context, in *ApproveEmployeeRequest, opts ...grpc.CallOption) (*ApproveEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveEmployeeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ApproveEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) RejectEmployee(ctx context.Context, in *RejectEmployeeRequest, opts ...grpc.CallOption) (*RejectEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectEmployeeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_RejectEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ListScheduledChanges(ctx context.Context, in *ListScheduledChangesRequest, opts ...grpc.CallOption) (*ListScheduledChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledChangesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListScheduledChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) CancelScheduledChange(ctx context.Context, in *CancelScheduledChangeRequest, opts ...grpc.CallOption) (*CancelScheduledChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledChangeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_CancelScheduledChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ListDirectReports(ctx context.Context, in *ListDirectReportsRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListDirectReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetManagementChain(ctx context.Context, in *GetManagementChainRequest, opts ...grpc.CallOption) (*GetManagementChainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetManagementChainResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetManagementChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ListEmploymentHistory(ctx context.Context, in *ListEmploymentHistoryRequest, opts ...grpc.CallOption) (*ListEmploymentHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmploymentHistoryResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListEmploymentHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) AddTag(ctx context.Context, in *AddTagRequest, opts ...grpc.CallOption) (*AddTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTagResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_AddTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) RemoveTag(ctx context.Context, in *RemoveTagRequest, opts ...grpc.CallOption) (*RemoveTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTagResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_RemoveTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) AddSecondaryEmail(ctx context.Context, in *AddSecondaryEmailRequest, opts ...grpc.CallOption) (*AddSecondaryEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddSecondaryEmailResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_AddSecondaryEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) RemoveSecondaryEmail(ctx context.Context, in *RemoveSecondaryEmailRequest, opts ...grpc.CallOption) (*RemoveSecondaryEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveSecondaryEmailResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_RemoveSecondaryEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) SetPrimaryEmail(ctx context.Context, in *SetPrimaryEmailRequest, opts ...grpc.CallOption) (*SetPrimaryEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPrimaryEmailResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_SetPrimaryEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ListEmployeesAsOf(ctx context.Context, in *ListEmployeesAsOfRequest, opts ...grpc.CallOption) (*ListEmployeesAsOfResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeesAsOfResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListEmployeesAsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) UploadEmployeePhoto(ctx context.Context, in *UploadEmployeePhotoRequest, opts ...grpc.CallOption) (*UploadEmployeePhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadEmployeePhotoResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_UploadEmployeePhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetEmployeePhotoURL(ctx context.Context, in *GetEmployeePhotoURLRequest, opts ...grpc.CallOption) (*GetEmployeePhotoURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmployeePhotoURLResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetEmployeePhotoURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) DeactivateEmployee(ctx context.Context, in *DeactivateEmployeeRequest, opts ...grpc.CallOption) (*DeactivateEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateEmployeeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_DeactivateEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ReactivateEmployee(ctx context.Context, in *ReactivateEmployeeRequest, opts ...grpc.CallOption) (*ReactivateEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateEmployeeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ReactivateEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ReportEmailBounces(ctx context.Context, in *ReportEmailBouncesRequest, opts ...grpc.CallOption) (*ReportEmailBouncesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportEmailBouncesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ReportEmailBounces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ValidateEmployee(ctx context.Context, in *ValidateEmployeeRequest, opts ...grpc.CallOption) (*ValidateEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateEmployeeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ValidateEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmployeeServiceServer is the server API for EmployeeService service.
+// All implementations must embed UnimplementedEmployeeServiceServer
+// for forward compatibility.
+//
+// The employee service definition.
+type EmployeeServiceServer interface {
+	// Creates a new employee
+	CreateEmployee(context.Context, *CreateEmployeeRequest) (*CreateEmployeeResponse, error)
+	// Updates an existing employee
+	// Creates an employee owning email, or updates the employee that already
+	// owns it, atomically; meant for idempotent syncs from an HRIS
+	CreateOrUpdateEmployeeByEmail(context.Context, *CreateOrUpdateEmployeeByEmailRequest) (*CreateOrUpdateEmployeeByEmailResponse, error)
+	UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*UpdateEmployeeResponse, error)
+	// Deletes an employee
+	DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error)
+	// Lists employees with pagination and filtering
+	// Use query parameters: ?page=1&page_size=20&email=...
+	ListEmployees(context.Context, *ListEmployeesRequest) (*ListEmployeesResponse, error)
+	// Counts the employees ListEmployees would list, without returning them
+	CountEmployees(context.Context, *CountEmployeesRequest) (*CountEmployeesResponse, error)
+	// Gets an employee by ID
+	GetEmployee(context.Context, *GetEmployeeRequest) (*GetEmployeeResponse, error)
+	// Gets an employee by email (deprecated - use ListEmployees with email param)
+	GetEmployeeByEmail(context.Context, *GetEmployeeByEmailRequest) (*GetEmployeeByEmailResponse, error)
+	// Gets the employee with an ID in an external system such as an HRIS
+	GetEmployeeByExternalID(context.Context, *GetEmployeeByExternalIDRequest) (*GetEmployeeByExternalIDResponse, error)
+	// Exports everything stored about an employee as one document, to answer
+	// data-subject access requests: the employee, its audit history and the
+	// webhook events sent about it
+	ExportEmployeeData(context.Context, *ExportEmployeeDataRequest) (*ExportEmployeeDataResponse, error)
+	// Reports whether an email belongs to an employee, without returning the
+	// employee. Emails of employees pending review count as taken.
+	EmployeeExists(context.Context, *EmployeeExistsRequest) (*EmployeeExistsResponse, error)
+	// Merges two employees by email. With validate_only the merge is only
+	// previewed.
+	MergeEmployees(context.Context, *MergeEmployeesRequest) (*MergeEmployeesResponse, error)
+	// Merges two employees by ID. With validate_only the merge is only
+	// previewed.
+	MergeEmployeesById(context.Context, *MergeEmployeesByIdRequest) (*MergeEmployeesResponse, error)
+	// Undoes a merge, recreating the secondary employee and moving its emails
+	// back from the primary employee
+	UnmergeEmployees(context.Context, *UnmergeEmployeesRequest) (*UnmergeEmployeesResponse, error)
+	// Lists the merges awaiting the approval of a second user
+	ListMergeApprovals(context.Context, *ListMergeApprovalsRequest) (*ListMergeApprovalsResponse, error)
+	// Approves a merge awaiting approval, performing it. The merge must be
+	// approved by another user than the one requesting it, acting as
+	// themselves rather than impersonated.
+	ApproveMerge(context.Context, *ApproveMergeRequest) (*ApproveMergeResponse, error)
+	// Rejects a merge awaiting approval; the employees are left unchanged
+	RejectMerge(context.Context, *RejectMergeRequest) (*RejectMergeResponse, error)
+	// Follows the merges an employee ID was merged away by to the surviving
+	// employee, for systems still keyed by the ID of a secondary employee
+	ResolveMergedEmployee(context.Context, *ResolveMergedEmployeeRequest) (*ResolveMergedEmployeeResponse, error)
+	// Scans the tenant for likely duplicate employees and returns scored
+	// candidate pairs to merge
+	FindDuplicateCandidates(context.Context, *FindDuplicateCandidatesRequest) (*FindDuplicateCandidatesResponse, error)
+	// Compares two employees field by field, with their emails and a summary
+	// of their histories, to decide on a merge
+	DiffEmployees(context.Context, *DiffEmployeesRequest) (*DiffEmployeesResponse, error)
+	// Streams changes to employees of the caller's tenant (gRPC only).
+	// Every change carries a resume_token; after a disconnect, calling again
+	// with the last received token replays the missed changes from the audit
+	// history before switching to live tailing. A token whose change has been
+	// archived fails with FAILED_PRECONDITION (RESUME_TOKEN_EXPIRED).
+	WatchEmployees(*WatchEmployeesRequest, grpc.ServerStreamingServer[WatchEmployeesResponse]) error
+	// Lists the employees pending review, oldest first
+	ListPendingEmployees(context.Context, *ListPendingEmployeesRequest) (*ListEmployeesResponse, error)
+	// Approves an employee pending review, making it visible and publishing
+	// its created event
+	ApproveEmployee(context.Context, *ApproveEmployeeRequest) (*ApproveEmployeeResponse, error)
+	// Rejects an employee pending review, deleting it
+	RejectEmployee(context.Context, *RejectEmployeeRequest) (*RejectEmployeeResponse, error)
+	// Lists the creates and updates scheduled with effective_at, next due first
+	ListScheduledChanges(context.Context, *ListScheduledChangesRequest) (*ListScheduledChangesResponse, error)
+	// Cancels a scheduled change that has not been applied yet
+	CancelScheduledChange(context.Context, *CancelScheduledChangeRequest) (*CancelScheduledChangeResponse, error)
+	// Lists the employees reporting directly to a manager, newest first
+	ListDirectReports(context.Context, *ListDirectReportsRequest) (*ListEmployeesResponse, error)
+	// Returns the managers above an employee, its direct manager first and
+	// the top of the hierarchy last
+	GetManagementChain(context.Context, *GetManagementChainRequest) (*GetManagementChainResponse, error)
+	// Lists the jobs an employee held, the current one first. A job is the
+	// title, department and manager of the employee over a period; a new one
+	// starts whenever any of them changes.
+	ListEmploymentHistory(context.Context, *ListEmploymentHistoryRequest) (*ListEmploymentHistoryResponse, error)
+	// Tags an employee, e.g. contractor or remote; tagging an employee again
+	// with the same tag is a no-op
+	AddTag(context.Context, *AddTagRequest) (*AddTagResponse, error)
+	// Removes a tag from an employee; removing a tag the employee does not
+	// have is a no-op
+	RemoveTag(context.Context, *RemoveTagRequest) (*RemoveTagResponse, error)
+	// Adds an email to an employee as a secondary email; adding an email the
+	// employee already has is a no-op
+	AddSecondaryEmail(context.Context, *AddSecondaryEmailRequest) (*AddSecondaryEmailResponse, error)
+	// Removes a secondary email from an employee; removing an email the
+	// employee does not have is a no-op. The primary email cannot be removed.
+	RemoveSecondaryEmail(context.Context, *RemoveSecondaryEmailRequest) (*RemoveSecondaryEmailResponse, error)
+	// Makes one of an employee's emails its primary email; the previous
+	// primary email stays one of its emails. Emails marked undeliverable
+	// cannot become primary (EMAIL_UNDELIVERABLE).
+	SetPrimaryEmail(context.Context, *SetPrimaryEmailRequest) (*SetPrimaryEmailResponse, error)
+	// Lists the employees as they were at a point in time, e.g. for the
+	// headcount on Jan 1, reconstructed from the audit log
+	ListEmployeesAsOf(context.Context, *ListEmployeesAsOfRequest) (*ListEmployeesAsOfResponse, error)
+	// Uploads the photo of an employee, a JPEG, PNG or WebP image, replacing
+	// the previous one
+	UploadEmployeePhoto(context.Context, *UploadEmployeePhotoRequest) (*UploadEmployeePhotoResponse, error)
+	// Returns a pre-signed URL the photo of an employee can be downloaded
+	// from until it expires
+	GetEmployeePhotoURL(context.Context, *GetEmployeePhotoURLRequest) (*GetEmployeePhotoURLResponse, error)
+	// Deactivates an employee, e.g. when offboarded. The employee is kept and
+	// stays readable, but is left out of listings unless active_only is false.
+	DeactivateEmployee(context.Context, *DeactivateEmployeeRequest) (*DeactivateEmployeeResponse, error)
+	// Reactivates a deactivated employee
+	ReactivateEmployee(context.Context, *ReactivateEmployeeRequest) (*ReactivateEmployeeResponse, error)
+	// Reports bounces and complaints of the mail provider, marking the emails
+	// undeliverable. Emails of no employee are ignored.
+	ReportEmailBounces(context.Context, *ReportEmailBouncesRequest) (*ReportEmailBouncesResponse, error)
+	// Validates an employee payload as CreateEmployee would, or as an update
+	// of an existing employee, without storing anything. Every problem is
+	// returned at once: violations would fail the write, warnings would not.
+	ValidateEmployee(context.Context, *ValidateEmployeeRequest) (*ValidateEmployeeResponse, error)
+	mustEmbedUnimplementedEmployeeServiceServer()
+}
+
+// UnimplementedEmployeeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmployeeServiceServer struct{}
+
+func (UnimplementedEmployeeServiceServer) CreateEmployee(context.Context, *CreateEmployeeRequest) (*CreateEmployeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) CreateOrUpdateEmployeeByEmail(context.Context, *CreateOrUpdateEmployeeByEmailRequest) (*CreateOrUpdateEmployeeByEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateOrUpdateEmployeeByEmail not implemented")
+}
+func (UnimplementedEmployeeServiceServer) UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*UpdateEmployeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListEmployees(context.Context, *ListEmployeesRequest) (*ListEmployeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEmployees not implemented")
+}
+func (UnimplementedEmployeeServiceServer) CountEmployees(context.Context, *CountEmployeesRequest) (*CountEmployeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CountEmployees not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetEmployee(context.Context, *GetEmployeeRequest) (*GetEmployeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetEmployeeByEmail(context.Context, *GetEmployeeByEmailRequest) (*GetEmployeeByEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEmployeeByEmail not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetEmployeeByExternalID(context.Context, *GetEmployeeByExternalIDRequest) (*GetEmployeeByExternalIDResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEmployeeByExternalID not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ExportEmployeeData(context.Context, *ExportEmployeeDataRequest) (*ExportEmployeeDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportEmployeeData not implemented")
+}
+func (UnimplementedEmployeeServiceServer) EmployeeExists(context.Context, *EmployeeExistsRequest) (*EmployeeExistsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EmployeeExists not implemented")
+}
+func (UnimplementedEmployeeServiceServer) MergeEmployees(context.Context, *MergeEmployeesRequest) (*MergeEmployeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeEmployees not implemented")
+}
+func (UnimplementedEmployeeServiceServer) MergeEmployeesById(context.Context, *MergeEmployeesByIdRequest) (*MergeEmployeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeEmployeesById not implemented")
+}
+func (UnimplementedEmployeeServiceServer) UnmergeEmployees(context.Context, *UnmergeEmployeesRequest) (*UnmergeEmployeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnmergeEmployees not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListMergeApprovals(context.Context, *ListMergeApprovalsRequest) (*ListMergeApprovalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMergeApprovals not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ApproveMerge(context.Context, *ApproveMergeRequest) (*ApproveMergeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveMerge not implemented")
+}
+func (UnimplementedEmployeeServiceServer) RejectMerge(context.Context, *RejectMergeRequest) (*RejectMergeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectMerge not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ResolveMergedEmployee(context.Context, *ResolveMergedEmployeeRequest) (*ResolveMergedEmployeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveMergedEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) FindDuplicateCandidates(context.Context, *FindDuplicateCandidatesRequest) (*FindDuplicateCandidatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindDuplicateCandidates not implemented")
+}
+func (UnimplementedEmployeeServiceServer) DiffEmployees(context.Context, *DiffEmployeesRequest) (*DiffEmployeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffEmployees not implemented")
+}
+func (UnimplementedEmployeeServiceServer) WatchEmployees(*WatchEmployeesRequest, grpc.ServerStreamingServer[WatchEmployeesResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchEmployees not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListPendingEmployees(context.Context, *ListPendingEmployeesRequest) (*ListEmployeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPendingEmployees not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ApproveEmployee(context.Context, *ApproveEmployeeRequest) (*ApproveEmployeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) RejectEmployee(context.Context, *RejectEmployeeRequest) (*RejectEmployeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListScheduledChanges(context.Context, *ListScheduledChangesRequest) (*ListScheduledChangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledChanges not implemented")
+}
+func (UnimplementedEmployeeServiceServer) CancelScheduledChange(context.Context, *CancelScheduledChangeRequest) (*CancelScheduledChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledChange not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListDirectReports(context.Context, *ListDirectReportsRequest) (*ListEmployeesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDirectReports not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetManagementChain(context.Context, *GetManagementChainRequest) (*GetManagementChainResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetManagementChain not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListEmploymentHistory(context.Context, *ListEmploymentHistoryRequest) (*ListEmploymentHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEmploymentHistory not implemented")
+}
+func (UnimplementedEmployeeServiceServer) AddTag(context.Context, *AddTagRequest) (*AddTagResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTag not implemented")
+}
+func (UnimplementedEmployeeServiceServer) RemoveTag(context.Context, *RemoveTagRequest) (*RemoveTagResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveTag not implemented")
+}
+func (UnimplementedEmployeeServiceServer) AddSecondaryEmail(context.Context, *AddSecondaryEmailRequest) (*AddSecondaryEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddSecondaryEmail not implemented")
+}
+func (UnimplementedEmployeeServiceServer) RemoveSecondaryEmail(context.Context, *RemoveSecondaryEmailRequest) (*RemoveSecondaryEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveSecondaryEmail not implemented")
+}
+func (UnimplementedEmployeeServiceServer) SetPrimaryEmail(context.Context, *SetPrimaryEmailRequest) (*SetPrimaryEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPrimaryEmail not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListEmployeesAsOf(context.Context, *ListEmployeesAsOfRequest) (*ListEmployeesAsOfResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEmployeesAsOf not implemented")
+}
+func (UnimplementedEmployeeServiceServer) UploadEmployeePhoto(context.Context, *UploadEmployeePhotoRequest) (*UploadEmployeePhotoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadEmployeePhoto not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetEmployeePhotoURL(context.Context, *GetEmployeePhotoURLRequest) (*GetEmployeePhotoURLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEmployeePhotoURL not implemented")
+}
+func (UnimplementedEmployeeServiceServer) DeactivateEmployee(context.Context, *DeactivateEmployeeRequest) (*DeactivateEmployeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ReactivateEmployee(context.Context, *ReactivateEmployeeRequest) (*ReactivateEmployeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReactivateEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ReportEmailBounces(context.Context, *ReportEmailBouncesRequest) (*ReportEmailBouncesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportEmailBounces not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ValidateEmployee(context.Context, *ValidateEmployeeRequest) (*ValidateEmployeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) mustEmbedUnimplementedEmployeeServiceServer() {}
+func (UnimplementedEmployeeServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeEmployeeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmployeeServiceServer will
+// result in compilation errors.
+type UnsafeEmployeeServiceServer interface {
+	mustEmbedUnimplementedEmployeeServiceServer()
+}
+
+func RegisterEmployeeServiceServer(s grpc.ServiceRegistrar, srv EmployeeServiceServer) {
+	// If the following call panics, it indicates UnimplementedEmployeeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmployeeService_ServiceDesc, srv)
+}
+
+func _EmployeeService_CreateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).CreateEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_CreateEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).CreateEmployee(ctx, req.(*CreateEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_CreateOrUpdateEmployeeByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrUpdateEmployeeByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).CreateOrUpdateEmployeeByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_CreateOrUpdateEmployeeByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).CreateOrUpdateEmployeeByEmail(ctx, req.(*CreateOrUpdateEmployeeByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_UpdateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).UpdateEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_UpdateEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).UpdateEmployee(ctx, req.(*UpdateEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_DeleteEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).DeleteEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_DeleteEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).DeleteEmployee(ctx, req.(*DeleteEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ListEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListEmployees(ctx, req.(*ListEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_CountEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).CountEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_CountEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).CountEmployees(ctx, req.(*CountEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetEmployee(ctx, req.(*GetEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetEmployeeByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeeByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetEmployeeByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetEmployeeByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetEmployeeByEmail(ctx, req.(*GetEmployeeByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetEmployeeByExternalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeeByExternalIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetEmployeeByExternalID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetEmployeeByExternalID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetEmployeeByExternalID(ctx, req.(*GetEmployeeByExternalIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ExportEmployeeData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEmployeeDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ExportEmployeeData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ExportEmployeeData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ExportEmployeeData(ctx, req.(*ExportEmployeeDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_EmployeeExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployeeExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).EmployeeExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_EmployeeExists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).EmployeeExists(ctx, req.(*EmployeeExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_MergeEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).MergeEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_MergeEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).MergeEmployees(ctx, req.(*MergeEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_MergeEmployeesById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeEmployeesByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).MergeEmployeesById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_MergeEmployeesById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).MergeEmployeesById(ctx, req.(*MergeEmployeesByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_UnmergeEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmergeEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).UnmergeEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_UnmergeEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).UnmergeEmployees(ctx, req.(*UnmergeEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ListMergeApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMergeApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListMergeApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListMergeApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListMergeApprovals(ctx, req.(*ListMergeApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ApproveMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ApproveMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ApproveMerge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ApproveMerge(ctx, req.(*ApproveMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_RejectMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RejectMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RejectMerge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RejectMerge(ctx, req.(*RejectMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ResolveMergedEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveMergedEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ResolveMergedEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ResolveMergedEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ResolveMergedEmployee(ctx, req.(*ResolveMergedEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_FindDuplicateCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicateCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).FindDuplicateCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_FindDuplicateCandidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).FindDuplicateCandidates(ctx, req.(*FindDuplicateCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_DiffEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).DiffEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_DiffEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).DiffEmployees(ctx, req.(*DiffEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_WatchEmployees_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEmployeesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EmployeeServiceServer).WatchEmployees(m, &grpc.GenericServerStream[WatchEmployeesRequest, WatchEmployeesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_WatchEmployeesServer = grpc.ServerStreamingServer[WatchEmployeesResponse]
+
+func _EmployeeService_ListPendingEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListPendingEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListPendingEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListPendingEmployees(ctx, req.(*ListPendingEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ApproveEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ApproveEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ApproveEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ApproveEmployee(ctx, req.(*ApproveEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_RejectEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RejectEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RejectEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RejectEmployee(ctx, req.(*RejectEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ListScheduledChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListScheduledChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListScheduledChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListScheduledChanges(ctx, req.(*ListScheduledChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_CancelScheduledChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).CancelScheduledChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_CancelScheduledChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).CancelScheduledChange(ctx, req.(*CancelScheduledChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ListDirectReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDirectReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListDirectReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListDirectReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListDirectReports(ctx, req.(*ListDirectReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetManagementChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetManagementChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetManagementChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetManagementChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetManagementChain(ctx, req.(*GetManagementChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *employeeServiceClient) ListEmployees(ctx context.Context, in *ListEmployeesRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListEmployeesResponse)
-	err := c.cc.Invoke(ctx, EmployeeService_ListEmployees_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _EmployeeService_ListEmploymentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmploymentHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListEmploymentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListEmploymentHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListEmploymentHistory(ctx, req.(*ListEmploymentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *employeeServiceClient) GetEmployee(ctx context.Context, in *GetEmployeeRequest, opts ...grpc.CallOption) (*GetEmployeeResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetEmployeeResponse)
-	err := c.cc.Invoke(ctx, EmployeeService_GetEmployee_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _EmployeeService_AddTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTagRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).AddTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_AddTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).AddTag(ctx, req.(*AddTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *employeeServiceClient) GetEmployeeByEmail(ctx context.Context, in *GetEmployeeByEmailRequest, opts ...grpc.CallOption) (*GetEmployeeByEmailResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetEmployeeByEmailResponse)
-	err := c.cc.Invoke(ctx, EmployeeService_GetEmployeeByEmail_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _EmployeeService_RemoveTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTagRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RemoveTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RemoveTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RemoveTag(ctx, req.(*RemoveTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *employeeServiceClient) MergeEmployees(ctx context.Context, in *MergeEmployeesRequest, opts ...grpc.CallOption) (*MergeEmployeesResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MergeEmployeesResponse)
-	err := c.cc.Invoke(ctx, EmployeeService_MergeEmployees_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _EmployeeService_AddSecondaryEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSecondaryEmailRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// EmployeeServiceServer is the server API for EmployeeService service.
-// All implementations must embed UnimplementedEmployeeServiceServer
-// for forward compatibility.
-//
-// The employee service definition.
-type EmployeeServiceServer interface {
-	// Creates a new employee
-	CreateEmployee(context.Context, *CreateEmployeeRequest) (*CreateEmployeeResponse, error)
-	// Updates an existing employee
-	UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*UpdateEmployeeResponse, error)
-	// Deletes an employee
-	DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error)
-	// Lists employees with pagination and filtering
-	// Use query parameters: ?page=1&page_size=20&email=...
-	ListEmployees(context.Context, *ListEmployeesRequest) (*ListEmployeesResponse, error)
-	// Gets an employee by ID
-	GetEmployee(context.Context, *GetEmployeeRequest) (*GetEmployeeResponse, error)
-	// Gets an employee by email (deprecated - use ListEmployees with email param)
-	GetEmployeeByEmail(context.Context, *GetEmployeeByEmailRequest) (*GetEmployeeByEmailResponse, error)
-	// Merges two employees by email
-	MergeEmployees(context.Context, *MergeEmployeesRequest) (*MergeEmployeesResponse, error)
-	mustEmbedUnimplementedEmployeeServiceServer()
-}
-
-// UnimplementedEmployeeServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedEmployeeServiceServer struct{}
-
-func (UnimplementedEmployeeServiceServer) CreateEmployee(context.Context, *CreateEmployeeRequest) (*CreateEmployeeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateEmployee not implemented")
-}
-func (UnimplementedEmployeeServiceServer) UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*UpdateEmployeeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateEmployee not implemented")
-}
-func (UnimplementedEmployeeServiceServer) DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteEmployee not implemented")
-}
-func (UnimplementedEmployeeServiceServer) ListEmployees(context.Context, *ListEmployeesRequest) (*ListEmployeesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListEmployees not implemented")
-}
-func (UnimplementedEmployeeServiceServer) GetEmployee(context.Context, *GetEmployeeRequest) (*GetEmployeeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetEmployee not implemented")
-}
-func (UnimplementedEmployeeServiceServer) GetEmployeeByEmail(context.Context, *GetEmployeeByEmailRequest) (*GetEmployeeByEmailResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetEmployeeByEmail not implemented")
-}
-func (UnimplementedEmployeeServiceServer) MergeEmployees(context.Context, *MergeEmployeesRequest) (*MergeEmployeesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method MergeEmployees not implemented")
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).AddSecondaryEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_AddSecondaryEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).AddSecondaryEmail(ctx, req.(*AddSecondaryEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEmployeeServiceServer) mustEmbedUnimplementedEmployeeServiceServer() {}
-func (UnimplementedEmployeeServiceServer) testEmbeddedByValue()                         {}
 
-// UnsafeEmployeeServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to EmployeeServiceServer will
-// result in compilation errors.
-type UnsafeEmployeeServiceServer interface {
-	mustEmbedUnimplementedEmployeeServiceServer()
+func _EmployeeService_RemoveSecondaryEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSecondaryEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RemoveSecondaryEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RemoveSecondaryEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RemoveSecondaryEmail(ctx, req.(*RemoveSecondaryEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterEmployeeServiceServer(s grpc.ServiceRegistrar, srv EmployeeServiceServer) {
-	// If the following call panics, it indicates UnimplementedEmployeeServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _EmployeeService_SetPrimaryEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&EmployeeService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).SetPrimaryEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_SetPrimaryEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).SetPrimaryEmail(ctx, req.(*SetPrimaryEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_CreateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateEmployeeRequest)
+func _EmployeeService_ListEmployeesAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployeesAsOfRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).CreateEmployee(ctx, in)
+		return srv.(EmployeeServiceServer).ListEmployeesAsOf(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_CreateEmployee_FullMethodName,
+		FullMethod: EmployeeService_ListEmployeesAsOf_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).CreateEmployee(ctx, req.(*CreateEmployeeRequest))
+		return srv.(EmployeeServiceServer).ListEmployeesAsOf(ctx, req.(*ListEmployeesAsOfRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_UpdateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateEmployeeRequest)
+func _EmployeeService_UploadEmployeePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadEmployeePhotoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).UpdateEmployee(ctx, in)
+		return srv.(EmployeeServiceServer).UploadEmployeePhoto(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_UpdateEmployee_FullMethodName,
+		FullMethod: EmployeeService_UploadEmployeePhoto_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).UpdateEmployee(ctx, req.(*UpdateEmployeeRequest))
+		return srv.(EmployeeServiceServer).UploadEmployeePhoto(ctx, req.(*UploadEmployeePhotoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_DeleteEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteEmployeeRequest)
+func _EmployeeService_GetEmployeePhotoURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeePhotoURLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).DeleteEmployee(ctx, in)
+		return srv.(EmployeeServiceServer).GetEmployeePhotoURL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_DeleteEmployee_FullMethodName,
+		FullMethod: EmployeeService_GetEmployeePhotoURL_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).DeleteEmployee(ctx, req.(*DeleteEmployeeRequest))
+		return srv.(EmployeeServiceServer).GetEmployeePhotoURL(ctx, req.(*GetEmployeePhotoURLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_ListEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListEmployeesRequest)
+func _EmployeeService_DeactivateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateEmployeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).ListEmployees(ctx, in)
+		return srv.(EmployeeServiceServer).DeactivateEmployee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_ListEmployees_FullMethodName,
+		FullMethod: EmployeeService_DeactivateEmployee_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).ListEmployees(ctx, req.(*ListEmployeesRequest))
+		return srv.(EmployeeServiceServer).DeactivateEmployee(ctx, req.(*DeactivateEmployeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_GetEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEmployeeRequest)
+func _EmployeeService_ReactivateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateEmployeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).GetEmployee(ctx, in)
+		return srv.(EmployeeServiceServer).ReactivateEmployee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_GetEmployee_FullMethodName,
+		FullMethod: EmployeeService_ReactivateEmployee_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).GetEmployee(ctx, req.(*GetEmployeeRequest))
+		return srv.(EmployeeServiceServer).ReactivateEmployee(ctx, req.(*ReactivateEmployeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_GetEmployeeByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEmployeeByEmailRequest)
+func _EmployeeService_ReportEmailBounces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportEmailBouncesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).GetEmployeeByEmail(ctx, in)
+		return srv.(EmployeeServiceServer).ReportEmailBounces(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_GetEmployeeByEmail_FullMethodName,
+		FullMethod: EmployeeService_ReportEmailBounces_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).GetEmployeeByEmail(ctx, req.(*GetEmployeeByEmailRequest))
+		return srv.(EmployeeServiceServer).ReportEmailBounces(ctx, req.(*ReportEmailBouncesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_MergeEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MergeEmployeesRequest)
+func _EmployeeService_ValidateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateEmployeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).MergeEmployees(ctx, in)
+		return srv.(EmployeeServiceServer).ValidateEmployee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_MergeEmployees_FullMethodName,
+		FullMethod: EmployeeService_ValidateEmployee_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).MergeEmployees(ctx, req.(*MergeEmployeesRequest))
+		return srv.(EmployeeServiceServer).ValidateEmployee(ctx, req.(*ValidateEmployeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -339,6 +1631,10 @@ var EmployeeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateEmployee",
 			Handler:    _EmployeeService_CreateEmployee_Handler,
 		},
+		{
+			MethodName: "CreateOrUpdateEmployeeByEmail",
+			Handler:    _EmployeeService_CreateOrUpdateEmployeeByEmail_Handler,
+		},
 		{
 			MethodName: "UpdateEmployee",
 			Handler:    _EmployeeService_UpdateEmployee_Handler,
@@ -351,6 +1647,10 @@ var EmployeeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEmployees",
 			Handler:    _EmployeeService_ListEmployees_Handler,
 		},
+		{
+			MethodName: "CountEmployees",
+			Handler:    _EmployeeService_CountEmployees_Handler,
+		},
 		{
 			MethodName: "GetEmployee",
 			Handler:    _EmployeeService_GetEmployee_Handler,
@@ -359,11 +1659,141 @@ var EmployeeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEmployeeByEmail",
 			Handler:    _EmployeeService_GetEmployeeByEmail_Handler,
 		},
+		{
+			MethodName: "GetEmployeeByExternalID",
+			Handler:    _EmployeeService_GetEmployeeByExternalID_Handler,
+		},
+		{
+			MethodName: "ExportEmployeeData",
+			Handler:    _EmployeeService_ExportEmployeeData_Handler,
+		},
+		{
+			MethodName: "EmployeeExists",
+			Handler:    _EmployeeService_EmployeeExists_Handler,
+		},
 		{
 			MethodName: "MergeEmployees",
 			Handler:    _EmployeeService_MergeEmployees_Handler,
 		},
+		{
+			MethodName: "MergeEmployeesById",
+			Handler:    _EmployeeService_MergeEmployeesById_Handler,
+		},
+		{
+			MethodName: "UnmergeEmployees",
+			Handler:    _EmployeeService_UnmergeEmployees_Handler,
+		},
+		{
+			MethodName: "ListMergeApprovals",
+			Handler:    _EmployeeService_ListMergeApprovals_Handler,
+		},
+		{
+			MethodName: "ApproveMerge",
+			Handler:    _EmployeeService_ApproveMerge_Handler,
+		},
+		{
+			MethodName: "RejectMerge",
+			Handler:    _EmployeeService_RejectMerge_Handler,
+		},
+		{
+			MethodName: "ResolveMergedEmployee",
+			Handler:    _EmployeeService_ResolveMergedEmployee_Handler,
+		},
+		{
+			MethodName: "FindDuplicateCandidates",
+			Handler:    _EmployeeService_FindDuplicateCandidates_Handler,
+		},
+		{
+			MethodName: "DiffEmployees",
+			Handler:    _EmployeeService_DiffEmployees_Handler,
+		},
+		{
+			MethodName: "ListPendingEmployees",
+			Handler:    _EmployeeService_ListPendingEmployees_Handler,
+		},
+		{
+			MethodName: "ApproveEmployee",
+			Handler:    _EmployeeService_ApproveEmployee_Handler,
+		},
+		{
+			MethodName: "RejectEmployee",
+			Handler:    _EmployeeService_RejectEmployee_Handler,
+		},
+		{
+			MethodName: "ListScheduledChanges",
+			Handler:    _EmployeeService_ListScheduledChanges_Handler,
+		},
+		{
+			MethodName: "CancelScheduledChange",
+			Handler:    _EmployeeService_CancelScheduledChange_Handler,
+		},
+		{
+			MethodName: "ListDirectReports",
+			Handler:    _EmployeeService_ListDirectReports_Handler,
+		},
+		{
+			MethodName: "GetManagementChain",
+			Handler:    _EmployeeService_GetManagementChain_Handler,
+		},
+		{
+			MethodName: "ListEmploymentHistory",
+			Handler:    _EmployeeService_ListEmploymentHistory_Handler,
+		},
+		{
+			MethodName: "AddTag",
+			Handler:    _EmployeeService_AddTag_Handler,
+		},
+		{
+			MethodName: "RemoveTag",
+			Handler:    _EmployeeService_RemoveTag_Handler,
+		},
+		{
+			MethodName: "AddSecondaryEmail",
+			Handler:    _EmployeeService_AddSecondaryEmail_Handler,
+		},
+		{
+			MethodName: "RemoveSecondaryEmail",
+			Handler:    _EmployeeService_RemoveSecondaryEmail_Handler,
+		},
+		{
+			MethodName: "SetPrimaryEmail",
+			Handler:    _EmployeeService_SetPrimaryEmail_Handler,
+		},
+		{
+			MethodName: "ListEmployeesAsOf",
+			Handler:    _EmployeeService_ListEmployeesAsOf_Handler,
+		},
+		{
+			MethodName: "UploadEmployeePhoto",
+			Handler:    _EmployeeService_UploadEmployeePhoto_Handler,
+		},
+		{
+			MethodName: "GetEmployeePhotoURL",
+			Handler:    _EmployeeService_GetEmployeePhotoURL_Handler,
+		},
+		{
+			MethodName: "DeactivateEmployee",
+			Handler:    _EmployeeService_DeactivateEmployee_Handler,
+		},
+		{
+			MethodName: "ReactivateEmployee",
+			Handler:    _EmployeeService_ReactivateEmployee_Handler,
+		},
+		{
+			MethodName: "ReportEmailBounces",
+			Handler:    _EmployeeService_ReportEmailBounces_Handler,
+		},
+		{
+			MethodName: "ValidateEmployee",
+			Handler:    _EmployeeService_ValidateEmployee_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEmployees",
+			Handler:       _EmployeeService_WatchEmployees_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "employee/v1/employee.proto",
 }
@@ -19,41 +19,202 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationEmployeeServiceAddSecondaryEmail = "/employee.v1.EmployeeService/AddSecondaryEmail"
+const OperationEmployeeServiceAddTag = "/employee.v1.EmployeeService/AddTag"
+const OperationEmployeeServiceApproveEmployee = "/employee.v1.EmployeeService/ApproveEmployee"
+const OperationEmployeeServiceApproveMerge = "/employee.v1.EmployeeService/ApproveMerge"
+const OperationEmployeeServiceCancelScheduledChange = "/employee.v1.EmployeeService/CancelScheduledChange"
+const OperationEmployeeServiceCountEmployees = "/employee.v1.EmployeeService/CountEmployees"
 const OperationEmployeeServiceCreateEmployee = "/employee.v1.EmployeeService/CreateEmployee"
+const OperationEmployeeServiceCreateOrUpdateEmployeeByEmail = "/employee.v1.EmployeeService/CreateOrUpdateEmployeeByEmail"
+const OperationEmployeeServiceDeactivateEmployee = "/employee.v1.EmployeeService/DeactivateEmployee"
 const OperationEmployeeServiceDeleteEmployee = "/employee.v1.EmployeeService/DeleteEmployee"
+const OperationEmployeeServiceDiffEmployees = "/employee.v1.EmployeeService/DiffEmployees"
+const OperationEmployeeServiceEmployeeExists = "/employee.v1.EmployeeService/EmployeeExists"
+const OperationEmployeeServiceExportEmployeeData = "/employee.v1.EmployeeService/ExportEmployeeData"
+const OperationEmployeeServiceFindDuplicateCandidates = "/employee.v1.EmployeeService/FindDuplicateCandidates"
 const OperationEmployeeServiceGetEmployee = "/employee.v1.EmployeeService/GetEmployee"
 const OperationEmployeeServiceGetEmployeeByEmail = "/employee.v1.EmployeeService/GetEmployeeByEmail"
+const OperationEmployeeServiceGetEmployeeByExternalID = "/employee.v1.EmployeeService/GetEmployeeByExternalID"
+const OperationEmployeeServiceGetEmployeePhotoURL = "/employee.v1.EmployeeService/GetEmployeePhotoURL"
+const OperationEmployeeServiceGetManagementChain = "/employee.v1.EmployeeService/GetManagementChain"
+const OperationEmployeeServiceListDirectReports = "/employee.v1.EmployeeService/ListDirectReports"
 const OperationEmployeeServiceListEmployees = "/employee.v1.EmployeeService/ListEmployees"
+const OperationEmployeeServiceListEmployeesAsOf = "/employee.v1.EmployeeService/ListEmployeesAsOf"
+const OperationEmployeeServiceListEmploymentHistory = "/employee.v1.EmployeeService/ListEmploymentHistory"
+const OperationEmployeeServiceListMergeApprovals = "/employee.v1.EmployeeService/ListMergeApprovals"
+const OperationEmployeeServiceListPendingEmployees = "/employee.v1.EmployeeService/ListPendingEmployees"
+const OperationEmployeeServiceListScheduledChanges = "/employee.v1.EmployeeService/ListScheduledChanges"
 const OperationEmployeeServiceMergeEmployees = "/employee.v1.EmployeeService/MergeEmployees"
+const OperationEmployeeServiceMergeEmployeesById = "/employee.v1.EmployeeService/MergeEmployeesById"
+const OperationEmployeeServiceReactivateEmployee = "/employee.v1.EmployeeService/ReactivateEmployee"
+const OperationEmployeeServiceRejectEmployee = "/employee.v1.EmployeeService/RejectEmployee"
+const OperationEmployeeServiceRejectMerge = "/employee.v1.EmployeeService/RejectMerge"
+const OperationEmployeeServiceRemoveSecondaryEmail = "/employee.v1.EmployeeService/RemoveSecondaryEmail"
+const OperationEmployeeServiceRemoveTag = "/employee.v1.EmployeeService/RemoveTag"
+const OperationEmployeeServiceReportEmailBounces = "/employee.v1.EmployeeService/ReportEmailBounces"
+const OperationEmployeeServiceResolveMergedEmployee = "/employee.v1.EmployeeService/ResolveMergedEmployee"
+const OperationEmployeeServiceSetPrimaryEmail = "/employee.v1.EmployeeService/SetPrimaryEmail"
+const OperationEmployeeServiceUnmergeEmployees = "/employee.v1.EmployeeService/UnmergeEmployees"
 const OperationEmployeeServiceUpdateEmployee = "/employee.v1.EmployeeService/UpdateEmployee"
+const OperationEmployeeServiceUploadEmployeePhoto = "/employee.v1.EmployeeService/UploadEmployeePhoto"
+const OperationEmployeeServiceValidateEmployee = "/employee.v1.EmployeeService/ValidateEmployee"
 
 type EmployeeServiceHTTPServer interface {
+	// AddSecondaryEmail Adds an email to an employee as a secondary email; adding an email the
+	// employee already has is a no-op
+	AddSecondaryEmail(context.Context, *AddSecondaryEmailRequest) (*AddSecondaryEmailResponse, error)
+	// AddTag Tags an employee, e.g. contractor or remote; tagging an employee again
+	// with the same tag is a no-op
+	AddTag(context.Context, *AddTagRequest) (*AddTagResponse, error)
+	// ApproveEmployee Approves an employee pending review, making it visible and publishing
+	// its created event
+	ApproveEmployee(context.Context, *ApproveEmployeeRequest) (*ApproveEmployeeResponse, error)
+	// ApproveMerge Approves a merge awaiting approval, performing it. The merge must be
+	// approved by another user than the one requesting it, acting as
+	// themselves rather than impersonated.
+	ApproveMerge(context.Context, *ApproveMergeRequest) (*ApproveMergeResponse, error)
+	// CancelScheduledChange Cancels a scheduled change that has not been applied yet
+	CancelScheduledChange(context.Context, *CancelScheduledChangeRequest) (*CancelScheduledChangeResponse, error)
+	// CountEmployees Counts the employees ListEmployees would list, without returning them
+	CountEmployees(context.Context, *CountEmployeesRequest) (*CountEmployeesResponse, error)
 	// CreateEmployee Creates a new employee
 	CreateEmployee(context.Context, *CreateEmployeeRequest) (*CreateEmployeeResponse, error)
+	// CreateOrUpdateEmployeeByEmail Updates an existing employee
+	// Creates an employee owning email, or updates the employee that already
+	// owns it, atomically; meant for idempotent syncs from an HRIS
+	CreateOrUpdateEmployeeByEmail(context.Context, *CreateOrUpdateEmployeeByEmailRequest) (*CreateOrUpdateEmployeeByEmailResponse, error)
+	// DeactivateEmployee Deactivates an employee, e.g. when offboarded. The employee is kept and
+	// stays readable, but is left out of listings unless active_only is false.
+	DeactivateEmployee(context.Context, *DeactivateEmployeeRequest) (*DeactivateEmployeeResponse, error)
 	// DeleteEmployee Deletes an employee
 	DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error)
+	// DiffEmployees Compares two employees field by field, with their emails and a summary
+	// of their histories, to decide on a merge
+	DiffEmployees(context.Context, *DiffEmployeesRequest) (*DiffEmployeesResponse, error)
+	// EmployeeExists Reports whether an email belongs to an employee, without returning the
+	// employee. Emails of employees pending review count as taken.
+	EmployeeExists(context.Context, *EmployeeExistsRequest) (*EmployeeExistsResponse, error)
+	// ExportEmployeeData Exports everything stored about an employee as one document, to answer
+	// data-subject access requests: the employee, its audit history and the
+	// webhook events sent about it
+	ExportEmployeeData(context.Context, *ExportEmployeeDataRequest) (*ExportEmployeeDataResponse, error)
+	// FindDuplicateCandidates Scans the tenant for likely duplicate employees and returns scored
+	// candidate pairs to merge
+	FindDuplicateCandidates(context.Context, *FindDuplicateCandidatesRequest) (*FindDuplicateCandidatesResponse, error)
 	// GetEmployee Gets an employee by ID
 	GetEmployee(context.Context, *GetEmployeeRequest) (*GetEmployeeResponse, error)
 	// GetEmployeeByEmail Gets an employee by email (deprecated - use ListEmployees with email param)
 	GetEmployeeByEmail(context.Context, *GetEmployeeByEmailRequest) (*GetEmployeeByEmailResponse, error)
+	// GetEmployeeByExternalID Gets the employee with an ID in an external system such as an HRIS
+	GetEmployeeByExternalID(context.Context, *GetEmployeeByExternalIDRequest) (*GetEmployeeByExternalIDResponse, error)
+	// GetEmployeePhotoURL Returns a pre-signed URL the photo of an employee can be downloaded
+	// from until it expires
+	GetEmployeePhotoURL(context.Context, *GetEmployeePhotoURLRequest) (*GetEmployeePhotoURLResponse, error)
+	// GetManagementChain Returns the managers above an employee, its direct manager first and
+	// the top of the hierarchy last
+	GetManagementChain(context.Context, *GetManagementChainRequest) (*GetManagementChainResponse, error)
+	// ListDirectReports Lists the employees reporting directly to a manager, newest first
+	ListDirectReports(context.Context, *ListDirectReportsRequest) (*ListEmployeesResponse, error)
 	// ListEmployees Lists employees with pagination and filtering
 	// Use query parameters: ?page=1&page_size=20&email=...
 	ListEmployees(context.Context, *ListEmployeesRequest) (*ListEmployeesResponse, error)
-	// MergeEmployees Merges two employees by email
+	// ListEmployeesAsOf Lists the employees as they were at a point in time, e.g. for the
+	// headcount on Jan 1, reconstructed from the audit log
+	ListEmployeesAsOf(context.Context, *ListEmployeesAsOfRequest) (*ListEmployeesAsOfResponse, error)
+	// ListEmploymentHistory Lists the jobs an employee held, the current one first. A job is the
+	// title, department and manager of the employee over a period; a new one
+	// starts whenever any of them changes.
+	ListEmploymentHistory(context.Context, *ListEmploymentHistoryRequest) (*ListEmploymentHistoryResponse, error)
+	// ListMergeApprovals Lists the merges awaiting the approval of a second user
+	ListMergeApprovals(context.Context, *ListMergeApprovalsRequest) (*ListMergeApprovalsResponse, error)
+	// ListPendingEmployees Lists the employees pending review, oldest first
+	ListPendingEmployees(context.Context, *ListPendingEmployeesRequest) (*ListEmployeesResponse, error)
+	// ListScheduledChanges Lists the creates and updates scheduled with effective_at, next due first
+	ListScheduledChanges(context.Context, *ListScheduledChangesRequest) (*ListScheduledChangesResponse, error)
+	// MergeEmployees Merges two employees by email. With validate_only the merge is only
+	// previewed.
 	MergeEmployees(context.Context, *MergeEmployeesRequest) (*MergeEmployeesResponse, error)
-	// UpdateEmployee Updates an existing employee
+	// MergeEmployeesById Merges two employees by ID. With validate_only the merge is only
+	// previewed.
+	MergeEmployeesById(context.Context, *MergeEmployeesByIdRequest) (*MergeEmployeesResponse, error)
+	// ReactivateEmployee Reactivates a deactivated employee
+	ReactivateEmployee(context.Context, *ReactivateEmployeeRequest) (*ReactivateEmployeeResponse, error)
+	// RejectEmployee Rejects an employee pending review, deleting it
+	RejectEmployee(context.Context, *RejectEmployeeRequest) (*RejectEmployeeResponse, error)
+	// RejectMerge Rejects a merge awaiting approval; the employees are left unchanged
+	RejectMerge(context.Context, *RejectMergeRequest) (*RejectMergeResponse, error)
+	// RemoveSecondaryEmail Removes a secondary email from an employee; removing an email the
+	// employee does not have is a no-op. The primary email cannot be removed.
+	RemoveSecondaryEmail(context.Context, *RemoveSecondaryEmailRequest) (*RemoveSecondaryEmailResponse, error)
+	// RemoveTag Removes a tag from an employee; removing a tag the employee does not
+	// have is a no-op
+	RemoveTag(context.Context, *RemoveTagRequest) (*RemoveTagResponse, error)
+	// ReportEmailBounces Reports bounces and complaints of the mail provider, marking the emails
+	// undeliverable. Emails of no employee are ignored.
+	ReportEmailBounces(context.Context, *ReportEmailBouncesRequest) (*ReportEmailBouncesResponse, error)
+	// ResolveMergedEmployee Follows the merges an employee ID was merged away by to the surviving
+	// employee, for systems still keyed by the ID of a secondary employee
+	ResolveMergedEmployee(context.Context, *ResolveMergedEmployeeRequest) (*ResolveMergedEmployeeResponse, error)
+	// SetPrimaryEmail Makes one of an employee's emails its primary email; the previous
+	// primary email stays one of its emails. Emails marked undeliverable
+	// cannot become primary (EMAIL_UNDELIVERABLE).
+	SetPrimaryEmail(context.Context, *SetPrimaryEmailRequest) (*SetPrimaryEmailResponse, error)
+	// UnmergeEmployees Undoes a merge, recreating the secondary employee and moving its emails
+	// back from the primary employee
+	UnmergeEmployees(context.Context, *UnmergeEmployeesRequest) (*UnmergeEmployeesResponse, error)
 	UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*UpdateEmployeeResponse, error)
+	// UploadEmployeePhoto Uploads the photo of an employee, a JPEG, PNG or WebP image, replacing
+	// the previous one
+	UploadEmployeePhoto(context.Context, *UploadEmployeePhotoRequest) (*UploadEmployeePhotoResponse, error)
+	// ValidateEmployee Validates an employee payload as CreateEmployee would, or as an update
+	// of an existing employee, without storing anything. Every problem is
+	// returned at once: violations would fail the write, warnings would not.
+	ValidateEmployee(context.Context, *ValidateEmployeeRequest) (*ValidateEmployeeResponse, error)
 }
 
 func RegisterEmployeeServiceHTTPServer(s *http.Server, srv EmployeeServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/api/v1/employees", _EmployeeService_CreateEmployee0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees:upsert", _EmployeeService_CreateOrUpdateEmployeeByEmail0_HTTP_Handler(srv))
 	r.PUT("/api/v1/employees/{id}", _EmployeeService_UpdateEmployee0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/employees/{id}", _EmployeeService_DeleteEmployee0_HTTP_Handler(srv))
 	r.GET("/api/v1/employees", _EmployeeService_ListEmployees0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees:count", _EmployeeService_CountEmployees0_HTTP_Handler(srv))
 	r.GET("/api/v1/employees/{id}", _EmployeeService_GetEmployee0_HTTP_Handler(srv))
 	r.GET("/api/v1/employees:byEmail", _EmployeeService_GetEmployeeByEmail0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees:byExternalId", _EmployeeService_GetEmployeeByExternalID0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees/{id}/data", _EmployeeService_ExportEmployeeData0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees:exists", _EmployeeService_EmployeeExists0_HTTP_Handler(srv))
 	r.POST("/api/v1/employees/merge", _EmployeeService_MergeEmployees0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees/merge:byId", _EmployeeService_MergeEmployeesById0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees/unmerge", _EmployeeService_UnmergeEmployees0_HTTP_Handler(srv))
+	r.GET("/api/v1/merge-approvals", _EmployeeService_ListMergeApprovals0_HTTP_Handler(srv))
+	r.POST("/api/v1/merge-approvals/{id}:approve", _EmployeeService_ApproveMerge0_HTTP_Handler(srv))
+	r.POST("/api/v1/merge-approvals/{id}:reject", _EmployeeService_RejectMerge0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees/{id}/resolve", _EmployeeService_ResolveMergedEmployee0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees/duplicates", _EmployeeService_FindDuplicateCandidates0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees:diff", _EmployeeService_DiffEmployees0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees/pending", _EmployeeService_ListPendingEmployees0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees/{id}:approve", _EmployeeService_ApproveEmployee0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees/{id}:reject", _EmployeeService_RejectEmployee0_HTTP_Handler(srv))
+	r.GET("/api/v1/scheduled-changes", _EmployeeService_ListScheduledChanges0_HTTP_Handler(srv))
+	r.POST("/api/v1/scheduled-changes/{id}:cancel", _EmployeeService_CancelScheduledChange0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees/{manager_id}/reports", _EmployeeService_ListDirectReports0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees/{employee_id}/managers", _EmployeeService_GetManagementChain0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees/{employee_id}/history", _EmployeeService_ListEmploymentHistory0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees/{id}/tags", _EmployeeService_AddTag0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/employees/{id}/tags/{tag}", _EmployeeService_RemoveTag0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees/{id}/emails", _EmployeeService_AddSecondaryEmail0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/employees/{id}/emails/{email}", _EmployeeService_RemoveSecondaryEmail0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees/{id}/primary-email", _EmployeeService_SetPrimaryEmail0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees:asOf", _EmployeeService_ListEmployeesAsOf0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees/{id}/photo", _EmployeeService_UploadEmployeePhoto0_HTTP_Handler(srv))
+	r.GET("/api/v1/employees/{id}/photo:url", _EmployeeService_GetEmployeePhotoURL0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees/{id}:deactivate", _EmployeeService_DeactivateEmployee0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees/{id}:reactivate", _EmployeeService_ReactivateEmployee0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees:report-bounces", _EmployeeService_ReportEmailBounces0_HTTP_Handler(srv))
+	r.POST("/api/v1/employees:validate", _EmployeeService_ValidateEmployee0_HTTP_Handler(srv))
 }
 
 func _EmployeeService_CreateEmployee0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
@@ -78,6 +239,28 @@ func _EmployeeService_CreateEmployee0_HTTP_Handler(srv EmployeeServiceHTTPServer
 	}
 }
 
+func _EmployeeService_CreateOrUpdateEmployeeByEmail0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateOrUpdateEmployeeByEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceCreateOrUpdateEmployeeByEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateOrUpdateEmployeeByEmail(ctx, req.(*CreateOrUpdateEmployeeByEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateOrUpdateEmployeeByEmailResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _EmployeeService_UpdateEmployee0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in UpdateEmployeeRequest
@@ -144,6 +327,25 @@ func _EmployeeService_ListEmployees0_HTTP_Handler(srv EmployeeServiceHTTPServer)
 	}
 }
 
+func _EmployeeService_CountEmployees0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CountEmployeesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceCountEmployees)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CountEmployees(ctx, req.(*CountEmployeesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CountEmployeesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _EmployeeService_GetEmployee0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetEmployeeRequest
@@ -185,6 +387,66 @@ func _EmployeeService_GetEmployeeByEmail0_HTTP_Handler(srv EmployeeServiceHTTPSe
 	}
 }
 
+func _EmployeeService_GetEmployeeByExternalID0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetEmployeeByExternalIDRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceGetEmployeeByExternalID)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetEmployeeByExternalID(ctx, req.(*GetEmployeeByExternalIDRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetEmployeeByExternalIDResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_ExportEmployeeData0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportEmployeeDataRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceExportEmployeeData)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportEmployeeData(ctx, req.(*ExportEmployeeDataRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportEmployeeDataResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_EmployeeExists0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EmployeeExistsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceEmployeeExists)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EmployeeExists(ctx, req.(*EmployeeExistsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EmployeeExistsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _EmployeeService_MergeEmployees0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in MergeEmployeesRequest
@@ -207,107 +469,1146 @@ func _EmployeeService_MergeEmployees0_HTTP_Handler(srv EmployeeServiceHTTPServer
 	}
 }
 
-type EmployeeServiceHTTPClient interface {
-	// CreateEmployee Creates a new employee
-	CreateEmployee(ctx context.Context, req *CreateEmployeeRequest, opts ...http.CallOption) (rsp *CreateEmployeeResponse, err error)
-	// DeleteEmployee Deletes an employee
-	DeleteEmployee(ctx context.Context, req *DeleteEmployeeRequest, opts ...http.CallOption) (rsp *DeleteEmployeeResponse, err error)
-	// GetEmployee Gets an employee by ID
-	GetEmployee(ctx context.Context, req *GetEmployeeRequest, opts ...http.CallOption) (rsp *GetEmployeeResponse, err error)
-	// GetEmployeeByEmail Gets an employee by email (deprecated - use ListEmployees with email param)
-	GetEmployeeByEmail(ctx context.Context, req *GetEmployeeByEmailRequest, opts ...http.CallOption) (rsp *GetEmployeeByEmailResponse, err error)
-	// ListEmployees Lists employees with pagination and filtering
-	// Use query parameters: ?page=1&page_size=20&email=...
-	ListEmployees(ctx context.Context, req *ListEmployeesRequest, opts ...http.CallOption) (rsp *ListEmployeesResponse, err error)
-	// MergeEmployees Merges two employees by email
-	MergeEmployees(ctx context.Context, req *MergeEmployeesRequest, opts ...http.CallOption) (rsp *MergeEmployeesResponse, err error)
-	// UpdateEmployee Updates an existing employee
-	UpdateEmployee(ctx context.Context, req *UpdateEmployeeRequest, opts ...http.CallOption) (rsp *UpdateEmployeeResponse, err error)
-}
-
-type EmployeeServiceHTTPClientImpl struct {
-	cc *http.Client
+func _EmployeeService_MergeEmployeesById0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MergeEmployeesByIdRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceMergeEmployeesById)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MergeEmployeesById(ctx, req.(*MergeEmployeesByIdRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MergeEmployeesResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewEmployeeServiceHTTPClient(client *http.Client) EmployeeServiceHTTPClient {
-	return &EmployeeServiceHTTPClientImpl{client}
+func _EmployeeService_UnmergeEmployees0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnmergeEmployeesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceUnmergeEmployees)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnmergeEmployees(ctx, req.(*UnmergeEmployeesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnmergeEmployeesResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
-// CreateEmployee Creates a new employee
-func (c *EmployeeServiceHTTPClientImpl) CreateEmployee(ctx context.Context, in *CreateEmployeeRequest, opts ...http.CallOption) (*CreateEmployeeResponse, error) {
-	var out CreateEmployeeResponse
-	pattern := "/api/v1/employees"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationEmployeeServiceCreateEmployee))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _EmployeeService_ListMergeApprovals0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMergeApprovalsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceListMergeApprovals)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMergeApprovals(ctx, req.(*ListMergeApprovalsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMergeApprovalsResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// DeleteEmployee Deletes an employee
-func (c *EmployeeServiceHTTPClientImpl) DeleteEmployee(ctx context.Context, in *DeleteEmployeeRequest, opts ...http.CallOption) (*DeleteEmployeeResponse, error) {
-	var out DeleteEmployeeResponse
-	pattern := "/api/v1/employees/{id}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationEmployeeServiceDeleteEmployee))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _EmployeeService_ApproveMerge0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApproveMergeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceApproveMerge)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApproveMerge(ctx, req.(*ApproveMergeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ApproveMergeResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// GetEmployee Gets an employee by ID
-func (c *EmployeeServiceHTTPClientImpl) GetEmployee(ctx context.Context, in *GetEmployeeRequest, opts ...http.CallOption) (*GetEmployeeResponse, error) {
-	var out GetEmployeeResponse
-	pattern := "/api/v1/employees/{id}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationEmployeeServiceGetEmployee))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _EmployeeService_RejectMerge0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RejectMergeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceRejectMerge)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RejectMerge(ctx, req.(*RejectMergeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RejectMergeResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// GetEmployeeByEmail Gets an employee by email (deprecated - use ListEmployees with email param)
-func (c *EmployeeServiceHTTPClientImpl) GetEmployeeByEmail(ctx context.Context, in *GetEmployeeByEmailRequest, opts ...http.CallOption) (*GetEmployeeByEmailResponse, error) {
-	var out GetEmployeeByEmailResponse
-	pattern := "/api/v1/employees:byEmail"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationEmployeeServiceGetEmployeeByEmail))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _EmployeeService_ResolveMergedEmployee0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResolveMergedEmployeeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceResolveMergedEmployee)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResolveMergedEmployee(ctx, req.(*ResolveMergedEmployeeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResolveMergedEmployeeResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// ListEmployees Lists employees with pagination and filtering
-// Use query parameters: ?page=1&page_size=20&email=...
-func (c *EmployeeServiceHTTPClientImpl) ListEmployees(ctx context.Context, in *ListEmployeesRequest, opts ...http.CallOption) (*ListEmployeesResponse, error) {
-	var out ListEmployeesResponse
-	pattern := "/api/v1/employees"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationEmployeeServiceListEmployees))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _EmployeeService_FindDuplicateCandidates0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FindDuplicateCandidatesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceFindDuplicateCandidates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FindDuplicateCandidates(ctx, req.(*FindDuplicateCandidatesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FindDuplicateCandidatesResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// MergeEmployees Merges two employees by email
-func (c *EmployeeServiceHTTPClientImpl) MergeEmployees(ctx context.Context, in *MergeEmployeesRequest, opts ...http.CallOption) (*MergeEmployeesResponse, error) {
-	var out MergeEmployeesResponse
-	pattern := "/api/v1/employees/merge"
+func _EmployeeService_DiffEmployees0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DiffEmployeesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceDiffEmployees)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DiffEmployees(ctx, req.(*DiffEmployeesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DiffEmployeesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_ListPendingEmployees0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPendingEmployeesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceListPendingEmployees)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPendingEmployees(ctx, req.(*ListPendingEmployeesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListEmployeesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_ApproveEmployee0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApproveEmployeeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceApproveEmployee)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApproveEmployee(ctx, req.(*ApproveEmployeeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ApproveEmployeeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_RejectEmployee0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RejectEmployeeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceRejectEmployee)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RejectEmployee(ctx, req.(*RejectEmployeeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RejectEmployeeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_ListScheduledChanges0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListScheduledChangesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceListScheduledChanges)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListScheduledChanges(ctx, req.(*ListScheduledChangesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListScheduledChangesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_CancelScheduledChange0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelScheduledChangeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceCancelScheduledChange)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelScheduledChange(ctx, req.(*CancelScheduledChangeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CancelScheduledChangeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_ListDirectReports0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDirectReportsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceListDirectReports)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDirectReports(ctx, req.(*ListDirectReportsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListEmployeesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_GetManagementChain0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetManagementChainRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceGetManagementChain)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetManagementChain(ctx, req.(*GetManagementChainRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetManagementChainResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_ListEmploymentHistory0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListEmploymentHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceListEmploymentHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListEmploymentHistory(ctx, req.(*ListEmploymentHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListEmploymentHistoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_AddTag0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddTagRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceAddTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddTag(ctx, req.(*AddTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddTagResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_RemoveTag0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveTagRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceRemoveTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveTag(ctx, req.(*RemoveTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveTagResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_AddSecondaryEmail0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddSecondaryEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceAddSecondaryEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddSecondaryEmail(ctx, req.(*AddSecondaryEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddSecondaryEmailResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_RemoveSecondaryEmail0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveSecondaryEmailRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceRemoveSecondaryEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveSecondaryEmail(ctx, req.(*RemoveSecondaryEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveSecondaryEmailResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_SetPrimaryEmail0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetPrimaryEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceSetPrimaryEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetPrimaryEmail(ctx, req.(*SetPrimaryEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetPrimaryEmailResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_ListEmployeesAsOf0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListEmployeesAsOfRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceListEmployeesAsOf)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListEmployeesAsOf(ctx, req.(*ListEmployeesAsOfRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListEmployeesAsOfResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_UploadEmployeePhoto0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UploadEmployeePhotoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceUploadEmployeePhoto)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UploadEmployeePhoto(ctx, req.(*UploadEmployeePhotoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UploadEmployeePhotoResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_GetEmployeePhotoURL0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetEmployeePhotoURLRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceGetEmployeePhotoURL)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetEmployeePhotoURL(ctx, req.(*GetEmployeePhotoURLRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetEmployeePhotoURLResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_DeactivateEmployee0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeactivateEmployeeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceDeactivateEmployee)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeactivateEmployee(ctx, req.(*DeactivateEmployeeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeactivateEmployeeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_ReactivateEmployee0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReactivateEmployeeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceReactivateEmployee)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReactivateEmployee(ctx, req.(*ReactivateEmployeeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReactivateEmployeeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_ReportEmailBounces0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReportEmailBouncesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceReportEmailBounces)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReportEmailBounces(ctx, req.(*ReportEmailBouncesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReportEmailBouncesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EmployeeService_ValidateEmployee0_HTTP_Handler(srv EmployeeServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ValidateEmployeeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeServiceValidateEmployee)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ValidateEmployee(ctx, req.(*ValidateEmployeeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ValidateEmployeeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type EmployeeServiceHTTPClient interface {
+	// AddSecondaryEmail Adds an email to an employee as a secondary email; adding an email the
+	// employee already has is a no-op
+	AddSecondaryEmail(ctx context.Context, req *AddSecondaryEmailRequest, opts ...http.CallOption) (rsp *AddSecondaryEmailResponse, err error)
+	// AddTag Tags an employee, e.g. contractor or remote; tagging an employee again
+	// with the same tag is a no-op
+	AddTag(ctx context.Context, req *AddTagRequest, opts ...http.CallOption) (rsp *AddTagResponse, err error)
+	// ApproveEmployee Approves an employee pending review, making it visible and publishing
+	// its created event
+	ApproveEmployee(ctx context.Context, req *ApproveEmployeeRequest, opts ...http.CallOption) (rsp *ApproveEmployeeResponse, err error)
+	// ApproveMerge Approves a merge awaiting approval, performing it. The merge must be
+	// approved by another user than the one requesting it, acting as
+	// themselves rather than impersonated.
+	ApproveMerge(ctx context.Context, req *ApproveMergeRequest, opts ...http.CallOption) (rsp *ApproveMergeResponse, err error)
+	// CancelScheduledChange Cancels a scheduled change that has not been applied yet
+	CancelScheduledChange(ctx context.Context, req *CancelScheduledChangeRequest, opts ...http.CallOption) (rsp *CancelScheduledChangeResponse, err error)
+	// CountEmployees Counts the employees ListEmployees would list, without returning them
+	CountEmployees(ctx context.Context, req *CountEmployeesRequest, opts ...http.CallOption) (rsp *CountEmployeesResponse, err error)
+	// CreateEmployee Creates a new employee
+	CreateEmployee(ctx context.Context, req *CreateEmployeeRequest, opts ...http.CallOption) (rsp *CreateEmployeeResponse, err error)
+	// CreateOrUpdateEmployeeByEmail Updates an existing employee
+	// Creates an employee owning email, or updates the employee that already
+	// owns it, atomically; meant for idempotent syncs from an HRIS
+	CreateOrUpdateEmployeeByEmail(ctx context.Context, req *CreateOrUpdateEmployeeByEmailRequest, opts ...http.CallOption) (rsp *CreateOrUpdateEmployeeByEmailResponse, err error)
+	// DeactivateEmployee Deactivates an employee, e.g. when offboarded. The employee is kept and
+	// stays readable, but is left out of listings unless active_only is false.
+	DeactivateEmployee(ctx context.Context, req *DeactivateEmployeeRequest, opts ...http.CallOption) (rsp *DeactivateEmployeeResponse, err error)
+	// DeleteEmployee Deletes an employee
+	DeleteEmployee(ctx context.Context, req *DeleteEmployeeRequest, opts ...http.CallOption) (rsp *DeleteEmployeeResponse, err error)
+	// DiffEmployees Compares two employees field by field, with their emails and a summary
+	// of their histories, to decide on a merge
+	DiffEmployees(ctx context.Context, req *DiffEmployeesRequest, opts ...http.CallOption) (rsp *DiffEmployeesResponse, err error)
+	// EmployeeExists Reports whether an email belongs to an employee, without returning the
+	// employee. Emails of employees pending review count as taken.
+	EmployeeExists(ctx context.Context, req *EmployeeExistsRequest, opts ...http.CallOption) (rsp *EmployeeExistsResponse, err error)
+	// ExportEmployeeData Exports everything stored about an employee as one document, to answer
+	// data-subject access requests: the employee, its audit history and the
+	// webhook events sent about it
+	ExportEmployeeData(ctx context.Context, req *ExportEmployeeDataRequest, opts ...http.CallOption) (rsp *ExportEmployeeDataResponse, err error)
+	// FindDuplicateCandidates Scans the tenant for likely duplicate employees and returns scored
+	// candidate pairs to merge
+	FindDuplicateCandidates(ctx context.Context, req *FindDuplicateCandidatesRequest, opts ...http.CallOption) (rsp *FindDuplicateCandidatesResponse, err error)
+	// GetEmployee Gets an employee by ID
+	GetEmployee(ctx context.Context, req *GetEmployeeRequest, opts ...http.CallOption) (rsp *GetEmployeeResponse, err error)
+	// GetEmployeeByEmail Gets an employee by email (deprecated - use ListEmployees with email param)
+	GetEmployeeByEmail(ctx context.Context, req *GetEmployeeByEmailRequest, opts ...http.CallOption) (rsp *GetEmployeeByEmailResponse, err error)
+	// GetEmployeeByExternalID Gets the employee with an ID in an external system such as an HRIS
+	GetEmployeeByExternalID(ctx context.Context, req *GetEmployeeByExternalIDRequest, opts ...http.CallOption) (rsp *GetEmployeeByExternalIDResponse, err error)
+	// GetEmployeePhotoURL Returns a pre-signed URL the photo of an employee can be downloaded
+	// from until it expires
+	GetEmployeePhotoURL(ctx context.Context, req *GetEmployeePhotoURLRequest, opts ...http.CallOption) (rsp *GetEmployeePhotoURLResponse, err error)
+	// GetManagementChain Returns the managers above an employee, its direct manager first and
+	// the top of the hierarchy last
+	GetManagementChain(ctx context.Context, req *GetManagementChainRequest, opts ...http.CallOption) (rsp *GetManagementChainResponse, err error)
+	// ListDirectReports Lists the employees reporting directly to a manager, newest first
+	ListDirectReports(ctx context.Context, req *ListDirectReportsRequest, opts ...http.CallOption) (rsp *ListEmployeesResponse, err error)
+	// ListEmployees Lists employees with pagination and filtering
+	// Use query parameters: ?page=1&page_size=20&email=...
+	ListEmployees(ctx context.Context, req *ListEmployeesRequest, opts ...http.CallOption) (rsp *ListEmployeesResponse, err error)
+	// ListEmployeesAsOf Lists the employees as they were at a point in time, e.g. for the
+	// headcount on Jan 1, reconstructed from the audit log
+	ListEmployeesAsOf(ctx context.Context, req *ListEmployeesAsOfRequest, opts ...http.CallOption) (rsp *ListEmployeesAsOfResponse, err error)
+	// ListEmploymentHistory Lists the jobs an employee held, the current one first. A job is the
+	// title, department and manager of the employee over a period; a new one
+	// starts whenever any of them changes.
+	ListEmploymentHistory(ctx context.Context, req *ListEmploymentHistoryRequest, opts ...http.CallOption) (rsp *ListEmploymentHistoryResponse, err error)
+	// ListMergeApprovals Lists the merges awaiting the approval of a second user
+	ListMergeApprovals(ctx context.Context, req *ListMergeApprovalsRequest, opts ...http.CallOption) (rsp *ListMergeApprovalsResponse, err error)
+	// ListPendingEmployees Lists the employees pending review, oldest first
+	ListPendingEmployees(ctx context.Context, req *ListPendingEmployeesRequest, opts ...http.CallOption) (rsp *ListEmployeesResponse, err error)
+	// ListScheduledChanges Lists the creates and updates scheduled with effective_at, next due first
+	ListScheduledChanges(ctx context.Context, req *ListScheduledChangesRequest, opts ...http.CallOption) (rsp *ListScheduledChangesResponse, err error)
+	// MergeEmployees Merges two employees by email. With validate_only the merge is only
+	// previewed.
+	MergeEmployees(ctx context.Context, req *MergeEmployeesRequest, opts ...http.CallOption) (rsp *MergeEmployeesResponse, err error)
+	// MergeEmployeesById Merges two employees by ID. With validate_only the merge is only
+	// previewed.
+	MergeEmployeesById(ctx context.Context, req *MergeEmployeesByIdRequest, opts ...http.CallOption) (rsp *MergeEmployeesResponse, err error)
+	// ReactivateEmployee Reactivates a deactivated employee
+	ReactivateEmployee(ctx context.Context, req *ReactivateEmployeeRequest, opts ...http.CallOption) (rsp *ReactivateEmployeeResponse, err error)
+	// RejectEmployee Rejects an employee pending review, deleting it
+	RejectEmployee(ctx context.Context, req *RejectEmployeeRequest, opts ...http.CallOption) (rsp *RejectEmployeeResponse, err error)
+	// RejectMerge Rejects a merge awaiting approval; the employees are left unchanged
+	RejectMerge(ctx context.Context, req *RejectMergeRequest, opts ...http.CallOption) (rsp *RejectMergeResponse, err error)
+	// RemoveSecondaryEmail Removes a secondary email from an employee; removing an email the
+	// employee does not have is a no-op. The primary email cannot be removed.
+	RemoveSecondaryEmail(ctx context.Context, req *RemoveSecondaryEmailRequest, opts ...http.CallOption) (rsp *RemoveSecondaryEmailResponse, err error)
+	// RemoveTag Removes a tag from an employee; removing a tag the employee does not
+	// have is a no-op
+	RemoveTag(ctx context.Context, req *RemoveTagRequest, opts ...http.CallOption) (rsp *RemoveTagResponse, err error)
+	// ReportEmailBounces Reports bounces and complaints of the mail provider, marking the emails
+	// undeliverable. Emails of no employee are ignored.
+	ReportEmailBounces(ctx context.Context, req *ReportEmailBouncesRequest, opts ...http.CallOption) (rsp *ReportEmailBouncesResponse, err error)
+	// ResolveMergedEmployee Follows the merges an employee ID was merged away by to the surviving
+	// employee, for systems still keyed by the ID of a secondary employee
+	ResolveMergedEmployee(ctx context.Context, req *ResolveMergedEmployeeRequest, opts ...http.CallOption) (rsp *ResolveMergedEmployeeResponse, err error)
+	// SetPrimaryEmail Makes one of an employee's emails its primary email; the previous
+	// primary email stays one of its emails. Emails marked undeliverable
+	// cannot become primary (EMAIL_UNDELIVERABLE).
+	SetPrimaryEmail(ctx context.Context, req *SetPrimaryEmailRequest, opts ...http.CallOption) (rsp *SetPrimaryEmailResponse, err error)
+	// UnmergeEmployees Undoes a merge, recreating the secondary employee and moving its emails
+	// back from the primary employee
+	UnmergeEmployees(ctx context.Context, req *UnmergeEmployeesRequest, opts ...http.CallOption) (rsp *UnmergeEmployeesResponse, err error)
+	UpdateEmployee(ctx context.Context, req *UpdateEmployeeRequest, opts ...http.CallOption) (rsp *UpdateEmployeeResponse, err error)
+	// UploadEmployeePhoto Uploads the photo of an employee, a JPEG, PNG or WebP image, replacing
+	// the previous one
+	UploadEmployeePhoto(ctx context.Context, req *UploadEmployeePhotoRequest, opts ...http.CallOption) (rsp *UploadEmployeePhotoResponse, err error)
+	// ValidateEmployee Validates an employee payload as CreateEmployee would, or as an update
+	// of an existing employee, without storing anything. Every problem is
+	// returned at once: violations would fail the write, warnings would not.
+	ValidateEmployee(ctx context.Context, req *ValidateEmployeeRequest, opts ...http.CallOption) (rsp *ValidateEmployeeResponse, err error)
+}
+
+type EmployeeServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewEmployeeServiceHTTPClient(client *http.Client) EmployeeServiceHTTPClient {
+	return &EmployeeServiceHTTPClientImpl{client}
+}
+
+// AddSecondaryEmail Adds an email to an employee as a secondary email; adding an email the
+// employee already has is a no-op
+func (c *EmployeeServiceHTTPClientImpl) AddSecondaryEmail(ctx context.Context, in *AddSecondaryEmailRequest, opts ...http.CallOption) (*AddSecondaryEmailResponse, error) {
+	var out AddSecondaryEmailResponse
+	pattern := "/api/v1/employees/{id}/emails"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceAddSecondaryEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddTag Tags an employee, e.g. contractor or remote; tagging an employee again
+// with the same tag is a no-op
+func (c *EmployeeServiceHTTPClientImpl) AddTag(ctx context.Context, in *AddTagRequest, opts ...http.CallOption) (*AddTagResponse, error) {
+	var out AddTagResponse
+	pattern := "/api/v1/employees/{id}/tags"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceAddTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ApproveEmployee Approves an employee pending review, making it visible and publishing
+// its created event
+func (c *EmployeeServiceHTTPClientImpl) ApproveEmployee(ctx context.Context, in *ApproveEmployeeRequest, opts ...http.CallOption) (*ApproveEmployeeResponse, error) {
+	var out ApproveEmployeeResponse
+	pattern := "/api/v1/employees/{id}:approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceApproveEmployee))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ApproveMerge Approves a merge awaiting approval, performing it. The merge must be
+// approved by another user than the one requesting it, acting as
+// themselves rather than impersonated.
+func (c *EmployeeServiceHTTPClientImpl) ApproveMerge(ctx context.Context, in *ApproveMergeRequest, opts ...http.CallOption) (*ApproveMergeResponse, error) {
+	var out ApproveMergeResponse
+	pattern := "/api/v1/merge-approvals/{id}:approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceApproveMerge))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelScheduledChange Cancels a scheduled change that has not been applied yet
+func (c *EmployeeServiceHTTPClientImpl) CancelScheduledChange(ctx context.Context, in *CancelScheduledChangeRequest, opts ...http.CallOption) (*CancelScheduledChangeResponse, error) {
+	var out CancelScheduledChangeResponse
+	pattern := "/api/v1/scheduled-changes/{id}:cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceCancelScheduledChange))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CountEmployees Counts the employees ListEmployees would list, without returning them
+func (c *EmployeeServiceHTTPClientImpl) CountEmployees(ctx context.Context, in *CountEmployeesRequest, opts ...http.CallOption) (*CountEmployeesResponse, error) {
+	var out CountEmployeesResponse
+	pattern := "/api/v1/employees:count"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceCountEmployees))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateEmployee Creates a new employee
+func (c *EmployeeServiceHTTPClientImpl) CreateEmployee(ctx context.Context, in *CreateEmployeeRequest, opts ...http.CallOption) (*CreateEmployeeResponse, error) {
+	var out CreateEmployeeResponse
+	pattern := "/api/v1/employees"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceCreateEmployee))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateOrUpdateEmployeeByEmail Updates an existing employee
+// Creates an employee owning email, or updates the employee that already
+// owns it, atomically; meant for idempotent syncs from an HRIS
+func (c *EmployeeServiceHTTPClientImpl) CreateOrUpdateEmployeeByEmail(ctx context.Context, in *CreateOrUpdateEmployeeByEmailRequest, opts ...http.CallOption) (*CreateOrUpdateEmployeeByEmailResponse, error) {
+	var out CreateOrUpdateEmployeeByEmailResponse
+	pattern := "/api/v1/employees:upsert"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceCreateOrUpdateEmployeeByEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeactivateEmployee Deactivates an employee, e.g. when offboarded. The employee is kept and
+// stays readable, but is left out of listings unless active_only is false.
+func (c *EmployeeServiceHTTPClientImpl) DeactivateEmployee(ctx context.Context, in *DeactivateEmployeeRequest, opts ...http.CallOption) (*DeactivateEmployeeResponse, error) {
+	var out DeactivateEmployeeResponse
+	pattern := "/api/v1/employees/{id}:deactivate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceDeactivateEmployee))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteEmployee Deletes an employee
+func (c *EmployeeServiceHTTPClientImpl) DeleteEmployee(ctx context.Context, in *DeleteEmployeeRequest, opts ...http.CallOption) (*DeleteEmployeeResponse, error) {
+	var out DeleteEmployeeResponse
+	pattern := "/api/v1/employees/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceDeleteEmployee))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DiffEmployees Compares two employees field by field, with their emails and a summary
+// of their histories, to decide on a merge
+func (c *EmployeeServiceHTTPClientImpl) DiffEmployees(ctx context.Context, in *DiffEmployeesRequest, opts ...http.CallOption) (*DiffEmployeesResponse, error) {
+	var out DiffEmployeesResponse
+	pattern := "/api/v1/employees:diff"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceDiffEmployees))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// EmployeeExists Reports whether an email belongs to an employee, without returning the
+// employee. Emails of employees pending review count as taken.
+func (c *EmployeeServiceHTTPClientImpl) EmployeeExists(ctx context.Context, in *EmployeeExistsRequest, opts ...http.CallOption) (*EmployeeExistsResponse, error) {
+	var out EmployeeExistsResponse
+	pattern := "/api/v1/employees:exists"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceEmployeeExists))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportEmployeeData Exports everything stored about an employee as one document, to answer
+// data-subject access requests: the employee, its audit history and the
+// webhook events sent about it
+func (c *EmployeeServiceHTTPClientImpl) ExportEmployeeData(ctx context.Context, in *ExportEmployeeDataRequest, opts ...http.CallOption) (*ExportEmployeeDataResponse, error) {
+	var out ExportEmployeeDataResponse
+	pattern := "/api/v1/employees/{id}/data"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceExportEmployeeData))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindDuplicateCandidates Scans the tenant for likely duplicate employees and returns scored
+// candidate pairs to merge
+func (c *EmployeeServiceHTTPClientImpl) FindDuplicateCandidates(ctx context.Context, in *FindDuplicateCandidatesRequest, opts ...http.CallOption) (*FindDuplicateCandidatesResponse, error) {
+	var out FindDuplicateCandidatesResponse
+	pattern := "/api/v1/employees/duplicates"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceFindDuplicateCandidates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEmployee Gets an employee by ID
+func (c *EmployeeServiceHTTPClientImpl) GetEmployee(ctx context.Context, in *GetEmployeeRequest, opts ...http.CallOption) (*GetEmployeeResponse, error) {
+	var out GetEmployeeResponse
+	pattern := "/api/v1/employees/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceGetEmployee))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEmployeeByEmail Gets an employee by email (deprecated - use ListEmployees with email param)
+func (c *EmployeeServiceHTTPClientImpl) GetEmployeeByEmail(ctx context.Context, in *GetEmployeeByEmailRequest, opts ...http.CallOption) (*GetEmployeeByEmailResponse, error) {
+	var out GetEmployeeByEmailResponse
+	pattern := "/api/v1/employees:byEmail"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceGetEmployeeByEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEmployeeByExternalID Gets the employee with an ID in an external system such as an HRIS
+func (c *EmployeeServiceHTTPClientImpl) GetEmployeeByExternalID(ctx context.Context, in *GetEmployeeByExternalIDRequest, opts ...http.CallOption) (*GetEmployeeByExternalIDResponse, error) {
+	var out GetEmployeeByExternalIDResponse
+	pattern := "/api/v1/employees:byExternalId"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceGetEmployeeByExternalID))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEmployeePhotoURL Returns a pre-signed URL the photo of an employee can be downloaded
+// from until it expires
+func (c *EmployeeServiceHTTPClientImpl) GetEmployeePhotoURL(ctx context.Context, in *GetEmployeePhotoURLRequest, opts ...http.CallOption) (*GetEmployeePhotoURLResponse, error) {
+	var out GetEmployeePhotoURLResponse
+	pattern := "/api/v1/employees/{id}/photo:url"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceGetEmployeePhotoURL))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetManagementChain Returns the managers above an employee, its direct manager first and
+// the top of the hierarchy last
+func (c *EmployeeServiceHTTPClientImpl) GetManagementChain(ctx context.Context, in *GetManagementChainRequest, opts ...http.CallOption) (*GetManagementChainResponse, error) {
+	var out GetManagementChainResponse
+	pattern := "/api/v1/employees/{employee_id}/managers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceGetManagementChain))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListDirectReports Lists the employees reporting directly to a manager, newest first
+func (c *EmployeeServiceHTTPClientImpl) ListDirectReports(ctx context.Context, in *ListDirectReportsRequest, opts ...http.CallOption) (*ListEmployeesResponse, error) {
+	var out ListEmployeesResponse
+	pattern := "/api/v1/employees/{manager_id}/reports"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceListDirectReports))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEmployees Lists employees with pagination and filtering
+// Use query parameters: ?page=1&page_size=20&email=...
+func (c *EmployeeServiceHTTPClientImpl) ListEmployees(ctx context.Context, in *ListEmployeesRequest, opts ...http.CallOption) (*ListEmployeesResponse, error) {
+	var out ListEmployeesResponse
+	pattern := "/api/v1/employees"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceListEmployees))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEmployeesAsOf Lists the employees as they were at a point in time, e.g. for the
+// headcount on Jan 1, reconstructed from the audit log
+func (c *EmployeeServiceHTTPClientImpl) ListEmployeesAsOf(ctx context.Context, in *ListEmployeesAsOfRequest, opts ...http.CallOption) (*ListEmployeesAsOfResponse, error) {
+	var out ListEmployeesAsOfResponse
+	pattern := "/api/v1/employees:asOf"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceListEmployeesAsOf))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEmploymentHistory Lists the jobs an employee held, the current one first. A job is the
+// title, department and manager of the employee over a period; a new one
+// starts whenever any of them changes.
+func (c *EmployeeServiceHTTPClientImpl) ListEmploymentHistory(ctx context.Context, in *ListEmploymentHistoryRequest, opts ...http.CallOption) (*ListEmploymentHistoryResponse, error) {
+	var out ListEmploymentHistoryResponse
+	pattern := "/api/v1/employees/{employee_id}/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceListEmploymentHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListMergeApprovals Lists the merges awaiting the approval of a second user
+func (c *EmployeeServiceHTTPClientImpl) ListMergeApprovals(ctx context.Context, in *ListMergeApprovalsRequest, opts ...http.CallOption) (*ListMergeApprovalsResponse, error) {
+	var out ListMergeApprovalsResponse
+	pattern := "/api/v1/merge-approvals"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceListMergeApprovals))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListPendingEmployees Lists the employees pending review, oldest first
+func (c *EmployeeServiceHTTPClientImpl) ListPendingEmployees(ctx context.Context, in *ListPendingEmployeesRequest, opts ...http.CallOption) (*ListEmployeesResponse, error) {
+	var out ListEmployeesResponse
+	pattern := "/api/v1/employees/pending"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceListPendingEmployees))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListScheduledChanges Lists the creates and updates scheduled with effective_at, next due first
+func (c *EmployeeServiceHTTPClientImpl) ListScheduledChanges(ctx context.Context, in *ListScheduledChangesRequest, opts ...http.CallOption) (*ListScheduledChangesResponse, error) {
+	var out ListScheduledChangesResponse
+	pattern := "/api/v1/scheduled-changes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceListScheduledChanges))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MergeEmployees Merges two employees by email. With validate_only the merge is only
+// previewed.
+func (c *EmployeeServiceHTTPClientImpl) MergeEmployees(ctx context.Context, in *MergeEmployeesRequest, opts ...http.CallOption) (*MergeEmployeesResponse, error) {
+	var out MergeEmployeesResponse
+	pattern := "/api/v1/employees/merge"
 	path := binding.EncodeURL(pattern, in, false)
 	opts = append(opts, http.Operation(OperationEmployeeServiceMergeEmployees))
 	opts = append(opts, http.PathTemplate(pattern))
@@ -318,7 +1619,154 @@ func (c *EmployeeServiceHTTPClientImpl) MergeEmployees(ctx context.Context, in *
 	return &out, nil
 }
 
-// UpdateEmployee Updates an existing employee
+// MergeEmployeesById Merges two employees by ID. With validate_only the merge is only
+// previewed.
+func (c *EmployeeServiceHTTPClientImpl) MergeEmployeesById(ctx context.Context, in *MergeEmployeesByIdRequest, opts ...http.CallOption) (*MergeEmployeesResponse, error) {
+	var out MergeEmployeesResponse
+	pattern := "/api/v1/employees/merge:byId"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceMergeEmployeesById))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReactivateEmployee Reactivates a deactivated employee
+func (c *EmployeeServiceHTTPClientImpl) ReactivateEmployee(ctx context.Context, in *ReactivateEmployeeRequest, opts ...http.CallOption) (*ReactivateEmployeeResponse, error) {
+	var out ReactivateEmployeeResponse
+	pattern := "/api/v1/employees/{id}:reactivate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceReactivateEmployee))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RejectEmployee Rejects an employee pending review, deleting it
+func (c *EmployeeServiceHTTPClientImpl) RejectEmployee(ctx context.Context, in *RejectEmployeeRequest, opts ...http.CallOption) (*RejectEmployeeResponse, error) {
+	var out RejectEmployeeResponse
+	pattern := "/api/v1/employees/{id}:reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceRejectEmployee))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RejectMerge Rejects a merge awaiting approval; the employees are left unchanged
+func (c *EmployeeServiceHTTPClientImpl) RejectMerge(ctx context.Context, in *RejectMergeRequest, opts ...http.CallOption) (*RejectMergeResponse, error) {
+	var out RejectMergeResponse
+	pattern := "/api/v1/merge-approvals/{id}:reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceRejectMerge))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveSecondaryEmail Removes a secondary email from an employee; removing an email the
+// employee does not have is a no-op. The primary email cannot be removed.
+func (c *EmployeeServiceHTTPClientImpl) RemoveSecondaryEmail(ctx context.Context, in *RemoveSecondaryEmailRequest, opts ...http.CallOption) (*RemoveSecondaryEmailResponse, error) {
+	var out RemoveSecondaryEmailResponse
+	pattern := "/api/v1/employees/{id}/emails/{email}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceRemoveSecondaryEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveTag Removes a tag from an employee; removing a tag the employee does not
+// have is a no-op
+func (c *EmployeeServiceHTTPClientImpl) RemoveTag(ctx context.Context, in *RemoveTagRequest, opts ...http.CallOption) (*RemoveTagResponse, error) {
+	var out RemoveTagResponse
+	pattern := "/api/v1/employees/{id}/tags/{tag}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceRemoveTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReportEmailBounces Reports bounces and complaints of the mail provider, marking the emails
+// undeliverable. Emails of no employee are ignored.
+func (c *EmployeeServiceHTTPClientImpl) ReportEmailBounces(ctx context.Context, in *ReportEmailBouncesRequest, opts ...http.CallOption) (*ReportEmailBouncesResponse, error) {
+	var out ReportEmailBouncesResponse
+	pattern := "/api/v1/employees:report-bounces"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceReportEmailBounces))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResolveMergedEmployee Follows the merges an employee ID was merged away by to the surviving
+// employee, for systems still keyed by the ID of a secondary employee
+func (c *EmployeeServiceHTTPClientImpl) ResolveMergedEmployee(ctx context.Context, in *ResolveMergedEmployeeRequest, opts ...http.CallOption) (*ResolveMergedEmployeeResponse, error) {
+	var out ResolveMergedEmployeeResponse
+	pattern := "/api/v1/employees/{id}/resolve"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeServiceResolveMergedEmployee))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetPrimaryEmail Makes one of an employee's emails its primary email; the previous
+// primary email stays one of its emails. Emails marked undeliverable
+// cannot become primary (EMAIL_UNDELIVERABLE).
+func (c *EmployeeServiceHTTPClientImpl) SetPrimaryEmail(ctx context.Context, in *SetPrimaryEmailRequest, opts ...http.CallOption) (*SetPrimaryEmailResponse, error) {
+	var out SetPrimaryEmailResponse
+	pattern := "/api/v1/employees/{id}/primary-email"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceSetPrimaryEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnmergeEmployees Undoes a merge, recreating the secondary employee and moving its emails
+// back from the primary employee
+func (c *EmployeeServiceHTTPClientImpl) UnmergeEmployees(ctx context.Context, in *UnmergeEmployeesRequest, opts ...http.CallOption) (*UnmergeEmployeesResponse, error) {
+	var out UnmergeEmployeesResponse
+	pattern := "/api/v1/employees/unmerge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceUnmergeEmployees))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *EmployeeServiceHTTPClientImpl) UpdateEmployee(ctx context.Context, in *UpdateEmployeeRequest, opts ...http.CallOption) (*UpdateEmployeeResponse, error) {
 	var out UpdateEmployeeResponse
 	pattern := "/api/v1/employees/{id}"
@@ -331,3 +1779,34 @@ func (c *EmployeeServiceHTTPClientImpl) UpdateEmployee(ctx context.Context, in *
 	}
 	return &out, nil
 }
+
+// UploadEmployeePhoto Uploads the photo of an employee, a JPEG, PNG or WebP image, replacing
+// the previous one
+func (c *EmployeeServiceHTTPClientImpl) UploadEmployeePhoto(ctx context.Context, in *UploadEmployeePhotoRequest, opts ...http.CallOption) (*UploadEmployeePhotoResponse, error) {
+	var out UploadEmployeePhotoResponse
+	pattern := "/api/v1/employees/{id}/photo"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceUploadEmployeePhoto))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ValidateEmployee Validates an employee payload as CreateEmployee would, or as an update
+// of an existing employee, without storing anything. Every problem is
+// returned at once: violations would fail the write, warnings would not.
+func (c *EmployeeServiceHTTPClientImpl) ValidateEmployee(ctx context.Context, in *ValidateEmployeeRequest, opts ...http.CallOption) (*ValidateEmployeeResponse, error) {
+	var out ValidateEmployeeResponse
+	pattern := "/api/v1/employees:validate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeServiceValidateEmployee))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
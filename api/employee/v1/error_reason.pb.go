@@ -24,17 +24,81 @@ const (
 type ErrorReason int32
 
 const (
-	ErrorReason_UNKNOWN                 ErrorReason = 0
-	ErrorReason_EMPLOYEE_NOT_FOUND      ErrorReason = 1
-	ErrorReason_EMPLOYEE_ALREADY_EXISTS ErrorReason = 2
-	ErrorReason_EMPLOYEE_NOT_IN_TENANT  ErrorReason = 3
-	ErrorReason_INVALID_EMAIL           ErrorReason = 4
-	ErrorReason_INVALID_EMPLOYEE_ID     ErrorReason = 5
-	ErrorReason_TENANT_NOT_FOUND        ErrorReason = 6
-	ErrorReason_UNAUTHORIZED            ErrorReason = 7
-	ErrorReason_INVALID_UUID            ErrorReason = 8
-	ErrorReason_INVALID_DATE_RANGE      ErrorReason = 9
-	ErrorReason_INVALID_MERGE           ErrorReason = 10
+	ErrorReason_UNKNOWN                       ErrorReason = 0
+	ErrorReason_EMPLOYEE_NOT_FOUND            ErrorReason = 1
+	ErrorReason_EMPLOYEE_ALREADY_EXISTS       ErrorReason = 2
+	ErrorReason_EMPLOYEE_NOT_IN_TENANT        ErrorReason = 3
+	ErrorReason_INVALID_EMAIL                 ErrorReason = 4
+	ErrorReason_INVALID_EMPLOYEE_ID           ErrorReason = 5
+	ErrorReason_TENANT_NOT_FOUND              ErrorReason = 6
+	ErrorReason_UNAUTHORIZED                  ErrorReason = 7
+	ErrorReason_INVALID_UUID                  ErrorReason = 8
+	ErrorReason_INVALID_DATE_RANGE            ErrorReason = 9
+	ErrorReason_INVALID_MERGE                 ErrorReason = 10
+	ErrorReason_INVALID_CONFIRMATION_TOKEN    ErrorReason = 11
+	ErrorReason_INVALID_RESUME_TOKEN          ErrorReason = 12
+	ErrorReason_WEBHOOK_NOT_FOUND             ErrorReason = 13
+	ErrorReason_INVALID_WEBHOOK_URL           ErrorReason = 14
+	ErrorReason_IMPORT_NOT_FOUND              ErrorReason = 15
+	ErrorReason_INVALID_IMPORT_SOURCE         ErrorReason = 16
+	ErrorReason_MERGE_NOT_FOUND               ErrorReason = 17
+	ErrorReason_UNMERGE_CONFLICT              ErrorReason = 18
+	ErrorReason_INVALID_LIST_FILTER           ErrorReason = 19
+	ErrorReason_VERSION_REQUIRED              ErrorReason = 20
+	ErrorReason_VERSION_MISMATCH              ErrorReason = 21
+	ErrorReason_INVALID_IF_MATCH              ErrorReason = 22
+	ErrorReason_EMPLOYEE_PENDING_REVIEW       ErrorReason = 23
+	ErrorReason_EMPLOYEE_NOT_PENDING_REVIEW   ErrorReason = 24
+	ErrorReason_IDEMPOTENCY_KEY_REUSED        ErrorReason = 25
+	ErrorReason_INVALID_IDEMPOTENCY_KEY       ErrorReason = 26
+	ErrorReason_SCHEDULED_CHANGE_NOT_FOUND    ErrorReason = 27
+	ErrorReason_SCHEDULED_CHANGE_NOT_PENDING  ErrorReason = 28
+	ErrorReason_INVALID_EFFECTIVE_AT          ErrorReason = 29
+	ErrorReason_WEBHOOK_DELIVERY_NOT_FOUND    ErrorReason = 30
+	ErrorReason_WEBHOOK_DELIVERY_NOT_FAILED   ErrorReason = 31
+	ErrorReason_INVALID_USAGE_RANGE           ErrorReason = 32
+	ErrorReason_EMPLOYEE_NAME_REQUIRED        ErrorReason = 33
+	ErrorReason_DEPARTMENT_NOT_FOUND          ErrorReason = 34
+	ErrorReason_DEPARTMENT_ALREADY_EXISTS     ErrorReason = 35
+	ErrorReason_DEPARTMENT_NOT_EMPTY          ErrorReason = 36
+	ErrorReason_TEAM_NOT_FOUND                ErrorReason = 37
+	ErrorReason_TEAM_ALREADY_EXISTS           ErrorReason = 38
+	ErrorReason_TEAM_MEMBER_NOT_FOUND         ErrorReason = 39
+	ErrorReason_MANAGER_NOT_FOUND             ErrorReason = 40
+	ErrorReason_INVALID_MANAGER               ErrorReason = 41
+	ErrorReason_DEADLINE_EXHAUSTED            ErrorReason = 42
+	ErrorReason_TAG_LIMIT_EXCEEDED            ErrorReason = 43
+	ErrorReason_INVALID_PHONE_NUMBER          ErrorReason = 44
+	ErrorReason_INVALID_ADDRESS               ErrorReason = 45
+	ErrorReason_PHOTO_NOT_FOUND               ErrorReason = 46
+	ErrorReason_INVALID_PHOTO                 ErrorReason = 47
+	ErrorReason_PHOTO_STORAGE_NOT_CONFIGURED  ErrorReason = 48
+	ErrorReason_INVALID_AS_OF                 ErrorReason = 49
+	ErrorReason_INVALID_EXTERNAL_ID           ErrorReason = 50
+	ErrorReason_EXTERNAL_ID_ALREADY_EXISTS    ErrorReason = 51
+	ErrorReason_INVALID_WEBHOOK_TEMPLATE      ErrorReason = 52
+	ErrorReason_EMPLOYEE_EMAIL_NOT_FOUND      ErrorReason = 53
+	ErrorReason_PRIMARY_EMAIL_NOT_REMOVABLE   ErrorReason = 54
+	ErrorReason_EMAIL_LIMIT_EXCEEDED          ErrorReason = 55
+	ErrorReason_MERGE_APPROVAL_NOT_FOUND      ErrorReason = 56
+	ErrorReason_MERGE_APPROVAL_NOT_PENDING    ErrorReason = 57
+	ErrorReason_MERGE_SELF_APPROVAL           ErrorReason = 58
+	ErrorReason_EMAIL_DOMAIN_NOT_ALLOWED      ErrorReason = 59
+	ErrorReason_API_KEY_NOT_FOUND             ErrorReason = 60
+	ErrorReason_INVALID_BACKFILL_CURSOR       ErrorReason = 61
+	ErrorReason_EVENTS_NOT_CONFIGURED         ErrorReason = 62
+	ErrorReason_TENANT_CLONE_NOT_FOUND        ErrorReason = 63
+	ErrorReason_TENANT_CLONE_NOT_ALLOWED      ErrorReason = 64
+	ErrorReason_TENANT_CLONE_TARGET_NOT_EMPTY ErrorReason = 65
+	ErrorReason_MERGE_CONCURRENCY_EXHAUSTED   ErrorReason = 66
+	ErrorReason_INVALID_REQUEST_SIGNATURE     ErrorReason = 67
+	ErrorReason_REQUEST_REPLAYED              ErrorReason = 68
+	ErrorReason_RUNBOOK_NOT_PERMITTED         ErrorReason = 69
+	ErrorReason_RUNBOOK_ACTION_UNAVAILABLE    ErrorReason = 70
+	ErrorReason_RESUME_TOKEN_EXPIRED          ErrorReason = 71
+	ErrorReason_MERGE_DECISION_IMPERSONATED   ErrorReason = 72
+	ErrorReason_MERGE_REQUEST_IMPERSONATED    ErrorReason = 73
+	ErrorReason_EMAIL_UNDELIVERABLE           ErrorReason = 74
 )
 
 // Enum value maps for ErrorReason.
@@ -51,19 +115,147 @@ var (
 		8:  "INVALID_UUID",
 		9:  "INVALID_DATE_RANGE",
 		10: "INVALID_MERGE",
+		11: "INVALID_CONFIRMATION_TOKEN",
+		12: "INVALID_RESUME_TOKEN",
+		13: "WEBHOOK_NOT_FOUND",
+		14: "INVALID_WEBHOOK_URL",
+		15: "IMPORT_NOT_FOUND",
+		16: "INVALID_IMPORT_SOURCE",
+		17: "MERGE_NOT_FOUND",
+		18: "UNMERGE_CONFLICT",
+		19: "INVALID_LIST_FILTER",
+		20: "VERSION_REQUIRED",
+		21: "VERSION_MISMATCH",
+		22: "INVALID_IF_MATCH",
+		23: "EMPLOYEE_PENDING_REVIEW",
+		24: "EMPLOYEE_NOT_PENDING_REVIEW",
+		25: "IDEMPOTENCY_KEY_REUSED",
+		26: "INVALID_IDEMPOTENCY_KEY",
+		27: "SCHEDULED_CHANGE_NOT_FOUND",
+		28: "SCHEDULED_CHANGE_NOT_PENDING",
+		29: "INVALID_EFFECTIVE_AT",
+		30: "WEBHOOK_DELIVERY_NOT_FOUND",
+		31: "WEBHOOK_DELIVERY_NOT_FAILED",
+		32: "INVALID_USAGE_RANGE",
+		33: "EMPLOYEE_NAME_REQUIRED",
+		34: "DEPARTMENT_NOT_FOUND",
+		35: "DEPARTMENT_ALREADY_EXISTS",
+		36: "DEPARTMENT_NOT_EMPTY",
+		37: "TEAM_NOT_FOUND",
+		38: "TEAM_ALREADY_EXISTS",
+		39: "TEAM_MEMBER_NOT_FOUND",
+		40: "MANAGER_NOT_FOUND",
+		41: "INVALID_MANAGER",
+		42: "DEADLINE_EXHAUSTED",
+		43: "TAG_LIMIT_EXCEEDED",
+		44: "INVALID_PHONE_NUMBER",
+		45: "INVALID_ADDRESS",
+		46: "PHOTO_NOT_FOUND",
+		47: "INVALID_PHOTO",
+		48: "PHOTO_STORAGE_NOT_CONFIGURED",
+		49: "INVALID_AS_OF",
+		50: "INVALID_EXTERNAL_ID",
+		51: "EXTERNAL_ID_ALREADY_EXISTS",
+		52: "INVALID_WEBHOOK_TEMPLATE",
+		53: "EMPLOYEE_EMAIL_NOT_FOUND",
+		54: "PRIMARY_EMAIL_NOT_REMOVABLE",
+		55: "EMAIL_LIMIT_EXCEEDED",
+		56: "MERGE_APPROVAL_NOT_FOUND",
+		57: "MERGE_APPROVAL_NOT_PENDING",
+		58: "MERGE_SELF_APPROVAL",
+		59: "EMAIL_DOMAIN_NOT_ALLOWED",
+		60: "API_KEY_NOT_FOUND",
+		61: "INVALID_BACKFILL_CURSOR",
+		62: "EVENTS_NOT_CONFIGURED",
+		63: "TENANT_CLONE_NOT_FOUND",
+		64: "TENANT_CLONE_NOT_ALLOWED",
+		65: "TENANT_CLONE_TARGET_NOT_EMPTY",
+		66: "MERGE_CONCURRENCY_EXHAUSTED",
+		67: "INVALID_REQUEST_SIGNATURE",
+		68: "REQUEST_REPLAYED",
+		69: "RUNBOOK_NOT_PERMITTED",
+		70: "RUNBOOK_ACTION_UNAVAILABLE",
+		71: "RESUME_TOKEN_EXPIRED",
+		72: "MERGE_DECISION_IMPERSONATED",
+		73: "MERGE_REQUEST_IMPERSONATED",
+		74: "EMAIL_UNDELIVERABLE",
 	}
 	ErrorReason_value = map[string]int32{
-		"UNKNOWN":                 0,
-		"EMPLOYEE_NOT_FOUND":      1,
-		"EMPLOYEE_ALREADY_EXISTS": 2,
-		"EMPLOYEE_NOT_IN_TENANT":  3,
-		"INVALID_EMAIL":           4,
-		"INVALID_EMPLOYEE_ID":     5,
-		"TENANT_NOT_FOUND":        6,
-		"UNAUTHORIZED":            7,
-		"INVALID_UUID":            8,
-		"INVALID_DATE_RANGE":      9,
-		"INVALID_MERGE":           10,
+		"UNKNOWN":                       0,
+		"EMPLOYEE_NOT_FOUND":            1,
+		"EMPLOYEE_ALREADY_EXISTS":       2,
+		"EMPLOYEE_NOT_IN_TENANT":        3,
+		"INVALID_EMAIL":                 4,
+		"INVALID_EMPLOYEE_ID":           5,
+		"TENANT_NOT_FOUND":              6,
+		"UNAUTHORIZED":                  7,
+		"INVALID_UUID":                  8,
+		"INVALID_DATE_RANGE":            9,
+		"INVALID_MERGE":                 10,
+		"INVALID_CONFIRMATION_TOKEN":    11,
+		"INVALID_RESUME_TOKEN":          12,
+		"WEBHOOK_NOT_FOUND":             13,
+		"INVALID_WEBHOOK_URL":           14,
+		"IMPORT_NOT_FOUND":              15,
+		"INVALID_IMPORT_SOURCE":         16,
+		"MERGE_NOT_FOUND":               17,
+		"UNMERGE_CONFLICT":              18,
+		"INVALID_LIST_FILTER":           19,
+		"VERSION_REQUIRED":              20,
+		"VERSION_MISMATCH":              21,
+		"INVALID_IF_MATCH":              22,
+		"EMPLOYEE_PENDING_REVIEW":       23,
+		"EMPLOYEE_NOT_PENDING_REVIEW":   24,
+		"IDEMPOTENCY_KEY_REUSED":        25,
+		"INVALID_IDEMPOTENCY_KEY":       26,
+		"SCHEDULED_CHANGE_NOT_FOUND":    27,
+		"SCHEDULED_CHANGE_NOT_PENDING":  28,
+		"INVALID_EFFECTIVE_AT":          29,
+		"WEBHOOK_DELIVERY_NOT_FOUND":    30,
+		"WEBHOOK_DELIVERY_NOT_FAILED":   31,
+		"INVALID_USAGE_RANGE":           32,
+		"EMPLOYEE_NAME_REQUIRED":        33,
+		"DEPARTMENT_NOT_FOUND":          34,
+		"DEPARTMENT_ALREADY_EXISTS":     35,
+		"DEPARTMENT_NOT_EMPTY":          36,
+		"TEAM_NOT_FOUND":                37,
+		"TEAM_ALREADY_EXISTS":           38,
+		"TEAM_MEMBER_NOT_FOUND":         39,
+		"MANAGER_NOT_FOUND":             40,
+		"INVALID_MANAGER":               41,
+		"DEADLINE_EXHAUSTED":            42,
+		"TAG_LIMIT_EXCEEDED":            43,
+		"INVALID_PHONE_NUMBER":          44,
+		"INVALID_ADDRESS":               45,
+		"PHOTO_NOT_FOUND":               46,
+		"INVALID_PHOTO":                 47,
+		"PHOTO_STORAGE_NOT_CONFIGURED":  48,
+		"INVALID_AS_OF":                 49,
+		"INVALID_EXTERNAL_ID":           50,
+		"EXTERNAL_ID_ALREADY_EXISTS":    51,
+		"INVALID_WEBHOOK_TEMPLATE":      52,
+		"EMPLOYEE_EMAIL_NOT_FOUND":      53,
+		"PRIMARY_EMAIL_NOT_REMOVABLE":   54,
+		"EMAIL_LIMIT_EXCEEDED":          55,
+		"MERGE_APPROVAL_NOT_FOUND":      56,
+		"MERGE_APPROVAL_NOT_PENDING":    57,
+		"MERGE_SELF_APPROVAL":           58,
+		"EMAIL_DOMAIN_NOT_ALLOWED":      59,
+		"API_KEY_NOT_FOUND":             60,
+		"INVALID_BACKFILL_CURSOR":       61,
+		"EVENTS_NOT_CONFIGURED":         62,
+		"TENANT_CLONE_NOT_FOUND":        63,
+		"TENANT_CLONE_NOT_ALLOWED":      64,
+		"TENANT_CLONE_TARGET_NOT_EMPTY": 65,
+		"MERGE_CONCURRENCY_EXHAUSTED":   66,
+		"INVALID_REQUEST_SIGNATURE":     67,
+		"REQUEST_REPLAYED":              68,
+		"RUNBOOK_NOT_PERMITTED":         69,
+		"RUNBOOK_ACTION_UNAVAILABLE":    70,
+		"RESUME_TOKEN_EXPIRED":          71,
+		"MERGE_DECISION_IMPERSONATED":   72,
+		"MERGE_REQUEST_IMPERSONATED":    73,
+		"EMAIL_UNDELIVERABLE":           74,
 	}
 )
 
@@ -98,7 +290,7 @@ var File_employee_v1_error_reason_proto protoreflect.FileDescriptor
 
 const file_employee_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
-	"\x1eemployee/v1/error_reason.proto\x12\vemployee.v1*\xfc\x01\n" +
+	"\x1eemployee/v1/error_reason.proto\x12\vemployee.v1*\xbf\x0f\n" +
 	"\vErrorReason\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\x16\n" +
 	"\x12EMPLOYEE_NOT_FOUND\x10\x01\x12\x1b\n" +
@@ -111,7 +303,71 @@ const file_employee_v1_error_reason_proto_rawDesc = "" +
 	"\fINVALID_UUID\x10\b\x12\x16\n" +
 	"\x12INVALID_DATE_RANGE\x10\t\x12\x11\n" +
 	"\rINVALID_MERGE\x10\n" +
-	"BC\n" +
+	"\x12\x1e\n" +
+	"\x1aINVALID_CONFIRMATION_TOKEN\x10\v\x12\x18\n" +
+	"\x14INVALID_RESUME_TOKEN\x10\f\x12\x15\n" +
+	"\x11WEBHOOK_NOT_FOUND\x10\r\x12\x17\n" +
+	"\x13INVALID_WEBHOOK_URL\x10\x0e\x12\x14\n" +
+	"\x10IMPORT_NOT_FOUND\x10\x0f\x12\x19\n" +
+	"\x15INVALID_IMPORT_SOURCE\x10\x10\x12\x13\n" +
+	"\x0fMERGE_NOT_FOUND\x10\x11\x12\x14\n" +
+	"\x10UNMERGE_CONFLICT\x10\x12\x12\x17\n" +
+	"\x13INVALID_LIST_FILTER\x10\x13\x12\x14\n" +
+	"\x10VERSION_REQUIRED\x10\x14\x12\x14\n" +
+	"\x10VERSION_MISMATCH\x10\x15\x12\x14\n" +
+	"\x10INVALID_IF_MATCH\x10\x16\x12\x1b\n" +
+	"\x17EMPLOYEE_PENDING_REVIEW\x10\x17\x12\x1f\n" +
+	"\x1bEMPLOYEE_NOT_PENDING_REVIEW\x10\x18\x12\x1a\n" +
+	"\x16IDEMPOTENCY_KEY_REUSED\x10\x19\x12\x1b\n" +
+	"\x17INVALID_IDEMPOTENCY_KEY\x10\x1a\x12\x1e\n" +
+	"\x1aSCHEDULED_CHANGE_NOT_FOUND\x10\x1b\x12 \n" +
+	"\x1cSCHEDULED_CHANGE_NOT_PENDING\x10\x1c\x12\x18\n" +
+	"\x14INVALID_EFFECTIVE_AT\x10\x1d\x12\x1e\n" +
+	"\x1aWEBHOOK_DELIVERY_NOT_FOUND\x10\x1e\x12\x1f\n" +
+	"\x1bWEBHOOK_DELIVERY_NOT_FAILED\x10\x1f\x12\x17\n" +
+	"\x13INVALID_USAGE_RANGE\x10 \x12\x1a\n" +
+	"\x16EMPLOYEE_NAME_REQUIRED\x10!\x12\x18\n" +
+	"\x14DEPARTMENT_NOT_FOUND\x10\"\x12\x1d\n" +
+	"\x19DEPARTMENT_ALREADY_EXISTS\x10#\x12\x18\n" +
+	"\x14DEPARTMENT_NOT_EMPTY\x10$\x12\x12\n" +
+	"\x0eTEAM_NOT_FOUND\x10%\x12\x17\n" +
+	"\x13TEAM_ALREADY_EXISTS\x10&\x12\x19\n" +
+	"\x15TEAM_MEMBER_NOT_FOUND\x10'\x12\x15\n" +
+	"\x11MANAGER_NOT_FOUND\x10(\x12\x13\n" +
+	"\x0fINVALID_MANAGER\x10)\x12\x16\n" +
+	"\x12DEADLINE_EXHAUSTED\x10*\x12\x16\n" +
+	"\x12TAG_LIMIT_EXCEEDED\x10+\x12\x18\n" +
+	"\x14INVALID_PHONE_NUMBER\x10,\x12\x13\n" +
+	"\x0fINVALID_ADDRESS\x10-\x12\x13\n" +
+	"\x0fPHOTO_NOT_FOUND\x10.\x12\x11\n" +
+	"\rINVALID_PHOTO\x10/\x12 \n" +
+	"\x1cPHOTO_STORAGE_NOT_CONFIGURED\x100\x12\x11\n" +
+	"\rINVALID_AS_OF\x101\x12\x17\n" +
+	"\x13INVALID_EXTERNAL_ID\x102\x12\x1e\n" +
+	"\x1aEXTERNAL_ID_ALREADY_EXISTS\x103\x12\x1c\n" +
+	"\x18INVALID_WEBHOOK_TEMPLATE\x104\x12\x1c\n" +
+	"\x18EMPLOYEE_EMAIL_NOT_FOUND\x105\x12\x1f\n" +
+	"\x1bPRIMARY_EMAIL_NOT_REMOVABLE\x106\x12\x18\n" +
+	"\x14EMAIL_LIMIT_EXCEEDED\x107\x12\x1c\n" +
+	"\x18MERGE_APPROVAL_NOT_FOUND\x108\x12\x1e\n" +
+	"\x1aMERGE_APPROVAL_NOT_PENDING\x109\x12\x17\n" +
+	"\x13MERGE_SELF_APPROVAL\x10:\x12\x1c\n" +
+	"\x18EMAIL_DOMAIN_NOT_ALLOWED\x10;\x12\x15\n" +
+	"\x11API_KEY_NOT_FOUND\x10<\x12\x1b\n" +
+	"\x17INVALID_BACKFILL_CURSOR\x10=\x12\x19\n" +
+	"\x15EVENTS_NOT_CONFIGURED\x10>\x12\x1a\n" +
+	"\x16TENANT_CLONE_NOT_FOUND\x10?\x12\x1c\n" +
+	"\x18TENANT_CLONE_NOT_ALLOWED\x10@\x12!\n" +
+	"\x1dTENANT_CLONE_TARGET_NOT_EMPTY\x10A\x12\x1f\n" +
+	"\x1bMERGE_CONCURRENCY_EXHAUSTED\x10B\x12\x1d\n" +
+	"\x19INVALID_REQUEST_SIGNATURE\x10C\x12\x14\n" +
+	"\x10REQUEST_REPLAYED\x10D\x12\x19\n" +
+	"\x15RUNBOOK_NOT_PERMITTED\x10E\x12\x1e\n" +
+	"\x1aRUNBOOK_ACTION_UNAVAILABLE\x10F\x12\x18\n" +
+	"\x14RESUME_TOKEN_EXPIRED\x10G\x12\x1f\n" +
+	"\x1bMERGE_DECISION_IMPERSONATED\x10H\x12\x1e\n" +
+	"\x1aMERGE_REQUEST_IMPERSONATED\x10I\x12\x17\n" +
+	"\x13EMAIL_UNDELIVERABLE\x10JBC\n" +
 	"\x1adev.kratos.api.employee.v1P\x01Z#employee-service/api/employee/v1;v1b\x06proto3"
 
 var (
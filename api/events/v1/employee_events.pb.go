@@ -26,28 +26,58 @@ const (
 type EventType int32
 
 const (
-	EventType_EVENT_TYPE_UNSPECIFIED EventType = 0
-	EventType_EVENT_TYPE_CREATED     EventType = 1
-	EventType_EVENT_TYPE_UPDATED     EventType = 2
-	EventType_EVENT_TYPE_DELETED     EventType = 3
-	EventType_EVENT_TYPE_MERGED      EventType = 4
+	EventType_EVENT_TYPE_UNSPECIFIED         EventType = 0
+	EventType_EVENT_TYPE_CREATED             EventType = 1
+	EventType_EVENT_TYPE_UPDATED             EventType = 2
+	EventType_EVENT_TYPE_DELETED             EventType = 3
+	EventType_EVENT_TYPE_MERGED              EventType = 4
+	EventType_EVENT_TYPE_UNMERGED            EventType = 5
+	EventType_EVENT_TYPE_DEPARTMENT_CREATED  EventType = 6
+	EventType_EVENT_TYPE_DEPARTMENT_UPDATED  EventType = 7
+	EventType_EVENT_TYPE_DEPARTMENT_DELETED  EventType = 8
+	EventType_EVENT_TYPE_TEAM_CREATED        EventType = 9
+	EventType_EVENT_TYPE_TEAM_MEMBER_ADDED   EventType = 10
+	EventType_EVENT_TYPE_TEAM_MEMBER_REMOVED EventType = 11
+	EventType_EVENT_TYPE_DEACTIVATED         EventType = 12
+	EventType_EVENT_TYPE_REACTIVATED         EventType = 13
+	EventType_EVENT_TYPE_STALE               EventType = 14
 )
 
 // Enum value maps for EventType.
 var (
 	EventType_name = map[int32]string{
-		0: "EVENT_TYPE_UNSPECIFIED",
-		1: "EVENT_TYPE_CREATED",
-		2: "EVENT_TYPE_UPDATED",
-		3: "EVENT_TYPE_DELETED",
-		4: "EVENT_TYPE_MERGED",
+		0:  "EVENT_TYPE_UNSPECIFIED",
+		1:  "EVENT_TYPE_CREATED",
+		2:  "EVENT_TYPE_UPDATED",
+		3:  "EVENT_TYPE_DELETED",
+		4:  "EVENT_TYPE_MERGED",
+		5:  "EVENT_TYPE_UNMERGED",
+		6:  "EVENT_TYPE_DEPARTMENT_CREATED",
+		7:  "EVENT_TYPE_DEPARTMENT_UPDATED",
+		8:  "EVENT_TYPE_DEPARTMENT_DELETED",
+		9:  "EVENT_TYPE_TEAM_CREATED",
+		10: "EVENT_TYPE_TEAM_MEMBER_ADDED",
+		11: "EVENT_TYPE_TEAM_MEMBER_REMOVED",
+		12: "EVENT_TYPE_DEACTIVATED",
+		13: "EVENT_TYPE_REACTIVATED",
+		14: "EVENT_TYPE_STALE",
 	}
 	EventType_value = map[string]int32{
-		"EVENT_TYPE_UNSPECIFIED": 0,
-		"EVENT_TYPE_CREATED":     1,
-		"EVENT_TYPE_UPDATED":     2,
-		"EVENT_TYPE_DELETED":     3,
-		"EVENT_TYPE_MERGED":      4,
+		"EVENT_TYPE_UNSPECIFIED":         0,
+		"EVENT_TYPE_CREATED":             1,
+		"EVENT_TYPE_UPDATED":             2,
+		"EVENT_TYPE_DELETED":             3,
+		"EVENT_TYPE_MERGED":              4,
+		"EVENT_TYPE_UNMERGED":            5,
+		"EVENT_TYPE_DEPARTMENT_CREATED":  6,
+		"EVENT_TYPE_DEPARTMENT_UPDATED":  7,
+		"EVENT_TYPE_DEPARTMENT_DELETED":  8,
+		"EVENT_TYPE_TEAM_CREATED":        9,
+		"EVENT_TYPE_TEAM_MEMBER_ADDED":   10,
+		"EVENT_TYPE_TEAM_MEMBER_REMOVED": 11,
+		"EVENT_TYPE_DEACTIVATED":         12,
+		"EVENT_TYPE_REACTIVATED":         13,
+		"EVENT_TYPE_STALE":               14,
 	}
 )
 
@@ -94,7 +124,12 @@ type EmployeeEvent struct {
 	// Employee data at the time of the event
 	Employee *EmployeeData `protobuf:"bytes,6,opt,name=employee,proto3" json:"employee,omitempty"`
 	// Additional metadata for the event
-	Metadata      map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Set when employee only carries the ID, updated_at and (for updates) the
+	// changed fields, either because slim events are enabled or because the
+	// full event exceeded the maximum payload size. Consumers needing the rest
+	// of the employee fetch it back with GetEmployee.
+	Slim          bool `protobuf:"varint,8,opt,name=slim,proto3" json:"slim,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -178,6 +213,13 @@ func (x *EmployeeEvent) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *EmployeeEvent) GetSlim() bool {
+	if x != nil {
+		return x.Slim
+	}
+	return false
+}
+
 // EmployeeData contains the employee information
 type EmployeeData struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -192,9 +234,38 @@ type EmployeeData struct {
 	// When the employee was created
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// When the employee was last updated
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Department the employee belongs to; empty when unassigned
+	DepartmentId string `protobuf:"bytes,7,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	// Manager the employee reports to; empty when it has none
+	ManagerId string `protobuf:"bytes,8,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	// Job title; empty when unset
+	Title string `protobuf:"bytes,9,opt,name=title,proto3" json:"title,omitempty"`
+	// Tags grouping the employee, lowercase and sorted
+	Tags []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Phone numbers of the employee in E.164 format
+	PhoneNumbers []*PhoneNumber `protobuf:"bytes,11,rep,name=phone_numbers,json=phoneNumbers,proto3" json:"phone_numbers,omitempty"`
+	// Postal address of the employee. Only published when the service is
+	// configured to include addresses; the event metadata then carries
+	// address_included=true, telling an employee without an address from an
+	// event leaving it out.
+	Address *PostalAddress `protobuf:"bytes,12,opt,name=address,proto3" json:"address,omitempty"`
+	// IDs of the employee in external systems such as an HRIS, by system
+	ExternalIds map[string]string `protobuf:"bytes,13,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The email to contact the employee at, one of emails
+	PrimaryEmail string `protobuf:"bytes,14,opt,name=primary_email,json=primaryEmail,proto3" json:"primary_email,omitempty"`
+	// False once the employee was deactivated, e.g. when offboarded
+	Active bool `protobuf:"varint,15,opt,name=active,proto3" json:"active,omitempty"`
+	// When the employee was deactivated; unset while active
+	DeactivatedAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"`
+	// Whether the employee was flagged stale and not changed since
+	Stale bool `protobuf:"varint,17,opt,name=stale,proto3" json:"stale,omitempty"`
+	// When the employee was flagged stale; unset unless stale
+	StaleSince *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=stale_since,json=staleSince,proto3" json:"stale_since,omitempty"`
+	// The emails that bounced or were complained about
+	UndeliverableEmails []string `protobuf:"bytes,19,rep,name=undeliverable_emails,json=undeliverableEmails,proto3" json:"undeliverable_emails,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *EmployeeData) Reset() {
@@ -269,6 +340,239 @@ func (x *EmployeeData) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *EmployeeData) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *EmployeeData) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *EmployeeData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EmployeeData) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *EmployeeData) GetPhoneNumbers() []*PhoneNumber {
+	if x != nil {
+		return x.PhoneNumbers
+	}
+	return nil
+}
+
+func (x *EmployeeData) GetAddress() *PostalAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *EmployeeData) GetExternalIds() map[string]string {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *EmployeeData) GetPrimaryEmail() string {
+	if x != nil {
+		return x.PrimaryEmail
+	}
+	return ""
+}
+
+func (x *EmployeeData) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *EmployeeData) GetDeactivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivatedAt
+	}
+	return nil
+}
+
+func (x *EmployeeData) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *EmployeeData) GetStaleSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StaleSince
+	}
+	return nil
+}
+
+func (x *EmployeeData) GetUndeliverableEmails() []string {
+	if x != nil {
+		return x.UndeliverableEmails
+	}
+	return nil
+}
+
+// PostalAddress is the postal address of an employee
+type PostalAddress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Line1 string                 `protobuf:"bytes,1,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2 string                 `protobuf:"bytes,2,opt,name=line2,proto3" json:"line2,omitempty"`
+	City  string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	// State, province or county
+	Region     string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode string `protobuf:"bytes,5,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// ISO 3166-1 alpha-2 country code
+	Country       string `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostalAddress) Reset() {
+	*x = PostalAddress{}
+	mi := &file_events_v1_employee_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostalAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostalAddress) ProtoMessage() {}
+
+func (x *PostalAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_employee_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostalAddress.ProtoReflect.Descriptor instead.
+func (*PostalAddress) Descriptor() ([]byte, []int) {
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PostalAddress) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// PhoneNumber is a phone number of an employee
+type PhoneNumber struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// mobile, work, home or other
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Phone number in E.164 format
+	Number        string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PhoneNumber) Reset() {
+	*x = PhoneNumber{}
+	mi := &file_events_v1_employee_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhoneNumber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhoneNumber) ProtoMessage() {}
+
+func (x *PhoneNumber) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_employee_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhoneNumber.ProtoReflect.Descriptor instead.
+func (*PhoneNumber) Descriptor() ([]byte, []int) {
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PhoneNumber) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PhoneNumber) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
 // EmployeeCreatedEvent is published when a new employee is created
 type EmployeeCreatedEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -279,7 +583,7 @@ type EmployeeCreatedEvent struct {
 
 func (x *EmployeeCreatedEvent) Reset() {
 	*x = EmployeeCreatedEvent{}
-	mi := &file_events_v1_employee_events_proto_msgTypes[2]
+	mi := &file_events_v1_employee_events_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -291,7 +595,7 @@ func (x *EmployeeCreatedEvent) String() string {
 func (*EmployeeCreatedEvent) ProtoMessage() {}
 
 func (x *EmployeeCreatedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_v1_employee_events_proto_msgTypes[2]
+	mi := &file_events_v1_employee_events_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +608,7 @@ func (x *EmployeeCreatedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmployeeCreatedEvent.ProtoReflect.Descriptor instead.
 func (*EmployeeCreatedEvent) Descriptor() ([]byte, []int) {
-	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{2}
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *EmployeeCreatedEvent) GetEvent() *EmployeeEvent {
@@ -320,13 +624,17 @@ type EmployeeUpdatedEvent struct {
 	Event *EmployeeEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	// List of field names that were updated (for tracking changes)
 	UpdatedFields []string `protobuf:"bytes,2,rep,name=updated_fields,json=updatedFields,proto3" json:"updated_fields,omitempty"`
+	// The emails the update added to and removed from the employee, when
+	// emails is among updated_fields
+	AddedEmails   []string `protobuf:"bytes,3,rep,name=added_emails,json=addedEmails,proto3" json:"added_emails,omitempty"`
+	RemovedEmails []string `protobuf:"bytes,4,rep,name=removed_emails,json=removedEmails,proto3" json:"removed_emails,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EmployeeUpdatedEvent) Reset() {
 	*x = EmployeeUpdatedEvent{}
-	mi := &file_events_v1_employee_events_proto_msgTypes[3]
+	mi := &file_events_v1_employee_events_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -338,7 +646,7 @@ func (x *EmployeeUpdatedEvent) String() string {
 func (*EmployeeUpdatedEvent) ProtoMessage() {}
 
 func (x *EmployeeUpdatedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_v1_employee_events_proto_msgTypes[3]
+	mi := &file_events_v1_employee_events_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +659,7 @@ func (x *EmployeeUpdatedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmployeeUpdatedEvent.ProtoReflect.Descriptor instead.
 func (*EmployeeUpdatedEvent) Descriptor() ([]byte, []int) {
-	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{3}
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EmployeeUpdatedEvent) GetEvent() *EmployeeEvent {
@@ -368,6 +676,20 @@ func (x *EmployeeUpdatedEvent) GetUpdatedFields() []string {
 	return nil
 }
 
+func (x *EmployeeUpdatedEvent) GetAddedEmails() []string {
+	if x != nil {
+		return x.AddedEmails
+	}
+	return nil
+}
+
+func (x *EmployeeUpdatedEvent) GetRemovedEmails() []string {
+	if x != nil {
+		return x.RemovedEmails
+	}
+	return nil
+}
+
 // EmployeeDeletedEvent is published when an employee is deleted
 type EmployeeDeletedEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -378,7 +700,7 @@ type EmployeeDeletedEvent struct {
 
 func (x *EmployeeDeletedEvent) Reset() {
 	*x = EmployeeDeletedEvent{}
-	mi := &file_events_v1_employee_events_proto_msgTypes[4]
+	mi := &file_events_v1_employee_events_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -390,7 +712,7 @@ func (x *EmployeeDeletedEvent) String() string {
 func (*EmployeeDeletedEvent) ProtoMessage() {}
 
 func (x *EmployeeDeletedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_v1_employee_events_proto_msgTypes[4]
+	mi := &file_events_v1_employee_events_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +725,7 @@ func (x *EmployeeDeletedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmployeeDeletedEvent.ProtoReflect.Descriptor instead.
 func (*EmployeeDeletedEvent) Descriptor() ([]byte, []int) {
-	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{4}
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EmployeeDeletedEvent) GetEvent() *EmployeeEvent {
@@ -419,13 +741,25 @@ type EmployeeMergedEvent struct {
 	Event *EmployeeEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	// The email address of the employee that was merged (became secondary)
 	MergedFromEmail string `protobuf:"bytes,2,opt,name=merged_from_email,json=mergedFromEmail,proto3" json:"merged_from_email,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// ID of the merge, for UnmergeEmployees
+	MergeId string `protobuf:"bytes,3,opt,name=merge_id,json=mergeId,proto3" json:"merge_id,omitempty"`
+	// The ID of the secondary employee, which no longer exists. Systems keyed
+	// by it should switch to primary_id.
+	SecondaryId string `protobuf:"bytes,4,opt,name=secondary_id,json=secondaryId,proto3" json:"secondary_id,omitempty"`
+	// The ID of the primary employee, which survived the merge
+	PrimaryId string `protobuf:"bytes,5,opt,name=primary_id,json=primaryId,proto3" json:"primary_id,omitempty"`
+	// The emails moved from the secondary to the primary employee
+	MovedEmails []string `protobuf:"bytes,6,rep,name=moved_emails,json=movedEmails,proto3" json:"moved_emails,omitempty"`
+	// The external IDs moved from the secondary to the primary employee, by
+	// system. IDs in systems the primary employee already had are dropped.
+	MovedExternalIds map[string]string `protobuf:"bytes,7,rep,name=moved_external_ids,json=movedExternalIds,proto3" json:"moved_external_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *EmployeeMergedEvent) Reset() {
 	*x = EmployeeMergedEvent{}
-	mi := &file_events_v1_employee_events_proto_msgTypes[5]
+	mi := &file_events_v1_employee_events_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +771,7 @@ func (x *EmployeeMergedEvent) String() string {
 func (*EmployeeMergedEvent) ProtoMessage() {}
 
 func (x *EmployeeMergedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_v1_employee_events_proto_msgTypes[5]
+	mi := &file_events_v1_employee_events_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +784,7 @@ func (x *EmployeeMergedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmployeeMergedEvent.ProtoReflect.Descriptor instead.
 func (*EmployeeMergedEvent) Descriptor() ([]byte, []int) {
-	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{5}
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EmployeeMergedEvent) GetEvent() *EmployeeEvent {
@@ -467,49 +801,650 @@ func (x *EmployeeMergedEvent) GetMergedFromEmail() string {
 	return ""
 }
 
-var File_events_v1_employee_events_proto protoreflect.FileDescriptor
+func (x *EmployeeMergedEvent) GetMergeId() string {
+	if x != nil {
+		return x.MergeId
+	}
+	return ""
+}
 
-const file_events_v1_employee_events_proto_rawDesc = "" +
-	"\n" +
-	"\x1fevents/v1/employee_events.proto\x12\tevents.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x85\x03\n" +
-	"\rEmployeeEvent\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\tR\aeventId\x123\n" +
-	"\n" +
-	"event_type\x18\x02 \x01(\x0e2\x14.events.v1.EventTypeR\teventType\x12\x1b\n" +
-	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x17\n" +
-	"\auser_id\x18\x05 \x01(\tR\x06userId\x123\n" +
-	"\bemployee\x18\x06 \x01(\v2\x17.events.v1.EmployeeDataR\bemployee\x12B\n" +
-	"\bmetadata\x18\a \x03(\v2&.events.v1.EmployeeEvent.MetadataEntryR\bmetadata\x1a;\n" +
-	"\rMetadataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe8\x01\n" +
-	"\fEmployeeData\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06emails\x18\x02 \x03(\tR\x06emails\x12\x1d\n" +
-	"\n" +
-	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
-	"\tlast_name\x18\x04 \x01(\tR\blastName\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"F\n" +
-	"\x14EmployeeCreatedEvent\x12.\n" +
-	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\"m\n" +
-	"\x14EmployeeUpdatedEvent\x12.\n" +
-	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\x12%\n" +
-	"\x0eupdated_fields\x18\x02 \x03(\tR\rupdatedFields\"F\n" +
-	"\x14EmployeeDeletedEvent\x12.\n" +
-	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\"q\n" +
-	"\x13EmployeeMergedEvent\x12.\n" +
-	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\x12*\n" +
-	"\x11merged_from_email\x18\x02 \x01(\tR\x0fmergedFromEmail*\x86\x01\n" +
+func (x *EmployeeMergedEvent) GetSecondaryId() string {
+	if x != nil {
+		return x.SecondaryId
+	}
+	return ""
+}
+
+func (x *EmployeeMergedEvent) GetPrimaryId() string {
+	if x != nil {
+		return x.PrimaryId
+	}
+	return ""
+}
+
+func (x *EmployeeMergedEvent) GetMovedEmails() []string {
+	if x != nil {
+		return x.MovedEmails
+	}
+	return nil
+}
+
+func (x *EmployeeMergedEvent) GetMovedExternalIds() map[string]string {
+	if x != nil {
+		return x.MovedExternalIds
+	}
+	return nil
+}
+
+// EmployeeUnmergedEvent is published when a merge is undone. The event
+// employee is the recreated secondary employee.
+type EmployeeUnmergedEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *EmployeeEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// The primary employee after its emails were moved back
+	Primary *EmployeeData `protobuf:"bytes,2,opt,name=primary,proto3" json:"primary,omitempty"`
+	// ID of the merge that was undone
+	MergeId       string `protobuf:"bytes,3,opt,name=merge_id,json=mergeId,proto3" json:"merge_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeUnmergedEvent) Reset() {
+	*x = EmployeeUnmergedEvent{}
+	mi := &file_events_v1_employee_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeUnmergedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeUnmergedEvent) ProtoMessage() {}
+
+func (x *EmployeeUnmergedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_employee_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeUnmergedEvent.ProtoReflect.Descriptor instead.
+func (*EmployeeUnmergedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EmployeeUnmergedEvent) GetEvent() *EmployeeEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EmployeeUnmergedEvent) GetPrimary() *EmployeeData {
+	if x != nil {
+		return x.Primary
+	}
+	return nil
+}
+
+func (x *EmployeeUnmergedEvent) GetMergeId() string {
+	if x != nil {
+		return x.MergeId
+	}
+	return ""
+}
+
+// EmployeeDeactivatedEvent is published when an employee is deactivated. The
+// employee is kept and can be reactivated.
+type EmployeeDeactivatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *EmployeeEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeDeactivatedEvent) Reset() {
+	*x = EmployeeDeactivatedEvent{}
+	mi := &file_events_v1_employee_events_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeDeactivatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeDeactivatedEvent) ProtoMessage() {}
+
+func (x *EmployeeDeactivatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_employee_events_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeDeactivatedEvent.ProtoReflect.Descriptor instead.
+func (*EmployeeDeactivatedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EmployeeDeactivatedEvent) GetEvent() *EmployeeEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// EmployeeReactivatedEvent is published when a deactivated employee is
+// reactivated
+type EmployeeReactivatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *EmployeeEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeReactivatedEvent) Reset() {
+	*x = EmployeeReactivatedEvent{}
+	mi := &file_events_v1_employee_events_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeReactivatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeReactivatedEvent) ProtoMessage() {}
+
+func (x *EmployeeReactivatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_employee_events_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeReactivatedEvent.ProtoReflect.Descriptor instead.
+func (*EmployeeReactivatedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EmployeeReactivatedEvent) GetEvent() *EmployeeEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// EmployeeStaleEvent is published when the stale-record job flags an
+// employee, for cleanup workflows; the event has no user_id
+type EmployeeStaleEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *EmployeeEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// The signals the employee met: untouched, undeliverable_email
+	Reasons       []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeStaleEvent) Reset() {
+	*x = EmployeeStaleEvent{}
+	mi := &file_events_v1_employee_events_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeStaleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeStaleEvent) ProtoMessage() {}
+
+func (x *EmployeeStaleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_employee_events_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeStaleEvent.ProtoReflect.Descriptor instead.
+func (*EmployeeStaleEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EmployeeStaleEvent) GetEvent() *EmployeeEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EmployeeStaleEvent) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+// DepartmentData contains the department information
+type DepartmentData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Department ID (UUID v4)
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartmentData) Reset() {
+	*x = DepartmentData{}
+	mi := &file_events_v1_employee_events_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartmentData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentData) ProtoMessage() {}
+
+func (x *DepartmentData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_employee_events_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentData.ProtoReflect.Descriptor instead.
+func (*DepartmentData) Descriptor() ([]byte, []int) {
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DepartmentData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DepartmentData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DepartmentData) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DepartmentData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DepartmentData) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// DepartmentEvent is published on employees.v1.departments.{created,updated,deleted}
+// when a department changes. The event carries no employee.
+type DepartmentEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *EmployeeEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// The department after the change, or as it was before a delete
+	Department    *DepartmentData `protobuf:"bytes,2,opt,name=department,proto3" json:"department,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartmentEvent) Reset() {
+	*x = DepartmentEvent{}
+	mi := &file_events_v1_employee_events_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartmentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentEvent) ProtoMessage() {}
+
+func (x *DepartmentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_employee_events_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentEvent.ProtoReflect.Descriptor instead.
+func (*DepartmentEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DepartmentEvent) GetEvent() *EmployeeEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *DepartmentEvent) GetDepartment() *DepartmentData {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+// TeamData contains the team information
+type TeamData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Team ID (UUID v4)
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamData) Reset() {
+	*x = TeamData{}
+	mi := &file_events_v1_employee_events_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamData) ProtoMessage() {}
+
+func (x *TeamData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_employee_events_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamData.ProtoReflect.Descriptor instead.
+func (*TeamData) Descriptor() ([]byte, []int) {
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TeamData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TeamData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TeamData) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TeamData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TeamData) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// TeamEvent is published on employees.v1.teams.created when a team is created
+// and on employees.v1.teams.members.{added,removed} when its membership
+// changes. The event employee is the added or removed member, and is unset
+// for created teams.
+type TeamEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *EmployeeEvent         `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Team          *TeamData              `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamEvent) Reset() {
+	*x = TeamEvent{}
+	mi := &file_events_v1_employee_events_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamEvent) ProtoMessage() {}
+
+func (x *TeamEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_employee_events_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamEvent.ProtoReflect.Descriptor instead.
+func (*TeamEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_employee_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TeamEvent) GetEvent() *EmployeeEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *TeamEvent) GetTeam() *TeamData {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+var File_events_v1_employee_events_proto protoreflect.FileDescriptor
+
+const file_events_v1_employee_events_proto_rawDesc = "" +
+	"\n" +
+	"\x1fevents/v1/employee_events.proto\x12\tevents.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x99\x03\n" +
+	"\rEmployeeEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x123\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\x0e2\x14.events.v1.EventTypeR\teventType\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x123\n" +
+	"\bemployee\x18\x06 \x01(\v2\x17.events.v1.EmployeeDataR\bemployee\x12B\n" +
+	"\bmetadata\x18\a \x03(\v2&.events.v1.EmployeeEvent.MetadataEntryR\bmetadata\x12\x12\n" +
+	"\x04slim\x18\b \x01(\bR\x04slim\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xda\x06\n" +
+	"\fEmployeeData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06emails\x18\x02 \x03(\tR\x06emails\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x04 \x01(\tR\blastName\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rdepartment_id\x18\a \x01(\tR\fdepartmentId\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\b \x01(\tR\tmanagerId\x12\x14\n" +
+	"\x05title\x18\t \x01(\tR\x05title\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12;\n" +
+	"\rphone_numbers\x18\v \x03(\v2\x16.events.v1.PhoneNumberR\fphoneNumbers\x122\n" +
+	"\aaddress\x18\f \x01(\v2\x18.events.v1.PostalAddressR\aaddress\x12K\n" +
+	"\fexternal_ids\x18\r \x03(\v2(.events.v1.EmployeeData.ExternalIdsEntryR\vexternalIds\x12#\n" +
+	"\rprimary_email\x18\x0e \x01(\tR\fprimaryEmail\x12\x16\n" +
+	"\x06active\x18\x0f \x01(\bR\x06active\x12A\n" +
+	"\x0edeactivated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\rdeactivatedAt\x12\x14\n" +
+	"\x05stale\x18\x11 \x01(\bR\x05stale\x12;\n" +
+	"\vstale_since\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"staleSince\x121\n" +
+	"\x14undeliverable_emails\x18\x13 \x03(\tR\x13undeliverableEmails\x1a>\n" +
+	"\x10ExternalIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa2\x01\n" +
+	"\rPostalAddress\x12\x14\n" +
+	"\x05line1\x18\x01 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x02 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\x05 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\"9\n" +
+	"\vPhoneNumber\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\"F\n" +
+	"\x14EmployeeCreatedEvent\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\"\xb7\x01\n" +
+	"\x14EmployeeUpdatedEvent\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\x12%\n" +
+	"\x0eupdated_fields\x18\x02 \x03(\tR\rupdatedFields\x12!\n" +
+	"\fadded_emails\x18\x03 \x03(\tR\vaddedEmails\x12%\n" +
+	"\x0eremoved_emails\x18\x04 \x03(\tR\rremovedEmails\"F\n" +
+	"\x14EmployeeDeletedEvent\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\"\x9a\x03\n" +
+	"\x13EmployeeMergedEvent\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\x12*\n" +
+	"\x11merged_from_email\x18\x02 \x01(\tR\x0fmergedFromEmail\x12\x19\n" +
+	"\bmerge_id\x18\x03 \x01(\tR\amergeId\x12!\n" +
+	"\fsecondary_id\x18\x04 \x01(\tR\vsecondaryId\x12\x1d\n" +
+	"\n" +
+	"primary_id\x18\x05 \x01(\tR\tprimaryId\x12!\n" +
+	"\fmoved_emails\x18\x06 \x03(\tR\vmovedEmails\x12b\n" +
+	"\x12moved_external_ids\x18\a \x03(\v24.events.v1.EmployeeMergedEvent.MovedExternalIdsEntryR\x10movedExternalIds\x1aC\n" +
+	"\x15MovedExternalIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x95\x01\n" +
+	"\x15EmployeeUnmergedEvent\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\x121\n" +
+	"\aprimary\x18\x02 \x01(\v2\x17.events.v1.EmployeeDataR\aprimary\x12\x19\n" +
+	"\bmerge_id\x18\x03 \x01(\tR\amergeId\"J\n" +
+	"\x18EmployeeDeactivatedEvent\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\"J\n" +
+	"\x18EmployeeReactivatedEvent\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\"^\n" +
+	"\x12EmployeeStaleEvent\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\x12\x18\n" +
+	"\areasons\x18\x02 \x03(\tR\areasons\"\xcc\x01\n" +
+	"\x0eDepartmentData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"|\n" +
+	"\x0fDepartmentEvent\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\x129\n" +
+	"\n" +
+	"department\x18\x02 \x01(\v2\x19.events.v1.DepartmentDataR\n" +
+	"department\"\xc6\x01\n" +
+	"\bTeamData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"d\n" +
+	"\tTeamEvent\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.events.v1.EmployeeEventR\x05event\x12'\n" +
+	"\x04team\x18\x02 \x01(\v2\x13.events.v1.TeamDataR\x04team*\xb9\x03\n" +
 	"\tEventType\x12\x1a\n" +
 	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12EVENT_TYPE_CREATED\x10\x01\x12\x16\n" +
 	"\x12EVENT_TYPE_UPDATED\x10\x02\x12\x16\n" +
 	"\x12EVENT_TYPE_DELETED\x10\x03\x12\x15\n" +
-	"\x11EVENT_TYPE_MERGED\x10\x04B?\n" +
+	"\x11EVENT_TYPE_MERGED\x10\x04\x12\x17\n" +
+	"\x13EVENT_TYPE_UNMERGED\x10\x05\x12!\n" +
+	"\x1dEVENT_TYPE_DEPARTMENT_CREATED\x10\x06\x12!\n" +
+	"\x1dEVENT_TYPE_DEPARTMENT_UPDATED\x10\a\x12!\n" +
+	"\x1dEVENT_TYPE_DEPARTMENT_DELETED\x10\b\x12\x1b\n" +
+	"\x17EVENT_TYPE_TEAM_CREATED\x10\t\x12 \n" +
+	"\x1cEVENT_TYPE_TEAM_MEMBER_ADDED\x10\n" +
+	"\x12\"\n" +
+	"\x1eEVENT_TYPE_TEAM_MEMBER_REMOVED\x10\v\x12\x1a\n" +
+	"\x16EVENT_TYPE_DEACTIVATED\x10\f\x12\x1a\n" +
+	"\x16EVENT_TYPE_REACTIVATED\x10\r\x12\x14\n" +
+	"\x10EVENT_TYPE_STALE\x10\x0eB?\n" +
 	"\x18dev.kratos.api.events.v1P\x01Z!employee-service/api/events/v1;v1b\x06proto3"
 
 var (
@@ -525,34 +1460,65 @@ func file_events_v1_employee_events_proto_rawDescGZIP() []byte {
 }
 
 var file_events_v1_employee_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_events_v1_employee_events_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_events_v1_employee_events_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_events_v1_employee_events_proto_goTypes = []any{
-	(EventType)(0),                // 0: events.v1.EventType
-	(*EmployeeEvent)(nil),         // 1: events.v1.EmployeeEvent
-	(*EmployeeData)(nil),          // 2: events.v1.EmployeeData
-	(*EmployeeCreatedEvent)(nil),  // 3: events.v1.EmployeeCreatedEvent
-	(*EmployeeUpdatedEvent)(nil),  // 4: events.v1.EmployeeUpdatedEvent
-	(*EmployeeDeletedEvent)(nil),  // 5: events.v1.EmployeeDeletedEvent
-	(*EmployeeMergedEvent)(nil),   // 6: events.v1.EmployeeMergedEvent
-	nil,                           // 7: events.v1.EmployeeEvent.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(EventType)(0),                   // 0: events.v1.EventType
+	(*EmployeeEvent)(nil),            // 1: events.v1.EmployeeEvent
+	(*EmployeeData)(nil),             // 2: events.v1.EmployeeData
+	(*PostalAddress)(nil),            // 3: events.v1.PostalAddress
+	(*PhoneNumber)(nil),              // 4: events.v1.PhoneNumber
+	(*EmployeeCreatedEvent)(nil),     // 5: events.v1.EmployeeCreatedEvent
+	(*EmployeeUpdatedEvent)(nil),     // 6: events.v1.EmployeeUpdatedEvent
+	(*EmployeeDeletedEvent)(nil),     // 7: events.v1.EmployeeDeletedEvent
+	(*EmployeeMergedEvent)(nil),      // 8: events.v1.EmployeeMergedEvent
+	(*EmployeeUnmergedEvent)(nil),    // 9: events.v1.EmployeeUnmergedEvent
+	(*EmployeeDeactivatedEvent)(nil), // 10: events.v1.EmployeeDeactivatedEvent
+	(*EmployeeReactivatedEvent)(nil), // 11: events.v1.EmployeeReactivatedEvent
+	(*EmployeeStaleEvent)(nil),       // 12: events.v1.EmployeeStaleEvent
+	(*DepartmentData)(nil),           // 13: events.v1.DepartmentData
+	(*DepartmentEvent)(nil),          // 14: events.v1.DepartmentEvent
+	(*TeamData)(nil),                 // 15: events.v1.TeamData
+	(*TeamEvent)(nil),                // 16: events.v1.TeamEvent
+	nil,                              // 17: events.v1.EmployeeEvent.MetadataEntry
+	nil,                              // 18: events.v1.EmployeeData.ExternalIdsEntry
+	nil,                              // 19: events.v1.EmployeeMergedEvent.MovedExternalIdsEntry
+	(*timestamppb.Timestamp)(nil),    // 20: google.protobuf.Timestamp
 }
 var file_events_v1_employee_events_proto_depIdxs = []int32{
 	0,  // 0: events.v1.EmployeeEvent.event_type:type_name -> events.v1.EventType
-	8,  // 1: events.v1.EmployeeEvent.timestamp:type_name -> google.protobuf.Timestamp
+	20, // 1: events.v1.EmployeeEvent.timestamp:type_name -> google.protobuf.Timestamp
 	2,  // 2: events.v1.EmployeeEvent.employee:type_name -> events.v1.EmployeeData
-	7,  // 3: events.v1.EmployeeEvent.metadata:type_name -> events.v1.EmployeeEvent.MetadataEntry
-	8,  // 4: events.v1.EmployeeData.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 5: events.v1.EmployeeData.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 6: events.v1.EmployeeCreatedEvent.event:type_name -> events.v1.EmployeeEvent
-	1,  // 7: events.v1.EmployeeUpdatedEvent.event:type_name -> events.v1.EmployeeEvent
-	1,  // 8: events.v1.EmployeeDeletedEvent.event:type_name -> events.v1.EmployeeEvent
-	1,  // 9: events.v1.EmployeeMergedEvent.event:type_name -> events.v1.EmployeeEvent
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	17, // 3: events.v1.EmployeeEvent.metadata:type_name -> events.v1.EmployeeEvent.MetadataEntry
+	20, // 4: events.v1.EmployeeData.created_at:type_name -> google.protobuf.Timestamp
+	20, // 5: events.v1.EmployeeData.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 6: events.v1.EmployeeData.phone_numbers:type_name -> events.v1.PhoneNumber
+	3,  // 7: events.v1.EmployeeData.address:type_name -> events.v1.PostalAddress
+	18, // 8: events.v1.EmployeeData.external_ids:type_name -> events.v1.EmployeeData.ExternalIdsEntry
+	20, // 9: events.v1.EmployeeData.deactivated_at:type_name -> google.protobuf.Timestamp
+	20, // 10: events.v1.EmployeeData.stale_since:type_name -> google.protobuf.Timestamp
+	1,  // 11: events.v1.EmployeeCreatedEvent.event:type_name -> events.v1.EmployeeEvent
+	1,  // 12: events.v1.EmployeeUpdatedEvent.event:type_name -> events.v1.EmployeeEvent
+	1,  // 13: events.v1.EmployeeDeletedEvent.event:type_name -> events.v1.EmployeeEvent
+	1,  // 14: events.v1.EmployeeMergedEvent.event:type_name -> events.v1.EmployeeEvent
+	19, // 15: events.v1.EmployeeMergedEvent.moved_external_ids:type_name -> events.v1.EmployeeMergedEvent.MovedExternalIdsEntry
+	1,  // 16: events.v1.EmployeeUnmergedEvent.event:type_name -> events.v1.EmployeeEvent
+	2,  // 17: events.v1.EmployeeUnmergedEvent.primary:type_name -> events.v1.EmployeeData
+	1,  // 18: events.v1.EmployeeDeactivatedEvent.event:type_name -> events.v1.EmployeeEvent
+	1,  // 19: events.v1.EmployeeReactivatedEvent.event:type_name -> events.v1.EmployeeEvent
+	1,  // 20: events.v1.EmployeeStaleEvent.event:type_name -> events.v1.EmployeeEvent
+	20, // 21: events.v1.DepartmentData.created_at:type_name -> google.protobuf.Timestamp
+	20, // 22: events.v1.DepartmentData.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 23: events.v1.DepartmentEvent.event:type_name -> events.v1.EmployeeEvent
+	13, // 24: events.v1.DepartmentEvent.department:type_name -> events.v1.DepartmentData
+	20, // 25: events.v1.TeamData.created_at:type_name -> google.protobuf.Timestamp
+	20, // 26: events.v1.TeamData.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 27: events.v1.TeamEvent.event:type_name -> events.v1.EmployeeEvent
+	15, // 28: events.v1.TeamEvent.team:type_name -> events.v1.TeamData
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_events_v1_employee_events_proto_init() }
@@ -566,7 +1532,7 @@ func file_events_v1_employee_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_employee_events_proto_rawDesc), len(file_events_v1_employee_events_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
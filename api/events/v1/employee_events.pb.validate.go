@@ -291,6 +291,114 @@ func (m *EmployeeData) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for DepartmentId
+
+	// no validation rules for ManagerId
+
+	// no validation rules for Title
+
+	// no validation rules for Tags
+
+	// no validation rules for PrimaryEmail
+
+	for idx, item := range m.GetPhoneNumbers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EmployeeDataValidationError{
+						field:  fmt.Sprintf("PhoneNumbers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EmployeeDataValidationError{
+						field:  fmt.Sprintf("PhoneNumbers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EmployeeDataValidationError{
+					field:  fmt.Sprintf("PhoneNumbers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Active
+
+	if all {
+		switch v := interface{}(m.GetDeactivatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EmployeeDataValidationError{
+					field:  "DeactivatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EmployeeDataValidationError{
+					field:  "DeactivatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeactivatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EmployeeDataValidationError{
+				field:  "DeactivatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Stale
+
+	if all {
+		switch v := interface{}(m.GetStaleSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EmployeeDataValidationError{
+					field:  "StaleSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EmployeeDataValidationError{
+					field:  "StaleSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStaleSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EmployeeDataValidationError{
+				field:  "StaleSince",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UndeliverableEmails
+
 	if len(errors) > 0 {
 		return EmployeeDataMultiError(errors)
 	}
@@ -368,6 +476,109 @@ var _ interface {
 	ErrorName() string
 } = EmployeeDataValidationError{}
 
+// Validate checks the field values on PhoneNumber with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PhoneNumber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PhoneNumber with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PhoneNumberMultiError, or
+// nil if none found.
+func (m *PhoneNumber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PhoneNumber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return PhoneNumberMultiError(errors)
+	}
+
+	return nil
+}
+
+// PhoneNumberMultiError is an error wrapping multiple validation errors
+// returned by PhoneNumber.ValidateAll() if the designated constraints aren't met.
+type PhoneNumberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PhoneNumberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PhoneNumberMultiError) AllErrors() []error { return m }
+
+// PhoneNumberValidationError is the validation error returned by
+// PhoneNumber.Validate if the designated constraints aren't met.
+type PhoneNumberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PhoneNumberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PhoneNumberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PhoneNumberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PhoneNumberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PhoneNumberValidationError) ErrorName() string { return "PhoneNumberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PhoneNumberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPhoneNumber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PhoneNumberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PhoneNumberValidationError{}
+
 // Validate checks the field values on EmployeeCreatedEvent with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -814,6 +1025,16 @@ func (m *EmployeeMergedEvent) validate(all bool) error {
 
 	// no validation rules for MergedFromEmail
 
+	// no validation rules for MergeId
+
+	// no validation rules for SecondaryId
+
+	// no validation rules for PrimaryId
+
+	// no validation rules for MovedEmails
+
+	// no validation rules for MovedExternalIds
+
 	if len(errors) > 0 {
 		return EmployeeMergedEventMultiError(errors)
 	}
@@ -893,3 +1114,1204 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = EmployeeMergedEventValidationError{}
+
+// Validate checks the field values on EmployeeUnmergedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EmployeeUnmergedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmployeeUnmergedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EmployeeUnmergedEventMultiError, or nil if none found.
+func (m *EmployeeUnmergedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmployeeUnmergedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EmployeeUnmergedEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EmployeeUnmergedEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EmployeeUnmergedEventValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrimary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EmployeeUnmergedEventValidationError{
+					field:  "Primary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EmployeeUnmergedEventValidationError{
+					field:  "Primary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrimary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EmployeeUnmergedEventValidationError{
+				field:  "Primary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MergeId
+
+	if len(errors) > 0 {
+		return EmployeeUnmergedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmployeeUnmergedEventMultiError is an error wrapping multiple validation
+// errors returned by EmployeeUnmergedEvent.ValidateAll() if the designated
+// constraints aren't met.
+type EmployeeUnmergedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmployeeUnmergedEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmployeeUnmergedEventMultiError) AllErrors() []error { return m }
+
+// EmployeeUnmergedEventValidationError is the validation error returned by
+// EmployeeUnmergedEvent.Validate if the designated constraints aren't met.
+type EmployeeUnmergedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmployeeUnmergedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmployeeUnmergedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmployeeUnmergedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmployeeUnmergedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmployeeUnmergedEventValidationError) ErrorName() string {
+	return "EmployeeUnmergedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EmployeeUnmergedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmployeeUnmergedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmployeeUnmergedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmployeeUnmergedEventValidationError{}
+
+// Validate checks the field values on EmployeeDeactivatedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EmployeeDeactivatedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmployeeDeactivatedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EmployeeDeactivatedEventMultiError, or nil if none found.
+func (m *EmployeeDeactivatedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmployeeDeactivatedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EmployeeDeactivatedEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EmployeeDeactivatedEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EmployeeDeactivatedEventValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EmployeeDeactivatedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmployeeDeactivatedEventMultiError is an error wrapping multiple validation
+// errors returned by EmployeeDeactivatedEvent.ValidateAll() if the designated
+// constraints aren't met.
+type EmployeeDeactivatedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmployeeDeactivatedEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmployeeDeactivatedEventMultiError) AllErrors() []error { return m }
+
+// EmployeeDeactivatedEventValidationError is the validation error returned by
+// EmployeeDeactivatedEvent.Validate if the designated constraints aren't met.
+type EmployeeDeactivatedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmployeeDeactivatedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmployeeDeactivatedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmployeeDeactivatedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmployeeDeactivatedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmployeeDeactivatedEventValidationError) ErrorName() string {
+	return "EmployeeDeactivatedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EmployeeDeactivatedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmployeeDeactivatedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmployeeDeactivatedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmployeeDeactivatedEventValidationError{}
+
+// Validate checks the field values on EmployeeReactivatedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EmployeeReactivatedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmployeeReactivatedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EmployeeReactivatedEventMultiError, or nil if none found.
+func (m *EmployeeReactivatedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmployeeReactivatedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EmployeeReactivatedEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EmployeeReactivatedEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EmployeeReactivatedEventValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EmployeeReactivatedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmployeeReactivatedEventMultiError is an error wrapping multiple validation
+// errors returned by EmployeeReactivatedEvent.ValidateAll() if the designated
+// constraints aren't met.
+type EmployeeReactivatedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmployeeReactivatedEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmployeeReactivatedEventMultiError) AllErrors() []error { return m }
+
+// EmployeeReactivatedEventValidationError is the validation error returned by
+// EmployeeReactivatedEvent.Validate if the designated constraints aren't met.
+type EmployeeReactivatedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmployeeReactivatedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmployeeReactivatedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmployeeReactivatedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmployeeReactivatedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmployeeReactivatedEventValidationError) ErrorName() string {
+	return "EmployeeReactivatedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EmployeeReactivatedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmployeeReactivatedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmployeeReactivatedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmployeeReactivatedEventValidationError{}
+
+// Validate checks the field values on EmployeeStaleEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EmployeeStaleEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmployeeStaleEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EmployeeStaleEventMultiError, or nil if none found.
+func (m *EmployeeStaleEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmployeeStaleEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EmployeeStaleEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EmployeeStaleEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EmployeeStaleEventValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EmployeeStaleEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmployeeStaleEventMultiError is an error wrapping multiple validation
+// errors returned by EmployeeStaleEvent.ValidateAll() if the designated
+// constraints aren't met.
+type EmployeeStaleEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmployeeStaleEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmployeeStaleEventMultiError) AllErrors() []error { return m }
+
+// EmployeeStaleEventValidationError is the validation error returned by
+// EmployeeStaleEvent.Validate if the designated constraints aren't met.
+type EmployeeStaleEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmployeeStaleEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmployeeStaleEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmployeeStaleEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmployeeStaleEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmployeeStaleEventValidationError) ErrorName() string {
+	return "EmployeeStaleEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EmployeeStaleEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmployeeStaleEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmployeeStaleEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmployeeStaleEventValidationError{}
+
+// Validate checks the field values on DepartmentData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DepartmentData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepartmentData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DepartmentDataMultiError, or
+// nil if none found.
+func (m *DepartmentData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepartmentData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DepartmentDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DepartmentDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DepartmentDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DepartmentDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DepartmentDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DepartmentDataValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DepartmentDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepartmentDataMultiError is an error wrapping multiple validation errors
+// returned by DepartmentData.ValidateAll() if the designated constraints aren't met.
+type DepartmentDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepartmentDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepartmentDataMultiError) AllErrors() []error { return m }
+
+// DepartmentDataValidationError is the validation error returned by
+// DepartmentData.Validate if the designated constraints aren't met.
+type DepartmentDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepartmentDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepartmentDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepartmentDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepartmentDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepartmentDataValidationError) ErrorName() string { return "DepartmentDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DepartmentDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepartmentData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepartmentDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepartmentDataValidationError{}
+
+// Validate checks the field values on DepartmentEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepartmentEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepartmentEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DepartmentEventMultiError, or nil if none found.
+func (m *DepartmentEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepartmentEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DepartmentEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DepartmentEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DepartmentEventValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDepartment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DepartmentEventValidationError{
+					field:  "Department",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DepartmentEventValidationError{
+					field:  "Department",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDepartment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DepartmentEventValidationError{
+				field:  "Department",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DepartmentEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepartmentEventMultiError is an error wrapping multiple validation
+// errors returned by DepartmentEvent.ValidateAll() if the designated
+// constraints aren't met.
+type DepartmentEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepartmentEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepartmentEventMultiError) AllErrors() []error { return m }
+
+// DepartmentEventValidationError is the validation error returned by
+// DepartmentEvent.Validate if the designated constraints aren't met.
+type DepartmentEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepartmentEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepartmentEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepartmentEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepartmentEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepartmentEventValidationError) ErrorName() string {
+	return "DepartmentEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DepartmentEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepartmentEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepartmentEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepartmentEventValidationError{}
+
+// Validate checks the field values on TeamData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TeamData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeamData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TeamDataMultiError, or
+// nil if none found.
+func (m *TeamData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeamData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamDataValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TeamDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeamDataMultiError is an error wrapping multiple validation errors
+// returned by TeamData.ValidateAll() if the designated constraints aren't met.
+type TeamDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeamDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeamDataMultiError) AllErrors() []error { return m }
+
+// TeamDataValidationError is the validation error returned by
+// TeamData.Validate if the designated constraints aren't met.
+type TeamDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeamDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeamDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeamDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeamDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeamDataValidationError) ErrorName() string { return "TeamDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TeamDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeamData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeamDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeamDataValidationError{}
+
+// Validate checks the field values on TeamEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TeamEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeamEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TeamEventMultiError, or nil if none found.
+func (m *TeamEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeamEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamEventValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamEventValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTeam()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamEventValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamEventValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeam()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamEventValidationError{
+				field:  "Team",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TeamEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeamEventMultiError is an error wrapping multiple validation
+// errors returned by TeamEvent.ValidateAll() if the designated
+// constraints aren't met.
+type TeamEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeamEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeamEventMultiError) AllErrors() []error { return m }
+
+// TeamEventValidationError is the validation error returned by
+// TeamEvent.Validate if the designated constraints aren't met.
+type TeamEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeamEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeamEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeamEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeamEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeamEventValidationError) ErrorName() string {
+	return "TeamEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TeamEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeamEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeamEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeamEventValidationError{}
@@ -0,0 +1,118 @@
+// Command backfill publishes employees.v1.created events for the existing
+// employees of a tenant, so that consumers adopting the event stream can
+// build their state from events alone. It calls BackfillEvents batch by
+// batch at a limited rate; the events carry metadata backfill=true.
+//
+// The cursor of the next batch is logged after every batch: pass it to
+// -cursor to resume an interrupted backfill.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	adminv1 "github.com/cvele/employee-service/api/admin/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	apiURL    string
+	token     string
+	apiKey    string
+	cursor    string
+	batchSize int
+	rate      float64
+)
+
+func init() {
+	flag.StringVar(&apiURL, "api", "http://localhost:8000", "HTTP API of the service")
+	flag.StringVar(&token, "token", os.Getenv("EMPLOYEE_SERVICE_TOKEN"), "JWT of the tenant to backfill, with an admin role")
+	flag.StringVar(&apiKey, "api-key", os.Getenv("EMPLOYEE_SERVICE_API_KEY"), "API key to authenticate with instead of -token")
+	flag.StringVar(&cursor, "cursor", "", "cursor logged by an interrupted backfill, to resume it")
+	flag.IntVar(&batchSize, "batch-size", 100, "employees published per call, at most 500")
+	flag.Float64Var(&rate, "rate", 100, "events published per second at most")
+}
+
+func main() {
+	flag.Parse()
+	if token == "" && apiKey == "" {
+		log.Fatal("-token or -api-key is required")
+	}
+	if batchSize < 1 || batchSize > 500 || rate <= 0 {
+		log.Fatal("-batch-size must be between 1 and 500 and -rate positive")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	total := 0
+	for {
+		start := time.Now()
+		reply, err := backfill(ctx, cursor)
+		if err != nil {
+			log.Fatalf("Backfill failed, resume with -cursor %q: %v", cursor, err)
+		}
+		total += int(reply.GetPublished())
+		cursor = reply.GetNextCursor()
+		if cursor == "" {
+			log.Printf("✓ Backfill complete: %d events published", total)
+			return
+		}
+		log.Printf("%d events published, next -cursor %q", total, cursor)
+
+		// Spread the batches so that the events average out at rate
+		wait := time.Duration(float64(reply.GetPublished())/rate*float64(time.Second)) - time.Since(start)
+		select {
+		case <-ctx.Done():
+			log.Fatalf("Interrupted, resume with -cursor %q", cursor)
+		case <-time.After(wait):
+		}
+	}
+}
+
+// backfill publishes the batch after cursor
+func backfill(ctx context.Context, cursor string) (*adminv1.BackfillEventsResponse, error) {
+	body, err := protojson.Marshal(&adminv1.BackfillEventsRequest{Cursor: cursor, BatchSize: int32(batchSize)})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+"/api/v1/admin/events:backfill", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else {
+		req.Header.Set("X-API-Key", apiKey)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	reply, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %.512s", resp.Status, reply)
+	}
+
+	var out adminv1.BackfillEventsResponse
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(reply, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -2,28 +2,92 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	eventsv1 "github.com/cvele/employee-service/api/events/v1"
+	"github.com/cvele/employee-service/internal/observability"
+	"github.com/cvele/employee-service/pkg/eventcrypto"
 
+	kratoslog "github.com/go-kratos/kratos/v2/log"
 	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/protobuf/proto"
 )
 
 var (
-	natsURL string
+	natsURL        string
+	keys           string
+	signingKeysURL string
+	pushgatewayURL string
 )
 
 func init() {
 	flag.StringVar(&natsURL, "nats", "nats://localhost:4222", "NATS server URL")
+	flag.StringVar(&keys, "keys", os.Getenv("EVENT_ENCRYPTION_KEYS"), "event decryption keys as id=base64key,...")
+	flag.StringVar(&signingKeysURL, "signing-keys", os.Getenv("EVENT_SIGNING_KEYS_URL"), "URL of the event signing keys, e.g. http://localhost:8000/.well-known/event-signing-keys")
+	flag.StringVar(&pushgatewayURL, "pushgateway", os.Getenv("PUSHGATEWAY_URL"), "Pushgateway URL to push consumer metrics to while running and on exit")
 }
 
 func main() {
 	flag.Parse()
+	keyring, err := eventcrypto.ParseKeyring(keys)
+	if err != nil {
+		log.Fatalf("Invalid -keys: %v", err)
+	}
+
+	// Verify signatures when the signing keys are known
+	var verifier *eventcrypto.Verifier
+	if signingKeysURL != "" {
+		verifier, err = eventcrypto.FetchVerifier(context.Background(), http.DefaultClient, signingKeysURL)
+		if err != nil {
+			log.Fatalf("Failed to fetch event signing keys: %v", err)
+		}
+		log.Printf("✓ Verifying event signatures with keys from %s", signingKeysURL)
+	}
+
+	// Consumer batches end before anything scrapes them; their counts are
+	// pushed instead
+	consumed := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "employee_service_consumer_events_total",
+		Help: "Events received by the consumer by subject and result (ok, rejected).",
+	}, []string{"subject", "result"})
+	if pushgatewayURL != "" {
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(consumed)
+		pusher := observability.NewPusher(pushgatewayURL, "employee_service_consumer", nil, registry, kratoslog.DefaultLogger)
+		pusher.Start(15 * time.Second)
+		defer func() {
+			if err := pusher.Stop(context.Background()); err != nil {
+				log.Printf("✗ Failed to push metrics: %v", err)
+			}
+		}()
+		log.Printf("✓ Pushing metrics to %s", pushgatewayURL)
+	}
+
+	// payload verifies and decrypts an event
+	payload := func(msg *nats.Msg) ([]byte, error) {
+		data, err := func() ([]byte, error) {
+			if verifier != nil {
+				if err := verifier.Verify(msg); err != nil {
+					return nil, err
+				}
+			}
+			return keyring.Decrypt(msg)
+		}()
+		result := "ok"
+		if err != nil {
+			result = "rejected"
+		}
+		consumed.WithLabelValues(msg.Subject, result).Inc()
+		return data, err
+	}
 
 	// Connect to NATS
 	nc, err := nats.Connect(natsURL)
@@ -39,12 +103,21 @@ func main() {
 	log.Println("  - employees.v1.updated")
 	log.Println("  - employees.v1.deleted")
 	log.Println("  - employees.v1.merged")
+	log.Println("  - employees.v1.unmerged")
+	log.Println("  - employees.v1.deactivated")
+	log.Println("  - employees.v1.reactivated")
+	log.Println("  - employees.v1.stale")
 	log.Println()
 
 	// Subscribe to employee created events
 	_, err = nc.Subscribe("employees.v1.created", func(msg *nats.Msg) {
 		var event eventsv1.EmployeeCreatedEvent
-		if err := proto.Unmarshal(msg.Data, &event); err != nil {
+		data, err := payload(msg)
+		if err != nil {
+			log.Printf("✗ Error verifying created event: %v", err)
+			return
+		}
+		if err := proto.Unmarshal(data, &event); err != nil {
 			log.Printf("✗ Error unmarshaling created event: %v", err)
 			return
 		}
@@ -57,7 +130,12 @@ func main() {
 	// Subscribe to employee updated events
 	_, err = nc.Subscribe("employees.v1.updated", func(msg *nats.Msg) {
 		var event eventsv1.EmployeeUpdatedEvent
-		if err := proto.Unmarshal(msg.Data, &event); err != nil {
+		data, err := payload(msg)
+		if err != nil {
+			log.Printf("✗ Error verifying updated event: %v", err)
+			return
+		}
+		if err := proto.Unmarshal(data, &event); err != nil {
 			log.Printf("✗ Error unmarshaling updated event: %v", err)
 			return
 		}
@@ -73,7 +151,12 @@ func main() {
 	// Subscribe to employee deleted events
 	_, err = nc.Subscribe("employees.v1.deleted", func(msg *nats.Msg) {
 		var event eventsv1.EmployeeDeletedEvent
-		if err := proto.Unmarshal(msg.Data, &event); err != nil {
+		data, err := payload(msg)
+		if err != nil {
+			log.Printf("✗ Error verifying deleted event: %v", err)
+			return
+		}
+		if err := proto.Unmarshal(data, &event); err != nil {
 			log.Printf("✗ Error unmarshaling deleted event: %v", err)
 			return
 		}
@@ -86,7 +169,12 @@ func main() {
 	// Subscribe to employee merged events
 	_, err = nc.Subscribe("employees.v1.merged", func(msg *nats.Msg) {
 		var event eventsv1.EmployeeMergedEvent
-		if err := proto.Unmarshal(msg.Data, &event); err != nil {
+		data, err := payload(msg)
+		if err != nil {
+			log.Printf("✗ Error verifying merged event: %v", err)
+			return
+		}
+		if err := proto.Unmarshal(data, &event); err != nil {
 			log.Printf("✗ Error unmarshaling merged event: %v", err)
 			return
 		}
@@ -99,6 +187,83 @@ func main() {
 		log.Fatalf("Failed to subscribe to merged events: %v", err)
 	}
 
+	// Subscribe to employee unmerged events
+	_, err = nc.Subscribe("employees.v1.unmerged", func(msg *nats.Msg) {
+		var event eventsv1.EmployeeUnmergedEvent
+		data, err := payload(msg)
+		if err != nil {
+			log.Printf("✗ Error verifying unmerged event: %v", err)
+			return
+		}
+		if err := proto.Unmarshal(data, &event); err != nil {
+			log.Printf("✗ Error unmarshaling unmerged event: %v", err)
+			return
+		}
+		printEvent("UNMERGED", event.Event)
+		log.Printf("  Merge ID: %s", event.MergeId)
+		if event.Primary != nil {
+			log.Printf("  Primary:  %s (%v)", event.Primary.Id, event.Primary.Emails)
+		}
+	})
+	if err != nil {
+		log.Fatalf("Failed to subscribe to unmerged events: %v", err)
+	}
+
+	// Subscribe to employee deactivated events
+	_, err = nc.Subscribe("employees.v1.deactivated", func(msg *nats.Msg) {
+		var event eventsv1.EmployeeDeactivatedEvent
+		data, err := payload(msg)
+		if err != nil {
+			log.Printf("✗ Error verifying deactivated event: %v", err)
+			return
+		}
+		if err := proto.Unmarshal(data, &event); err != nil {
+			log.Printf("✗ Error unmarshaling deactivated event: %v", err)
+			return
+		}
+		printEvent("DEACTIVATED", event.Event)
+	})
+	if err != nil {
+		log.Fatalf("Failed to subscribe to deactivated events: %v", err)
+	}
+
+	// Subscribe to employee reactivated events
+	_, err = nc.Subscribe("employees.v1.reactivated", func(msg *nats.Msg) {
+		var event eventsv1.EmployeeReactivatedEvent
+		data, err := payload(msg)
+		if err != nil {
+			log.Printf("✗ Error verifying reactivated event: %v", err)
+			return
+		}
+		if err := proto.Unmarshal(data, &event); err != nil {
+			log.Printf("✗ Error unmarshaling reactivated event: %v", err)
+			return
+		}
+		printEvent("REACTIVATED", event.Event)
+	})
+	if err != nil {
+		log.Fatalf("Failed to subscribe to reactivated events: %v", err)
+	}
+
+	// Subscribe to employee stale events
+	_, err = nc.Subscribe("employees.v1.stale", func(msg *nats.Msg) {
+		var event eventsv1.EmployeeStaleEvent
+		data, err := payload(msg)
+		if err != nil {
+			log.Printf("✗ Error verifying stale event: %v", err)
+			return
+		}
+		if err := proto.Unmarshal(data, &event); err != nil {
+			log.Printf("✗ Error unmarshaling stale event: %v", err)
+			return
+		}
+		printEvent("STALE", event.Event)
+		log.Printf("  Reasons: %v", event.Reasons)
+	})
+	if err != nil {
+		log.Fatalf("Failed to subscribe to stale events: %v", err)
+	}
+
 	log.Println("🎧 Listening for employee events...")
 	log.Println("   Press Ctrl+C to exit")
 	log.Println()
@@ -122,6 +287,10 @@ func printEvent(eventType string, event *eventsv1.EmployeeEvent) {
 	log.Printf("User ID:    %s", event.UserId)
 	log.Printf("Timestamp:  %s", event.Timestamp.AsTime().Format("2006-01-02 15:04:05"))
 
+	if event.Slim {
+		log.Println("Slim event: fetch the employee with GetEmployee for the full record")
+	}
+
 	// Print employee data if present
 	if event.Employee != nil {
 		emp := event.Employee
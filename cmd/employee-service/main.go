@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
 
 	"github.com/cvele/employee-service/internal/conf"
 	"github.com/cvele/employee-service/internal/observability"
+	"github.com/cvele/employee-service/internal/secrets"
+	"github.com/cvele/employee-service/internal/server"
+	"github.com/cvele/employee-service/internal/server/middleware"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -35,7 +39,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs/config.yaml", "config path, eg: -conf ./configs/config.yaml")
 }
 
-func newApp(logger log.Logger, environment string, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, environment string, gs *grpc.Server, hs *http.Server, warmup *server.Warmup, auditArchive *server.AuditArchiveJob, idempotency *server.IdempotencyCleanupJob, webhooks *server.WebhookWorker, imports *server.ImportWorker, schedules *server.ScheduleWorker, clones *server.CloneWorker, usage *server.UsageFlushJob, readAudit *server.ReadAuditFlushJob, stale *server.StaleJob, secretRotation *server.SecretRotationJob, employeeCount *server.EmployeeCountJob) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -48,6 +52,20 @@ func newApp(logger log.Logger, environment string, gs *grpc.Server, hs *http.Ser
 			gs,
 			hs,
 		),
+		kratos.AfterStart(warmup.Start),
+		kratos.AfterStart(auditArchive.Start),
+		kratos.AfterStart(idempotency.Start),
+		kratos.AfterStart(webhooks.Start),
+		kratos.AfterStart(imports.Start),
+		kratos.AfterStart(schedules.Start),
+		kratos.AfterStart(clones.Start),
+		kratos.AfterStart(usage.Start),
+		kratos.AfterStart(readAudit.Start),
+		kratos.AfterStart(stale.Start),
+		kratos.AfterStart(secretRotation.Start),
+		kratos.AfterStart(employeeCount.Start),
+		kratos.BeforeStop(usage.Stop),
+		kratos.BeforeStop(readAudit.Stop),
 	)
 }
 
@@ -73,6 +91,13 @@ func main() {
 		panic(err)
 	}
 
+	// Resolve the JWT secret and database password from Vault and secret
+	// files before anything uses them
+	secretSource, err := secrets.Load(context.Background(), &bc)
+	if err != nil {
+		panic(err)
+	}
+
 	// Create logger with environment context
 	logger := log.With(log.NewStdLogger(os.Stdout),
 		"ts", log.DefaultTimestamp,
@@ -83,6 +108,8 @@ func main() {
 		"service.env", bc.Environment,
 		"trace.id", tracing.TraceID(),
 		"span.id", tracing.SpanID(),
+		"request.id", middleware.RequestIDValuer(),
+		"impersonator", middleware.ImpersonatorValuer(),
 	)
 
 	// Apply log level filter
@@ -94,10 +121,12 @@ func main() {
 		bc.Server,
 		bc.Data,
 		bc.Auth,
+		bc.Admin,
 		bc.Observability,
 		bc.Environment,
 		observability.ServiceName(Name),
 		observability.ServiceVersion(Version),
+		secretSource,
 		logger,
 	)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/cvele/employee-service/internal/conf"
 	"github.com/cvele/employee-service/internal/data"
 	"github.com/cvele/employee-service/internal/observability"
+	"github.com/cvele/employee-service/internal/secrets"
 	"github.com/cvele/employee-service/internal/server"
 	"github.com/cvele/employee-service/internal/service"
 
@@ -23,14 +24,18 @@ func wireApp(
 	serverConf *conf.Server,
 	dataConf *conf.Data,
 	authConf *conf.Auth,
+	adminConf *conf.Admin,
 	obsConf *conf.Observability,
 	environment string,
 	serviceName observability.ServiceName,
 	version observability.ServiceVersion,
+	secretSource *secrets.Source,
 	logger log.Logger,
 ) (*kratos.App, func(), error) {
 	panic(wire.Build(
 		server.ProviderSet,
+		// Deployments adding their own middlewares provide them here
+		server.NoExtraMiddlewares,
 		data.ProviderSet,
 		biz.ProviderSet,
 		service.ProviderSet,
@@ -11,6 +11,7 @@ import (
 	"github.com/cvele/employee-service/internal/conf"
 	"github.com/cvele/employee-service/internal/data"
 	"github.com/cvele/employee-service/internal/observability"
+	"github.com/cvele/employee-service/internal/secrets"
 	"github.com/cvele/employee-service/internal/server"
 	"github.com/cvele/employee-service/internal/service"
 	"github.com/go-kratos/kratos/v2"
@@ -24,24 +25,124 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(serverConf *conf.Server, dataConf *conf.Data, authConf *conf.Auth, obsConf *conf.Observability, environment string, serviceName observability.ServiceName, version observability.ServiceVersion, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(serverConf *conf.Server, dataConf *conf.Data, authConf *conf.Auth, adminConf *conf.Admin, obsConf *conf.Observability, environment string, serviceName observability.ServiceName, version observability.ServiceVersion, secretSource *secrets.Source, logger log.Logger) (*kratos.App, func(), error) {
+	tokenVerifier := server.ProvideTokenVerifier(authConf)
+	clock := biz.NewSystemClock()
+	idGenerator := biz.NewRandomIDGenerator()
 	serviceInfo := observability.NewServiceInfo(serviceName, version)
 	observabilityObservability, cleanup, err := observability.NewObservability(obsConf, serviceInfo, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	dataData, cleanup2, err := data.NewData(dataConf, logger)
+	dataData, cleanup2, err := data.NewData(dataConf, clock, idGenerator, observabilityObservability, logger)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	employeeRepo := data.NewEmployeeRepo(dataData, logger)
-	employeeUsecase := biz.NewEmployeeUsecase(employeeRepo, logger)
-	employeeService := service.NewEmployeeService(employeeUsecase)
-	grpcServer := server.NewGRPCServer(serverConf, authConf, observabilityObservability, employeeService, logger)
+	apiKeyRepo := data.NewAPIKeyRepo(dataData, logger)
+	apiKeyUsecase := biz.NewAPIKeyUsecase(apiKeyRepo, clock, idGenerator, logger)
+	tokenRevocationRepo := data.NewTokenRevocationRepo(dataData, logger)
+	tokenRevocationUsecase := biz.NewTokenRevocationUsecase(tokenRevocationRepo, clock, idGenerator, logger)
+	requestNonces := data.NewRequestNonces(dataData)
+	requestSignatures := biz.NewRequestSignatures(authConf, requestNonces, clock)
+	employeeRepo := data.NewEmployeeRepo(dataData, observabilityObservability, logger)
+	transaction := data.NewTransaction(dataData)
+	eventPublisher := data.NewEmployeeEventPublisher(dataData)
+	quotaRepo := data.NewQuotaRepo(dataData, logger)
+	quotaEventPublisher := data.NewQuotaEventPublisher(dataData)
+	quotaUsecase := biz.NewQuotaUsecase(employeeRepo, quotaRepo, quotaEventPublisher, dataConf, logger)
+	eventBus := data.NewEventBus(dataData, eventPublisher, quotaUsecase, observabilityObservability, logger)
+	reviewPolicy := biz.NewReviewPolicy(adminConf)
+	tenantSettingsRepo := data.NewTenantSettingsRepo(dataData, logger)
+	strictEmailMetrics := data.NewStrictEmailMetrics(observabilityObservability)
+	emailPolicy := biz.NewEmailPolicy(adminConf, tenantSettingsRepo, strictEmailMetrics, logger)
+	idempotencyRepo := data.NewIdempotencyRepo(dataData, logger)
+	idempotency := biz.NewIdempotency(idempotencyRepo, clock, dataConf)
+	readAuditWriter := data.NewReadAuditWriter(dataConf, dataData, observabilityObservability, logger)
+	readAuditor := data.NewReadAuditor(readAuditWriter)
+	mergeLimitMetrics := data.NewMergeLimitMetrics(observabilityObservability)
+	mergeLimiter := biz.NewMergeLimiter(adminConf, mergeLimitMetrics)
+	employeeUsecase := biz.NewEmployeeUsecase(employeeRepo, transaction, eventBus, reviewPolicy, emailPolicy, idempotency, readAuditor, mergeLimiter, logger)
+	auditRepo := data.NewAuditRepo(dataConf, dataData, logger)
+	auditUsecase := biz.NewAuditUsecase(auditRepo, logger)
+	scheduleRepo := data.NewScheduleRepo(dataData, logger)
+	scheduleUsecase := biz.NewScheduleUsecase(scheduleRepo, employeeRepo, employeeUsecase, clock, idGenerator, logger)
+	employmentHistoryRepo := data.NewEmploymentHistoryRepo(dataData, logger)
+	employmentHistoryUsecase := biz.NewEmploymentHistoryUsecase(employmentHistoryRepo, employeeRepo, logger)
+	photoStore, err := data.NewPhotoStore(dataConf, clock)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	photoUsecase := biz.NewPhotoUsecase(employeeRepo, transaction, eventBus, photoStore, idGenerator, logger)
+	webhookRepo := data.NewWebhookRepo(dataData, logger)
+	subjectAccessUsecase := biz.NewSubjectAccessUsecase(employeeRepo, auditRepo, webhookRepo, clock, logger)
+	mergeApprovalRepo := data.NewMergeApprovalRepo(dataData, logger)
+	mergeApprovalUsecase := biz.NewMergeApprovalUsecase(adminConf, mergeApprovalRepo, auditRepo, employeeUsecase, transaction, clock, idGenerator, logger)
+	employeeDiffUsecase := biz.NewEmployeeDiffUsecase(employeeRepo, auditRepo, employmentHistoryRepo, logger)
+	departmentRepo := data.NewDepartmentRepo(dataData, logger)
+	employeeValidationUsecase := biz.NewEmployeeValidationUsecase(employeeUsecase, departmentRepo, quotaUsecase, logger)
+	employeeService := service.NewEmployeeService(employeeUsecase, auditUsecase, scheduleUsecase, employmentHistoryUsecase, photoUsecase, quotaUsecase, subjectAccessUsecase, mergeApprovalUsecase, employeeDiffUsecase, employeeValidationUsecase)
+	confirmationRepo := data.NewConfirmationRepo(dataData, logger)
+	adminUsecase := biz.NewAdminUsecase(employeeRepo, confirmationRepo, eventBus, clock, adminConf, logger)
+	importRepo := data.NewImportRepo(dataData, logger)
+	importSource, err := data.NewImportSource(adminConf)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	importUsecase := biz.NewImportUsecase(importRepo, employeeRepo, eventBus, importSource, clock, idGenerator, reviewPolicy, emailPolicy, adminConf, logger)
+	usageRepo := data.NewUsageRepo(dataData, logger)
+	usageTracker := biz.NewUsageTracker(usageRepo, clock, logger)
+	inFlightRegistry := biz.NewInFlightRegistry(clock, adminConf)
+	tenantSettingsUsecase := biz.NewTenantSettingsUsecase(tenantSettingsRepo, clock, logger)
+	eventBackfillUsecase := biz.NewEventBackfillUsecase(employeeRepo, eventPublisher, logger)
+	tenantCloneRepo := data.NewTenantCloneRepo(dataData, logger)
+	tenantCloneUsecase := biz.NewTenantCloneUsecase(tenantCloneRepo, employeeRepo, clock, idGenerator, adminConf, logger)
+	runbookRepo := data.NewRunbookRepo(dataData, secretSource)
+	secretRotationJob := server.NewSecretRotationJob(secretSource, tokenVerifier, logger)
+	secretReloader := server.NewSecretReloader(secretRotationJob)
+	runbookUsecase := biz.NewRunbookUsecase(runbookRepo, secretReloader, adminConf, logger)
+	adminService := service.NewAdminService(adminUsecase, auditUsecase, importUsecase, usageTracker, inFlightRegistry, tenantSettingsUsecase, apiKeyUsecase, eventBackfillUsecase, tokenRevocationUsecase, tenantCloneUsecase, runbookUsecase)
+	webhookUsecase := biz.NewWebhookUsecase(webhookRepo, idGenerator, logger)
+	webhookService := service.NewWebhookService(webhookUsecase)
+	departmentUsecase := biz.NewDepartmentUsecase(departmentRepo, eventBus, idGenerator, logger)
+	departmentService := service.NewDepartmentService(departmentUsecase)
+	teamRepo := data.NewTeamRepo(dataData, logger)
+	teamUsecase := biz.NewTeamUsecase(teamRepo, employeeRepo, eventBus, idGenerator, logger)
+	teamService := service.NewTeamService(teamUsecase)
+	extraMiddlewares := server.NoExtraMiddlewares()
+	grpcServer := server.NewGRPCServer(serverConf, authConf, tokenVerifier, apiKeyUsecase, tokenRevocationUsecase, requestSignatures, observabilityObservability, employeeService, adminService, webhookService, departmentService, teamService, usageTracker, inFlightRegistry, extraMiddlewares, dataData, logger)
 	healthChecker := server.ProvideHealthChecker(dataData, logger)
-	httpServer := server.NewHTTPServer(serverConf, authConf, observabilityObservability, employeeService, healthChecker, logger)
-	app := newApp(logger, environment, grpcServer, httpServer)
+	httpServer := server.NewHTTPServer(serverConf, authConf, tokenVerifier, apiKeyUsecase, tokenRevocationUsecase, requestSignatures, observabilityObservability, employeeService, adminService, webhookService, departmentService, teamService, usageTracker, inFlightRegistry, extraMiddlewares, healthChecker, serviceInfo, dataData, logger)
+	warmup := server.NewWarmup(serverConf, healthChecker, dataData, employeeRepo, logger)
+	auditArchiver, err := data.NewAuditArchiver(dataConf, dataData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	auditArchiveJob := server.NewAuditArchiveJob(dataConf, auditArchiver, observabilityObservability, logger)
+	idempotencyCleanupJob := server.NewIdempotencyCleanupJob(dataConf, idempotency, logger)
+	webhookDispatcher := data.NewWebhookDispatcher(dataConf, dataData, logger)
+	webhookWorker := server.NewWebhookWorker(dataConf, webhookDispatcher, observabilityObservability, logger)
+	importWorker := server.NewImportWorker(adminConf, importUsecase, observabilityObservability, logger)
+	scheduleWorker := server.NewScheduleWorker(adminConf, scheduleUsecase, logger)
+	cloneWorker := server.NewCloneWorker(adminConf, tenantCloneUsecase, logger)
+	usageFlushJob := server.NewUsageFlushJob(adminConf, usageTracker, logger)
+	readAuditFlushJob := server.NewReadAuditFlushJob(dataConf, readAuditWriter, logger)
+	staleUsecase, err := biz.NewStaleUsecase(adminConf, employeeRepo, eventBus, clock, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	staleJob := server.NewStaleJob(adminConf, staleUsecase, logger)
+	employeeStats := data.NewEmployeeStats(dataData)
+	employeeCountJob := server.NewEmployeeCountJob(obsConf, employeeStats, observabilityObservability, logger)
+	app := newApp(logger, environment, grpcServer, httpServer, warmup, auditArchiveJob, idempotencyCleanupJob, webhookWorker, importWorker, scheduleWorker, cloneWorker, usageFlushJob, readAuditFlushJob, staleJob, secretRotationJob, employeeCountJob)
 	return app, func() {
 		cleanup2()
 		cleanup()
@@ -1,30 +1,44 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/cvele/employee-service/internal/observability"
+	"github.com/cvele/employee-service/migrations"
+
+	kratoslog "github.com/go-kratos/kratos/v2/log"
 	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/cockroachdb"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 var (
+	dbDriver       string
 	dbURL          string
 	migrationsPath string
 	command        string
 	steps          int
+	pushgatewayURL string
 )
 
 func init() {
+	flag.StringVar(&dbDriver, "driver", envOr("DATABASE_DRIVER", "postgres"), "Database driver: postgres or cockroachdb (or set DATABASE_DRIVER env)")
 	flag.StringVar(&dbURL, "database-url", os.Getenv("DATABASE_URL"), "Database connection URL (or set DATABASE_URL env)")
-	flag.StringVar(&migrationsPath, "path", "file://migrations", "Path to migrations directory")
+	flag.StringVar(&migrationsPath, "path", "file://migrations", "Path to migrations directory; migrations of the driver's subdirectory replace those of the same version")
 	flag.StringVar(&command, "command", "up", "Migration command: up, down, force, version, drop")
 	flag.IntVar(&steps, "steps", 0, "Number of steps for up/down (0 = all)")
+	flag.StringVar(&pushgatewayURL, "pushgateway", os.Getenv("PUSHGATEWAY_URL"), "Pushgateway URL to push the metrics of the run to (or set PUSHGATEWAY_URL env)")
 }
 
 func main() {
@@ -36,10 +50,79 @@ func main() {
 		log.Printf("Using default database URL: %s", dbURL)
 	}
 
+	start := time.Now()
+	version, err := run()
+	if pushgatewayURL != "" {
+		if err := pushMetrics(time.Since(start), version, err == nil); err != nil {
+			log.Printf("Failed to push metrics to %s: %v", pushgatewayURL, err)
+		}
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// pushMetrics pushes the outcome of the run to the Pushgateway, as nothing
+// scrapes a process this short-lived
+func pushMetrics(duration time.Duration, version uint, succeeded bool) error {
+	registry := prometheus.NewRegistry()
+	labels := prometheus.Labels{"command": command}
+
+	durationGauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:        "employee_service_migrate_duration_seconds",
+		Help:        "Duration of the last migrate run in seconds.",
+		ConstLabels: labels,
+	})
+	durationGauge.Set(duration.Seconds())
+
+	success := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:        "employee_service_migrate_success",
+		Help:        "Whether the last migrate run succeeded (1) or failed (0).",
+		ConstLabels: labels,
+	})
+	if succeeded {
+		success.Set(1)
+	}
+
+	schemaVersion := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "employee_service_migrate_schema_version",
+		Help: "Schema version after the last migrate run; 0 before any migration.",
+	})
+	schemaVersion.Set(float64(version))
+
+	registry.MustRegister(durationGauge, success, schemaVersion)
+
+	pusher := observability.NewPusher(pushgatewayURL, "employee_service_migrate", nil, registry, kratoslog.DefaultLogger)
+	return pusher.Push(context.Background())
+}
+
+// newDriver creates the migrate driver of dbDriver. CockroachDB has no
+// advisory locks, so its driver locks migrations with a lock table instead.
+func newDriver(db *sql.DB) (database.Driver, error) {
+	switch dbDriver {
+	case "postgres":
+		return postgres.WithInstance(db, &postgres.Config{})
+	case "cockroachdb":
+		return cockroachdb.WithInstance(db, &cockroachdb.Config{})
+	default:
+		return nil, fmt.Errorf("unsupported driver %q", dbDriver)
+	}
+}
+
+// envOr returns the environment variable key, or fallback when unset
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// run executes the migration command and returns the schema version it left
+func run() (uint, error) {
 	// Open database connection
 	db, err := sql.Open("pgx", dbURL)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		return 0, fmt.Errorf("failed to connect to database: %w", err)
 	}
 	defer func() {
 		_ = db.Close()
@@ -47,23 +130,30 @@ func main() {
 
 	// Verify connection
 	if err := db.Ping(); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+		return 0, fmt.Errorf("failed to ping database: %w", err)
 	}
 
 	// Create driver instance
-	driver, err := postgres.WithInstance(db, &postgres.Config{})
+	driver, err := newDriver(db)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create driver: %w", err)
+	}
+
+	// Read the migrations, with the variants of the driver
+	src, err := migrations.NewSource(os.DirFS(strings.TrimPrefix(migrationsPath, "file://")), dbDriver)
 	if err != nil {
-		log.Fatalf("Failed to create driver: %v", err)
+		return 0, fmt.Errorf("failed to read migrations: %w", err)
 	}
 
 	// Create migrate instance
-	m, err := migrate.NewWithDatabaseInstance(
-		migrationsPath,
-		"postgres",
+	m, err := migrate.NewWithInstance(
+		"migrations",
+		src,
+		dbDriver,
 		driver,
 	)
 	if err != nil {
-		log.Fatalf("Failed to create migrate instance: %v", err)
+		return 0, fmt.Errorf("failed to create migrate instance: %w", err)
 	}
 
 	// Execute command
@@ -72,12 +162,12 @@ func main() {
 		if steps > 0 {
 			log.Printf("Applying %d migration(s) up...", steps)
 			if err := m.Steps(steps); err != nil && err != migrate.ErrNoChange {
-				log.Fatalf("Migration failed: %v", err)
+				return currentVersion(m), fmt.Errorf("migration failed: %w", err)
 			}
 		} else {
 			log.Println("Applying all migrations up...")
 			if err := m.Up(); err != nil && err != migrate.ErrNoChange {
-				log.Fatalf("Migration failed: %v", err)
+				return currentVersion(m), fmt.Errorf("migration failed: %w", err)
 			}
 		}
 		log.Println("Migrations applied successfully")
@@ -86,30 +176,30 @@ func main() {
 		if steps > 0 {
 			log.Printf("Rolling back %d migration(s)...", steps)
 			if err := m.Steps(-steps); err != nil && err != migrate.ErrNoChange {
-				log.Fatalf("Migration rollback failed: %v", err)
+				return currentVersion(m), fmt.Errorf("migration rollback failed: %w", err)
 			}
 		} else {
 			log.Println("Rolling back all migrations...")
 			if err := m.Down(); err != nil && err != migrate.ErrNoChange {
-				log.Fatalf("Migration rollback failed: %v", err)
+				return currentVersion(m), fmt.Errorf("migration rollback failed: %w", err)
 			}
 		}
 		log.Println("Migrations rolled back successfully")
 
 	case "force":
 		if steps == 0 {
-			log.Fatal("Must specify -steps for force command")
+			return currentVersion(m), errors.New("must specify -steps for force command")
 		}
 		log.Printf("Forcing version to %d...", steps)
 		if err := m.Force(steps); err != nil {
-			log.Fatalf("Force version failed: %v", err)
+			return currentVersion(m), fmt.Errorf("force version failed: %w", err)
 		}
 		log.Println("Version forced successfully")
 
 	case "version":
 		version, dirty, err := m.Version()
 		if err != nil && err != migrate.ErrNilVersion {
-			log.Fatalf("Failed to get version: %v", err)
+			return 0, fmt.Errorf("failed to get version: %w", err)
 		}
 		if err == migrate.ErrNilVersion {
 			log.Println("No migrations applied yet")
@@ -121,11 +211,23 @@ func main() {
 		log.Println("WARNING: This will drop all tables!")
 		log.Println("Press Ctrl+C to cancel, or wait 5 seconds to continue...")
 		if err := m.Drop(); err != nil {
-			log.Fatalf("Drop failed: %v", err)
+			return currentVersion(m), fmt.Errorf("drop failed: %w", err)
 		}
 		log.Println("Database dropped successfully")
 
 	default:
-		log.Fatalf("Unknown command: %s (available: up, down, force, version, drop)", command)
+		return 0, fmt.Errorf("unknown command: %s (available: up, down, force, version, drop)", command)
+	}
+
+	return currentVersion(m), nil
+}
+
+// currentVersion returns the schema version, 0 when none is applied or it
+// cannot be read
+func currentVersion(m *migrate.Migrate) uint {
+	version, _, err := m.Version()
+	if err != nil {
+		return 0
 	}
+	return version
 }
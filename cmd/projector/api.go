@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	employeev1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// maxSnapshotLine bounds the size of one employee in an NDJSON export
+const maxSnapshotLine = 1 << 20
+
+// apiClient reads employees from the service's HTTP API, to rebuild the read
+// model from a snapshot and to fetch the employees of slim events
+type apiClient struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// get sends an authenticated GET request for path
+func (c *apiClient) get(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	return c.http.Do(req)
+}
+
+// Snapshot returns every employee of the token's tenant, deactivated ones
+// included, from an NDJSON export
+func (c *apiClient) Snapshot(ctx context.Context) ([]*Employee, error) {
+	resp, err := c.get(ctx, "/api/v1/employees:export?format=ndjson&active_only=false")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus("export", resp)
+	}
+
+	var employees []*Employee
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(nil, maxSnapshotLine)
+	for scanner.Scan() {
+		var e employeev1.Employee
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("export line %d: %w", len(employees)+1, err)
+		}
+		employees = append(employees, fromAPIEmployee(&e))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("export: %w", err)
+	}
+	return employees, nil
+}
+
+// Employee returns the employee with the given ID, or nil when it no longer
+// exists or was merged
+func (c *apiClient) Employee(ctx context.Context, id string) (*Employee, error) {
+	resp, err := c.get(ctx, "/api/v1/employees/"+url.PathEscape(id))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus("get employee "+id, resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var reply employeev1.GetEmployeeResponse
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, &reply); err != nil {
+		return nil, fmt.Errorf("get employee %s: %w", id, err)
+	}
+	if reply.Employee == nil {
+		// Merged into another employee
+		return nil, nil
+	}
+	return fromAPIEmployee(reply.Employee), nil
+}
+
+// unexpectedStatus describes a failed API call with the start of its body
+func unexpectedStatus(call string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("%s: %s: %s", call, resp.Status, body)
+}
+
+// fromAPIEmployee converts an employee returned by the API
+func fromAPIEmployee(e *employeev1.Employee) *Employee {
+	return &Employee{
+		ID:           e.GetId(),
+		FirstName:    e.GetFirstName(),
+		LastName:     e.GetLastName(),
+		Emails:       e.GetEmails(),
+		PrimaryEmail: e.GetPrimaryEmail(),
+		Title:        e.GetTitle(),
+		DepartmentID: e.GetDepartmentId(),
+		ManagerID:    e.GetManagerId(),
+		Tags:         e.GetTags(),
+		UpdatedAt:    e.GetUpdatedAt().AsTime(),
+	}
+}
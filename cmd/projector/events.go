@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+
+	eventsv1 "github.com/cvele/employee-service/api/events/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// subjects are the employee event subjects the read model is built from
+var subjects = []string{
+	"employees.v1.created",
+	"employees.v1.updated",
+	"employees.v1.deleted",
+	"employees.v1.merged",
+	"employees.v1.unmerged",
+	"employees.v1.deactivated",
+	"employees.v1.reactivated",
+	"employees.v1.stale",
+}
+
+// decodeEvent unmarshals the (verified and decrypted) payload of an event into
+// the change it makes to the read model
+func decodeEvent(subject string, data []byte) (*change, error) {
+	var (
+		event *eventsv1.EmployeeEvent
+		c     = &change{}
+	)
+	switch subject {
+	case "employees.v1.created":
+		var msg eventsv1.EmployeeCreatedEvent
+		if err := proto.Unmarshal(data, &msg); err != nil {
+			return nil, err
+		}
+		event = msg.Event
+		c.Upserts = []*Employee{fromEventEmployee(event.GetEmployee())}
+	case "employees.v1.updated":
+		var msg eventsv1.EmployeeUpdatedEvent
+		if err := proto.Unmarshal(data, &msg); err != nil {
+			return nil, err
+		}
+		event = msg.Event
+		c.Upserts = []*Employee{fromEventEmployee(event.GetEmployee())}
+	case "employees.v1.deleted":
+		var msg eventsv1.EmployeeDeletedEvent
+		if err := proto.Unmarshal(data, &msg); err != nil {
+			return nil, err
+		}
+		event = msg.Event
+		c.Deletes = []string{event.GetEmployee().GetId()}
+	case "employees.v1.merged":
+		// The secondary employee is gone, the primary one took its emails
+		var msg eventsv1.EmployeeMergedEvent
+		if err := proto.Unmarshal(data, &msg); err != nil {
+			return nil, err
+		}
+		event = msg.Event
+		c.Deletes = []string{msg.SecondaryId}
+		c.Upserts = []*Employee{fromEventEmployee(event.GetEmployee())}
+	case "employees.v1.unmerged":
+		// The secondary employee is back under its ID, with its emails moved
+		// back from the primary one
+		var msg eventsv1.EmployeeUnmergedEvent
+		if err := proto.Unmarshal(data, &msg); err != nil {
+			return nil, err
+		}
+		event = msg.Event
+		c.Restores = []string{event.GetEmployee().GetId()}
+		c.Upserts = []*Employee{fromEventEmployee(event.GetEmployee()), fromEventEmployee(msg.Primary)}
+	case "employees.v1.deactivated":
+		// Deactivated employees stay in the read model, as in its snapshot
+		var msg eventsv1.EmployeeDeactivatedEvent
+		if err := proto.Unmarshal(data, &msg); err != nil {
+			return nil, err
+		}
+		event = msg.Event
+		c.Upserts = []*Employee{fromEventEmployee(event.GetEmployee())}
+	case "employees.v1.reactivated":
+		var msg eventsv1.EmployeeReactivatedEvent
+		if err := proto.Unmarshal(data, &msg); err != nil {
+			return nil, err
+		}
+		event = msg.Event
+		c.Upserts = []*Employee{fromEventEmployee(event.GetEmployee())}
+	case "employees.v1.stale":
+		var msg eventsv1.EmployeeStaleEvent
+		if err := proto.Unmarshal(data, &msg); err != nil {
+			return nil, err
+		}
+		event = msg.Event
+		c.Upserts = []*Employee{fromEventEmployee(event.GetEmployee())}
+	default:
+		return nil, fmt.Errorf("unexpected subject %s", subject)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("%s event without event metadata", subject)
+	}
+
+	c.EventID = event.EventId
+	c.TenantID = event.TenantId
+	c.Timestamp = event.Timestamp.AsTime()
+	c.Slim = event.Slim
+	return c, nil
+}
+
+// fromEventEmployee converts the employee carried by an event
+func fromEventEmployee(e *eventsv1.EmployeeData) *Employee {
+	return &Employee{
+		ID:           e.GetId(),
+		FirstName:    e.GetFirstName(),
+		LastName:     e.GetLastName(),
+		Emails:       e.GetEmails(),
+		PrimaryEmail: e.GetPrimaryEmail(),
+		Title:        e.GetTitle(),
+		DepartmentID: e.GetDepartmentId(),
+		ManagerID:    e.GetManagerId(),
+		Tags:         e.GetTags(),
+		UpdatedAt:    e.GetUpdatedAt().AsTime(),
+	}
+}
@@ -0,0 +1,303 @@
+// Command projector is the reference consumer of the employee events: it
+// builds a local read model of one tenant's employees from the JetStream
+// stream, the way downstream services are expected to.
+//
+//   - The read model and the stream sequence it is current to are saved
+//     together (-state), so a restart resumes after the last event saved and
+//     nothing is lost or applied twice.
+//   - Events are applied idempotently (see ReadModel), so redeliveries,
+//     publisher retries and replays are harmless.
+//   - Without a saved read model, with -rebuild, or when the events since the
+//     checkpoint are no longer retained by the stream, the read model is
+//     rebuilt from an NDJSON export and the events published since replayed.
+//
+// With -verify it exits once caught up with the stream, comparing the read
+// model with a fresh export: run against a quiet service, this tests the
+// delivery and ordering guarantees of the events end to end.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/cvele/employee-service/pkg/eventcrypto"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+var (
+	natsURL        string
+	stream         string
+	tenantID       string
+	statePath      string
+	apiURL         string
+	token          string
+	keys           string
+	signingKeysURL string
+	saveInterval   time.Duration
+	rebuild        bool
+	verify         bool
+)
+
+func init() {
+	flag.StringVar(&natsURL, "nats", "nats://localhost:4222", "NATS server URL")
+	flag.StringVar(&stream, "stream", "EMPLOYEES", "JetStream stream of the employee events")
+	flag.StringVar(&tenantID, "tenant", "", "tenant to project (required)")
+	flag.StringVar(&statePath, "state", "projector.json", "file the read model and its checkpoint are saved to")
+	flag.StringVar(&apiURL, "api", "http://localhost:8000", "HTTP API of the service, to export snapshots and fetch employees of slim events")
+	flag.StringVar(&token, "token", os.Getenv("EMPLOYEE_SERVICE_TOKEN"), "JWT of the tenant for the API")
+	flag.StringVar(&keys, "keys", os.Getenv("EVENT_ENCRYPTION_KEYS"), "event decryption keys as id=base64key,...")
+	flag.StringVar(&signingKeysURL, "signing-keys", os.Getenv("EVENT_SIGNING_KEYS_URL"), "URL of the event signing keys, e.g. http://localhost:8000/.well-known/event-signing-keys")
+	flag.DurationVar(&saveInterval, "save-interval", 5*time.Second, "how often the read model is saved while catching up")
+	flag.BoolVar(&rebuild, "rebuild", false, "rebuild the read model from a snapshot")
+	flag.BoolVar(&verify, "verify", false, "compare the read model with the service once caught up and exit, non-zero on differences")
+}
+
+func main() {
+	flag.Parse()
+	if tenantID == "" {
+		log.Fatal("-tenant is required")
+	}
+	keyring, err := eventcrypto.ParseKeyring(keys)
+	if err != nil {
+		log.Fatalf("Invalid -keys: %v", err)
+	}
+	var verifier *eventcrypto.Verifier
+	if signingKeysURL != "" {
+		verifier, err = eventcrypto.FetchVerifier(context.Background(), http.DefaultClient, signingKeysURL)
+		if err != nil {
+			log.Fatalf("Failed to fetch event signing keys: %v", err)
+		}
+		log.Printf("✓ Verifying event signatures with keys from %s", signingKeysURL)
+	}
+	api := &apiClient{baseURL: apiURL, token: token, http: http.DefaultClient}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	nc, err := nats.Connect(natsURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to NATS: %v", err)
+	}
+	defer nc.Close()
+	js, err := jetstream.New(nc)
+	if err != nil {
+		log.Fatalf("Failed to open JetStream: %v", err)
+	}
+	log.Printf("✓ Connected to NATS at %s", natsURL)
+
+	model, err := loadOrRebuild(ctx, js, api)
+	if err != nil {
+		log.Fatalf("Failed to load the read model: %v", err)
+	}
+
+	p := &projector{model: model, api: api, keyring: keyring, verifier: verifier, lastSave: time.Now()}
+	err = p.run(ctx, js)
+	if saveErr := p.save(); saveErr != nil {
+		log.Printf("✗ Failed to save the read model: %v", saveErr)
+	}
+	if err != nil && !errors.Is(err, context.Canceled) {
+		log.Fatalf("✗ %v", err)
+	}
+	if verify {
+		os.Exit(p.verify(context.Background()))
+	}
+	log.Println("Shutting down projector...")
+}
+
+// loadOrRebuild loads the saved read model, or rebuilds it from a snapshot
+// when there is none, -rebuild is set, or the stream no longer retains the
+// events since its checkpoint
+func loadOrRebuild(ctx context.Context, js jetstream.JetStream, api *apiClient) (*ReadModel, error) {
+	s, err := js.Stream(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+	info, err := s.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rebuild {
+		model, err := LoadReadModel(statePath)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			log.Printf("No read model at %s, rebuilding from a snapshot", statePath)
+		case err != nil:
+			return nil, err
+		case model.TenantID != tenantID:
+			log.Printf("Read model at %s is of tenant %s, rebuilding from a snapshot", statePath, model.TenantID)
+		case model.Checkpoint+1 < info.State.FirstSeq:
+			log.Printf("Events after checkpoint %d are no longer in the stream (first is %d), rebuilding from a snapshot", model.Checkpoint, info.State.FirstSeq)
+		default:
+			log.Printf("✓ Loaded %d employees at checkpoint %d", len(model.Employees), model.Checkpoint)
+			return model, nil
+		}
+	}
+
+	// Events published while the export runs are replayed over it; the
+	// read model ignores those already reflected in the snapshot
+	checkpoint := info.State.LastSeq
+	employees, err := api.Snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	model := NewReadModel(tenantID, checkpoint, employees)
+	if err := model.Save(statePath); err != nil {
+		return nil, err
+	}
+	log.Printf("✓ Rebuilt %d employees from a snapshot at checkpoint %d", len(employees), checkpoint)
+	return model, nil
+}
+
+// projector applies the events of the stream to its read model
+type projector struct {
+	model    *ReadModel
+	api      *apiClient
+	keyring  *eventcrypto.Keyring
+	verifier *eventcrypto.Verifier
+
+	lastSave time.Time
+	counts   map[outcome]int
+}
+
+// run consumes the stream after the checkpoint until ctx ends or, with
+// -verify, until caught up
+func (p *projector) run(ctx context.Context, js jetstream.JetStream) error {
+	consumer, err := js.OrderedConsumer(ctx, stream, jetstream.OrderedConsumerConfig{
+		FilterSubjects: subjects,
+		DeliverPolicy:  jetstream.DeliverByStartSequencePolicy,
+		OptStartSeq:    p.model.Checkpoint + 1,
+	})
+	if err != nil {
+		return err
+	}
+	if verify {
+		info, err := consumer.Info(ctx)
+		if err != nil {
+			return err
+		}
+		if info.NumPending == 0 {
+			return nil
+		}
+	}
+
+	msgs, err := consumer.Messages()
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		msgs.Stop()
+	}()
+	log.Printf("🎧 Projecting events of tenant %s after checkpoint %d", tenantID, p.model.Checkpoint)
+
+	p.counts = make(map[outcome]int)
+	for {
+		msg, err := msgs.Next()
+		if errors.Is(err, jetstream.ErrMsgIteratorClosed) {
+			return ctx.Err()
+		}
+		if err != nil {
+			return err
+		}
+		meta, err := msg.Metadata()
+		if err != nil {
+			return err
+		}
+		if err := p.apply(ctx, meta.Sequence.Stream, msg); err != nil {
+			return err
+		}
+
+		// Save while catching up, and whenever caught up
+		if meta.NumPending == 0 || time.Since(p.lastSave) >= saveInterval {
+			if err := p.save(); err != nil {
+				return err
+			}
+		}
+		if verify && meta.NumPending == 0 {
+			return nil
+		}
+	}
+}
+
+// apply verifies, decrypts and applies the event at stream sequence seq.
+// Events that fail verification or decryption stop the projector rather than
+// being skipped, since the read model would silently diverge.
+func (p *projector) apply(ctx context.Context, seq uint64, msg jetstream.Msg) error {
+	m := &nats.Msg{Subject: msg.Subject(), Data: msg.Data(), Header: msg.Headers()}
+	if p.verifier != nil {
+		if err := p.verifier.Verify(m); err != nil {
+			return err
+		}
+	}
+	data, err := p.keyring.Decrypt(m)
+	if err != nil {
+		return err
+	}
+	c, err := decodeEvent(m.Subject, data)
+	if err != nil {
+		return err
+	}
+
+	if c.Slim && c.TenantID == p.model.TenantID {
+		// The event only carries IDs; fetch the employees as they are now
+		var upserts []*Employee
+		for _, e := range c.Upserts {
+			fetched, err := p.api.Employee(ctx, e.ID)
+			if err != nil {
+				return err
+			}
+			// Gone employees are removed by the events deleting them
+			if fetched != nil {
+				upserts = append(upserts, fetched)
+			}
+		}
+		c.Upserts = upserts
+	}
+
+	result := p.model.Apply(seq, c)
+	p.counts[result]++
+	if result == outcomeStale || result == outcomeDuplicate {
+		log.Printf("%s event %s (%s, sequence %d) ignored", result, c.EventID, m.Subject, seq)
+	}
+	return nil
+}
+
+// save saves the read model and its checkpoint
+func (p *projector) save() error {
+	if err := p.model.Save(statePath); err != nil {
+		return err
+	}
+	p.lastSave = time.Now()
+	log.Printf("✓ Saved %d employees at checkpoint %d (%v)", len(p.model.Employees), p.model.Checkpoint, p.counts)
+	return nil
+}
+
+// verify compares the read model with a fresh snapshot, returning the exit
+// code
+func (p *projector) verify(ctx context.Context) int {
+	snapshot, err := p.api.Snapshot(ctx)
+	if err != nil {
+		log.Printf("✗ Failed to export a snapshot: %v", err)
+		return 2
+	}
+	diffs := p.model.Diff(snapshot)
+	for _, d := range diffs {
+		log.Printf("✗ %s", d)
+	}
+	if len(diffs) > 0 {
+		log.Printf("✗ Read model differs from the service on %d of %d employees", len(diffs), len(snapshot))
+		return 1
+	}
+	log.Printf("✓ Read model matches the service's %d employees", len(snapshot))
+	return 0
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// maxSeenEvents bounds the event IDs remembered to drop duplicates published
+// under a new stream sequence, e.g. a retry outside the duplicate window
+const maxSeenEvents = 10000
+
+// Employee is an employee as projected from the events
+type Employee struct {
+	ID           string    `json:"id"`
+	FirstName    string    `json:"first_name"`
+	LastName     string    `json:"last_name"`
+	Emails       []string  `json:"emails"`
+	PrimaryEmail string    `json:"primary_email,omitempty"`
+	Title        string    `json:"title,omitempty"`
+	DepartmentID string    `json:"department_id,omitempty"`
+	ManagerID    string    `json:"manager_id,omitempty"`
+	Tags         []string  `json:"tags,omitempty"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// change is what an event does to the read model
+type change struct {
+	EventID   string
+	TenantID  string
+	Timestamp time.Time
+	// Deletes are the IDs of the employees the event removes
+	Deletes []string
+	// Upserts are the employees as of the event
+	Upserts []*Employee
+	// Restores are the IDs of deleted employees the event brings back
+	Restores []string
+	// Slim is set when Upserts only carry IDs and must be fetched
+	Slim bool
+}
+
+// outcome is what applying an event did
+type outcome string
+
+const (
+	// outcomeApplied events changed the read model
+	outcomeApplied outcome = "applied"
+	// outcomeDuplicate events were applied before
+	outcomeDuplicate outcome = "duplicate"
+	// outcomeStale events are older than what the read model has
+	outcomeStale outcome = "stale"
+	// outcomeSkipped events belong to another tenant
+	outcomeSkipped outcome = "skipped"
+)
+
+// ReadModel is the projection of one tenant's employees, together with the
+// stream sequence it is current to. Both are saved in one file, so that a
+// restart resumes exactly after the last event saved and replays the rest.
+// Applying is idempotent: events seen before are dropped by their ID, and
+// events older than the employee they carry (by updated_at) or than its
+// deletion are ignored, so replays and out of order deliveries converge.
+type ReadModel struct {
+	TenantID string `json:"tenant_id"`
+	// Checkpoint is the stream sequence of the last event applied
+	Checkpoint uint64               `json:"checkpoint"`
+	Employees  map[string]*Employee `json:"employees"`
+	// Deleted are when deleted employees were deleted, so that older events
+	// do not bring them back
+	Deleted map[string]time.Time `json:"deleted"`
+	// Seen are the IDs of the last events applied, oldest first
+	Seen []string `json:"seen"`
+
+	seen map[string]bool
+}
+
+// NewReadModel creates a read model from a snapshot of the tenant's
+// employees taken after the event at checkpoint
+func NewReadModel(tenantID string, checkpoint uint64, employees []*Employee) *ReadModel {
+	m := &ReadModel{
+		TenantID:   tenantID,
+		Checkpoint: checkpoint,
+		Employees:  make(map[string]*Employee, len(employees)),
+		Deleted:    make(map[string]time.Time),
+		seen:       make(map[string]bool),
+	}
+	for _, e := range employees {
+		m.Employees[e.ID] = e
+	}
+	return m
+}
+
+// LoadReadModel reads a read model saved by Save. It returns an error
+// satisfying errors.Is(err, os.ErrNotExist) when there is none yet.
+func LoadReadModel(path string) (*ReadModel, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m := &ReadModel{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("read model %s: %w", path, err)
+	}
+	if m.Employees == nil {
+		m.Employees = make(map[string]*Employee)
+	}
+	if m.Deleted == nil {
+		m.Deleted = make(map[string]time.Time)
+	}
+	m.seen = make(map[string]bool, len(m.Seen))
+	for _, id := range m.Seen {
+		m.seen[id] = true
+	}
+	return m, nil
+}
+
+// Save writes the read model to path atomically, replacing the previous one
+func (m *ReadModel) Save(path string) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Apply applies the event at stream sequence seq
+func (m *ReadModel) Apply(seq uint64, c *change) outcome {
+	if seq <= m.Checkpoint {
+		return outcomeDuplicate
+	}
+	m.Checkpoint = seq
+	if c.TenantID != m.TenantID {
+		return outcomeSkipped
+	}
+	if m.seen[c.EventID] {
+		return outcomeDuplicate
+	}
+	m.remember(c.EventID)
+
+	applied := false
+	for _, id := range c.Deletes {
+		applied = m.delete(id, c.Timestamp) || applied
+	}
+	for _, id := range c.Restores {
+		delete(m.Deleted, id)
+	}
+	for _, e := range c.Upserts {
+		applied = m.upsert(e) || applied
+	}
+	if !applied {
+		return outcomeStale
+	}
+	return outcomeApplied
+}
+
+// remember records an applied event ID, forgetting the oldest beyond
+// maxSeenEvents
+func (m *ReadModel) remember(eventID string) {
+	m.Seen = append(m.Seen, eventID)
+	m.seen[eventID] = true
+	if len(m.Seen) > maxSeenEvents {
+		delete(m.seen, m.Seen[0])
+		m.Seen = slices.Delete(m.Seen, 0, 1)
+	}
+}
+
+// upsert stores an employee unless the read model has a newer version of it
+// or deleted it later
+func (m *ReadModel) upsert(e *Employee) bool {
+	if deletedAt, ok := m.Deleted[e.ID]; ok && !e.UpdatedAt.After(deletedAt) {
+		return false
+	}
+	if current, ok := m.Employees[e.ID]; ok && e.UpdatedAt.Before(current.UpdatedAt) {
+		return false
+	}
+	m.Employees[e.ID] = e
+	delete(m.Deleted, e.ID)
+	return true
+}
+
+// delete removes an employee, remembering when
+func (m *ReadModel) delete(id string, at time.Time) bool {
+	_, existed := m.Employees[id]
+	if deletedAt, ok := m.Deleted[id]; ok && !existed && !at.After(deletedAt) {
+		return false
+	}
+	delete(m.Employees, id)
+	m.Deleted[id] = at
+	return true
+}
+
+// Diff returns a description of every employee the read model and a snapshot
+// of the service disagree on
+func (m *ReadModel) Diff(snapshot []*Employee) []string {
+	var diffs []string
+	expected := make(map[string]bool, len(snapshot))
+	for _, want := range snapshot {
+		expected[want.ID] = true
+		got, ok := m.Employees[want.ID]
+		switch {
+		case !ok:
+			diffs = append(diffs, fmt.Sprintf("%s: missing", want.ID))
+		case !sameEmployee(got, want):
+			diffs = append(diffs, fmt.Sprintf("%s: projected %+v, service has %+v", want.ID, *got, *want))
+		}
+	}
+	for id := range m.Employees {
+		if !expected[id] {
+			diffs = append(diffs, fmt.Sprintf("%s: projected but deleted", id))
+		}
+	}
+	slices.Sort(diffs)
+	return diffs
+}
+
+// sameEmployee compares the projected fields of two employees, ignoring the
+// order of emails
+func sameEmployee(a, b *Employee) bool {
+	return a.FirstName == b.FirstName && a.LastName == b.LastName &&
+		a.PrimaryEmail == b.PrimaryEmail && a.Title == b.Title &&
+		a.DepartmentID == b.DepartmentID && a.ManagerID == b.ManagerID &&
+		slices.Equal(sorted(a.Emails), sorted(b.Emails)) && slices.Equal(a.Tags, b.Tags) &&
+		a.UpdatedAt.Equal(b.UpdatedAt)
+}
+
+func sorted(s []string) []string {
+	s = slices.Clone(s)
+	slices.Sort(s)
+	return s
+}
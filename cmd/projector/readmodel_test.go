@@ -0,0 +1,149 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	eventsv1 "github.com/cvele/employee-service/api/events/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var t0 = time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+func jane(updatedAt time.Time, title string) *Employee {
+	return &Employee{ID: "e1", FirstName: "Jane", LastName: "Doe", Emails: []string{"jane@example.com"}, PrimaryEmail: "jane@example.com", Title: title, UpdatedAt: updatedAt}
+}
+
+func upsert(eventID string, e *Employee) *change {
+	return &change{EventID: eventID, TenantID: "tenant-a", Timestamp: e.UpdatedAt, Upserts: []*Employee{e}}
+}
+
+func TestReadModel_Apply(t *testing.T) {
+	tests := []struct {
+		name     string
+		events   []*change
+		want     []outcome
+		wantJane *Employee
+	}{
+		{
+			name:     "created then updated",
+			events:   []*change{upsert("ev1", jane(t0, "")), upsert("ev2", jane(t0.Add(time.Minute), "CTO"))},
+			want:     []outcome{outcomeApplied, outcomeApplied},
+			wantJane: jane(t0.Add(time.Minute), "CTO"),
+		},
+		{
+			name:     "published twice",
+			events:   []*change{upsert("ev1", jane(t0, "")), upsert("ev1", jane(t0, ""))},
+			want:     []outcome{outcomeApplied, outcomeDuplicate},
+			wantJane: jane(t0, ""),
+		},
+		{
+			name:     "older than the employee",
+			events:   []*change{upsert("ev2", jane(t0.Add(time.Minute), "CTO")), upsert("ev1", jane(t0, ""))},
+			want:     []outcome{outcomeApplied, outcomeStale},
+			wantJane: jane(t0.Add(time.Minute), "CTO"),
+		},
+		{
+			name: "older than the deletion",
+			events: []*change{
+				{EventID: "ev2", TenantID: "tenant-a", Timestamp: t0.Add(time.Minute), Deletes: []string{"e1"}},
+				upsert("ev1", jane(t0, "")),
+			},
+			want: []outcome{outcomeApplied, outcomeStale},
+		},
+		{
+			name: "other tenant",
+			events: []*change{
+				{EventID: "ev1", TenantID: "tenant-b", Timestamp: t0, Upserts: []*Employee{jane(t0, "")}},
+			},
+			want: []outcome{outcomeSkipped},
+		},
+		{
+			name: "merged and unmerged",
+			events: []*change{
+				upsert("ev1", jane(t0, "")),
+				{EventID: "ev2", TenantID: "tenant-a", Timestamp: t0.Add(time.Minute), Deletes: []string{"e1"}, Upserts: []*Employee{{ID: "e2", UpdatedAt: t0.Add(time.Minute)}}},
+				// The recreated employee keeps its original updated_at
+				{EventID: "ev3", TenantID: "tenant-a", Timestamp: t0.Add(2 * time.Minute), Restores: []string{"e1"}, Upserts: []*Employee{jane(t0, ""), {ID: "e2", UpdatedAt: t0.Add(2 * time.Minute)}}},
+			},
+			want:     []outcome{outcomeApplied, outcomeApplied, outcomeApplied},
+			wantJane: jane(t0, ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewReadModel("tenant-a", 10, nil)
+			for i, c := range tt.events {
+				assert.Equal(t, tt.want[i], m.Apply(uint64(11+i), c), "event %d", i)
+			}
+			assert.Equal(t, uint64(10+len(tt.events)), m.Checkpoint)
+			assert.Equal(t, tt.wantJane, m.Employees["e1"])
+		})
+	}
+}
+
+func TestReadModel_ApplyRedelivered(t *testing.T) {
+	m := NewReadModel("tenant-a", 10, nil)
+
+	assert.Equal(t, outcomeDuplicate, m.Apply(10, upsert("ev1", jane(t0, ""))))
+	assert.Empty(t, m.Employees)
+	assert.Equal(t, uint64(10), m.Checkpoint)
+}
+
+func TestReadModel_SaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "projector.json")
+	m := NewReadModel("tenant-a", 10, []*Employee{jane(t0, "")})
+	m.Apply(11, &change{EventID: "ev1", TenantID: "tenant-a", Timestamp: t0, Deletes: []string{"e2"}})
+	require.NoError(t, m.Save(path))
+
+	loaded, err := LoadReadModel(path)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(11), loaded.Checkpoint)
+	assert.Equal(t, jane(t0, ""), loaded.Employees["e1"])
+	assert.Contains(t, loaded.Deleted, "e2")
+	// Event IDs seen before the restart are still dropped
+	assert.Equal(t, outcomeDuplicate, loaded.Apply(12, &change{EventID: "ev1", TenantID: "tenant-a"}))
+}
+
+func TestReadModel_Diff(t *testing.T) {
+	m := NewReadModel("tenant-a", 10, []*Employee{jane(t0, ""), {ID: "e2", UpdatedAt: t0}})
+
+	assert.Empty(t, m.Diff([]*Employee{jane(t0, ""), {ID: "e2", UpdatedAt: t0}}))
+	assert.Equal(t, []string{
+		"e2: projected but deleted",
+		"e3: missing",
+	}, m.Diff([]*Employee{jane(t0, ""), {ID: "e3", UpdatedAt: t0}}))
+	assert.Len(t, m.Diff([]*Employee{jane(t0, "CTO"), {ID: "e2", UpdatedAt: t0}}), 1)
+}
+
+func TestDecodeEvent(t *testing.T) {
+	data, err := proto.Marshal(&eventsv1.EmployeeMergedEvent{
+		Event: &eventsv1.EmployeeEvent{
+			EventId:   "ev1",
+			TenantId:  "tenant-a",
+			Timestamp: timestamppb.New(t0),
+			Employee:  &eventsv1.EmployeeData{Id: "e1", Emails: []string{"jane@example.com", "j.doe@example.com"}, UpdatedAt: timestamppb.New(t0)},
+		},
+		SecondaryId: "e2",
+		PrimaryId:   "e1",
+	})
+	require.NoError(t, err)
+
+	c, err := decodeEvent("employees.v1.merged", data)
+	require.NoError(t, err)
+	assert.Equal(t, "ev1", c.EventID)
+	assert.Equal(t, "tenant-a", c.TenantID)
+	assert.Equal(t, t0, c.Timestamp)
+	assert.Equal(t, []string{"e2"}, c.Deletes)
+	require.Len(t, c.Upserts, 1)
+	assert.Equal(t, "e1", c.Upserts[0].ID)
+
+	_, err = decodeEvent("employees.v1.departments.created", data)
+	assert.Error(t, err)
+}
@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"strings"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrInvalidAddress is a postal address missing its first line, city or
+// country, or with a country that is not an ISO 3166-1 alpha-2 code
+var ErrInvalidAddress = errors.BadRequest(v1.ErrorReason_INVALID_ADDRESS.String(), "address requires line1, city and an ISO 3166-1 alpha-2 country code")
+
+// countryCodes are the officially assigned ISO 3166-1 alpha-2 codes
+var countryCodes = func() map[string]bool {
+	codes := map[string]bool{}
+	for _, code := range strings.Fields(`
+		AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ
+		BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ
+		CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ
+		DE DJ DK DM DO DZ
+		EC EE EG EH ER ES ET
+		FI FJ FK FM FO FR
+		GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY
+		HK HM HN HR HT HU
+		ID IE IL IM IN IO IQ IR IS IT
+		JE JM JO JP
+		KE KG KH KI KM KN KP KR KW KY KZ
+		LA LB LC LI LK LR LS LT LU LV LY
+		MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ
+		NA NC NE NF NG NI NL NO NP NR NU NZ
+		OM
+		PA PE PF PG PH PK PL PM PN PR PS PT PW PY
+		QA
+		RE RO RS RU RW
+		SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ
+		TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ
+		UA UG UM US UY UZ
+		VA VC VE VG VI VN VU
+		WF WS
+		YE YT
+		ZA ZM ZW`) {
+		codes[code] = true
+	}
+	return codes
+}()
+
+// Address is the postal address of an employee
+type Address struct {
+	Line1      string
+	Line2      string
+	City       string
+	Region     string
+	PostalCode string
+	// Country is an uppercase ISO 3166-1 alpha-2 code
+	Country string
+}
+
+// IsZero reports whether the address is empty; in an update an empty
+// address removes the employee's address
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
+
+// normalizeAddress trims the fields of address and uppercases its country,
+// rejecting addresses without line1, city or a known country with
+// ErrInvalidAddress. nil and empty addresses are returned as they are.
+func normalizeAddress(address *Address) (*Address, error) {
+	if address == nil || address.IsZero() {
+		return address, nil
+	}
+	normalized := &Address{
+		Line1:      strings.TrimSpace(address.Line1),
+		Line2:      strings.TrimSpace(address.Line2),
+		City:       strings.TrimSpace(address.City),
+		Region:     strings.TrimSpace(address.Region),
+		PostalCode: strings.TrimSpace(address.PostalCode),
+		Country:    strings.ToUpper(strings.TrimSpace(address.Country)),
+	}
+	if normalized.Line1 == "" || normalized.City == "" || !countryCodes[normalized.Country] {
+		return nil, ErrInvalidAddress
+	}
+	return normalized, nil
+}
+
+// sameAddress reports whether a and b are the same address, nil being the
+// empty address
+func sameAddress(a, b *Address) bool {
+	var x, y Address
+	if a != nil {
+		x = *a
+	}
+	if b != nil {
+		y = *b
+	}
+	return x == y
+}
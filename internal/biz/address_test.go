@@ -0,0 +1,45 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeAddress(t *testing.T) {
+	address, err := normalizeAddress(&Address{Line1: " Main St 1 ", City: "Berlin", PostalCode: "10115 ", Country: "de"})
+
+	require.NoError(t, err)
+	assert.Equal(t, &Address{Line1: "Main St 1", City: "Berlin", PostalCode: "10115", Country: "DE"}, address)
+}
+
+func TestNormalizeAddress_KeepsNilAndEmpty(t *testing.T) {
+	address, err := normalizeAddress(nil)
+	require.NoError(t, err)
+	assert.Nil(t, address)
+
+	address, err = normalizeAddress(&Address{})
+	require.NoError(t, err)
+	assert.Equal(t, &Address{}, address)
+}
+
+func TestNormalizeAddress_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		address Address
+	}{
+		{name: "unknown country", address: Address{Line1: "Main St 1", City: "Berlin", Country: "XX"}},
+		{name: "alpha-3 country", address: Address{Line1: "Main St 1", City: "Berlin", Country: "DEU"}},
+		{name: "missing country", address: Address{Line1: "Main St 1", City: "Berlin"}},
+		{name: "missing city", address: Address{Line1: "Main St 1", Country: "DE"}},
+		{name: "missing line1", address: Address{City: "Berlin", Country: "DE"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := normalizeAddress(&tt.address)
+			assert.Equal(t, ErrInvalidAddress, err)
+		})
+	}
+}
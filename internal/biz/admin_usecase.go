@@ -0,0 +1,184 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// defaultConfirmationTTL is used when conf.Admin does not set confirmation_ttl.
+const defaultConfirmationTTL = 5 * time.Minute
+
+// DestructiveResult is the outcome of a destructive operation.
+// Exactly one of Challenge (first step) or Deleted (confirmed step) is meaningful.
+type DestructiveResult struct {
+	Challenge *ConfirmationChallenge
+	Deleted   int64
+}
+
+// AdminUsecase implements tenant-wide and destructive admin operations.
+type AdminUsecase struct {
+	repo          EmployeeRepo
+	confirmations ConfirmationRepo
+	events        *EventBus
+	clock         Clock
+	ttl           time.Duration
+	log           *log.Helper
+}
+
+// NewAdminUsecase creates a new Admin usecase.
+func NewAdminUsecase(repo EmployeeRepo, confirmations ConfirmationRepo, events *EventBus, clock Clock, c *conf.Admin, logger log.Logger) *AdminUsecase {
+	ttl := defaultConfirmationTTL
+	if c != nil && c.ConfirmationTtl != nil && c.ConfirmationTtl.AsDuration() > 0 {
+		ttl = c.ConfirmationTtl.AsDuration()
+	}
+	return &AdminUsecase{
+		repo:          repo,
+		confirmations: confirmations,
+		events:        events,
+		clock:         clock,
+		ttl:           ttl,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// PurgeTenant deletes every employee of the caller's tenant.
+// Without a token it only returns a confirmation challenge.
+func (uc *AdminUsecase) PurgeTenant(ctx context.Context, token string) (*DestructiveResult, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, _ := GetUserID(ctx)
+
+	if token == "" {
+		count, err := uc.repo.Count(ctx, tenantID, &ListFilter{})
+		if err != nil {
+			return nil, err
+		}
+		summary := fmt.Sprintf("permanently deletes all %d employees of tenant %s", count, tenantID)
+		challenge, err := uc.challenge(ctx, tenantID, userID, OperationPurgeTenant, "", count, summary)
+		if err != nil {
+			return nil, err
+		}
+		return &DestructiveResult{Challenge: challenge}, nil
+	}
+
+	confirmation, err := uc.consume(ctx, tenantID, OperationPurgeTenant, token, "")
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := uc.repo.DeleteAll(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("audit: %s executed tenant=%s requested_by=%s confirmed_by=%s deleted=%d",
+		OperationPurgeTenant, tenantID, confirmation.RequestedBy, userID, deleted)
+
+	uc.events.Publish(ctx, &DomainEvent{Type: EventTenantPurged, TenantID: tenantID, UserID: userID})
+
+	return &DestructiveResult{Deleted: deleted}, nil
+}
+
+// BulkDeleteEmployees deletes the given employees of the caller's tenant.
+// Without a token it only returns a confirmation challenge.
+func (uc *AdminUsecase) BulkDeleteEmployees(ctx context.Context, ids []uuid.UUID, token string) (*DestructiveResult, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, _ := GetUserID(ctx)
+
+	if len(ids) == 0 {
+		return nil, ErrInvalidEmployeeID
+	}
+	digest := digestIDs(ids)
+
+	existing, err := uc.repo.GetByIDs(ctx, tenantID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if token == "" {
+		count := int64(len(existing))
+		summary := fmt.Sprintf("permanently deletes %d of %d requested employees", count, len(ids))
+		challenge, err := uc.challenge(ctx, tenantID, userID, OperationBulkDeleteEmployees, digest, count, summary)
+		if err != nil {
+			return nil, err
+		}
+		return &DestructiveResult{Challenge: challenge}, nil
+	}
+
+	confirmation, err := uc.consume(ctx, tenantID, OperationBulkDeleteEmployees, token, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := uc.repo.DeleteByIDs(ctx, tenantID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("audit: %s executed tenant=%s requested_by=%s confirmed_by=%s deleted=%d",
+		OperationBulkDeleteEmployees, tenantID, confirmation.RequestedBy, userID, deleted)
+
+	// Publish events with deleted employee info (best-effort)
+	for _, employee := range existing {
+		uc.events.Publish(ctx, &DomainEvent{Type: EventEmployeeDeleted, TenantID: tenantID, UserID: userID, Employee: employee})
+	}
+
+	return &DestructiveResult{Deleted: deleted}, nil
+}
+
+// challenge persists a new confirmation and returns the challenge for the caller.
+func (uc *AdminUsecase) challenge(ctx context.Context, tenantID, userID, operation, digest string, affected int64, summary string) (*ConfirmationChallenge, error) {
+	token, hash, err := newConfirmationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	confirmation := &Confirmation{
+		TokenHash:     hash,
+		TenantID:      tenantID,
+		Operation:     operation,
+		ParamsDigest:  digest,
+		AffectedCount: affected,
+		RequestedBy:   userID,
+		ExpiresAt:     now.Add(uc.ttl),
+		CreatedAt:     now,
+	}
+	if err := uc.confirmations.Create(ctx, confirmation); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("audit: %s requested tenant=%s requested_by=%s affected=%d expires_at=%s",
+		operation, tenantID, userID, affected, confirmation.ExpiresAt.Format(time.RFC3339))
+
+	return &ConfirmationChallenge{
+		Token:         token,
+		ExpiresAt:     confirmation.ExpiresAt,
+		AffectedCount: affected,
+		Summary:       summary,
+	}, nil
+}
+
+// consume redeems a confirmation token and checks it was issued for the same request parameters.
+func (uc *AdminUsecase) consume(ctx context.Context, tenantID, operation, token, digest string) (*Confirmation, error) {
+	confirmation, err := uc.confirmations.Consume(ctx, tenantID, operation, hashConfirmationToken(token), uc.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	if confirmation == nil || confirmation.ParamsDigest != digest {
+		uc.log.WithContext(ctx).Warnf("audit: %s rejected tenant=%s: invalid confirmation token", operation, tenantID)
+		return nil, ErrInvalidConfirmationToken
+	}
+	return confirmation, nil
+}
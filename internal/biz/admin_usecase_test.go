@@ -0,0 +1,172 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// MockConfirmationRepo is a mock implementation of ConfirmationRepo
+type MockConfirmationRepo struct {
+	mock.Mock
+}
+
+func (m *MockConfirmationRepo) Create(ctx context.Context, confirmation *Confirmation) error {
+	args := m.Called(ctx, confirmation)
+	return args.Error(0)
+}
+
+func (m *MockConfirmationRepo) Consume(ctx context.Context, tenantID, operation, tokenHash string, now time.Time) (*Confirmation, error) {
+	args := m.Called(ctx, tenantID, operation, tokenHash, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Confirmation), args.Error(1)
+}
+
+// adminNow is the time of the admin usecase clock in tests
+var adminNow = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func setupAdminUsecase() (*AdminUsecase, *MockEmployeeRepo, *MockConfirmationRepo) {
+	repo := new(MockEmployeeRepo)
+	confirmations := new(MockConfirmationRepo)
+	clock := ClockFunc(func() time.Time { return adminNow })
+	uc := NewAdminUsecase(repo, confirmations, nil, clock, nil, log.NewStdLogger(io.Discard))
+	return uc, repo, confirmations
+}
+
+func adminContext() context.Context {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+	return WithUserID(ctx, "user-456")
+}
+
+func TestNewAdminUsecase(t *testing.T) {
+	t.Run("default ttl", func(t *testing.T) {
+		uc, _, _ := setupAdminUsecase()
+		assert.Equal(t, defaultConfirmationTTL, uc.ttl)
+	})
+
+	t.Run("configured ttl", func(t *testing.T) {
+		c := &conf.Admin{ConfirmationTtl: durationpb.New(time.Minute)}
+		uc := NewAdminUsecase(new(MockEmployeeRepo), new(MockConfirmationRepo), nil, NewSystemClock(), c, log.NewStdLogger(io.Discard))
+		assert.Equal(t, time.Minute, uc.ttl)
+	})
+}
+
+func TestPurgeTenant_RequestsConfirmation(t *testing.T) {
+	uc, repo, confirmations := setupAdminUsecase()
+
+	repo.On("Count", mock.Anything, "tenant-123", mock.Anything).Return(int64(42), nil)
+	confirmations.On("Create", mock.Anything, mock.MatchedBy(func(c *Confirmation) bool {
+		return c.TenantID == "tenant-123" &&
+			c.Operation == OperationPurgeTenant &&
+			c.RequestedBy == "user-456" &&
+			c.AffectedCount == 42 &&
+			len(c.TokenHash) == 64
+	})).Return(nil)
+
+	result, err := uc.PurgeTenant(adminContext(), "")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result.Challenge)
+	assert.NotEmpty(t, result.Challenge.Token)
+	assert.Equal(t, int64(42), result.Challenge.AffectedCount)
+	assert.Equal(t, adminNow.Add(defaultConfirmationTTL), result.Challenge.ExpiresAt)
+	assert.Zero(t, result.Deleted)
+
+	// Nothing must be deleted in the first step
+	repo.AssertNotCalled(t, "DeleteAll", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+	confirmations.AssertExpectations(t)
+}
+
+func TestPurgeTenant_Confirmed(t *testing.T) {
+	uc, repo, confirmations := setupAdminUsecase()
+
+	confirmations.On("Consume", mock.Anything, "tenant-123", OperationPurgeTenant, hashConfirmationToken("token"), adminNow).
+		Return(&Confirmation{TenantID: "tenant-123", Operation: OperationPurgeTenant, RequestedBy: "user-1"}, nil)
+	repo.On("DeleteAll", mock.Anything, "tenant-123").Return(int64(42), nil)
+
+	result, err := uc.PurgeTenant(adminContext(), "token")
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.Challenge)
+	assert.Equal(t, int64(42), result.Deleted)
+	repo.AssertExpectations(t)
+	confirmations.AssertExpectations(t)
+}
+
+func TestPurgeTenant_InvalidToken(t *testing.T) {
+	uc, repo, confirmations := setupAdminUsecase()
+
+	confirmations.On("Consume", mock.Anything, "tenant-123", OperationPurgeTenant, mock.Anything, mock.Anything).Return(nil, nil)
+
+	result, err := uc.PurgeTenant(adminContext(), "expired")
+
+	assert.Equal(t, ErrInvalidConfirmationToken, err)
+	assert.Nil(t, result)
+	repo.AssertNotCalled(t, "DeleteAll", mock.Anything, mock.Anything)
+}
+
+func TestBulkDeleteEmployees(t *testing.T) {
+	id1, id2 := uuid.New(), uuid.New()
+	existing := []*Employee{{ID: id1, TenantID: "tenant-123"}, {ID: id2, TenantID: "tenant-123"}}
+
+	t.Run("requests confirmation", func(t *testing.T) {
+		uc, repo, confirmations := setupAdminUsecase()
+
+		repo.On("GetByIDs", mock.Anything, "tenant-123", []uuid.UUID{id1, id2}).Return(existing, nil)
+		confirmations.On("Create", mock.Anything, mock.MatchedBy(func(c *Confirmation) bool {
+			return c.Operation == OperationBulkDeleteEmployees && c.ParamsDigest == digestIDs([]uuid.UUID{id2, id1})
+		})).Return(nil)
+
+		result, err := uc.BulkDeleteEmployees(adminContext(), []uuid.UUID{id1, id2}, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), result.Challenge.AffectedCount)
+		repo.AssertNotCalled(t, "DeleteByIDs", mock.Anything, mock.Anything, mock.Anything)
+		confirmations.AssertExpectations(t)
+	})
+
+	t.Run("confirmed", func(t *testing.T) {
+		uc, repo, confirmations := setupAdminUsecase()
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+
+		repo.On("GetByIDs", mock.Anything, "tenant-123", []uuid.UUID{id1, id2}).Return(existing, nil)
+		confirmations.On("Consume", mock.Anything, "tenant-123", OperationBulkDeleteEmployees, hashConfirmationToken("token"), mock.Anything).
+			Return(&Confirmation{ParamsDigest: digestIDs([]uuid.UUID{id1, id2})}, nil)
+		repo.On("DeleteByIDs", mock.Anything, "tenant-123", []uuid.UUID{id1, id2}).Return(int64(2), nil)
+		pub.On("PublishEmployeeDeleted", mock.Anything, "tenant-123", "user-456", mock.Anything).Return(nil).Twice()
+
+		result, err := uc.BulkDeleteEmployees(adminContext(), []uuid.UUID{id1, id2}, "token")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), result.Deleted)
+		repo.AssertExpectations(t)
+		pub.AssertExpectations(t)
+	})
+
+	t.Run("token issued for different ids", func(t *testing.T) {
+		uc, repo, confirmations := setupAdminUsecase()
+
+		repo.On("GetByIDs", mock.Anything, "tenant-123", []uuid.UUID{id1}).Return(existing[:1], nil)
+		confirmations.On("Consume", mock.Anything, "tenant-123", OperationBulkDeleteEmployees, mock.Anything, mock.Anything).
+			Return(&Confirmation{ParamsDigest: digestIDs([]uuid.UUID{id1, id2})}, nil)
+
+		result, err := uc.BulkDeleteEmployees(adminContext(), []uuid.UUID{id1}, "token")
+
+		assert.Equal(t, ErrInvalidConfirmationToken, err)
+		assert.Nil(t, result)
+		repo.AssertNotCalled(t, "DeleteByIDs", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
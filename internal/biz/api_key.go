@@ -0,0 +1,198 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrAPIKeyNotFound is returned when an API key does not exist in the
+	// tenant
+	ErrAPIKeyNotFound = errors.NotFound(v1.ErrorReason_API_KEY_NOT_FOUND.String(), "API key not found")
+	// ErrInvalidAPIKey is an unknown or revoked API key
+	ErrInvalidAPIKey = errors.Unauthorized("UNAUTHORIZED", "invalid or revoked API key")
+)
+
+const (
+	// apiKeyPrefix starts every API key, so that leaked keys are recognizable
+	apiKeyPrefix = "esk_"
+	// apiKeyDisplayLength is how much of a key is kept to tell keys apart
+	apiKeyDisplayLength = len(apiKeyPrefix) + 8
+	// apiKeyUserPrefix prefixes the service of an API key in the user ID of
+	// its requests, so that services and users cannot be confused in audits
+	apiKeyUserPrefix = "service:"
+	// signingSecretPrefix starts the signing secret of every API key
+	signingSecretPrefix = "essig_"
+)
+
+// APIKey authenticates a backend service of a tenant without a JWT. Only a
+// hash of the key is stored; its signing secret is stored as is, like
+// webhook secrets, to verify signed requests.
+type APIKey struct {
+	ID       uuid.UUID
+	TenantID string
+	// Service names the service using the key, e.g. payroll-sync
+	Service string
+	// Roles are the roles of the requests made with the key
+	Roles []string
+	// Prefix is the start of the key, to tell keys apart
+	Prefix    string
+	CreatedBy string
+	CreatedAt time.Time
+	// RevokedAt is set once the key is revoked
+	RevokedAt *time.Time
+	// SigningSecret signs requests made with the key, see SignRequest;
+	// empty for keys created before requests could be signed
+	SigningSecret string
+	// RequireSignature rejects requests made with the key unless signed
+	RequireSignature bool
+}
+
+// UserID returns the user ID of the requests made with the key
+func (k *APIKey) UserID() string {
+	return apiKeyUserPrefix + k.Service
+}
+
+// APIKeyRepo stores API keys
+type APIKeyRepo interface {
+	// Create stores a new key with the hash of its secret
+	Create(ctx context.Context, key *APIKey, hash string) (*APIKey, error)
+	// List returns the keys of the tenant, revoked ones included, newest first
+	List(ctx context.Context, tenantID string) ([]*APIKey, error)
+	// Revoke revokes a key of the tenant as of now; a key already revoked is
+	// returned unchanged. It returns ErrAPIKeyNotFound when there is none.
+	Revoke(ctx context.Context, tenantID string, id uuid.UUID, now time.Time) (*APIKey, error)
+	// GetByHash returns the unrevoked key with the hash, nil when there is
+	// none
+	GetByHash(ctx context.Context, hash string) (*APIKey, error)
+}
+
+// APIKeyUsecase manages the API keys of the caller's tenant and
+// authenticates requests made with them
+type APIKeyUsecase struct {
+	repo  APIKeyRepo
+	clock Clock
+	ids   IDGenerator
+	log   *log.Helper
+}
+
+// NewAPIKeyUsecase creates a new APIKey usecase.
+func NewAPIKeyUsecase(repo APIKeyRepo, clock Clock, ids IDGenerator, logger log.Logger) *APIKeyUsecase {
+	return &APIKeyUsecase{
+		repo:  repo,
+		clock: clock,
+		ids:   ids,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// newAPIKey returns a random API key and its hash
+func newAPIKey() (string, string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(buf)
+	return key, hashAPIKey(key), nil
+}
+
+// newSigningSecret returns a random request signing secret
+func newSigningSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return signingSecretPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashAPIKey hashes an API key for storage and lookup. Keys are random, so
+// an unsalted hash is enough.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateAPIKey creates a key for a service of the caller's tenant with the
+// given roles and a new signing secret. With requireSignature, requests made
+// with the key must be signed. The key is returned only here.
+func (uc *APIKeyUsecase) CreateAPIKey(ctx context.Context, service string, roles []string, requireSignature bool) (*APIKey, string, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	userID, _ := GetUserID(ctx)
+
+	uc.log.WithContext(ctx).Infof("CreateAPIKey: tenant=%s, service=%s, roles=%v, require_signature=%t", tenantID, service, roles, requireSignature)
+
+	key, hash, err := newAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+	signingSecret, err := newSigningSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	created, err := uc.repo.Create(ctx, &APIKey{
+		ID:               uc.ids.NewID(),
+		TenantID:         tenantID,
+		Service:          service,
+		Roles:            roles,
+		Prefix:           key[:apiKeyDisplayLength],
+		CreatedBy:        userID,
+		CreatedAt:        uc.clock.Now().UTC(),
+		SigningSecret:    signingSecret,
+		RequireSignature: requireSignature,
+	}, hash)
+	if err != nil {
+		return nil, "", err
+	}
+	return created, key, nil
+}
+
+// ListAPIKeys returns the keys of the caller's tenant.
+func (uc *APIKeyUsecase) ListAPIKeys(ctx context.Context) ([]*APIKey, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.List(ctx, tenantID)
+}
+
+// RevokeAPIKey revokes a key of the caller's tenant. Requests made with it
+// are rejected from then on.
+func (uc *APIKeyUsecase) RevokeAPIKey(ctx context.Context, id uuid.UUID) (*APIKey, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("RevokeAPIKey: tenant=%s, id=%s", tenantID, id)
+
+	return uc.repo.Revoke(ctx, tenantID, id, uc.clock.Now().UTC())
+}
+
+// Authenticate returns the unrevoked key matching key, or ErrInvalidAPIKey
+func (uc *APIKeyUsecase) Authenticate(ctx context.Context, key string) (*APIKey, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return nil, ErrInvalidAPIKey
+	}
+	found, err := uc.repo.GetByHash(ctx, hashAPIKey(key))
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, ErrInvalidAPIKey
+	}
+	return found, nil
+}
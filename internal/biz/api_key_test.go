@@ -0,0 +1,127 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAPIKeyRepo is a mock implementation of APIKeyRepo
+type MockAPIKeyRepo struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepo) Create(ctx context.Context, key *APIKey, hash string) (*APIKey, error) {
+	args := m.Called(ctx, key, hash)
+	return key, args.Error(0)
+}
+
+func (m *MockAPIKeyRepo) List(ctx context.Context, tenantID string) ([]*APIKey, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepo) Revoke(ctx context.Context, tenantID string, id uuid.UUID, now time.Time) (*APIKey, error) {
+	args := m.Called(ctx, tenantID, id, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepo) GetByHash(ctx context.Context, hash string) (*APIKey, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*APIKey), args.Error(1)
+}
+
+func setupAPIKeyUsecase(id uuid.UUID) (*APIKeyUsecase, *MockAPIKeyRepo) {
+	repo := new(MockAPIKeyRepo)
+	uc := NewAPIKeyUsecase(repo, ClockFunc(func() time.Time { return scheduleNow }), IDGeneratorFunc(func() uuid.UUID { return id }), log.NewStdLogger(io.Discard))
+	return uc, repo
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	id := uuid.New()
+	uc, repo := setupAPIKeyUsecase(id)
+	var hash string
+	repo.On("Create", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		hash = args.String(2)
+	}).Return(nil)
+
+	created, key, err := uc.CreateAPIKey(reviewContext(""), "payroll-sync", []string{"employee_reader"}, true)
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, "esk_"))
+	assert.True(t, strings.HasPrefix(created.SigningSecret, "essig_"))
+	assert.Equal(t, hashAPIKey(key), hash, "only the hash is stored")
+	assert.NotContains(t, hash, key)
+	assert.Equal(t, &APIKey{
+		ID:               id,
+		TenantID:         "tenant-123",
+		Service:          "payroll-sync",
+		Roles:            []string{"employee_reader"},
+		Prefix:           key[:apiKeyDisplayLength],
+		CreatedBy:        "user-456",
+		CreatedAt:        scheduleNow.UTC(),
+		SigningSecret:    created.SigningSecret,
+		RequireSignature: true,
+	}, created)
+	assert.Equal(t, "service:payroll-sync", created.UserID())
+
+	second, other, err := uc.CreateAPIKey(reviewContext(""), "payroll-sync", nil, false)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+	assert.NotEqual(t, created.SigningSecret, second.SigningSecret)
+	assert.False(t, second.RequireSignature)
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	id := uuid.New()
+	uc, repo := setupAPIKeyUsecase(id)
+	repo.On("Revoke", mock.Anything, "tenant-123", id, scheduleNow.UTC()).Return(nil, ErrAPIKeyNotFound)
+
+	_, err := uc.RevokeAPIKey(reviewContext(""), id)
+
+	assert.ErrorIs(t, err, ErrAPIKeyNotFound)
+}
+
+func TestAuthenticate(t *testing.T) {
+	uc, repo := setupAPIKeyUsecase(uuid.New())
+	key := &APIKey{ID: uuid.New(), TenantID: "tenant-123", Service: "payroll-sync"}
+	repo.On("GetByHash", mock.Anything, hashAPIKey("esk_valid")).Return(key, nil)
+	repo.On("GetByHash", mock.Anything, hashAPIKey("esk_revoked")).Return(nil, nil)
+
+	t.Run("valid", func(t *testing.T) {
+		found, err := uc.Authenticate(context.Background(), "esk_valid")
+
+		require.NoError(t, err)
+		assert.Equal(t, key, found)
+	})
+
+	t.Run("unknown or revoked", func(t *testing.T) {
+		_, err := uc.Authenticate(context.Background(), "esk_revoked")
+
+		assert.ErrorIs(t, err, ErrInvalidAPIKey)
+	})
+
+	t.Run("not an API key", func(t *testing.T) {
+		_, err := uc.Authenticate(context.Background(), "valid")
+
+		assert.ErrorIs(t, err, ErrInvalidAPIKey)
+		repo.AssertNotCalled(t, "GetByHash", mock.Anything, hashAPIKey("valid"))
+	})
+}
@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// Audit actions recorded for employee mutations.
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionDelete  = "delete"
+	AuditActionMerge   = "merge"
+	AuditActionUnmerge = "unmerge"
+	AuditActionApprove = "approve"
+	// AuditActionDeactivate and AuditActionReactivate record the changes of
+	// the active state of an employee
+	AuditActionDeactivate = "deactivate"
+	AuditActionReactivate = "reactivate"
+)
+
+// AuditEntry is a single recorded mutation of an employee.
+// Before is nil for creates and for the secondary employee of an unmerge,
+// After is nil for deletes and for the secondary employee of a merge.
+type AuditEntry struct {
+	ID         uuid.UUID
+	TenantID   string
+	EmployeeID uuid.UUID
+	Action     string
+	ActorID    string
+	RequestID  string
+	ClientIP   string
+	// ImpersonatorID and ImpersonatorTenantID are the user and tenant of the
+	// admin that acted as ActorID, empty unless impersonated
+	ImpersonatorID       string
+	ImpersonatorTenantID string
+	Before               *Employee
+	After                *Employee
+	// TxID and Seq are the Position of the entry, assigned by the
+	// repository. Seq increases monotonically in the order entries are
+	// recorded, which is not the order their transactions commit in.
+	TxID      int64
+	Seq       int64
+	CreatedAt time.Time
+}
+
+// AuditFilter represents filtering options for listing audit entries
+type AuditFilter struct {
+	EmployeeID *uuid.UUID
+	Since      *time.Time
+	Page       int32
+	PageSize   int32
+}
+
+// AuditRepo reads and writes the audit log.
+//
+// Mutations performed through EmployeeRepo are audited by the repository in
+// the same transaction as the change; Create is for callers that need to
+// record entries outside of those paths.
+type AuditRepo interface {
+	Create(ctx context.Context, entry *AuditEntry) error
+	List(ctx context.Context, tenantID string, filter *AuditFilter) ([]*AuditEntry, int64, error)
+	// ListSince lists the tenant's entries after the position, in position
+	// order. It stops before entries that a transaction still in progress
+	// could be ordered after, so that tailing it skips nothing.
+	ListSince(ctx context.Context, tenantID string, after AuditPosition, limit int) ([]*AuditEntry, error)
+	// LatestPosition returns the position from which ListSince lists the
+	// entries recorded from now on
+	LatestPosition(ctx context.Context, tenantID string) (AuditPosition, error)
+	// HasEntry reports whether the tenant's entry with seq is still in the
+	// audit log, i.e. has not been archived
+	HasEntry(ctx context.Context, tenantID string, seq int64) (bool, error)
+	// ListAsOf lists a page of the approved employees of the tenant as they
+	// were at asOf, oldest first, reconstructed from the latest entry of
+	// each employee up to asOf. Only DepartmentID and Tags of filter apply.
+	ListAsOf(ctx context.Context, tenantID string, asOf time.Time, filter *ListFilter) (*ListResult, error)
+}
+
+// AuditUsecase exposes the audit log.
+type AuditUsecase struct {
+	repo AuditRepo
+	log  *log.Helper
+
+	// watchPoll controls live tailing in Watch
+	watchPoll time.Duration
+}
+
+// NewAuditUsecase creates a new Audit usecase.
+func NewAuditUsecase(repo AuditRepo, logger log.Logger) *AuditUsecase {
+	return &AuditUsecase{
+		repo:      repo,
+		log:       log.NewHelper(logger),
+		watchPoll: defaultWatchPoll,
+	}
+}
+
+// ListAuditEntries lists audit entries of the caller's tenant, newest first.
+func (uc *AuditUsecase) ListAuditEntries(ctx context.Context, filter *AuditFilter) ([]*AuditEntry, int64, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Set default pagination values
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 {
+		filter.PageSize = 50
+	}
+	if filter.PageSize > 200 {
+		filter.PageSize = 200
+	}
+
+	uc.log.WithContext(ctx).Infof("ListAuditEntries: tenant=%s, page=%d, size=%d", tenantID, filter.Page, filter.PageSize)
+
+	return uc.repo.List(ctx, tenantID, filter)
+}
+
+// ListEmployeesAsOf lists the employees of the caller's tenant as they were
+// at asOf, filtered by the department and tags they had then. Pagination
+// defaults are applied to filter.
+func (uc *AuditUsecase) ListEmployeesAsOf(ctx context.Context, asOf time.Time, filter *ListFilter) (*ListResult, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applyPagination(filter)
+	for i, tag := range filter.Tags {
+		filter.Tags[i] = normalizeTag(tag)
+	}
+
+	uc.log.WithContext(ctx).Infof("ListEmployeesAsOf: tenant=%s, as_of=%s, page=%d, size=%d", tenantID, asOf.Format(time.RFC3339), filter.Page, filter.PageSize)
+
+	return uc.repo.ListAsOf(ctx, tenantID, asOf, filter)
+}
+
+// EmployeeHistory returns all audit entries of a single employee, newest first.
+func (uc *AuditUsecase) EmployeeHistory(ctx context.Context, employeeID uuid.UUID) ([]*AuditEntry, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, _, err := uc.repo.List(ctx, tenantID, &AuditFilter{EmployeeID: &employeeID})
+	return entries, err
+}
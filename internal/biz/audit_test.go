@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAuditRepo is a mock implementation of AuditRepo
+type MockAuditRepo struct {
+	mock.Mock
+}
+
+func (m *MockAuditRepo) Create(ctx context.Context, entry *AuditEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockAuditRepo) List(ctx context.Context, tenantID string, filter *AuditFilter) ([]*AuditEntry, int64, error) {
+	args := m.Called(ctx, tenantID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*AuditEntry), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockAuditRepo) ListSince(ctx context.Context, tenantID string, after AuditPosition, limit int) ([]*AuditEntry, error) {
+	args := m.Called(ctx, tenantID, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*AuditEntry), args.Error(1)
+}
+
+func (m *MockAuditRepo) LatestPosition(ctx context.Context, tenantID string) (AuditPosition, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).(AuditPosition), args.Error(1)
+}
+
+func (m *MockAuditRepo) HasEntry(ctx context.Context, tenantID string, seq int64) (bool, error) {
+	args := m.Called(ctx, tenantID, seq)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAuditRepo) ListAsOf(ctx context.Context, tenantID string, asOf time.Time, filter *ListFilter) (*ListResult, error) {
+	args := m.Called(ctx, tenantID, asOf, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ListResult), args.Error(1)
+}
+
+func TestListAuditEntries(t *testing.T) {
+	tests := []struct {
+		name         string
+		filter       *AuditFilter
+		wantPage     int32
+		wantPageSize int32
+	}{
+		{name: "defaults", filter: &AuditFilter{}, wantPage: 1, wantPageSize: 50},
+		{name: "explicit", filter: &AuditFilter{Page: 3, PageSize: 10}, wantPage: 3, wantPageSize: 10},
+		{name: "page size capped", filter: &AuditFilter{PageSize: 1000}, wantPage: 1, wantPageSize: 200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockAuditRepo)
+			uc := NewAuditUsecase(repo, log.NewStdLogger(io.Discard))
+
+			entries := []*AuditEntry{{ID: uuid.New(), Action: AuditActionCreate}}
+			repo.On("List", mock.Anything, "tenant-123", mock.MatchedBy(func(f *AuditFilter) bool {
+				return f.Page == tt.wantPage && f.PageSize == tt.wantPageSize
+			})).Return(entries, int64(1), nil)
+
+			got, total, err := uc.ListAuditEntries(WithTenantID(context.Background(), "tenant-123"), tt.filter)
+
+			assert.NoError(t, err)
+			assert.Equal(t, entries, got)
+			assert.Equal(t, int64(1), total)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListAuditEntries_MissingTenant(t *testing.T) {
+	repo := new(MockAuditRepo)
+	uc := NewAuditUsecase(repo, log.NewStdLogger(io.Discard))
+
+	_, _, err := uc.ListAuditEntries(context.Background(), &AuditFilter{})
+
+	assert.Equal(t, ErrTenantNotFound, err)
+	repo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListEmployeesAsOf(t *testing.T) {
+	repo := new(MockAuditRepo)
+	uc := NewAuditUsecase(repo, log.NewStdLogger(io.Discard))
+	asOf := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	result := &ListResult{Employees: []*Employee{{ID: uuid.New()}}, Total: 1}
+	repo.On("ListAsOf", mock.Anything, "tenant-123", asOf, &ListFilter{Page: 1, PageSize: 20, Tags: []string{"remote"}}).Return(result, nil)
+
+	got, err := uc.ListEmployeesAsOf(WithTenantID(context.Background(), "tenant-123"), asOf, &ListFilter{Tags: []string{" Remote "}})
+
+	assert.NoError(t, err)
+	assert.Same(t, result, got)
+	repo.AssertExpectations(t)
+}
+
+func TestEmployeeHistory(t *testing.T) {
+	repo := new(MockAuditRepo)
+	uc := NewAuditUsecase(repo, log.NewStdLogger(io.Discard))
+	employeeID := uuid.New()
+
+	entries := []*AuditEntry{
+		{EmployeeID: employeeID, Action: AuditActionUpdate},
+		{EmployeeID: employeeID, Action: AuditActionCreate},
+	}
+	repo.On("List", mock.Anything, "tenant-123", mock.MatchedBy(func(f *AuditFilter) bool {
+		// History is unpaginated
+		return f.EmployeeID != nil && *f.EmployeeID == employeeID && f.PageSize == 0
+	})).Return(entries, int64(2), nil)
+
+	got, err := uc.EmployeeHistory(WithTenantID(context.Background(), "tenant-123"), employeeID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entries, got)
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,35 @@
+package biz
+
+// Reasons a request fails authentication
+const (
+	AuthFailureMissingToken     = "missing_token"
+	AuthFailureMalformedHeader  = "malformed_header"
+	AuthFailureMalformedToken   = "malformed_token"
+	AuthFailureInvalidSignature = "invalid_signature"
+	AuthFailureTokenExpired     = "token_expired"
+	AuthFailureTokenNotYetValid = "token_not_yet_valid"
+	AuthFailureMissingSubject   = "missing_subject"
+	AuthFailureMissingTenant    = "missing_tenant"
+	AuthFailureInvalidIssuer    = "invalid_issuer"
+	AuthFailureInvalidAudience  = "invalid_audience"
+	AuthFailureInvalidAPIKey    = "invalid_api_key"
+	AuthFailureTokenRevoked     = "token_revoked"
+	// AuthFailureInvalidRequestSignature is a request of an API key with a
+	// missing, malformed, stale or wrong X-Signature
+	AuthFailureInvalidRequestSignature = "invalid_request_signature"
+	// AuthFailureRequestReplayed is a signed request reusing a nonce
+	AuthFailureRequestReplayed = "request_replayed"
+)
+
+// AuthFailure is a request rejected by authentication, reported to security
+// monitoring
+type AuthFailure struct {
+	// Reason is one of the AuthFailure constants
+	Reason string
+	// TenantID is the tenant_id claim of the rejected token. It is not
+	// verified; empty when the token could not be decoded.
+	TenantID  string
+	ClientIP  string
+	Operation string
+	RequestID string
+}
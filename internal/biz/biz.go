@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewEmployeeUsecase)
+var ProviderSet = wire.NewSet(NewSystemClock, NewRandomIDGenerator, NewReviewPolicy, NewEmailPolicy, NewIdempotency, NewMergeLimiter, NewEmployeeUsecase, NewAdminUsecase, NewAuditUsecase, NewWebhookUsecase, NewImportUsecase, NewScheduleUsecase, NewMergeApprovalUsecase, NewTenantSettingsUsecase, NewAPIKeyUsecase, NewRequestSignatures, NewTokenRevocationUsecase, NewEventBackfillUsecase, NewTenantCloneUsecase, NewUsageTracker, NewInFlightRegistry, NewDepartmentUsecase, NewTeamUsecase, NewEmploymentHistoryUsecase, NewPhotoUsecase, NewQuotaUsecase, NewSubjectAccessUsecase, NewEmployeeDiffUsecase, NewEmployeeValidationUsecase, NewStaleUsecase, NewRunbookUsecase)
@@ -0,0 +1,44 @@
+package biz
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Clock tells the current time. Timestamps of records and events are taken
+// from the injected clock so that tests and event replays are deterministic.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to a Clock
+type ClockFunc func() time.Time
+
+// Now calls f().
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// NewSystemClock returns the wall clock
+func NewSystemClock() Clock {
+	return ClockFunc(time.Now)
+}
+
+// IDGenerator generates the IDs of new records and events
+type IDGenerator interface {
+	NewID() uuid.UUID
+}
+
+// IDGeneratorFunc adapts a function to an IDGenerator
+type IDGeneratorFunc func() uuid.UUID
+
+// NewID calls f().
+func (f IDGeneratorFunc) NewID() uuid.UUID {
+	return f()
+}
+
+// NewRandomIDGenerator returns a generator of random (version 4) UUIDs
+func NewRandomIDGenerator() IDGenerator {
+	return IDGeneratorFunc(uuid.New)
+}
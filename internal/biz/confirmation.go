@@ -0,0 +1,82 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// Destructive operations that require a confirmation token.
+const (
+	OperationPurgeTenant         = "purge_tenant"
+	OperationBulkDeleteEmployees = "bulk_delete_employees"
+)
+
+// ErrInvalidConfirmationToken is an unknown, expired, already used or mismatched confirmation token.
+var ErrInvalidConfirmationToken = errors.BadRequest(v1.ErrorReason_INVALID_CONFIRMATION_TOKEN.String(), "confirmation token is invalid, expired or does not match the request")
+
+// Confirmation is a pending confirmation for a destructive operation.
+// Only the hash of the token is persisted.
+type Confirmation struct {
+	TokenHash     string
+	TenantID      string
+	Operation     string
+	ParamsDigest  string
+	AffectedCount int64
+	RequestedBy   string
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+}
+
+// ConfirmationChallenge is handed to the caller in the first step of a destructive operation.
+type ConfirmationChallenge struct {
+	Token         string
+	ExpiresAt     time.Time
+	AffectedCount int64
+	Summary       string
+}
+
+// ConfirmationRepo stores confirmation tokens for destructive operations.
+type ConfirmationRepo interface {
+	Create(ctx context.Context, confirmation *Confirmation) error
+	// Consume atomically marks an unexpired, unused confirmation as used and returns it.
+	// It returns nil when no such confirmation exists.
+	Consume(ctx context.Context, tenantID, operation, tokenHash string, now time.Time) (*Confirmation, error)
+}
+
+// newConfirmationToken returns a random URL-safe token and its hash.
+func newConfirmationToken() (string, string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashConfirmationToken(token), nil
+}
+
+// hashConfirmationToken hashes a confirmation token for storage and lookup.
+func hashConfirmationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// digestIDs returns an order-independent digest of a set of employee IDs.
+func digestIDs(ids []uuid.UUID) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = id.String()
+	}
+	sort.Strings(parts)
+	sum := sha256.Sum256([]byte(strings.Join(parts, ",")))
+	return hex.EncodeToString(sum[:])
+}
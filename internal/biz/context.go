@@ -3,14 +3,40 @@ package biz
 import (
 	"context"
 
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
 	"github.com/go-kratos/kratos/v2/errors"
 )
 
 type contextKey string
 
 const (
-	tenantIDKey contextKey = "tenant_id"
-	userIDKey   contextKey = "user_id"
+	tenantIDKey        contextKey = "tenant_id"
+	userIDKey          contextKey = "user_id"
+	requestMetadataKey contextKey = "request_metadata"
+	rolesKey           contextKey = "roles"
+	channelKey         contextKey = "channel"
+	scopesKey          contextKey = "scopes"
+	idempotencyKeyKey  contextKey = "idempotency_key"
+	sourceKey          contextKey = "source"
+	impersonatorKey    contextKey = "impersonator"
+	apiKeyKey          contextKey = "api_key"
+)
+
+// Operation sources, telling downstream consumers what caused a change
+const (
+	// SourceAPI is a change made by an API request
+	SourceAPI = "api"
+	// SourceImport is a change made by a bulk import
+	SourceImport = "import"
+	// SourceSchedule is a scheduled change applied when it became due
+	SourceSchedule = "schedule"
+	// SourceBackfill is an event published for an existing employee by an
+	// event backfill, not a change
+	SourceBackfill = "backfill"
+	// SourceClone is an employee copied into a sandbox tenant by a tenant
+	// clone
+	SourceClone = "clone"
 )
 
 var (
@@ -18,6 +44,9 @@ var (
 	ErrTenantNotFound = errors.Unauthorized("TENANT_NOT_FOUND", "tenant not found in context")
 	// ErrUserNotFound is user not found in context.
 	ErrUserNotFound = errors.Unauthorized("USER_NOT_FOUND", "user not found in context")
+	// ErrDeadlineExhausted is returned when too little of the request
+	// deadline is left to call a dependency such as the database.
+	ErrDeadlineExhausted = errors.GatewayTimeout(v1.ErrorReason_DEADLINE_EXHAUSTED.String(), "request deadline exhausted")
 )
 
 // GetTenantID extracts tenant_id from context
@@ -47,3 +76,144 @@ func WithTenantID(ctx context.Context, tenantID string) context.Context {
 func WithUserID(ctx context.Context, userID string) context.Context {
 	return context.WithValue(ctx, userIDKey, userID)
 }
+
+// RequestMetadata describes the request being served. The server middleware
+// sets it once per request so that lower layers can log, audit and publish it
+// without parsing transport headers.
+type RequestMetadata struct {
+	// RequestID is the caller's X-Request-ID, generated when absent
+	RequestID string
+	// ClientIP is the caller's address, as reported by X-Forwarded-For when
+	// the service runs behind a trusted proxy
+	ClientIP string
+	// UserAgent is the caller's User-Agent
+	UserAgent string
+	// APIVersion is the version of the API called, e.g. v1
+	APIVersion string
+	// ClientAPIVersion is the version of the API behavior the caller
+	// negotiated with X-API-Version, e.g. 2026-10-16
+	ClientAPIVersion string
+	// Locale is the caller's preferred language tag from Accept-Language,
+	// e.g. en-US
+	Locale string
+}
+
+// GetRequestMetadata extracts the request metadata from context, returning
+// the zero value if absent
+func GetRequestMetadata(ctx context.Context) RequestMetadata {
+	md, _ := ctx.Value(requestMetadataKey).(RequestMetadata)
+	return md
+}
+
+// WithRequestMetadata injects the request metadata into context
+func WithRequestMetadata(ctx context.Context, md RequestMetadata) context.Context {
+	return context.WithValue(ctx, requestMetadataKey, md)
+}
+
+// GetRequestID extracts the request ID from context, returning "" if absent
+func GetRequestID(ctx context.Context) string {
+	return GetRequestMetadata(ctx).RequestID
+}
+
+// WithRequestID injects the request ID into the request metadata of context
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	md := GetRequestMetadata(ctx)
+	md.RequestID = requestID
+	return WithRequestMetadata(ctx, md)
+}
+
+// GetRoles extracts the caller's roles from context, returning nil if absent
+func GetRoles(ctx context.Context) []string {
+	roles, _ := ctx.Value(rolesKey).([]string)
+	return roles
+}
+
+// WithRoles injects the caller's roles into context
+func WithRoles(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, rolesKey, roles)
+}
+
+// GetScopes extracts the OAuth scopes granted to the caller's token. ok is
+// false when the token had no scope claim, so that the caller is governed by
+// its roles alone.
+func GetScopes(ctx context.Context) (scopes []string, ok bool) {
+	scopes, ok = ctx.Value(scopesKey).([]string)
+	return scopes, ok
+}
+
+// WithScopes injects the OAuth scopes granted to the caller into context
+func WithScopes(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, scopesKey, scopes)
+}
+
+// GetChannel extracts the channel the caller creates employees through,
+// returning ChannelAPI if absent
+func GetChannel(ctx context.Context) string {
+	channel, _ := ctx.Value(channelKey).(string)
+	if channel == "" {
+		return ChannelAPI
+	}
+	return channel
+}
+
+// WithChannel injects the caller's creation channel into context
+func WithChannel(ctx context.Context, channel string) context.Context {
+	return context.WithValue(ctx, channelKey, channel)
+}
+
+// GetIdempotencyKey extracts the idempotency key of the request, returning ""
+// if absent
+func GetIdempotencyKey(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyKey).(string)
+	return key
+}
+
+// WithIdempotencyKey injects the idempotency key of the request into context
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey, key)
+}
+
+// GetSource extracts the source of the operation being performed, returning
+// "" if absent
+func GetSource(ctx context.Context) string {
+	source, _ := ctx.Value(sourceKey).(string)
+	return source
+}
+
+// WithSource injects the source of the operation into context
+func WithSource(ctx context.Context, source string) context.Context {
+	return context.WithValue(ctx, sourceKey, source)
+}
+
+// Impersonator is the caller acting as another user or tenant of the
+// context, e.g. a support admin reproducing a problem. The tenant and user
+// of the context are those impersonated; changes are attributed to them and
+// record the impersonator alongside.
+type Impersonator struct {
+	TenantID string
+	UserID   string
+}
+
+// GetImpersonator extracts the impersonator of the caller. ok is false when
+// the caller acts as itself.
+func GetImpersonator(ctx context.Context) (impersonator Impersonator, ok bool) {
+	impersonator, ok = ctx.Value(impersonatorKey).(Impersonator)
+	return impersonator, ok
+}
+
+// WithImpersonator injects the impersonator of the caller into context
+func WithImpersonator(ctx context.Context, impersonator Impersonator) context.Context {
+	return context.WithValue(ctx, impersonatorKey, impersonator)
+}
+
+// GetAPIKey extracts the API key the caller authenticated with. ok is false
+// for callers authenticated otherwise.
+func GetAPIKey(ctx context.Context) (key *APIKey, ok bool) {
+	key, ok = ctx.Value(apiKeyKey).(*APIKey)
+	return key, ok
+}
+
+// WithAPIKey injects the API key the caller authenticated with into context
+func WithAPIKey(ctx context.Context, key *APIKey) context.Context {
+	return context.WithValue(ctx, apiKeyKey, key)
+}
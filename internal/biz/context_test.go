@@ -168,3 +168,34 @@ func TestContextIntegration(t *testing.T) {
 	assert.Equal(t, "user-456", userID)
 }
 
+func TestRequestID(t *testing.T) {
+	assert.Equal(t, "", GetRequestID(context.Background()))
+
+	ctx := WithRequestID(context.Background(), "req-789")
+	assert.Equal(t, "req-789", GetRequestID(ctx))
+}
+
+func TestRoles(t *testing.T) {
+	assert.Nil(t, GetRoles(context.Background()))
+
+	ctx := WithRoles(context.Background(), []string{"viewer", "admin"})
+	assert.Equal(t, []string{"viewer", "admin"}, GetRoles(ctx))
+}
+
+func TestRequestMetadata(t *testing.T) {
+	assert.Equal(t, RequestMetadata{}, GetRequestMetadata(context.Background()))
+
+	ctx := WithRequestMetadata(context.Background(), RequestMetadata{RequestID: "req-1", ClientIP: "192.0.2.1", APIVersion: "v1"})
+	ctx = WithRequestID(ctx, "req-2")
+	assert.Equal(t, RequestMetadata{RequestID: "req-2", ClientIP: "192.0.2.1", APIVersion: "v1"}, GetRequestMetadata(ctx))
+}
+
+func TestImpersonator(t *testing.T) {
+	_, ok := GetImpersonator(context.Background())
+	assert.False(t, ok)
+
+	ctx := WithImpersonator(context.Background(), Impersonator{TenantID: "support", UserID: "admin-1"})
+	impersonator, ok := GetImpersonator(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, Impersonator{TenantID: "support", UserID: "admin-1"}, impersonator)
+}
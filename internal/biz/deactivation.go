@@ -0,0 +1,53 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// IsActive reports whether the employee was not deactivated
+func (e *Employee) IsActive() bool {
+	return e.DeactivatedAt == nil
+}
+
+// DeactivateEmployee deactivates an employee of the caller's tenant, e.g.
+// when offboarded. Unlike a delete the employee is kept with its history and
+// stays readable, e.g. for payroll; listings leave it out unless asked not
+// to. It returns the employee and whether it changed; an employee already
+// deactivated is returned unchanged and publishes no event.
+func (uc *EmployeeUsecase) DeactivateEmployee(ctx context.Context, id uuid.UUID) (*Employee, bool, error) {
+	return uc.setActive(ctx, id, false)
+}
+
+// ReactivateEmployee reactivates a deactivated employee of the caller's
+// tenant, see DeactivateEmployee.
+func (uc *EmployeeUsecase) ReactivateEmployee(ctx context.Context, id uuid.UUID) (*Employee, bool, error) {
+	return uc.setActive(ctx, id, true)
+}
+
+// setActive changes the active state of an employee and publishes the change
+func (uc *EmployeeUsecase) setActive(ctx context.Context, id uuid.UUID, active bool) (*Employee, bool, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	eventType, operation := EventEmployeeDeactivated, "DeactivateEmployee"
+	if active {
+		eventType, operation = EventEmployeeReactivated, "ReactivateEmployee"
+	}
+	uc.log.WithContext(ctx).Infof("%s: tenant=%s, id=%s", operation, tenantID, id)
+
+	employee, changed, err := uc.repo.SetActive(ctx, tenantID, id, active)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if changed {
+		// Publish event (best-effort)
+		userID, _ := GetUserID(ctx)
+		uc.events.Publish(ctx, &DomainEvent{Type: eventType, TenantID: tenantID, UserID: userID, Employee: employee})
+	}
+	return employee, changed, nil
+}
@@ -0,0 +1,69 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeactivateEmployee(t *testing.T) {
+	id := uuid.New()
+	deactivated := &Employee{ID: id, DeactivatedAt: &scheduleNow, Version: 2}
+
+	t.Run("active employee", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+		repo.On("SetActive", mock.Anything, "tenant-123", id, false).Return(deactivated, true, nil)
+		pub.On("PublishEmployeeDeactivated", mock.Anything, "tenant-123", "user-456", deactivated).Return(nil)
+
+		employee, changed, err := uc.DeactivateEmployee(reviewContext(""), id)
+
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.False(t, employee.IsActive())
+		pub.AssertExpectations(t)
+	})
+
+	t.Run("already deactivated", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+		repo.On("SetActive", mock.Anything, "tenant-123", id, false).Return(deactivated, false, nil)
+
+		_, changed, err := uc.DeactivateEmployee(reviewContext(""), id)
+
+		require.NoError(t, err)
+		assert.False(t, changed)
+		pub.AssertNotCalled(t, "PublishEmployeeDeactivated", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		repo.On("SetActive", mock.Anything, "tenant-123", id, false).Return(nil, false, ErrEmployeeNotFound)
+
+		_, _, err := uc.DeactivateEmployee(reviewContext(""), id)
+
+		assert.Equal(t, ErrEmployeeNotFound, err)
+	})
+}
+
+func TestReactivateEmployee(t *testing.T) {
+	id := uuid.New()
+	active := &Employee{ID: id, Version: 3}
+	uc, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	uc.events = newTestEventBus(pub)
+	repo.On("SetActive", mock.Anything, "tenant-123", id, true).Return(active, true, nil)
+	pub.On("PublishEmployeeReactivated", mock.Anything, "tenant-123", "user-456", active).Return(nil)
+
+	employee, changed, err := uc.ReactivateEmployee(reviewContext(""), id)
+
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, employee.IsActive())
+	pub.AssertExpectations(t)
+}
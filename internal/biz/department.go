@@ -0,0 +1,197 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrDepartmentNotFound is returned when a department does not exist in
+	// the tenant, including when an employee is assigned to one
+	ErrDepartmentNotFound = errors.NotFound(v1.ErrorReason_DEPARTMENT_NOT_FOUND.String(), "department not found")
+	// ErrDepartmentAlreadyExists is a department named like another
+	// department of the tenant
+	ErrDepartmentAlreadyExists = errors.Conflict(v1.ErrorReason_DEPARTMENT_ALREADY_EXISTS.String(), "department with this name already exists")
+	// ErrDepartmentNotEmpty is a delete of a department that still has
+	// employees
+	ErrDepartmentNotEmpty = errors.Conflict(v1.ErrorReason_DEPARTMENT_NOT_EMPTY.String(), "department still has employees")
+)
+
+// Department groups the employees of a tenant
+type Department struct {
+	ID       uuid.UUID
+	TenantID string
+	// Name is unique within the tenant, case-insensitively
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// DepartmentUpdate holds the department fields to change; nil fields are kept
+type DepartmentUpdate struct {
+	Name        *string
+	Description *string
+}
+
+// DepartmentRepo stores departments
+type DepartmentRepo interface {
+	// Create stores a new department. It returns ErrDepartmentAlreadyExists
+	// when the name is taken.
+	Create(ctx context.Context, department *Department) (*Department, error)
+	Get(ctx context.Context, tenantID string, id uuid.UUID) (*Department, error)
+	// List returns a page of the tenant's departments ordered by name, and
+	// their total
+	List(ctx context.Context, tenantID string, page, pageSize int32) ([]*Department, int64, error)
+	// Update stores the changed name and description of a department
+	Update(ctx context.Context, department *Department) (*Department, error)
+	// Delete deletes a department, returning ErrDepartmentNotEmpty while
+	// employees belong to it
+	Delete(ctx context.Context, tenantID string, id uuid.UUID) error
+}
+
+// DepartmentUsecase manages the departments of the caller's tenant
+type DepartmentUsecase struct {
+	repo   DepartmentRepo
+	events *EventBus
+	ids    IDGenerator
+	log    *log.Helper
+}
+
+// NewDepartmentUsecase creates a new Department usecase.
+func NewDepartmentUsecase(repo DepartmentRepo, events *EventBus, ids IDGenerator, logger log.Logger) *DepartmentUsecase {
+	return &DepartmentUsecase{
+		repo:   repo,
+		events: events,
+		ids:    ids,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// CreateDepartment creates a department in the caller's tenant.
+func (uc *DepartmentUsecase) CreateDepartment(ctx context.Context, name, description string) (*Department, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("CreateDepartment: tenant=%s, name=%s", tenantID, name)
+
+	created, err := uc.repo.Create(ctx, &Department{
+		ID:          uc.ids.NewID(),
+		TenantID:    tenantID,
+		Name:        strings.TrimSpace(name),
+		Description: description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publish(ctx, EventDepartmentCreated, created)
+	return created, nil
+}
+
+// GetDepartment retrieves a department of the caller's tenant.
+func (uc *DepartmentUsecase) GetDepartment(ctx context.Context, id uuid.UUID) (*Department, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.Get(ctx, tenantID, id)
+}
+
+// ListDepartments lists a page of the departments of the caller's tenant. It
+// returns the page and page size after defaults.
+func (uc *DepartmentUsecase) ListDepartments(ctx context.Context, page, pageSize int32) ([]*Department, int64, int32, int32, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+
+	filter := &ListFilter{Page: page, PageSize: pageSize}
+	applyPagination(filter)
+
+	departments, total, err := uc.repo.List(ctx, tenantID, filter.Page, filter.PageSize)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+	return departments, total, filter.Page, filter.PageSize, nil
+}
+
+// UpdateDepartment applies the update to a department of the caller's
+// tenant. An update that changes nothing publishes no event.
+func (uc *DepartmentUsecase) UpdateDepartment(ctx context.Context, id uuid.UUID, update *DepartmentUpdate) (*Department, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("UpdateDepartment: tenant=%s, id=%s", tenantID, id)
+
+	department, err := uc.repo.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := false
+	if update.Name != nil && strings.TrimSpace(*update.Name) != department.Name {
+		department.Name = strings.TrimSpace(*update.Name)
+		changed = true
+	}
+	if update.Description != nil && *update.Description != department.Description {
+		department.Description = *update.Description
+		changed = true
+	}
+	if !changed {
+		return department, nil
+	}
+
+	updated, err := uc.repo.Update(ctx, department)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publish(ctx, EventDepartmentUpdated, updated)
+	return updated, nil
+}
+
+// DeleteDepartment deletes a department of the caller's tenant that has no
+// employees.
+func (uc *DepartmentUsecase) DeleteDepartment(ctx context.Context, id uuid.UUID) error {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("DeleteDepartment: tenant=%s, id=%s", tenantID, id)
+
+	department, err := uc.repo.Get(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.Delete(ctx, tenantID, id); err != nil {
+		return err
+	}
+
+	uc.publish(ctx, EventDepartmentDeleted, department)
+	return nil
+}
+
+// publish publishes a department change on the event bus (best-effort)
+func (uc *DepartmentUsecase) publish(ctx context.Context, eventType DomainEventType, department *Department) {
+	userID, _ := GetUserID(ctx)
+	uc.events.Publish(ctx, &DomainEvent{
+		Type:       eventType,
+		TenantID:   department.TenantID,
+		UserID:     userID,
+		Department: department,
+	})
+}
@@ -0,0 +1,171 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockDepartmentRepo is a mock implementation of DepartmentRepo
+type MockDepartmentRepo struct {
+	mock.Mock
+}
+
+func (m *MockDepartmentRepo) Create(ctx context.Context, department *Department) (*Department, error) {
+	args := m.Called(ctx, department)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Department), args.Error(1)
+}
+
+func (m *MockDepartmentRepo) Get(ctx context.Context, tenantID string, id uuid.UUID) (*Department, error) {
+	args := m.Called(ctx, tenantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Department), args.Error(1)
+}
+
+func (m *MockDepartmentRepo) List(ctx context.Context, tenantID string, page, pageSize int32) ([]*Department, int64, error) {
+	args := m.Called(ctx, tenantID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*Department), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockDepartmentRepo) Update(ctx context.Context, department *Department) (*Department, error) {
+	args := m.Called(ctx, department)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Department), args.Error(1)
+}
+
+func (m *MockDepartmentRepo) Delete(ctx context.Context, tenantID string, id uuid.UUID) error {
+	args := m.Called(ctx, tenantID, id)
+	return args.Error(0)
+}
+
+func newTestDepartmentUsecase(repo DepartmentRepo, pub EventPublisher, id uuid.UUID) *DepartmentUsecase {
+	return NewDepartmentUsecase(repo, newTestEventBus(pub), IDGeneratorFunc(func() uuid.UUID { return id }), log.NewStdLogger(io.Discard))
+}
+
+func TestCreateDepartment(t *testing.T) {
+	repo := new(MockDepartmentRepo)
+	pub := new(MockEventPublisher)
+	id := uuid.New()
+	uc := newTestDepartmentUsecase(repo, pub, id)
+	ctx := WithUserID(WithTenantID(context.Background(), "tenant-123"), "user-1")
+
+	created := &Department{ID: id, TenantID: "tenant-123", Name: "Engineering"}
+	repo.On("Create", mock.Anything, &Department{ID: id, TenantID: "tenant-123", Name: "Engineering", Description: "Builds things"}).
+		Return(created, nil)
+	pub.On("PublishDepartmentCreated", mock.Anything, "tenant-123", "user-1", created).Return(nil)
+
+	got, err := uc.CreateDepartment(ctx, " Engineering ", "Builds things")
+
+	require.NoError(t, err)
+	assert.Equal(t, created, got)
+	repo.AssertExpectations(t)
+	pub.AssertExpectations(t)
+}
+
+func TestCreateDepartment_NameTaken(t *testing.T) {
+	repo := new(MockDepartmentRepo)
+	pub := new(MockEventPublisher)
+	uc := newTestDepartmentUsecase(repo, pub, uuid.New())
+
+	repo.On("Create", mock.Anything, mock.Anything).Return(nil, ErrDepartmentAlreadyExists)
+
+	_, err := uc.CreateDepartment(WithTenantID(context.Background(), "tenant-123"), "Engineering", "")
+
+	assert.True(t, errors.Is(err, ErrDepartmentAlreadyExists))
+	pub.AssertNotCalled(t, "PublishDepartmentCreated", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateDepartment(t *testing.T) {
+	id := uuid.New()
+	name := "Platform"
+	description := "Unchanged"
+
+	t.Run("changed", func(t *testing.T) {
+		repo := new(MockDepartmentRepo)
+		pub := new(MockEventPublisher)
+		uc := newTestDepartmentUsecase(repo, pub, id)
+
+		repo.On("Get", mock.Anything, "tenant-123", id).
+			Return(&Department{ID: id, TenantID: "tenant-123", Name: "Engineering", Description: description}, nil)
+		updated := &Department{ID: id, TenantID: "tenant-123", Name: name, Description: description}
+		repo.On("Update", mock.Anything, updated).Return(updated, nil)
+		pub.On("PublishDepartmentUpdated", mock.Anything, "tenant-123", "", updated).Return(nil)
+
+		got, err := uc.UpdateDepartment(WithTenantID(context.Background(), "tenant-123"), id, &DepartmentUpdate{Name: &name})
+
+		require.NoError(t, err)
+		assert.Equal(t, updated, got)
+		repo.AssertExpectations(t)
+		pub.AssertExpectations(t)
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		repo := new(MockDepartmentRepo)
+		pub := new(MockEventPublisher)
+		uc := newTestDepartmentUsecase(repo, pub, id)
+
+		existing := &Department{ID: id, TenantID: "tenant-123", Name: name, Description: description}
+		repo.On("Get", mock.Anything, "tenant-123", id).Return(existing, nil)
+
+		got, err := uc.UpdateDepartment(WithTenantID(context.Background(), "tenant-123"), id, &DepartmentUpdate{Name: &name, Description: &description})
+
+		require.NoError(t, err)
+		assert.Equal(t, existing, got)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		pub.AssertNotCalled(t, "PublishDepartmentUpdated", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteDepartment_NotEmpty(t *testing.T) {
+	repo := new(MockDepartmentRepo)
+	pub := new(MockEventPublisher)
+	id := uuid.New()
+	uc := newTestDepartmentUsecase(repo, pub, id)
+
+	repo.On("Get", mock.Anything, "tenant-123", id).Return(&Department{ID: id, TenantID: "tenant-123"}, nil)
+	repo.On("Delete", mock.Anything, "tenant-123", id).Return(ErrDepartmentNotEmpty)
+
+	err := uc.DeleteDepartment(WithTenantID(context.Background(), "tenant-123"), id)
+
+	assert.True(t, errors.Is(err, ErrDepartmentNotEmpty))
+	pub.AssertNotCalled(t, "PublishDepartmentDeleted", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSameReference(t *testing.T) {
+	departmentID := uuid.New()
+	tests := []struct {
+		name      string
+		current   *uuid.UUID
+		requested uuid.UUID
+		changed   bool
+	}{
+		{name: "assign", current: nil, requested: departmentID, changed: true},
+		{name: "same department", current: &departmentID, requested: departmentID, changed: false},
+		{name: "remove", current: &departmentID, requested: uuid.Nil, changed: true},
+		{name: "remove unassigned", current: nil, requested: uuid.Nil, changed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested := tt.requested
+			assert.Equal(t, !tt.changed, sameReference(tt.current, &requested))
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Reasons for which two employees are considered duplicates
+const (
+	// DuplicateReasonSameName means both employees have the same normalized name
+	DuplicateReasonSameName = "same_name"
+	// DuplicateReasonSameEmailLocalPart means the employees have emails with the
+	// same local part at different domains
+	DuplicateReasonSameEmailLocalPart = "same_email_local_part"
+	// DuplicateReasonSimilarName means the names differ by a few edits
+	DuplicateReasonSimilarName = "similar_name"
+)
+
+const (
+	// defaultDuplicateLimit is the number of candidates returned by default
+	defaultDuplicateLimit = 100
+	// maxDuplicateLimit bounds the number of candidates returned
+	maxDuplicateLimit = 1000
+
+	// Score contributed by each reason; reasons are combined as independent
+	// evidence, 1 - (1-a)(1-b)...
+	sameNameScore           = 0.6
+	sameEmailLocalPartScore = 0.5
+	similarNameScore        = 0.4
+
+	// minNameSimilarity is the edit distance ratio from which names are similar
+	minNameSimilarity = 0.8
+	// maxDuplicateBucket skips local parts and names shared by more employees
+	// than this, such as info@ or a very common name, which would flood the
+	// result with weak pairs
+	maxDuplicateBucket = 50
+)
+
+// DuplicateCandidate is a pair of employees that are likely the same person.
+// Primary is the older employee, the natural primary of a merge.
+type DuplicateCandidate struct {
+	Primary   *Employee
+	Secondary *Employee
+	// Score in (0, 1]; higher is more likely a duplicate
+	Score float64
+	// Reasons are the DuplicateReason constants that matched
+	Reasons []string
+}
+
+// DuplicateFilter represents the options of a duplicate scan
+type DuplicateFilter struct {
+	// MinScore drops candidates scoring lower
+	MinScore float64
+	// Limit is the maximum number of candidates returned (default 100)
+	Limit int32
+}
+
+// FindDuplicateCandidates scans all employees of the tenant for likely
+// duplicates and returns candidate pairs, highest score first. Employees are
+// compared by normalized name, by email local part and by name similarity
+// within employees sharing the first letter of their last name.
+func (uc *EmployeeUsecase) FindDuplicateCandidates(ctx context.Context, filter *DuplicateFilter) ([]*DuplicateCandidate, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = defaultDuplicateLimit
+	}
+	if filter.Limit > maxDuplicateLimit {
+		filter.Limit = maxDuplicateLimit
+	}
+
+	uc.log.WithContext(ctx).Infof("FindDuplicateCandidates: tenant=%s, min_score=%.2f", tenantID, filter.MinScore)
+
+	it, err := uc.repo.Stream(ctx, tenantID, &StreamFilter{})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var employees []*Employee
+	for it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		employees = append(employees, it.Employee())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	candidates := FindDuplicates(employees, filter.MinScore)
+	if len(candidates) > int(filter.Limit) {
+		candidates = candidates[:filter.Limit]
+	}
+	return candidates, nil
+}
+
+// FindDuplicates returns the likely duplicate pairs among employees scoring
+// at least minScore, highest score first. employees are expected oldest
+// first; the earlier employee of a pair becomes its primary.
+func FindDuplicates(employees []*Employee, minScore float64) []*DuplicateCandidate {
+	names := make([]string, len(employees))
+	byName := make(map[string][]int)
+	byLocalPart := make(map[string][]int)
+	byInitial := make(map[rune][]int)
+
+	for i, e := range employees {
+		names[i] = normalizeName(e.FirstName + " " + e.LastName)
+		if names[i] == "" {
+			continue
+		}
+		byName[names[i]] = append(byName[names[i]], i)
+		if last := normalizeName(e.LastName); last != "" {
+			initial := []rune(last)[0]
+			byInitial[initial] = append(byInitial[initial], i)
+		}
+	}
+	for i, e := range employees {
+		seen := make(map[string]bool)
+		for _, email := range e.Emails {
+			local := emailLocalPart(email)
+			if local == "" || seen[local] {
+				continue
+			}
+			seen[local] = true
+			byLocalPart[local] = append(byLocalPart[local], i)
+		}
+	}
+
+	pairs := make(map[[2]int]*DuplicateCandidate)
+	add := func(i, j int, reason string) {
+		if i > j {
+			i, j = j, i
+		}
+		c, ok := pairs[[2]int{i, j}]
+		if !ok {
+			c = &DuplicateCandidate{Primary: employees[i], Secondary: employees[j]}
+			pairs[[2]int{i, j}] = c
+		}
+		if !slices.Contains(c.Reasons, reason) {
+			c.Reasons = append(c.Reasons, reason)
+		}
+	}
+
+	for _, bucket := range byName {
+		forEachPair(bucket, func(i, j int) {
+			add(i, j, DuplicateReasonSameName)
+		})
+	}
+	for local, bucket := range byLocalPart {
+		forEachPair(bucket, func(i, j int) {
+			if hasLocalPartAtOtherDomain(employees[i], employees[j], local) {
+				add(i, j, DuplicateReasonSameEmailLocalPart)
+			}
+		})
+	}
+	for _, bucket := range byInitial {
+		forEachPair(bucket, func(i, j int) {
+			if names[i] != names[j] && nameSimilarity(names[i], names[j]) >= minNameSimilarity {
+				add(i, j, DuplicateReasonSimilarName)
+			}
+		})
+	}
+
+	candidates := make([]*DuplicateCandidate, 0, len(pairs))
+	for _, c := range pairs {
+		c.Score = duplicateScore(c.Reasons)
+		if c.Score >= minScore {
+			candidates = append(candidates, c)
+		}
+	}
+	sort.Slice(candidates, func(a, b int) bool {
+		if candidates[a].Score != candidates[b].Score {
+			return candidates[a].Score > candidates[b].Score
+		}
+		if !candidates[a].Primary.CreatedAt.Equal(candidates[b].Primary.CreatedAt) {
+			return candidates[a].Primary.CreatedAt.Before(candidates[b].Primary.CreatedAt)
+		}
+		return candidates[a].Secondary.CreatedAt.Before(candidates[b].Secondary.CreatedAt)
+	})
+	return candidates
+}
+
+// forEachPair calls fn for every pair of a bucket, skipping oversized buckets
+func forEachPair(bucket []int, fn func(i, j int)) {
+	if len(bucket) > maxDuplicateBucket {
+		return
+	}
+	for a := 0; a < len(bucket); a++ {
+		for b := a + 1; b < len(bucket); b++ {
+			fn(bucket[a], bucket[b])
+		}
+	}
+}
+
+// hasLocalPartAtOtherDomain reports whether a and b have emails with the
+// given local part at different domains
+func hasLocalPartAtOtherDomain(a, b *Employee, local string) bool {
+	for _, ea := range a.Emails {
+		if emailLocalPart(ea) != local {
+			continue
+		}
+		for _, eb := range b.Emails {
+			if emailLocalPart(eb) == local && !strings.EqualFold(emailDomain(ea), emailDomain(eb)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// duplicateScore combines the scores of the matched reasons
+func duplicateScore(reasons []string) float64 {
+	miss := 1.0
+	for _, reason := range reasons {
+		switch reason {
+		case DuplicateReasonSameName:
+			miss *= 1 - sameNameScore
+		case DuplicateReasonSameEmailLocalPart:
+			miss *= 1 - sameEmailLocalPartScore
+		case DuplicateReasonSimilarName:
+			miss *= 1 - similarNameScore
+		}
+	}
+	return 1 - miss
+}
+
+// normalizeName lowercases name, drops everything but letters and digits and
+// collapses whitespace
+func normalizeName(name string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.TrimSpace(name) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(unicode.ToLower(r))
+		case unicode.IsSpace(r):
+			space = true
+		}
+	}
+	return b.String()
+}
+
+// emailLocalPart returns the lowercased local part of email without a
+// +suffix
+func emailLocalPart(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at <= 0 {
+		return ""
+	}
+	local := strings.ToLower(email[:at])
+	if plus := strings.IndexByte(local, '+'); plus > 0 {
+		local = local[:plus]
+	}
+	return local
+}
+
+// emailDomain returns the domain of email
+func emailDomain(email string) string {
+	return email[strings.LastIndexByte(email, '@')+1:]
+}
+
+// nameSimilarity returns 1 minus the edit distance of a and b relative to
+// the longer name
+func nameSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein returns the edit distance of a and b
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
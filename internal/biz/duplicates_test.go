@@ -0,0 +1,110 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func duplicateEmployee(firstName, lastName string, emails ...string) *Employee {
+	return &Employee{ID: uuid.New(), FirstName: firstName, LastName: lastName, Emails: emails}
+}
+
+func TestFindDuplicates(t *testing.T) {
+	jane := duplicateEmployee("Jane", "Doe", "jane.doe@acme.com")
+	janeUK := duplicateEmployee(" jane ", "DOE", "jane.doe+hr@acme.co.uk")
+	jayne := duplicateEmployee("Jayne", "Doe", "jdoe@acme.com")
+	john := duplicateEmployee("John", "Smith", "john@acme.com")
+	johnOther := duplicateEmployee("Jon", "Smyth", "john@other.com")
+
+	candidates := FindDuplicates([]*Employee{jane, janeUK, jayne, john, johnOther}, 0)
+
+	require.Len(t, candidates, 4)
+
+	// Same name and same local part outweigh a single reason
+	assert.Equal(t, jane, candidates[0].Primary)
+	assert.Equal(t, janeUK, candidates[0].Secondary)
+	assert.ElementsMatch(t, []string{DuplicateReasonSameName, DuplicateReasonSameEmailLocalPart}, candidates[0].Reasons)
+	assert.InDelta(t, 0.8, candidates[0].Score, 1e-9)
+
+	byPair := make(map[[2]*Employee]*DuplicateCandidate)
+	for _, c := range candidates {
+		byPair[[2]*Employee{c.Primary, c.Secondary}] = c
+	}
+	assert.Equal(t, []string{DuplicateReasonSimilarName}, byPair[[2]*Employee{jane, jayne}].Reasons)
+	assert.Equal(t, []string{DuplicateReasonSimilarName}, byPair[[2]*Employee{janeUK, jayne}].Reasons)
+	assert.ElementsMatch(t, []string{DuplicateReasonSameEmailLocalPart, DuplicateReasonSimilarName}, byPair[[2]*Employee{john, johnOther}].Reasons)
+
+	// minScore drops weaker candidates
+	candidates = FindDuplicates([]*Employee{jane, janeUK, jayne, john, johnOther}, 0.5)
+	assert.Len(t, candidates, 2)
+}
+
+func TestFindDuplicates_SameDomainIsNotADuplicate(t *testing.T) {
+	a := duplicateEmployee("Alice", "Adams", "info@acme.com")
+	b := duplicateEmployee("Bob", "Brown", "INFO@acme.com")
+
+	assert.Empty(t, FindDuplicates([]*Employee{a, b}, 0))
+}
+
+func TestFindDuplicates_SkipsOversizedBuckets(t *testing.T) {
+	var employees []*Employee
+	for i := 0; i <= maxDuplicateBucket; i++ {
+		employees = append(employees, duplicateEmployee(fmt.Sprintf("Person%d", i), "", fmt.Sprintf("info@domain%d.com", i)))
+	}
+
+	assert.Empty(t, FindDuplicates(employees, 0))
+}
+
+func TestNameSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, nameSimilarity("jane doe", "jane doe"))
+	assert.InDelta(t, 8.0/9, nameSimilarity("jane doe", "jayne doe"), 1e-9)
+	assert.Less(t, nameSimilarity("jane doe", "john smith"), minNameSimilarity)
+}
+
+func TestFindDuplicateCandidates(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+	older := duplicateEmployee("Jane", "Doe", "jane@acme.com")
+	older.CreatedAt = time.Now().Add(-time.Hour)
+	newer := duplicateEmployee("Jane", "Doe", "jane@other.com")
+	newer.CreatedAt = time.Now()
+
+	t.Run("streams the tenant and limits the result", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		it := &sliceIterator{employees: []*Employee{older, newer}}
+		repo.On("Stream", mock.Anything, "tenant-123", mock.Anything).Return(it, nil)
+
+		filter := &DuplicateFilter{}
+		candidates, err := uc.FindDuplicateCandidates(ctx, filter)
+
+		require.NoError(t, err)
+		require.Len(t, candidates, 1)
+		assert.Equal(t, older, candidates[0].Primary)
+		assert.Equal(t, int32(defaultDuplicateLimit), filter.Limit)
+		assert.True(t, it.closed)
+	})
+
+	t.Run("stream error", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		it := &sliceIterator{err: assert.AnError}
+		repo.On("Stream", mock.Anything, "tenant-123", mock.Anything).Return(it, nil)
+
+		_, err := uc.FindDuplicateCandidates(ctx, &DuplicateFilter{})
+
+		assert.Equal(t, assert.AnError, err)
+	})
+
+	t.Run("no tenant", func(t *testing.T) {
+		uc, _ := setupUsecase()
+
+		_, err := uc.FindDuplicateCandidates(context.Background(), &DuplicateFilter{})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// gmailDomains are the domains of Gmail addresses, folded to gmail.com
+var gmailDomains = []string{"gmail.com", "googlemail.com"}
+
+// EmailPolicy normalizes employee emails, so that addresses differing only
+// in case or surrounding whitespace are the same employee email. Gmail
+// addresses are folded for the tenants configured to, since Gmail ignores
+// dots and +suffixes in the local part. It also restricts new emails to the
+// domains each tenant allows in its settings, and applies the strict email
+// validation being rolled out, see CheckStrict.
+type EmailPolicy struct {
+	// foldGmail is the default; tenantFoldGmail overrides it per tenant
+	foldGmail       bool
+	tenantFoldGmail map[string]bool
+	// settings is nil when any domain is allowed
+	settings TenantSettingsRepo
+
+	// strictness is the default mode of the strict validation;
+	// tenantStrictness overrides it per tenant
+	strictness       string
+	tenantStrictness map[string]string
+	// sampleRate is the fraction of violations logged
+	sampleRate float64
+	// metrics is nil when violations are not counted
+	metrics StrictEmailMetrics
+	log     *log.Helper
+}
+
+// NewEmailPolicy creates the email policy configured in c, with the allowed
+// domains of the tenant settings
+func NewEmailPolicy(c *conf.Admin, settings TenantSettingsRepo, metrics StrictEmailMetrics, logger log.Logger) *EmailPolicy {
+	validation := c.GetEmailValidation()
+	p := &EmailPolicy{
+		foldGmail:        c.GetEmailNormalization().GetFoldGmail(),
+		tenantFoldGmail:  c.GetEmailNormalization().GetTenantFoldGmail(),
+		settings:         settings,
+		strictness:       strictness(validation.GetStrictness()),
+		tenantStrictness: make(map[string]string, len(validation.GetTenantStrictness())),
+		sampleRate:       defaultStrictEmailSampleRate,
+		metrics:          metrics,
+		log:              log.NewHelper(logger),
+	}
+	for tenantID, mode := range validation.GetTenantStrictness() {
+		p.tenantStrictness[tenantID] = strictness(mode)
+	}
+	if rate := validation.GetSampleRate(); rate > 0 {
+		p.sampleRate = rate
+	}
+	return p
+}
+
+// foldsGmail reports whether Gmail addresses of tenant are folded
+func (p *EmailPolicy) foldsGmail(tenantID string) bool {
+	if p == nil {
+		return false
+	}
+	if fold, ok := p.tenantFoldGmail[tenantID]; ok {
+		return fold
+	}
+	return p.foldGmail
+}
+
+// Normalize returns email as stored for tenant: trimmed, lowercased and, if
+// the tenant folds Gmail addresses, without dots and +suffix in the local
+// part of Gmail addresses
+func (p *EmailPolicy) Normalize(tenantID, email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if !p.foldsGmail(tenantID) {
+		return email
+	}
+
+	at := strings.LastIndexByte(email, '@')
+	if at <= 0 || !slices.Contains(gmailDomains, email[at+1:]) {
+		return email
+	}
+	local := email[:at]
+	if plus := strings.IndexByte(local, '+'); plus > 0 {
+		local = local[:plus]
+	}
+	return strings.ReplaceAll(local, ".", "") + "@gmail.com"
+}
+
+// NormalizeAll normalizes emails for tenant, dropping the emails that
+// normalize to an earlier one. nil stays nil.
+func (p *EmailPolicy) NormalizeAll(tenantID string, emails []string) []string {
+	if emails == nil {
+		return nil
+	}
+	normalized := make([]string, 0, len(emails))
+	for _, email := range emails {
+		email = p.Normalize(tenantID, email)
+		if !slices.Contains(normalized, email) {
+			normalized = append(normalized, email)
+		}
+	}
+	return normalized
+}
+
+// TenantSettings returns the settings restricting the emails of tenant, nil
+// when any domain is allowed
+func (p *EmailPolicy) TenantSettings(ctx context.Context, tenantID string) (*TenantSettings, error) {
+	if p == nil || p.settings == nil {
+		return nil, nil
+	}
+	return p.settings.Get(ctx, tenantID)
+}
+
+// CheckDomains returns ErrEmailDomainNotAllowed, naming the email, when one
+// of the normalized emails is outside the domains tenant allows
+func (p *EmailPolicy) CheckDomains(ctx context.Context, tenantID string, emails []string) error {
+	if len(emails) == 0 {
+		return nil
+	}
+	settings, err := p.TenantSettings(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	for _, email := range emails {
+		if !settings.AllowsEmail(email) {
+			return emailDomainNotAllowed(email)
+		}
+	}
+	return nil
+}
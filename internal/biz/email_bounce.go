@@ -0,0 +1,86 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Reasons an email is undeliverable
+const (
+	// EmailBounceReasonBounce is a permanent (hard) bounce
+	EmailBounceReasonBounce = "bounce"
+	// EmailBounceReasonComplaint is a spam complaint of the recipient
+	EmailBounceReasonComplaint = "complaint"
+)
+
+// ErrEmailUndeliverable is an email marked undeliverable designated as the
+// primary email, which mail to the employee, verification mail included, is
+// sent to
+var ErrEmailUndeliverable = errors.BadRequest(v1.ErrorReason_EMAIL_UNDELIVERABLE.String(), "email is marked undeliverable")
+
+// EmailBounce is a bounce or complaint the mail provider reported
+type EmailBounce struct {
+	Email string
+	// Reason is EmailBounceReasonBounce or EmailBounceReasonComplaint
+	Reason     string
+	OccurredAt time.Time
+}
+
+// UndeliverableEmail is an email of an employee that bounced or was
+// complained about
+type UndeliverableEmail struct {
+	Email  string
+	Reason string
+	// Since is when the first bounce or complaint of the email occurred
+	Since time.Time
+}
+
+// IsUndeliverable reports whether email, one of the employee's, is marked
+// undeliverable
+func (e *Employee) IsUndeliverable(email string) bool {
+	return slices.ContainsFunc(e.UndeliverableEmails, func(u UndeliverableEmail) bool { return strings.EqualFold(u.Email, email) })
+}
+
+// ReportEmailBounces marks the bounced emails of the caller's tenant's
+// employees undeliverable. Only the first report of an email is kept; emails
+// of no employee are ignored. Marks stay with an email through updates and
+// merges until it is removed from the employee. It returns the emails it
+// marked.
+func (uc *EmployeeUsecase) ReportEmailBounces(ctx context.Context, bounces []EmailBounce) ([]string, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("ReportEmailBounces: tenant=%s, bounces=%d", tenantID, len(bounces))
+
+	normalized := make([]EmailBounce, len(bounces))
+	for i, bounce := range bounces {
+		bounce.Email = uc.emails.Normalize(tenantID, bounce.Email)
+		normalized[i] = bounce
+	}
+
+	marked, employees, err := uc.repo.MarkEmailsUndeliverable(ctx, tenantID, normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	// Publish events (best-effort)
+	userID, _ := GetUserID(ctx)
+	for _, employee := range employees {
+		uc.events.Publish(ctx, &DomainEvent{
+			Type:          EventEmployeeUpdated,
+			TenantID:      tenantID,
+			UserID:        userID,
+			Employee:      employee,
+			UpdatedFields: []string{"undeliverable_emails"},
+		})
+	}
+	return marked, nil
+}
@@ -0,0 +1,44 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportEmailBounces(t *testing.T) {
+	uc, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	uc.events = newTestEventBus(pub)
+	employee := &Employee{ID: uuid.New(), Emails: []string{"jane@example.com"}, UndeliverableEmails: []UndeliverableEmail{{Email: "jane@example.com", Reason: EmailBounceReasonBounce, Since: scheduleNow}}}
+	repo.On("MarkEmailsUndeliverable", mock.Anything, "tenant-123", []EmailBounce{
+		{Email: "jane@example.com", Reason: EmailBounceReasonBounce, OccurredAt: scheduleNow},
+		{Email: "nobody@example.com", Reason: EmailBounceReasonComplaint, OccurredAt: scheduleNow},
+	}).Return([]string{"jane@example.com"}, []*Employee{employee}, nil)
+	pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "user-456", employee, []string{"undeliverable_emails"}, EmailChanges{}).Return(nil)
+
+	marked, err := uc.ReportEmailBounces(reviewContext(""), []EmailBounce{
+		{Email: " Jane@Example.com", Reason: EmailBounceReasonBounce, OccurredAt: scheduleNow},
+		{Email: "nobody@example.com", Reason: EmailBounceReasonComplaint, OccurredAt: scheduleNow},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jane@example.com"}, marked)
+	pub.AssertExpectations(t)
+}
+
+func TestReportEmailBounces_NothingMarked(t *testing.T) {
+	uc, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	uc.events = newTestEventBus(pub)
+	repo.On("MarkEmailsUndeliverable", mock.Anything, "tenant-123", mock.Anything).Return([]string(nil), []*Employee(nil), nil)
+
+	marked, err := uc.ReportEmailBounces(reviewContext(""), []EmailBounce{{Email: "jane@example.com", Reason: EmailBounceReasonBounce, OccurredAt: scheduleNow}})
+
+	require.NoError(t, err)
+	assert.Empty(t, marked)
+	pub.AssertNotCalled(t, "PublishEmployeeUpdated", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,156 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Modes of the strict email validation, set per tenant while it is rolled out
+const (
+	// EmailStrictnessOff skips the strict validation
+	EmailStrictnessOff = "off"
+	// EmailStrictnessLog counts and logs violations without rejecting them
+	EmailStrictnessLog = "log"
+	// EmailStrictnessEnforce rejects violating emails with INVALID_EMAIL
+	EmailStrictnessEnforce = "enforce"
+)
+
+// defaultStrictEmailSampleRate is the fraction of violations logged as
+// examples
+const defaultStrictEmailSampleRate = 0.1
+
+// Rules of the strict email validation, beyond the format checked by the API
+const (
+	StrictEmailLocalPartLength  = "local_part_length"
+	StrictEmailLocalPartDots    = "local_part_dots"
+	StrictEmailDomainWithoutDot = "domain_without_dot"
+	StrictEmailInvalidTLD       = "invalid_tld"
+)
+
+// strictEmailMessages describe the rules
+var strictEmailMessages = map[string]string{
+	StrictEmailLocalPartLength:  "the part before @ is longer than 64 characters",
+	StrictEmailLocalPartDots:    "the part before @ starts or ends with a dot or has consecutive dots",
+	StrictEmailDomainWithoutDot: "the domain has no dot",
+	StrictEmailInvalidTLD:       "the top-level domain is not made of letters",
+}
+
+// StrictEmailMetrics counts the violations of the strict email validation
+type StrictEmailMetrics interface {
+	// RecordStrictEmailViolation counts an email violating rule under mode,
+	// log or enforce
+	RecordStrictEmailViolation(rule, mode string)
+}
+
+// strictEmailRule returns the rule a normalized email violates, "" when it
+// passes the strict validation
+func strictEmailRule(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		return ""
+	}
+	local, domain := email[:at], email[at+1:]
+
+	if len(local) > 64 {
+		return StrictEmailLocalPartLength
+	}
+	if strings.HasPrefix(local, ".") || strings.HasSuffix(local, ".") || strings.Contains(local, "..") {
+		return StrictEmailLocalPartDots
+	}
+	dot := strings.LastIndexByte(domain, '.')
+	if dot < 0 {
+		return StrictEmailDomainWithoutDot
+	}
+	if !validTLD(domain[dot+1:]) {
+		return StrictEmailInvalidTLD
+	}
+	return ""
+}
+
+// validTLD reports whether tld is two or more letters, or an internationalized
+// top-level domain in punycode
+func validTLD(tld string) bool {
+	if strings.HasPrefix(tld, "xn--") {
+		return len(tld) > len("xn--")
+	}
+	if len(tld) < 2 {
+		return false
+	}
+	for _, r := range tld {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// strictEmailError is the INVALID_EMAIL error of email violating rule
+func strictEmailError(email, rule string) error {
+	return errors.BadRequest(v1.ErrorReason_INVALID_EMAIL.String(), fmt.Sprintf("invalid email %s: %s", email, strictEmailMessages[rule]))
+}
+
+// maskEmail hides all but the first character of the part before @ of an
+// email logged as an example
+func maskEmail(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at <= 0 {
+		return "***"
+	}
+	return email[:1] + "***" + email[at:]
+}
+
+// Strictness returns the mode of the strict email validation of tenant
+func (p *EmailPolicy) Strictness(tenantID string) string {
+	if p == nil {
+		return EmailStrictnessOff
+	}
+	if mode, ok := p.tenantStrictness[tenantID]; ok {
+		return mode
+	}
+	return p.strictness
+}
+
+// CheckStrict applies the strict email validation to the normalized new
+// emails of tenant. Violations are counted, and a sample logged, in log and
+// enforce mode; only in enforce mode is the first returned as
+// INVALID_EMAIL.
+func (p *EmailPolicy) CheckStrict(ctx context.Context, tenantID string, emails []string) error {
+	mode := p.Strictness(tenantID)
+	if mode == EmailStrictnessOff {
+		return nil
+	}
+
+	for _, email := range emails {
+		rule := strictEmailRule(email)
+		if rule == "" {
+			continue
+		}
+		if p.metrics != nil {
+			p.metrics.RecordStrictEmailViolation(rule, mode)
+		}
+		if p.sampleRate >= 1 || rand.Float64() < p.sampleRate {
+			p.log.WithContext(ctx).Warnf("strict email validation (%s): tenant=%s, source=%s, rule=%s, email=%s",
+				mode, tenantID, GetSource(ctx), rule, maskEmail(email))
+		}
+		if mode == EmailStrictnessEnforce {
+			return strictEmailError(email, rule)
+		}
+	}
+	return nil
+}
+
+// strictness returns the configured mode s, log unless off or enforce
+func strictness(s string) string {
+	switch s {
+	case EmailStrictnessOff, EmailStrictnessEnforce:
+		return s
+	default:
+		return EmailStrictnessLog
+	}
+}
@@ -0,0 +1,149 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// recordedStrictEmails records the violations counted by an EmailPolicy
+type recordedStrictEmails struct {
+	violations []string
+}
+
+func (r *recordedStrictEmails) RecordStrictEmailViolation(rule, mode string) {
+	r.violations = append(r.violations, rule+"/"+mode)
+}
+
+func newStrictEmailPolicy(strictness string, tenants map[string]string) (*EmailPolicy, *recordedStrictEmails) {
+	metrics := &recordedStrictEmails{}
+	return NewEmailPolicy(&conf.Admin{EmailValidation: &conf.Admin_EmailValidation{
+		Strictness:       strictness,
+		TenantStrictness: tenants,
+		SampleRate:       1,
+	}}, nil, metrics, log.NewStdLogger(io.Discard)), metrics
+}
+
+func TestStrictEmailRule(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{"jane@example.com", ""},
+		{"jane.doe@mail.example.co.uk", ""},
+		{"jane@example.xn--p1ai", ""},
+		{strings.Repeat("a", 65) + "@example.com", StrictEmailLocalPartLength},
+		{".jane@example.com", StrictEmailLocalPartDots},
+		{"jane.@example.com", StrictEmailLocalPartDots},
+		{"jane..doe@example.com", StrictEmailLocalPartDots},
+		{"jane@localhost", StrictEmailDomainWithoutDot},
+		{"jane@example.c", StrictEmailInvalidTLD},
+		{"jane@example.c0m", StrictEmailInvalidTLD},
+		{"jane@10.0.0.1", StrictEmailInvalidTLD},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, strictEmailRule(tt.email), tt.email)
+	}
+}
+
+func TestEmailPolicy_Strictness(t *testing.T) {
+	p, _ := newStrictEmailPolicy("", map[string]string{"tenant-1": "enforce", "tenant-2": "off", "tenant-3": "bogus"})
+
+	assert.Equal(t, EmailStrictnessLog, p.Strictness("tenant-123"))
+	assert.Equal(t, EmailStrictnessEnforce, p.Strictness("tenant-1"))
+	assert.Equal(t, EmailStrictnessOff, p.Strictness("tenant-2"))
+	assert.Equal(t, EmailStrictnessLog, p.Strictness("tenant-3"))
+	assert.Equal(t, EmailStrictnessOff, (*EmailPolicy)(nil).Strictness("tenant-123"))
+}
+
+func TestEmailPolicy_CheckStrict(t *testing.T) {
+	p, metrics := newStrictEmailPolicy(EmailStrictnessLog, map[string]string{"tenant-1": "enforce", "tenant-2": "off"})
+	ctx := context.Background()
+	emails := []string{"jane@example.com", "jane..doe@example.com", "john@localhost"}
+
+	// Log mode counts every violation without rejecting
+	require.NoError(t, p.CheckStrict(ctx, "tenant-123", emails))
+	assert.Equal(t, []string{"local_part_dots/log", "domain_without_dot/log"}, metrics.violations)
+
+	// Off skips the validation
+	metrics.violations = nil
+	require.NoError(t, p.CheckStrict(ctx, "tenant-2", emails))
+	assert.Empty(t, metrics.violations)
+
+	// Enforce mode rejects the first violation
+	err := p.CheckStrict(ctx, "tenant-1", emails)
+	assert.Equal(t, "INVALID_EMAIL", errors.Reason(err))
+	assert.Equal(t, "invalid email jane..doe@example.com: "+strictEmailMessages[StrictEmailLocalPartDots], errors.FromError(err).Message)
+	assert.Equal(t, []string{"local_part_dots/enforce"}, metrics.violations)
+}
+
+func TestMaskEmail(t *testing.T) {
+	assert.Equal(t, "j***@example.com", maskEmail("jane@example.com"))
+	assert.Equal(t, "***", maskEmail("@example.com"))
+}
+
+func TestCreateEmployee_StrictEmailEnforced(t *testing.T) {
+	uc, repo := setupUsecase()
+	uc.emails, _ = newStrictEmailPolicy(EmailStrictnessEnforce, nil)
+
+	_, err := uc.CreateEmployee(reviewContext(""), &Employee{Emails: []string{"jane@localhost"}})
+
+	assert.Equal(t, "INVALID_EMAIL", errors.Reason(err))
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestValidateEmployee_StrictEmail(t *testing.T) {
+	tests := []struct {
+		name           string
+		strictness     string
+		wantViolations []ValidationIssue
+		wantWarnings   []ValidationIssue
+	}{
+		{
+			name:       "log",
+			strictness: EmailStrictnessLog,
+			wantWarnings: []ValidationIssue{{
+				Field:   "emails[0]",
+				Code:    WarningEmailNotStrict,
+				Message: "jane@localhost will be rejected once strict email validation is enforced: " + strictEmailMessages[StrictEmailDomainWithoutDot],
+			}},
+		},
+		{
+			name:       "enforce",
+			strictness: EmailStrictnessEnforce,
+			wantViolations: []ValidationIssue{{
+				Field:   "emails[0]",
+				Code:    "INVALID_EMAIL",
+				Message: "invalid email jane@localhost: " + strictEmailMessages[StrictEmailDomainWithoutDot],
+			}},
+		},
+		{name: "off", strictness: EmailStrictnessOff},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, employees, repo, _ := setupValidationUsecase()
+			var metrics *recordedStrictEmails
+			employees.emails, metrics = newStrictEmailPolicy(tt.strictness, nil)
+			repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"jane@localhost"}).Return(map[string]bool{}, nil)
+
+			validation, err := uc.ValidateEmployee(reviewContext(""), &Employee{Emails: []string{"jane@localhost"}}, nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantViolations, validation.Violations)
+			assert.Equal(t, tt.wantWarnings, validation.Warnings)
+			// A dry run does not count violations
+			assert.Empty(t, metrics.violations)
+		})
+	}
+}
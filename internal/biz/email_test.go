@@ -0,0 +1,95 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailPolicy_Normalize(t *testing.T) {
+	p := NewEmailPolicy(&conf.Admin{EmailNormalization: &conf.Admin_EmailNormalization{
+		TenantFoldGmail: map[string]bool{"folding": true},
+	}}, nil, nil, log.NewStdLogger(io.Discard))
+
+	tests := []struct {
+		tenantID string
+		email    string
+		want     string
+	}{
+		{tenantID: "tenant-1", email: " Foo@X.com ", want: "foo@x.com"},
+		{tenantID: "tenant-1", email: "J.Doe+hr@Gmail.com", want: "j.doe+hr@gmail.com"},
+		{tenantID: "folding", email: "J.Doe+hr@Gmail.com", want: "jdoe@gmail.com"},
+		{tenantID: "folding", email: "j.doe@googlemail.com", want: "jdoe@gmail.com"},
+		{tenantID: "folding", email: "j.doe+hr@example.com", want: "j.doe+hr@example.com"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, p.Normalize(tt.tenantID, tt.email), "%s in %s", tt.email, tt.tenantID)
+	}
+}
+
+func TestEmailPolicy_TenantOverride(t *testing.T) {
+	p := NewEmailPolicy(&conf.Admin{EmailNormalization: &conf.Admin_EmailNormalization{
+		FoldGmail:       true,
+		TenantFoldGmail: map[string]bool{"exact": false},
+	}}, nil, nil, log.NewStdLogger(io.Discard))
+
+	assert.Equal(t, "jdoe@gmail.com", p.Normalize("tenant-1", "j.doe@gmail.com"))
+	assert.Equal(t, "j.doe@gmail.com", p.Normalize("exact", "j.doe@gmail.com"))
+}
+
+func TestEmailPolicy_NormalizeAll(t *testing.T) {
+	var p *EmailPolicy
+
+	assert.Equal(t, []string{"foo@x.com", "bar@x.com"}, p.NormalizeAll("tenant-1", []string{"Foo@X.com", "bar@x.com", "foo@x.com"}))
+	assert.Nil(t, p.NormalizeAll("tenant-1", nil))
+}
+
+func TestCreateEmployee_NormalizesEmails(t *testing.T) {
+	uc, repo := setupUsecase()
+	uc.events = newTestEventBus(new(MockEventPublisher))
+	ctx := WithTenantID(context.Background(), "tenant-123")
+
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"foo@x.com"}).Return(map[string]bool{"foo@x.com": true}, nil)
+
+	_, err := uc.CreateEmployee(ctx, &Employee{Emails: []string{"Foo@X.com"}, FirstName: "Foo", LastName: "Bar"})
+
+	assert.Equal(t, ErrEmployeeAlreadyExists, err)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetEmployeeByEmail_NormalizesEmail(t *testing.T) {
+	uc, repo := setupUsecase()
+	ctx := WithTenantID(context.Background(), "tenant-123")
+	employee := &Employee{ID: uuid.New(), ReviewStatus: ReviewStatusApproved}
+	repo.On("GetByEmail", mock.Anything, "tenant-123", "foo@x.com").Return(employee, nil)
+
+	got, err := uc.GetEmployeeByEmail(ctx, " FOO@x.com")
+
+	require.NoError(t, err)
+	assert.Same(t, employee, got)
+}
+
+func TestUpdateEmployee_RecasedEmailIsNotTaken(t *testing.T) {
+	uc, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	uc.events = newTestEventBus(pub)
+	id := uuid.New()
+	pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "", mock.Anything, []string{"emails"}, EmailChanges{}).Return(nil)
+
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(&Employee{ID: id, Version: 1, Emails: []string{"Foo@X.com"}}, nil)
+	repo.On("Update", mock.Anything, "tenant-123", mock.Anything).Return(&Employee{ID: id, Version: 2, Emails: []string{"foo@x.com"}}, nil)
+
+	_, err := uc.UpdateEmployee(WithTenantID(context.Background(), "tenant-123"), &Employee{ID: id, Version: 1, Emails: []string{"FOO@x.com"}})
+
+	require.NoError(t, err)
+	repo.AssertNotCalled(t, "CheckEmailsExist", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -13,26 +13,151 @@ var (
 	// ErrEmployeeNotFound is employee not found.
 	ErrEmployeeNotFound = errors.NotFound(v1.ErrorReason_EMPLOYEE_NOT_FOUND.String(), "employee not found")
 	// ErrEmployeeAlreadyExists is employee already exists.
-	ErrEmployeeAlreadyExists = errors.BadRequest(v1.ErrorReason_EMPLOYEE_ALREADY_EXISTS.String(), "employee already exists")
+	ErrEmployeeAlreadyExists = errors.Conflict(v1.ErrorReason_EMPLOYEE_ALREADY_EXISTS.String(), "employee already exists")
 	// ErrInvalidEmail is invalid email format.
 	ErrInvalidEmail = errors.BadRequest(v1.ErrorReason_INVALID_EMAIL.String(), "invalid email format")
 	// ErrInvalidEmployeeID is invalid employee ID.
 	ErrInvalidEmployeeID = errors.BadRequest(v1.ErrorReason_INVALID_EMPLOYEE_ID.String(), "invalid employee ID")
 	// ErrInvalidDateRange is invalid date range.
 	ErrInvalidDateRange = errors.BadRequest(v1.ErrorReason_INVALID_DATE_RANGE.String(), "created_after must be before created_before")
+	// ErrInvalidListFilter is an invalid name or email list filter.
+	ErrInvalidListFilter = errors.BadRequest(v1.ErrorReason_INVALID_LIST_FILTER.String(), "invalid list filter")
 	// ErrInvalidMerge is invalid merge request.
 	ErrInvalidMerge = errors.BadRequest(v1.ErrorReason_INVALID_MERGE.String(), "primary and secondary emails must be different")
+	// ErrMergeNotFound is merge not found, or already undone.
+	ErrMergeNotFound = errors.NotFound(v1.ErrorReason_MERGE_NOT_FOUND.String(), "merge not found")
+	// ErrUnmergeConflict is returned when a merge cannot be undone because the
+	// employees changed since.
+	ErrUnmergeConflict = errors.Conflict(v1.ErrorReason_UNMERGE_CONFLICT.String(), "employees changed since the merge")
+	// ErrVersionRequired is an update without the version it is based on.
+	ErrVersionRequired = errors.BadRequest(v1.ErrorReason_VERSION_REQUIRED.String(), "version (or If-Match) is required to update an employee")
+	// ErrVersionMismatch is returned when an update is based on an outdated
+	// version of the employee.
+	ErrVersionMismatch = errors.Conflict(v1.ErrorReason_VERSION_MISMATCH.String(), "employee was changed since the given version")
+	// ErrEmployeeNameRequired is an upsert that would create an employee
+	// without a first or last name.
+	ErrEmployeeNameRequired = errors.BadRequest(v1.ErrorReason_EMPLOYEE_NAME_REQUIRED.String(), "first_name and last_name are required to create an employee")
+	// ErrEmployeeEmailNotFound is returned when an email designated as primary
+	// is not one of the employee's emails.
+	ErrEmployeeEmailNotFound = errors.NotFound(v1.ErrorReason_EMPLOYEE_EMAIL_NOT_FOUND.String(), "email is not one of the employee's emails")
 )
 
 // Employee is an Employee domain model.
 type Employee struct {
-	ID        uuid.UUID
-	TenantID  string
-	Emails    []string
-	FirstName string
-	LastName  string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID       uuid.UUID
+	TenantID string
+	Emails   []string
+	// PrimaryEmail is the primary one of Emails, and Emails lists it first.
+	// An update with an empty primary email keeps the primary, or moves it to
+	// the first email when the emails replacing Emails do not include it.
+	PrimaryEmail string
+	FirstName    string
+	LastName     string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	// Version starts at 1 and is incremented by every change of the employee
+	Version int64
+	// ReviewStatus is ReviewStatusApproved, or ReviewStatusPending while the
+	// employee waits for review
+	ReviewStatus string
+	// DepartmentID is the department of the employee, nil when unassigned.
+	// In an update nil keeps the department and uuid.Nil removes it.
+	DepartmentID *uuid.UUID
+	// ManagerID is the employee's manager in the same tenant, nil when the
+	// employee has none. Updates follow the semantics of DepartmentID.
+	ManagerID *uuid.UUID
+	// Title is the job title, empty when unset. An update with an empty
+	// title keeps the title.
+	Title string
+	// Tags group the employee, lowercase and sorted. In an update nil keeps
+	// the tags and any other value, including an empty slice, replaces them.
+	Tags []string
+	// PhoneNumbers are normalized to E.164. Updates follow the semantics of
+	// Tags.
+	PhoneNumbers []PhoneNumber
+	// Address is the postal address, nil when the employee has none. In an
+	// update nil keeps the address and an empty address removes it.
+	Address *Address
+	// PhotoKey is the object key of the photo in the PhotoStore, empty when
+	// the employee has none. An update with an empty key keeps the photo.
+	PhotoKey string
+	// ExternalIDs are the IDs of the employee in external systems such as an
+	// HRIS, by lowercase system name. An ID belongs to at most one employee
+	// of the tenant per system. Updates follow the semantics of Tags.
+	ExternalIDs map[string]string
+	// DeactivatedAt is when the employee was deactivated, nil while it is
+	// active. Updates leave it unchanged.
+	DeactivatedAt *time.Time
+	// StaleAt is when the employee was last flagged stale, nil if never.
+	// The flag lapses once the employee changes, see IsStale.
+	StaleAt *time.Time
+	// UndeliverableEmails are the emails of Emails that bounced, in the order
+	// of Emails. Updates leave them unchanged.
+	UndeliverableEmails []UndeliverableEmail
+}
+
+// Merge records the merge of Secondary into Primary. Secondary is the
+// secondary employee as it was before the merge; Primary is the primary
+// employee after the merge, or after the unmerge once UnmergedAt is set.
+type Merge struct {
+	ID         uuid.UUID
+	TenantID   string
+	Primary    *Employee
+	Secondary  *Employee
+	MergedBy   string
+	CreatedAt  time.Time
+	UnmergedBy string
+	UnmergedAt *time.Time
+}
+
+// MovedEmails returns the emails of the secondary employee, all of which the
+// merge moved to the primary employee
+func (m *Merge) MovedEmails() []string {
+	if m.Secondary == nil {
+		return nil
+	}
+	return m.Secondary.Emails
+}
+
+// MovedExternalIDs returns the external IDs of the secondary employee the
+// merge moved to the primary employee; IDs in systems the primary employee
+// already had an ID in are dropped by merges.
+func (m *Merge) MovedExternalIDs() map[string]string {
+	moved := map[string]string{}
+	if m.Primary == nil || m.Secondary == nil {
+		return moved
+	}
+	for system, id := range m.Secondary.ExternalIDs {
+		if m.Primary.ExternalIDs[system] == id {
+			moved[system] = id
+		}
+	}
+	return moved
+}
+
+// MergeRedirect is a merge as followed from the ID of its secondary employee:
+// the employee FromID was merged into the employee ToID
+type MergeRedirect struct {
+	MergeID  uuid.UUID
+	FromID   uuid.UUID
+	ToID     uuid.UUID
+	MergedAt time.Time
+}
+
+// NameConflicts returns the name fields in which the secondary employee
+// differs from the primary employee; a merge keeps the primary's values.
+func (m *Merge) NameConflicts() []string {
+	conflicts := []string{}
+	if m.Primary == nil || m.Secondary == nil {
+		return conflicts
+	}
+	if m.Primary.FirstName != m.Secondary.FirstName {
+		conflicts = append(conflicts, "first_name")
+	}
+	if m.Primary.LastName != m.Secondary.LastName {
+		conflicts = append(conflicts, "last_name")
+	}
+	return conflicts
 }
 
 // ListFilter represents filtering options for listing employees
@@ -41,6 +166,30 @@ type ListFilter struct {
 	PageSize      int32
 	CreatedAfter  *time.Time
 	CreatedBefore *time.Time
+	// NamePrefix matches the start of the first, last or full name,
+	// case-insensitively
+	NamePrefix string
+	// EmailDomain matches employees with an email at the domain
+	EmailDomain string
+	// EmailContains matches employees with an email containing it,
+	// case-insensitively
+	EmailContains string
+	// UpdatedSince matches employees updated at or after it and orders the
+	// result by update time, oldest first
+	UpdatedSince *time.Time
+	// ReviewStatus lists employees in this review status, oldest first;
+	// empty lists approved employees
+	ReviewStatus string
+	// DepartmentID matches employees of the department
+	DepartmentID *uuid.UUID
+	// ManagerID matches the direct reports of the manager
+	ManagerID *uuid.UUID
+	// Tags matches employees having all of the tags
+	Tags []string
+	// ActiveOnly leaves out deactivated employees
+	ActiveOnly bool
+	// Stale, when set, matches employees that are stale (true) or not
+	Stale *bool
 }
 
 // ListResult represents paginated list result
@@ -48,3 +197,44 @@ type ListResult struct {
 	Employees []*Employee
 	Total     int64
 }
+
+// StreamCursor is a position in a stream of employees ordered by creation time
+type StreamCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// StreamFilter represents filtering options for streaming employees.
+// Employees are streamed oldest first; After resumes a previous stream.
+type StreamFilter struct {
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// NamePrefix, EmailDomain and EmailContains match like those of a
+	// ListFilter
+	NamePrefix    string
+	EmailDomain   string
+	EmailContains string
+	// UpdatedSince matches employees updated at or after it; the order
+	// stays by creation time
+	UpdatedSince *time.Time
+	// DepartmentID matches employees of the department
+	DepartmentID *uuid.UUID
+	// Tags matches employees having all of the tags
+	Tags []string
+	// ActiveOnly leaves out deactivated employees
+	ActiveOnly bool
+	// Stale, when set, matches employees that are stale (true) or not
+	Stale *bool
+	After *StreamCursor
+	// Limit caps the number of employees streamed, unlimited when 0
+	Limit int
+}
+
+// sameReference reports whether current is the department or manager
+// requested by an update, where uuid.Nil requests none
+func sameReference(current, requested *uuid.UUID) bool {
+	if current == nil {
+		return *requested == uuid.Nil
+	}
+	return *current == *requested
+}
@@ -0,0 +1,207 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// EmployeeDiff compares two employees for a merge decision
+type EmployeeDiff struct {
+	A *Employee
+	B *Employee
+	// Fields are all compared fields, equal or not, in a fixed order
+	Fields []FieldDiff
+	Emails EmailDiff
+	// HistoryA and HistoryB summarize the histories of A and B
+	HistoryA HistorySummary
+	HistoryB HistorySummary
+}
+
+// FieldDiff is a field of two employees rendered as text
+type FieldDiff struct {
+	// Field is the field name; external IDs are compared per system as
+	// external_ids.<system>
+	Field string
+	A     string
+	B     string
+	Equal bool
+}
+
+// EmailDiff splits the emails of two employees, each list in the order of
+// the employee
+type EmailDiff struct {
+	Common []string
+	OnlyA  []string
+	OnlyB  []string
+}
+
+// HistorySummary summarizes the audit and employment history of an employee
+type HistorySummary struct {
+	AuditEntries int64
+	// LastAction, LastActorID and LastChangedAt are those of the latest
+	// audit entry, zero without entries
+	LastAction    string
+	LastActorID   string
+	LastChangedAt *time.Time
+	Jobs          int64
+}
+
+// EmployeeDiffUsecase compares employees
+type EmployeeDiffUsecase struct {
+	repo    EmployeeRepo
+	audit   AuditRepo
+	history EmploymentHistoryRepo
+	log     *log.Helper
+}
+
+// NewEmployeeDiffUsecase creates a new EmployeeDiff usecase.
+func NewEmployeeDiffUsecase(repo EmployeeRepo, audit AuditRepo, history EmploymentHistoryRepo, logger log.Logger) *EmployeeDiffUsecase {
+	return &EmployeeDiffUsecase{
+		repo:    repo,
+		audit:   audit,
+		history: history,
+		log:     log.NewHelper(logger),
+	}
+}
+
+// DiffEmployees compares two employees of the caller's tenant field by field,
+// with their emails and a summary of their histories. It returns
+// ErrEmployeeNotFound when either does not exist.
+func (uc *EmployeeDiffUsecase) DiffEmployees(ctx context.Context, idA, idB uuid.UUID) (*EmployeeDiff, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("DiffEmployees: tenant=%s, a=%s, b=%s", tenantID, idA, idB)
+
+	a, err := uc.repo.GetByID(ctx, tenantID, idA)
+	if err != nil {
+		return nil, err
+	}
+	b, err := uc.repo.GetByID(ctx, tenantID, idB)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &EmployeeDiff{A: a, B: b, Fields: diffFields(a, b), Emails: diffEmails(a.Emails, b.Emails)}
+	if diff.HistoryA, err = uc.summarize(ctx, tenantID, idA); err != nil {
+		return nil, err
+	}
+	if diff.HistoryB, err = uc.summarize(ctx, tenantID, idB); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// summarize counts the audit entries and jobs of an employee
+func (uc *EmployeeDiffUsecase) summarize(ctx context.Context, tenantID string, id uuid.UUID) (HistorySummary, error) {
+	var summary HistorySummary
+	entries, total, err := uc.audit.List(ctx, tenantID, &AuditFilter{EmployeeID: &id, Page: 1, PageSize: 1})
+	if err != nil {
+		return summary, err
+	}
+	summary.AuditEntries = total
+	if len(entries) > 0 {
+		summary.LastAction = entries[0].Action
+		summary.LastActorID = entries[0].ActorID
+		summary.LastChangedAt = &entries[0].CreatedAt
+	}
+	if _, summary.Jobs, err = uc.history.List(ctx, tenantID, id, 1, 1); err != nil {
+		return summary, err
+	}
+	return summary, nil
+}
+
+// diffFields renders the compared fields of a and b
+func diffFields(a, b *Employee) []FieldDiff {
+	fields := []FieldDiff{
+		{Field: "first_name", A: a.FirstName, B: b.FirstName},
+		{Field: "last_name", A: a.LastName, B: b.LastName},
+		{Field: "primary_email", A: a.PrimaryEmail, B: b.PrimaryEmail},
+		{Field: "title", A: a.Title, B: b.Title},
+		{Field: "department_id", A: uuidText(a.DepartmentID), B: uuidText(b.DepartmentID)},
+		{Field: "manager_id", A: uuidText(a.ManagerID), B: uuidText(b.ManagerID)},
+		{Field: "review_status", A: a.ReviewStatus, B: b.ReviewStatus},
+		{Field: "active", A: strconv.FormatBool(a.IsActive()), B: strconv.FormatBool(b.IsActive())},
+		{Field: "tags", A: strings.Join(a.Tags, ", "), B: strings.Join(b.Tags, ", ")},
+		{Field: "phone_numbers", A: phoneNumbersText(a.PhoneNumbers), B: phoneNumbersText(b.PhoneNumbers)},
+		{Field: "address", A: addressText(a.Address), B: addressText(b.Address)},
+		{Field: "has_photo", A: strconv.FormatBool(a.PhotoKey != ""), B: strconv.FormatBool(b.PhotoKey != "")},
+	}
+
+	systems := make([]string, 0, len(a.ExternalIDs)+len(b.ExternalIDs))
+	for system := range a.ExternalIDs {
+		systems = append(systems, system)
+	}
+	for system := range b.ExternalIDs {
+		if _, ok := a.ExternalIDs[system]; !ok {
+			systems = append(systems, system)
+		}
+	}
+	sort.Strings(systems)
+	for _, system := range systems {
+		fields = append(fields, FieldDiff{Field: "external_ids." + system, A: a.ExternalIDs[system], B: b.ExternalIDs[system]})
+	}
+
+	for i := range fields {
+		fields[i].Equal = fields[i].A == fields[i].B
+	}
+	return fields
+}
+
+// diffEmails splits the emails of a and b
+func diffEmails(a, b []string) EmailDiff {
+	var diff EmailDiff
+	for _, email := range a {
+		if slices.Contains(b, email) {
+			diff.Common = append(diff.Common, email)
+		} else {
+			diff.OnlyA = append(diff.OnlyA, email)
+		}
+	}
+	for _, email := range b {
+		if !slices.Contains(a, email) {
+			diff.OnlyB = append(diff.OnlyB, email)
+		}
+	}
+	return diff
+}
+
+// uuidText renders an optional ID, empty when unset
+func uuidText(id *uuid.UUID) string {
+	if id == nil || *id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
+
+// phoneNumbersText renders phone numbers as type:number pairs
+func phoneNumbersText(phones []PhoneNumber) string {
+	parts := make([]string, len(phones))
+	for i, phone := range phones {
+		parts[i] = phone.Type + ":" + phone.Number
+	}
+	return strings.Join(parts, ", ")
+}
+
+// addressText renders the non-empty lines of an address
+func addressText(address *Address) string {
+	if address == nil {
+		return ""
+	}
+	var parts []string
+	for _, part := range []string{address.Line1, address.Line2, address.City, address.Region, address.PostalCode, address.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,80 @@
+package biz
+
+import (
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffEmployees(t *testing.T) {
+	repo := new(MockEmployeeRepo)
+	audit := new(MockAuditRepo)
+	history := &stubEmploymentHistoryRepo{entries: []*EmploymentHistoryEntry{{ID: uuid.New(), Title: "Engineer"}}}
+	uc := NewEmployeeDiffUsecase(repo, audit, history, log.NewStdLogger(io.Discard))
+
+	idA, idB, dept := uuid.New(), uuid.New(), uuid.New()
+	a := &Employee{
+		ID: idA, FirstName: "Jane", LastName: "Doe", PrimaryEmail: "jane@example.com",
+		Emails:       []string{"jane@example.com", "j.doe@example.com"},
+		DepartmentID: &dept, Tags: []string{"berlin"},
+		ExternalIDs: map[string]string{"workday": "W-1"},
+	}
+	b := &Employee{
+		ID: idB, FirstName: "Jane", LastName: "Smith", PrimaryEmail: "jane.smith@example.com",
+		Emails:        []string{"jane.smith@example.com", "j.doe@example.com"},
+		Address:       &Address{City: "Berlin", Country: "DE"},
+		DeactivatedAt: &scheduleNow,
+		ExternalIDs:   map[string]string{"workday": "W-1", "bamboo": "B-7"},
+	}
+	repo.On("GetByID", mock.Anything, "tenant-123", idA).Return(a, nil)
+	repo.On("GetByID", mock.Anything, "tenant-123", idB).Return(b, nil)
+	audit.On("List", mock.Anything, "tenant-123", &AuditFilter{EmployeeID: &idA, Page: 1, PageSize: 1}).
+		Return([]*AuditEntry{{Action: AuditActionUpdate, ActorID: "user-1", CreatedAt: scheduleNow}}, int64(4), nil)
+	audit.On("List", mock.Anything, "tenant-123", &AuditFilter{EmployeeID: &idB, Page: 1, PageSize: 1}).
+		Return([]*AuditEntry{}, int64(0), nil)
+
+	diff, err := uc.DiffEmployees(reviewContext(""), idA, idB)
+
+	require.NoError(t, err)
+	assert.Same(t, a, diff.A)
+	assert.Same(t, b, diff.B)
+
+	fields := map[string]FieldDiff{}
+	for _, f := range diff.Fields {
+		fields[f.Field] = f
+	}
+	assert.Equal(t, FieldDiff{Field: "first_name", A: "Jane", B: "Jane", Equal: true}, fields["first_name"])
+	assert.Equal(t, FieldDiff{Field: "last_name", A: "Doe", B: "Smith"}, fields["last_name"])
+	assert.Equal(t, FieldDiff{Field: "department_id", A: dept.String()}, fields["department_id"])
+	assert.Equal(t, FieldDiff{Field: "active", A: "true", B: "false"}, fields["active"])
+	assert.Equal(t, FieldDiff{Field: "address", B: "Berlin, DE"}, fields["address"])
+	assert.Equal(t, FieldDiff{Field: "external_ids.workday", A: "W-1", B: "W-1", Equal: true}, fields["external_ids.workday"])
+	assert.Equal(t, FieldDiff{Field: "external_ids.bamboo", B: "B-7"}, fields["external_ids.bamboo"])
+	assert.Equal(t, "external_ids.workday", diff.Fields[len(diff.Fields)-1].Field)
+
+	assert.Equal(t, EmailDiff{
+		Common: []string{"j.doe@example.com"},
+		OnlyA:  []string{"jane@example.com"},
+		OnlyB:  []string{"jane.smith@example.com"},
+	}, diff.Emails)
+
+	assert.Equal(t, HistorySummary{AuditEntries: 4, LastAction: AuditActionUpdate, LastActorID: "user-1", LastChangedAt: &scheduleNow, Jobs: 1}, diff.HistoryA)
+	assert.Equal(t, HistorySummary{Jobs: 1}, diff.HistoryB)
+}
+
+func TestDiffEmployees_NotFound(t *testing.T) {
+	repo := new(MockEmployeeRepo)
+	uc := NewEmployeeDiffUsecase(repo, new(MockAuditRepo), &stubEmploymentHistoryRepo{}, log.NewStdLogger(io.Discard))
+	idA, idB := uuid.New(), uuid.New()
+	repo.On("GetByID", mock.Anything, "tenant-123", idA).Return(&Employee{ID: idA}, nil)
+	repo.On("GetByID", mock.Anything, "tenant-123", idB).Return(nil, ErrEmployeeNotFound)
+
+	_, err := uc.DiffEmployees(reviewContext(""), idA, idB)
+
+	assert.Equal(t, ErrEmployeeNotFound, err)
+}
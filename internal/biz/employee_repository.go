@@ -2,6 +2,7 @@ package biz
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -9,21 +10,91 @@ import (
 // EventPublisher defines the interface for publishing events using Protocol Buffers
 type EventPublisher interface {
 	PublishEmployeeCreated(ctx context.Context, tenantID, userID string, employee *Employee) error
-	PublishEmployeeUpdated(ctx context.Context, tenantID, userID string, employee *Employee, updatedFields []string) error
+	PublishEmployeeUpdated(ctx context.Context, tenantID, userID string, employee *Employee, updatedFields []string, emails EmailChanges) error
 	PublishEmployeeDeleted(ctx context.Context, tenantID, userID string, employee *Employee) error
-	PublishEmployeeMerged(ctx context.Context, tenantID, userID string, employee *Employee, mergedFromEmail string) error
+	PublishEmployeeMerged(ctx context.Context, tenantID, userID string, merge *Merge, mergedFromEmail string) error
+	PublishEmployeeUnmerged(ctx context.Context, tenantID, userID string, merge *Merge) error
+	PublishEmployeeDeactivated(ctx context.Context, tenantID, userID string, employee *Employee) error
+	PublishEmployeeReactivated(ctx context.Context, tenantID, userID string, employee *Employee) error
+	PublishEmployeeStale(ctx context.Context, tenantID, userID string, employee *Employee, reasons []string) error
+	PublishDepartmentCreated(ctx context.Context, tenantID, userID string, department *Department) error
+	PublishDepartmentUpdated(ctx context.Context, tenantID, userID string, department *Department) error
+	PublishDepartmentDeleted(ctx context.Context, tenantID, userID string, department *Department) error
+	PublishTeamCreated(ctx context.Context, tenantID, userID string, team *Team) error
+	PublishTeamMemberAdded(ctx context.Context, tenantID, userID string, team *Team, member *Employee) error
+	PublishTeamMemberRemoved(ctx context.Context, tenantID, userID string, team *Team, member *Employee) error
+}
+
+// EmployeeIterator yields employees one row at a time so that callers can
+// process result sets of any size with flat memory. Reading only advances
+// when Next is called, so a slow consumer naturally slows down the database
+// read. Close must be called once the caller is done.
+type EmployeeIterator interface {
+	Next() bool
+	Employee() *Employee
+	Cursor() StreamCursor
+	Err() error
+	Close() error
 }
 
 // EmployeeRepo is an Employee repository interface.
 type EmployeeRepo interface {
 	Create(ctx context.Context, tenantID string, employee *Employee) (*Employee, error)
+	// CreateMany creates all employees in a single transaction
+	CreateMany(ctx context.Context, tenantID string, employees []*Employee) ([]*Employee, error)
+	// Update applies the non-empty fields of employee and increments its
+	// version. A non-zero employee.Version must still be current, otherwise
+	// ErrVersionMismatch is returned.
 	Update(ctx context.Context, tenantID string, employee *Employee) (*Employee, error)
+	// UpsertByEmail atomically creates employee with email as its only
+	// email, or applies the non-empty names of employee to the employee
+	// already owning email. It returns the employee before the change, nil
+	// when it was created. Creating requires both names, otherwise
+	// ErrEmployeeNameRequired is returned.
+	UpsertByEmail(ctx context.Context, tenantID string, email string, employee *Employee) (after *Employee, before *Employee, err error)
 	Delete(ctx context.Context, tenantID string, id uuid.UUID) error
+	// Approve marks an employee pending review as approved
+	Approve(ctx context.Context, tenantID string, id uuid.UUID) (*Employee, error)
+	// SetActive deactivates or reactivates an employee. It returns the
+	// employee and whether it changed; an employee already in that state is
+	// returned unchanged.
+	SetActive(ctx context.Context, tenantID string, id uuid.UUID, active bool) (*Employee, bool, error)
+	// FlagStale flags up to limit active, approved employees of any tenant
+	// that meet criteria and are not stale yet, as stale since now. It
+	// returns the flagged employees.
+	FlagStale(ctx context.Context, criteria *StaleCriteria, now time.Time, limit int) ([]*Employee, error)
+	// MarkEmailsUndeliverable marks the emails of the tenant's employees
+	// that bounced and are not marked yet. It returns the emails it marked
+	// and their employees.
+	MarkEmailsUndeliverable(ctx context.Context, tenantID string, bounces []EmailBounce) ([]string, []*Employee, error)
 	GetByID(ctx context.Context, tenantID string, id uuid.UUID) (*Employee, error)
 	GetByEmail(ctx context.Context, tenantID string, email string) (*Employee, error)
+	// GetByExternalID retrieves the employee with externalID in the external
+	// system
+	GetByExternalID(ctx context.Context, tenantID string, system string, externalID string) (*Employee, error)
 	List(ctx context.Context, tenantID string, filter *ListFilter) (*ListResult, error)
 	CheckEmailExists(ctx context.Context, tenantID string, email string) (bool, error)
-	MergeEmployees(ctx context.Context, tenantID string, primaryEmail string, secondaryEmail string) (*Employee, error)
-	GetEventPublisher() EventPublisher
+	// CheckEmailsExist maps each of the given emails to whether it already
+	// belongs to an employee, in a single query
+	CheckEmailsExist(ctx context.Context, tenantID string, emails []string) (map[string]bool, error)
+	// MergeEmployees merges secondary into primary and records the merge
+	MergeEmployees(ctx context.Context, tenantID string, primaryEmail string, secondaryEmail string) (*Merge, error)
+	// MergeEmployeesByID is MergeEmployees for employees identified by ID
+	MergeEmployeesByID(ctx context.Context, tenantID string, primaryID, secondaryID uuid.UUID) (*Merge, error)
+	// UnmergeEmployees undoes a merge recorded by MergeEmployees
+	UnmergeEmployees(ctx context.Context, tenantID string, mergeID uuid.UUID) (*Merge, error)
+	// GetMergeRedirect returns the merge that removed the employee with
+	// secondaryID, ignoring undone merges; nil when there is none
+	GetMergeRedirect(ctx context.Context, tenantID string, secondaryID uuid.UUID) (*MergeRedirect, error)
+	GetByIDs(ctx context.Context, tenantID string, ids []uuid.UUID) ([]*Employee, error)
+	// Count counts the employees List matches, ignoring pagination. Unlike
+	// List, an empty ReviewStatus counts employees in any review status.
+	Count(ctx context.Context, tenantID string, filter *ListFilter) (int64, error)
+	DeleteByIDs(ctx context.Context, tenantID string, ids []uuid.UUID) (int64, error)
+	DeleteAll(ctx context.Context, tenantID string) (int64, error)
+	Stream(ctx context.Context, tenantID string, filter *StreamFilter) (EmployeeIterator, error)
+	// GetManagementChain returns the managers above an employee, its direct
+	// manager first, ending at the top of the hierarchy
+	GetManagementChain(ctx context.Context, tenantID string, id uuid.UUID) ([]*Employee, error)
 }
 
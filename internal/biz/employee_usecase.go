@@ -2,6 +2,13 @@ package biz
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -10,15 +17,32 @@ import (
 
 // EmployeeUsecase is an Employee usecase.
 type EmployeeUsecase struct {
-	repo EmployeeRepo
-	log  *log.Helper
+	repo   EmployeeRepo
+	tx     Transaction
+	events *EventBus
+	review *ReviewPolicy
+	emails *EmailPolicy
+	// idempotency is nil when idempotency keys are not supported
+	idempotency *Idempotency
+	// reads is nil when reads are not audited
+	reads ReadAuditor
+	// merges is nil when merges are not limited
+	merges *MergeLimiter
+	log    *log.Helper
 }
 
 // NewEmployeeUsecase creates a new Employee usecase.
-func NewEmployeeUsecase(repo EmployeeRepo, logger log.Logger) *EmployeeUsecase {
+func NewEmployeeUsecase(repo EmployeeRepo, tx Transaction, events *EventBus, review *ReviewPolicy, emails *EmailPolicy, idempotency *Idempotency, reads ReadAuditor, merges *MergeLimiter, logger log.Logger) *EmployeeUsecase {
 	return &EmployeeUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:        repo,
+		tx:          tx,
+		events:      events,
+		review:      review,
+		emails:      emails,
+		idempotency: idempotency,
+		reads:       reads,
+		merges:      merges,
+		log:         log.NewHelper(logger),
 	}
 }
 
@@ -33,35 +57,82 @@ func (uc *EmployeeUsecase) CreateEmployee(ctx context.Context, employee *Employe
 	if len(employee.Emails) == 0 {
 		return nil, ErrInvalidEmail
 	}
+	employee.Emails = uc.emails.NormalizeAll(tenantID, employee.Emails)
+	if employee.PhoneNumbers, err = normalizePhoneNumbers(employee.PhoneNumbers); err != nil {
+		return nil, err
+	}
+	if employee.Address, err = normalizeAddress(employee.Address); err != nil {
+		return nil, err
+	}
+	if employee.ExternalIDs, err = normalizeExternalIDs(employee.ExternalIDs, false); err != nil {
+		return nil, err
+	}
+	if err := uc.emails.CheckStrict(ctx, tenantID, employee.Emails); err != nil {
+		return nil, err
+	}
+	if err := uc.emails.CheckDomains(ctx, tenantID, employee.Emails); err != nil {
+		return nil, err
+	}
 
 	uc.log.WithContext(ctx).Infof("CreateEmployee: tenant=%s, emails=%v", tenantID, employee.Emails)
 
-	// Check if any email already exists in this tenant
-	for _, email := range employee.Emails {
-		exists, err := uc.repo.CheckEmailExists(ctx, tenantID, email)
+	// A request repeating an idempotency key returns the original employee
+	key := GetIdempotencyKey(ctx)
+	if uc.idempotency == nil {
+		key = ""
+	}
+	var created *Employee
+	var replayed bool
+	err = uc.tx.Transaction(ctx, func(ctx context.Context) error {
+		if key != "" {
+			original, err := uc.idempotency.claim(ctx, tenantID, key, employee)
+			if err != nil {
+				return err
+			}
+			if original != nil {
+				created, replayed = original, true
+				return nil
+			}
+		}
+
+		// Check if any email already exists in this tenant
+		exists, err := uc.repo.CheckEmailsExist(ctx, tenantID, employee.Emails)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		if exists {
-			return nil, ErrEmployeeAlreadyExists
+		for _, email := range employee.Emails {
+			if exists[email] {
+				return ErrEmployeeAlreadyExists
+			}
 		}
-	}
 
-	// Set tenant ID
-	employee.TenantID = tenantID
+		if employee.ManagerID != nil {
+			if err := uc.validateManager(ctx, tenantID, employee.ID, *employee.ManagerID); err != nil {
+				return err
+			}
+		}
 
-	created, err := uc.repo.Create(ctx, tenantID, employee)
+		// Set tenant ID; employees from channels under review wait for approval
+		employee.TenantID = tenantID
+		employee.ReviewStatus = uc.review.InitialStatus(GetChannel(ctx))
+
+		created, err = uc.repo.Create(ctx, tenantID, employee)
+		if err != nil || key == "" {
+			return err
+		}
+		return uc.idempotency.complete(ctx, tenantID, key, created)
+	})
 	if err != nil {
 		return nil, err
 	}
+	if replayed {
+		uc.log.WithContext(ctx).Infof("CreateEmployee: replayed idempotency key for employee %s", created.ID)
+		return created, nil
+	}
 
 	// Publish event (best-effort)
 	userID, _ := GetUserID(ctx)
-	if publisher := uc.repo.GetEventPublisher(); publisher != nil {
-		if err := publisher.PublishEmployeeCreated(ctx, tenantID, userID, created); err != nil {
-			uc.log.Warnf("failed to publish employee.created event: %v", err)
-		}
-	}
+	uc.events.Publish(ctx, &DomainEvent{Type: EventEmployeeCreated, TenantID: tenantID, UserID: userID, Employee: created})
 
 	return created, nil
 }
@@ -73,72 +144,196 @@ func (uc *EmployeeUsecase) UpdateEmployee(ctx context.Context, employee *Employe
 		return nil, err
 	}
 
-	uc.log.WithContext(ctx).Infof("UpdateEmployee: tenant=%s, id=%s", tenantID, employee.ID)
+	uc.log.WithContext(ctx).Infof("UpdateEmployee: tenant=%s, id=%s, version=%d", tenantID, employee.ID, employee.Version)
 
-	// Verify employee exists in this tenant
-	existing, err := uc.repo.GetByID(ctx, tenantID, employee.ID)
-	if err != nil {
+	if employee.Version <= 0 {
+		return nil, ErrVersionRequired
+	}
+	employee.Emails = uc.emails.NormalizeAll(tenantID, employee.Emails)
+	if employee.PhoneNumbers, err = normalizePhoneNumbers(employee.PhoneNumbers); err != nil {
 		return nil, err
 	}
-	if existing == nil {
-		return nil, ErrEmployeeNotFound
+	if employee.Address, err = normalizeAddress(employee.Address); err != nil {
+		return nil, err
+	}
+	// An empty external ID removes the employee's ID in its system
+	changedExternalIDs, err := normalizeExternalIDs(employee.ExternalIDs, true)
+	if err != nil {
+		return nil, err
 	}
 
 	// Track which fields are being updated
 	updatedFields := []string{}
+	var emailChanges EmailChanges
 
-	// Check if emails are being updated
-	if len(employee.Emails) > 0 {
-		// Check uniqueness for any new emails
-		for _, email := range employee.Emails {
-			// Skip if email already belongs to this employee
-			alreadyOwned := false
-			for _, existingEmail := range existing.Emails {
-				if email == existingEmail {
-					alreadyOwned = true
-					break
+	var updated *Employee
+	err = uc.tx.Transaction(ctx, func(ctx context.Context) error {
+		// Verify employee exists in this tenant
+		existing, err := uc.repo.GetByID(ctx, tenantID, employee.ID)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return ErrEmployeeNotFound
+		}
+		if existing.Version != employee.Version {
+			return ErrVersionMismatch
+		}
+
+		// Check if emails are being updated
+		if len(employee.Emails) > 0 {
+			// Check uniqueness for the emails not already belonging to this
+			// employee, in any case
+			var added []string
+			for _, email := range employee.Emails {
+				if !slices.ContainsFunc(existing.Emails, func(e string) bool { return strings.EqualFold(e, email) }) {
+					added = append(added, email)
 				}
 			}
-			if alreadyOwned {
-				continue
+			// Emails the employee already has are kept even when their
+			// domain is no longer allowed or they fail the strict validation
+			if err := uc.emails.CheckStrict(ctx, tenantID, added); err != nil {
+				return err
+			}
+			if err := uc.emails.CheckDomains(ctx, tenantID, added); err != nil {
+				return err
 			}
+			if len(added) > 0 {
+				exists, err := uc.repo.CheckEmailsExist(ctx, tenantID, added)
+				if err != nil {
+					return err
+				}
+				for _, email := range added {
+					if exists[email] {
+						return ErrEmployeeAlreadyExists
+					}
+				}
+			}
+			emailChanges = EmailChanges{Added: added, Removed: removedEmails(existing.Emails, employee.Emails)}
+			updatedFields = append(updatedFields, "emails")
+		}
 
-			// Check if email exists for another employee
-			exists, err := uc.repo.CheckEmailExists(ctx, tenantID, email)
-			if err != nil {
-				return nil, err
+		if employee.FirstName != "" && employee.FirstName != existing.FirstName {
+			updatedFields = append(updatedFields, "first_name")
+		}
+		if employee.LastName != "" && employee.LastName != existing.LastName {
+			updatedFields = append(updatedFields, "last_name")
+		}
+		if employee.DepartmentID != nil && !sameReference(existing.DepartmentID, employee.DepartmentID) {
+			updatedFields = append(updatedFields, "department_id")
+		}
+		if employee.ManagerID != nil && !sameReference(existing.ManagerID, employee.ManagerID) {
+			if err := uc.validateManager(ctx, tenantID, employee.ID, *employee.ManagerID); err != nil {
+				return err
+			}
+			updatedFields = append(updatedFields, "manager_id")
+		}
+		if employee.Title != "" && employee.Title != existing.Title {
+			updatedFields = append(updatedFields, "title")
+		}
+		if employee.PhoneNumbers != nil && !slices.Equal(employee.PhoneNumbers, existing.PhoneNumbers) {
+			updatedFields = append(updatedFields, "phone_numbers")
+		}
+		if employee.Address != nil && !sameAddress(employee.Address, existing.Address) {
+			updatedFields = append(updatedFields, "address")
+		}
+		// The repository replaces the external IDs, so the changes are applied
+		// to the current ones
+		employee.ExternalIDs = nil
+		if changedExternalIDs != nil {
+			externalIDs := applyExternalIDs(existing.ExternalIDs, changedExternalIDs)
+			if len(externalIDs) > MaxExternalIDs {
+				return ErrInvalidExternalID
 			}
-			if exists {
-				return nil, ErrEmployeeAlreadyExists
+			if !maps.Equal(externalIDs, existing.ExternalIDs) {
+				employee.ExternalIDs = externalIDs
+				updatedFields = append(updatedFields, "external_ids")
 			}
 		}
-		updatedFields = append(updatedFields, "emails")
+
+		// Set tenant ID
+		employee.TenantID = tenantID
+
+		updated, err = uc.repo.Update(ctx, tenantID, employee)
+		if err != nil {
+			return err
+		}
+		// Replacing the emails moves the primary email when it was removed
+		if updated.PrimaryEmail != existing.PrimaryEmail {
+			updatedFields = append(updatedFields, "primary_email")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if employee.FirstName != "" && employee.FirstName != existing.FirstName {
-		updatedFields = append(updatedFields, "first_name")
+	// Publish event (best-effort)
+	userID, _ := GetUserID(ctx)
+	uc.events.Publish(ctx, &DomainEvent{
+		Type:          EventEmployeeUpdated,
+		TenantID:      tenantID,
+		UserID:        userID,
+		Employee:      updated,
+		UpdatedFields: updatedFields,
+		Emails:        emailChanges,
+	})
+
+	return updated, nil
+}
+
+// CreateOrUpdateEmployeeByEmail creates an employee with email, or updates
+// the names of the employee owning it. It reports whether the employee was
+// created. An update that changes nothing publishes no event, so repeated
+// syncs of the same record are idempotent.
+func (uc *EmployeeUsecase) CreateOrUpdateEmployeeByEmail(ctx context.Context, email string, employee *Employee) (*Employee, bool, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, false, err
 	}
-	if employee.LastName != "" && employee.LastName != existing.LastName {
-		updatedFields = append(updatedFields, "last_name")
+
+	email = uc.emails.Normalize(tenantID, email)
+	if err := uc.emails.CheckStrict(ctx, tenantID, []string{email}); err != nil {
+		return nil, false, err
+	}
+	if err := uc.emails.CheckDomains(ctx, tenantID, []string{email}); err != nil {
+		return nil, false, err
 	}
+	uc.log.WithContext(ctx).Infof("CreateOrUpdateEmployeeByEmail: tenant=%s, email=%s", tenantID, email)
 
-	// Set tenant ID
+	// Employees from channels under review wait for approval when created
 	employee.TenantID = tenantID
+	employee.ReviewStatus = uc.review.InitialStatus(GetChannel(ctx))
 
-	updated, err := uc.repo.Update(ctx, tenantID, employee)
+	after, before, err := uc.repo.UpsertByEmail(ctx, tenantID, email, employee)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	// Publish event (best-effort)
 	userID, _ := GetUserID(ctx)
-	if publisher := uc.repo.GetEventPublisher(); publisher != nil {
-		if err := publisher.PublishEmployeeUpdated(ctx, tenantID, userID, updated, updatedFields); err != nil {
-			uc.log.Warnf("failed to publish employee.updated event: %v", err)
-		}
+	if before == nil {
+		uc.events.Publish(ctx, &DomainEvent{Type: EventEmployeeCreated, TenantID: tenantID, UserID: userID, Employee: after})
+		return after, true, nil
 	}
 
-	return updated, nil
+	var updatedFields []string
+	if after.FirstName != before.FirstName {
+		updatedFields = append(updatedFields, "first_name")
+	}
+	if after.LastName != before.LastName {
+		updatedFields = append(updatedFields, "last_name")
+	}
+	if len(updatedFields) > 0 {
+		uc.events.Publish(ctx, &DomainEvent{
+			Type:          EventEmployeeUpdated,
+			TenantID:      tenantID,
+			UserID:        userID,
+			Employee:      after,
+			UpdatedFields: updatedFields,
+		})
+	}
+	return after, false, nil
 }
 
 // DeleteEmployee deletes an employee within tenant.
@@ -150,27 +345,26 @@ func (uc *EmployeeUsecase) DeleteEmployee(ctx context.Context, id uuid.UUID) err
 
 	uc.log.WithContext(ctx).Infof("DeleteEmployee: tenant=%s, id=%s", tenantID, id)
 
-	// Verify employee exists in this tenant
-	existing, err := uc.repo.GetByID(ctx, tenantID, id)
-	if err != nil {
-		return err
-	}
-	if existing == nil {
-		return ErrEmployeeNotFound
-	}
+	var existing *Employee
+	err = uc.tx.Transaction(ctx, func(ctx context.Context) error {
+		// Verify employee exists in this tenant
+		existing, err = uc.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return ErrEmployeeNotFound
+		}
 
-	err = uc.repo.Delete(ctx, tenantID, id)
+		return uc.repo.Delete(ctx, tenantID, id)
+	})
 	if err != nil {
 		return err
 	}
 
 	// Publish event with deleted employee info (best-effort)
 	userID, _ := GetUserID(ctx)
-	if publisher := uc.repo.GetEventPublisher(); publisher != nil {
-		if err := publisher.PublishEmployeeDeleted(ctx, tenantID, userID, existing); err != nil {
-			uc.log.Warnf("failed to publish employee.deleted event: %v", err)
-		}
-	}
+	uc.events.Publish(ctx, &DomainEvent{Type: EventEmployeeDeleted, TenantID: tenantID, UserID: userID, Employee: existing})
 
 	return nil
 }
@@ -191,6 +385,7 @@ func (uc *EmployeeUsecase) GetEmployee(ctx context.Context, id uuid.UUID) (*Empl
 	if employee == nil {
 		return nil, ErrEmployeeNotFound
 	}
+	uc.recordRead(ctx, ReadOperationGet, tenantID, employee)
 
 	return employee, nil
 }
@@ -202,19 +397,62 @@ func (uc *EmployeeUsecase) GetEmployeeByEmail(ctx context.Context, email string)
 		return nil, err
 	}
 
+	email = uc.emails.Normalize(tenantID, email)
 	uc.log.WithContext(ctx).Infof("GetEmployeeByEmail: tenant=%s, email=%s", tenantID, email)
 
 	employee, err := uc.repo.GetByEmail(ctx, tenantID, email)
 	if err != nil {
 		return nil, err
 	}
-	if employee == nil {
+	// Employees pending review cannot be looked up by email
+	if employee == nil || employee.IsPending() {
+		return nil, ErrEmployeeNotFound
+	}
+	uc.recordRead(ctx, ReadOperationGetByEmail, tenantID, employee)
+
+	return employee, nil
+}
+
+// GetEmployeeByExternalID gets the employee with an ID in an external system
+// within tenant.
+func (uc *EmployeeUsecase) GetEmployeeByExternalID(ctx context.Context, system string, externalID string) (*Employee, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if system, externalID, err = NormalizeExternalID(system, externalID); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("GetEmployeeByExternalID: tenant=%s, system=%s, id=%s", tenantID, system, externalID)
+
+	employee, err := uc.repo.GetByExternalID(ctx, tenantID, system, externalID)
+	if err != nil {
+		return nil, err
+	}
+	// Employees pending review cannot be looked up, as with emails
+	if employee == nil || employee.IsPending() {
 		return nil, ErrEmployeeNotFound
 	}
+	uc.recordRead(ctx, ReadOperationGetByExternalID, tenantID, employee)
 
 	return employee, nil
 }
 
+// EmployeeExists reports whether email belongs to an employee of the tenant.
+// Employees pending review are included, since their emails are taken.
+func (uc *EmployeeUsecase) EmployeeExists(ctx context.Context, email string) (bool, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	email = uc.emails.Normalize(tenantID, email)
+	uc.log.WithContext(ctx).Infof("EmployeeExists: tenant=%s, email=%s", tenantID, email)
+
+	return uc.repo.CheckEmailExists(ctx, tenantID, email)
+}
+
 // ListEmployees lists employees with pagination and filtering within tenant.
 func (uc *EmployeeUsecase) ListEmployees(ctx context.Context, filter *ListFilter) (*ListResult, error) {
 	tenantID, err := GetTenantID(ctx)
@@ -224,7 +462,35 @@ func (uc *EmployeeUsecase) ListEmployees(ctx context.Context, filter *ListFilter
 
 	uc.log.WithContext(ctx).Infof("ListEmployees: tenant=%s, page=%d, size=%d", tenantID, filter.Page, filter.PageSize)
 
-	// Set default pagination values
+	applyPagination(filter)
+
+	if err := normalizeListFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.List(ctx, tenantID, filter)
+}
+
+// CountEmployees counts the employees ListEmployees would list with filter,
+// without loading them. Pagination fields are ignored.
+func (uc *EmployeeUsecase) CountEmployees(ctx context.Context, filter *ListFilter) (int64, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	uc.log.WithContext(ctx).Infof("CountEmployees: tenant=%s", tenantID)
+
+	if err := normalizeListFilter(filter); err != nil {
+		return 0, err
+	}
+	filter.ReviewStatus = ReviewStatusApproved
+
+	return uc.repo.Count(ctx, tenantID, filter)
+}
+
+// applyPagination sets the default page and bounds the page size
+func applyPagination(filter *ListFilter) {
 	if filter.Page <= 0 {
 		filter.Page = 1
 	}
@@ -234,67 +500,346 @@ func (uc *EmployeeUsecase) ListEmployees(ctx context.Context, filter *ListFilter
 	if filter.PageSize > 100 {
 		filter.PageSize = 100
 	}
+}
 
+// minEmailContainsLen is the shortest email_contains filter; shorter strings
+// have no trigrams, so the trigram index cannot serve them
+const minEmailContainsLen = 3
+
+// normalizeListFilter trims and lowercases the name and email filters so the
+// repository can match them against lowercased columns, and rejects filters
+// no index can serve and inverted date ranges
+func normalizeListFilter(filter *ListFilter) error {
 	// Business validation: date range check
 	if filter.CreatedAfter != nil && filter.CreatedBefore != nil {
 		if filter.CreatedAfter.After(*filter.CreatedBefore) {
-			return nil, ErrInvalidDateRange
+			return ErrInvalidDateRange
 		}
 	}
 
-	return uc.repo.List(ctx, tenantID, filter)
+	filter.NamePrefix = strings.ToLower(strings.Join(strings.Fields(filter.NamePrefix), " "))
+
+	filter.EmailDomain = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(filter.EmailDomain), "@"))
+	if filter.EmailDomain != "" && (strings.ContainsAny(filter.EmailDomain, "@ ") || !strings.Contains(filter.EmailDomain, ".")) {
+		return errors.BadRequest(v1.ErrorReason_INVALID_LIST_FILTER.String(), "email_domain must be a domain such as example.com")
+	}
+
+	filter.EmailContains = strings.ToLower(strings.TrimSpace(filter.EmailContains))
+	if filter.EmailContains != "" && utf8.RuneCountInString(filter.EmailContains) < minEmailContainsLen {
+		return errors.BadRequest(v1.ErrorReason_INVALID_LIST_FILTER.String(), fmt.Sprintf("email_contains must have at least %d characters", minEmailContainsLen))
+	}
+
+	filter.Tags = normalizeTags(filter.Tags)
+	return nil
+}
+
+// normalizeStreamFilter returns a copy of filter with the filters it shares
+// with listings normalized and checked like those of a listing
+func normalizeStreamFilter(filter *StreamFilter) (*StreamFilter, error) {
+	list := &ListFilter{
+		CreatedAfter:  filter.CreatedAfter,
+		CreatedBefore: filter.CreatedBefore,
+		NamePrefix:    filter.NamePrefix,
+		EmailDomain:   filter.EmailDomain,
+		EmailContains: filter.EmailContains,
+		Tags:          filter.Tags,
+	}
+	if err := normalizeListFilter(list); err != nil {
+		return nil, err
+	}
+
+	normalized := *filter
+	normalized.NamePrefix = list.NamePrefix
+	normalized.EmailDomain = list.EmailDomain
+	normalized.EmailContains = list.EmailContains
+	normalized.Tags = list.Tags
+	return &normalized, nil
+}
+
+// StreamEmployees calls fn for every employee of the tenant matching the filter,
+// oldest first, reading one row at a time. fn is expected to block while the
+// transport is not ready for more data; returning an error stops the stream.
+func (uc *EmployeeUsecase) StreamEmployees(ctx context.Context, filter *StreamFilter, fn func(*Employee) error) error {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	filter, err = normalizeStreamFilter(filter)
+	if err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("StreamEmployees: tenant=%s", tenantID)
+
+	it, err := uc.repo.Stream(ctx, tenantID, filter)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(it.Employee()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
 }
 
 // MergeEmployees merges two employees by email within tenant.
 // All emails from the secondary employee are transferred to the primary employee.
-func (uc *EmployeeUsecase) MergeEmployees(ctx context.Context, primaryEmail string, secondaryEmail string) (*Employee, error) {
+// The returned merge can be undone with UnmergeEmployees.
+func (uc *EmployeeUsecase) MergeEmployees(ctx context.Context, primaryEmail string, secondaryEmail string) (*Merge, error) {
 	tenantID, err := GetTenantID(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Business validation: emails must be different
+	primaryEmail, secondaryEmail = uc.emails.Normalize(tenantID, primaryEmail), uc.emails.Normalize(tenantID, secondaryEmail)
 	if primaryEmail == secondaryEmail {
 		return nil, ErrInvalidMerge
 	}
 
 	uc.log.WithContext(ctx).Infof("MergeEmployees: tenant=%s, primary=%s, secondary=%s", tenantID, primaryEmail, secondaryEmail)
 
+	release, err := uc.merges.Acquire(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var merge *Merge
+	err = uc.tx.Transaction(ctx, func(ctx context.Context) error {
+		if _, _, err := uc.mergeCandidates(ctx, tenantID, primaryEmail, secondaryEmail); err != nil {
+			return err
+		}
+
+		merge, err = uc.repo.MergeEmployees(ctx, tenantID, primaryEmail, secondaryEmail)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publishMerged(ctx, tenantID, merge, secondaryEmail)
+	return merge, nil
+}
+
+// PreviewMerge validates a merge by email like MergeEmployees and returns
+// its outcome without writing anything: Primary is the primary employee
+// with the secondary's emails added and Secondary the employee that would
+// be deleted. The returned merge has no ID.
+func (uc *EmployeeUsecase) PreviewMerge(ctx context.Context, primaryEmail string, secondaryEmail string) (*Merge, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryEmail, secondaryEmail = uc.emails.Normalize(tenantID, primaryEmail), uc.emails.Normalize(tenantID, secondaryEmail)
+	if primaryEmail == secondaryEmail {
+		return nil, ErrInvalidMerge
+	}
+
+	primary, secondary, err := uc.mergeCandidates(ctx, tenantID, primaryEmail, secondaryEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	return previewMerge(tenantID, primary, secondary), nil
+}
+
+// mergeCandidates looks up the employees of a merge by email, checking that
+// both exist in tenant and are different employees
+func (uc *EmployeeUsecase) mergeCandidates(ctx context.Context, tenantID, primaryEmail, secondaryEmail string) (*Employee, *Employee, error) {
 	// Validate both emails exist in this tenant
 	primary, err := uc.repo.GetByEmail(ctx, tenantID, primaryEmail)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if primary == nil {
-		return nil, errors.BadRequest("PRIMARY_NOT_FOUND", "primary employee not found")
+		return nil, nil, errors.BadRequest("PRIMARY_NOT_FOUND", "primary employee not found")
 	}
 
 	secondary, err := uc.repo.GetByEmail(ctx, tenantID, secondaryEmail)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if secondary == nil {
-		return nil, errors.BadRequest("SECONDARY_NOT_FOUND", "secondary employee not found")
+		return nil, nil, errors.BadRequest("SECONDARY_NOT_FOUND", "secondary employee not found")
 	}
 
 	// Cannot merge the same employee
 	if primary.ID == secondary.ID {
+		return nil, nil, errors.BadRequest("CANNOT_MERGE_SAME", "cannot merge employee with itself")
+	}
+	if err := checkMergeable(primary, secondary); err != nil {
+		return nil, nil, err
+	}
+
+	return primary, secondary, nil
+}
+
+// previewMerge returns the merge of secondary into primary as it would be
+// recorded, without an ID
+func previewMerge(tenantID string, primary, secondary *Employee) *Merge {
+	merged := *primary
+	merged.Emails = append(slices.Clone(primary.Emails), secondary.Emails...)
+
+	return &Merge{
+		TenantID:  tenantID,
+		Primary:   &merged,
+		Secondary: secondary,
+	}
+}
+
+// MergeEmployeesByID merges two employees by ID within tenant, unambiguous
+// for employees with several emails. It shares the merge path of
+// MergeEmployees.
+func (uc *EmployeeUsecase) MergeEmployeesByID(ctx context.Context, primaryID, secondaryID uuid.UUID) (*Merge, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cannot merge the same employee
+	if primaryID == secondaryID {
+		return nil, errors.BadRequest("CANNOT_MERGE_SAME", "cannot merge employee with itself")
+	}
+
+	uc.log.WithContext(ctx).Infof("MergeEmployeesByID: tenant=%s, primary=%s, secondary=%s", tenantID, primaryID, secondaryID)
+
+	release, err := uc.merges.Acquire(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	merge, err := uc.repo.MergeEmployeesByID(ctx, tenantID, primaryID, secondaryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// merged_from_email names the secondary employee by its first email
+	var mergedFromEmail string
+	if len(merge.Secondary.Emails) > 0 {
+		mergedFromEmail = merge.Secondary.Emails[0]
+	}
+	uc.publishMerged(ctx, tenantID, merge, mergedFromEmail)
+	return merge, nil
+}
+
+// PreviewMergeByID validates a merge by ID like MergeEmployeesByID and
+// returns its outcome without writing anything, see PreviewMerge.
+func (uc *EmployeeUsecase) PreviewMergeByID(ctx context.Context, primaryID, secondaryID uuid.UUID) (*Merge, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cannot merge the same employee
+	if primaryID == secondaryID {
 		return nil, errors.BadRequest("CANNOT_MERGE_SAME", "cannot merge employee with itself")
 	}
 
-	merged, err := uc.repo.MergeEmployees(ctx, tenantID, primaryEmail, secondaryEmail)
+	primary, err := uc.repo.GetByID(ctx, tenantID, primaryID)
+	if err != nil {
+		return nil, err
+	}
+	secondary, err := uc.repo.GetByID(ctx, tenantID, secondaryID)
+	if err != nil {
+		return nil, err
+	}
+	if primary == nil || secondary == nil {
+		return nil, ErrEmployeeNotFound
+	}
+	if err := checkMergeable(primary, secondary); err != nil {
+		return nil, err
+	}
+
+	return previewMerge(tenantID, primary, secondary), nil
+}
+
+// publishMerged publishes the merged event of a merge (best-effort)
+func (uc *EmployeeUsecase) publishMerged(ctx context.Context, tenantID string, merge *Merge, mergedFromEmail string) {
+	userID, _ := GetUserID(ctx)
+	uc.events.Publish(ctx, &DomainEvent{
+		Type:            EventEmployeeMerged,
+		TenantID:        tenantID,
+		UserID:          userID,
+		Employee:        merge.Primary,
+		Merge:           merge,
+		MergedFromEmail: mergedFromEmail,
+	})
+}
+
+// UnmergeEmployees undoes a merge within tenant, recreating the secondary
+// employee and moving its emails back from the primary employee.
+func (uc *EmployeeUsecase) UnmergeEmployees(ctx context.Context, mergeID uuid.UUID) (*Merge, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("UnmergeEmployees: tenant=%s, merge=%s", tenantID, mergeID)
+
+	release, err := uc.merges.Acquire(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	merge, err := uc.repo.UnmergeEmployees(ctx, tenantID, mergeID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Publish event with merge information (best-effort)
+	// Publish event (best-effort)
 	userID, _ := GetUserID(ctx)
-	if publisher := uc.repo.GetEventPublisher(); publisher != nil {
-		if err := publisher.PublishEmployeeMerged(ctx, tenantID, userID, merged, secondaryEmail); err != nil {
-			uc.log.Warnf("failed to publish employee.merged event: %v", err)
-		}
+	uc.events.Publish(ctx, &DomainEvent{Type: EventEmployeeUnmerged, TenantID: tenantID, UserID: userID, Merge: merge})
+
+	return merge, nil
+}
+
+// ResolveMergedEmployee returns the employee with id, following the merges
+// that removed it to the employee it survives as. The redirects are the
+// merges followed, in order, and empty when the employee still exists.
+func (uc *EmployeeUsecase) ResolveMergedEmployee(ctx context.Context, id uuid.UUID) (*Employee, []*MergeRedirect, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return merged, nil
+	uc.log.WithContext(ctx).Infof("ResolveMergedEmployee: tenant=%s, id=%s", tenantID, id)
+
+	redirects := []*MergeRedirect{}
+	seen := map[uuid.UUID]bool{}
+	for !seen[id] {
+		seen[id] = true
+		employee, err := uc.repo.GetByID(ctx, tenantID, id)
+		if err != nil && err != ErrEmployeeNotFound {
+			return nil, nil, err
+		}
+		if employee != nil {
+			return employee, redirects, nil
+		}
+
+		redirect, err := uc.repo.GetMergeRedirect(ctx, tenantID, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		// Deleted rather than merged away
+		if redirect == nil {
+			return nil, nil, ErrEmployeeNotFound
+		}
+		redirects = append(redirects, redirect)
+		id = redirect.ToID
+	}
+	// Unreachable unless the merge history is corrupt
+	return nil, nil, ErrEmployeeNotFound
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockEmployeeRepo is a mock implementation of EmployeeRepo
@@ -26,6 +27,14 @@ func (m *MockEmployeeRepo) Create(ctx context.Context, tenantID string, employee
 	return args.Get(0).(*Employee), args.Error(1)
 }
 
+func (m *MockEmployeeRepo) CreateMany(ctx context.Context, tenantID string, employees []*Employee) ([]*Employee, error) {
+	args := m.Called(ctx, tenantID, employees)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Employee), args.Error(1)
+}
+
 func (m *MockEmployeeRepo) Update(ctx context.Context, tenantID string, employee *Employee) (*Employee, error) {
 	args := m.Called(ctx, tenantID, employee)
 	if args.Get(0) == nil {
@@ -34,11 +43,28 @@ func (m *MockEmployeeRepo) Update(ctx context.Context, tenantID string, employee
 	return args.Get(0).(*Employee), args.Error(1)
 }
 
+func (m *MockEmployeeRepo) UpsertByEmail(ctx context.Context, tenantID string, email string, employee *Employee) (*Employee, *Employee, error) {
+	args := m.Called(ctx, tenantID, email, employee)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	before, _ := args.Get(1).(*Employee)
+	return args.Get(0).(*Employee), before, args.Error(2)
+}
+
 func (m *MockEmployeeRepo) Delete(ctx context.Context, tenantID string, id uuid.UUID) error {
 	args := m.Called(ctx, tenantID, id)
 	return args.Error(0)
 }
 
+func (m *MockEmployeeRepo) Approve(ctx context.Context, tenantID string, id uuid.UUID) (*Employee, error) {
+	args := m.Called(ctx, tenantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Employee), args.Error(1)
+}
+
 func (m *MockEmployeeRepo) GetByID(ctx context.Context, tenantID string, id uuid.UUID) (*Employee, error) {
 	args := m.Called(ctx, tenantID, id)
 	if args.Get(0) == nil {
@@ -55,6 +81,22 @@ func (m *MockEmployeeRepo) GetByEmail(ctx context.Context, tenantID string, emai
 	return args.Get(0).(*Employee), args.Error(1)
 }
 
+func (m *MockEmployeeRepo) GetByExternalID(ctx context.Context, tenantID string, system string, externalID string) (*Employee, error) {
+	args := m.Called(ctx, tenantID, system, externalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) CheckEmailsExist(ctx context.Context, tenantID string, emails []string) (map[string]bool, error) {
+	args := m.Called(ctx, tenantID, emails)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
 func (m *MockEmployeeRepo) CheckEmailExists(ctx context.Context, tenantID string, email string) (bool, error) {
 	args := m.Called(ctx, tenantID, email)
 	return args.Bool(0), args.Error(1)
@@ -68,20 +110,133 @@ func (m *MockEmployeeRepo) List(ctx context.Context, tenantID string, filter *Li
 	return args.Get(0).(*ListResult), args.Error(1)
 }
 
-func (m *MockEmployeeRepo) MergeEmployees(ctx context.Context, tenantID string, primaryEmail string, secondaryEmail string) (*Employee, error) {
+func (m *MockEmployeeRepo) MergeEmployees(ctx context.Context, tenantID string, primaryEmail string, secondaryEmail string) (*Merge, error) {
 	args := m.Called(ctx, tenantID, primaryEmail, secondaryEmail)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(*Employee), args.Error(1)
+	return args.Get(0).(*Merge), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) MergeEmployeesByID(ctx context.Context, tenantID string, primaryID, secondaryID uuid.UUID) (*Merge, error) {
+	args := m.Called(ctx, tenantID, primaryID, secondaryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Merge), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) UnmergeEmployees(ctx context.Context, tenantID string, mergeID uuid.UUID) (*Merge, error) {
+	args := m.Called(ctx, tenantID, mergeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Merge), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) GetMergeRedirect(ctx context.Context, tenantID string, secondaryID uuid.UUID) (*MergeRedirect, error) {
+	args := m.Called(ctx, tenantID, secondaryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*MergeRedirect), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) GetByIDs(ctx context.Context, tenantID string, ids []uuid.UUID) ([]*Employee, error) {
+	args := m.Called(ctx, tenantID, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) Count(ctx context.Context, tenantID string, filter *ListFilter) (int64, error) {
+	args := m.Called(ctx, tenantID, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) DeleteByIDs(ctx context.Context, tenantID string, ids []uuid.UUID) (int64, error) {
+	args := m.Called(ctx, tenantID, ids)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) DeleteAll(ctx context.Context, tenantID string) (int64, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) Stream(ctx context.Context, tenantID string, filter *StreamFilter) (EmployeeIterator, error) {
+	args := m.Called(ctx, tenantID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(EmployeeIterator), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) GetManagementChain(ctx context.Context, tenantID string, id uuid.UUID) ([]*Employee, error) {
+	args := m.Called(ctx, tenantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) SetActive(ctx context.Context, tenantID string, id uuid.UUID, active bool) (*Employee, bool, error) {
+	args := m.Called(ctx, tenantID, id, active)
+	if args.Get(0) == nil {
+		return nil, false, args.Error(2)
+	}
+	return args.Get(0).(*Employee), args.Bool(1), args.Error(2)
 }
 
-func (m *MockEmployeeRepo) GetEventPublisher() EventPublisher {
-	args := m.Called()
+func (m *MockEmployeeRepo) FlagStale(ctx context.Context, criteria *StaleCriteria, now time.Time, limit int) ([]*Employee, error) {
+	args := m.Called(ctx, criteria, now, limit)
 	if args.Get(0) == nil {
-		return nil
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) MarkEmailsUndeliverable(ctx context.Context, tenantID string, bounces []EmailBounce) ([]string, []*Employee, error) {
+	args := m.Called(ctx, tenantID, bounces)
+	if args.Get(1) == nil {
+		return nil, nil, args.Error(2)
 	}
-	return args.Get(0).(EventPublisher)
+	return args.Get(0).([]string), args.Get(1).([]*Employee), args.Error(2)
+}
+
+// sliceIterator is an in-memory EmployeeIterator for tests
+type sliceIterator struct {
+	employees []*Employee
+	pos       int
+	err       error
+	closed    bool
+}
+
+func (it *sliceIterator) Next() bool {
+	if it.pos >= len(it.employees) {
+		return false
+	}
+	it.pos++
+	return true
+}
+
+func (it *sliceIterator) Employee() *Employee {
+	return it.employees[it.pos-1]
+}
+
+func (it *sliceIterator) Cursor() StreamCursor {
+	e := it.Employee()
+	return StreamCursor{CreatedAt: e.CreatedAt, ID: e.ID}
+}
+
+func (it *sliceIterator) Err() error {
+	return it.err
+}
+
+func (it *sliceIterator) Close() error {
+	it.closed = true
+	return nil
 }
 
 // MockEventPublisher is a mock implementation of EventPublisher
@@ -94,8 +249,8 @@ func (m *MockEventPublisher) PublishEmployeeCreated(ctx context.Context, tenantI
 	return args.Error(0)
 }
 
-func (m *MockEventPublisher) PublishEmployeeUpdated(ctx context.Context, tenantID, userID string, employee *Employee, updatedFields []string) error {
-	args := m.Called(ctx, tenantID, userID, employee, updatedFields)
+func (m *MockEventPublisher) PublishEmployeeUpdated(ctx context.Context, tenantID, userID string, employee *Employee, updatedFields []string, emails EmailChanges) error {
+	args := m.Called(ctx, tenantID, userID, employee, updatedFields, emails)
 	return args.Error(0)
 }
 
@@ -104,17 +259,93 @@ func (m *MockEventPublisher) PublishEmployeeDeleted(ctx context.Context, tenantI
 	return args.Error(0)
 }
 
-func (m *MockEventPublisher) PublishEmployeeMerged(ctx context.Context, tenantID, userID string, employee *Employee, mergedFromEmail string) error {
-	args := m.Called(ctx, tenantID, userID, employee, mergedFromEmail)
+func (m *MockEventPublisher) PublishEmployeeMerged(ctx context.Context, tenantID, userID string, merge *Merge, mergedFromEmail string) error {
+	args := m.Called(ctx, tenantID, userID, merge, mergedFromEmail)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishEmployeeUnmerged(ctx context.Context, tenantID, userID string, merge *Merge) error {
+	args := m.Called(ctx, tenantID, userID, merge)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishEmployeeDeactivated(ctx context.Context, tenantID, userID string, employee *Employee) error {
+	args := m.Called(ctx, tenantID, userID, employee)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishEmployeeReactivated(ctx context.Context, tenantID, userID string, employee *Employee) error {
+	args := m.Called(ctx, tenantID, userID, employee)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishEmployeeStale(ctx context.Context, tenantID, userID string, employee *Employee, reasons []string) error {
+	args := m.Called(ctx, tenantID, userID, employee, reasons)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishDepartmentCreated(ctx context.Context, tenantID, userID string, department *Department) error {
+	args := m.Called(ctx, tenantID, userID, department)
 	return args.Error(0)
 }
 
+func (m *MockEventPublisher) PublishDepartmentUpdated(ctx context.Context, tenantID, userID string, department *Department) error {
+	args := m.Called(ctx, tenantID, userID, department)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishDepartmentDeleted(ctx context.Context, tenantID, userID string, department *Department) error {
+	args := m.Called(ctx, tenantID, userID, department)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishTeamCreated(ctx context.Context, tenantID, userID string, team *Team) error {
+	args := m.Called(ctx, tenantID, userID, team)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishTeamMemberAdded(ctx context.Context, tenantID, userID string, team *Team, member *Employee) error {
+	args := m.Called(ctx, tenantID, userID, team, member)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishTeamMemberRemoved(ctx context.Context, tenantID, userID string, team *Team, member *Employee) error {
+	args := m.Called(ctx, tenantID, userID, team, member)
+	return args.Error(0)
+}
+
+// newTestEventBus returns an event bus forwarding to pub
+func newTestEventBus(pub EventPublisher) *EventBus {
+	bus := NewEventBus(log.NewStdLogger(io.Discard))
+	bus.Subscribe("publisher", NewPublisherSubscriber(pub))
+	return bus
+}
+
+// fakeTransaction runs fn directly, counting transactions, and fails the
+// commit with commitErr
+type fakeTransaction struct {
+	calls     int
+	active    bool
+	commitErr error
+}
+
+func (t *fakeTransaction) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	t.calls++
+	t.active = true
+	defer func() { t.active = false }()
+	if err := fn(ctx); err != nil {
+		return err
+	}
+	return t.commitErr
+}
+
 func setupUsecase() (*EmployeeUsecase, *MockEmployeeRepo) {
 	repo := new(MockEmployeeRepo)
 	// Create a simple no-op logger with io.Discard
 	logger := log.NewHelper(log.NewStdLogger(io.Discard))
 	uc := &EmployeeUsecase{
 		repo: repo,
+		tx:   &fakeTransaction{},
 		log:  logger,
 	}
 	return uc, repo
@@ -123,10 +354,14 @@ func setupUsecase() (*EmployeeUsecase, *MockEmployeeRepo) {
 func TestNewEmployeeUsecase(t *testing.T) {
 	repo := new(MockEmployeeRepo)
 	logger := log.NewStdLogger(io.Discard)
-	uc := NewEmployeeUsecase(repo, logger)
+	events := NewEventBus(logger)
+	tx := &fakeTransaction{}
+	uc := NewEmployeeUsecase(repo, tx, events, nil, nil, nil, nil, nil, logger)
 	
 	assert.NotNil(t, uc)
 	assert.NotNil(t, uc.repo)
+	assert.Equal(t, tx, uc.tx)
+	assert.Equal(t, events, uc.events)
 	assert.NotNil(t, uc.log)
 }
 
@@ -146,7 +381,7 @@ func TestCreateEmployee(t *testing.T) {
 				LastName:  "Doe",
 			},
 			setupMock: func(repo *MockEmployeeRepo, pub *MockEventPublisher) {
-				repo.On("CheckEmailExists", mock.Anything, "tenant-123", "test@example.com").Return(false, nil)
+				repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"test@example.com"}).Return(map[string]bool{"test@example.com": false}, nil)
 				created := &Employee{
 					ID:        uuid.New(),
 					Emails:    []string{"test@example.com"},
@@ -157,7 +392,6 @@ func TestCreateEmployee(t *testing.T) {
 					UpdatedAt: time.Now(),
 				}
 				repo.On("Create", mock.Anything, "tenant-123", mock.Anything).Return(created, nil)
-				repo.On("GetEventPublisher").Return(EventPublisher(pub))
 				pub.On("PublishEmployeeCreated", mock.Anything, "tenant-123", "user-456", mock.Anything).Return(nil)
 			},
 			wantErr: false,
@@ -183,7 +417,21 @@ func TestCreateEmployee(t *testing.T) {
 				LastName:  "Doe",
 			},
 			setupMock: func(repo *MockEmployeeRepo, pub *MockEventPublisher) {
-				repo.On("CheckEmailExists", mock.Anything, "tenant-123", "existing@example.com").Return(true, nil)
+				repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"existing@example.com"}).Return(map[string]bool{"existing@example.com": true}, nil)
+			},
+			wantErr:     true,
+			errExpected: ErrEmployeeAlreadyExists,
+		},
+		{
+			name: "one of several emails already exists",
+			employee: &Employee{
+				Emails:    []string{"new@example.com", "existing@example.com"},
+				FirstName: "John",
+				LastName:  "Doe",
+			},
+			setupMock: func(repo *MockEmployeeRepo, pub *MockEventPublisher) {
+				repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"new@example.com", "existing@example.com"}).
+					Return(map[string]bool{"new@example.com": false, "existing@example.com": true}, nil).Once()
 			},
 			wantErr:     true,
 			errExpected: ErrEmployeeAlreadyExists,
@@ -196,7 +444,7 @@ func TestCreateEmployee(t *testing.T) {
 				LastName:  "Doe",
 			},
 			setupMock: func(repo *MockEmployeeRepo, pub *MockEventPublisher) {
-				repo.On("CheckEmailExists", mock.Anything, "tenant-123", "test@example.com").Return(false, nil)
+				repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"test@example.com"}).Return(map[string]bool{"test@example.com": false}, nil)
 				repo.On("Create", mock.Anything, "tenant-123", mock.Anything).Return(nil, errors.New("db error"))
 			},
 			wantErr: true,
@@ -209,7 +457,7 @@ func TestCreateEmployee(t *testing.T) {
 				LastName:  "Doe",
 			},
 			setupMock: func(repo *MockEmployeeRepo, pub *MockEventPublisher) {
-				repo.On("CheckEmailExists", mock.Anything, "tenant-123", "test@example.com").Return(false, nil)
+				repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"test@example.com"}).Return(map[string]bool{"test@example.com": false}, nil)
 				created := &Employee{
 					ID:        uuid.New(),
 					Emails:    []string{"test@example.com"},
@@ -220,7 +468,6 @@ func TestCreateEmployee(t *testing.T) {
 					UpdatedAt: time.Now(),
 				}
 				repo.On("Create", mock.Anything, "tenant-123", mock.Anything).Return(created, nil)
-				repo.On("GetEventPublisher").Return(EventPublisher(pub))
 				pub.On("PublishEmployeeCreated", mock.Anything, "tenant-123", "user-456", mock.Anything).Return(errors.New("event error"))
 			},
 			wantErr: false, // Event publish errors are non-fatal
@@ -231,6 +478,7 @@ func TestCreateEmployee(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			uc, repo := setupUsecase()
 			pub := new(MockEventPublisher)
+			uc.events = newTestEventBus(pub)
 			
 			if tt.setupMock != nil {
 				tt.setupMock(repo, pub)
@@ -275,6 +523,7 @@ func TestUpdateEmployee(t *testing.T) {
 				ID:        existingID,
 				FirstName: "Jane",
 				LastName:  "Smith",
+				Version:   1,
 			},
 			setupMock: func(repo *MockEmployeeRepo, pub *MockEventPublisher) {
 				existing := &Employee{
@@ -283,6 +532,7 @@ func TestUpdateEmployee(t *testing.T) {
 					FirstName: "John",
 					LastName:  "Doe",
 					TenantID:  "tenant-123",
+					Version:   1,
 				}
 				repo.On("GetByID", mock.Anything, "tenant-123", existingID).Return(existing, nil)
 				
@@ -295,15 +545,15 @@ func TestUpdateEmployee(t *testing.T) {
 					UpdatedAt: time.Now(),
 				}
 				repo.On("Update", mock.Anything, "tenant-123", mock.Anything).Return(updated, nil)
-				repo.On("GetEventPublisher").Return(EventPublisher(pub))
-				pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "user-456", mock.Anything, mock.Anything).Return(nil)
+				pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "user-456", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
 			name: "employee not found",
 			employee: &Employee{
-				ID: existingID,
+				ID:      existingID,
+				Version: 1,
 			},
 			setupMock: func(repo *MockEmployeeRepo, pub *MockEventPublisher) {
 				repo.On("GetByID", mock.Anything, "tenant-123", existingID).Return(nil, nil)
@@ -314,8 +564,9 @@ func TestUpdateEmployee(t *testing.T) {
 		{
 			name: "update with new email",
 			employee: &Employee{
-				ID:     existingID,
-				Emails: []string{"new@example.com"},
+				ID:      existingID,
+				Emails:  []string{"new@example.com"},
+				Version: 1,
 			},
 			setupMock: func(repo *MockEmployeeRepo, pub *MockEventPublisher) {
 				existing := &Employee{
@@ -324,9 +575,10 @@ func TestUpdateEmployee(t *testing.T) {
 					FirstName: "John",
 					LastName:  "Doe",
 					TenantID:  "tenant-123",
+					Version:   1,
 				}
 				repo.On("GetByID", mock.Anything, "tenant-123", existingID).Return(existing, nil)
-				repo.On("CheckEmailExists", mock.Anything, "tenant-123", "new@example.com").Return(false, nil)
+				repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"new@example.com"}).Return(map[string]bool{"new@example.com": false}, nil)
 				
 				updated := &Employee{
 					ID:        existingID,
@@ -337,16 +589,17 @@ func TestUpdateEmployee(t *testing.T) {
 					UpdatedAt: time.Now(),
 				}
 				repo.On("Update", mock.Anything, "tenant-123", mock.Anything).Return(updated, nil)
-				repo.On("GetEventPublisher").Return(EventPublisher(pub))
-				pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "user-456", mock.Anything, []string{"emails"}).Return(nil)
+				pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "user-456", mock.Anything, []string{"emails"},
+					EmailChanges{Added: []string{"new@example.com"}, Removed: []string{"old@example.com"}}).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
 			name: "email already exists for another employee",
 			employee: &Employee{
-				ID:     existingID,
-				Emails: []string{"taken@example.com"},
+				ID:      existingID,
+				Emails:  []string{"taken@example.com"},
+				Version: 1,
 			},
 			setupMock: func(repo *MockEmployeeRepo, pub *MockEventPublisher) {
 				existing := &Employee{
@@ -355,19 +608,51 @@ func TestUpdateEmployee(t *testing.T) {
 					FirstName: "John",
 					LastName:  "Doe",
 					TenantID:  "tenant-123",
+					Version:   1,
 				}
 				repo.On("GetByID", mock.Anything, "tenant-123", existingID).Return(existing, nil)
-				repo.On("CheckEmailExists", mock.Anything, "tenant-123", "taken@example.com").Return(true, nil)
+				repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"taken@example.com"}).Return(map[string]bool{"taken@example.com": true}, nil)
 			},
 			wantErr:     true,
 			errExpected: ErrEmployeeAlreadyExists,
 		},
+		{
+			name: "version required",
+			employee: &Employee{
+				ID:        existingID,
+				FirstName: "Jane",
+			},
+			wantErr:     true,
+			errExpected: ErrVersionRequired,
+		},
+		{
+			name: "outdated version",
+			employee: &Employee{
+				ID:        existingID,
+				FirstName: "Jane",
+				Version:   1,
+			},
+			setupMock: func(repo *MockEmployeeRepo, pub *MockEventPublisher) {
+				existing := &Employee{
+					ID:        existingID,
+					Emails:    []string{"old@example.com"},
+					FirstName: "John",
+					LastName:  "Doe",
+					TenantID:  "tenant-123",
+					Version:   2,
+				}
+				repo.On("GetByID", mock.Anything, "tenant-123", existingID).Return(existing, nil)
+			},
+			wantErr:     true,
+			errExpected: ErrVersionMismatch,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			uc, repo := setupUsecase()
 			pub := new(MockEventPublisher)
+			uc.events = newTestEventBus(pub)
 			
 			if tt.setupMock != nil {
 				tt.setupMock(repo, pub)
@@ -418,7 +703,6 @@ func TestDeleteEmployee(t *testing.T) {
 				}
 				repo.On("GetByID", mock.Anything, "tenant-123", employeeID).Return(existing, nil)
 				repo.On("Delete", mock.Anything, "tenant-123", employeeID).Return(nil)
-				repo.On("GetEventPublisher").Return(EventPublisher(pub))
 				pub.On("PublishEmployeeDeleted", mock.Anything, "tenant-123", "user-456", existing).Return(nil)
 			},
 			wantErr: false,
@@ -454,6 +738,7 @@ func TestDeleteEmployee(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			uc, repo := setupUsecase()
 			pub := new(MockEventPublisher)
+			uc.events = newTestEventBus(pub)
 			
 			if tt.setupMock != nil {
 				tt.setupMock(repo, pub)
@@ -712,6 +997,211 @@ func TestListEmployees(t *testing.T) {
 	}
 }
 
+func TestCreateEmployee_Transaction(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+	employee := &Employee{Emails: []string{"jane@example.com"}}
+
+	t.Run("publishes after commit", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		tx := uc.tx.(*fakeTransaction)
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+		repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"jane@example.com"}).Return(map[string]bool{"jane@example.com": false}, nil)
+		repo.On("Create", mock.Anything, "tenant-123", mock.Anything).Return(employee, nil)
+		pub.On("PublishEmployeeCreated", mock.Anything, "tenant-123", "", employee).Run(func(mock.Arguments) {
+			assert.False(t, tx.active, "events are published after the commit")
+		}).Return(nil)
+
+		_, err := uc.CreateEmployee(ctx, employee)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, tx.calls, "the check and the create share one transaction")
+		pub.AssertExpectations(t)
+	})
+
+	t.Run("failed commit publishes nothing", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		uc.tx = &fakeTransaction{commitErr: assert.AnError}
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+		repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"jane@example.com"}).Return(map[string]bool{"jane@example.com": false}, nil)
+		repo.On("Create", mock.Anything, "tenant-123", mock.Anything).Return(employee, nil)
+
+		_, err := uc.CreateEmployee(ctx, employee)
+
+		assert.Equal(t, assert.AnError, err)
+		pub.AssertNotCalled(t, "PublishEmployeeCreated", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestListEmployees_NameAndEmailFilters(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+
+	t.Run("normalizes filters", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		repo.On("List", mock.Anything, "tenant-123", mock.MatchedBy(func(f *ListFilter) bool {
+			return f.NamePrefix == "john sm" && f.EmailDomain == "example.com" && f.EmailContains == "doe"
+		})).Return(&ListResult{}, nil)
+
+		_, err := uc.ListEmployees(ctx, &ListFilter{NamePrefix: "  John   Sm", EmailDomain: "@Example.COM", EmailContains: " DOE "})
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	for name, filter := range map[string]*ListFilter{
+		"domain with local part": {EmailDomain: "jane@example.com"},
+		"domain without dot":     {EmailDomain: "localhost"},
+		"contains too short":     {EmailContains: "ab"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			uc, repo := setupUsecase()
+
+			_, err := uc.ListEmployees(ctx, filter)
+
+			assert.True(t, errors.Is(err, ErrInvalidListFilter), "got %v", err)
+			repo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestEmployeeExists(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+
+	for _, exists := range []bool{true, false} {
+		uc, repo := setupUsecase()
+		repo.On("CheckEmailExists", mock.Anything, "tenant-123", "jane@example.com").Return(exists, nil)
+
+		got, err := uc.EmployeeExists(ctx, "jane@example.com")
+
+		assert.NoError(t, err)
+		assert.Equal(t, exists, got)
+		repo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything, mock.Anything)
+	}
+}
+
+func TestCountEmployees(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+
+	t.Run("counts approved employees with normalized filters", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		repo.On("Count", mock.Anything, "tenant-123", mock.MatchedBy(func(f *ListFilter) bool {
+			return f.EmailDomain == "example.com" && f.ReviewStatus == ReviewStatusApproved
+		})).Return(int64(12), nil)
+
+		total, err := uc.CountEmployees(ctx, &ListFilter{EmailDomain: "@Example.COM"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), total)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects inverted date range", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		after, before := time.Now(), time.Now().Add(-time.Hour)
+
+		_, err := uc.CountEmployees(ctx, &ListFilter{CreatedAfter: &after, CreatedBefore: &before})
+
+		assert.Equal(t, ErrInvalidDateRange, err)
+		repo.AssertNotCalled(t, "Count", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestStreamEmployees(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+	employees := []*Employee{
+		{ID: uuid.New(), Emails: []string{"test1@example.com"}},
+		{ID: uuid.New(), Emails: []string{"test2@example.com"}},
+		{ID: uuid.New(), Emails: []string{"test3@example.com"}},
+	}
+
+	t.Run("yields every employee and closes the iterator", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		it := &sliceIterator{employees: employees}
+		repo.On("Stream", mock.Anything, "tenant-123", mock.Anything).Return(it, nil)
+
+		var got []*Employee
+		err := uc.StreamEmployees(ctx, &StreamFilter{}, func(e *Employee) error {
+			got = append(got, e)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, employees, got)
+		assert.True(t, it.closed)
+	})
+
+	t.Run("callback error stops the stream", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		it := &sliceIterator{employees: employees}
+		repo.On("Stream", mock.Anything, "tenant-123", mock.Anything).Return(it, nil)
+
+		sendErr := errors.New("client gone")
+		calls := 0
+		err := uc.StreamEmployees(ctx, &StreamFilter{}, func(e *Employee) error {
+			calls++
+			return sendErr
+		})
+
+		assert.Equal(t, sendErr, err)
+		assert.Equal(t, 1, calls)
+		assert.True(t, it.closed)
+	})
+
+	t.Run("iterator error is returned", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		iterErr := errors.New("connection reset")
+		it := &sliceIterator{employees: employees[:1], err: iterErr}
+		repo.On("Stream", mock.Anything, "tenant-123", mock.Anything).Return(it, nil)
+
+		err := uc.StreamEmployees(ctx, &StreamFilter{}, func(e *Employee) error { return nil })
+
+		assert.Equal(t, iterErr, err)
+	})
+
+	t.Run("invalid date range", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		now := time.Now()
+		later := now.Add(time.Hour)
+
+		err := uc.StreamEmployees(ctx, &StreamFilter{CreatedAfter: &later, CreatedBefore: &now}, func(e *Employee) error { return nil })
+
+		assert.Equal(t, ErrInvalidDateRange, err)
+		repo.AssertNotCalled(t, "Stream", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("filters are normalized into a copy", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		stale := true
+		repo.On("Stream", mock.Anything, "tenant-123", &StreamFilter{
+			NamePrefix:    "ada lovelace",
+			EmailDomain:   "example.com",
+			EmailContains: "lovelace",
+			Tags:          []string{"remote", "on-call"},
+			ActiveOnly:    true,
+			Stale:         &stale,
+		}).Return(&sliceIterator{}, nil)
+
+		tags := []string{" Remote", "ON-CALL "}
+		filter := &StreamFilter{NamePrefix: " Ada  Lovelace", EmailDomain: "@Example.com", EmailContains: " LoveLace", Tags: tags, ActiveOnly: true, Stale: &stale}
+		err := uc.StreamEmployees(ctx, filter, func(e *Employee) error { return nil })
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		assert.Equal(t, []string{" Remote", "ON-CALL "}, tags, "the caller's tags are left unchanged")
+		assert.Equal(t, " Ada  Lovelace", filter.NamePrefix)
+	})
+
+	t.Run("invalid email filter", func(t *testing.T) {
+		uc, repo := setupUsecase()
+
+		err := uc.StreamEmployees(ctx, &StreamFilter{EmailContains: "ab"}, func(e *Employee) error { return nil })
+
+		assert.True(t, errors.Is(err, ErrInvalidListFilter), "got %v", err)
+		repo.AssertNotCalled(t, "Stream", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func TestMergeEmployees(t *testing.T) {
 	primaryID := uuid.New()
 	secondaryID := uuid.New()
@@ -747,9 +1237,11 @@ func TestMergeEmployees(t *testing.T) {
 				
 				repo.On("GetByEmail", mock.Anything, "tenant-123", "primary@example.com").Return(primary, nil)
 				repo.On("GetByEmail", mock.Anything, "tenant-123", "secondary@example.com").Return(secondary, nil)
-				repo.On("MergeEmployees", mock.Anything, "tenant-123", "primary@example.com", "secondary@example.com").Return(merged, nil)
-				repo.On("GetEventPublisher").Return(EventPublisher(pub))
-				pub.On("PublishEmployeeMerged", mock.Anything, "tenant-123", "user-456", merged, "secondary@example.com").Return(nil)
+				repo.On("MergeEmployees", mock.Anything, "tenant-123", "primary@example.com", "secondary@example.com").
+					Return(&Merge{ID: uuid.New(), Primary: merged, Secondary: secondary}, nil)
+				pub.On("PublishEmployeeMerged", mock.Anything, "tenant-123", "user-456", mock.MatchedBy(func(m *Merge) bool {
+					return m.Primary == merged
+				}), "secondary@example.com").Return(nil)
 			},
 			wantErr: false,
 		},
@@ -811,6 +1303,7 @@ func TestMergeEmployees(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			uc, repo := setupUsecase()
 			pub := new(MockEventPublisher)
+			uc.events = newTestEventBus(pub)
 			
 			if tt.setupMock != nil {
 				tt.setupMock(repo, pub)
@@ -838,6 +1331,92 @@ func TestMergeEmployees(t *testing.T) {
 	}
 }
 
+func TestMergeEmployeesByID(t *testing.T) {
+	primaryID := uuid.New()
+	secondaryID := uuid.New()
+
+	t.Run("successful merge", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+		merged := &Employee{ID: primaryID, Emails: []string{"primary@example.com", "secondary@example.com", "other@example.com"}}
+		merge := &Merge{
+			ID:        uuid.New(),
+			Primary:   merged,
+			Secondary: &Employee{ID: secondaryID, Emails: []string{"secondary@example.com", "other@example.com"}},
+		}
+
+		repo.On("MergeEmployeesByID", mock.Anything, "tenant-123", primaryID, secondaryID).Return(merge, nil)
+		pub.On("PublishEmployeeMerged", mock.Anything, "tenant-123", "user-456", merge, "secondary@example.com").Return(nil)
+
+		ctx := WithUserID(WithTenantID(context.Background(), "tenant-123"), "user-456")
+		result, err := uc.MergeEmployeesByID(ctx, primaryID, secondaryID)
+
+		require.NoError(t, err)
+		assert.Equal(t, merge, result)
+		repo.AssertExpectations(t)
+		pub.AssertExpectations(t)
+	})
+
+	t.Run("same employee ID", func(t *testing.T) {
+		uc, repo := setupUsecase()
+
+		_, err := uc.MergeEmployeesByID(WithTenantID(context.Background(), "tenant-123"), primaryID, primaryID)
+
+		assert.ErrorContains(t, err, "CANNOT_MERGE_SAME")
+		repo.AssertNotCalled(t, "MergeEmployeesByID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("employee not found", func(t *testing.T) {
+		uc, repo := setupUsecase()
+
+		repo.On("MergeEmployeesByID", mock.Anything, "tenant-123", primaryID, secondaryID).Return(nil, ErrEmployeeNotFound)
+
+		_, err := uc.MergeEmployeesByID(WithTenantID(context.Background(), "tenant-123"), primaryID, secondaryID)
+
+		assert.Equal(t, ErrEmployeeNotFound, err)
+	})
+}
+
+func TestUnmergeEmployees(t *testing.T) {
+	mergeID := uuid.New()
+
+	t.Run("publishes unmerged event", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+		merge := &Merge{
+			ID:        mergeID,
+			Primary:   &Employee{ID: uuid.New(), Emails: []string{"primary@example.com"}},
+			Secondary: &Employee{ID: uuid.New(), Emails: []string{"secondary@example.com"}},
+		}
+
+		repo.On("UnmergeEmployees", mock.Anything, "tenant-123", mergeID).Return(merge, nil)
+		pub.On("PublishEmployeeUnmerged", mock.Anything, "tenant-123", "user-456", merge).Return(nil)
+
+		ctx := WithUserID(WithTenantID(context.Background(), "tenant-123"), "user-456")
+		result, err := uc.UnmergeEmployees(ctx, mergeID)
+
+		require.NoError(t, err)
+		assert.Equal(t, merge, result)
+		repo.AssertExpectations(t)
+		pub.AssertExpectations(t)
+	})
+
+	t.Run("conflict is not published", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+
+		repo.On("UnmergeEmployees", mock.Anything, "tenant-123", mergeID).Return(nil, ErrUnmergeConflict)
+
+		_, err := uc.UnmergeEmployees(WithTenantID(context.Background(), "tenant-123"), mergeID)
+
+		assert.Equal(t, ErrUnmergeConflict, err)
+		pub.AssertNotCalled(t, "PublishEmployeeUnmerged", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func TestMissingTenantID(t *testing.T) {
 	uc, _ := setupUsecase()
 	ctx := context.Background() // No tenant ID
@@ -863,5 +1442,180 @@ func TestMissingTenantID(t *testing.T) {
 
 	_, err = uc.MergeEmployees(ctx, "primary@example.com", "secondary@example.com")
 	assert.Error(t, err)
+
+	_, err = uc.MergeEmployeesByID(ctx, uuid.New(), uuid.New())
+	assert.Error(t, err)
+
+	_, err = uc.UnmergeEmployees(ctx, uuid.New())
+	assert.Error(t, err)
+}
+
+func TestResolveMergedEmployee(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+	oldID, midID, survivorID := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("existing employee", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		employee := &Employee{ID: oldID}
+		repo.On("GetByID", mock.Anything, "tenant-123", oldID).Return(employee, nil)
+
+		got, redirects, err := uc.ResolveMergedEmployee(ctx, oldID)
+
+		require.NoError(t, err)
+		assert.Same(t, employee, got)
+		assert.Empty(t, redirects)
+		repo.AssertNotCalled(t, "GetMergeRedirect", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("merged twice", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		survivor := &Employee{ID: survivorID}
+		first := &MergeRedirect{MergeID: uuid.New(), FromID: oldID, ToID: midID}
+		second := &MergeRedirect{MergeID: uuid.New(), FromID: midID, ToID: survivorID}
+		repo.On("GetByID", mock.Anything, "tenant-123", oldID).Return(nil, ErrEmployeeNotFound)
+		repo.On("GetMergeRedirect", mock.Anything, "tenant-123", oldID).Return(first, nil)
+		repo.On("GetByID", mock.Anything, "tenant-123", midID).Return(nil, ErrEmployeeNotFound)
+		repo.On("GetMergeRedirect", mock.Anything, "tenant-123", midID).Return(second, nil)
+		repo.On("GetByID", mock.Anything, "tenant-123", survivorID).Return(survivor, nil)
+
+		got, redirects, err := uc.ResolveMergedEmployee(ctx, oldID)
+
+		require.NoError(t, err)
+		assert.Same(t, survivor, got)
+		assert.Equal(t, []*MergeRedirect{first, second}, redirects)
+	})
+
+	t.Run("deleted", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		repo.On("GetByID", mock.Anything, "tenant-123", oldID).Return(nil, ErrEmployeeNotFound)
+		repo.On("GetMergeRedirect", mock.Anything, "tenant-123", oldID).Return(nil, nil)
+
+		_, _, err := uc.ResolveMergedEmployee(ctx, oldID)
+
+		assert.Equal(t, ErrEmployeeNotFound, err)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		repo.On("GetByID", mock.Anything, "tenant-123", mock.Anything).Return(nil, ErrEmployeeNotFound)
+		repo.On("GetMergeRedirect", mock.Anything, "tenant-123", oldID).Return(&MergeRedirect{FromID: oldID, ToID: midID}, nil)
+		repo.On("GetMergeRedirect", mock.Anything, "tenant-123", midID).Return(&MergeRedirect{FromID: midID, ToID: oldID}, nil)
+
+		_, _, err := uc.ResolveMergedEmployee(ctx, oldID)
+
+		assert.Equal(t, ErrEmployeeNotFound, err)
+	})
+}
+
+
+func TestPreviewMerge(t *testing.T) {
+	primary := &Employee{ID: uuid.New(), Emails: []string{"primary@example.com"}, FirstName: "Jane", LastName: "Doe"}
+	secondary := &Employee{ID: uuid.New(), Emails: []string{"secondary@example.com", "other@example.com"}, FirstName: "Janet", LastName: "Doe"}
+
+	t.Run("previews without merging", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+
+		repo.On("GetByEmail", mock.Anything, "tenant-123", "primary@example.com").Return(primary, nil)
+		repo.On("GetByEmail", mock.Anything, "tenant-123", "secondary@example.com").Return(secondary, nil)
+
+		result, err := uc.PreviewMerge(WithTenantID(context.Background(), "tenant-123"), "primary@example.com", "secondary@example.com")
+
+		require.NoError(t, err)
+		assert.Equal(t, uuid.Nil, result.ID)
+		assert.Equal(t, primary.ID, result.Primary.ID)
+		assert.Equal(t, []string{"primary@example.com", "secondary@example.com", "other@example.com"}, result.Primary.Emails)
+		assert.Equal(t, []string{"primary@example.com"}, primary.Emails, "the primary employee is not modified")
+		assert.Equal(t, secondary, result.Secondary)
+		assert.Equal(t, []string{"first_name"}, result.NameConflicts())
+		repo.AssertNotCalled(t, "MergeEmployees", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		pub.AssertNotCalled(t, "PublishEmployeeMerged", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("secondary not found", func(t *testing.T) {
+		uc, repo := setupUsecase()
+
+		repo.On("GetByEmail", mock.Anything, "tenant-123", "primary@example.com").Return(primary, nil)
+		repo.On("GetByEmail", mock.Anything, "tenant-123", "missing@example.com").Return(nil, nil)
+
+		_, err := uc.PreviewMerge(WithTenantID(context.Background(), "tenant-123"), "primary@example.com", "missing@example.com")
+
+		assert.ErrorContains(t, err, "SECONDARY_NOT_FOUND")
+	})
+
+	t.Run("by ID", func(t *testing.T) {
+		uc, repo := setupUsecase()
+
+		repo.On("GetByID", mock.Anything, "tenant-123", primary.ID).Return(primary, nil)
+		repo.On("GetByID", mock.Anything, "tenant-123", secondary.ID).Return(secondary, nil)
+
+		result, err := uc.PreviewMergeByID(WithTenantID(context.Background(), "tenant-123"), primary.ID, secondary.ID)
+
+		require.NoError(t, err)
+		assert.Len(t, result.Primary.Emails, 3)
+		repo.AssertNotCalled(t, "MergeEmployeesByID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("by ID, same employee", func(t *testing.T) {
+		uc, _ := setupUsecase()
+
+		_, err := uc.PreviewMergeByID(WithTenantID(context.Background(), "tenant-123"), primary.ID, primary.ID)
+
+		assert.ErrorContains(t, err, "CANNOT_MERGE_SAME")
+	})
 }
 
+func TestCreateOrUpdateEmployeeByEmail(t *testing.T) {
+	ctx := WithUserID(WithTenantID(context.Background(), "tenant-123"), "user-456")
+	existing := &Employee{ID: uuid.New(), Emails: []string{"jane@example.com"}, FirstName: "Jane", LastName: "Doe", Version: 3}
+
+	t.Run("creates", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+		created := &Employee{ID: uuid.New(), Emails: []string{"jane@example.com"}, FirstName: "Jane", LastName: "Doe", Version: 1}
+		repo.On("UpsertByEmail", mock.Anything, "tenant-123", "jane@example.com", mock.MatchedBy(func(e *Employee) bool {
+			return e.ReviewStatus == ReviewStatusApproved
+		})).Return(created, nil, nil)
+		pub.On("PublishEmployeeCreated", mock.Anything, "tenant-123", "user-456", created).Return(nil)
+
+		got, isNew, err := uc.CreateOrUpdateEmployeeByEmail(ctx, "jane@example.com", &Employee{FirstName: "Jane", LastName: "Doe"})
+
+		assert.NoError(t, err)
+		assert.True(t, isNew)
+		assert.Equal(t, created, got)
+		pub.AssertExpectations(t)
+	})
+
+	t.Run("updates the owner", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+		updated := *existing
+		updated.LastName, updated.Version = "Smith", 4
+		repo.On("UpsertByEmail", mock.Anything, "tenant-123", "jane@example.com", mock.Anything).Return(&updated, existing, nil)
+		pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "user-456", &updated, []string{"last_name"}, EmailChanges{}).Return(nil)
+
+		got, isNew, err := uc.CreateOrUpdateEmployeeByEmail(ctx, "jane@example.com", &Employee{LastName: "Smith"})
+
+		assert.NoError(t, err)
+		assert.False(t, isNew)
+		assert.Equal(t, "Smith", got.LastName)
+		pub.AssertExpectations(t)
+	})
+
+	t.Run("unchanged owner publishes nothing", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+		repo.On("UpsertByEmail", mock.Anything, "tenant-123", "jane@example.com", mock.Anything).Return(existing, existing, nil)
+
+		got, isNew, err := uc.CreateOrUpdateEmployeeByEmail(ctx, "jane@example.com", &Employee{FirstName: "Jane", LastName: "Doe"})
+
+		assert.NoError(t, err)
+		assert.False(t, isNew)
+		assert.Equal(t, existing, got)
+		pub.AssertNotCalled(t, "PublishEmployeeUpdated", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
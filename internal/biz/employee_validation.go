@@ -0,0 +1,253 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// Codes of validation warnings, which do not fail a write
+const (
+	// WarningEmailNormalized is an email that is stored differently than
+	// given, e.g. a Gmail address folded by the tenant's email policy
+	WarningEmailNormalized = "EMAIL_NORMALIZED"
+	// WarningReviewRequired is an employee that would be held for review
+	WarningReviewRequired = "REVIEW_REQUIRED"
+	// WarningQuotaExceeded is an employee that would exceed the soft employee
+	// quota of the tenant
+	WarningQuotaExceeded = "QUOTA_EXCEEDED"
+	// WarningEmailNotStrict is an email violating the strict email
+	// validation of a tenant that only logs violations
+	WarningEmailNotStrict = "EMAIL_NOT_STRICT"
+)
+
+// ValidationIssue is a problem found validating a field of an employee
+type ValidationIssue struct {
+	// Field is the path of the field, e.g. emails[1] or
+	// external_ids["workday"], empty for the employee as a whole
+	Field string
+	// Code is the error reason a write would fail with or, for warnings, one
+	// of the Warning constants
+	Code    string
+	Message string
+}
+
+// EmployeeValidation is the outcome of validating an employee
+type EmployeeValidation struct {
+	// Violations would fail the write
+	Violations []ValidationIssue
+	// Warnings would not fail the write
+	Warnings []ValidationIssue
+}
+
+// Valid reports whether the employee has no violations
+func (v *EmployeeValidation) Valid() bool {
+	return len(v.Violations) == 0
+}
+
+// violate records err, a validation error, as a violation of field
+func (v *EmployeeValidation) violate(field string, err error) {
+	e := errors.FromError(err)
+	v.Violations = append(v.Violations, ValidationIssue{Field: field, Code: e.Reason, Message: e.Message})
+}
+
+// warn records a warning about field
+func (v *EmployeeValidation) warn(field, code, message string) {
+	v.Warnings = append(v.Warnings, ValidationIssue{Field: field, Code: code, Message: message})
+}
+
+// EmployeeValidationUsecase validates employees before they are submitted,
+// so that forms can report every problem at once
+type EmployeeValidationUsecase struct {
+	employees   *EmployeeUsecase
+	departments DepartmentRepo
+	quota       *QuotaUsecase
+	log         *log.Helper
+}
+
+// NewEmployeeValidationUsecase creates a new EmployeeValidation usecase.
+func NewEmployeeValidationUsecase(employees *EmployeeUsecase, departments DepartmentRepo, quota *QuotaUsecase, logger log.Logger) *EmployeeValidationUsecase {
+	return &EmployeeValidationUsecase{
+		employees:   employees,
+		departments: departments,
+		quota:       quota,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// ValidateEmployee runs the checks of CreateEmployee on an employee of the
+// caller's tenant without storing it, or those of UpdateEmployee when id is
+// set, collecting every violation instead of failing on the first:
+// normalization of emails, phone numbers, the address and external IDs, the
+// tenant's email domains, emails and external IDs taken by other employees,
+// the department and the manager. The employee is normalized in place. It
+// returns ErrEmployeeNotFound when id does not exist; errors other than
+// violations, e.g. of the database, fail the validation.
+func (uc *EmployeeValidationUsecase) ValidateEmployee(ctx context.Context, employee *Employee, id *uuid.UUID) (*EmployeeValidation, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if id != nil {
+		if _, err := uc.employees.repo.GetByID(ctx, tenantID, *id); err != nil {
+			return nil, err
+		}
+		employee.ID = *id
+	}
+
+	uc.log.WithContext(ctx).Infof("ValidateEmployee: tenant=%s, emails=%v", tenantID, employee.Emails)
+
+	v := &EmployeeValidation{}
+	if err := uc.validateEmails(ctx, v, tenantID, employee); err != nil {
+		return nil, err
+	}
+	if employee.PhoneNumbers, err = normalizePhoneNumbers(employee.PhoneNumbers); err != nil {
+		v.violate("phone_numbers", err)
+	}
+	if employee.Address, err = normalizeAddress(employee.Address); err != nil {
+		v.violate("address", err)
+	}
+	if err := uc.validateExternalIDs(ctx, v, tenantID, employee); err != nil {
+		return nil, err
+	}
+
+	if employee.DepartmentID != nil && *employee.DepartmentID != uuid.Nil {
+		_, err := uc.departments.Get(ctx, tenantID, *employee.DepartmentID)
+		if errors.Is(err, ErrDepartmentNotFound) {
+			v.violate("department_id", err)
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	if employee.ManagerID != nil {
+		err := uc.employees.validateManager(ctx, tenantID, employee.ID, *employee.ManagerID)
+		if errors.Is(err, ErrManagerNotFound) || errors.Is(err, ErrInvalidManager) {
+			v.violate("manager_id", err)
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	// Only new employees are held for review and count against the quota
+	if id == nil {
+		if channel := GetChannel(ctx); uc.employees.review.InitialStatus(channel) == ReviewStatusPending {
+			v.warn("", WarningReviewRequired, fmt.Sprintf("employees created through %s are held for review", channel))
+		}
+		if uc.quota != nil {
+			usage, err := uc.quota.employeeUsage(ctx, tenantID)
+			if err != nil {
+				return nil, err
+			}
+			if usage != nil && usage.Remaining() == 0 {
+				v.warn("", WarningQuotaExceeded, fmt.Sprintf("the tenant uses all of its quota of %d employees", usage.Limit))
+			}
+		}
+	}
+	return v, nil
+}
+
+// validateEmails normalizes the emails of employee and records those outside
+// the tenant's domains or belonging to another employee. Emails normalizing
+// to an earlier one are dropped, as by CreateEmployee.
+func (uc *EmployeeValidationUsecase) validateEmails(ctx context.Context, v *EmployeeValidation, tenantID string, employee *Employee) error {
+	if len(employee.Emails) == 0 {
+		v.violate("emails", ErrInvalidEmail)
+		return nil
+	}
+	settings, err := uc.employees.emails.TenantSettings(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+
+	// Fields of the normalized emails, by email
+	fields := make(map[string]string, len(employee.Emails))
+	normalized := make([]string, 0, len(employee.Emails))
+	for i, given := range employee.Emails {
+		field := "emails[" + strconv.Itoa(i) + "]"
+		email := uc.employees.emails.Normalize(tenantID, given)
+		if _, ok := fields[email]; ok {
+			continue
+		}
+		if email != strings.ToLower(strings.TrimSpace(given)) {
+			v.warn(field, WarningEmailNormalized, fmt.Sprintf("%s is stored as %s", given, email))
+		}
+		if !settings.AllowsEmail(email) {
+			v.violate(field, emailDomainNotAllowed(email))
+		}
+		fields[email] = field
+		normalized = append(normalized, email)
+	}
+	employee.Emails = normalized
+
+	exists, err := uc.employees.repo.CheckEmailsExist(ctx, tenantID, normalized)
+	if err != nil {
+		return err
+	}
+	own := make(map[string]bool)
+	for _, email := range normalized {
+		if !exists[email] {
+			continue
+		}
+		// The employee's own emails are not taken
+		if employee.ID != uuid.Nil {
+			owner, err := uc.employees.repo.GetByEmail(ctx, tenantID, email)
+			if err != nil && !errors.Is(err, ErrEmployeeNotFound) {
+				return err
+			}
+			if owner != nil && owner.ID == employee.ID {
+				own[email] = true
+				continue
+			}
+		}
+		v.violate(fields[email], ErrEmployeeAlreadyExists)
+	}
+
+	// The strict validation applies to new emails, without counting them as
+	// violations since nothing is written
+	strictness := uc.employees.emails.Strictness(tenantID)
+	for _, email := range normalized {
+		rule := strictEmailRule(email)
+		if rule == "" || own[email] {
+			continue
+		}
+		switch strictness {
+		case EmailStrictnessEnforce:
+			v.violate(fields[email], strictEmailError(email, rule))
+		case EmailStrictnessLog:
+			v.warn(fields[email], WarningEmailNotStrict, fmt.Sprintf("%s will be rejected once strict email validation is enforced: %s", email, strictEmailMessages[rule]))
+		}
+	}
+	return nil
+}
+
+// validateExternalIDs normalizes the external IDs of employee and records
+// those belonging to another employee
+func (uc *EmployeeValidationUsecase) validateExternalIDs(ctx context.Context, v *EmployeeValidation, tenantID string, employee *Employee) error {
+	ids, err := normalizeExternalIDs(employee.ExternalIDs, false)
+	if err != nil {
+		v.violate("external_ids", err)
+		return nil
+	}
+	employee.ExternalIDs = ids
+
+	for _, system := range slices.Sorted(maps.Keys(ids)) {
+		owner, err := uc.employees.repo.GetByExternalID(ctx, tenantID, system, ids[system])
+		if errors.Is(err, ErrEmployeeNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if owner.ID != employee.ID {
+			v.violate("external_ids["+strconv.Quote(system)+"]", ErrExternalIDAlreadyExists)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"io"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupValidationUsecase() (*EmployeeValidationUsecase, *EmployeeUsecase, *MockEmployeeRepo, *MockDepartmentRepo) {
+	employees, repo := setupUsecase()
+	departments := new(MockDepartmentRepo)
+	return NewEmployeeValidationUsecase(employees, departments, nil, log.NewStdLogger(io.Discard)), employees, repo, departments
+}
+
+func TestValidateEmployee_Valid(t *testing.T) {
+	uc, _, repo, departments := setupValidationUsecase()
+	departmentID := uuid.New()
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"jane@example.com"}).Return(map[string]bool{}, nil)
+	repo.On("GetByExternalID", mock.Anything, "tenant-123", "workday", "W-1").Return(nil, ErrEmployeeNotFound)
+	departments.On("Get", mock.Anything, "tenant-123", departmentID).Return(&Department{ID: departmentID}, nil)
+
+	employee := &Employee{Emails: []string{" Jane@Example.com"}, ExternalIDs: map[string]string{"workday": "W-1"}, DepartmentID: &departmentID}
+	validation, err := uc.ValidateEmployee(reviewContext(""), employee, nil)
+
+	require.NoError(t, err)
+	assert.True(t, validation.Valid())
+	assert.Empty(t, validation.Warnings)
+	assert.Equal(t, []string{"jane@example.com"}, employee.Emails)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestValidateEmployee_CollectsViolations(t *testing.T) {
+	uc, employees, repo, departments := setupValidationUsecase()
+	setupAllowedDomains(employees, "example.com")
+	departmentID, managerID := uuid.New(), uuid.New()
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"taken@example.com", "jane@other.com"}).Return(map[string]bool{"taken@example.com": true}, nil)
+	repo.On("GetByExternalID", mock.Anything, "tenant-123", "workday", "W-1").Return(&Employee{ID: uuid.New()}, nil)
+	departments.On("Get", mock.Anything, "tenant-123", departmentID).Return(nil, ErrDepartmentNotFound)
+	repo.On("GetByID", mock.Anything, "tenant-123", managerID).Return(nil, ErrEmployeeNotFound)
+
+	employee := &Employee{
+		Emails:       []string{"taken@example.com", "jane@other.com"},
+		PhoneNumbers: []PhoneNumber{{Type: PhoneTypeWork, Number: "555-0123"}},
+		ExternalIDs:  map[string]string{"workday": "W-1"},
+		DepartmentID: &departmentID,
+		ManagerID:    &managerID,
+	}
+	validation, err := uc.ValidateEmployee(reviewContext(""), employee, nil)
+
+	require.NoError(t, err)
+	assert.False(t, validation.Valid())
+	assert.Equal(t, []ValidationIssue{
+		{Field: "emails[1]", Code: ErrEmailDomainNotAllowed.Reason, Message: "the domain of jane@other.com is not allowed in this tenant"},
+		{Field: "emails[0]", Code: ErrEmployeeAlreadyExists.Reason, Message: ErrEmployeeAlreadyExists.Message},
+		{Field: "phone_numbers", Code: ErrInvalidPhoneNumber.Reason, Message: ErrInvalidPhoneNumber.Message},
+		{Field: `external_ids["workday"]`, Code: ErrExternalIDAlreadyExists.Reason, Message: ErrExternalIDAlreadyExists.Message},
+		{Field: "department_id", Code: ErrDepartmentNotFound.Reason, Message: ErrDepartmentNotFound.Message},
+		{Field: "manager_id", Code: ErrManagerNotFound.Reason, Message: ErrManagerNotFound.Message},
+	}, validation.Violations)
+}
+
+func TestValidateEmployee_Update(t *testing.T) {
+	uc, _, repo, _ := setupValidationUsecase()
+	id := uuid.New()
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(&Employee{ID: id}, nil)
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"jane@example.com"}).Return(map[string]bool{"jane@example.com": true}, nil)
+	repo.On("GetByEmail", mock.Anything, "tenant-123", "jane@example.com").Return(&Employee{ID: id}, nil)
+	repo.On("GetByExternalID", mock.Anything, "tenant-123", "workday", "W-1").Return(&Employee{ID: id}, nil)
+
+	// The employee's own email and external ID are not taken, and it may
+	// not manage itself
+	employee := &Employee{Emails: []string{"jane@example.com"}, ExternalIDs: map[string]string{"workday": "W-1"}, ManagerID: &id}
+	validation, err := uc.ValidateEmployee(reviewContext(""), employee, &id)
+
+	require.NoError(t, err)
+	assert.Equal(t, []ValidationIssue{
+		{Field: "manager_id", Code: ErrInvalidManager.Reason, Message: ErrInvalidManager.Message},
+	}, validation.Violations)
+}
+
+func TestValidateEmployee_UpdateNotFound(t *testing.T) {
+	uc, _, repo, _ := setupValidationUsecase()
+	id := uuid.New()
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(nil, ErrEmployeeNotFound)
+
+	_, err := uc.ValidateEmployee(reviewContext(""), &Employee{Emails: []string{"jane@example.com"}}, &id)
+
+	assert.ErrorIs(t, err, ErrEmployeeNotFound)
+}
+
+func TestValidateEmployee_Warnings(t *testing.T) {
+	uc, employees, repo, _ := setupValidationUsecase()
+	employees.review = NewReviewPolicy(&conf.Admin{Review: &conf.Admin_Review{Channels: []string{"public"}}})
+	employees.emails = NewEmailPolicy(&conf.Admin{EmailNormalization: &conf.Admin_EmailNormalization{FoldGmail: true}}, nil, nil, log.NewStdLogger(io.Discard))
+	uc.quota = NewQuotaUsecase(repo, nil, nil, &conf.Data{Quota: &conf.Data_Quota{MaxEmployees: 10}}, log.NewStdLogger(io.Discard))
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"janedoe@gmail.com"}).Return(map[string]bool{}, nil)
+	repo.On("Count", mock.Anything, "tenant-123", mock.Anything).Return(int64(10), nil)
+
+	validation, err := uc.ValidateEmployee(reviewContext("public"), &Employee{Emails: []string{"Jane.Doe+hr@gmail.com"}}, nil)
+
+	require.NoError(t, err)
+	assert.True(t, validation.Valid())
+	assert.Equal(t, []ValidationIssue{
+		{Field: "emails[0]", Code: WarningEmailNormalized, Message: "Jane.Doe+hr@gmail.com is stored as janedoe@gmail.com"},
+		{Code: WarningReviewRequired, Message: "employees created through public are held for review"},
+		{Code: WarningQuotaExceeded, Message: "the tenant uses all of its quota of 10 employees"},
+	}, validation.Warnings)
+}
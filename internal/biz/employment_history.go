@@ -0,0 +1,66 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// EmploymentHistoryEntry is a job an employee held: its title, department
+// and manager over a period. The repository starts a new entry whenever any
+// of them changes.
+type EmploymentHistoryEntry struct {
+	ID           uuid.UUID
+	TenantID     string
+	EmployeeID   uuid.UUID
+	Title        string
+	DepartmentID *uuid.UUID
+	ManagerID    *uuid.UUID
+	// EffectiveFrom is when the job started
+	EffectiveFrom time.Time
+	// EffectiveTo is when the job ended, nil for the current job
+	EffectiveTo *time.Time
+}
+
+// EmploymentHistoryRepo reads the employment history. Entries are appended
+// by EmployeeRepo in the same transaction as the change of the employee.
+type EmploymentHistoryRepo interface {
+	// List returns a page of the jobs of an employee, the current one first,
+	// and their total
+	List(ctx context.Context, tenantID string, employeeID uuid.UUID, page, pageSize int32) ([]*EmploymentHistoryEntry, int64, error)
+}
+
+// EmploymentHistoryUsecase exposes the employment history of employees
+type EmploymentHistoryUsecase struct {
+	repo      EmploymentHistoryRepo
+	employees EmployeeRepo
+	log       *log.Helper
+}
+
+// NewEmploymentHistoryUsecase creates a new EmploymentHistory usecase.
+func NewEmploymentHistoryUsecase(repo EmploymentHistoryRepo, employees EmployeeRepo, logger log.Logger) *EmploymentHistoryUsecase {
+	return &EmploymentHistoryUsecase{
+		repo:      repo,
+		employees: employees,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// ListEmploymentHistory lists a page of the jobs of an employee of the
+// caller's tenant, the current one first. Pagination defaults are applied
+// to filter.
+func (uc *EmploymentHistoryUsecase) ListEmploymentHistory(ctx context.Context, employeeID uuid.UUID, filter *ListFilter) ([]*EmploymentHistoryEntry, int64, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if _, err := uc.employees.GetByID(ctx, tenantID, employeeID); err != nil {
+		return nil, 0, err
+	}
+
+	applyPagination(filter)
+	return uc.repo.List(ctx, tenantID, employeeID, filter.Page, filter.PageSize)
+}
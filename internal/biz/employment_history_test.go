@@ -0,0 +1,56 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubEmploymentHistoryRepo returns entries and records the page requested
+type stubEmploymentHistoryRepo struct {
+	entries        []*EmploymentHistoryEntry
+	page, pageSize int32
+}
+
+func (r *stubEmploymentHistoryRepo) List(_ context.Context, _ string, _ uuid.UUID, page, pageSize int32) ([]*EmploymentHistoryEntry, int64, error) {
+	r.page, r.pageSize = page, pageSize
+	return r.entries, int64(len(r.entries)), nil
+}
+
+func TestListEmploymentHistory(t *testing.T) {
+	employees := new(MockEmployeeRepo)
+	history := &stubEmploymentHistoryRepo{entries: []*EmploymentHistoryEntry{{ID: uuid.New(), Title: "Engineer"}}}
+	uc := NewEmploymentHistoryUsecase(history, employees, log.NewStdLogger(io.Discard))
+	id := uuid.New()
+
+	employees.On("GetByID", mock.Anything, "tenant-123", id).Return(&Employee{ID: id}, nil)
+
+	filter := &ListFilter{}
+	entries, total, err := uc.ListEmploymentHistory(WithTenantID(context.Background(), "tenant-123"), id, filter)
+
+	require.NoError(t, err)
+	assert.Equal(t, history.entries, entries)
+	assert.EqualValues(t, 1, total)
+	assert.Equal(t, int32(1), history.page)
+	assert.Equal(t, int32(20), history.pageSize)
+}
+
+func TestListEmploymentHistory_EmployeeNotFound(t *testing.T) {
+	employees := new(MockEmployeeRepo)
+	history := &stubEmploymentHistoryRepo{}
+	uc := NewEmploymentHistoryUsecase(history, employees, log.NewStdLogger(io.Discard))
+	id := uuid.New()
+
+	employees.On("GetByID", mock.Anything, "tenant-123", id).Return(nil, ErrEmployeeNotFound)
+
+	_, _, err := uc.ListEmploymentHistory(WithTenantID(context.Background(), "tenant-123"), id, &ListFilter{})
+
+	assert.Equal(t, ErrEmployeeNotFound, err)
+	assert.Zero(t, history.page)
+}
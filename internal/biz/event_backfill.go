@@ -0,0 +1,122 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidBackfillCursor is returned when a backfill cursor cannot be
+	// decoded
+	ErrInvalidBackfillCursor = errors.BadRequest(v1.ErrorReason_INVALID_BACKFILL_CURSOR.String(), "invalid backfill cursor")
+	// ErrEventsNotConfigured is returned when a backfill is started while
+	// neither NATS nor an event sink is configured
+	ErrEventsNotConfigured = errors.ServiceUnavailable(v1.ErrorReason_EVENTS_NOT_CONFIGURED.String(), "events are not published")
+)
+
+// backfillCursorPrefix versions the backfill cursor format
+const backfillCursorPrefix = "emp:"
+
+// EncodeBackfillCursor returns the opaque cursor continuing a backfill after
+// the employee at cursor
+func EncodeBackfillCursor(cursor StreamCursor) string {
+	raw := backfillCursorPrefix + strconv.FormatInt(cursor.CreatedAt.UnixNano(), 10) + "/" + cursor.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeBackfillCursor returns the position encoded in a backfill cursor
+func DecodeBackfillCursor(token string) (*StreamCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidBackfillCursor
+	}
+	s, ok := strings.CutPrefix(string(raw), backfillCursorPrefix)
+	if !ok {
+		return nil, ErrInvalidBackfillCursor
+	}
+	nanos, id, ok := strings.Cut(s, "/")
+	if !ok {
+		return nil, ErrInvalidBackfillCursor
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidBackfillCursor
+	}
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrInvalidBackfillCursor
+	}
+	return &StreamCursor{CreatedAt: time.Unix(0, n).UTC(), ID: parsed}, nil
+}
+
+// EventBackfillUsecase publishes created events for employees that existed
+// before consumers subscribed to the events
+type EventBackfillUsecase struct {
+	repo      EmployeeRepo
+	publisher EventPublisher
+	log       *log.Helper
+}
+
+// NewEventBackfillUsecase creates a new EventBackfill usecase. publisher is
+// nil when events are not published.
+func NewEventBackfillUsecase(repo EmployeeRepo, publisher EventPublisher, logger log.Logger) *EventBackfillUsecase {
+	return &EventBackfillUsecase{
+		repo:      repo,
+		publisher: publisher,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// BackfillEvents publishes an employees.v1.created event, with source
+// backfill, for each of the next limit employees of the caller's tenant
+// after the cursor, oldest first; a nil cursor starts with the oldest
+// employee. Employees pending review are skipped. The events go straight to
+// the publisher: nothing is audited, cached or sent to webhooks. It returns
+// the number of events published and the cursor of the next batch, nil once
+// all employees were published. A failed publish fails the batch; retrying
+// it from the same cursor publishes its first events again, which consumers
+// handle like any redelivery.
+func (uc *EventBackfillUsecase) BackfillEvents(ctx context.Context, after *StreamCursor, limit int) (int, *StreamCursor, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	if uc.publisher == nil {
+		return 0, nil, ErrEventsNotConfigured
+	}
+	userID, _ := GetUserID(ctx)
+
+	uc.log.WithContext(ctx).Infof("BackfillEvents: tenant=%s, limit=%d", tenantID, limit)
+
+	it, err := uc.repo.Stream(ctx, tenantID, &StreamFilter{After: after, Limit: limit})
+	if err != nil {
+		return 0, nil, err
+	}
+	defer it.Close()
+
+	ctx = WithSource(ctx, SourceBackfill)
+	published, next := 0, after
+	for it.Next() {
+		if err := uc.publisher.PublishEmployeeCreated(ctx, tenantID, userID, it.Employee()); err != nil {
+			return published, nil, err
+		}
+		cursor := it.Cursor()
+		published, next = published+1, &cursor
+	}
+	if err := it.Err(); err != nil {
+		return published, nil, err
+	}
+	if published < limit {
+		return published, nil, nil
+	}
+	return published, next, nil
+}
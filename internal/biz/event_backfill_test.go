@@ -0,0 +1,88 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackfillCursor(t *testing.T) {
+	cursor := StreamCursor{CreatedAt: time.Date(2026, 3, 1, 9, 0, 0, 123456789, time.UTC), ID: uuid.New()}
+
+	decoded, err := DecodeBackfillCursor(EncodeBackfillCursor(cursor))
+	require.NoError(t, err)
+	assert.Equal(t, cursor, *decoded)
+
+	for _, token := range []string{"%%%", "c2VxOjE", EncodeResumeToken(AuditPosition{TxID: 1, Seq: 1})} {
+		_, err := DecodeBackfillCursor(token)
+		assert.ErrorIs(t, err, ErrInvalidBackfillCursor, token)
+	}
+}
+
+func TestBackfillEvents(t *testing.T) {
+	employees := []*Employee{
+		{ID: uuid.New(), CreatedAt: scheduleNow.Add(-2 * time.Hour)},
+		{ID: uuid.New(), CreatedAt: scheduleNow.Add(-time.Hour)},
+	}
+	after := &StreamCursor{CreatedAt: scheduleNow.Add(-3 * time.Hour), ID: uuid.New()}
+
+	t.Run("full batch", func(t *testing.T) {
+		repo, pub := new(MockEmployeeRepo), new(MockEventPublisher)
+		uc := NewEventBackfillUsecase(repo, pub, log.NewStdLogger(io.Discard))
+		it := &sliceIterator{employees: employees}
+		repo.On("Stream", mock.Anything, "tenant-123", &StreamFilter{After: after, Limit: 2}).Return(it, nil)
+		pub.On("PublishEmployeeCreated", mock.MatchedBy(func(ctx context.Context) bool {
+			return GetSource(ctx) == SourceBackfill
+		}), "tenant-123", "user-456", mock.Anything).Return(nil)
+
+		published, next, err := uc.BackfillEvents(reviewContext(""), after, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, published)
+		assert.Equal(t, &StreamCursor{CreatedAt: employees[1].CreatedAt, ID: employees[1].ID}, next)
+		assert.True(t, it.closed)
+		pub.AssertNumberOfCalls(t, "PublishEmployeeCreated", 2)
+	})
+
+	t.Run("last batch", func(t *testing.T) {
+		repo, pub := new(MockEmployeeRepo), new(MockEventPublisher)
+		uc := NewEventBackfillUsecase(repo, pub, log.NewStdLogger(io.Discard))
+		repo.On("Stream", mock.Anything, "tenant-123", &StreamFilter{Limit: 100}).Return(&sliceIterator{employees: employees}, nil)
+		pub.On("PublishEmployeeCreated", mock.Anything, "tenant-123", "user-456", mock.Anything).Return(nil)
+
+		published, next, err := uc.BackfillEvents(reviewContext(""), nil, 100)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, published)
+		assert.Nil(t, next)
+	})
+
+	t.Run("publish fails", func(t *testing.T) {
+		repo, pub := new(MockEmployeeRepo), new(MockEventPublisher)
+		uc := NewEventBackfillUsecase(repo, pub, log.NewStdLogger(io.Discard))
+		repo.On("Stream", mock.Anything, "tenant-123", mock.Anything).Return(&sliceIterator{employees: employees}, nil)
+		pub.On("PublishEmployeeCreated", mock.Anything, "tenant-123", "user-456", employees[0]).Return(nil)
+		pub.On("PublishEmployeeCreated", mock.Anything, "tenant-123", "user-456", employees[1]).Return(assert.AnError)
+
+		published, next, err := uc.BackfillEvents(reviewContext(""), nil, 100)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, 1, published)
+		assert.Nil(t, next)
+	})
+
+	t.Run("events not configured", func(t *testing.T) {
+		uc := NewEventBackfillUsecase(new(MockEmployeeRepo), nil, log.NewStdLogger(io.Discard))
+
+		_, _, err := uc.BackfillEvents(reviewContext(""), nil, 100)
+
+		assert.ErrorIs(t, err, ErrEventsNotConfigured)
+	})
+}
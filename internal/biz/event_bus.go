@@ -0,0 +1,176 @@
+package biz
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// DomainEventType names a committed change to employees, departments or
+// teams
+type DomainEventType string
+
+// Domain event types
+const (
+	EventEmployeeCreated  DomainEventType = "employee.created"
+	EventEmployeeUpdated  DomainEventType = "employee.updated"
+	EventEmployeeDeleted  DomainEventType = "employee.deleted"
+	EventEmployeeMerged   DomainEventType = "employee.merged"
+	EventEmployeeUnmerged DomainEventType = "employee.unmerged"
+	// Deactivations and reactivations carry the employee after the change
+	EventEmployeeDeactivated DomainEventType = "employee.deactivated"
+	EventEmployeeReactivated DomainEventType = "employee.reactivated"
+	// EventEmployeeStale carries an employee the stale-record job flagged
+	// and the signals it met
+	EventEmployeeStale DomainEventType = "employee.stale"
+	// EventTenantPurged means every employee of the tenant was deleted; it
+	// carries no employee
+	EventTenantPurged DomainEventType = "tenant.purged"
+	// Department events carry the department instead of an employee
+	EventDepartmentCreated DomainEventType = "department.created"
+	EventDepartmentUpdated DomainEventType = "department.updated"
+	EventDepartmentDeleted DomainEventType = "department.deleted"
+	// Team events carry the team, and the added or removed member for
+	// membership changes
+	EventTeamCreated       DomainEventType = "team.created"
+	EventTeamMemberAdded   DomainEventType = "team.member_added"
+	EventTeamMemberRemoved DomainEventType = "team.member_removed"
+)
+
+// EmailChanges are the emails an update added to and removed from an employee
+type EmailChanges struct {
+	Added   []string
+	Removed []string
+}
+
+// DomainEvent is a committed change published on the EventBus
+type DomainEvent struct {
+	Type     DomainEventType
+	TenantID string
+	UserID   string
+	// Employee is the employee after the change: the deleted employee for
+	// deletes and the merged primary employee for merges, and the member of
+	// team membership changes. Unset for unmerges, tenant purges, department
+	// events and created teams.
+	Employee *Employee
+	// UpdatedFields lists the changed fields of an update
+	UpdatedFields []string
+	// Emails are the emails an update added and removed
+	Emails EmailChanges
+	// StaleReasons are the signals a stale employee met
+	StaleReasons []string
+	// Merge is set for merges and unmerges
+	Merge *Merge
+	// MergedFromEmail names the secondary employee of a merge
+	MergedFromEmail string
+	// Department is the changed department of department events, as it was
+	// before a delete
+	Department *Department
+	// Team is the team of team events
+	Team *Team
+}
+
+// EventSubscriber handles domain events. The change is already committed, so
+// an error is only logged; it neither fails the change nor stops delivery to
+// the other subscribers.
+type EventSubscriber interface {
+	HandleEvent(ctx context.Context, event *DomainEvent) error
+}
+
+// EventSubscriberFunc adapts a function to an EventSubscriber
+type EventSubscriberFunc func(ctx context.Context, event *DomainEvent) error
+
+// HandleEvent calls f(ctx, event).
+func (f EventSubscriberFunc) HandleEvent(ctx context.Context, event *DomainEvent) error {
+	return f(ctx, event)
+}
+
+// namedSubscriber is a subscriber with the name it is logged as
+type namedSubscriber struct {
+	name       string
+	subscriber EventSubscriber
+}
+
+// EventBus delivers domain events to in-process subscribers. Usecases publish
+// every committed change once; cross-cutting concerns such as event
+// publishing, cache invalidation and statistics subscribe to it instead of
+// hooking the usecases. Subscribers are called synchronously, in the order
+// they subscribed.
+type EventBus struct {
+	mu          sync.RWMutex
+	subscribers []namedSubscriber
+	log         *log.Helper
+}
+
+// NewEventBus creates an event bus without subscribers
+func NewEventBus(logger log.Logger) *EventBus {
+	return &EventBus{log: log.NewHelper(logger)}
+}
+
+// Subscribe registers a subscriber for all events
+func (b *EventBus) Subscribe(name string, subscriber EventSubscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers = append(b.subscribers, namedSubscriber{name: name, subscriber: subscriber})
+}
+
+// Publish delivers event to every subscriber (best-effort)
+func (b *EventBus) Publish(ctx context.Context, event *DomainEvent) {
+	if b == nil {
+		return
+	}
+
+	b.mu.RLock()
+	subscribers := b.subscribers
+	b.mu.RUnlock()
+
+	for _, s := range subscribers {
+		if err := s.subscriber.HandleEvent(ctx, event); err != nil {
+			b.log.WithContext(ctx).Warnf("event subscriber %s failed on %s: %v", s.name, event.Type, err)
+		}
+	}
+}
+
+// NewPublisherSubscriber returns a subscriber forwarding employee, department
+// and team events to an EventPublisher, except events of employees pending
+// review
+func NewPublisherSubscriber(publisher EventPublisher) EventSubscriber {
+	return EventSubscriberFunc(func(ctx context.Context, e *DomainEvent) error {
+		// Employees pending review are published once approved
+		if e.Employee != nil && e.Employee.IsPending() {
+			return nil
+		}
+		switch e.Type {
+		case EventEmployeeCreated:
+			return publisher.PublishEmployeeCreated(ctx, e.TenantID, e.UserID, e.Employee)
+		case EventEmployeeUpdated:
+			return publisher.PublishEmployeeUpdated(ctx, e.TenantID, e.UserID, e.Employee, e.UpdatedFields, e.Emails)
+		case EventEmployeeDeleted:
+			return publisher.PublishEmployeeDeleted(ctx, e.TenantID, e.UserID, e.Employee)
+		case EventEmployeeMerged:
+			return publisher.PublishEmployeeMerged(ctx, e.TenantID, e.UserID, e.Merge, e.MergedFromEmail)
+		case EventEmployeeUnmerged:
+			return publisher.PublishEmployeeUnmerged(ctx, e.TenantID, e.UserID, e.Merge)
+		case EventEmployeeDeactivated:
+			return publisher.PublishEmployeeDeactivated(ctx, e.TenantID, e.UserID, e.Employee)
+		case EventEmployeeReactivated:
+			return publisher.PublishEmployeeReactivated(ctx, e.TenantID, e.UserID, e.Employee)
+		case EventEmployeeStale:
+			return publisher.PublishEmployeeStale(ctx, e.TenantID, e.UserID, e.Employee, e.StaleReasons)
+		case EventDepartmentCreated:
+			return publisher.PublishDepartmentCreated(ctx, e.TenantID, e.UserID, e.Department)
+		case EventDepartmentUpdated:
+			return publisher.PublishDepartmentUpdated(ctx, e.TenantID, e.UserID, e.Department)
+		case EventDepartmentDeleted:
+			return publisher.PublishDepartmentDeleted(ctx, e.TenantID, e.UserID, e.Department)
+		case EventTeamCreated:
+			return publisher.PublishTeamCreated(ctx, e.TenantID, e.UserID, e.Team)
+		case EventTeamMemberAdded:
+			return publisher.PublishTeamMemberAdded(ctx, e.TenantID, e.UserID, e.Team, e.Employee)
+		case EventTeamMemberRemoved:
+			return publisher.PublishTeamMemberRemoved(ctx, e.TenantID, e.UserID, e.Team, e.Employee)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,77 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBus_Publish(t *testing.T) {
+	bus := NewEventBus(log.NewStdLogger(io.Discard))
+	event := &DomainEvent{Type: EventEmployeeCreated, TenantID: "tenant-123"}
+
+	var calls []string
+	bus.Subscribe("failing", EventSubscriberFunc(func(_ context.Context, e *DomainEvent) error {
+		calls = append(calls, "failing")
+		return errors.New("subscriber error")
+	}))
+	bus.Subscribe("second", EventSubscriberFunc(func(_ context.Context, e *DomainEvent) error {
+		assert.Equal(t, event, e)
+		calls = append(calls, "second")
+		return nil
+	}))
+
+	bus.Publish(context.Background(), event)
+
+	// A failing subscriber does not stop delivery to the others
+	assert.Equal(t, []string{"failing", "second"}, calls)
+}
+
+func TestEventBus_Nil(t *testing.T) {
+	var bus *EventBus
+
+	assert.NotPanics(t, func() {
+		bus.Publish(context.Background(), &DomainEvent{Type: EventEmployeeCreated})
+	})
+}
+
+func TestPublisherSubscriber(t *testing.T) {
+	ctx := context.Background()
+	employee := &Employee{ID: uuid.New()}
+	merge := &Merge{ID: uuid.New(), Primary: employee, Secondary: &Employee{ID: uuid.New()}}
+
+	pub := new(MockEventPublisher)
+	pub.On("PublishEmployeeCreated", ctx, "tenant-123", "user-456", employee).Return(nil)
+	pub.On("PublishEmployeeUpdated", ctx, "tenant-123", "user-456", employee, []string{"last_name"}, EmailChanges{}).Return(nil)
+	pub.On("PublishEmployeeDeleted", ctx, "tenant-123", "user-456", employee).Return(nil)
+	pub.On("PublishEmployeeMerged", ctx, "tenant-123", "user-456", merge, "secondary@example.com").Return(nil)
+	pub.On("PublishEmployeeUnmerged", ctx, "tenant-123", "user-456", merge).Return(errors.New("publish error"))
+
+	s := NewPublisherSubscriber(pub)
+	event := func(eventType DomainEventType) *DomainEvent {
+		return &DomainEvent{
+			Type:            eventType,
+			TenantID:        "tenant-123",
+			UserID:          "user-456",
+			Employee:        employee,
+			UpdatedFields:   []string{"last_name"},
+			Merge:           merge,
+			MergedFromEmail: "secondary@example.com",
+		}
+	}
+
+	assert.NoError(t, s.HandleEvent(ctx, event(EventEmployeeCreated)))
+	assert.NoError(t, s.HandleEvent(ctx, event(EventEmployeeUpdated)))
+	assert.NoError(t, s.HandleEvent(ctx, event(EventEmployeeDeleted)))
+	assert.NoError(t, s.HandleEvent(ctx, event(EventEmployeeMerged)))
+	assert.EqualError(t, s.HandleEvent(ctx, event(EventEmployeeUnmerged)), "publish error")
+
+	// Events without a message are not published
+	assert.NoError(t, s.HandleEvent(ctx, &DomainEvent{Type: EventTenantPurged, TenantID: "tenant-123"}))
+	pub.AssertExpectations(t)
+}
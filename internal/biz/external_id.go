@@ -0,0 +1,89 @@
+package biz
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	// MaxExternalIDs is the most external systems an employee can have an ID in
+	MaxExternalIDs = 20
+	// maxExternalIDLength is the longest ID in an external system
+	maxExternalIDLength = 255
+)
+
+var (
+	// ErrInvalidExternalID is an external ID with an invalid system name, or
+	// an empty or overlong ID
+	ErrInvalidExternalID = errors.BadRequest(v1.ErrorReason_INVALID_EXTERNAL_ID.String(), fmt.Sprintf("external systems must be lowercase names such as workday, with IDs of 1 to %d characters, in at most %d systems", maxExternalIDLength, MaxExternalIDs))
+	// ErrExternalIDAlreadyExists is an external ID that already belongs to
+	// another employee of the tenant in the same system
+	ErrExternalIDAlreadyExists = errors.Conflict(v1.ErrorReason_EXTERNAL_ID_ALREADY_EXISTS.String(), "external ID already belongs to another employee")
+)
+
+// externalSystem matches the name of an external system, e.g. workday
+var externalSystem = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+// NormalizeExternalID lowercases system and trims both system and id,
+// rejecting unknown-looking systems and empty or overlong IDs with
+// ErrInvalidExternalID
+func NormalizeExternalID(system, id string) (string, string, error) {
+	system = strings.ToLower(strings.TrimSpace(system))
+	id = strings.TrimSpace(id)
+	if !externalSystem.MatchString(system) || id == "" || len(id) > maxExternalIDLength {
+		return "", "", ErrInvalidExternalID
+	}
+	return system, id, nil
+}
+
+// normalizeExternalIDs normalizes the systems and IDs of ids. With
+// allowRemoval an empty ID is kept, marking the removal of the system's ID
+// in an update. nil stays nil, so that updates keep the external IDs.
+func normalizeExternalIDs(ids map[string]string, allowRemoval bool) (map[string]string, error) {
+	if ids == nil {
+		return nil, nil
+	}
+	if len(ids) > MaxExternalIDs {
+		return nil, ErrInvalidExternalID
+	}
+	normalized := make(map[string]string, len(ids))
+	for system, id := range ids {
+		if allowRemoval && strings.TrimSpace(id) == "" {
+			system, _, err := NormalizeExternalID(system, "-")
+			if err != nil {
+				return nil, err
+			}
+			normalized[system] = ""
+			continue
+		}
+		system, id, err := NormalizeExternalID(system, id)
+		if err != nil {
+			return nil, err
+		}
+		normalized[system] = id
+	}
+	return normalized, nil
+}
+
+// applyExternalIDs returns existing with the IDs of changes set and the
+// systems changes maps to an empty ID removed. existing is not modified.
+func applyExternalIDs(existing, changes map[string]string) map[string]string {
+	applied := maps.Clone(existing)
+	if applied == nil {
+		applied = map[string]string{}
+	}
+	for system, id := range changes {
+		if id == "" {
+			delete(applied, system)
+		} else {
+			applied[system] = id
+		}
+	}
+	return applied
+}
@@ -0,0 +1,127 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeExternalIDs(t *testing.T) {
+	ids, err := normalizeExternalIDs(map[string]string{" Workday ": " W-1001 ", "bamboohr": "42"}, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"workday": "W-1001", "bamboohr": "42"}, ids)
+}
+
+func TestNormalizeExternalIDs_Removal(t *testing.T) {
+	ids, err := normalizeExternalIDs(map[string]string{"Workday": " "}, true)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"workday": ""}, ids)
+
+	_, err = normalizeExternalIDs(map[string]string{"workday": ""}, false)
+	assert.Equal(t, ErrInvalidExternalID, err)
+}
+
+func TestNormalizeExternalIDs_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  map[string]string
+	}{
+		{name: "empty system", ids: map[string]string{"": "42"}},
+		{name: "system with spaces", ids: map[string]string{"work day": "42"}},
+		{name: "system starting with a digit", ids: map[string]string{"1hris": "42"}},
+		{name: "overlong id", ids: map[string]string{"workday": strings.Repeat("x", 256)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := normalizeExternalIDs(tt.ids, true)
+			assert.Equal(t, ErrInvalidExternalID, err)
+		})
+	}
+}
+
+func TestUpdateEmployee_ExternalIDs(t *testing.T) {
+	uc, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	uc.events = newTestEventBus(pub)
+	id := uuid.New()
+
+	existing := &Employee{ID: id, Version: 2, ExternalIDs: map[string]string{"workday": "W-1", "bamboohr": "42"}}
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(existing, nil)
+	repo.On("Update", mock.Anything, "tenant-123", mock.MatchedBy(func(e *Employee) bool {
+		return assert.ObjectsAreEqual(map[string]string{"workday": "W-2", "adp": "A7"}, e.ExternalIDs)
+	})).Return(&Employee{ID: id, Version: 3}, nil)
+	pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "", mock.Anything, []string{"external_ids"}, EmailChanges{}).Return(nil)
+
+	_, err := uc.UpdateEmployee(WithTenantID(context.Background(), "tenant-123"), &Employee{
+		ID:          id,
+		Version:     2,
+		ExternalIDs: map[string]string{"Workday": "W-2", "bamboohr": "", "adp": "A7"},
+	})
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+	pub.AssertExpectations(t)
+	assert.Equal(t, map[string]string{"workday": "W-1", "bamboohr": "42"}, existing.ExternalIDs)
+}
+
+func TestUpdateEmployee_ExternalIDsUnchanged(t *testing.T) {
+	uc, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	uc.events = newTestEventBus(pub)
+	id := uuid.New()
+
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(&Employee{ID: id, Version: 2, ExternalIDs: map[string]string{"workday": "W-1"}}, nil)
+	repo.On("Update", mock.Anything, "tenant-123", mock.MatchedBy(func(e *Employee) bool {
+		return e.ExternalIDs == nil
+	})).Return(&Employee{ID: id, Version: 3}, nil)
+	pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "", mock.Anything, []string{}, EmailChanges{}).Return(nil)
+
+	_, err := uc.UpdateEmployee(WithTenantID(context.Background(), "tenant-123"), &Employee{
+		ID:          id,
+		Version:     2,
+		ExternalIDs: map[string]string{"workday": "W-1", "bamboohr": ""},
+	})
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestGetEmployeeByExternalID(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+
+	t.Run("found", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		employee := &Employee{ID: uuid.New(), ReviewStatus: ReviewStatusApproved}
+		repo.On("GetByExternalID", mock.Anything, "tenant-123", "workday", "W-1").Return(employee, nil)
+
+		got, err := uc.GetEmployeeByExternalID(ctx, " WorkDay", "W-1 ")
+
+		require.NoError(t, err)
+		assert.Same(t, employee, got)
+	})
+
+	t.Run("pending review", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		repo.On("GetByExternalID", mock.Anything, "tenant-123", "workday", "W-1").Return(&Employee{ID: uuid.New(), ReviewStatus: ReviewStatusPending}, nil)
+
+		_, err := uc.GetEmployeeByExternalID(ctx, "workday", "W-1")
+
+		assert.Equal(t, ErrEmployeeNotFound, err)
+	})
+
+	t.Run("invalid system", func(t *testing.T) {
+		uc, repo := setupUsecase()
+
+		_, err := uc.GetEmployeeByExternalID(ctx, "work day", "W-1")
+
+		assert.Equal(t, ErrInvalidExternalID, err)
+		repo.AssertNotCalled(t, "GetByExternalID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,112 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// defaultIdempotencyTTL is how long an idempotency key replays its response
+const defaultIdempotencyTTL = 24 * time.Hour
+
+// MaxIdempotencyKeyLength bounds the length of an idempotency key
+const MaxIdempotencyKeyLength = 255
+
+// ErrIdempotencyKeyReused is an idempotency key sent again with a different
+// request.
+var ErrIdempotencyKeyReused = errors.Conflict(v1.ErrorReason_IDEMPOTENCY_KEY_REUSED.String(), "idempotency key was already used for a different request")
+
+// IdempotencyRecord is a create request made with an idempotency key and the
+// employee it created
+type IdempotencyRecord struct {
+	Key string
+	// Fingerprint is the digest of the request; the key only replays
+	// requests with the same fingerprint
+	Fingerprint string
+	// Employee is the employee as returned by the original request
+	Employee  *Employee
+	ExpiresAt time.Time
+}
+
+// IdempotencyRepo stores idempotency keys per tenant. Claim and Complete are
+// called within the transaction creating the employee, so a failed create
+// releases its key.
+type IdempotencyRepo interface {
+	// Claim reserves key for a request with fingerprint until expiresAt. It
+	// returns the existing record when the key is held by an unexpired
+	// earlier request, waiting for that request to commit, and nil once the
+	// key is claimed.
+	Claim(ctx context.Context, tenantID, key, fingerprint string, now, expiresAt time.Time) (*IdempotencyRecord, error)
+	// Complete stores the employee created for a claimed key
+	Complete(ctx context.Context, tenantID, key string, employee *Employee) error
+	// DeleteExpired deletes keys that expired before now, returning how many
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// Idempotency replays create requests sent again with the same idempotency
+// key
+type Idempotency struct {
+	repo  IdempotencyRepo
+	clock Clock
+	ttl   time.Duration
+}
+
+// NewIdempotency creates the idempotency key store configured in c
+func NewIdempotency(repo IdempotencyRepo, clock Clock, c *conf.Data) *Idempotency {
+	i := &Idempotency{repo: repo, clock: clock, ttl: defaultIdempotencyTTL}
+	if ttl := c.GetIdempotency().GetTtl(); ttl != nil && ttl.AsDuration() > 0 {
+		i.ttl = ttl.AsDuration()
+	}
+	return i
+}
+
+// claim reserves key for the creation of employee. It returns the employee
+// created by an earlier request with the same key, or nil when the caller
+// should create the employee and complete the key.
+func (i *Idempotency) claim(ctx context.Context, tenantID, key string, employee *Employee) (*Employee, error) {
+	now := i.clock.Now()
+	fingerprint := createFingerprint(employee)
+	record, err := i.repo.Claim(ctx, tenantID, key, fingerprint, now, now.Add(i.ttl))
+	if err != nil || record == nil {
+		return nil, err
+	}
+	if record.Fingerprint != fingerprint {
+		return nil, ErrIdempotencyKeyReused
+	}
+	return record.Employee, nil
+}
+
+// complete stores the employee created for key
+func (i *Idempotency) complete(ctx context.Context, tenantID, key string, created *Employee) error {
+	return i.repo.Complete(ctx, tenantID, key, created)
+}
+
+// DeleteExpired deletes the expired idempotency keys of all tenants
+func (i *Idempotency) DeleteExpired(ctx context.Context) (int64, error) {
+	return i.repo.DeleteExpired(ctx, i.clock.Now())
+}
+
+// createFingerprint returns the digest of a create request. Emails are
+// compared as a set.
+func createFingerprint(e *Employee) string {
+	emails := slices.Clone(e.Emails)
+	for j, email := range emails {
+		emails[j] = strings.ToLower(email)
+	}
+	slices.Sort(emails)
+
+	h := sha256.New()
+	for _, part := range append(emails, "", e.FirstName, e.LastName) {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
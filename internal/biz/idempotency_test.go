@@ -0,0 +1,104 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// memoryIdempotencyRepo keeps idempotency keys in memory
+type memoryIdempotencyRepo struct {
+	records map[string]*IdempotencyRecord
+}
+
+func (r *memoryIdempotencyRepo) Claim(_ context.Context, tenantID, key, fingerprint string, now, expiresAt time.Time) (*IdempotencyRecord, error) {
+	if record, ok := r.records[tenantID+"/"+key]; ok && record.ExpiresAt.After(now) {
+		return record, nil
+	}
+	r.records[tenantID+"/"+key] = &IdempotencyRecord{Key: key, Fingerprint: fingerprint, ExpiresAt: expiresAt}
+	return nil, nil
+}
+
+func (r *memoryIdempotencyRepo) Complete(_ context.Context, tenantID, key string, employee *Employee) error {
+	r.records[tenantID+"/"+key].Employee = employee
+	return nil
+}
+
+func (r *memoryIdempotencyRepo) DeleteExpired(_ context.Context, now time.Time) (int64, error) {
+	var deleted int64
+	for k, record := range r.records {
+		if !record.ExpiresAt.After(now) {
+			delete(r.records, k)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func TestCreateEmployee_IdempotencyKey(t *testing.T) {
+	uc, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	uc.events = newTestEventBus(pub)
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	store := &memoryIdempotencyRepo{records: make(map[string]*IdempotencyRecord)}
+	uc.idempotency = NewIdempotency(store, ClockFunc(func() time.Time { return now }),
+		&conf.Data{Idempotency: &conf.Data_Idempotency{Ttl: durationpb.New(time.Hour)}})
+	created := &Employee{ID: uuid.New(), Emails: []string{"new@example.com"}, FirstName: "Jane", LastName: "Doe", Version: 1}
+
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"new@example.com"}).Return(map[string]bool{}, nil).Once()
+	repo.On("Create", mock.Anything, "tenant-123", mock.Anything).Return(created, nil).Once()
+	pub.On("PublishEmployeeCreated", mock.Anything, "tenant-123", "user-456", created).Return(nil).Once()
+
+	ctx := WithIdempotencyKey(reviewContext(""), "key-1")
+	got, err := uc.CreateEmployee(ctx, &Employee{Emails: []string{"new@example.com"}, FirstName: "Jane", LastName: "Doe"})
+	require.NoError(t, err)
+	assert.Equal(t, created, got)
+	assert.Equal(t, now.Add(time.Hour), store.records["tenant-123/key-1"].ExpiresAt)
+
+	// A retry returns the original employee without creating or publishing
+	got, err = uc.CreateEmployee(ctx, &Employee{Emails: []string{"NEW@example.com"}, FirstName: "Jane", LastName: "Doe"})
+	require.NoError(t, err)
+	assert.Equal(t, created, got)
+
+	_, err = uc.CreateEmployee(ctx, &Employee{Emails: []string{"new@example.com"}, FirstName: "John", LastName: "Doe"})
+	assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+
+	repo.AssertExpectations(t)
+	pub.AssertExpectations(t)
+
+	// Expired keys are deleted and may be used again
+	now = now.Add(time.Hour)
+	deleted, err := uc.idempotency.DeleteExpired(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
+
+func TestCreateEmployee_IdempotencyKeyNotCompletedOnFailure(t *testing.T) {
+	uc, repo := setupUsecase()
+	store := &memoryIdempotencyRepo{records: make(map[string]*IdempotencyRecord)}
+	uc.idempotency = NewIdempotency(store, NewSystemClock(), nil)
+
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"taken@example.com"}).Return(map[string]bool{"taken@example.com": true}, nil)
+
+	ctx := WithIdempotencyKey(reviewContext(""), "key-1")
+	_, err := uc.CreateEmployee(ctx, &Employee{Emails: []string{"taken@example.com"}})
+	assert.ErrorIs(t, err, ErrEmployeeAlreadyExists)
+	// Rolling back the transaction releases the claimed key
+	assert.Nil(t, store.records["tenant-123/key-1"].Employee)
+}
+
+func TestCreateFingerprint(t *testing.T) {
+	a := createFingerprint(&Employee{Emails: []string{"a@example.com", "B@example.com"}, FirstName: "Jane", LastName: "Doe"})
+
+	assert.Equal(t, a, createFingerprint(&Employee{Emails: []string{"b@example.com", "a@example.com"}, FirstName: "Jane", LastName: "Doe"}))
+	assert.NotEqual(t, a, createFingerprint(&Employee{Emails: []string{"a@example.com", "b@example.com"}, FirstName: "JaneDoe"}))
+	assert.NotEqual(t, a, createFingerprint(&Employee{Emails: []string{"a@example.com"}, FirstName: "Jane", LastName: "Doe"}))
+}
@@ -0,0 +1,588 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/mail"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// Import statuses
+const (
+	ImportStatusPending   = "pending"
+	ImportStatusRunning   = "running"
+	ImportStatusSucceeded = "succeeded"
+	ImportStatusFailed    = "failed"
+)
+
+const (
+	// defaultImportBatchSize is the number of rows created per transaction
+	defaultImportBatchSize = 500
+	// defaultImportMaxRows is the largest accepted CSV, in data rows
+	defaultImportMaxRows = 100000
+	// maxImportRowErrors caps the row errors kept per import
+	maxImportRowErrors = 1000
+	// importLease is how long a worker owns an import without saving progress
+	importLease = 2 * time.Minute
+	// maxImportEmails mirrors the CreateEmployee limit on emails per employee
+	maxImportEmails = 10
+	// defaultImportMaxPerTenant is the number of imports of one tenant
+	// running at the same time
+	defaultImportMaxPerTenant = 1
+	// defaultImportBatchesPerTurn is the number of batches an import runs
+	// before yielding to other tenants
+	defaultImportBatchesPerTurn = 10
+)
+
+var (
+	// ErrImportNotFound is returned when an import does not exist in the tenant
+	ErrImportNotFound = errors.NotFound(v1.ErrorReason_IMPORT_NOT_FOUND.String(), "import not found")
+	// ErrInvalidImportSource is returned for source URLs that cannot be read
+	ErrInvalidImportSource = errors.BadRequest(v1.ErrorReason_INVALID_IMPORT_SOURCE.String(), "invalid import source")
+)
+
+// importNamePattern mirrors the CreateEmployee name validation
+var importNamePattern = regexp.MustCompile(`^[a-zA-Z\s\-']+$`)
+
+// ImportRowError describes a CSV row that was not imported
+type ImportRowError struct {
+	Row     int32  `json:"row"`
+	Email   string `json:"email,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportOperation is a long-running bulk import of employees
+type ImportOperation struct {
+	ID            uuid.UUID
+	TenantID      string
+	Status        string
+	SourceURL     string
+	TotalRows     int32
+	ProcessedRows int32
+	CreatedCount  int32
+	FailedCount   int32
+	Errors        []ImportRowError
+	Error         string
+	CreatedBy     string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	CompletedAt   *time.Time
+}
+
+// ErrorsTruncated reports whether more rows failed than errors are kept
+func (op *ImportOperation) ErrorsTruncated() bool {
+	return int(op.FailedCount) > len(op.Errors)
+}
+
+// addRowError counts a failed row, keeping its error while under the cap
+func (op *ImportOperation) addRowError(row int32, email, message string) {
+	op.FailedCount++
+	if len(op.Errors) < maxImportRowErrors {
+		op.Errors = append(op.Errors, ImportRowError{Row: row, Email: email, Message: message})
+	}
+}
+
+// ImportQueueStats describes the runnable imports no worker is running
+type ImportQueueStats struct {
+	Waiting int64
+	// WaitingTenants is the number of tenants with a waiting import
+	WaitingTenants int64
+	// OldestWait is how long the import waiting longest has been runnable
+	OldestWait time.Duration
+}
+
+// ImportRepo stores import operations
+type ImportRepo interface {
+	Create(ctx context.Context, op *ImportOperation, csv []byte) (*ImportOperation, error)
+	Get(ctx context.Context, tenantID string, id uuid.UUID) (*ImportOperation, error)
+	// ClaimNext leases a runnable import, pending or yielded or with an
+	// expired lease, and returns it with its inline CSV. Tenants already
+	// running maxPerTenant imports are skipped; among the others the tenant
+	// whose last turn started the longest ago goes first, then its import
+	// runnable the longest. It returns a nil operation when there is nothing
+	// to run.
+	ClaimNext(ctx context.Context, lease time.Duration, maxPerTenant int) (*ImportOperation, []byte, error)
+	// SaveProgress stores the counters, errors and status of an import and
+	// extends its lease. The inline CSV is dropped once the import completes.
+	SaveProgress(ctx context.Context, op *ImportOperation, lease time.Duration) error
+	// Yield stores the progress of a running import and releases its lease,
+	// making it runnable again
+	Yield(ctx context.Context, op *ImportOperation) error
+	// OthersWaiting reports whether a tenant other than tenantID has a
+	// runnable import
+	OthersWaiting(ctx context.Context, tenantID string) (bool, error)
+	// QueueStats describes the runnable imports waiting for a worker
+	QueueStats(ctx context.Context) (*ImportQueueStats, error)
+}
+
+// ImportSource reads CSV documents referenced by an import's source URL
+type ImportSource interface {
+	Validate(sourceURL string) error
+	Fetch(ctx context.Context, sourceURL string) ([]byte, error)
+}
+
+// importRow is one parsed CSV data row
+type importRow struct {
+	Row       int32
+	FirstName string
+	LastName  string
+	Emails    []string
+}
+
+// ImportUsecase runs bulk employee imports
+type ImportUsecase struct {
+	imports   ImportRepo
+	repo      EmployeeRepo
+	events    *EventBus
+	source    ImportSource
+	clock     Clock
+	ids       IDGenerator
+	review    *ReviewPolicy
+	emails    *EmailPolicy
+	batchSize int
+	maxRows   int
+	// Fair scheduling between tenants, see ClaimNext
+	maxPerTenant   int
+	batchesPerTurn int
+	tenantWeights  map[string]int32
+	log            *log.Helper
+}
+
+// NewImportUsecase creates a new Import usecase. source may be nil, in which
+// case only inline CSV imports are accepted.
+func NewImportUsecase(imports ImportRepo, repo EmployeeRepo, events *EventBus, source ImportSource, clock Clock, ids IDGenerator, review *ReviewPolicy, emails *EmailPolicy, c *conf.Admin, logger log.Logger) *ImportUsecase {
+	uc := &ImportUsecase{
+		imports:   imports,
+		repo:      repo,
+		events:    events,
+		source:    source,
+		clock:     clock,
+		ids:       ids,
+		review:    review,
+		emails:    emails,
+		batchSize: defaultImportBatchSize,
+		maxRows:   defaultImportMaxRows,
+
+		maxPerTenant:   defaultImportMaxPerTenant,
+		batchesPerTurn: defaultImportBatchesPerTurn,
+		tenantWeights:  c.GetImport().GetTenantWeights(),
+		log:            log.NewHelper(logger),
+	}
+	if n := c.GetImport().GetBatchSize(); n > 0 {
+		uc.batchSize = int(n)
+	}
+	if n := c.GetImport().GetMaxRows(); n > 0 {
+		uc.maxRows = int(n)
+	}
+	if n := c.GetImport().GetMaxPerTenant(); n > 0 {
+		uc.maxPerTenant = int(n)
+	}
+	if n := c.GetImport().GetBatchesPerTurn(); n > 0 {
+		uc.batchesPerTurn = int(n)
+	}
+	return uc
+}
+
+// StartImport queues an import of the inline CSV document or, when data is
+// empty, of the object at sourceURL. The import runs in the background.
+func (uc *ImportUsecase) StartImport(ctx context.Context, data []byte, sourceURL string) (*ImportOperation, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, _ := GetUserID(ctx)
+
+	if len(data) == 0 {
+		if uc.source == nil {
+			return nil, errors.BadRequest(v1.ErrorReason_INVALID_IMPORT_SOURCE.String(), "imports from object storage are not configured")
+		}
+		if err := uc.source.Validate(sourceURL); err != nil {
+			return nil, err
+		}
+	}
+
+	uc.log.WithContext(ctx).Infof("StartImport: tenant=%s, bytes=%d, source=%q", tenantID, len(data), sourceURL)
+
+	return uc.imports.Create(ctx, &ImportOperation{
+		ID:        uc.ids.NewID(),
+		TenantID:  tenantID,
+		Status:    ImportStatusPending,
+		SourceURL: sourceURL,
+		CreatedBy: userID,
+	}, data)
+}
+
+// GetImportStatus returns an import of the caller's tenant.
+func (uc *ImportUsecase) GetImportStatus(ctx context.Context, id uuid.UUID) (*ImportOperation, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.imports.Get(ctx, tenantID, id)
+}
+
+// RunNext claims and runs the next runnable import for one turn. It reports
+// whether an import was found.
+func (uc *ImportUsecase) RunNext(ctx context.Context) (bool, error) {
+	op, data, err := uc.imports.ClaimNext(ctx, importLease, uc.maxPerTenant)
+	if err != nil || op == nil {
+		return false, err
+	}
+
+	return true, uc.run(ctx, op, data)
+}
+
+// QueueStats describes the imports waiting for a worker.
+func (uc *ImportUsecase) QueueStats(ctx context.Context) (*ImportQueueStats, error) {
+	return uc.imports.QueueStats(ctx)
+}
+
+// turnBatches is the number of batches the imports of a tenant run per turn,
+// its weight times batchesPerTurn
+func (uc *ImportUsecase) turnBatches(tenantID string) int {
+	if w := uc.tenantWeights[tenantID]; w > 0 {
+		return int(w) * uc.batchesPerTurn
+	}
+	return uc.batchesPerTurn
+}
+
+// run imports the rows of op that have not been processed yet. Progress is
+// saved after every batch, so an interrupted import resumes where it stopped.
+// After each turn of batches the import yields when other tenants' imports
+// are waiting, and is resumed later from its saved progress.
+func (uc *ImportUsecase) run(ctx context.Context, op *ImportOperation, data []byte) error {
+	// Mutations are audited and published as the user who started the import
+	ctx = WithTenantID(ctx, op.TenantID)
+	ctx = WithUserID(ctx, op.CreatedBy)
+	ctx = WithRequestID(ctx, "import-"+op.ID.String())
+	ctx = WithSource(ctx, SourceImport)
+
+	uc.log.WithContext(ctx).Infof("running import %s: tenant=%s, processed=%d", op.ID, op.TenantID, op.ProcessedRows)
+
+	if len(data) == 0 && op.SourceURL != "" {
+		if uc.source == nil {
+			return uc.fail(ctx, op, "imports from object storage are not configured")
+		}
+		var err error
+		if data, err = uc.source.Fetch(ctx, op.SourceURL); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return uc.fail(ctx, op, fmt.Sprintf("read %s: %v", op.SourceURL, err))
+		}
+	}
+
+	rows, err := parseImportCSV(data, uc.maxRows)
+	if err != nil {
+		return uc.fail(ctx, op, err.Error())
+	}
+	// Emails are normalized one by one, so that a row listing an email twice
+	// is still reported
+	for _, row := range rows {
+		for i, email := range row.Emails {
+			row.Emails[i] = uc.emails.Normalize(op.TenantID, email)
+		}
+	}
+
+	settings, err := uc.emails.TenantSettings(ctx, op.TenantID)
+	if err != nil {
+		return err
+	}
+
+	op.Status = ImportStatusRunning
+	op.TotalRows = int32(len(rows))
+	op.ProcessedRows = min(op.ProcessedRows, op.TotalRows)
+
+	// Emails of rows handled before a restart still count as seen
+	seen := make(map[string]int32)
+	for _, row := range rows[:op.ProcessedRows] {
+		for _, email := range row.Emails {
+			if _, ok := seen[email]; !ok {
+				seen[email] = row.Row
+			}
+		}
+	}
+
+	turn := uc.turnBatches(op.TenantID)
+	batches := 0
+	for start := int(op.ProcessedRows); start < len(rows); start += uc.batchSize {
+		if batches == turn {
+			others, err := uc.imports.OthersWaiting(ctx, op.TenantID)
+			if err != nil {
+				return err
+			}
+			if others {
+				uc.log.WithContext(ctx).Infof("import %s yields after %d rows", op.ID, op.ProcessedRows)
+				return uc.imports.Yield(ctx, op)
+			}
+			batches = 0
+		}
+		batches++
+
+		end := min(start+uc.batchSize, len(rows))
+		reported := len(op.Errors)
+		if err := uc.importBatch(ctx, op, rows[start:end], seen, settings); err != nil {
+			return err
+		}
+		// Report the batch's errors in row order
+		batchErrors := op.Errors[reported:]
+		sort.SliceStable(batchErrors, func(i, j int) bool { return batchErrors[i].Row < batchErrors[j].Row })
+		op.ProcessedRows = int32(end)
+		if err := uc.imports.SaveProgress(ctx, op, importLease); err != nil {
+			return err
+		}
+	}
+
+	now := uc.clock.Now().UTC()
+	op.Status = ImportStatusSucceeded
+	op.CompletedAt = &now
+	uc.log.WithContext(ctx).Infof("import %s finished: created=%d, failed=%d", op.ID, op.CreatedCount, op.FailedCount)
+	return uc.imports.SaveProgress(ctx, op, importLease)
+}
+
+// fail marks the whole import as failed
+func (uc *ImportUsecase) fail(ctx context.Context, op *ImportOperation, message string) error {
+	now := uc.clock.Now().UTC()
+	op.Status = ImportStatusFailed
+	op.Error = message
+	op.CompletedAt = &now
+	uc.log.WithContext(ctx).Warnf("import %s failed: %s", op.ID, message)
+	return uc.imports.SaveProgress(ctx, op, importLease)
+}
+
+// importBatch validates a batch of rows and creates the valid ones in a
+// single transaction, falling back to one transaction per row when the
+// batch is rejected so that a single conflicting row does not fail the rest.
+// Rows with emails outside the domains settings allows, or failing the
+// enforced strict email validation, fail.
+func (uc *ImportUsecase) importBatch(ctx context.Context, op *ImportOperation, rows []importRow, seen map[string]int32, settings *TenantSettings) error {
+	var candidates []importRow
+	var emails []string
+	for _, row := range rows {
+		if msg := validateImportRow(row); msg != "" {
+			op.addRowError(row.Row, firstEmail(row), msg)
+			continue
+		}
+		if err := uc.emails.CheckStrict(ctx, op.TenantID, row.Emails); err != nil {
+			op.addRowError(row.Row, firstEmail(row), errors.FromError(err).Message)
+			continue
+		}
+		if email := firstNotAllowed(row, settings); email != "" {
+			op.addRowError(row.Row, firstEmail(row), fmt.Sprintf("the domain of %s is not allowed", email))
+			continue
+		}
+		if msg := checkImportDuplicates(row, seen); msg != "" {
+			op.addRowError(row.Row, firstEmail(row), msg)
+			continue
+		}
+		candidates = append(candidates, row)
+		emails = append(emails, row.Emails...)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	taken, err := uc.repo.CheckEmailsExist(ctx, op.TenantID, emails)
+	if err != nil {
+		return err
+	}
+
+	var valid []importRow
+	var employees []*Employee
+	for _, row := range candidates {
+		if email := firstTaken(row, taken); email != "" {
+			op.addRowError(row.Row, firstEmail(row), fmt.Sprintf("email %s already exists", email))
+			continue
+		}
+		valid = append(valid, row)
+		employees = append(employees, &Employee{
+			ID:           uc.ids.NewID(),
+			TenantID:     op.TenantID,
+			Emails:       row.Emails,
+			FirstName:    row.FirstName,
+			LastName:     row.LastName,
+			ReviewStatus: uc.review.InitialStatus(ChannelImport),
+		})
+	}
+	if len(employees) == 0 {
+		return nil
+	}
+
+	created, err := uc.repo.CreateMany(ctx, op.TenantID, employees)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("import %s: batch insert failed, retrying rows one by one: %v", op.ID, err)
+		created = nil
+		for i, employee := range employees {
+			one, err := uc.repo.Create(ctx, op.TenantID, employee)
+			if errors.Is(err, ErrEmployeeAlreadyExists) {
+				op.addRowError(valid[i].Row, firstEmail(valid[i]), "email already exists")
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			created = append(created, one)
+		}
+	}
+	op.CreatedCount += int32(len(created))
+
+	// Publish events (best-effort)
+	for _, employee := range created {
+		uc.events.Publish(ctx, &DomainEvent{Type: EventEmployeeCreated, TenantID: op.TenantID, UserID: op.CreatedBy, Employee: employee})
+	}
+
+	return nil
+}
+
+// parseImportCSV reads the header and data rows of an import document
+func parseImportCSV(data []byte, maxRows int) ([]importRow, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.TrimLeadingSpace = true
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("csv is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv: %w", err)
+	}
+
+	columns := map[string]int{"first_name": -1, "last_name": -1, "emails": -1}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, ok := columns[name]; ok {
+			columns[name] = i
+		}
+	}
+	for _, name := range []string{"first_name", "last_name", "emails"} {
+		if columns[name] < 0 {
+			return nil, fmt.Errorf("csv header is missing the %s column", name)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		if i := columns[name]; i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var rows []importRow
+	for n := int32(1); ; n++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv: %w", err)
+		}
+		if len(rows) == maxRows {
+			return nil, fmt.Errorf("csv has more than %d rows", maxRows)
+		}
+
+		var emails []string
+		for _, email := range strings.Split(field(record, "emails"), ";") {
+			if email = strings.TrimSpace(email); email != "" {
+				emails = append(emails, email)
+			}
+		}
+		rows = append(rows, importRow{
+			Row:       n,
+			FirstName: field(record, "first_name"),
+			LastName:  field(record, "last_name"),
+			Emails:    emails,
+		})
+	}
+}
+
+// validateImportRow applies the CreateEmployee validation rules to a row,
+// returning a message describing the first violation
+func validateImportRow(row importRow) string {
+	if len(row.Emails) == 0 {
+		return "at least one email is required"
+	}
+	if len(row.Emails) > maxImportEmails {
+		return fmt.Sprintf("at most %d emails are allowed", maxImportEmails)
+	}
+	for _, email := range row.Emails {
+		addr, err := mail.ParseAddress(email)
+		if err != nil || addr.Address != email || len(email) < 3 || len(email) > 255 {
+			return fmt.Sprintf("invalid email %q", email)
+		}
+	}
+	if !validImportName(row.FirstName) {
+		return fmt.Sprintf("invalid first_name %q", row.FirstName)
+	}
+	if !validImportName(row.LastName) {
+		return fmt.Sprintf("invalid last_name %q", row.LastName)
+	}
+	return ""
+}
+
+// validImportName applies the CreateEmployee name rules
+func validImportName(name string) bool {
+	return name != "" && len(name) <= 100 && importNamePattern.MatchString(name)
+}
+
+// checkImportDuplicates rejects rows that repeat an email of an earlier row
+// and records the row's emails as seen otherwise
+func checkImportDuplicates(row importRow, seen map[string]int32) string {
+	for i, email := range row.Emails {
+		if first, ok := seen[email]; ok {
+			return fmt.Sprintf("email %s duplicates row %d", email, first)
+		}
+		for _, other := range row.Emails[:i] {
+			if other == email {
+				return fmt.Sprintf("email %s is listed twice", email)
+			}
+		}
+	}
+	for _, email := range row.Emails {
+		seen[email] = row.Row
+	}
+	return ""
+}
+
+// firstTaken returns the first email of row found in taken, or ""
+func firstTaken(row importRow, taken map[string]bool) string {
+	for _, email := range row.Emails {
+		if taken[email] {
+			return email
+		}
+	}
+	return ""
+}
+
+// firstNotAllowed returns the first email of row outside the domains settings
+// allows, or ""
+func firstNotAllowed(row importRow, settings *TenantSettings) string {
+	for _, email := range row.Emails {
+		if !settings.AllowsEmail(email) {
+			return email
+		}
+	}
+	return ""
+}
+
+// firstEmail returns the first email of row, or ""
+func firstEmail(row importRow) string {
+	if len(row.Emails) == 0 {
+		return ""
+	}
+	return row.Emails[0]
+}
@@ -0,0 +1,288 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockImportRepo is a mock implementation of ImportRepo
+type MockImportRepo struct {
+	mock.Mock
+}
+
+func (m *MockImportRepo) Create(ctx context.Context, op *ImportOperation, csv []byte) (*ImportOperation, error) {
+	args := m.Called(ctx, op, csv)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ImportOperation), args.Error(1)
+}
+
+func (m *MockImportRepo) Get(ctx context.Context, tenantID string, id uuid.UUID) (*ImportOperation, error) {
+	args := m.Called(ctx, tenantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ImportOperation), args.Error(1)
+}
+
+func (m *MockImportRepo) ClaimNext(ctx context.Context, lease time.Duration, maxPerTenant int) (*ImportOperation, []byte, error) {
+	args := m.Called(ctx, lease, maxPerTenant)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*ImportOperation), args.Get(1).([]byte), args.Error(2)
+}
+
+func (m *MockImportRepo) SaveProgress(ctx context.Context, op *ImportOperation, lease time.Duration) error {
+	// Record a copy, the usecase keeps mutating op
+	snapshot := *op
+	args := m.Called(ctx, &snapshot, lease)
+	return args.Error(0)
+}
+
+func (m *MockImportRepo) Yield(ctx context.Context, op *ImportOperation) error {
+	snapshot := *op
+	args := m.Called(ctx, &snapshot)
+	return args.Error(0)
+}
+
+func (m *MockImportRepo) OthersWaiting(ctx context.Context, tenantID string) (bool, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockImportRepo) QueueStats(ctx context.Context) (*ImportQueueStats, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ImportQueueStats), args.Error(1)
+}
+
+func newTestImportUsecase(imports ImportRepo, repo EmployeeRepo, batchSize int32) *ImportUsecase {
+	return NewImportUsecase(imports, repo, nil, nil, NewSystemClock(), NewRandomIDGenerator(), nil, nil, &conf.Admin{Import: &conf.Admin_Import{BatchSize: batchSize}}, log.NewStdLogger(io.Discard))
+}
+
+func TestParseImportCSV(t *testing.T) {
+	rows, err := parseImportCSV([]byte("\ufeffEmails,first_name,last_name\n a@example.com; b@example.com ,Ada,Lovelace\n,Alan,Turing\n"), 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, []importRow{
+		{Row: 1, FirstName: "Ada", LastName: "Lovelace", Emails: []string{"a@example.com", "b@example.com"}},
+		{Row: 2, FirstName: "Alan", LastName: "Turing"},
+	}, rows)
+}
+
+func TestParseImportCSV_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		csv  string
+		want string
+	}{
+		{name: "empty", csv: "", want: "csv is empty"},
+		{name: "missing column", csv: "first_name,emails\nAda,a@example.com\n", want: "missing the last_name column"},
+		{name: "too many rows", csv: "first_name,last_name,emails\nA,B,a@x.io\nC,D,c@x.io\n", want: "more than 1 rows"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseImportCSV([]byte(tt.csv), 1)
+			assert.ErrorContains(t, err, tt.want)
+		})
+	}
+}
+
+func TestValidateImportRow(t *testing.T) {
+	valid := importRow{FirstName: "Ada", LastName: "Lovelace", Emails: []string{"ada@example.com"}}
+	assert.Empty(t, validateImportRow(valid))
+
+	noEmail := valid
+	noEmail.Emails = nil
+	assert.Contains(t, validateImportRow(noEmail), "at least one email")
+
+	badEmail := valid
+	badEmail.Emails = []string{"Ada <ada@example.com>"}
+	assert.Contains(t, validateImportRow(badEmail), "invalid email")
+
+	badName := valid
+	badName.LastName = "L0velace"
+	assert.Contains(t, validateImportRow(badName), "invalid last_name")
+}
+
+func TestRunImport(t *testing.T) {
+	imports := new(MockImportRepo)
+	repo := new(MockEmployeeRepo)
+	uc := newTestImportUsecase(imports, repo, 10)
+
+	op := &ImportOperation{ID: uuid.New(), TenantID: "tenant-123", Status: ImportStatusRunning, CreatedBy: "user-1"}
+	csv := "first_name,last_name,emails\n" +
+		"Ada,Lovelace,ada@example.com\n" + // created
+		"Alan,Turing,taken@example.com\n" + // already exists
+		"Grace,Hopper,ada@example.com\n" + // duplicate of row 1
+		"Bad1,Name,bad@example.com\n" // invalid name
+
+	imports.On("ClaimNext", mock.Anything, importLease, defaultImportMaxPerTenant).Return(op, []byte(csv), nil)
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"ada@example.com", "taken@example.com"}).
+		Return(map[string]bool{"ada@example.com": false, "taken@example.com": true}, nil)
+	repo.On("CreateMany", mock.Anything, "tenant-123", mock.MatchedBy(func(employees []*Employee) bool {
+		return len(employees) == 1 && employees[0].FirstName == "Ada"
+	})).Return([]*Employee{{ID: uuid.New(), FirstName: "Ada"}}, nil)
+	imports.On("SaveProgress", mock.Anything, mock.MatchedBy(func(op *ImportOperation) bool {
+		return op.Status == ImportStatusRunning && op.ProcessedRows == 4
+	}), importLease).Return(nil)
+	imports.On("SaveProgress", mock.Anything, mock.MatchedBy(func(op *ImportOperation) bool {
+		return op.Status == ImportStatusSucceeded && op.CompletedAt != nil
+	}), importLease).Return(nil)
+
+	found, err := uc.RunNext(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, int32(4), op.TotalRows)
+	assert.Equal(t, int32(1), op.CreatedCount)
+	assert.Equal(t, int32(3), op.FailedCount)
+	assert.Equal(t, []ImportRowError{
+		{Row: 2, Email: "taken@example.com", Message: "email taken@example.com already exists"},
+		{Row: 3, Email: "ada@example.com", Message: "email ada@example.com duplicates row 1"},
+		{Row: 4, Email: "bad@example.com", Message: `invalid first_name "Bad1"`},
+	}, op.Errors)
+	imports.AssertExpectations(t)
+	repo.AssertExpectations(t)
+}
+
+func TestRunImport_BatchConflictFallsBackToRows(t *testing.T) {
+	imports := new(MockImportRepo)
+	repo := new(MockEmployeeRepo)
+	uc := newTestImportUsecase(imports, repo, 10)
+
+	op := &ImportOperation{ID: uuid.New(), TenantID: "tenant-123"}
+	csv := "first_name,last_name,emails\nAda,Lovelace,ada@example.com\nAlan,Turing,alan@example.com\n"
+
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", mock.Anything).Return(map[string]bool{}, nil)
+	repo.On("CreateMany", mock.Anything, "tenant-123", mock.Anything).Return(nil, ErrEmployeeAlreadyExists)
+	repo.On("Create", mock.Anything, "tenant-123", mock.MatchedBy(func(e *Employee) bool { return e.FirstName == "Ada" })).
+		Return(&Employee{FirstName: "Ada"}, nil)
+	repo.On("Create", mock.Anything, "tenant-123", mock.MatchedBy(func(e *Employee) bool { return e.FirstName == "Alan" })).
+		Return(nil, ErrEmployeeAlreadyExists)
+	imports.On("SaveProgress", mock.Anything, mock.Anything, importLease).Return(nil)
+
+	err := uc.run(context.Background(), op, []byte(csv))
+
+	require.NoError(t, err)
+	assert.Equal(t, ImportStatusSucceeded, op.Status)
+	assert.Equal(t, int32(1), op.CreatedCount)
+	assert.Equal(t, []ImportRowError{{Row: 2, Email: "alan@example.com", Message: "email already exists"}}, op.Errors)
+}
+
+func TestRunImport_Resumes(t *testing.T) {
+	imports := new(MockImportRepo)
+	repo := new(MockEmployeeRepo)
+	uc := newTestImportUsecase(imports, repo, 10)
+
+	// Row 1 was imported before the worker restarted
+	op := &ImportOperation{ID: uuid.New(), TenantID: "tenant-123", ProcessedRows: 1, CreatedCount: 1}
+	csv := "first_name,last_name,emails\nAda,Lovelace,ada@example.com\nAlan,Turing,ada@example.com\n"
+
+	imports.On("SaveProgress", mock.Anything, mock.Anything, importLease).Return(nil)
+
+	err := uc.run(context.Background(), op, []byte(csv))
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), op.CreatedCount)
+	assert.Equal(t, []ImportRowError{{Row: 2, Email: "ada@example.com", Message: "email ada@example.com duplicates row 1"}}, op.Errors)
+	repo.AssertNotCalled(t, "CreateMany", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRunImport_YieldsToOtherTenants(t *testing.T) {
+	imports := new(MockImportRepo)
+	repo := new(MockEmployeeRepo)
+	uc := NewImportUsecase(imports, repo, nil, nil, NewSystemClock(), NewRandomIDGenerator(), nil, nil, &conf.Admin{Import: &conf.Admin_Import{
+		BatchSize:      1,
+		BatchesPerTurn: 1,
+		TenantWeights:  map[string]int32{"tenant-big": 2},
+	}}, log.NewStdLogger(io.Discard))
+
+	op := &ImportOperation{ID: uuid.New(), TenantID: "tenant-big"}
+	csv := "first_name,last_name,emails\nAda,Lovelace,ada@example.com\nAlan,Turing,alan@example.com\n" +
+		"Grace,Hopper,grace@example.com\nEdsger,Dijkstra,edsger@example.com\nBarbara,Liskov,barbara@example.com\n"
+
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-big", mock.Anything).Return(map[string]bool{}, nil)
+	repo.On("CreateMany", mock.Anything, "tenant-big", mock.Anything).Return([]*Employee{{}}, nil)
+	imports.On("SaveProgress", mock.Anything, mock.Anything, importLease).Return(nil)
+	// Turns are two batches long; nobody waits after the first one, so the
+	// import keeps its worker
+	imports.On("OthersWaiting", mock.Anything, "tenant-big").Return(false, nil).Once()
+	imports.On("OthersWaiting", mock.Anything, "tenant-big").Return(true, nil).Once()
+	imports.On("Yield", mock.Anything, mock.MatchedBy(func(op *ImportOperation) bool {
+		return op.Status == ImportStatusRunning && op.ProcessedRows == 4 && op.CreatedCount == 4
+	})).Return(nil)
+
+	err := uc.run(context.Background(), op, []byte(csv))
+
+	require.NoError(t, err)
+	assert.Nil(t, op.CompletedAt, "the yielded import is resumed by a later turn")
+	imports.AssertExpectations(t)
+	repo.AssertNumberOfCalls(t, "CreateMany", 4)
+}
+
+func TestRunImport_InvalidCSVFailsImport(t *testing.T) {
+	imports := new(MockImportRepo)
+	uc := newTestImportUsecase(imports, new(MockEmployeeRepo), 10)
+
+	op := &ImportOperation{ID: uuid.New(), TenantID: "tenant-123"}
+	imports.On("SaveProgress", mock.Anything, mock.MatchedBy(func(op *ImportOperation) bool {
+		return op.Status == ImportStatusFailed && op.Error == "csv header is missing the emails column"
+	}), importLease).Return(nil)
+
+	err := uc.run(context.Background(), op, []byte("first_name,last_name\nAda,Lovelace\n"))
+
+	require.NoError(t, err)
+	imports.AssertExpectations(t)
+}
+
+func TestStartImport_SourceURLWithoutStore(t *testing.T) {
+	imports := new(MockImportRepo)
+	uc := newTestImportUsecase(imports, new(MockEmployeeRepo), 0)
+
+	_, err := uc.StartImport(WithTenantID(context.Background(), "tenant-123"), nil, "s3://bucket/employees.csv")
+
+	assert.Equal(t, "INVALID_IMPORT_SOURCE", kerrors.Reason(err))
+	imports.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRunNext_NothingToRun(t *testing.T) {
+	imports := new(MockImportRepo)
+	uc := newTestImportUsecase(imports, new(MockEmployeeRepo), 0)
+
+	imports.On("ClaimNext", mock.Anything, importLease, defaultImportMaxPerTenant).Return(nil, nil, nil)
+
+	found, err := uc.RunNext(context.Background())
+
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestRunNext_ClaimError(t *testing.T) {
+	imports := new(MockImportRepo)
+	uc := newTestImportUsecase(imports, new(MockEmployeeRepo), 0)
+
+	imports.On("ClaimNext", mock.Anything, importLease, defaultImportMaxPerTenant).Return(nil, nil, errors.New("db down"))
+
+	found, err := uc.RunNext(context.Background())
+
+	assert.EqualError(t, err, "db down")
+	assert.False(t, found)
+}
@@ -0,0 +1,103 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrAllTenantsForbidden is a request for the in-flight requests of all
+// tenants by a caller without one of the configured roles
+var ErrAllTenantsForbidden = errors.Forbidden("FORBIDDEN", "listing the requests of all tenants is not permitted for the caller's roles")
+
+// InFlightRequest is a request being served
+type InFlightRequest struct {
+	Operation string
+	TenantID  string
+	// TraceID is empty when the request is not traced
+	TraceID   string
+	RequestID string
+	StartedAt time.Time
+	// Elapsed is how long the request had been running when listed
+	Elapsed time.Duration
+}
+
+// InFlightRegistry tracks the requests this instance is serving, so that
+// they can be listed during incidents
+type InFlightRegistry struct {
+	clock           Clock
+	allTenantsRoles []string
+
+	mu       sync.Mutex
+	next     uint64
+	requests map[uint64]*InFlightRequest
+}
+
+// NewInFlightRegistry creates the registry of in-flight requests
+func NewInFlightRegistry(clock Clock, c *conf.Admin) *InFlightRegistry {
+	return &InFlightRegistry{
+		clock:           clock,
+		allTenantsRoles: c.GetInFlight().GetAllTenantsRoles(),
+		requests:        make(map[uint64]*InFlightRequest),
+	}
+}
+
+// Start registers a request until the returned function is called
+func (r *InFlightRegistry) Start(operation, tenantID, traceID, requestID string) func() {
+	req := &InFlightRequest{
+		Operation: operation,
+		TenantID:  tenantID,
+		TraceID:   traceID,
+		RequestID: requestID,
+		StartedAt: r.clock.Now(),
+	}
+
+	r.mu.Lock()
+	r.next++
+	id := r.next
+	r.requests[id] = req
+	r.mu.Unlock()
+
+	return func() {
+		r.mu.Lock()
+		delete(r.requests, id)
+		r.mu.Unlock()
+	}
+}
+
+// List returns the in-flight requests of the caller's tenant, or of all
+// tenants with allTenants, longest running first
+func (r *InFlightRegistry) List(ctx context.Context, allTenants bool) ([]*InFlightRequest, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if allTenants && !slices.ContainsFunc(GetRoles(ctx), func(role string) bool {
+		return slices.Contains(r.allTenantsRoles, role)
+	}) {
+		return nil, ErrAllTenantsForbidden
+	}
+
+	now := r.clock.Now()
+	r.mu.Lock()
+	requests := make([]*InFlightRequest, 0, len(r.requests))
+	for _, req := range r.requests {
+		if !allTenants && req.TenantID != tenantID {
+			continue
+		}
+		listed := *req
+		listed.Elapsed = now.Sub(req.StartedAt)
+		requests = append(requests, &listed)
+	}
+	r.mu.Unlock()
+
+	slices.SortFunc(requests, func(a, b *InFlightRequest) int {
+		return a.StartedAt.Compare(b.StartedAt)
+	})
+	return requests, nil
+}
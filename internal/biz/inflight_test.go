@@ -0,0 +1,58 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInFlightRegistry_List(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	registry := NewInFlightRegistry(ClockFunc(func() time.Time { return now }), &conf.Admin{
+		InFlight: &conf.Admin_InFlight{AllTenantsRoles: []string{"operator"}},
+	})
+
+	doneSlow := registry.Start("/employee.v1.EmployeeService/ListEmployees", "tenant-1", "trace-1", "req-1")
+	now = now.Add(2 * time.Second)
+	registry.Start("/employee.v1.EmployeeService/GetEmployee", "tenant-2", "", "req-2")
+	now = now.Add(time.Second)
+	ctx := WithTenantID(context.Background(), "tenant-1")
+
+	t.Run("tenant", func(t *testing.T) {
+		requests, err := registry.List(ctx, false)
+
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+		assert.Equal(t, "req-1", requests[0].RequestID)
+		assert.Equal(t, 3*time.Second, requests[0].Elapsed)
+	})
+
+	t.Run("all tenants", func(t *testing.T) {
+		requests, err := registry.List(WithRoles(ctx, []string{"operator"}), true)
+
+		require.NoError(t, err)
+		require.Len(t, requests, 2)
+		assert.Equal(t, "req-1", requests[0].RequestID, "longest running first")
+		assert.Equal(t, time.Second, requests[1].Elapsed)
+	})
+
+	t.Run("all tenants forbidden", func(t *testing.T) {
+		_, err := registry.List(WithRoles(ctx, []string{"admin"}), true)
+
+		assert.Equal(t, ErrAllTenantsForbidden, err)
+	})
+
+	t.Run("done", func(t *testing.T) {
+		doneSlow()
+
+		requests, err := registry.List(ctx, false)
+
+		require.NoError(t, err)
+		assert.Empty(t, requests)
+	})
+}
@@ -0,0 +1,80 @@
+package biz
+
+import (
+	"context"
+	"slices"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrManagerNotFound is a manager that does not exist in the tenant
+	ErrManagerNotFound = errors.NotFound(v1.ErrorReason_MANAGER_NOT_FOUND.String(), "manager not found")
+	// ErrInvalidManager is a manager that is the employee itself or reports
+	// to the employee, which would make the hierarchy cyclic
+	ErrInvalidManager = errors.BadRequest(v1.ErrorReason_INVALID_MANAGER.String(), "employee cannot be managed by itself or by one of its reports")
+)
+
+// validateManager checks that managerID may become the manager of the
+// employee: the manager exists in the tenant, and the employee is not in its
+// management chain. uuid.Nil, removing the manager, is always valid.
+func (uc *EmployeeUsecase) validateManager(ctx context.Context, tenantID string, employeeID, managerID uuid.UUID) error {
+	if managerID == uuid.Nil {
+		return nil
+	}
+	if managerID == employeeID {
+		return ErrInvalidManager
+	}
+
+	manager, err := uc.repo.GetByID(ctx, tenantID, managerID)
+	if errors.Is(err, ErrEmployeeNotFound) {
+		return ErrManagerNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	chain, err := uc.repo.GetManagementChain(ctx, tenantID, manager.ID)
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(chain, func(e *Employee) bool { return e.ID == employeeID }) {
+		return ErrInvalidManager
+	}
+	return nil
+}
+
+// ListDirectReports lists the employees managed by an employee of the
+// caller's tenant.
+func (uc *EmployeeUsecase) ListDirectReports(ctx context.Context, managerID uuid.UUID, filter *ListFilter) (*ListResult, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.repo.GetByID(ctx, tenantID, managerID); err != nil {
+		return nil, err
+	}
+
+	filter.ManagerID = &managerID
+	return uc.ListEmployees(ctx, filter)
+}
+
+// GetManagementChain returns the managers above an employee of the caller's
+// tenant, its direct manager first.
+func (uc *EmployeeUsecase) GetManagementChain(ctx context.Context, id uuid.UUID) ([]*Employee, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("GetManagementChain: tenant=%s, id=%s", tenantID, id)
+
+	if _, err := uc.repo.GetByID(ctx, tenantID, id); err != nil {
+		return nil, err
+	}
+	return uc.repo.GetManagementChain(ctx, tenantID, id)
+}
@@ -0,0 +1,111 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateEmployee_Manager(t *testing.T) {
+	employeeID := uuid.New()
+	managerID := uuid.New()
+	previousID := uuid.New()
+	existing := &Employee{ID: employeeID, TenantID: "tenant-123", FirstName: "John", LastName: "Doe", Version: 1, ManagerID: &previousID}
+
+	tests := []struct {
+		name      string
+		managerID uuid.UUID
+		setupMock func(*MockEmployeeRepo)
+		wantErr   error
+	}{
+		{
+			name:      "assign",
+			managerID: managerID,
+			setupMock: func(repo *MockEmployeeRepo) {
+				repo.On("GetByID", mock.Anything, "tenant-123", managerID).Return(&Employee{ID: managerID}, nil)
+				repo.On("GetManagementChain", mock.Anything, "tenant-123", managerID).Return([]*Employee{{ID: uuid.New()}}, nil)
+			},
+		},
+		{
+			name:      "remove",
+			managerID: uuid.Nil,
+			setupMock: func(*MockEmployeeRepo) {},
+		},
+		{
+			name:      "self",
+			managerID: employeeID,
+			setupMock: func(*MockEmployeeRepo) {},
+			wantErr:   ErrInvalidManager,
+		},
+		{
+			name:      "manager not found",
+			managerID: managerID,
+			setupMock: func(repo *MockEmployeeRepo) {
+				repo.On("GetByID", mock.Anything, "tenant-123", managerID).Return(nil, ErrEmployeeNotFound)
+			},
+			wantErr: ErrManagerNotFound,
+		},
+		{
+			name:      "manager reports to the employee",
+			managerID: managerID,
+			setupMock: func(repo *MockEmployeeRepo) {
+				repo.On("GetByID", mock.Anything, "tenant-123", managerID).Return(&Employee{ID: managerID}, nil)
+				repo.On("GetManagementChain", mock.Anything, "tenant-123", managerID).Return([]*Employee{{ID: uuid.New()}, {ID: employeeID}}, nil)
+			},
+			wantErr: ErrInvalidManager,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo := setupUsecase()
+			pub := new(MockEventPublisher)
+			uc.events = newTestEventBus(pub)
+
+			repo.On("GetByID", mock.Anything, "tenant-123", employeeID).Return(existing, nil)
+			tt.setupMock(repo)
+			repo.On("Update", mock.Anything, "tenant-123", mock.Anything).Return(existing, nil)
+			pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "", existing, mock.Anything, mock.Anything).Return(nil)
+
+			_, err := uc.UpdateEmployee(WithTenantID(context.Background(), "tenant-123"), &Employee{ID: employeeID, Version: 1, ManagerID: &tt.managerID})
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			pub.AssertCalled(t, "PublishEmployeeUpdated", mock.Anything, "tenant-123", "", existing, []string{"manager_id"}, EmailChanges{})
+		})
+	}
+}
+
+func TestGetManagementChain(t *testing.T) {
+	uc, repo := setupUsecase()
+	id := uuid.New()
+	chain := []*Employee{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(&Employee{ID: id}, nil)
+	repo.On("GetManagementChain", mock.Anything, "tenant-123", id).Return(chain, nil)
+
+	got, err := uc.GetManagementChain(WithTenantID(context.Background(), "tenant-123"), id)
+
+	require.NoError(t, err)
+	assert.Equal(t, chain, got)
+}
+
+func TestListDirectReports_ManagerNotFound(t *testing.T) {
+	uc, repo := setupUsecase()
+	id := uuid.New()
+
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(nil, ErrEmployeeNotFound)
+
+	_, err := uc.ListDirectReports(WithTenantID(context.Background(), "tenant-123"), id, &ListFilter{})
+
+	assert.Equal(t, ErrEmployeeNotFound, err)
+	repo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything)
+}
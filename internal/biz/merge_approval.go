@@ -0,0 +1,321 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// Merge approval statuses
+const (
+	MergeApprovalPending  = "pending"
+	MergeApprovalApproved = "approved"
+	MergeApprovalRejected = "rejected"
+	MergeApprovalFailed   = "failed"
+)
+
+// Criteria requiring the approval of a merge
+const (
+	MergeApprovalReasonHistoryEntries = "history_entries"
+	MergeApprovalReasonEmails         = "emails"
+)
+
+var (
+	// ErrMergeApprovalNotFound is returned when a merge approval does not
+	// exist in the tenant
+	ErrMergeApprovalNotFound = errors.NotFound(v1.ErrorReason_MERGE_APPROVAL_NOT_FOUND.String(), "merge approval not found")
+	// ErrMergeApprovalNotPending is a decision on a merge that was already
+	// approved, rejected or failed
+	ErrMergeApprovalNotPending = errors.BadRequest(v1.ErrorReason_MERGE_APPROVAL_NOT_PENDING.String(), "merge approval is not pending")
+	// ErrMergeSelfApproval is the approval of a merge by the user who
+	// requested it
+	ErrMergeSelfApproval = errors.Forbidden(v1.ErrorReason_MERGE_SELF_APPROVAL.String(), "a merge must be approved by another user than the one requesting it")
+	// ErrMergeDecisionImpersonated is a decision on a merge by an admin
+	// impersonating a user. Impersonation keeps the admin's roles, so the
+	// second user of the approval would not be the user deciding.
+	ErrMergeDecisionImpersonated = errors.Forbidden(v1.ErrorReason_MERGE_DECISION_IMPERSONATED.String(), "merges cannot be approved or rejected while impersonating a user")
+	// ErrMergeRequestImpersonated is a request for a merge needing approval
+	// by an admin impersonating a user. The approval would record the
+	// impersonated user as the requester, so the admin could approve it.
+	ErrMergeRequestImpersonated = errors.Forbidden(v1.ErrorReason_MERGE_REQUEST_IMPERSONATED.String(), "merges needing approval cannot be requested while impersonating a user")
+)
+
+// MergeApproval is a merge held until a second user approves it
+type MergeApproval struct {
+	ID          uuid.UUID
+	TenantID    string
+	PrimaryID   uuid.UUID
+	SecondaryID uuid.UUID
+	Status      string
+	// Reasons are the criteria the merge met
+	Reasons []string
+	// HistoryEntries and Emails are those of both employees when the merge
+	// was requested
+	HistoryEntries int64
+	Emails         int
+	RequestedBy    string
+	CreatedAt      time.Time
+	DecidedBy      string
+	DecidedAt      *time.Time
+	// MergeID is the merge performed on approval
+	MergeID *uuid.UUID
+	Error   string
+}
+
+// MergeApprovalFilter represents the options of a merge approval listing
+type MergeApprovalFilter struct {
+	// Status defaults to MergeApprovalPending
+	Status   string
+	Page     int32
+	PageSize int32
+}
+
+// MergeApprovalRepo stores merge approvals
+type MergeApprovalRepo interface {
+	Create(ctx context.Context, approval *MergeApproval) (*MergeApproval, error)
+	// Get returns an approval of the tenant, or ErrMergeApprovalNotFound
+	Get(ctx context.Context, tenantID string, id uuid.UUID) (*MergeApproval, error)
+	// List returns the approvals of a tenant, oldest first, and their total
+	List(ctx context.Context, tenantID string, filter *MergeApprovalFilter) ([]*MergeApproval, int64, error)
+	// Decide stores the status, decision, merge and error of an approval
+	// that is still pending. It returns ErrMergeApprovalNotPending otherwise.
+	Decide(ctx context.Context, approval *MergeApproval) (*MergeApproval, error)
+}
+
+// MergeApprovalUsecase holds merges of employees with large histories for
+// the approval of a second user, and performs them once approved
+type MergeApprovalUsecase struct {
+	approvals MergeApprovalRepo
+	audit     AuditRepo
+	employees *EmployeeUsecase
+	tx        Transaction
+	clock     Clock
+	ids       IDGenerator
+	// minHistoryEntries and minEmails are the thresholds, 0 when disabled
+	minHistoryEntries int64
+	minEmails         int
+	log               *log.Helper
+}
+
+// NewMergeApprovalUsecase creates a new MergeApproval usecase with the
+// thresholds configured in c.
+func NewMergeApprovalUsecase(c *conf.Admin, approvals MergeApprovalRepo, audit AuditRepo, employees *EmployeeUsecase, tx Transaction, clock Clock, ids IDGenerator, logger log.Logger) *MergeApprovalUsecase {
+	return &MergeApprovalUsecase{
+		approvals:         approvals,
+		audit:             audit,
+		employees:         employees,
+		tx:                tx,
+		clock:             clock,
+		ids:               ids,
+		minHistoryEntries: int64(c.GetMergeApproval().GetMinHistoryEntries()),
+		minEmails:         int(c.GetMergeApproval().GetMinEmails()),
+		log:               log.NewHelper(logger),
+	}
+}
+
+// enabled reports whether any merge can need approval
+func (uc *MergeApprovalUsecase) enabled() bool {
+	return uc != nil && (uc.minHistoryEntries > 0 || uc.minEmails > 0)
+}
+
+// RequestMerge holds a merge by email for approval when it meets the
+// thresholds. It returns nil when the merge needs no approval; the caller
+// merges right away then.
+func (uc *MergeApprovalUsecase) RequestMerge(ctx context.Context, primaryEmail, secondaryEmail string) (*MergeApproval, error) {
+	if !uc.enabled() {
+		return nil, nil
+	}
+	merge, err := uc.employees.PreviewMerge(ctx, primaryEmail, secondaryEmail)
+	if err != nil {
+		return nil, err
+	}
+	return uc.request(ctx, merge)
+}
+
+// RequestMergeByID holds a merge by ID for approval when it meets the
+// thresholds, see RequestMerge.
+func (uc *MergeApprovalUsecase) RequestMergeByID(ctx context.Context, primaryID, secondaryID uuid.UUID) (*MergeApproval, error) {
+	if !uc.enabled() {
+		return nil, nil
+	}
+	merge, err := uc.employees.PreviewMergeByID(ctx, primaryID, secondaryID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.request(ctx, merge)
+}
+
+// request stores a pending approval of a validated merge if it meets a
+// threshold. Impersonated callers cannot request approvals, so that an admin
+// cannot approve a merge they requested as another user.
+func (uc *MergeApprovalUsecase) request(ctx context.Context, merge *Merge) (*MergeApproval, error) {
+	var history int64
+	for _, id := range []uuid.UUID{merge.Primary.ID, merge.Secondary.ID} {
+		_, total, err := uc.audit.List(ctx, merge.TenantID, &AuditFilter{EmployeeID: &id, Page: 1, PageSize: 1})
+		if err != nil {
+			return nil, err
+		}
+		history += total
+	}
+	// The preview's primary already holds the emails of both employees
+	emails := len(merge.Primary.Emails)
+
+	var reasons []string
+	if uc.minHistoryEntries > 0 && history >= uc.minHistoryEntries {
+		reasons = append(reasons, MergeApprovalReasonHistoryEntries)
+	}
+	if uc.minEmails > 0 && emails >= uc.minEmails {
+		reasons = append(reasons, MergeApprovalReasonEmails)
+	}
+	if len(reasons) == 0 {
+		return nil, nil
+	}
+	if _, ok := GetImpersonator(ctx); ok {
+		return nil, ErrMergeRequestImpersonated
+	}
+
+	userID, _ := GetUserID(ctx)
+	uc.log.WithContext(ctx).Infof("RequestMerge: tenant=%s, primary=%s, secondary=%s, reasons=%v", merge.TenantID, merge.Primary.ID, merge.Secondary.ID, reasons)
+
+	return uc.approvals.Create(ctx, &MergeApproval{
+		ID:             uc.ids.NewID(),
+		TenantID:       merge.TenantID,
+		PrimaryID:      merge.Primary.ID,
+		SecondaryID:    merge.Secondary.ID,
+		Status:         MergeApprovalPending,
+		Reasons:        reasons,
+		HistoryEntries: history,
+		Emails:         emails,
+		RequestedBy:    userID,
+		CreatedAt:      uc.clock.Now().UTC(),
+	})
+}
+
+// ListMergeApprovals lists the merge approvals of the caller's tenant.
+func (uc *MergeApprovalUsecase) ListMergeApprovals(ctx context.Context, filter *MergeApprovalFilter) ([]*MergeApproval, int64, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if filter.Status == "" {
+		filter.Status = MergeApprovalPending
+	}
+	page := &ListFilter{Page: filter.Page, PageSize: filter.PageSize}
+	applyPagination(page)
+	filter.Page, filter.PageSize = page.Page, page.PageSize
+
+	return uc.approvals.List(ctx, tenantID, filter)
+}
+
+// ApproveMerge approves a pending merge of the caller's tenant and performs
+// it, as the approver. The requester cannot approve their own merge, and
+// impersonated callers cannot approve any. A merge that can no longer be
+// performed, e.g. because an employee was deleted in the meantime, is marked
+// failed and its error returned.
+func (uc *MergeApprovalUsecase) ApproveMerge(ctx context.Context, id uuid.UUID) (*MergeApproval, *Merge, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	userID, _ := GetUserID(ctx)
+	if _, ok := GetImpersonator(ctx); ok {
+		return nil, nil, ErrMergeDecisionImpersonated
+	}
+
+	approval, err := uc.approvals.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if approval.Status != MergeApprovalPending {
+		return nil, nil, ErrMergeApprovalNotPending
+	}
+	if userID == "" || userID == approval.RequestedBy {
+		return nil, nil, ErrMergeSelfApproval
+	}
+
+	uc.log.WithContext(ctx).Infof("ApproveMerge: tenant=%s, id=%s, primary=%s, secondary=%s", tenantID, id, approval.PrimaryID, approval.SecondaryID)
+
+	release, err := uc.employees.merges.Acquire(ctx, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	// The merge and the decision commit together, so that two approvers
+	// racing cannot both merge
+	var merge *Merge
+	var approved *MergeApproval
+	err = uc.tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		merge, err = uc.employees.repo.MergeEmployeesByID(ctx, tenantID, approval.PrimaryID, approval.SecondaryID)
+		if err != nil {
+			return err
+		}
+		decided := *approval
+		uc.decide(&decided, MergeApprovalApproved, userID)
+		decided.MergeID = &merge.ID
+		approved, err = uc.approvals.Decide(ctx, &decided)
+		return err
+	})
+	if err != nil {
+		if errors.FromError(err).Code >= 500 || errors.Is(err, ErrMergeApprovalNotPending) {
+			return nil, nil, err
+		}
+		failed := *approval
+		uc.decide(&failed, MergeApprovalFailed, userID)
+		failed.Error = errors.FromError(err).Message
+		if _, derr := uc.approvals.Decide(ctx, &failed); derr != nil {
+			return nil, nil, derr
+		}
+		return nil, nil, err
+	}
+
+	// merged_from_email names the secondary employee by its first email, as
+	// for merges by ID
+	var mergedFromEmail string
+	if len(merge.Secondary.Emails) > 0 {
+		mergedFromEmail = merge.Secondary.Emails[0]
+	}
+	uc.employees.publishMerged(ctx, tenantID, merge, mergedFromEmail)
+	return approved, merge, nil
+}
+
+// RejectMerge rejects a pending merge of the caller's tenant, leaving the
+// employees unchanged. The requester may reject their own merge to withdraw
+// it. Impersonated callers cannot reject merges, so that the decision is
+// recorded under the user who made it.
+func (uc *MergeApprovalUsecase) RejectMerge(ctx context.Context, id uuid.UUID) (*MergeApproval, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, _ := GetUserID(ctx)
+	if _, ok := GetImpersonator(ctx); ok {
+		return nil, ErrMergeDecisionImpersonated
+	}
+
+	approval, err := uc.approvals.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("RejectMerge: tenant=%s, id=%s", tenantID, id)
+
+	uc.decide(approval, MergeApprovalRejected, userID)
+	return uc.approvals.Decide(ctx, approval)
+}
+
+// decide records the decision of userID on an approval
+func (uc *MergeApprovalUsecase) decide(approval *MergeApproval, status, userID string) {
+	now := uc.clock.Now().UTC()
+	approval.Status = status
+	approval.DecidedBy = userID
+	approval.DecidedAt = &now
+}
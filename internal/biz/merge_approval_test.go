@@ -0,0 +1,235 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockMergeApprovalRepo is a mock implementation of MergeApprovalRepo
+type MockMergeApprovalRepo struct {
+	mock.Mock
+}
+
+func (m *MockMergeApprovalRepo) Create(ctx context.Context, approval *MergeApproval) (*MergeApproval, error) {
+	args := m.Called(ctx, approval)
+	return approval, args.Error(0)
+}
+
+func (m *MockMergeApprovalRepo) Get(ctx context.Context, tenantID string, id uuid.UUID) (*MergeApproval, error) {
+	args := m.Called(ctx, tenantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*MergeApproval), args.Error(1)
+}
+
+func (m *MockMergeApprovalRepo) List(ctx context.Context, tenantID string, filter *MergeApprovalFilter) ([]*MergeApproval, int64, error) {
+	args := m.Called(ctx, tenantID, filter)
+	return args.Get(0).([]*MergeApproval), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockMergeApprovalRepo) Decide(ctx context.Context, approval *MergeApproval) (*MergeApproval, error) {
+	args := m.Called(ctx, approval)
+	if err := args.Error(0); err != nil {
+		return nil, err
+	}
+	return approval, nil
+}
+
+func setupMergeApprovalUsecase(c *conf.Admin_MergeApproval) (*MergeApprovalUsecase, *MockMergeApprovalRepo, *MockAuditRepo, *MockEmployeeRepo, *MockEventPublisher) {
+	employees, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	employees.events = newTestEventBus(pub)
+	approvals := new(MockMergeApprovalRepo)
+	audit := new(MockAuditRepo)
+	uc := NewMergeApprovalUsecase(&conf.Admin{MergeApproval: c}, approvals, audit, employees, &fakeTransaction{},
+		ClockFunc(func() time.Time { return scheduleNow }),
+		IDGeneratorFunc(uuid.New),
+		log.NewStdLogger(io.Discard))
+	return uc, approvals, audit, repo, pub
+}
+
+func TestRequestMergeByID(t *testing.T) {
+	primaryID, secondaryID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		conf        *conf.Admin_MergeApproval
+		history     int64
+		wantReasons []string
+	}{
+		{name: "disabled", history: 1000},
+		{name: "below thresholds", conf: &conf.Admin_MergeApproval{MinHistoryEntries: 100, MinEmails: 4}, history: 40},
+		{name: "large history", conf: &conf.Admin_MergeApproval{MinHistoryEntries: 100}, history: 60, wantReasons: []string{MergeApprovalReasonHistoryEntries}},
+		{name: "many emails", conf: &conf.Admin_MergeApproval{MinHistoryEntries: 1000, MinEmails: 3}, history: 60, wantReasons: []string{MergeApprovalReasonEmails}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, approvals, audit, repo, _ := setupMergeApprovalUsecase(tt.conf)
+			repo.On("GetByID", mock.Anything, "tenant-123", primaryID).Return(&Employee{ID: primaryID, Emails: []string{"jane@example.com", "j.doe@example.com"}}, nil)
+			repo.On("GetByID", mock.Anything, "tenant-123", secondaryID).Return(&Employee{ID: secondaryID, Emails: []string{"jane.doe@example.com"}}, nil)
+			// Each employee has half of the history
+			audit.On("List", mock.Anything, "tenant-123", mock.Anything).Return([]*AuditEntry{}, tt.history, nil)
+			approvals.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+			approval, err := uc.RequestMergeByID(reviewContext(""), primaryID, secondaryID)
+
+			require.NoError(t, err)
+			if tt.wantReasons == nil {
+				assert.Nil(t, approval)
+				approvals.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			assert.Equal(t, tt.wantReasons, approval.Reasons)
+			assert.Equal(t, MergeApprovalPending, approval.Status)
+			assert.Equal(t, primaryID, approval.PrimaryID)
+			assert.Equal(t, secondaryID, approval.SecondaryID)
+			assert.Equal(t, 2*tt.history, approval.HistoryEntries)
+			assert.Equal(t, 3, approval.Emails)
+			assert.Equal(t, "user-456", approval.RequestedBy)
+		})
+	}
+}
+
+func TestRequestMergeByID_Impersonated(t *testing.T) {
+	primaryID, secondaryID := uuid.New(), uuid.New()
+	// admin-1 acts as user-456 to request the merge, so that they could
+	// approve it as themselves
+	ctx := WithImpersonator(reviewContext(""), Impersonator{TenantID: "support", UserID: "admin-1"})
+
+	tests := []struct {
+		name    string
+		history int64
+		wantErr error
+	}{
+		{name: "needing approval", history: 60, wantErr: ErrMergeRequestImpersonated},
+		{name: "needing no approval", history: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, approvals, audit, repo, _ := setupMergeApprovalUsecase(&conf.Admin_MergeApproval{MinHistoryEntries: 100})
+			repo.On("GetByID", mock.Anything, "tenant-123", primaryID).Return(&Employee{ID: primaryID, Emails: []string{"jane@example.com"}}, nil)
+			repo.On("GetByID", mock.Anything, "tenant-123", secondaryID).Return(&Employee{ID: secondaryID, Emails: []string{"jane.doe@example.com"}}, nil)
+			audit.On("List", mock.Anything, "tenant-123", mock.Anything).Return([]*AuditEntry{}, tt.history, nil)
+
+			approval, err := uc.RequestMergeByID(ctx, primaryID, secondaryID)
+
+			assert.Nil(t, approval)
+			assert.Equal(t, tt.wantErr, err)
+			approvals.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestApproveMerge(t *testing.T) {
+	id, primaryID, secondaryID := uuid.New(), uuid.New(), uuid.New()
+	pending := func() *MergeApproval {
+		return &MergeApproval{ID: id, TenantID: "tenant-123", PrimaryID: primaryID, SecondaryID: secondaryID, Status: MergeApprovalPending, RequestedBy: "user-1"}
+	}
+
+	t.Run("approved by another user", func(t *testing.T) {
+		uc, approvals, _, repo, pub := setupMergeApprovalUsecase(nil)
+		merge := &Merge{ID: uuid.New(), Primary: &Employee{ID: primaryID}, Secondary: &Employee{ID: secondaryID, Emails: []string{"jane.doe@example.com"}}}
+		approvals.On("Get", mock.Anything, "tenant-123", id).Return(pending(), nil)
+		repo.On("MergeEmployeesByID", mock.Anything, "tenant-123", primaryID, secondaryID).Return(merge, nil)
+		approvals.On("Decide", mock.Anything, mock.Anything).Return(nil)
+		pub.On("PublishEmployeeMerged", mock.Anything, "tenant-123", "user-456", merge, "jane.doe@example.com").Return(nil)
+
+		approval, result, err := uc.ApproveMerge(reviewContext(""), id)
+
+		require.NoError(t, err)
+		assert.Same(t, merge, result)
+		assert.Equal(t, MergeApprovalApproved, approval.Status)
+		assert.Equal(t, "user-456", approval.DecidedBy)
+		assert.Equal(t, &merge.ID, approval.MergeID)
+		pub.AssertExpectations(t)
+	})
+
+	t.Run("approved by the requester", func(t *testing.T) {
+		uc, approvals, _, repo, _ := setupMergeApprovalUsecase(nil)
+		approval := pending()
+		approval.RequestedBy = "user-456"
+		approvals.On("Get", mock.Anything, "tenant-123", id).Return(approval, nil)
+
+		_, _, err := uc.ApproveMerge(reviewContext(""), id)
+
+		assert.Equal(t, ErrMergeSelfApproval, err)
+		repo.AssertNotCalled(t, "MergeEmployeesByID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("approved while impersonating", func(t *testing.T) {
+		uc, approvals, _, repo, _ := setupMergeApprovalUsecase(nil)
+		approvals.On("Get", mock.Anything, "tenant-123", id).Return(pending(), nil).Maybe()
+		// The admin keeps their roles while acting as user-456
+		ctx := WithImpersonator(reviewContext(""), Impersonator{TenantID: "support", UserID: "admin-1"})
+
+		_, _, err := uc.ApproveMerge(ctx, id)
+
+		assert.Equal(t, ErrMergeDecisionImpersonated, err)
+		repo.AssertNotCalled(t, "MergeEmployeesByID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		approvals.AssertNotCalled(t, "Decide", mock.Anything, mock.Anything)
+	})
+
+	t.Run("not pending", func(t *testing.T) {
+		uc, approvals, _, repo, _ := setupMergeApprovalUsecase(nil)
+		approval := pending()
+		approval.Status = MergeApprovalRejected
+		approvals.On("Get", mock.Anything, "tenant-123", id).Return(approval, nil)
+
+		_, _, err := uc.ApproveMerge(reviewContext(""), id)
+
+		assert.Equal(t, ErrMergeApprovalNotPending, err)
+		repo.AssertNotCalled(t, "MergeEmployeesByID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("merge no longer possible", func(t *testing.T) {
+		uc, approvals, _, repo, pub := setupMergeApprovalUsecase(nil)
+		approvals.On("Get", mock.Anything, "tenant-123", id).Return(pending(), nil)
+		repo.On("MergeEmployeesByID", mock.Anything, "tenant-123", primaryID, secondaryID).Return(nil, ErrEmployeeNotFound)
+		approvals.On("Decide", mock.Anything, mock.MatchedBy(func(a *MergeApproval) bool {
+			return a.Status == MergeApprovalFailed && a.Error == ErrEmployeeNotFound.Message && a.DecidedBy == "user-456"
+		})).Return(nil)
+
+		_, _, err := uc.ApproveMerge(reviewContext(""), id)
+
+		assert.Equal(t, ErrEmployeeNotFound, err)
+		approvals.AssertExpectations(t)
+		pub.AssertNotCalled(t, "PublishEmployeeMerged", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestRejectMerge(t *testing.T) {
+	uc, approvals, _, repo, _ := setupMergeApprovalUsecase(nil)
+	id := uuid.New()
+	approvals.On("Get", mock.Anything, "tenant-123", id).Return(&MergeApproval{ID: id, TenantID: "tenant-123", Status: MergeApprovalPending, RequestedBy: "user-456"}, nil)
+	approvals.On("Decide", mock.Anything, mock.Anything).Return(nil)
+
+	approval, err := uc.RejectMerge(reviewContext(""), id)
+
+	require.NoError(t, err)
+	assert.Equal(t, MergeApprovalRejected, approval.Status)
+	assert.Equal(t, "user-456", approval.DecidedBy)
+	repo.AssertNotCalled(t, "MergeEmployeesByID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRejectMerge_Impersonated(t *testing.T) {
+	uc, approvals, _, _, _ := setupMergeApprovalUsecase(nil)
+	ctx := WithImpersonator(reviewContext(""), Impersonator{TenantID: "support", UserID: "admin-1"})
+
+	_, err := uc.RejectMerge(ctx, uuid.New())
+
+	assert.Equal(t, ErrMergeDecisionImpersonated, err)
+	approvals.AssertNotCalled(t, "Decide", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,195 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	// defaultMergeMaxQueued is the number of merges of a tenant waiting for
+	// a slot
+	defaultMergeMaxQueued = 10
+	// defaultMergeQueueTimeout is how long a merge waits for a slot
+	defaultMergeQueueTimeout = 5 * time.Second
+)
+
+// Reasons a merge is rejected by the MergeLimiter
+const (
+	MergeRejectedQueueFull = "queue_full"
+	MergeRejectedTimeout   = "timeout"
+)
+
+// ErrMergeConcurrencyExhausted is a merge rejected because its tenant runs
+// as many merges as allowed and the queue is full, or the merge waited too
+// long for a slot. It maps to RESOURCE_EXHAUSTED over gRPC.
+var ErrMergeConcurrencyExhausted = errors.New(429, v1.ErrorReason_MERGE_CONCURRENCY_EXHAUSTED.String(), "too many merges in progress for this tenant, retry later")
+
+// MergeLimitMetrics reports the merges of a MergeLimiter
+type MergeLimitMetrics interface {
+	// RecordMergeQueue reports the merges running and waiting for a slot
+	// across all tenants
+	RecordMergeQueue(running, queued int)
+	// RecordMergeRejected counts a merge rejected by reason, one of the
+	// MergeRejected constants
+	RecordMergeRejected(reason string)
+}
+
+// MergeLimiter limits the merges and unmerges of each tenant running at the
+// same time on this instance. Merges over the limit wait for a slot, up to a
+// queue length and a timeout.
+type MergeLimiter struct {
+	maxConcurrent int
+	maxQueued     int
+	timeout       time.Duration
+	// metrics is nil when merges are not reported
+	metrics MergeLimitMetrics
+
+	mu      sync.Mutex
+	tenants map[string]*mergeSlots
+	running int
+	queued  int
+}
+
+// mergeSlots are the slots of a tenant: a merge holds a slot by sending on
+// slots. users counts the merges holding or waiting for one, and the tenant
+// is dropped when it reaches 0.
+type mergeSlots struct {
+	slots  chan struct{}
+	queued int
+	users  int
+}
+
+// NewMergeLimiter creates the limiter of merges, nil when merges are not
+// limited
+func NewMergeLimiter(c *conf.Admin, metrics MergeLimitMetrics) *MergeLimiter {
+	limits := c.GetMergeLimits()
+	if limits.GetMaxConcurrent() <= 0 {
+		return nil
+	}
+
+	l := &MergeLimiter{
+		maxConcurrent: int(limits.GetMaxConcurrent()),
+		maxQueued:     defaultMergeMaxQueued,
+		timeout:       defaultMergeQueueTimeout,
+		metrics:       metrics,
+		tenants:       make(map[string]*mergeSlots),
+	}
+	if n := limits.GetMaxQueued(); n > 0 {
+		l.maxQueued = int(n)
+	}
+	if d := limits.GetQueueTimeout().AsDuration(); d > 0 {
+		l.timeout = d
+	}
+	return l
+}
+
+// Acquire takes a merge slot of tenantID, waiting for one when the tenant
+// runs as many merges as allowed. The slot is held until the returned
+// function is called. A nil limiter does not limit merges.
+func (l *MergeLimiter) Acquire(ctx context.Context, tenantID string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	t := l.tenants[tenantID]
+	if t == nil {
+		t = &mergeSlots{slots: make(chan struct{}, l.maxConcurrent)}
+		l.tenants[tenantID] = t
+	}
+	select {
+	case t.slots <- struct{}{}:
+		t.users++
+		l.running++
+		l.record()
+		l.mu.Unlock()
+		return l.release(tenantID, t), nil
+	default:
+	}
+	if t.queued >= l.maxQueued {
+		l.mu.Unlock()
+		l.reject(MergeRejectedQueueFull)
+		return nil, ErrMergeConcurrencyExhausted
+	}
+	t.users++
+	t.queued++
+	l.queued++
+	l.record()
+	l.mu.Unlock()
+
+	timer := time.NewTimer(l.timeout)
+	defer timer.Stop()
+
+	var err error
+	select {
+	case t.slots <- struct{}{}:
+	case <-timer.C:
+		err = ErrMergeConcurrencyExhausted
+	case <-ctx.Done():
+		err = ctx.Err()
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = ErrDeadlineExhausted
+		}
+	}
+
+	l.mu.Lock()
+	t.queued--
+	l.queued--
+	if err != nil {
+		l.leave(tenantID, t)
+	} else {
+		l.running++
+	}
+	l.record()
+	l.mu.Unlock()
+
+	if err != nil {
+		if errors.Is(err, ErrMergeConcurrencyExhausted) {
+			l.reject(MergeRejectedTimeout)
+		}
+		return nil, err
+	}
+	return l.release(tenantID, t), nil
+}
+
+// release returns the function freeing a slot of t, once
+func (l *MergeLimiter) release(tenantID string, t *mergeSlots) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			<-t.slots
+			l.mu.Lock()
+			l.running--
+			l.leave(tenantID, t)
+			l.record()
+			l.mu.Unlock()
+		})
+	}
+}
+
+// leave drops a user of t, and t once unused. l.mu must be held.
+func (l *MergeLimiter) leave(tenantID string, t *mergeSlots) {
+	t.users--
+	if t.users == 0 {
+		delete(l.tenants, tenantID)
+	}
+}
+
+// record reports the merges running and queued. l.mu must be held.
+func (l *MergeLimiter) record() {
+	if l.metrics != nil {
+		l.metrics.RecordMergeQueue(l.running, l.queued)
+	}
+}
+
+func (l *MergeLimiter) reject(reason string) {
+	if l.metrics != nil {
+		l.metrics.RecordMergeRejected(reason)
+	}
+}
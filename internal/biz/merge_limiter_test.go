@@ -0,0 +1,186 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// recordedMergeLimits records the reports of a MergeLimiter
+type recordedMergeLimits struct {
+	mu       sync.Mutex
+	running  int
+	queued   int
+	rejected []string
+}
+
+func (r *recordedMergeLimits) RecordMergeQueue(running, queued int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.running, r.queued = running, queued
+}
+
+func (r *recordedMergeLimits) RecordMergeRejected(reason string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rejected = append(r.rejected, reason)
+}
+
+func (r *recordedMergeLimits) queue() (int, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.running, r.queued
+}
+
+func newTestMergeLimiter(maxConcurrent, maxQueued int32, timeout time.Duration) (*MergeLimiter, *recordedMergeLimits) {
+	metrics := &recordedMergeLimits{}
+	return NewMergeLimiter(&conf.Admin{MergeLimits: &conf.Admin_MergeLimits{
+		MaxConcurrent: maxConcurrent,
+		MaxQueued:     maxQueued,
+		QueueTimeout:  durationpb.New(timeout),
+	}}, metrics), metrics
+}
+
+func TestNewMergeLimiter_Disabled(t *testing.T) {
+	l := NewMergeLimiter(&conf.Admin{}, nil)
+	assert.Nil(t, l)
+
+	// A nil limiter does not limit merges
+	for range 3 {
+		release, err := l.Acquire(context.Background(), "tenant-123")
+		require.NoError(t, err)
+		defer release()
+	}
+}
+
+func TestNewMergeLimiter_Defaults(t *testing.T) {
+	l := NewMergeLimiter(&conf.Admin{MergeLimits: &conf.Admin_MergeLimits{MaxConcurrent: 2}}, nil)
+
+	assert.Equal(t, 2, l.maxConcurrent)
+	assert.Equal(t, defaultMergeMaxQueued, l.maxQueued)
+	assert.Equal(t, defaultMergeQueueTimeout, l.timeout)
+}
+
+func TestMergeLimiter_QueuesUntilReleased(t *testing.T) {
+	l, metrics := newTestMergeLimiter(1, 1, time.Minute)
+	ctx := context.Background()
+
+	release, err := l.Acquire(ctx, "tenant-123")
+	require.NoError(t, err)
+
+	// Other tenants have their own slots
+	other, err := l.Acquire(ctx, "tenant-456")
+	require.NoError(t, err)
+	other()
+
+	acquired := make(chan func())
+	go func() {
+		release, err := l.Acquire(ctx, "tenant-123")
+		assert.NoError(t, err)
+		acquired <- release
+	}()
+
+	require.Eventually(t, func() bool {
+		_, queued := metrics.queue()
+		return queued == 1
+	}, time.Second, time.Millisecond)
+	select {
+	case <-acquired:
+		t.Fatal("merge acquired a slot held by another merge")
+	default:
+	}
+
+	release()
+	release() // releasing twice frees the slot once
+	(<-acquired)()
+
+	running, queued := metrics.queue()
+	assert.Equal(t, 0, running)
+	assert.Equal(t, 0, queued)
+	assert.Empty(t, l.tenants)
+}
+
+func TestMergeLimiter_QueueFull(t *testing.T) {
+	l, metrics := newTestMergeLimiter(1, 1, time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	release, err := l.Acquire(ctx, "tenant-123")
+	require.NoError(t, err)
+	defer release()
+
+	waited := make(chan error)
+	go func() {
+		_, err := l.Acquire(ctx, "tenant-123")
+		waited <- err
+	}()
+	require.Eventually(t, func() bool {
+		_, queued := metrics.queue()
+		return queued == 1
+	}, time.Second, time.Millisecond)
+
+	_, err = l.Acquire(ctx, "tenant-123")
+	assert.ErrorIs(t, err, ErrMergeConcurrencyExhausted)
+	assert.Equal(t, []string{MergeRejectedQueueFull}, metrics.rejected)
+
+	// A canceled merge leaves the queue
+	cancel()
+	assert.ErrorIs(t, <-waited, context.Canceled)
+	_, queued := metrics.queue()
+	assert.Equal(t, 0, queued)
+}
+
+func TestMergeLimiter_Timeout(t *testing.T) {
+	l, metrics := newTestMergeLimiter(1, 1, 10*time.Millisecond)
+	ctx := context.Background()
+
+	release, err := l.Acquire(ctx, "tenant-123")
+	require.NoError(t, err)
+	defer release()
+
+	_, err = l.Acquire(ctx, "tenant-123")
+
+	assert.ErrorIs(t, err, ErrMergeConcurrencyExhausted)
+	assert.Equal(t, []string{MergeRejectedTimeout}, metrics.rejected)
+	running, queued := metrics.queue()
+	assert.Equal(t, 1, running)
+	assert.Equal(t, 0, queued)
+}
+
+func TestMergeLimiter_Deadline(t *testing.T) {
+	l, metrics := newTestMergeLimiter(1, 1, time.Minute)
+
+	release, err := l.Acquire(context.Background(), "tenant-123")
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.Acquire(ctx, "tenant-123")
+
+	assert.ErrorIs(t, err, ErrDeadlineExhausted)
+	assert.Empty(t, metrics.rejected)
+}
+
+func TestMergeEmployeesByID_ConcurrencyExhausted(t *testing.T) {
+	uc, repo := setupUsecase()
+	uc.merges, _ = newTestMergeLimiter(1, 1, 10*time.Millisecond)
+	ctx := reviewContext("")
+
+	release, err := uc.merges.Acquire(ctx, "tenant-123")
+	require.NoError(t, err)
+	defer release()
+
+	_, err = uc.MergeEmployeesByID(ctx, uuid.New(), uuid.New())
+
+	assert.ErrorIs(t, err, ErrMergeConcurrencyExhausted)
+	repo.AssertNotCalled(t, "MergeEmployeesByID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
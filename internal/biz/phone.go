@@ -0,0 +1,83 @@
+package biz
+
+import (
+	"regexp"
+	"strings"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Phone number types
+const (
+	PhoneTypeMobile = "mobile"
+	PhoneTypeWork   = "work"
+	PhoneTypeHome   = "home"
+	PhoneTypeOther  = "other"
+)
+
+// ErrInvalidPhoneNumber is a phone number that is not a valid E.164 number,
+// or has an unknown type
+var ErrInvalidPhoneNumber = errors.BadRequest(v1.ErrorReason_INVALID_PHONE_NUMBER.String(), "phone numbers must be in E.164 format, e.g. +14155550123")
+
+// e164 matches a phone number in E.164 format: a + followed by a country
+// code and up to 15 digits in total
+var e164 = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// phoneSeparators are removed from phone numbers before they are validated
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+
+// PhoneNumber is a phone number of an employee
+type PhoneNumber struct {
+	// Type is one of the PhoneType constants
+	Type string
+	// Number is in E.164 format
+	Number string
+}
+
+// NormalizePhoneNumber returns number in E.164 format. Separators are
+// removed and a leading 00 is read as the international prefix; numbers
+// without a country code are rejected with ErrInvalidPhoneNumber.
+func NormalizePhoneNumber(number string) (string, error) {
+	number = phoneSeparators.Replace(strings.TrimSpace(number))
+	if rest, ok := strings.CutPrefix(number, "00"); ok {
+		number = "+" + rest
+	}
+	if !e164.MatchString(number) {
+		return "", ErrInvalidPhoneNumber
+	}
+	return number, nil
+}
+
+// normalizePhoneNumbers normalizes the numbers and types of phones, with
+// an empty type defaulting to PhoneTypeOther. A number repeated with
+// another formatting is kept once. nil stays nil, so that updates keep the
+// phone numbers.
+func normalizePhoneNumbers(phones []PhoneNumber) ([]PhoneNumber, error) {
+	if phones == nil {
+		return nil, nil
+	}
+	normalized := make([]PhoneNumber, 0, len(phones))
+	seen := make(map[string]bool, len(phones))
+	for _, phone := range phones {
+		number, err := NormalizePhoneNumber(phone.Number)
+		if err != nil {
+			return nil, err
+		}
+		phoneType := strings.ToLower(strings.TrimSpace(phone.Type))
+		switch phoneType {
+		case "":
+			phoneType = PhoneTypeOther
+		case PhoneTypeMobile, PhoneTypeWork, PhoneTypeHome, PhoneTypeOther:
+		default:
+			return nil, ErrInvalidPhoneNumber
+		}
+		if seen[number] {
+			continue
+		}
+		seen[number] = true
+		normalized = append(normalized, PhoneNumber{Type: phoneType, Number: number})
+	}
+	return normalized, nil
+}
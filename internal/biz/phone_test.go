@@ -0,0 +1,107 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	tests := []struct {
+		number string
+		want   string
+	}{
+		{number: "+14155550123", want: "+14155550123"},
+		{number: " +1 (415) 555-0123 ", want: "+14155550123"},
+		{number: "+381.11.123.4567", want: "+381111234567"},
+		{number: "0044 20 7946 0958", want: "+442079460958"},
+		{number: "4155550123"},
+		{number: "+0123456"},
+		{number: "+1234567890123456"},
+		{number: "+1-415-CALL-NOW"},
+		{number: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.number, func(t *testing.T) {
+			got, err := NormalizePhoneNumber(tt.number)
+			if tt.want == "" {
+				assert.Equal(t, ErrInvalidPhoneNumber, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNormalizePhoneNumbers(t *testing.T) {
+	phones, err := normalizePhoneNumbers([]PhoneNumber{
+		{Type: "Mobile", Number: "+1 415 555 0123"},
+		{Number: "+44 20 7946 0958"},
+		{Type: "work", Number: "001-415-555-0123"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []PhoneNumber{
+		{Type: PhoneTypeMobile, Number: "+14155550123"},
+		{Type: PhoneTypeOther, Number: "+442079460958"},
+	}, phones)
+}
+
+func TestNormalizePhoneNumbers_KeepsNilAndEmpty(t *testing.T) {
+	phones, err := normalizePhoneNumbers(nil)
+	require.NoError(t, err)
+	assert.Nil(t, phones)
+
+	phones, err = normalizePhoneNumbers([]PhoneNumber{})
+	require.NoError(t, err)
+	assert.NotNil(t, phones)
+	assert.Empty(t, phones)
+}
+
+func TestNormalizePhoneNumbers_UnknownType(t *testing.T) {
+	_, err := normalizePhoneNumbers([]PhoneNumber{{Type: "pager", Number: "+14155550123"}})
+
+	assert.Equal(t, ErrInvalidPhoneNumber, err)
+}
+
+func TestUpdateEmployee_PhoneNumbers(t *testing.T) {
+	uc, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	uc.events = newTestEventBus(pub)
+	id := uuid.New()
+
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(&Employee{ID: id, Version: 2}, nil)
+	repo.On("Update", mock.Anything, "tenant-123", mock.MatchedBy(func(e *Employee) bool {
+		return assert.ObjectsAreEqual([]PhoneNumber{{Type: PhoneTypeWork, Number: "+14155550123"}}, e.PhoneNumbers)
+	})).Return(&Employee{ID: id, Version: 3}, nil)
+	pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "", mock.Anything, []string{"phone_numbers"}, EmailChanges{}).Return(nil)
+
+	_, err := uc.UpdateEmployee(WithTenantID(context.Background(), "tenant-123"), &Employee{
+		ID:           id,
+		Version:      2,
+		PhoneNumbers: []PhoneNumber{{Type: "work", Number: "+1 (415) 555-0123"}},
+	})
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+	pub.AssertExpectations(t)
+}
+
+func TestUpdateEmployee_InvalidPhoneNumber(t *testing.T) {
+	uc, repo := setupUsecase()
+
+	_, err := uc.UpdateEmployee(WithTenantID(context.Background(), "tenant-123"), &Employee{
+		ID:           uuid.New(),
+		Version:      1,
+		PhoneNumbers: []PhoneNumber{{Number: "555-0123"}},
+	})
+
+	assert.Equal(t, ErrInvalidPhoneNumber, err)
+	repo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// MaxPhotoSize is the largest photo that can be uploaded, in bytes
+const MaxPhotoSize = 5 << 20
+
+var (
+	// ErrPhotoNotFound is a photo URL requested for an employee without photo
+	ErrPhotoNotFound = errors.NotFound(v1.ErrorReason_PHOTO_NOT_FOUND.String(), "employee has no photo")
+	// ErrInvalidPhoto is an upload that is not a JPEG, PNG or WebP image of
+	// at most MaxPhotoSize bytes
+	ErrInvalidPhoto = errors.BadRequest(v1.ErrorReason_INVALID_PHOTO.String(), fmt.Sprintf("photos must be JPEG, PNG or WebP images of at most %d bytes", MaxPhotoSize))
+	// ErrPhotoStorageNotConfigured is a photo request while no photo bucket
+	// is configured
+	ErrPhotoStorageNotConfigured = errors.New(http.StatusNotImplemented, v1.ErrorReason_PHOTO_STORAGE_NOT_CONFIGURED.String(), "photo storage is not configured")
+)
+
+// photoExtensions are the accepted photo content types and the extension of
+// their object keys
+var photoExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+// PhotoStore keeps employee photos in object storage
+type PhotoStore interface {
+	Put(ctx context.Context, key string, photo []byte, contentType string) error
+	Delete(ctx context.Context, key string) error
+	// URL returns a pre-signed URL of the photo stored under key and when it
+	// expires
+	URL(ctx context.Context, key string) (string, time.Time, error)
+}
+
+// PhotoUsecase manages employee photos. Photos are stored in a PhotoStore
+// and employees only keep the object key.
+type PhotoUsecase struct {
+	repo   EmployeeRepo
+	tx     Transaction
+	events *EventBus
+	store  PhotoStore
+	ids    IDGenerator
+	log    *log.Helper
+}
+
+// NewPhotoUsecase creates a photo usecase. store is nil when photo storage is
+// not configured.
+func NewPhotoUsecase(repo EmployeeRepo, tx Transaction, events *EventBus, store PhotoStore, ids IDGenerator, logger log.Logger) *PhotoUsecase {
+	return &PhotoUsecase{
+		repo:   repo,
+		tx:     tx,
+		events: events,
+		store:  store,
+		ids:    ids,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// UploadPhoto stores photo as the photo of an employee of the caller's
+// tenant, replacing the previous photo. Each upload gets a new object key, so
+// that URLs of the previous photo do not serve the new one.
+func (uc *PhotoUsecase) UploadPhoto(ctx context.Context, id uuid.UUID, photo []byte) (*Employee, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if uc.store == nil {
+		return nil, ErrPhotoStorageNotConfigured
+	}
+
+	// The content type is sniffed rather than trusted from the client
+	contentType := http.DetectContentType(photo)
+	ext, ok := photoExtensions[contentType]
+	if len(photo) == 0 || len(photo) > MaxPhotoSize || !ok {
+		return nil, ErrInvalidPhoto
+	}
+
+	uc.log.WithContext(ctx).Infof("UploadPhoto: tenant=%s, id=%s, type=%s, bytes=%d", tenantID, id, contentType, len(photo))
+
+	if _, err := uc.repo.GetByID(ctx, tenantID, id); err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s/%s/%s%s", tenantID, id, uc.ids.NewID(), ext)
+	if err := uc.store.Put(ctx, key, photo, contentType); err != nil {
+		uc.log.WithContext(ctx).Errorf("failed to store photo %s: %v", key, err)
+		return nil, err
+	}
+
+	var employee *Employee
+	var previous string
+	err = uc.tx.Transaction(ctx, func(ctx context.Context) error {
+		existing, err := uc.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		previous = existing.PhotoKey
+
+		employee, err = uc.repo.Update(ctx, tenantID, &Employee{ID: id, TenantID: tenantID, Version: existing.Version, PhotoKey: key})
+		return err
+	})
+	if err != nil {
+		uc.deletePhoto(ctx, key)
+		return nil, err
+	}
+	if previous != "" {
+		uc.deletePhoto(ctx, previous)
+	}
+
+	// Publish event (best-effort)
+	userID, _ := GetUserID(ctx)
+	uc.events.Publish(ctx, &DomainEvent{
+		Type:          EventEmployeeUpdated,
+		TenantID:      tenantID,
+		UserID:        userID,
+		Employee:      employee,
+		UpdatedFields: []string{"photo"},
+	})
+	return employee, nil
+}
+
+// GetPhotoURL returns a pre-signed URL of the photo of an employee of the
+// caller's tenant and when it expires
+func (uc *PhotoUsecase) GetPhotoURL(ctx context.Context, id uuid.UUID) (string, time.Time, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if uc.store == nil {
+		return "", time.Time{}, ErrPhotoStorageNotConfigured
+	}
+
+	employee, err := uc.repo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if employee.PhotoKey == "" {
+		return "", time.Time{}, ErrPhotoNotFound
+	}
+	return uc.store.URL(ctx, employee.PhotoKey)
+}
+
+// deletePhoto deletes a photo that is no longer referenced. Failures are only
+// logged; the object is left behind.
+func (uc *PhotoUsecase) deletePhoto(ctx context.Context, key string) {
+	if err := uc.store.Delete(ctx, key); err != nil {
+		uc.log.WithContext(ctx).Warnf("failed to delete photo %s: %v", key, err)
+	}
+}
@@ -0,0 +1,145 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// pngHeader is enough of a PNG for content type detection
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+type mockPhotoStore struct {
+	mock.Mock
+}
+
+func (m *mockPhotoStore) Put(ctx context.Context, key string, photo []byte, contentType string) error {
+	return m.Called(ctx, key, photo, contentType).Error(0)
+}
+
+func (m *mockPhotoStore) Delete(ctx context.Context, key string) error {
+	return m.Called(ctx, key).Error(0)
+}
+
+func (m *mockPhotoStore) URL(ctx context.Context, key string) (string, time.Time, error) {
+	args := m.Called(ctx, key)
+	return args.String(0), args.Get(1).(time.Time), args.Error(2)
+}
+
+func setupPhotoUsecase(store PhotoStore, photoID uuid.UUID) (*PhotoUsecase, *MockEmployeeRepo, *MockEventPublisher) {
+	repo := new(MockEmployeeRepo)
+	pub := new(MockEventPublisher)
+	ids := IDGeneratorFunc(func() uuid.UUID { return photoID })
+	return NewPhotoUsecase(repo, &fakeTransaction{}, newTestEventBus(pub), store, ids, log.NewStdLogger(io.Discard)), repo, pub
+}
+
+func TestUploadPhoto(t *testing.T) {
+	id := uuid.New()
+	photoID := uuid.New()
+	key := "tenant-123/" + id.String() + "/" + photoID.String() + ".png"
+	ctx := WithTenantID(context.Background(), "tenant-123")
+
+	store := new(mockPhotoStore)
+	uc, repo, pub := setupPhotoUsecase(store, photoID)
+	existing := &Employee{ID: id, TenantID: "tenant-123", Version: 2, PhotoKey: "tenant-123/old.jpg"}
+	updated := &Employee{ID: id, TenantID: "tenant-123", Version: 3, PhotoKey: key}
+
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(existing, nil)
+	repo.On("Update", mock.Anything, "tenant-123", &Employee{ID: id, TenantID: "tenant-123", Version: 2, PhotoKey: key}).Return(updated, nil)
+	store.On("Put", mock.Anything, key, pngHeader, "image/png").Return(nil)
+	store.On("Delete", mock.Anything, "tenant-123/old.jpg").Return(nil)
+	pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "", updated, []string{"photo"}, EmailChanges{}).Return(nil)
+
+	employee, err := uc.UploadPhoto(ctx, id, pngHeader)
+
+	require.NoError(t, err)
+	assert.Same(t, updated, employee)
+	store.AssertExpectations(t)
+	pub.AssertExpectations(t)
+}
+
+func TestUploadPhoto_UpdateFailsDeletesUpload(t *testing.T) {
+	id := uuid.New()
+	photoID := uuid.New()
+	key := "tenant-123/" + id.String() + "/" + photoID.String() + ".png"
+
+	store := new(mockPhotoStore)
+	uc, repo, _ := setupPhotoUsecase(store, photoID)
+	existing := &Employee{ID: id, TenantID: "tenant-123", Version: 2}
+
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(existing, nil)
+	repo.On("Update", mock.Anything, "tenant-123", mock.Anything).Return(nil, ErrVersionMismatch)
+	store.On("Put", mock.Anything, key, pngHeader, "image/png").Return(nil)
+	store.On("Delete", mock.Anything, key).Return(nil)
+
+	_, err := uc.UploadPhoto(WithTenantID(context.Background(), "tenant-123"), id, pngHeader)
+
+	assert.Equal(t, ErrVersionMismatch, err)
+	store.AssertExpectations(t)
+}
+
+func TestUploadPhoto_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		photo []byte
+	}{
+		{name: "not an image", photo: []byte("hello world")},
+		{name: "gif", photo: []byte("GIF89a\x01\x00\x01\x00")},
+		{name: "too large", photo: append(pngHeader, make([]byte, MaxPhotoSize)...)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := new(mockPhotoStore)
+			uc, _, _ := setupPhotoUsecase(store, uuid.New())
+
+			_, err := uc.UploadPhoto(WithTenantID(context.Background(), "tenant-123"), uuid.New(), tt.photo)
+
+			assert.Equal(t, ErrInvalidPhoto, err)
+			store.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUploadPhoto_NotConfigured(t *testing.T) {
+	uc, _, _ := setupPhotoUsecase(nil, uuid.New())
+
+	_, err := uc.UploadPhoto(WithTenantID(context.Background(), "tenant-123"), uuid.New(), pngHeader)
+
+	assert.Equal(t, ErrPhotoStorageNotConfigured, err)
+}
+
+func TestGetPhotoURL(t *testing.T) {
+	id := uuid.New()
+	expiresAt := time.Date(2024, 1, 1, 0, 15, 0, 0, time.UTC)
+	ctx := WithTenantID(context.Background(), "tenant-123")
+
+	t.Run("photo", func(t *testing.T) {
+		store := new(mockPhotoStore)
+		uc, repo, _ := setupPhotoUsecase(store, uuid.New())
+		repo.On("GetByID", mock.Anything, "tenant-123", id).Return(&Employee{ID: id, PhotoKey: "tenant-123/photo.png"}, nil)
+		store.On("URL", mock.Anything, "tenant-123/photo.png").Return("https://photos.example.com/signed", expiresAt, nil)
+
+		url, expires, err := uc.GetPhotoURL(ctx, id)
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://photos.example.com/signed", url)
+		assert.Equal(t, expiresAt, expires)
+	})
+
+	t.Run("no photo", func(t *testing.T) {
+		uc, repo, _ := setupPhotoUsecase(new(mockPhotoStore), uuid.New())
+		repo.On("GetByID", mock.Anything, "tenant-123", id).Return(&Employee{ID: id}, nil)
+
+		_, _, err := uc.GetPhotoURL(ctx, id)
+
+		assert.Equal(t, ErrPhotoNotFound, err)
+	})
+}
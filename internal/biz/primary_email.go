@@ -0,0 +1,67 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// SetPrimaryEmail designates one of the emails of an employee of the caller's
+// tenant as its primary email. It returns the employee and whether the
+// primary email changed; an employee whose primary email it already is is
+// returned unchanged. Emails marked undeliverable cannot become primary, so
+// that mail is not sent to them. The swap is based on the version read, so it
+// fails with ErrVersionMismatch rather than overwrite a concurrent change of
+// the emails.
+func (uc *EmployeeUsecase) SetPrimaryEmail(ctx context.Context, id uuid.UUID, email string) (*Employee, bool, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	email = uc.emails.Normalize(tenantID, email)
+
+	uc.log.WithContext(ctx).Infof("SetPrimaryEmail: tenant=%s, id=%s, email=%s", tenantID, id, email)
+
+	var employee *Employee
+	changed := false
+	err = uc.tx.Transaction(ctx, func(ctx context.Context) error {
+		existing, err := uc.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+
+		i := slices.IndexFunc(existing.Emails, func(e string) bool { return strings.EqualFold(e, email) })
+		if i < 0 {
+			return ErrEmployeeEmailNotFound
+		}
+		if strings.EqualFold(existing.PrimaryEmail, email) {
+			employee = existing
+			return nil
+		}
+		if existing.IsUndeliverable(email) {
+			return ErrEmailUndeliverable
+		}
+
+		employee, err = uc.repo.Update(ctx, tenantID, &Employee{ID: id, TenantID: tenantID, Version: existing.Version, PrimaryEmail: existing.Emails[i]})
+		changed = err == nil
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if changed {
+		// Publish event (best-effort)
+		userID, _ := GetUserID(ctx)
+		uc.events.Publish(ctx, &DomainEvent{
+			Type:          EventEmployeeUpdated,
+			TenantID:      tenantID,
+			UserID:        userID,
+			Employee:      employee,
+			UpdatedFields: []string{"primary_email"},
+		})
+	}
+	return employee, changed, nil
+}
@@ -0,0 +1,62 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetPrimaryEmail(t *testing.T) {
+	id := uuid.New()
+	emails := []string{"jane@example.com", "jane.doe@example.com", "jane.d@example.com"}
+	undeliverable := []UndeliverableEmail{{Email: "jane.d@example.com", Reason: EmailBounceReasonBounce}}
+
+	tests := []struct {
+		name        string
+		email       string
+		wantPrimary string
+		wantErr     error
+	}{
+		{name: "swapped", email: "Jane.Doe@Example.com", wantPrimary: "jane.doe@example.com"},
+		{name: "already primary", email: "jane@example.com"},
+		{name: "not the employee's", email: "john@example.com", wantErr: ErrEmployeeEmailNotFound},
+		{name: "undeliverable", email: "jane.d@example.com", wantErr: ErrEmailUndeliverable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo := setupUsecase()
+			pub := new(MockEventPublisher)
+			uc.events = newTestEventBus(pub)
+			existing := &Employee{ID: id, TenantID: "tenant-123", Version: 3, Emails: emails, PrimaryEmail: "jane@example.com", UndeliverableEmails: undeliverable}
+			updated := &Employee{ID: id, TenantID: "tenant-123", Version: 4, Emails: emails, PrimaryEmail: tt.wantPrimary}
+
+			repo.On("GetByID", mock.Anything, "tenant-123", id).Return(existing, nil)
+			repo.On("Update", mock.Anything, "tenant-123", &Employee{ID: id, TenantID: "tenant-123", Version: 3, PrimaryEmail: tt.wantPrimary}).Return(updated, nil)
+			pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "", updated, []string{"primary_email"}, EmailChanges{}).Return(nil)
+
+			employee, changed, err := uc.SetPrimaryEmail(WithTenantID(context.Background(), "tenant-123"), id, tt.email)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantPrimary == "" {
+				assert.False(t, changed)
+				assert.Same(t, existing, employee)
+				repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				pub.AssertNotCalled(t, "PublishEmployeeUpdated", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.True(t, changed)
+			assert.Same(t, updated, employee)
+			pub.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// QuotaResourceEmployees is the quota on the employees of a tenant
+const QuotaResourceEmployees = "employees"
+
+// QuotaThresholds are the percentages of a quota whose crossing is published
+var QuotaThresholds = []int32{80, 90, 100}
+
+// QuotaUsage is the usage of a tenant quota
+type QuotaUsage struct {
+	TenantID string
+	// Resource is one of the QuotaResource constants
+	Resource string
+	Used     int64
+	Limit    int64
+}
+
+// Threshold returns the highest of QuotaThresholds the usage reached, 0 below
+// the lowest
+func (u *QuotaUsage) Threshold() int32 {
+	var reached int32
+	for _, threshold := range QuotaThresholds {
+		if u.Used*100 >= int64(threshold)*u.Limit {
+			reached = threshold
+		}
+	}
+	return reached
+}
+
+// Remaining returns how much of the quota is left, 0 once it is used up
+func (u *QuotaUsage) Remaining() int64 {
+	return max(u.Limit-u.Used, 0)
+}
+
+// QuotaRepo stores the threshold each tenant quota reached, so that a
+// threshold is published once no matter which instance sees it crossed
+type QuotaRepo interface {
+	// RecordThreshold stores threshold as the one a quota reached. It reports
+	// whether the stored threshold was lower, i.e. threshold was crossed.
+	RecordThreshold(ctx context.Context, tenantID, resource string, threshold int32) (bool, error)
+}
+
+// QuotaEventPublisher publishes the crossing of quota thresholds
+type QuotaEventPublisher interface {
+	PublishQuotaThreshold(ctx context.Context, usage *QuotaUsage, threshold int32) error
+}
+
+// QuotaUsecase tracks the soft quotas of tenants. Quotas are not enforced:
+// tenants are warned as they approach them, through events and the quota
+// headers of create responses.
+type QuotaUsecase struct {
+	repo      EmployeeRepo
+	quotas    QuotaRepo
+	publisher QuotaEventPublisher
+	// maxEmployees is the default employee quota; tenantMaxEmployees
+	// overrides it per tenant
+	maxEmployees       int64
+	tenantMaxEmployees map[string]int64
+	log                *log.Helper
+}
+
+// NewQuotaUsecase creates the quota usecase configured in c. publisher is
+// nil when events are disabled.
+func NewQuotaUsecase(repo EmployeeRepo, quotas QuotaRepo, publisher QuotaEventPublisher, c *conf.Data, logger log.Logger) *QuotaUsecase {
+	return &QuotaUsecase{
+		repo:               repo,
+		quotas:             quotas,
+		publisher:          publisher,
+		maxEmployees:       c.GetQuota().GetMaxEmployees(),
+		tenantMaxEmployees: c.GetQuota().GetTenantMaxEmployees(),
+		log:                log.NewHelper(logger),
+	}
+}
+
+// employeeLimit returns the employee quota of a tenant, 0 without quota
+func (uc *QuotaUsecase) employeeLimit(tenantID string) int64 {
+	if limit, ok := uc.tenantMaxEmployees[tenantID]; ok {
+		return limit
+	}
+	return uc.maxEmployees
+}
+
+// EmployeeUsage returns the usage of the employee quota of the caller's
+// tenant, nil when the tenant has no quota
+func (uc *QuotaUsecase) EmployeeUsage(ctx context.Context) (*QuotaUsage, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return uc.employeeUsage(ctx, tenantID)
+}
+
+func (uc *QuotaUsecase) employeeUsage(ctx context.Context, tenantID string) (*QuotaUsage, error) {
+	limit := uc.employeeLimit(tenantID)
+	if limit <= 0 {
+		return nil, nil
+	}
+	// An empty review status counts employees pending review too
+	used, err := uc.repo.Count(ctx, tenantID, &ListFilter{})
+	if err != nil {
+		return nil, err
+	}
+	return &QuotaUsage{TenantID: tenantID, Resource: QuotaResourceEmployees, Used: used, Limit: limit}, nil
+}
+
+// HandleEvent implements EventSubscriber. Changes to the number of employees
+// record the threshold the tenant's quota reached, publishing it when it was
+// crossed; thresholds left behind by deletes are published again when
+// crossed again.
+func (uc *QuotaUsecase) HandleEvent(ctx context.Context, e *DomainEvent) error {
+	switch e.Type {
+	case EventEmployeeCreated, EventEmployeeDeleted, EventEmployeeMerged, EventEmployeeUnmerged, EventTenantPurged:
+	default:
+		return nil
+	}
+
+	usage, err := uc.employeeUsage(ctx, e.TenantID)
+	if err != nil || usage == nil {
+		return err
+	}
+	threshold := usage.Threshold()
+	crossed, err := uc.quotas.RecordThreshold(ctx, e.TenantID, usage.Resource, threshold)
+	if err != nil || !crossed {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("tenant %s reached %d%% of its %s quota (%d of %d)", e.TenantID, threshold, usage.Resource, usage.Used, usage.Limit)
+	if uc.publisher == nil {
+		return nil
+	}
+	return uc.publisher.PublishQuotaThreshold(ctx, usage, threshold)
+}
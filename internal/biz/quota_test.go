@@ -0,0 +1,108 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockQuotaRepo struct {
+	mock.Mock
+}
+
+func (m *mockQuotaRepo) RecordThreshold(ctx context.Context, tenantID, resource string, threshold int32) (bool, error) {
+	args := m.Called(ctx, tenantID, resource, threshold)
+	return args.Bool(0), args.Error(1)
+}
+
+type mockQuotaPublisher struct {
+	mock.Mock
+}
+
+func (m *mockQuotaPublisher) PublishQuotaThreshold(ctx context.Context, usage *QuotaUsage, threshold int32) error {
+	return m.Called(ctx, usage, threshold).Error(0)
+}
+
+func setupQuotaUsecase(c *conf.Data_Quota) (*QuotaUsecase, *MockEmployeeRepo, *mockQuotaRepo, *mockQuotaPublisher) {
+	repo := new(MockEmployeeRepo)
+	quotas := new(mockQuotaRepo)
+	publisher := new(mockQuotaPublisher)
+	uc := NewQuotaUsecase(repo, quotas, publisher, &conf.Data{Quota: c}, log.NewStdLogger(io.Discard))
+	return uc, repo, quotas, publisher
+}
+
+func TestQuotaUsage_Threshold(t *testing.T) {
+	tests := []struct {
+		used      int64
+		threshold int32
+	}{
+		{used: 0, threshold: 0},
+		{used: 79, threshold: 0},
+		{used: 80, threshold: 80},
+		{used: 95, threshold: 90},
+		{used: 100, threshold: 100},
+		{used: 120, threshold: 100},
+	}
+
+	for _, tt := range tests {
+		usage := &QuotaUsage{Used: tt.used, Limit: 100}
+		assert.Equal(t, tt.threshold, usage.Threshold(), "used %d", tt.used)
+	}
+	assert.Equal(t, int64(0), (&QuotaUsage{Used: 120, Limit: 100}).Remaining())
+}
+
+func TestQuotaUsecase_HandleEvent(t *testing.T) {
+	ctx := context.Background()
+	created := &DomainEvent{Type: EventEmployeeCreated, TenantID: "tenant-123", Employee: &Employee{}}
+
+	t.Run("crossed", func(t *testing.T) {
+		uc, repo, quotas, publisher := setupQuotaUsecase(&conf.Data_Quota{MaxEmployees: 100, TenantMaxEmployees: map[string]int64{"tenant-123": 50}})
+		usage := &QuotaUsage{TenantID: "tenant-123", Resource: QuotaResourceEmployees, Used: 45, Limit: 50}
+		repo.On("Count", mock.Anything, "tenant-123", &ListFilter{}).Return(int64(45), nil)
+		quotas.On("RecordThreshold", mock.Anything, "tenant-123", QuotaResourceEmployees, int32(90)).Return(true, nil)
+		publisher.On("PublishQuotaThreshold", mock.Anything, usage, int32(90)).Return(nil)
+
+		require.NoError(t, uc.HandleEvent(ctx, created))
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("already reached", func(t *testing.T) {
+		uc, repo, quotas, publisher := setupQuotaUsecase(&conf.Data_Quota{MaxEmployees: 100})
+		repo.On("Count", mock.Anything, "tenant-123", &ListFilter{}).Return(int64(85), nil)
+		quotas.On("RecordThreshold", mock.Anything, "tenant-123", QuotaResourceEmployees, int32(80)).Return(false, nil)
+
+		require.NoError(t, uc.HandleEvent(ctx, created))
+		publisher.AssertNotCalled(t, "PublishQuotaThreshold", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("deleted below the thresholds", func(t *testing.T) {
+		uc, repo, quotas, _ := setupQuotaUsecase(&conf.Data_Quota{MaxEmployees: 100})
+		repo.On("Count", mock.Anything, "tenant-123", &ListFilter{}).Return(int64(70), nil)
+		quotas.On("RecordThreshold", mock.Anything, "tenant-123", QuotaResourceEmployees, int32(0)).Return(false, nil)
+
+		require.NoError(t, uc.HandleEvent(ctx, &DomainEvent{Type: EventEmployeeDeleted, TenantID: "tenant-123", Employee: &Employee{}}))
+		quotas.AssertExpectations(t)
+	})
+
+	t.Run("no quota", func(t *testing.T) {
+		uc, repo, quotas, _ := setupQuotaUsecase(&conf.Data_Quota{MaxEmployees: 100, TenantMaxEmployees: map[string]int64{"tenant-123": 0}})
+
+		require.NoError(t, uc.HandleEvent(ctx, created))
+		repo.AssertNotCalled(t, "Count", mock.Anything, mock.Anything, mock.Anything)
+		quotas.AssertNotCalled(t, "RecordThreshold", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		uc, repo, _, _ := setupQuotaUsecase(&conf.Data_Quota{MaxEmployees: 100})
+
+		require.NoError(t, uc.HandleEvent(ctx, &DomainEvent{Type: EventEmployeeUpdated, TenantID: "tenant-123", Employee: &Employee{}}))
+		repo.AssertNotCalled(t, "Count", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,45 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Read operations recorded by a ReadAuditor
+const (
+	ReadOperationGet             = "get"
+	ReadOperationGetByEmail      = "get_by_email"
+	ReadOperationGetByExternalID = "get_by_external_id"
+)
+
+// ReadAuditEntry is a recorded read of an employee
+type ReadAuditEntry struct {
+	TenantID   string
+	EmployeeID uuid.UUID
+	// Operation is one of the ReadOperation constants
+	Operation string
+	ActorID   string
+	RequestID string
+	ClientIP  string
+	CreatedAt time.Time
+}
+
+// ReadAuditor records reads of employees. Unlike the audit entries of
+// changes, which are written in the transaction of the change, reads are
+// recorded without waiting on the database: they may be sampled, and dropped
+// under pressure.
+type ReadAuditor interface {
+	// RecordRead records a read of an employee by the caller in ctx. It must
+	// not block.
+	RecordRead(ctx context.Context, operation, tenantID string, employeeID uuid.UUID)
+}
+
+// recordRead records a read of employee through uc.reads, if configured
+func (uc *EmployeeUsecase) recordRead(ctx context.Context, operation, tenantID string, employee *Employee) {
+	if uc.reads == nil {
+		return
+	}
+	uc.reads.RecordRead(ctx, operation, tenantID, employee.ID)
+}
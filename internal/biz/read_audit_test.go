@@ -0,0 +1,64 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockReadAuditor struct {
+	mock.Mock
+}
+
+func (m *mockReadAuditor) RecordRead(ctx context.Context, operation, tenantID string, employeeID uuid.UUID) {
+	m.Called(ctx, operation, tenantID, employeeID)
+}
+
+func TestGetEmployee_RecordsRead(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+
+	t.Run("found", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		reads := new(mockReadAuditor)
+		uc.reads = reads
+		id := uuid.New()
+		repo.On("GetByID", mock.Anything, "tenant-123", id).Return(&Employee{ID: id}, nil)
+		reads.On("RecordRead", mock.Anything, ReadOperationGet, "tenant-123", id).Return()
+
+		_, err := uc.GetEmployee(ctx, id)
+
+		require.NoError(t, err)
+		reads.AssertExpectations(t)
+	})
+
+	t.Run("by email", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		reads := new(mockReadAuditor)
+		uc.reads = reads
+		id := uuid.New()
+		repo.On("GetByEmail", mock.Anything, "tenant-123", "jane@example.com").Return(&Employee{ID: id, ReviewStatus: ReviewStatusApproved}, nil)
+		reads.On("RecordRead", mock.Anything, ReadOperationGetByEmail, "tenant-123", id).Return()
+
+		_, err := uc.GetEmployeeByEmail(ctx, "jane@example.com")
+
+		require.NoError(t, err)
+		reads.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		reads := new(mockReadAuditor)
+		uc.reads = reads
+		id := uuid.New()
+		repo.On("GetByID", mock.Anything, "tenant-123", id).Return(nil, nil)
+
+		_, err := uc.GetEmployee(ctx, id)
+
+		assert.Equal(t, ErrEmployeeNotFound, err)
+		reads.AssertNotCalled(t, "RecordRead", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
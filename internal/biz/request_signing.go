@@ -0,0 +1,152 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultRequestSigningMaxSkew is how far the timestamp of a signed
+	// request may be from the server time
+	defaultRequestSigningMaxSkew = 5 * time.Minute
+	// maxRequestNonceLength bounds the nonces remembered per request
+	maxRequestNonceLength = 128
+)
+
+// ErrRequestReplayed is a signed request whose nonce was already used by the
+// API key within the signing window
+var ErrRequestReplayed = errors.Unauthorized(v1.ErrorReason_REQUEST_REPLAYED.String(), "request nonce already used")
+
+// invalidRequestSignature is the INVALID_REQUEST_SIGNATURE error of a request
+// with a missing, malformed, stale or wrong signature
+func invalidRequestSignature(message string) error {
+	return errors.Unauthorized(v1.ErrorReason_INVALID_REQUEST_SIGNATURE.String(), message)
+}
+
+// RequestNonces remembers the nonces of signed requests
+type RequestNonces interface {
+	// Claim records nonce for the API key for ttl. It returns false when
+	// the nonce is already recorded.
+	Claim(ctx context.Context, keyID uuid.UUID, nonce string, ttl time.Duration) (bool, error)
+}
+
+// SignRequest returns the X-Signature header value of a request made at
+// timestamp with nonce: t=<unix seconds>,n=<nonce>,v1=<hex>, where v1 is
+// the HMAC-SHA256 of <t>.<nonce>.<method>.<path>.<body> keyed with the
+// signing secret of the API key.
+func SignRequest(secret string, timestamp time.Time, nonce, method, path string, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + t + ",n=" + nonce + ",v1=" + hex.EncodeToString(requestMAC(secret, t, nonce, method, path, body))
+}
+
+func requestMAC(secret, t, nonce, method, path string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	for _, part := range []string{t, nonce, method, path} {
+		mac.Write([]byte(part))
+		mac.Write([]byte("."))
+	}
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// requestSignature is a parsed X-Signature header
+type requestSignature struct {
+	t     string
+	nonce string
+	mac   []byte
+}
+
+// parseRequestSignature parses an X-Signature header, ok is false when a
+// part is missing or malformed
+func parseRequestSignature(header string) (sig requestSignature, ok bool) {
+	for _, part := range strings.Split(header, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch name {
+		case "t":
+			sig.t = value
+		case "n":
+			sig.nonce = value
+		case "v1":
+			mac, err := hex.DecodeString(value)
+			if err != nil {
+				return sig, false
+			}
+			sig.mac = mac
+		}
+	}
+	if sig.t == "" || sig.nonce == "" || len(sig.nonce) > maxRequestNonceLength || len(sig.mac) == 0 {
+		return sig, false
+	}
+	return sig, true
+}
+
+// RequestSignatures verifies the requests signed with the signing secret of
+// their API key, rejecting stale timestamps and reused nonces so that a
+// captured request cannot be replayed
+type RequestSignatures struct {
+	maxSkew time.Duration
+	nonces  RequestNonces
+	clock   Clock
+}
+
+// NewRequestSignatures creates the verifier of signed requests configured
+// in c
+func NewRequestSignatures(c *conf.Auth, nonces RequestNonces, clock Clock) *RequestSignatures {
+	maxSkew := defaultRequestSigningMaxSkew
+	if d := c.GetRequestSigning().GetMaxSkew().AsDuration(); d > 0 {
+		maxSkew = d
+	}
+	return &RequestSignatures{
+		maxSkew: maxSkew,
+		nonces:  nonces,
+		clock:   clock,
+	}
+}
+
+// Verify checks header, the X-Signature of a request made with key, against
+// the method, path and raw body of the request. It returns
+// INVALID_REQUEST_SIGNATURE for a missing, malformed, stale or wrong
+// signature and ErrRequestReplayed for a nonce already used by the key.
+func (s *RequestSignatures) Verify(ctx context.Context, key *APIKey, header, method, path string, body []byte) error {
+	if header == "" {
+		return invalidRequestSignature("requests made with this API key must be signed in X-Signature")
+	}
+	sig, ok := parseRequestSignature(header)
+	if !ok {
+		return invalidRequestSignature("malformed X-Signature, expected t=<unix seconds>,n=<nonce>,v1=<hex>")
+	}
+	unix, err := strconv.ParseInt(sig.t, 10, 64)
+	if err != nil {
+		return invalidRequestSignature("malformed X-Signature timestamp")
+	}
+	skew := s.clock.Now().Sub(time.Unix(unix, 0))
+	if skew > s.maxSkew || skew < -s.maxSkew {
+		return invalidRequestSignature("X-Signature timestamp is outside the signing window")
+	}
+	if key.SigningSecret == "" || !hmac.Equal(sig.mac, requestMAC(key.SigningSecret, sig.t, sig.nonce, method, path, body)) {
+		return invalidRequestSignature("X-Signature does not match the request")
+	}
+
+	// Nonces are claimed once the signature is verified, so that forged
+	// requests cannot use them up. A nonce is kept until its timestamp,
+	// which may be ahead of the server time, leaves the window.
+	claimed, err := s.nonces.Claim(ctx, key.ID, sig.nonce, 2*s.maxSkew)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return ErrRequestReplayed
+	}
+	return nil
+}
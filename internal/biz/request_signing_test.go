@@ -0,0 +1,86 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// memoryNonces remembers nonces forever, recording the TTL they were
+// claimed for
+type memoryNonces struct {
+	seen map[string]bool
+	ttl  time.Duration
+}
+
+func (n *memoryNonces) Claim(_ context.Context, keyID uuid.UUID, nonce string, ttl time.Duration) (bool, error) {
+	n.ttl = ttl
+	if n.seen[keyID.String()+nonce] {
+		return false, nil
+	}
+	n.seen[keyID.String()+nonce] = true
+	return true, nil
+}
+
+func TestSignRequest(t *testing.T) {
+	sig := SignRequest("secret", time.Unix(1700000000, 0), "n-1", "POST", "/api/v1/employees", []byte(`{}`))
+
+	// HMAC-SHA256("secret", "1700000000.n-1.POST./api/v1/employees.{}")
+	assert.Equal(t, "t=1700000000,n=n-1,v1=fe5a47c70f0844607f23582fdc97c1ad167fbe01f7778bb35c97d364bfc98c00", sig)
+}
+
+func TestRequestSignatures_Verify(t *testing.T) {
+	nonces := &memoryNonces{seen: map[string]bool{}}
+	s := NewRequestSignatures(&conf.Auth{RequestSigning: &conf.Auth_RequestSigning{MaxSkew: durationpb.New(time.Minute)}}, nonces, ClockFunc(func() time.Time { return scheduleNow }))
+	key := &APIKey{ID: uuid.New(), SigningSecret: "essig_secret"}
+	ctx := context.Background()
+	body := []byte(`{"emails":["jane@example.com"]}`)
+	sign := func(at time.Time, nonce string) string {
+		return SignRequest(key.SigningSecret, at, nonce, "POST", "/api/v1/employees", body)
+	}
+
+	require.NoError(t, s.Verify(ctx, key, sign(scheduleNow.Add(-30*time.Second), "n-1"), "POST", "/api/v1/employees", body))
+	assert.Equal(t, 2*time.Minute, nonces.ttl)
+
+	// The same nonce cannot be used again, even with a new timestamp
+	err := s.Verify(ctx, key, sign(scheduleNow, "n-1"), "POST", "/api/v1/employees", body)
+	assert.ErrorIs(t, err, ErrRequestReplayed)
+
+	tests := []struct {
+		name   string
+		key    *APIKey
+		header string
+		path   string
+	}{
+		{name: "missing", key: key, header: "", path: "/api/v1/employees"},
+		{name: "malformed", key: key, header: "t=1,v1=zz", path: "/api/v1/employees"},
+		{name: "stale", key: key, header: sign(scheduleNow.Add(-2*time.Minute), "n-2"), path: "/api/v1/employees"},
+		{name: "future", key: key, header: sign(scheduleNow.Add(2*time.Minute), "n-3"), path: "/api/v1/employees"},
+		{name: "other path", key: key, header: sign(scheduleNow, "n-4"), path: "/api/v1/employees?dry_run=true"},
+		{name: "key without secret", key: &APIKey{ID: key.ID}, header: sign(scheduleNow, "n-5"), path: "/api/v1/employees"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.Verify(ctx, tt.key, tt.header, "POST", tt.path, body)
+
+			assert.Equal(t, "INVALID_REQUEST_SIGNATURE", errors.Reason(err))
+			assert.Equal(t, 401, errors.Code(err))
+		})
+	}
+	// Rejected requests do not use up their nonce
+	assert.Len(t, nonces.seen, 1)
+}
+
+func TestNewRequestSignatures_Defaults(t *testing.T) {
+	s := NewRequestSignatures(&conf.Auth{}, nil, NewSystemClock())
+
+	assert.Equal(t, defaultRequestSigningMaxSkew, s.maxSkew)
+}
@@ -0,0 +1,166 @@
+package biz
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// Review statuses of an employee
+const (
+	// ReviewStatusApproved employees are visible everywhere
+	ReviewStatusApproved = "approved"
+	// ReviewStatusPending employees wait in the review queue. They are only
+	// returned by GetEmployee and ListPendingEmployees, cannot be merged and
+	// are not published until approved.
+	ReviewStatusPending = "pending"
+)
+
+// Creation channels
+const (
+	// ChannelAPI is the channel of callers whose token has no channel claim
+	ChannelAPI = "api"
+	// ChannelImport is the channel of employees created by bulk imports
+	ChannelImport = "import"
+)
+
+var (
+	// ErrEmployeePendingReview is an operation on an employee that is not
+	// approved yet.
+	ErrEmployeePendingReview = errors.Conflict(v1.ErrorReason_EMPLOYEE_PENDING_REVIEW.String(), "employee is pending review")
+	// ErrEmployeeNotPendingReview is a review of an employee that is not in
+	// the review queue.
+	ErrEmployeeNotPendingReview = errors.BadRequest(v1.ErrorReason_EMPLOYEE_NOT_PENDING_REVIEW.String(), "employee is not pending review")
+)
+
+// ReviewPolicy decides which new employees are held for review, by the
+// channel they are created through.
+type ReviewPolicy struct {
+	channels map[string]bool
+}
+
+// NewReviewPolicy creates the review policy configured in c. Without
+// configured channels no employee is held for review.
+func NewReviewPolicy(c *conf.Admin) *ReviewPolicy {
+	p := &ReviewPolicy{channels: make(map[string]bool)}
+	for _, channel := range c.GetReview().GetChannels() {
+		p.channels[channel] = true
+	}
+	return p
+}
+
+// InitialStatus returns the review status of an employee created through
+// channel
+func (p *ReviewPolicy) InitialStatus(channel string) string {
+	if p != nil && p.channels[channel] {
+		return ReviewStatusPending
+	}
+	return ReviewStatusApproved
+}
+
+// IsPending reports whether the employee waits for review
+func (e *Employee) IsPending() bool {
+	return e.ReviewStatus == ReviewStatusPending
+}
+
+// checkMergeable rejects merges involving employees pending review
+func checkMergeable(primary, secondary *Employee) error {
+	if primary.IsPending() || secondary.IsPending() {
+		return ErrEmployeePendingReview
+	}
+	return nil
+}
+
+// ApproveEmployee approves an employee pending review, making it visible
+// and publishing its created event.
+func (uc *EmployeeUsecase) ApproveEmployee(ctx context.Context, id uuid.UUID) (*Employee, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("ApproveEmployee: tenant=%s, id=%s", tenantID, id)
+
+	var approved *Employee
+	err = uc.tx.Transaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.pendingEmployee(ctx, tenantID, id); err != nil {
+			return err
+		}
+		approved, err = uc.repo.Approve(ctx, tenantID, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Consumers see the employee for the first time
+	userID, _ := GetUserID(ctx)
+	uc.events.Publish(ctx, &DomainEvent{Type: EventEmployeeCreated, TenantID: tenantID, UserID: userID, Employee: approved})
+
+	return approved, nil
+}
+
+// RejectEmployee rejects an employee pending review by deleting it. The
+// audit log keeps the rejected employee; no event is published since the
+// employee was never visible.
+func (uc *EmployeeUsecase) RejectEmployee(ctx context.Context, id uuid.UUID) error {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("RejectEmployee: tenant=%s, id=%s", tenantID, id)
+
+	var rejected *Employee
+	err = uc.tx.Transaction(ctx, func(ctx context.Context) error {
+		rejected, err = uc.pendingEmployee(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		return uc.repo.Delete(ctx, tenantID, id)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Subscribers such as the cache still need to forget the employee
+	userID, _ := GetUserID(ctx)
+	uc.events.Publish(ctx, &DomainEvent{Type: EventEmployeeDeleted, TenantID: tenantID, UserID: userID, Employee: rejected})
+
+	return nil
+}
+
+// ListPendingEmployees lists the review queue of the tenant, oldest first.
+// Only the pagination of filter applies.
+func (uc *EmployeeUsecase) ListPendingEmployees(ctx context.Context, filter *ListFilter) (*ListResult, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	*filter = ListFilter{Page: filter.Page, PageSize: filter.PageSize, ReviewStatus: ReviewStatusPending}
+	applyPagination(filter)
+
+	uc.log.WithContext(ctx).Infof("ListPendingEmployees: tenant=%s, page=%d, page_size=%d", tenantID, filter.Page, filter.PageSize)
+
+	return uc.repo.List(ctx, tenantID, filter)
+}
+
+// pendingEmployee returns the employee if it exists and waits for review
+func (uc *EmployeeUsecase) pendingEmployee(ctx context.Context, tenantID string, id uuid.UUID) (*Employee, error) {
+	employee, err := uc.repo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, ErrEmployeeNotFound
+	}
+	if !employee.IsPending() {
+		return nil, ErrEmployeeNotPendingReview
+	}
+	return employee, nil
+}
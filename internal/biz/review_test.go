@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func reviewContext(channel string) context.Context {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+	ctx = WithUserID(ctx, "user-456")
+	if channel != "" {
+		ctx = WithChannel(ctx, channel)
+	}
+	return ctx
+}
+
+func TestReviewPolicy(t *testing.T) {
+	p := NewReviewPolicy(&conf.Admin{Review: &conf.Admin_Review{Channels: []string{"public", ChannelImport}}})
+
+	assert.Equal(t, ReviewStatusPending, p.InitialStatus("public"))
+	assert.Equal(t, ReviewStatusPending, p.InitialStatus(ChannelImport))
+	assert.Equal(t, ReviewStatusApproved, p.InitialStatus(ChannelAPI))
+	assert.Equal(t, ReviewStatusApproved, NewReviewPolicy(nil).InitialStatus("public"))
+	assert.Equal(t, ChannelAPI, GetChannel(context.Background()))
+}
+
+func TestCreateEmployee_PendingReview(t *testing.T) {
+	uc, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	uc.events = newTestEventBus(pub)
+	uc.review = NewReviewPolicy(&conf.Admin{Review: &conf.Admin_Review{Channels: []string{"public"}}})
+
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"new@example.com"}).Return(map[string]bool{}, nil)
+	repo.On("Create", mock.Anything, "tenant-123", mock.MatchedBy(func(e *Employee) bool {
+		return e.ReviewStatus == ReviewStatusPending
+	})).Return(&Employee{ID: uuid.New(), Emails: []string{"new@example.com"}, ReviewStatus: ReviewStatusPending}, nil)
+
+	created, err := uc.CreateEmployee(reviewContext("public"), &Employee{Emails: []string{"new@example.com"}, FirstName: "Jane", LastName: "Doe"})
+
+	require.NoError(t, err)
+	assert.True(t, created.IsPending())
+	repo.AssertExpectations(t)
+	pub.AssertNotCalled(t, "PublishEmployeeCreated", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestApproveEmployee(t *testing.T) {
+	uc, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	uc.events = newTestEventBus(pub)
+	id := uuid.New()
+	approved := &Employee{ID: id, ReviewStatus: ReviewStatusApproved, Version: 2}
+
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(&Employee{ID: id, ReviewStatus: ReviewStatusPending, Version: 1}, nil)
+	repo.On("Approve", mock.Anything, "tenant-123", id).Return(approved, nil)
+	pub.On("PublishEmployeeCreated", mock.Anything, "tenant-123", "user-456", approved).Return(nil)
+
+	got, err := uc.ApproveEmployee(reviewContext(""), id)
+
+	require.NoError(t, err)
+	assert.Equal(t, approved, got)
+	repo.AssertExpectations(t)
+	pub.AssertExpectations(t)
+}
+
+func TestRejectEmployee(t *testing.T) {
+	uc, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	uc.events = newTestEventBus(pub)
+	pending, approved := uuid.New(), uuid.New()
+
+	repo.On("GetByID", mock.Anything, "tenant-123", pending).Return(&Employee{ID: pending, ReviewStatus: ReviewStatusPending}, nil)
+	repo.On("GetByID", mock.Anything, "tenant-123", approved).Return(&Employee{ID: approved, ReviewStatus: ReviewStatusApproved}, nil)
+	repo.On("Delete", mock.Anything, "tenant-123", pending).Return(nil)
+
+	require.NoError(t, uc.RejectEmployee(reviewContext(""), pending))
+	assert.Equal(t, ErrEmployeeNotPendingReview, uc.RejectEmployee(reviewContext(""), approved))
+	repo.AssertExpectations(t)
+	pub.AssertNotCalled(t, "PublishEmployeeDeleted", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPendingEmployeeVisibility(t *testing.T) {
+	uc, repo := setupUsecase()
+	pending := &Employee{ID: uuid.New(), Emails: []string{"p@example.com"}, ReviewStatus: ReviewStatusPending}
+	approved := &Employee{ID: uuid.New(), Emails: []string{"a@example.com"}, ReviewStatus: ReviewStatusApproved}
+
+	repo.On("GetByEmail", mock.Anything, "tenant-123", "p@example.com").Return(pending, nil)
+	repo.On("GetByEmail", mock.Anything, "tenant-123", "a@example.com").Return(approved, nil)
+
+	_, err := uc.GetEmployeeByEmail(reviewContext(""), "p@example.com")
+	assert.Equal(t, ErrEmployeeNotFound, err)
+
+	_, err = uc.PreviewMerge(reviewContext(""), "a@example.com", "p@example.com")
+	assert.Equal(t, ErrEmployeePendingReview, err)
+}
@@ -0,0 +1,136 @@
+package biz
+
+import (
+	"context"
+	"slices"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Runbook actions, as recorded in the audit log
+const (
+	RunbookFlushCaches        = "flush_caches"
+	RunbookReconnectNATS      = "reconnect_nats"
+	RunbookRotateDatabasePool = "rotate_database_pool"
+	RunbookReloadSecrets      = "reload_secrets"
+)
+
+// ErrRunbookNotPermitted is a runbook action by a caller without one of the
+// runbook roles
+var ErrRunbookNotPermitted = errors.Forbidden(v1.ErrorReason_RUNBOOK_NOT_PERMITTED.String(), "runbook operations are not permitted for the caller's roles")
+
+// RunbookActionUnavailable is the RUNBOOK_ACTION_UNAVAILABLE error of an
+// action whose target is not configured, e.g. a cache flush without cache
+func RunbookActionUnavailable(message string) error {
+	return errors.ServiceUnavailable(v1.ErrorReason_RUNBOOK_ACTION_UNAVAILABLE.String(), message)
+}
+
+// RunbookRepo acts on the connections of this replica
+type RunbookRepo interface {
+	// FlushCaches removes every cached employee and returns the number of
+	// entries removed
+	FlushCaches(ctx context.Context) (int64, error)
+	// ReconnectNATS drops the NATS connection so that it reconnects, and
+	// returns the server it was connected to
+	ReconnectNATS(ctx context.Context) (string, error)
+	// RotateDatabasePool replaces the database connection pool with one
+	// connected with the database credentials resolved again, and returns
+	// the number of connections of the replaced pool
+	RotateDatabasePool(ctx context.Context) (int, error)
+}
+
+// SecretReloader reloads the secrets of this replica
+type SecretReloader interface {
+	// ReloadSecrets renews the Vault token and reloads the JWT secret,
+	// reporting whether it changed
+	ReloadSecrets(ctx context.Context) (bool, error)
+}
+
+// RunbookUsecase runs the operational runbook actions done by hand during
+// incidents. They act on the whole replica, so they are guarded by their own
+// roles, and each one is audited with the reason given by the caller.
+type RunbookUsecase struct {
+	repo    RunbookRepo
+	secrets SecretReloader
+	roles   []string
+	log     *log.Helper
+}
+
+// NewRunbookUsecase creates the runbook usecase
+func NewRunbookUsecase(repo RunbookRepo, secrets SecretReloader, c *conf.Admin, logger log.Logger) *RunbookUsecase {
+	return &RunbookUsecase{
+		repo:    repo,
+		secrets: secrets,
+		roles:   c.GetRunbook().GetRoles(),
+		log:     log.NewHelper(logger),
+	}
+}
+
+// FlushCaches removes every cached employee of all tenants
+func (uc *RunbookUsecase) FlushCaches(ctx context.Context, reason string) (int64, error) {
+	var flushed int64
+	err := uc.run(ctx, RunbookFlushCaches, reason, func() (err error) {
+		flushed, err = uc.repo.FlushCaches(ctx)
+		return err
+	})
+	return flushed, err
+}
+
+// ReconnectNATS reconnects to NATS and returns the server the replica was
+// connected to
+func (uc *RunbookUsecase) ReconnectNATS(ctx context.Context, reason string) (string, error) {
+	var previous string
+	err := uc.run(ctx, RunbookReconnectNATS, reason, func() (err error) {
+		previous, err = uc.repo.ReconnectNATS(ctx)
+		return err
+	})
+	return previous, err
+}
+
+// RotateDatabasePool reconnects to the database and returns the number of
+// connections retired
+func (uc *RunbookUsecase) RotateDatabasePool(ctx context.Context, reason string) (int, error) {
+	var retired int
+	err := uc.run(ctx, RunbookRotateDatabasePool, reason, func() (err error) {
+		retired, err = uc.repo.RotateDatabasePool(ctx)
+		return err
+	})
+	return retired, err
+}
+
+// ReloadSecrets reloads the secrets and reports whether the JWT secret was
+// rotated
+func (uc *RunbookUsecase) ReloadSecrets(ctx context.Context, reason string) (bool, error) {
+	var rotated bool
+	err := uc.run(ctx, RunbookReloadSecrets, reason, func() (err error) {
+		rotated, err = uc.secrets.ReloadSecrets(ctx)
+		return err
+	})
+	return rotated, err
+}
+
+// run checks that the caller may run action and runs it, auditing the
+// request and its outcome
+func (uc *RunbookUsecase) run(ctx context.Context, action, reason string, fn func() error) error {
+	tenantID, _ := GetTenantID(ctx)
+	userID, _ := GetUserID(ctx)
+
+	if !slices.ContainsFunc(GetRoles(ctx), func(role string) bool {
+		return slices.Contains(uc.roles, role)
+	}) {
+		uc.log.WithContext(ctx).Warnf("audit: runbook %s rejected tenant=%s requested_by=%s: not permitted", action, tenantID, userID)
+		return ErrRunbookNotPermitted
+	}
+
+	uc.log.WithContext(ctx).Infof("audit: runbook %s requested tenant=%s requested_by=%s reason=%q", action, tenantID, userID, reason)
+	if err := fn(); err != nil {
+		uc.log.WithContext(ctx).Errorf("audit: runbook %s failed tenant=%s requested_by=%s: %v", action, tenantID, userID, err)
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("audit: runbook %s executed tenant=%s requested_by=%s", action, tenantID, userID)
+	return nil
+}
@@ -0,0 +1,93 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRunbookRepo records the runbook actions run
+type fakeRunbookRepo struct {
+	actions []string
+	err     error
+}
+
+func (r *fakeRunbookRepo) FlushCaches(context.Context) (int64, error) {
+	r.actions = append(r.actions, RunbookFlushCaches)
+	return 12, r.err
+}
+
+func (r *fakeRunbookRepo) ReconnectNATS(context.Context) (string, error) {
+	r.actions = append(r.actions, RunbookReconnectNATS)
+	return "nats://nats-1:4222", r.err
+}
+
+func (r *fakeRunbookRepo) RotateDatabasePool(context.Context) (int, error) {
+	r.actions = append(r.actions, RunbookRotateDatabasePool)
+	return 4, r.err
+}
+
+func (r *fakeRunbookRepo) ReloadSecrets(context.Context) (bool, error) {
+	r.actions = append(r.actions, RunbookReloadSecrets)
+	return true, r.err
+}
+
+func newTestRunbookUsecase(repo *fakeRunbookRepo) *RunbookUsecase {
+	return NewRunbookUsecase(repo, repo, &conf.Admin{
+		Runbook: &conf.Admin_Runbook{Roles: []string{"operator"}},
+	}, log.DefaultLogger)
+}
+
+func TestRunbookUsecase(t *testing.T) {
+	repo := &fakeRunbookRepo{}
+	uc := newTestRunbookUsecase(repo)
+	ctx := WithRoles(WithTenantID(context.Background(), "tenant-1"), []string{"admin", "operator"})
+
+	flushed, err := uc.FlushCaches(ctx, "INC-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), flushed)
+
+	previous, err := uc.ReconnectNATS(ctx, "INC-1")
+	require.NoError(t, err)
+	assert.Equal(t, "nats://nats-1:4222", previous)
+
+	retired, err := uc.RotateDatabasePool(ctx, "INC-1")
+	require.NoError(t, err)
+	assert.Equal(t, 4, retired)
+
+	rotated, err := uc.ReloadSecrets(ctx, "INC-1")
+	require.NoError(t, err)
+	assert.True(t, rotated)
+
+	assert.Equal(t, []string{RunbookFlushCaches, RunbookReconnectNATS, RunbookRotateDatabasePool, RunbookReloadSecrets}, repo.actions)
+}
+
+func TestRunbookUsecase_NotPermitted(t *testing.T) {
+	repo := &fakeRunbookRepo{}
+	ctx := WithRoles(WithTenantID(context.Background(), "tenant-1"), []string{"admin"})
+
+	_, err := newTestRunbookUsecase(repo).FlushCaches(ctx, "INC-1")
+	assert.True(t, kerrors.Is(err, ErrRunbookNotPermitted))
+
+	// Without roles configured, runbook actions are disabled
+	disabled := NewRunbookUsecase(repo, repo, &conf.Admin{}, log.DefaultLogger)
+	_, err = disabled.RotateDatabasePool(WithRoles(ctx, []string{"operator"}), "INC-1")
+	assert.True(t, kerrors.Is(err, ErrRunbookNotPermitted))
+
+	assert.Empty(t, repo.actions)
+}
+
+func TestRunbookUsecase_Failure(t *testing.T) {
+	repo := &fakeRunbookRepo{err: errors.New("connection refused")}
+	ctx := WithRoles(context.Background(), []string{"operator"})
+
+	_, err := newTestRunbookUsecase(repo).RotateDatabasePool(ctx, "INC-1")
+	assert.EqualError(t, err, "connection refused")
+}
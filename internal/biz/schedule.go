@@ -0,0 +1,388 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// Scheduled change operations
+const (
+	ScheduledOperationCreate = "create"
+	ScheduledOperationUpdate = "update"
+)
+
+// Scheduled change statuses
+const (
+	ScheduledStatusPending   = "pending"
+	ScheduledStatusApplied   = "applied"
+	ScheduledStatusFailed    = "failed"
+	ScheduledStatusCancelled = "cancelled"
+)
+
+const (
+	// scheduleLease is how long a worker owns a due change while applying it
+	scheduleLease = time.Minute
+	// maxScheduleAhead bounds how far in the future a change can be scheduled
+	maxScheduleAhead = 5 * 365 * 24 * time.Hour
+	// maxScheduledUpdateAttempts bounds the retries of a scheduled update
+	// racing with other changes of the employee
+	maxScheduledUpdateAttempts = 3
+)
+
+var (
+	// ErrScheduledChangeNotFound is returned when a scheduled change does not
+	// exist in the tenant
+	ErrScheduledChangeNotFound = errors.NotFound(v1.ErrorReason_SCHEDULED_CHANGE_NOT_FOUND.String(), "scheduled change not found")
+	// ErrScheduledChangeNotPending is a cancel of a change that was already
+	// applied, failed, cancelled or is being applied
+	ErrScheduledChangeNotPending = errors.BadRequest(v1.ErrorReason_SCHEDULED_CHANGE_NOT_PENDING.String(), "scheduled change is not pending")
+	// ErrInvalidEffectiveAt is an effective time too far in the future
+	ErrInvalidEffectiveAt = errors.BadRequest(v1.ErrorReason_INVALID_EFFECTIVE_AT.String(), "effective_at must be within 5 years")
+)
+
+// ScheduledChange is a create or update of an employee applied at
+// EffectiveAt
+type ScheduledChange struct {
+	ID        uuid.UUID
+	TenantID  string
+	Operation string
+	// EmployeeID is the employee to update, or the ID the employee is
+	// created with
+	EmployeeID uuid.UUID
+	// Requested values; empty values of an update are left unchanged
+	Emails    []string
+	FirstName string
+	LastName  string
+	// DepartmentID follows the update semantics of Employee.DepartmentID
+	DepartmentID *uuid.UUID
+	// ManagerID follows the update semantics of Employee.ManagerID
+	ManagerID *uuid.UUID
+	Title     string
+	// PhoneNumbers follow the update semantics of Employee.PhoneNumbers
+	PhoneNumbers []PhoneNumber
+	// Address follows the update semantics of Employee.Address
+	Address *Address
+	// ExternalIDs are the requested IDs; in an update an empty ID removes
+	// the employee's ID in its system
+	ExternalIDs map[string]string
+	// Channel is the creation channel of the caller, deciding the review
+	// status of a scheduled create
+	Channel     string
+	EffectiveAt time.Time
+	Status      string
+	Error       string
+	CreatedBy   string
+	CreatedAt   time.Time
+	AppliedAt   *time.Time
+}
+
+// ScheduledChangeFilter represents the options of a scheduled change listing
+type ScheduledChangeFilter struct {
+	// Status defaults to ScheduledStatusPending
+	Status     string
+	EmployeeID *uuid.UUID
+	Page       int32
+	PageSize   int32
+}
+
+// ScheduleRepo stores scheduled changes
+type ScheduleRepo interface {
+	Create(ctx context.Context, change *ScheduledChange) (*ScheduledChange, error)
+	// List returns the changes of a tenant, next due first, and their total
+	List(ctx context.Context, tenantID string, filter *ScheduledChangeFilter) ([]*ScheduledChange, int64, error)
+	// Cancel cancels a pending change that is not being applied. It returns
+	// ErrScheduledChangeNotFound or ErrScheduledChangeNotPending otherwise.
+	Cancel(ctx context.Context, tenantID string, id uuid.UUID) (*ScheduledChange, error)
+	// ClaimDue leases the pending change due first at now, across all
+	// tenants, skipping changes leased by other workers. It returns nil when
+	// nothing is due.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration) (*ScheduledChange, error)
+	// Finish stores the status, error and applied time of a claimed change
+	// and releases its lease
+	Finish(ctx context.Context, change *ScheduledChange) error
+}
+
+// ScheduleUsecase schedules creates and updates of employees for a future
+// time and applies them once due
+type ScheduleUsecase struct {
+	schedules ScheduleRepo
+	repo      EmployeeRepo
+	employees *EmployeeUsecase
+	clock     Clock
+	ids       IDGenerator
+	log       *log.Helper
+}
+
+// NewScheduleUsecase creates a new Schedule usecase.
+func NewScheduleUsecase(schedules ScheduleRepo, repo EmployeeRepo, employees *EmployeeUsecase, clock Clock, ids IDGenerator, logger log.Logger) *ScheduleUsecase {
+	return &ScheduleUsecase{
+		schedules: schedules,
+		repo:      repo,
+		employees: employees,
+		clock:     clock,
+		ids:       ids,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// ScheduleCreate schedules the creation of employee at effectiveAt. It returns
+// nil when effectiveAt is not in the future; the caller creates the employee
+// right away then.
+func (uc *ScheduleUsecase) ScheduleCreate(ctx context.Context, employee *Employee, effectiveAt time.Time) (*ScheduledChange, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if due, err := uc.checkEffectiveAt(effectiveAt); due || err != nil {
+		return nil, err
+	}
+	if len(employee.Emails) == 0 {
+		return nil, ErrInvalidEmail
+	}
+	employee.Emails = uc.employees.emails.NormalizeAll(tenantID, employee.Emails)
+	if employee.PhoneNumbers, err = normalizePhoneNumbers(employee.PhoneNumbers); err != nil {
+		return nil, err
+	}
+	if employee.Address, err = normalizeAddress(employee.Address); err != nil {
+		return nil, err
+	}
+	if employee.ExternalIDs, err = normalizeExternalIDs(employee.ExternalIDs, false); err != nil {
+		return nil, err
+	}
+
+	// Fail early on emails that are not allowed or taken now; they are
+	// checked again when the change is applied
+	if err := uc.employees.emails.CheckStrict(ctx, tenantID, employee.Emails); err != nil {
+		return nil, err
+	}
+	if err := uc.employees.emails.CheckDomains(ctx, tenantID, employee.Emails); err != nil {
+		return nil, err
+	}
+	exists, err := uc.repo.CheckEmailsExist(ctx, tenantID, employee.Emails)
+	if err != nil {
+		return nil, err
+	}
+	for _, email := range employee.Emails {
+		if exists[email] {
+			return nil, ErrEmployeeAlreadyExists
+		}
+	}
+
+	uc.log.WithContext(ctx).Infof("ScheduleCreate: tenant=%s, emails=%v, effective_at=%s", tenantID, employee.Emails, effectiveAt)
+
+	return uc.create(ctx, tenantID, &ScheduledChange{
+		Operation:    ScheduledOperationCreate,
+		EmployeeID:   uc.ids.NewID(),
+		Emails:       employee.Emails,
+		FirstName:    employee.FirstName,
+		LastName:     employee.LastName,
+		DepartmentID: employee.DepartmentID,
+		ManagerID:    employee.ManagerID,
+		Title:        employee.Title,
+		PhoneNumbers: employee.PhoneNumbers,
+		Address:      employee.Address,
+		ExternalIDs:  employee.ExternalIDs,
+		Channel:      GetChannel(ctx),
+		EffectiveAt:  effectiveAt,
+	})
+}
+
+// ScheduleUpdate schedules an update of employee at effectiveAt. It returns
+// nil when effectiveAt is not in the future; the caller updates the employee
+// right away then.
+func (uc *ScheduleUsecase) ScheduleUpdate(ctx context.Context, employee *Employee, effectiveAt time.Time) (*ScheduledChange, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if due, err := uc.checkEffectiveAt(effectiveAt); due || err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.repo.GetByID(ctx, tenantID, employee.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrEmployeeNotFound
+	}
+	if employee.PhoneNumbers, err = normalizePhoneNumbers(employee.PhoneNumbers); err != nil {
+		return nil, err
+	}
+	if employee.Address, err = normalizeAddress(employee.Address); err != nil {
+		return nil, err
+	}
+	if employee.ExternalIDs, err = normalizeExternalIDs(employee.ExternalIDs, true); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("ScheduleUpdate: tenant=%s, id=%s, effective_at=%s", tenantID, employee.ID, effectiveAt)
+
+	return uc.create(ctx, tenantID, &ScheduledChange{
+		Operation:    ScheduledOperationUpdate,
+		EmployeeID:   employee.ID,
+		Emails:       employee.Emails,
+		FirstName:    employee.FirstName,
+		LastName:     employee.LastName,
+		DepartmentID: employee.DepartmentID,
+		ManagerID:    employee.ManagerID,
+		Title:        employee.Title,
+		PhoneNumbers: employee.PhoneNumbers,
+		Address:      employee.Address,
+		ExternalIDs:  employee.ExternalIDs,
+		Channel:      GetChannel(ctx),
+		EffectiveAt:  effectiveAt,
+	})
+}
+
+// checkEffectiveAt reports whether a change effective at t is due now, and
+// rejects times too far ahead
+func (uc *ScheduleUsecase) checkEffectiveAt(t time.Time) (bool, error) {
+	now := uc.clock.Now()
+	if !t.After(now) {
+		return true, nil
+	}
+	if t.Sub(now) > maxScheduleAhead {
+		return false, ErrInvalidEffectiveAt
+	}
+	return false, nil
+}
+
+// create stores a pending change of the caller
+func (uc *ScheduleUsecase) create(ctx context.Context, tenantID string, change *ScheduledChange) (*ScheduledChange, error) {
+	userID, _ := GetUserID(ctx)
+	change.ID = uc.ids.NewID()
+	change.TenantID = tenantID
+	change.Status = ScheduledStatusPending
+	change.CreatedBy = userID
+	change.CreatedAt = uc.clock.Now().UTC()
+	change.EffectiveAt = change.EffectiveAt.UTC()
+	return uc.schedules.Create(ctx, change)
+}
+
+// ListScheduledChanges lists the scheduled changes of the caller's tenant.
+func (uc *ScheduleUsecase) ListScheduledChanges(ctx context.Context, filter *ScheduledChangeFilter) ([]*ScheduledChange, int64, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if filter.Status == "" {
+		filter.Status = ScheduledStatusPending
+	}
+	page := &ListFilter{Page: filter.Page, PageSize: filter.PageSize}
+	applyPagination(page)
+	filter.Page, filter.PageSize = page.Page, page.PageSize
+
+	return uc.schedules.List(ctx, tenantID, filter)
+}
+
+// CancelScheduledChange cancels a pending change of the caller's tenant.
+func (uc *ScheduleUsecase) CancelScheduledChange(ctx context.Context, id uuid.UUID) (*ScheduledChange, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("CancelScheduledChange: tenant=%s, id=%s", tenantID, id)
+
+	return uc.schedules.Cancel(ctx, tenantID, id)
+}
+
+// RunNext claims and applies the next due change. It reports whether a
+// change was found. Changes rejected by the usecases, e.g. because an email
+// was taken in the meantime, are marked failed; other errors leave the change
+// pending, to be retried once its lease expires.
+func (uc *ScheduleUsecase) RunNext(ctx context.Context) (bool, error) {
+	change, err := uc.schedules.ClaimDue(ctx, uc.clock.Now().UTC(), scheduleLease)
+	if err != nil || change == nil {
+		return false, err
+	}
+
+	// The change is audited and published as the user who scheduled it
+	ctx = WithTenantID(ctx, change.TenantID)
+	ctx = WithUserID(ctx, change.CreatedBy)
+	ctx = WithRequestID(ctx, "schedule-"+change.ID.String())
+	ctx = WithChannel(ctx, change.Channel)
+	ctx = WithSource(ctx, SourceSchedule)
+
+	if err := uc.apply(ctx, change); err != nil {
+		if errors.FromError(err).Code >= 500 {
+			return true, err
+		}
+		change.Status = ScheduledStatusFailed
+		change.Error = errors.FromError(err).Message
+		uc.log.WithContext(ctx).Warnf("scheduled %s %s of employee %s failed: %v", change.Operation, change.ID, change.EmployeeID, err)
+	} else {
+		now := uc.clock.Now().UTC()
+		change.Status = ScheduledStatusApplied
+		change.AppliedAt = &now
+	}
+	return true, uc.schedules.Finish(ctx, change)
+}
+
+// apply performs a due change through the employee usecase, which audits and
+// publishes it like any other create or update
+func (uc *ScheduleUsecase) apply(ctx context.Context, change *ScheduledChange) error {
+	switch change.Operation {
+	case ScheduledOperationCreate:
+		// A worker stopped between creating the employee and finishing the
+		// change already applied it
+		existing, err := uc.repo.GetByID(ctx, change.TenantID, change.EmployeeID)
+		if err != nil || existing != nil {
+			return err
+		}
+		_, err = uc.employees.CreateEmployee(ctx, &Employee{
+			ID:           change.EmployeeID,
+			Emails:       change.Emails,
+			FirstName:    change.FirstName,
+			LastName:     change.LastName,
+			DepartmentID: change.DepartmentID,
+			ManagerID:    change.ManagerID,
+			Title:        change.Title,
+			PhoneNumbers: change.PhoneNumbers,
+			Address:      change.Address,
+			ExternalIDs:  change.ExternalIDs,
+		})
+		return err
+
+	case ScheduledOperationUpdate:
+		// Scheduled updates apply to the current version of the employee
+		var err error
+		for range maxScheduledUpdateAttempts {
+			var existing *Employee
+			existing, err = uc.repo.GetByID(ctx, change.TenantID, change.EmployeeID)
+			if err != nil {
+				return err
+			}
+			if existing == nil {
+				return ErrEmployeeNotFound
+			}
+			_, err = uc.employees.UpdateEmployee(ctx, &Employee{
+				ID:           change.EmployeeID,
+				Version:      existing.Version,
+				Emails:       change.Emails,
+				FirstName:    change.FirstName,
+				LastName:     change.LastName,
+				DepartmentID: change.DepartmentID,
+				ManagerID:    change.ManagerID,
+				Title:        change.Title,
+				PhoneNumbers: change.PhoneNumbers,
+				Address:      change.Address,
+				ExternalIDs:  change.ExternalIDs,
+			})
+			if !errors.Is(err, ErrVersionMismatch) {
+				return err
+			}
+		}
+		return err
+	}
+	return fmt.Errorf("unknown scheduled operation %q", change.Operation)
+}
@@ -0,0 +1,164 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockScheduleRepo is a mock implementation of ScheduleRepo
+type MockScheduleRepo struct {
+	mock.Mock
+}
+
+func (m *MockScheduleRepo) Create(ctx context.Context, change *ScheduledChange) (*ScheduledChange, error) {
+	args := m.Called(ctx, change)
+	return change, args.Error(0)
+}
+
+func (m *MockScheduleRepo) List(ctx context.Context, tenantID string, filter *ScheduledChangeFilter) ([]*ScheduledChange, int64, error) {
+	args := m.Called(ctx, tenantID, filter)
+	return args.Get(0).([]*ScheduledChange), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockScheduleRepo) Cancel(ctx context.Context, tenantID string, id uuid.UUID) (*ScheduledChange, error) {
+	args := m.Called(ctx, tenantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ScheduledChange), args.Error(1)
+}
+
+func (m *MockScheduleRepo) ClaimDue(ctx context.Context, now time.Time, lease time.Duration) (*ScheduledChange, error) {
+	args := m.Called(ctx, now, lease)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ScheduledChange), args.Error(1)
+}
+
+func (m *MockScheduleRepo) Finish(ctx context.Context, change *ScheduledChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+var scheduleNow = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func setupScheduleUsecase() (*ScheduleUsecase, *MockScheduleRepo, *MockEmployeeRepo, *MockEventPublisher) {
+	employees, repo := setupUsecase()
+	pub := new(MockEventPublisher)
+	employees.events = newTestEventBus(pub)
+	schedules := new(MockScheduleRepo)
+	uc := NewScheduleUsecase(schedules, repo, employees,
+		ClockFunc(func() time.Time { return scheduleNow }),
+		IDGeneratorFunc(uuid.New),
+		log.NewStdLogger(io.Discard))
+	return uc, schedules, repo, pub
+}
+
+func TestScheduleCreate(t *testing.T) {
+	uc, schedules, repo, _ := setupScheduleUsecase()
+	ctx := reviewContext("public")
+	employee := &Employee{Emails: []string{"new@example.com"}, FirstName: "Jane", LastName: "Doe"}
+	effectiveAt := scheduleNow.Add(14 * 24 * time.Hour)
+
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"new@example.com"}).Return(map[string]bool{}, nil)
+	schedules.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	change, err := uc.ScheduleCreate(ctx, employee, effectiveAt)
+	require.NoError(t, err)
+	assert.Equal(t, ScheduledOperationCreate, change.Operation)
+	assert.Equal(t, ScheduledStatusPending, change.Status)
+	assert.NotEqual(t, uuid.Nil, change.EmployeeID)
+	assert.Equal(t, "user-456", change.CreatedBy)
+	assert.Equal(t, "public", change.Channel)
+	assert.Equal(t, effectiveAt, change.EffectiveAt)
+
+	// Changes due now are made by the caller
+	change, err = uc.ScheduleCreate(ctx, employee, scheduleNow)
+	assert.NoError(t, err)
+	assert.Nil(t, change)
+
+	_, err = uc.ScheduleCreate(ctx, employee, scheduleNow.Add(6*365*24*time.Hour))
+	assert.ErrorIs(t, err, ErrInvalidEffectiveAt)
+	schedules.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestScheduleCreate_EmailTaken(t *testing.T) {
+	uc, schedules, repo, _ := setupScheduleUsecase()
+
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"taken@example.com"}).Return(map[string]bool{"taken@example.com": true}, nil)
+
+	_, err := uc.ScheduleCreate(reviewContext(""), &Employee{Emails: []string{"taken@example.com"}}, scheduleNow.Add(time.Hour))
+	assert.ErrorIs(t, err, ErrEmployeeAlreadyExists)
+	schedules.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestScheduleRunNext_AppliesCreate(t *testing.T) {
+	uc, schedules, repo, pub := setupScheduleUsecase()
+	change := &ScheduledChange{
+		ID:         uuid.New(),
+		TenantID:   "tenant-123",
+		Operation:  ScheduledOperationCreate,
+		EmployeeID: uuid.New(),
+		Emails:     []string{"new@example.com"},
+		FirstName:  "Jane",
+		LastName:   "Doe",
+		Channel:    ChannelAPI,
+		Status:     ScheduledStatusPending,
+		CreatedBy:  "user-456",
+	}
+	created := &Employee{ID: change.EmployeeID, Emails: change.Emails, Version: 1}
+
+	schedules.On("ClaimDue", mock.Anything, scheduleNow, scheduleLease).Return(change, nil)
+	repo.On("GetByID", mock.Anything, "tenant-123", change.EmployeeID).Return(nil, nil)
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", change.Emails).Return(map[string]bool{}, nil)
+	repo.On("Create", mock.Anything, "tenant-123", mock.MatchedBy(func(e *Employee) bool {
+		return e.ID == change.EmployeeID && e.FirstName == "Jane"
+	})).Return(created, nil)
+	pub.On("PublishEmployeeCreated", mock.Anything, "tenant-123", "user-456", created).Return(nil)
+	schedules.On("Finish", mock.Anything, mock.MatchedBy(func(c *ScheduledChange) bool {
+		return c.Status == ScheduledStatusApplied && c.AppliedAt.Equal(scheduleNow)
+	})).Return(nil)
+
+	found, err := uc.RunNext(context.Background())
+	require.NoError(t, err)
+	assert.True(t, found)
+	schedules.AssertExpectations(t)
+	repo.AssertExpectations(t)
+	pub.AssertExpectations(t)
+}
+
+func TestScheduleRunNext_UpdateFails(t *testing.T) {
+	uc, schedules, repo, _ := setupScheduleUsecase()
+	id := uuid.New()
+	change := &ScheduledChange{ID: uuid.New(), TenantID: "tenant-123", Operation: ScheduledOperationUpdate, EmployeeID: id, Emails: []string{"taken@example.com"}}
+
+	schedules.On("ClaimDue", mock.Anything, scheduleNow, scheduleLease).Return(change, nil)
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(&Employee{ID: id, Emails: []string{"old@example.com"}, Version: 4}, nil)
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"taken@example.com"}).Return(map[string]bool{"taken@example.com": true}, nil)
+	schedules.On("Finish", mock.Anything, mock.MatchedBy(func(c *ScheduledChange) bool {
+		return c.Status == ScheduledStatusFailed && c.Error == ErrEmployeeAlreadyExists.Message && c.AppliedAt == nil
+	})).Return(nil)
+
+	found, err := uc.RunNext(context.Background())
+	require.NoError(t, err)
+	assert.True(t, found)
+	schedules.AssertExpectations(t)
+}
+
+func TestScheduleRunNext_NothingDue(t *testing.T) {
+	uc, schedules, _, _ := setupScheduleUsecase()
+	schedules.On("ClaimDue", mock.Anything, scheduleNow, scheduleLease).Return(nil, nil)
+
+	found, err := uc.RunNext(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
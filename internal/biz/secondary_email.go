@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// MaxEmployeeEmails is the most emails an employee can have, as validated by
+// the API for the email lists of creates and updates
+const MaxEmployeeEmails = 10
+
+var (
+	// ErrEmailLimitExceeded is an email added to an employee that already has
+	// MaxEmployeeEmails emails
+	ErrEmailLimitExceeded = errors.BadRequest(v1.ErrorReason_EMAIL_LIMIT_EXCEEDED.String(), fmt.Sprintf("an employee can have at most %d emails", MaxEmployeeEmails))
+	// ErrPrimaryEmailNotRemovable is the removal of an employee's primary email
+	ErrPrimaryEmailNotRemovable = errors.BadRequest(v1.ErrorReason_PRIMARY_EMAIL_NOT_REMOVABLE.String(), "the primary email cannot be removed, designate another primary email first")
+)
+
+// removedEmails returns the emails of before missing from after, in any case
+func removedEmails(before, after []string) []string {
+	var removed []string
+	for _, email := range before {
+		if !slices.ContainsFunc(after, func(e string) bool { return strings.EqualFold(e, email) }) {
+			removed = append(removed, email)
+		}
+	}
+	return removed
+}
+
+// AddSecondaryEmail adds an email to an employee of the caller's tenant,
+// keeping its primary email. It returns the employee and whether the email
+// was added; an employee already having the email is returned unchanged.
+func (uc *EmployeeUsecase) AddSecondaryEmail(ctx context.Context, id uuid.UUID, email string) (*Employee, bool, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	email = uc.emails.Normalize(tenantID, email)
+
+	return uc.changeEmails(ctx, id, "AddSecondaryEmail", email, func(existing *Employee) ([]string, EmailChanges, error) {
+		if slices.ContainsFunc(existing.Emails, func(e string) bool { return strings.EqualFold(e, email) }) {
+			return nil, EmailChanges{}, nil
+		}
+		if len(existing.Emails) >= MaxEmployeeEmails {
+			return nil, EmailChanges{}, ErrEmailLimitExceeded
+		}
+		if err := uc.emails.CheckStrict(ctx, tenantID, []string{email}); err != nil {
+			return nil, EmailChanges{}, err
+		}
+		if err := uc.emails.CheckDomains(ctx, tenantID, []string{email}); err != nil {
+			return nil, EmailChanges{}, err
+		}
+		exists, err := uc.repo.CheckEmailsExist(ctx, tenantID, []string{email})
+		if err != nil {
+			return nil, EmailChanges{}, err
+		}
+		if exists[email] {
+			return nil, EmailChanges{}, ErrEmployeeAlreadyExists
+		}
+		return append(slices.Clone(existing.Emails), email), EmailChanges{Added: []string{email}}, nil
+	})
+}
+
+// RemoveSecondaryEmail removes an email other than the primary one from an
+// employee of the caller's tenant. It returns the employee and whether the
+// email was removed; an employee without the email is returned unchanged.
+// Emails stored before normalization are matched as given too.
+func (uc *EmployeeUsecase) RemoveSecondaryEmail(ctx context.Context, id uuid.UUID, email string) (*Employee, bool, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	given := strings.TrimSpace(email)
+	email = uc.emails.Normalize(tenantID, email)
+
+	return uc.changeEmails(ctx, id, "RemoveSecondaryEmail", email, func(existing *Employee) ([]string, EmailChanges, error) {
+		i := slices.IndexFunc(existing.Emails, func(e string) bool {
+			return strings.EqualFold(e, email) || strings.EqualFold(e, given)
+		})
+		if i < 0 {
+			return nil, EmailChanges{}, nil
+		}
+		if strings.EqualFold(existing.Emails[i], existing.PrimaryEmail) || len(existing.Emails) == 1 {
+			return nil, EmailChanges{}, ErrPrimaryEmailNotRemovable
+		}
+		return slices.Delete(slices.Clone(existing.Emails), i, i+1), EmailChanges{Removed: []string{existing.Emails[i]}}, nil
+	})
+}
+
+// changeEmails replaces the emails of an employee by the result of change,
+// unless change returns nil emails. Like changeTags, the update is based on
+// the version read.
+func (uc *EmployeeUsecase) changeEmails(ctx context.Context, id uuid.UUID, operation, email string, change func(*Employee) ([]string, EmailChanges, error)) (*Employee, bool, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	uc.log.WithContext(ctx).Infof("%s: tenant=%s, id=%s, email=%s", operation, tenantID, id, email)
+
+	var employee *Employee
+	var changes EmailChanges
+	err = uc.tx.Transaction(ctx, func(ctx context.Context) error {
+		existing, err := uc.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+
+		var emails []string
+		emails, changes, err = change(existing)
+		if err != nil {
+			return err
+		}
+		if emails == nil {
+			employee = existing
+			return nil
+		}
+
+		employee, err = uc.repo.Update(ctx, tenantID, &Employee{ID: id, TenantID: tenantID, Version: existing.Version, Emails: emails})
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	changed := len(changes.Added) > 0 || len(changes.Removed) > 0
+	if changed {
+		// Publish event (best-effort)
+		userID, _ := GetUserID(ctx)
+		uc.events.Publish(ctx, &DomainEvent{
+			Type:          EventEmployeeUpdated,
+			TenantID:      tenantID,
+			UserID:        userID,
+			Employee:      employee,
+			UpdatedFields: []string{"emails"},
+			Emails:        changes,
+		})
+	}
+	return employee, changed, nil
+}
@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddSecondaryEmail(t *testing.T) {
+	id := uuid.New()
+	full := make([]string, MaxEmployeeEmails)
+	for i := range full {
+		full[i] = uuid.NewString() + "@example.com"
+	}
+
+	tests := []struct {
+		name       string
+		emails     []string
+		email      string
+		taken      bool
+		wantEmails []string
+		wantErr    error
+	}{
+		{name: "added", emails: []string{"jane@example.com"}, email: " Jane.Doe@Example.com", wantEmails: []string{"jane@example.com", "jane.doe@example.com"}},
+		{name: "already the employee's", emails: []string{"jane@example.com"}, email: "JANE@example.com"},
+		{name: "taken", emails: []string{"jane@example.com"}, email: "john@example.com", taken: true, wantErr: ErrEmployeeAlreadyExists},
+		{name: "limit", emails: full, email: "jane@example.com", wantErr: ErrEmailLimitExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo := setupUsecase()
+			pub := new(MockEventPublisher)
+			uc.events = newTestEventBus(pub)
+			existing := &Employee{ID: id, TenantID: "tenant-123", Version: 3, Emails: tt.emails, PrimaryEmail: tt.emails[0]}
+			updated := &Employee{ID: id, TenantID: "tenant-123", Version: 4, Emails: tt.wantEmails, PrimaryEmail: tt.emails[0]}
+
+			repo.On("GetByID", mock.Anything, "tenant-123", id).Return(existing, nil)
+			repo.On("CheckEmailsExist", mock.Anything, "tenant-123", mock.Anything).Return(map[string]bool{"john@example.com": tt.taken}, nil)
+			repo.On("Update", mock.Anything, "tenant-123", &Employee{ID: id, TenantID: "tenant-123", Version: 3, Emails: tt.wantEmails}).Return(updated, nil)
+			pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "", updated, []string{"emails"}, EmailChanges{Added: []string{"jane.doe@example.com"}}).Return(nil)
+
+			employee, added, err := uc.AddSecondaryEmail(WithTenantID(context.Background(), "tenant-123"), id, tt.email)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantEmails == nil {
+				assert.False(t, added)
+				assert.Same(t, existing, employee)
+				repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				pub.AssertNotCalled(t, "PublishEmployeeUpdated", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.True(t, added)
+			assert.Same(t, updated, employee)
+			pub.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRemoveSecondaryEmail(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name       string
+		emails     []string
+		email      string
+		wantEmails []string
+		wantErr    error
+	}{
+		{name: "removed", emails: []string{"jane@example.com", "j.doe@example.com"}, email: "J.Doe@example.com", wantEmails: []string{"jane@example.com"}},
+		{name: "not the employee's", emails: []string{"jane@example.com"}, email: "john@example.com"},
+		{name: "primary", emails: []string{"jane@example.com", "j.doe@example.com"}, email: "jane@example.com", wantErr: ErrPrimaryEmailNotRemovable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo := setupUsecase()
+			pub := new(MockEventPublisher)
+			uc.events = newTestEventBus(pub)
+			existing := &Employee{ID: id, TenantID: "tenant-123", Version: 3, Emails: tt.emails, PrimaryEmail: "jane@example.com"}
+			updated := &Employee{ID: id, TenantID: "tenant-123", Version: 4, Emails: tt.wantEmails, PrimaryEmail: "jane@example.com"}
+
+			repo.On("GetByID", mock.Anything, "tenant-123", id).Return(existing, nil)
+			repo.On("Update", mock.Anything, "tenant-123", &Employee{ID: id, TenantID: "tenant-123", Version: 3, Emails: tt.wantEmails}).Return(updated, nil)
+			pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "", updated, []string{"emails"}, EmailChanges{Removed: []string{"j.doe@example.com"}}).Return(nil)
+
+			employee, removed, err := uc.RemoveSecondaryEmail(WithTenantID(context.Background(), "tenant-123"), id, tt.email)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantEmails == nil {
+				assert.False(t, removed)
+				assert.Same(t, existing, employee)
+				repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.True(t, removed)
+			assert.Same(t, updated, employee)
+			pub.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Signals for which an employee is flagged stale
+const (
+	// StaleReasonUntouched means the employee was not changed for
+	// admin.stale.untouched_for
+	StaleReasonUntouched = "untouched"
+	// StaleReasonUndeliverableEmail means an email of the employee bounced
+	// or was reported as spam
+	StaleReasonUndeliverableEmail = "undeliverable_email"
+)
+
+// How admin.stale.undeliverable_emails weighs undeliverable emails
+const (
+	// StaleUndeliverableIgnore flags untouched employees whatever their
+	// emails
+	StaleUndeliverableIgnore = "ignore"
+	// StaleUndeliverableRequire only flags untouched employees that also
+	// have an undeliverable email
+	StaleUndeliverableRequire = "require"
+	// StaleUndeliverableAccept flags untouched employees and those having an
+	// undeliverable email
+	StaleUndeliverableAccept = "accept"
+)
+
+// StaleCriteria selects the employees to flag stale
+type StaleCriteria struct {
+	// UntouchedBefore is the time employees were last changed before to be
+	// untouched
+	UntouchedBefore time.Time
+	// Undeliverable is StaleUndeliverableIgnore, StaleUndeliverableRequire
+	// or StaleUndeliverableAccept
+	Undeliverable string
+}
+
+// defaultStaleBatchSize is the number of employees flagged per batch
+const defaultStaleBatchSize = 500
+
+// IsStale reports whether the employee was flagged stale and not changed
+// since
+func (e *Employee) IsStale() bool {
+	return e.StaleAt != nil && !e.StaleAt.Before(e.UpdatedAt)
+}
+
+// StaleUsecase flags employees that look abandoned so that cleanup workflows
+// can review them
+type StaleUsecase struct {
+	repo   EmployeeRepo
+	events *EventBus
+	clock  Clock
+	// untouchedFor is 0 when flagging is disabled
+	untouchedFor  time.Duration
+	undeliverable string
+	batchSize     int
+	log           *log.Helper
+}
+
+// NewStaleUsecase creates a new Stale usecase with the signals configured
+// in c.
+func NewStaleUsecase(c *conf.Admin, repo EmployeeRepo, events *EventBus, clock Clock, logger log.Logger) (*StaleUsecase, error) {
+	uc := &StaleUsecase{
+		repo:          repo,
+		events:        events,
+		clock:         clock,
+		undeliverable: StaleUndeliverableIgnore,
+		batchSize:     defaultStaleBatchSize,
+		log:           log.NewHelper(logger),
+	}
+	if d := c.GetStale().GetUntouchedFor(); d != nil {
+		uc.untouchedFor = d.AsDuration()
+	}
+	switch u := c.GetStale().GetUndeliverableEmails(); u {
+	case "":
+	case StaleUndeliverableIgnore, StaleUndeliverableRequire, StaleUndeliverableAccept:
+		uc.undeliverable = u
+	default:
+		return nil, fmt.Errorf("admin.stale: unknown undeliverable_emails %q", u)
+	}
+	if n := c.GetStale().GetBatchSize(); n > 0 {
+		uc.batchSize = int(n)
+	}
+	return uc, nil
+}
+
+// Enabled reports whether any employee can be flagged
+func (uc *StaleUsecase) Enabled() bool {
+	return uc.untouchedFor > 0
+}
+
+// FlagNext flags a batch of stale employees across all tenants and publishes
+// an event for each. It returns the number flagged and whether the batch was
+// full, i.e. more may be left.
+func (uc *StaleUsecase) FlagNext(ctx context.Context) (int, bool, error) {
+	if !uc.Enabled() {
+		return 0, false, nil
+	}
+
+	now := uc.clock.Now().UTC()
+	criteria := &StaleCriteria{UntouchedBefore: now.Add(-uc.untouchedFor), Undeliverable: uc.undeliverable}
+	employees, err := uc.repo.FlagStale(ctx, criteria, now, uc.batchSize)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, employee := range employees {
+		uc.events.Publish(ctx, &DomainEvent{
+			Type:         EventEmployeeStale,
+			TenantID:     employee.TenantID,
+			Employee:     employee,
+			StaleReasons: criteria.reasons(employee),
+		})
+	}
+	return len(employees), len(employees) == uc.batchSize, nil
+}
+
+// reasons returns the signals a flagged employee met
+func (c *StaleCriteria) reasons(e *Employee) []string {
+	var reasons []string
+	if e.UpdatedAt.Before(c.UntouchedBefore) {
+		reasons = append(reasons, StaleReasonUntouched)
+	}
+	if c.Undeliverable != StaleUndeliverableIgnore && len(e.UndeliverableEmails) > 0 {
+		reasons = append(reasons, StaleReasonUndeliverableEmail)
+	}
+	return reasons
+}
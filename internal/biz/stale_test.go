@@ -0,0 +1,112 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestEmployee_IsStale(t *testing.T) {
+	flagged := scheduleNow.Add(-time.Hour)
+
+	assert.False(t, (&Employee{UpdatedAt: scheduleNow}).IsStale())
+	assert.True(t, (&Employee{UpdatedAt: flagged.Add(-time.Hour), StaleAt: &flagged}).IsStale())
+	assert.False(t, (&Employee{UpdatedAt: scheduleNow, StaleAt: &flagged}).IsStale(), "changed since flagged")
+}
+
+func TestNewStaleUsecase_UnknownUndeliverableEmails(t *testing.T) {
+	_, err := NewStaleUsecase(&conf.Admin{Stale: &conf.Admin_Stale{UndeliverableEmails: "sometimes"}}, new(MockEmployeeRepo), nil, NewSystemClock(), log.NewStdLogger(io.Discard))
+
+	assert.ErrorContains(t, err, `unknown undeliverable_emails "sometimes"`)
+}
+
+func TestFlagNext(t *testing.T) {
+	untouchedBefore := scheduleNow.Add(-24 * time.Hour)
+	newUsecase := func(c *conf.Admin_Stale) (*StaleUsecase, *MockEmployeeRepo, *MockEventPublisher) {
+		repo := new(MockEmployeeRepo)
+		pub := new(MockEventPublisher)
+		uc, err := NewStaleUsecase(&conf.Admin{Stale: c}, repo, newTestEventBus(pub),
+			ClockFunc(func() time.Time { return scheduleNow }),
+			log.NewStdLogger(io.Discard))
+		require.NoError(t, err)
+		return uc, repo, pub
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		uc, repo, _ := newUsecase(nil)
+
+		flagged, more, err := uc.FlagNext(context.Background())
+
+		require.NoError(t, err)
+		assert.False(t, uc.Enabled())
+		assert.Zero(t, flagged)
+		assert.False(t, more)
+		repo.AssertNotCalled(t, "FlagStale", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("flags and publishes", func(t *testing.T) {
+		uc, repo, pub := newUsecase(&conf.Admin_Stale{UntouchedFor: durationpb.New(24 * time.Hour), BatchSize: 2})
+		a := &Employee{ID: uuid.New(), TenantID: "tenant-a", UpdatedAt: untouchedBefore.Add(-time.Hour)}
+		b := &Employee{ID: uuid.New(), TenantID: "tenant-b", UpdatedAt: untouchedBefore.Add(-time.Hour)}
+		repo.On("FlagStale", mock.Anything, &StaleCriteria{UntouchedBefore: untouchedBefore, Undeliverable: StaleUndeliverableIgnore}, scheduleNow, 2).Return([]*Employee{a, b}, nil)
+		pub.On("PublishEmployeeStale", mock.Anything, "tenant-a", "", a, []string{StaleReasonUntouched}).Return(nil)
+		pub.On("PublishEmployeeStale", mock.Anything, "tenant-b", "", b, []string{StaleReasonUntouched}).Return(nil)
+
+		flagged, more, err := uc.FlagNext(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, flagged)
+		assert.True(t, more, "a full batch may leave more")
+		pub.AssertExpectations(t)
+	})
+
+	bounced := []UndeliverableEmail{{Email: "jane@example.com", Reason: EmailBounceReasonBounce, Since: untouchedBefore}}
+	tests := []struct {
+		name          string
+		undeliverable string
+		employee      *Employee
+		wantReasons   []string
+	}{
+		{name: "ignored undeliverable emails", undeliverable: StaleUndeliverableIgnore,
+			employee:    &Employee{UpdatedAt: untouchedBefore.Add(-time.Hour), UndeliverableEmails: bounced},
+			wantReasons: []string{StaleReasonUntouched}},
+		{name: "required undeliverable emails", undeliverable: StaleUndeliverableRequire,
+			employee:    &Employee{UpdatedAt: untouchedBefore.Add(-time.Hour), UndeliverableEmails: bounced},
+			wantReasons: []string{StaleReasonUntouched, StaleReasonUndeliverableEmail}},
+		{name: "accepted undeliverable emails of an untouched employee", undeliverable: StaleUndeliverableAccept,
+			employee:    &Employee{UpdatedAt: untouchedBefore.Add(-time.Hour), UndeliverableEmails: bounced},
+			wantReasons: []string{StaleReasonUntouched, StaleReasonUndeliverableEmail}},
+		{name: "accepted undeliverable emails of a recently changed employee", undeliverable: StaleUndeliverableAccept,
+			employee:    &Employee{UpdatedAt: scheduleNow.Add(-time.Hour), UndeliverableEmails: bounced},
+			wantReasons: []string{StaleReasonUndeliverableEmail}},
+		{name: "accepted undeliverable emails of an untouched employee without any", undeliverable: StaleUndeliverableAccept,
+			employee:    &Employee{UpdatedAt: untouchedBefore.Add(-time.Hour)},
+			wantReasons: []string{StaleReasonUntouched}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, pub := newUsecase(&conf.Admin_Stale{UntouchedFor: durationpb.New(24 * time.Hour), UndeliverableEmails: tt.undeliverable})
+			tt.employee.ID, tt.employee.TenantID = uuid.New(), "tenant-a"
+			repo.On("FlagStale", mock.Anything, &StaleCriteria{UntouchedBefore: untouchedBefore, Undeliverable: tt.undeliverable}, scheduleNow, defaultStaleBatchSize).
+				Return([]*Employee{tt.employee}, nil)
+			pub.On("PublishEmployeeStale", mock.Anything, "tenant-a", "", tt.employee, tt.wantReasons).Return(nil)
+
+			flagged, _, err := uc.FlagNext(context.Background())
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, flagged)
+			pub.AssertExpectations(t)
+		})
+	}
+}
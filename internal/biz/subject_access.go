@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// SubjectAccessExport is everything stored about one employee, bundled to
+// answer a data-subject access request
+type SubjectAccessExport struct {
+	EmployeeID uuid.UUID
+	// Employee is nil once the employee was deleted or merged away; its
+	// audit history is still exported
+	Employee *Employee
+	// Audit is the audit history of the employee, newest first
+	Audit []*AuditEntry
+	// Events are the webhook events sent about the employee, oldest first
+	Events     []*SubjectEvent
+	ExportedAt time.Time
+}
+
+// SubjectEvent is an event about an employee sent to a webhook. EventID is
+// the ID of the audit entry the event was sent for.
+type SubjectEvent struct {
+	EventID   uuid.UUID
+	EventType string
+	WebhookID uuid.UUID
+	// Recipient is the URL of the webhook, empty when it was deleted since
+	Recipient   string
+	Status      string
+	CreatedAt   time.Time
+	DeliveredAt *time.Time
+}
+
+// SubjectAccessUsecase exports the data of single employees
+type SubjectAccessUsecase struct {
+	repo     EmployeeRepo
+	audit    AuditRepo
+	webhooks WebhookRepo
+	clock    Clock
+	log      *log.Helper
+}
+
+// NewSubjectAccessUsecase creates a new SubjectAccess usecase.
+func NewSubjectAccessUsecase(repo EmployeeRepo, audit AuditRepo, webhooks WebhookRepo, clock Clock, logger log.Logger) *SubjectAccessUsecase {
+	return &SubjectAccessUsecase{
+		repo:     repo,
+		audit:    audit,
+		webhooks: webhooks,
+		clock:    clock,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// ExportEmployeeData bundles the employee with id, its audit history and the
+// webhook events sent about it. Employees that no longer exist are exported
+// from their audit history; ErrEmployeeNotFound is returned when the tenant
+// holds nothing about id.
+func (uc *SubjectAccessUsecase) ExportEmployeeData(ctx context.Context, id uuid.UUID) (*SubjectAccessExport, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("ExportEmployeeData: tenant=%s, id=%s", tenantID, id)
+
+	employee, err := uc.repo.GetByID(ctx, tenantID, id)
+	if err != nil && err != ErrEmployeeNotFound {
+		return nil, err
+	}
+	entries, _, err := uc.audit.List(ctx, tenantID, &AuditFilter{EmployeeID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil && len(entries) == 0 {
+		return nil, ErrEmployeeNotFound
+	}
+
+	deliveries, err := uc.webhooks.ListEmployeeDeliveries(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	webhooks, err := uc.webhooks.List(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	recipients := make(map[uuid.UUID]string, len(webhooks))
+	for _, w := range webhooks {
+		recipients[w.ID] = w.URL
+	}
+
+	events := make([]*SubjectEvent, len(deliveries))
+	for i, d := range deliveries {
+		events[i] = &SubjectEvent{
+			EventID:     d.EventID,
+			EventType:   d.EventType,
+			WebhookID:   d.WebhookID,
+			Recipient:   recipients[d.WebhookID],
+			Status:      d.Status,
+			CreatedAt:   d.CreatedAt,
+			DeliveredAt: d.DeliveredAt,
+		}
+	}
+
+	return &SubjectAccessExport{
+		EmployeeID: id,
+		Employee:   employee,
+		Audit:      entries,
+		Events:     events,
+		ExportedAt: uc.clock.Now(),
+	}, nil
+}
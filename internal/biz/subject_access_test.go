@@ -0,0 +1,85 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSubjectAccessUsecase(now time.Time) (*SubjectAccessUsecase, *MockEmployeeRepo, *MockAuditRepo, *MockWebhookRepo) {
+	repo := new(MockEmployeeRepo)
+	audit := new(MockAuditRepo)
+	webhooks := new(MockWebhookRepo)
+	clock := ClockFunc(func() time.Time { return now })
+	return NewSubjectAccessUsecase(repo, audit, webhooks, clock, log.NewStdLogger(io.Discard)), repo, audit, webhooks
+}
+
+func TestExportEmployeeData(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	id := uuid.New()
+	webhookID, deletedWebhookID := uuid.New(), uuid.New()
+
+	uc, repo, audit, webhooks := setupSubjectAccessUsecase(now)
+	employee := &Employee{ID: id, Emails: []string{"john@example.com"}}
+	entries := []*AuditEntry{{ID: uuid.New(), EmployeeID: id, Action: AuditActionCreate, After: employee}}
+	repo.On("GetByID", mock.Anything, "tenant-123", id).Return(employee, nil)
+	audit.On("List", mock.Anything, "tenant-123", &AuditFilter{EmployeeID: &id}).Return(entries, int64(1), nil)
+	webhooks.On("ListEmployeeDeliveries", mock.Anything, "tenant-123", id).Return([]*WebhookDelivery{
+		{EventID: entries[0].ID, EventType: WebhookEventEmployeeCreated, WebhookID: webhookID, Status: WebhookDeliverySucceeded},
+		{EventID: entries[0].ID, EventType: WebhookEventEmployeeCreated, WebhookID: deletedWebhookID, Status: WebhookDeliveryFailed},
+	}, nil)
+	webhooks.On("List", mock.Anything, "tenant-123").Return([]*Webhook{{ID: webhookID, URL: "https://hris.example.com/hook"}}, nil)
+
+	export, err := uc.ExportEmployeeData(ctx, id)
+
+	require.NoError(t, err)
+	assert.Equal(t, &SubjectAccessExport{
+		EmployeeID: id,
+		Employee:   employee,
+		Audit:      entries,
+		Events: []*SubjectEvent{
+			{EventID: entries[0].ID, EventType: WebhookEventEmployeeCreated, WebhookID: webhookID, Recipient: "https://hris.example.com/hook", Status: WebhookDeliverySucceeded},
+			{EventID: entries[0].ID, EventType: WebhookEventEmployeeCreated, WebhookID: deletedWebhookID, Status: WebhookDeliveryFailed},
+		},
+		ExportedAt: now,
+	}, export)
+}
+
+func TestExportEmployeeData_Deleted(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "tenant-123")
+	id := uuid.New()
+
+	t.Run("with audit history", func(t *testing.T) {
+		uc, repo, audit, webhooks := setupSubjectAccessUsecase(time.Now())
+		entries := []*AuditEntry{{ID: uuid.New(), EmployeeID: id, Action: AuditActionDelete}}
+		repo.On("GetByID", mock.Anything, "tenant-123", id).Return(nil, ErrEmployeeNotFound)
+		audit.On("List", mock.Anything, "tenant-123", mock.Anything).Return(entries, int64(1), nil)
+		webhooks.On("ListEmployeeDeliveries", mock.Anything, "tenant-123", id).Return([]*WebhookDelivery{}, nil)
+		webhooks.On("List", mock.Anything, "tenant-123").Return([]*Webhook{}, nil)
+
+		export, err := uc.ExportEmployeeData(ctx, id)
+
+		require.NoError(t, err)
+		assert.Nil(t, export.Employee)
+		assert.Equal(t, entries, export.Audit)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		uc, repo, audit, webhooks := setupSubjectAccessUsecase(time.Now())
+		repo.On("GetByID", mock.Anything, "tenant-123", id).Return(nil, ErrEmployeeNotFound)
+		audit.On("List", mock.Anything, "tenant-123", mock.Anything).Return([]*AuditEntry{}, int64(0), nil)
+
+		_, err := uc.ExportEmployeeData(ctx, id)
+
+		assert.Equal(t, ErrEmployeeNotFound, err)
+		webhooks.AssertNotCalled(t, "ListEmployeeDeliveries", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// MaxEmployeeTags is the most tags an employee can have
+const MaxEmployeeTags = 20
+
+// ErrTagLimitExceeded is a tag added to an employee that already has
+// MaxEmployeeTags tags
+var ErrTagLimitExceeded = errors.BadRequest(v1.ErrorReason_TAG_LIMIT_EXCEEDED.String(), fmt.Sprintf("an employee can have at most %d tags", MaxEmployeeTags))
+
+// normalizeTag returns tag as it is stored: trimmed and lowercase
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// normalizeTags returns a copy of tags normalized like normalizeTag, leaving
+// tags unchanged
+func normalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+	normalized := make([]string, len(tags))
+	for i, tag := range tags {
+		normalized[i] = normalizeTag(tag)
+	}
+	return normalized
+}
+
+// AddTag tags an employee of the caller's tenant. It returns the employee
+// and whether the tag was added; an employee already having the tag is
+// returned unchanged.
+func (uc *EmployeeUsecase) AddTag(ctx context.Context, id uuid.UUID, tag string) (*Employee, bool, error) {
+	tag = normalizeTag(tag)
+	return uc.changeTags(ctx, id, "AddTag", tag, func(tags []string) ([]string, error) {
+		if slices.Contains(tags, tag) {
+			return nil, nil
+		}
+		if len(tags) >= MaxEmployeeTags {
+			return nil, ErrTagLimitExceeded
+		}
+		tags = append(slices.Clone(tags), tag)
+		slices.Sort(tags)
+		return tags, nil
+	})
+}
+
+// RemoveTag removes a tag from an employee of the caller's tenant. It
+// returns the employee and whether the tag was removed; an employee without
+// the tag is returned unchanged.
+func (uc *EmployeeUsecase) RemoveTag(ctx context.Context, id uuid.UUID, tag string) (*Employee, bool, error) {
+	tag = normalizeTag(tag)
+	return uc.changeTags(ctx, id, "RemoveTag", tag, func(tags []string) ([]string, error) {
+		if !slices.Contains(tags, tag) {
+			return nil, nil
+		}
+		return slices.DeleteFunc(slices.Clone(tags), func(t string) bool { return t == tag }), nil
+	})
+}
+
+// changeTags replaces the tags of an employee by the result of change,
+// unless change returns nil tags. The update is based on the version read,
+// so concurrent tag changes fail with ErrVersionMismatch instead of being
+// lost.
+func (uc *EmployeeUsecase) changeTags(ctx context.Context, id uuid.UUID, operation, tag string, change func([]string) ([]string, error)) (*Employee, bool, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	uc.log.WithContext(ctx).Infof("%s: tenant=%s, id=%s, tag=%s", operation, tenantID, id, tag)
+
+	var employee *Employee
+	changed := false
+	err = uc.tx.Transaction(ctx, func(ctx context.Context) error {
+		existing, err := uc.repo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+
+		tags, err := change(existing.Tags)
+		if err != nil {
+			return err
+		}
+		if tags == nil {
+			employee = existing
+			return nil
+		}
+
+		employee, err = uc.repo.Update(ctx, tenantID, &Employee{ID: id, TenantID: tenantID, Version: existing.Version, Tags: tags})
+		changed = err == nil
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if changed {
+		// Publish event (best-effort)
+		userID, _ := GetUserID(ctx)
+		uc.events.Publish(ctx, &DomainEvent{
+			Type:          EventEmployeeUpdated,
+			TenantID:      tenantID,
+			UserID:        userID,
+			Employee:      employee,
+			UpdatedFields: []string{"tags"},
+		})
+	}
+	return employee, changed, nil
+}
@@ -0,0 +1,117 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddTag(t *testing.T) {
+	id := uuid.New()
+	full := make([]string, MaxEmployeeTags)
+	for i := range full {
+		full[i] = uuid.NewString()
+	}
+
+	tests := []struct {
+		name     string
+		tags     []string
+		tag      string
+		wantTags []string
+		wantErr  error
+	}{
+		{name: "first tag", tag: "Remote", wantTags: []string{"remote"}},
+		{name: "kept sorted", tags: []string{"alumni", "remote"}, tag: "contractor", wantTags: []string{"alumni", "contractor", "remote"}},
+		{name: "already tagged", tags: []string{"remote"}, tag: " REMOTE "},
+		{name: "limit", tags: full, tag: "remote", wantErr: ErrTagLimitExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo := setupUsecase()
+			pub := new(MockEventPublisher)
+			uc.events = newTestEventBus(pub)
+			existing := &Employee{ID: id, TenantID: "tenant-123", Version: 3, Tags: tt.tags}
+			updated := &Employee{ID: id, TenantID: "tenant-123", Version: 4, Tags: tt.wantTags}
+
+			repo.On("GetByID", mock.Anything, "tenant-123", id).Return(existing, nil)
+			repo.On("Update", mock.Anything, "tenant-123", &Employee{ID: id, TenantID: "tenant-123", Version: 3, Tags: tt.wantTags}).Return(updated, nil)
+			pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "", updated, []string{"tags"}, EmailChanges{}).Return(nil)
+
+			employee, added, err := uc.AddTag(WithTenantID(context.Background(), "tenant-123"), id, tt.tag)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantTags == nil {
+				assert.False(t, added)
+				assert.Same(t, existing, employee)
+				repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				pub.AssertNotCalled(t, "PublishEmployeeUpdated", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.True(t, added)
+			assert.Same(t, updated, employee)
+			pub.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRemoveTag(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name     string
+		tags     []string
+		wantTags []string
+	}{
+		{name: "removed", tags: []string{"alumni", "remote"}, wantTags: []string{"alumni"}},
+		{name: "last tag", tags: []string{"remote"}, wantTags: []string{}},
+		{name: "not tagged", tags: []string{"alumni"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo := setupUsecase()
+			pub := new(MockEventPublisher)
+			uc.events = newTestEventBus(pub)
+			existing := &Employee{ID: id, TenantID: "tenant-123", Version: 3, Tags: tt.tags}
+			updated := &Employee{ID: id, TenantID: "tenant-123", Version: 4, Tags: tt.wantTags}
+
+			repo.On("GetByID", mock.Anything, "tenant-123", id).Return(existing, nil)
+			repo.On("Update", mock.Anything, "tenant-123", &Employee{ID: id, TenantID: "tenant-123", Version: 3, Tags: tt.wantTags}).Return(updated, nil)
+			pub.On("PublishEmployeeUpdated", mock.Anything, "tenant-123", "", updated, []string{"tags"}, EmailChanges{}).Return(nil)
+
+			_, removed, err := uc.RemoveTag(WithTenantID(context.Background(), "tenant-123"), id, "Remote")
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTags != nil, removed)
+			if tt.wantTags == nil {
+				repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			// The remaining tags must replace the stored ones even when empty
+			repo.AssertCalled(t, "Update", mock.Anything, "tenant-123", mock.MatchedBy(func(e *Employee) bool { return e.Tags != nil }))
+		})
+	}
+}
+
+func TestListEmployees_NormalizesTags(t *testing.T) {
+	uc, repo := setupUsecase()
+
+	repo.On("List", mock.Anything, "tenant-123", mock.MatchedBy(func(f *ListFilter) bool {
+		return assert.ObjectsAreEqual([]string{"remote", "contractor"}, f.Tags)
+	})).Return(&ListResult{}, nil)
+
+	_, err := uc.ListEmployees(WithTenantID(context.Background(), "tenant-123"), &ListFilter{Tags: []string{" Remote", "CONTRACTOR"}})
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,197 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrTeamNotFound is returned when a team does not exist in the tenant
+	ErrTeamNotFound = errors.NotFound(v1.ErrorReason_TEAM_NOT_FOUND.String(), "team not found")
+	// ErrTeamAlreadyExists is a team named like another team of the tenant
+	ErrTeamAlreadyExists = errors.Conflict(v1.ErrorReason_TEAM_ALREADY_EXISTS.String(), "team with this name already exists")
+	// ErrTeamMemberNotFound is a removal of an employee that is not a member
+	// of the team
+	ErrTeamMemberNotFound = errors.NotFound(v1.ErrorReason_TEAM_MEMBER_NOT_FOUND.String(), "employee is not a member of the team")
+)
+
+// Team groups employees of a tenant across departments
+type Team struct {
+	ID       uuid.UUID
+	TenantID string
+	// Name is unique within the tenant, case-insensitively
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TeamMember is an employee of a team
+type TeamMember struct {
+	Employee *Employee
+	JoinedAt time.Time
+}
+
+// TeamRepo stores teams and their members
+type TeamRepo interface {
+	// Create stores a new team. It returns ErrTeamAlreadyExists when the name
+	// is taken.
+	Create(ctx context.Context, team *Team) (*Team, error)
+	Get(ctx context.Context, tenantID string, id uuid.UUID) (*Team, error)
+	// AddMember adds an employee to a team and reports whether it was not a
+	// member yet
+	AddMember(ctx context.Context, tenantID string, teamID, employeeID uuid.UUID) (bool, error)
+	// RemoveMember removes an employee from a team, returning
+	// ErrTeamMemberNotFound when it is not a member
+	RemoveMember(ctx context.Context, tenantID string, teamID, employeeID uuid.UUID) error
+	// ListMembers returns a page of the members of a team in the order they
+	// joined, and their total
+	ListMembers(ctx context.Context, tenantID string, teamID uuid.UUID, page, pageSize int32) ([]*TeamMember, int64, error)
+}
+
+// TeamUsecase manages the teams of the caller's tenant and their members
+type TeamUsecase struct {
+	repo      TeamRepo
+	employees EmployeeRepo
+	events    *EventBus
+	ids       IDGenerator
+	log       *log.Helper
+}
+
+// NewTeamUsecase creates a new Team usecase.
+func NewTeamUsecase(repo TeamRepo, employees EmployeeRepo, events *EventBus, ids IDGenerator, logger log.Logger) *TeamUsecase {
+	return &TeamUsecase{
+		repo:      repo,
+		employees: employees,
+		events:    events,
+		ids:       ids,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// CreateTeam creates a team in the caller's tenant.
+func (uc *TeamUsecase) CreateTeam(ctx context.Context, name, description string) (*Team, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("CreateTeam: tenant=%s, name=%s", tenantID, name)
+
+	created, err := uc.repo.Create(ctx, &Team{
+		ID:          uc.ids.NewID(),
+		TenantID:    tenantID,
+		Name:        strings.TrimSpace(name),
+		Description: description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publish(ctx, EventTeamCreated, created, nil)
+	return created, nil
+}
+
+// AddTeamMember adds an employee of the caller's tenant to a team. It reports
+// whether the employee was added; adding a member again changes nothing and
+// publishes no event. Employees pending review cannot join teams.
+func (uc *TeamUsecase) AddTeamMember(ctx context.Context, teamID, employeeID uuid.UUID) (bool, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	uc.log.WithContext(ctx).Infof("AddTeamMember: tenant=%s, team=%s, employee=%s", tenantID, teamID, employeeID)
+
+	team, err := uc.repo.Get(ctx, tenantID, teamID)
+	if err != nil {
+		return false, err
+	}
+	employee, err := uc.employees.GetByID(ctx, tenantID, employeeID)
+	if err != nil {
+		return false, err
+	}
+	if employee.IsPending() {
+		return false, ErrEmployeePendingReview
+	}
+
+	added, err := uc.repo.AddMember(ctx, tenantID, teamID, employeeID)
+	if err != nil || !added {
+		return false, err
+	}
+
+	uc.publish(ctx, EventTeamMemberAdded, team, employee)
+	return true, nil
+}
+
+// RemoveTeamMember removes an employee from a team of the caller's tenant.
+func (uc *TeamUsecase) RemoveTeamMember(ctx context.Context, teamID, employeeID uuid.UUID) error {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("RemoveTeamMember: tenant=%s, team=%s, employee=%s", tenantID, teamID, employeeID)
+
+	team, err := uc.repo.Get(ctx, tenantID, teamID)
+	if err != nil {
+		return err
+	}
+	// Members are removed with their employee, so a member always exists
+	employee, err := uc.employees.GetByID(ctx, tenantID, employeeID)
+	if errors.Is(err, ErrEmployeeNotFound) {
+		return ErrTeamMemberNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := uc.repo.RemoveMember(ctx, tenantID, teamID, employeeID); err != nil {
+		return err
+	}
+
+	uc.publish(ctx, EventTeamMemberRemoved, team, employee)
+	return nil
+}
+
+// ListTeamMembers lists a page of the members of a team of the caller's
+// tenant. It returns the page and page size after defaults.
+func (uc *TeamUsecase) ListTeamMembers(ctx context.Context, teamID uuid.UUID, page, pageSize int32) ([]*TeamMember, int64, int32, int32, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+
+	if _, err := uc.repo.Get(ctx, tenantID, teamID); err != nil {
+		return nil, 0, 0, 0, err
+	}
+
+	filter := &ListFilter{Page: page, PageSize: pageSize}
+	applyPagination(filter)
+
+	members, total, err := uc.repo.ListMembers(ctx, tenantID, teamID, filter.Page, filter.PageSize)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+	return members, total, filter.Page, filter.PageSize, nil
+}
+
+// publish publishes a team change on the event bus (best-effort); member is
+// the added or removed employee of membership changes
+func (uc *TeamUsecase) publish(ctx context.Context, eventType DomainEventType, team *Team, member *Employee) {
+	userID, _ := GetUserID(ctx)
+	uc.events.Publish(ctx, &DomainEvent{
+		Type:     eventType,
+		TenantID: team.TenantID,
+		UserID:   userID,
+		Employee: member,
+		Team:     team,
+	})
+}
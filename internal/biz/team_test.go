@@ -0,0 +1,156 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTeamRepo is a mock implementation of TeamRepo
+type MockTeamRepo struct {
+	mock.Mock
+}
+
+func (m *MockTeamRepo) Create(ctx context.Context, team *Team) (*Team, error) {
+	args := m.Called(ctx, team)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Team), args.Error(1)
+}
+
+func (m *MockTeamRepo) Get(ctx context.Context, tenantID string, id uuid.UUID) (*Team, error) {
+	args := m.Called(ctx, tenantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Team), args.Error(1)
+}
+
+func (m *MockTeamRepo) AddMember(ctx context.Context, tenantID string, teamID, employeeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, tenantID, teamID, employeeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTeamRepo) RemoveMember(ctx context.Context, tenantID string, teamID, employeeID uuid.UUID) error {
+	args := m.Called(ctx, tenantID, teamID, employeeID)
+	return args.Error(0)
+}
+
+func (m *MockTeamRepo) ListMembers(ctx context.Context, tenantID string, teamID uuid.UUID, page, pageSize int32) ([]*TeamMember, int64, error) {
+	args := m.Called(ctx, tenantID, teamID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*TeamMember), args.Get(1).(int64), args.Error(2)
+}
+
+func newTestTeamUsecase(repo TeamRepo, employees EmployeeRepo, pub EventPublisher, id uuid.UUID) *TeamUsecase {
+	return NewTeamUsecase(repo, employees, newTestEventBus(pub), IDGeneratorFunc(func() uuid.UUID { return id }), log.NewStdLogger(io.Discard))
+}
+
+func TestCreateTeam(t *testing.T) {
+	repo := new(MockTeamRepo)
+	pub := new(MockEventPublisher)
+	id := uuid.New()
+	uc := newTestTeamUsecase(repo, new(MockEmployeeRepo), pub, id)
+	ctx := WithUserID(WithTenantID(context.Background(), "tenant-123"), "user-1")
+
+	created := &Team{ID: id, TenantID: "tenant-123", Name: "Platform"}
+	repo.On("Create", mock.Anything, &Team{ID: id, TenantID: "tenant-123", Name: "Platform", Description: "On call"}).
+		Return(created, nil)
+	pub.On("PublishTeamCreated", mock.Anything, "tenant-123", "user-1", created).Return(nil)
+
+	got, err := uc.CreateTeam(ctx, " Platform ", "On call")
+
+	require.NoError(t, err)
+	assert.Equal(t, created, got)
+	repo.AssertExpectations(t)
+	pub.AssertExpectations(t)
+}
+
+func TestAddTeamMember(t *testing.T) {
+	teamID, employeeID := uuid.New(), uuid.New()
+	team := &Team{ID: teamID, TenantID: "tenant-123", Name: "Platform"}
+	employee := &Employee{ID: employeeID, TenantID: "tenant-123", ReviewStatus: ReviewStatusApproved}
+
+	t.Run("added", func(t *testing.T) {
+		repo, employees, pub := new(MockTeamRepo), new(MockEmployeeRepo), new(MockEventPublisher)
+		uc := newTestTeamUsecase(repo, employees, pub, uuid.New())
+
+		repo.On("Get", mock.Anything, "tenant-123", teamID).Return(team, nil)
+		employees.On("GetByID", mock.Anything, "tenant-123", employeeID).Return(employee, nil)
+		repo.On("AddMember", mock.Anything, "tenant-123", teamID, employeeID).Return(true, nil)
+		pub.On("PublishTeamMemberAdded", mock.Anything, "tenant-123", "", team, employee).Return(nil)
+
+		added, err := uc.AddTeamMember(WithTenantID(context.Background(), "tenant-123"), teamID, employeeID)
+
+		require.NoError(t, err)
+		assert.True(t, added)
+		pub.AssertExpectations(t)
+	})
+
+	t.Run("already a member", func(t *testing.T) {
+		repo, employees, pub := new(MockTeamRepo), new(MockEmployeeRepo), new(MockEventPublisher)
+		uc := newTestTeamUsecase(repo, employees, pub, uuid.New())
+
+		repo.On("Get", mock.Anything, "tenant-123", teamID).Return(team, nil)
+		employees.On("GetByID", mock.Anything, "tenant-123", employeeID).Return(employee, nil)
+		repo.On("AddMember", mock.Anything, "tenant-123", teamID, employeeID).Return(false, nil)
+
+		added, err := uc.AddTeamMember(WithTenantID(context.Background(), "tenant-123"), teamID, employeeID)
+
+		require.NoError(t, err)
+		assert.False(t, added)
+		pub.AssertNotCalled(t, "PublishTeamMemberAdded", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("pending employee", func(t *testing.T) {
+		repo, employees, pub := new(MockTeamRepo), new(MockEmployeeRepo), new(MockEventPublisher)
+		uc := newTestTeamUsecase(repo, employees, pub, uuid.New())
+
+		repo.On("Get", mock.Anything, "tenant-123", teamID).Return(team, nil)
+		employees.On("GetByID", mock.Anything, "tenant-123", employeeID).
+			Return(&Employee{ID: employeeID, TenantID: "tenant-123", ReviewStatus: ReviewStatusPending}, nil)
+
+		_, err := uc.AddTeamMember(WithTenantID(context.Background(), "tenant-123"), teamID, employeeID)
+
+		assert.True(t, errors.Is(err, ErrEmployeePendingReview))
+		repo.AssertNotCalled(t, "AddMember", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestRemoveTeamMember_NotMember(t *testing.T) {
+	repo, employees, pub := new(MockTeamRepo), new(MockEmployeeRepo), new(MockEventPublisher)
+	teamID, employeeID := uuid.New(), uuid.New()
+	uc := newTestTeamUsecase(repo, employees, pub, uuid.New())
+
+	repo.On("Get", mock.Anything, "tenant-123", teamID).Return(&Team{ID: teamID, TenantID: "tenant-123"}, nil)
+	employees.On("GetByID", mock.Anything, "tenant-123", employeeID).Return(&Employee{ID: employeeID, TenantID: "tenant-123"}, nil)
+	repo.On("RemoveMember", mock.Anything, "tenant-123", teamID, employeeID).Return(ErrTeamMemberNotFound)
+
+	err := uc.RemoveTeamMember(WithTenantID(context.Background(), "tenant-123"), teamID, employeeID)
+
+	assert.True(t, errors.Is(err, ErrTeamMemberNotFound))
+	pub.AssertNotCalled(t, "PublishTeamMemberRemoved", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListTeamMembers_TeamNotFound(t *testing.T) {
+	repo := new(MockTeamRepo)
+	teamID := uuid.New()
+	uc := newTestTeamUsecase(repo, new(MockEmployeeRepo), new(MockEventPublisher), uuid.New())
+
+	repo.On("Get", mock.Anything, "tenant-123", teamID).Return(nil, ErrTeamNotFound)
+
+	_, _, _, _, err := uc.ListTeamMembers(WithTenantID(context.Background(), "tenant-123"), teamID, 0, 0)
+
+	assert.True(t, errors.Is(err, ErrTeamNotFound))
+	repo.AssertNotCalled(t, "ListMembers", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,381 @@
+package biz
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// Tenant clone statuses
+const (
+	CloneStatusPending   = "pending"
+	CloneStatusRunning   = "running"
+	CloneStatusSucceeded = "succeeded"
+	CloneStatusFailed    = "failed"
+)
+
+// Tenant clone phases, run in this order
+const (
+	// ClonePhaseStructure copies the settings, departments and teams
+	ClonePhaseStructure = "structure"
+	// ClonePhaseEmployees copies the employees, without their managers,
+	// and their team memberships
+	ClonePhaseEmployees = "employees"
+	// ClonePhaseManagers sets the managers of the copied employees
+	ClonePhaseManagers = "managers"
+)
+
+const (
+	// defaultCloneBatchSize is the number of employees copied per batch
+	defaultCloneBatchSize = 500
+	// cloneLease is how long a worker owns a clone while running a batch
+	cloneLease = 2 * time.Minute
+)
+
+var (
+	// ErrTenantCloneNotFound is returned when a clone does not exist in the
+	// tenant
+	ErrTenantCloneNotFound = errors.NotFound(v1.ErrorReason_TENANT_CLONE_NOT_FOUND.String(), "tenant clone not found")
+	// ErrTenantCloneNotAllowed is a clone into a tenant that is not a
+	// sandbox of the caller's tenant
+	ErrTenantCloneNotAllowed = errors.Forbidden(v1.ErrorReason_TENANT_CLONE_NOT_ALLOWED.String(), "target tenant is not a sandbox of this tenant")
+	// ErrTenantCloneTargetNotEmpty is a clone into a tenant that has
+	// employees, departments or teams, or is being cloned into
+	ErrTenantCloneTargetNotEmpty = errors.Conflict(v1.ErrorReason_TENANT_CLONE_TARGET_NOT_EMPTY.String(), "target tenant is not empty")
+)
+
+// TenantClone copies a tenant into a sandbox tenant
+type TenantClone struct {
+	ID uuid.UUID
+	// TenantID is the tenant copied
+	TenantID       string
+	TargetTenantID string
+	Anonymize      bool
+	Status         string
+	Phase          string
+	// Cursor is the last employee of the phase copied, nil at its start
+	Cursor          *StreamCursor
+	CopiedEmployees int32
+	Error           string
+	CreatedBy       string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	CompletedAt     *time.Time
+}
+
+// TargetID returns the ID of the copy of the record sourceID in the target
+// tenant. The IDs are derived from the clone, so that copying a record again
+// after a failed batch finds the copy instead of duplicating it.
+func (c *TenantClone) TargetID(sourceID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(c.ID, sourceID[:])
+}
+
+// TenantCloneRepo stores tenant clones and copies the records of a tenant
+type TenantCloneRepo interface {
+	// Create stores a new pending clone. It returns
+	// ErrTenantCloneTargetNotEmpty when a clone into the same target is
+	// pending or running.
+	Create(ctx context.Context, clone *TenantClone) (*TenantClone, error)
+	Get(ctx context.Context, tenantID string, id uuid.UUID) (*TenantClone, error)
+	// IsTenantEmpty reports whether a tenant has no employees, departments
+	// or teams
+	IsTenantEmpty(ctx context.Context, tenantID string) (bool, error)
+	// ClaimNext leases the pending or running clone whose lease expired
+	// that was updated first, marking it running. It returns nil when there
+	// is nothing to run.
+	ClaimNext(ctx context.Context, now time.Time, lease time.Duration) (*TenantClone, error)
+	// SaveProgress stores the status, phase, cursor, counters and error of
+	// a claimed clone and releases its lease
+	SaveProgress(ctx context.Context, clone *TenantClone) error
+	// CopyStructure copies the settings, departments and teams of the
+	// clone's tenant to its target, skipping those already copied
+	CopyStructure(ctx context.Context, clone *TenantClone) error
+	// CopyEmployees stores employees, already carrying their target IDs and
+	// references, in the clone's target tenant with their tags and
+	// deactivation. Employees already copied are skipped. It returns the
+	// number of employees stored.
+	CopyEmployees(ctx context.Context, clone *TenantClone, employees []*Employee) (int, error)
+	// CopyTeamMembers copies the team memberships of employees of the
+	// clone's tenant to their copies, skipping those already copied
+	CopyTeamMembers(ctx context.Context, clone *TenantClone, employeeIDs []uuid.UUID) error
+	// SetManagers sets the managers of employees of the clone's target
+	// tenant, by target employee ID. Managers missing in the target are
+	// left unset.
+	SetManagers(ctx context.Context, clone *TenantClone, managers map[uuid.UUID]uuid.UUID) error
+}
+
+// TenantCloneUsecase copies tenants into sandbox tenants, seeding them with
+// the structure of production and, by default, anonymized employees
+type TenantCloneUsecase struct {
+	clones    TenantCloneRepo
+	repo      EmployeeRepo
+	clock     Clock
+	ids       IDGenerator
+	sandboxes map[string]string
+	batchSize int
+	log       *log.Helper
+}
+
+// NewTenantCloneUsecase creates a new TenantClone usecase.
+func NewTenantCloneUsecase(clones TenantCloneRepo, repo EmployeeRepo, clock Clock, ids IDGenerator, c *conf.Admin, logger log.Logger) *TenantCloneUsecase {
+	uc := &TenantCloneUsecase{
+		clones:    clones,
+		repo:      repo,
+		clock:     clock,
+		ids:       ids,
+		sandboxes: c.GetTenantClone().GetSandboxes(),
+		batchSize: defaultCloneBatchSize,
+		log:       log.NewHelper(logger),
+	}
+	if n := c.GetTenantClone().GetBatchSize(); n > 0 {
+		uc.batchSize = int(n)
+	}
+	return uc
+}
+
+// CloneTenant queues a copy of the caller's tenant into target, which must
+// be configured as its sandbox and be empty. The clone runs in the
+// background.
+func (uc *TenantCloneUsecase) CloneTenant(ctx context.Context, target string, anonymize bool) (*TenantClone, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, _ := GetUserID(ctx)
+
+	if source, ok := uc.sandboxes[target]; !ok || source != tenantID || target == tenantID {
+		return nil, ErrTenantCloneNotAllowed
+	}
+	empty, err := uc.clones.IsTenantEmpty(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	if !empty {
+		return nil, ErrTenantCloneTargetNotEmpty
+	}
+
+	uc.log.WithContext(ctx).Infof("CloneTenant: tenant=%s, target=%s, anonymize=%t", tenantID, target, anonymize)
+
+	now := uc.clock.Now().UTC()
+	return uc.clones.Create(ctx, &TenantClone{
+		ID:             uc.ids.NewID(),
+		TenantID:       tenantID,
+		TargetTenantID: target,
+		Anonymize:      anonymize,
+		Status:         CloneStatusPending,
+		Phase:          ClonePhaseStructure,
+		CreatedBy:      userID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+}
+
+// GetTenantClone returns a clone of the caller's tenant.
+func (uc *TenantCloneUsecase) GetTenantClone(ctx context.Context, id uuid.UUID) (*TenantClone, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.clones.Get(ctx, tenantID, id)
+}
+
+// RunNext claims the next clone and runs one step of it: its structure or a
+// batch of employees. It reports whether a clone was found. Errors of the
+// usecases fail the clone; other errors leave it to be retried once its
+// lease expires, which copying the same records again allows.
+func (uc *TenantCloneUsecase) RunNext(ctx context.Context) (bool, error) {
+	clone, err := uc.clones.ClaimNext(ctx, uc.clock.Now().UTC(), cloneLease)
+	if err != nil || clone == nil {
+		return false, err
+	}
+
+	// Copies are audited in the target as the user who started the clone
+	ctx = WithTenantID(ctx, clone.TargetTenantID)
+	ctx = WithUserID(ctx, clone.CreatedBy)
+	ctx = WithRequestID(ctx, "clone-"+clone.ID.String())
+	ctx = WithSource(ctx, SourceClone)
+
+	if err := uc.step(ctx, clone); err != nil {
+		if errors.FromError(err).Code >= 500 {
+			return true, err
+		}
+		now := uc.clock.Now().UTC()
+		clone.Status = CloneStatusFailed
+		clone.Error = errors.FromError(err).Message
+		clone.CompletedAt = &now
+		uc.log.WithContext(ctx).Warnf("clone %s of tenant %s failed: %v", clone.ID, clone.TenantID, err)
+	}
+	clone.UpdatedAt = uc.clock.Now().UTC()
+	return true, uc.clones.SaveProgress(ctx, clone)
+}
+
+// step runs the next step of a clone and advances its phase and cursor
+func (uc *TenantCloneUsecase) step(ctx context.Context, clone *TenantClone) error {
+	switch clone.Phase {
+	case ClonePhaseStructure:
+		if err := uc.clones.CopyStructure(ctx, clone); err != nil {
+			return err
+		}
+		clone.Phase = ClonePhaseEmployees
+		return nil
+
+	case ClonePhaseEmployees:
+		employees, next, err := uc.nextBatch(ctx, clone)
+		if err != nil {
+			return err
+		}
+		copies := make([]*Employee, len(employees))
+		ids := make([]uuid.UUID, len(employees))
+		for i, employee := range employees {
+			copies[i] = uc.copyEmployee(clone, employee)
+			ids[i] = employee.ID
+		}
+		copied, err := uc.clones.CopyEmployees(ctx, clone, copies)
+		if err != nil {
+			return err
+		}
+		if err := uc.clones.CopyTeamMembers(ctx, clone, ids); err != nil {
+			return err
+		}
+		clone.CopiedEmployees += int32(copied)
+		if next == nil {
+			clone.Phase = ClonePhaseManagers
+		}
+		clone.Cursor = next
+		return nil
+
+	case ClonePhaseManagers:
+		employees, next, err := uc.nextBatch(ctx, clone)
+		if err != nil {
+			return err
+		}
+		managers := map[uuid.UUID]uuid.UUID{}
+		for _, employee := range employees {
+			if employee.ManagerID != nil {
+				managers[clone.TargetID(employee.ID)] = clone.TargetID(*employee.ManagerID)
+			}
+		}
+		if len(managers) > 0 {
+			if err := uc.clones.SetManagers(ctx, clone, managers); err != nil {
+				return err
+			}
+		}
+		clone.Cursor = next
+		if next == nil {
+			now := uc.clock.Now().UTC()
+			clone.Status = CloneStatusSucceeded
+			clone.CompletedAt = &now
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown clone phase %q", clone.Phase)
+}
+
+// nextBatch returns the employees of the clone's tenant after its cursor and
+// the cursor of the next batch, nil after the last one. Employees pending
+// review are not copied.
+func (uc *TenantCloneUsecase) nextBatch(ctx context.Context, clone *TenantClone) ([]*Employee, *StreamCursor, error) {
+	it, err := uc.repo.Stream(ctx, clone.TenantID, &StreamFilter{After: clone.Cursor, Limit: uc.batchSize})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	var employees []*Employee
+	var next *StreamCursor
+	for it.Next() {
+		employees = append(employees, it.Employee())
+		cursor := it.Cursor()
+		next = &cursor
+	}
+	if err := it.Err(); err != nil {
+		return nil, nil, err
+	}
+	if len(employees) < uc.batchSize {
+		next = nil
+	}
+	return employees, next, nil
+}
+
+// copyEmployee returns the copy of employee in the clone's target, without
+// its manager, which is set once every employee was copied. Photos and
+// bounces are never copied.
+func (uc *TenantCloneUsecase) copyEmployee(clone *TenantClone, employee *Employee) *Employee {
+	id := clone.TargetID(employee.ID)
+	copied := &Employee{
+		ID:            id,
+		TenantID:      clone.TargetTenantID,
+		Emails:        employee.Emails,
+		PrimaryEmail:  employee.PrimaryEmail,
+		FirstName:     employee.FirstName,
+		LastName:      employee.LastName,
+		CreatedAt:     employee.CreatedAt,
+		UpdatedAt:     employee.UpdatedAt,
+		ReviewStatus:  employee.ReviewStatus,
+		Title:         employee.Title,
+		Tags:          employee.Tags,
+		PhoneNumbers:  employee.PhoneNumbers,
+		Address:       employee.Address,
+		ExternalIDs:   employee.ExternalIDs,
+		DeactivatedAt: employee.DeactivatedAt,
+	}
+	if employee.DepartmentID != nil {
+		departmentID := clone.TargetID(*employee.DepartmentID)
+		copied.DepartmentID = &departmentID
+	}
+	if clone.Anonymize {
+		anonymize(copied)
+	}
+	return copied
+}
+
+// Fake names of anonymized employees
+var (
+	anonymousFirstNames = []string{"Alex", "Blake", "Casey", "Dana", "Elliot", "Frankie", "Gray", "Harper", "Jamie", "Jordan", "Kai", "Lee", "Morgan", "Quinn", "Riley", "Sam"}
+	anonymousLastNames  = []string{"Adams", "Baker", "Carter", "Diaz", "Evans", "Foster", "Garcia", "Hughes", "Kim", "Lopez", "Moore", "Nguyen", "Parker", "Reed", "Smith", "Walker"}
+)
+
+// anonymize replaces the personal data of an employee copy: fake names
+// picked by its ID, emails at the same domains named after its ID, and no
+// phone numbers, street address or external IDs. The country of the address
+// is kept.
+func anonymize(employee *Employee) {
+	n := binary.BigEndian.Uint64(employee.ID[:8])
+	employee.FirstName = anonymousFirstNames[n%uint64(len(anonymousFirstNames))]
+	employee.LastName = anonymousLastNames[(n>>32)%uint64(len(anonymousLastNames))]
+
+	emails := make([]string, len(employee.Emails))
+	primary := ""
+	for i, email := range employee.Emails {
+		local := "employee-" + employee.ID.String()
+		if i > 0 {
+			local += fmt.Sprintf("-%d", i)
+		}
+		domain := email[strings.LastIndexByte(email, '@')+1:]
+		emails[i] = local + "@" + domain
+		if email == employee.PrimaryEmail {
+			primary = emails[i]
+		}
+	}
+	employee.Emails = emails
+	employee.PrimaryEmail = primary
+
+	employee.PhoneNumbers = nil
+	employee.ExternalIDs = nil
+	if employee.Address != nil {
+		employee.Address = &Address{Country: employee.Address.Country}
+		if employee.Address.IsZero() {
+			employee.Address = nil
+		}
+	}
+}
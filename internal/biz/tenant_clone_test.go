@@ -0,0 +1,228 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTenantCloneRepo is a mock implementation of TenantCloneRepo
+type MockTenantCloneRepo struct {
+	mock.Mock
+}
+
+func (m *MockTenantCloneRepo) Create(ctx context.Context, clone *TenantClone) (*TenantClone, error) {
+	args := m.Called(ctx, clone)
+	return clone, args.Error(0)
+}
+
+func (m *MockTenantCloneRepo) Get(ctx context.Context, tenantID string, id uuid.UUID) (*TenantClone, error) {
+	args := m.Called(ctx, tenantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*TenantClone), args.Error(1)
+}
+
+func (m *MockTenantCloneRepo) IsTenantEmpty(ctx context.Context, tenantID string) (bool, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTenantCloneRepo) ClaimNext(ctx context.Context, now time.Time, lease time.Duration) (*TenantClone, error) {
+	args := m.Called(ctx, now, lease)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*TenantClone), args.Error(1)
+}
+
+func (m *MockTenantCloneRepo) SaveProgress(ctx context.Context, clone *TenantClone) error {
+	return m.Called(ctx, clone).Error(0)
+}
+
+func (m *MockTenantCloneRepo) CopyStructure(ctx context.Context, clone *TenantClone) error {
+	return m.Called(ctx, clone).Error(0)
+}
+
+func (m *MockTenantCloneRepo) CopyEmployees(ctx context.Context, clone *TenantClone, employees []*Employee) (int, error) {
+	args := m.Called(ctx, clone, employees)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTenantCloneRepo) CopyTeamMembers(ctx context.Context, clone *TenantClone, employeeIDs []uuid.UUID) error {
+	return m.Called(ctx, clone, employeeIDs).Error(0)
+}
+
+func (m *MockTenantCloneRepo) SetManagers(ctx context.Context, clone *TenantClone, managers map[uuid.UUID]uuid.UUID) error {
+	return m.Called(ctx, clone, managers).Error(0)
+}
+
+func setupTenantCloneUsecase(batchSize int32) (*TenantCloneUsecase, *MockTenantCloneRepo, *MockEmployeeRepo) {
+	clones := new(MockTenantCloneRepo)
+	repo := new(MockEmployeeRepo)
+	c := &conf.Admin{TenantClone: &conf.Admin_TenantClone{
+		Sandboxes: map[string]string{"tenant-123-sandbox": "tenant-123"},
+		BatchSize: batchSize,
+	}}
+	uc := NewTenantCloneUsecase(clones, repo, ClockFunc(func() time.Time { return scheduleNow }), NewRandomIDGenerator(), c, log.NewStdLogger(io.Discard))
+	return uc, clones, repo
+}
+
+func TestCloneTenant(t *testing.T) {
+	t.Run("starts a clone into a sandbox", func(t *testing.T) {
+		uc, clones, _ := setupTenantCloneUsecase(0)
+		clones.On("IsTenantEmpty", mock.Anything, "tenant-123-sandbox").Return(true, nil)
+		clones.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+		clone, err := uc.CloneTenant(reviewContext(""), "tenant-123-sandbox", true)
+
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-123", clone.TenantID)
+		assert.Equal(t, "tenant-123-sandbox", clone.TargetTenantID)
+		assert.True(t, clone.Anonymize)
+		assert.Equal(t, CloneStatusPending, clone.Status)
+		assert.Equal(t, ClonePhaseStructure, clone.Phase)
+		assert.Equal(t, "user-456", clone.CreatedBy)
+	})
+
+	t.Run("rejects tenants that are not a sandbox of the caller's", func(t *testing.T) {
+		uc, clones, _ := setupTenantCloneUsecase(0)
+		uc.sandboxes["other-sandbox"] = "other"
+
+		for _, target := range []string{"other-sandbox", "unknown", "tenant-123"} {
+			_, err := uc.CloneTenant(reviewContext(""), target, true)
+			assert.ErrorIs(t, err, ErrTenantCloneNotAllowed, target)
+		}
+		clones.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects targets that are not empty", func(t *testing.T) {
+		uc, clones, _ := setupTenantCloneUsecase(0)
+		clones.On("IsTenantEmpty", mock.Anything, "tenant-123-sandbox").Return(false, nil)
+
+		_, err := uc.CloneTenant(reviewContext(""), "tenant-123-sandbox", false)
+
+		assert.ErrorIs(t, err, ErrTenantCloneTargetNotEmpty)
+		clones.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTenantClone_RunNext(t *testing.T) {
+	uc, clones, repo := setupTenantCloneUsecase(2)
+	clone := &TenantClone{ID: uuid.New(), TenantID: "tenant-123", TargetTenantID: "tenant-123-sandbox", Anonymize: true, Status: CloneStatusRunning, Phase: ClonePhaseStructure, CreatedBy: "user-456"}
+	clones.On("ClaimNext", mock.Anything, scheduleNow, cloneLease).Return(clone, nil)
+	clones.On("SaveProgress", mock.Anything, clone).Return(nil)
+
+	departmentID, managerID, reportID := uuid.New(), uuid.New(), uuid.New()
+	manager := &Employee{ID: managerID, Emails: []string{"boss@example.com"}, PrimaryEmail: "boss@example.com", FirstName: "Real", LastName: "Boss", CreatedAt: scheduleNow.Add(-2 * time.Hour), DepartmentID: &departmentID, Title: "CTO",
+		PhoneNumbers: []PhoneNumber{{Type: PhoneTypeWork, Number: "+14155550123"}}, ExternalIDs: map[string]string{"workday": "W-1"}, Address: &Address{Line1: "1 Main St", City: "Springfield", Country: "US"}, PhotoKey: "photos/1"}
+	report := &Employee{ID: reportID, Emails: []string{"jane@example.com", "jane@other.com"}, PrimaryEmail: "jane@other.com", FirstName: "Jane", LastName: "Doe", CreatedAt: scheduleNow.Add(-time.Hour), ManagerID: &managerID, Tags: []string{"remote"}}
+
+	// Structure first
+	clones.On("CopyStructure", mock.Anything, clone).Return(nil).Once()
+	found, err := uc.RunNext(context.Background())
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, ClonePhaseEmployees, clone.Phase)
+
+	// A full batch of employees leaves the phase at its cursor
+	repo.On("Stream", mock.Anything, "tenant-123", &StreamFilter{Limit: 2}).Return(&sliceIterator{employees: []*Employee{manager, report}}, nil).Once()
+	var copies []*Employee
+	clones.On("CopyEmployees", mock.Anything, clone, mock.Anything).Run(func(args mock.Arguments) {
+		copies = args.Get(2).([]*Employee)
+	}).Return(2, nil).Once()
+	clones.On("CopyTeamMembers", mock.Anything, clone, []uuid.UUID{managerID, reportID}).Return(nil).Once()
+	_, err = uc.RunNext(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, ClonePhaseEmployees, clone.Phase)
+	assert.Equal(t, &StreamCursor{CreatedAt: report.CreatedAt, ID: reportID}, clone.Cursor)
+	assert.Equal(t, int32(2), clone.CopiedEmployees)
+
+	require.Len(t, copies, 2)
+	managerCopy, reportCopy := copies[0], copies[1]
+	targetDepartmentID := clone.TargetID(departmentID)
+	assert.Equal(t, clone.TargetID(managerID), managerCopy.ID)
+	assert.Equal(t, "tenant-123-sandbox", managerCopy.TenantID)
+	assert.Equal(t, &targetDepartmentID, managerCopy.DepartmentID)
+	assert.Equal(t, "CTO", managerCopy.Title)
+	assert.Contains(t, anonymousFirstNames, managerCopy.FirstName)
+	assert.Contains(t, anonymousLastNames, managerCopy.LastName)
+	assert.Equal(t, []string{"employee-" + managerCopy.ID.String() + "@example.com"}, managerCopy.Emails)
+	assert.Nil(t, managerCopy.PhoneNumbers)
+	assert.Nil(t, managerCopy.ExternalIDs)
+	assert.Equal(t, &Address{Country: "US"}, managerCopy.Address)
+	assert.Empty(t, managerCopy.PhotoKey)
+	assert.Nil(t, reportCopy.ManagerID)
+	assert.Equal(t, []string{"remote"}, reportCopy.Tags)
+	assert.Equal(t, []string{"employee-" + reportCopy.ID.String() + "@example.com", "employee-" + reportCopy.ID.String() + "-1@other.com"}, reportCopy.Emails)
+	assert.Equal(t, reportCopy.Emails[1], reportCopy.PrimaryEmail)
+	assert.Nil(t, reportCopy.Address)
+
+	// A short batch ends the phase
+	repo.On("Stream", mock.Anything, "tenant-123", &StreamFilter{After: clone.Cursor, Limit: 2}).Return(&sliceIterator{}, nil).Once()
+	clones.On("CopyEmployees", mock.Anything, clone, []*Employee{}).Return(0, nil).Once()
+	clones.On("CopyTeamMembers", mock.Anything, clone, []uuid.UUID{}).Return(nil).Once()
+	_, err = uc.RunNext(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, ClonePhaseManagers, clone.Phase)
+	assert.Nil(t, clone.Cursor)
+
+	// Managers are set once every employee was copied
+	repo.On("Stream", mock.Anything, "tenant-123", &StreamFilter{Limit: 2}).Return(&sliceIterator{employees: []*Employee{report}}, nil).Once()
+	clones.On("SetManagers", mock.Anything, clone, map[uuid.UUID]uuid.UUID{clone.TargetID(reportID): clone.TargetID(managerID)}).Return(nil).Once()
+	_, err = uc.RunNext(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, CloneStatusSucceeded, clone.Status)
+	assert.Equal(t, &scheduleNow, clone.CompletedAt)
+	clones.AssertExpectations(t)
+}
+
+func TestTenantClone_RunNextKeepsPersonalData(t *testing.T) {
+	uc, clones, repo := setupTenantCloneUsecase(10)
+	clone := &TenantClone{ID: uuid.New(), TenantID: "tenant-123", TargetTenantID: "tenant-123-sandbox", Status: CloneStatusRunning, Phase: ClonePhaseEmployees}
+	clones.On("ClaimNext", mock.Anything, scheduleNow, cloneLease).Return(clone, nil)
+	clones.On("SaveProgress", mock.Anything, clone).Return(nil)
+
+	employee := &Employee{ID: uuid.New(), Emails: []string{"jane@example.com"}, FirstName: "Jane", LastName: "Doe", ExternalIDs: map[string]string{"workday": "W-1"}, PhotoKey: "photos/1"}
+	repo.On("Stream", mock.Anything, "tenant-123", mock.Anything).Return(&sliceIterator{employees: []*Employee{employee}}, nil)
+	clones.On("CopyEmployees", mock.Anything, clone, []*Employee{{
+		ID: clone.TargetID(employee.ID), TenantID: "tenant-123-sandbox", Emails: []string{"jane@example.com"}, FirstName: "Jane", LastName: "Doe", ExternalIDs: map[string]string{"workday": "W-1"},
+	}}).Return(1, nil)
+	clones.On("CopyTeamMembers", mock.Anything, clone, []uuid.UUID{employee.ID}).Return(nil)
+
+	_, err := uc.RunNext(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, ClonePhaseManagers, clone.Phase)
+	clones.AssertExpectations(t)
+}
+
+func TestTenantClone_RunNextFails(t *testing.T) {
+	uc, clones, _ := setupTenantCloneUsecase(0)
+	clone := &TenantClone{ID: uuid.New(), TenantID: "tenant-123", TargetTenantID: "tenant-123-sandbox", Status: CloneStatusRunning, Phase: ClonePhaseStructure}
+	clones.On("ClaimNext", mock.Anything, scheduleNow, cloneLease).Return(clone, nil)
+
+	// Database errors leave the clone to be retried
+	clones.On("CopyStructure", mock.Anything, clone).Return(assert.AnError).Once()
+	_, err := uc.RunNext(context.Background())
+	assert.ErrorIs(t, err, assert.AnError)
+	clones.AssertNotCalled(t, "SaveProgress", mock.Anything, mock.Anything)
+
+	// Errors of the usecases fail it
+	clones.On("CopyStructure", mock.Anything, clone).Return(ErrDepartmentAlreadyExists).Once()
+	clones.On("SaveProgress", mock.Anything, clone).Return(nil)
+	_, err = uc.RunNext(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, CloneStatusFailed, clone.Status)
+	assert.Equal(t, ErrDepartmentAlreadyExists.Message, clone.Error)
+}
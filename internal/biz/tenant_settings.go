@@ -0,0 +1,113 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrEmailDomainNotAllowed is an employee email outside the domains the
+// tenant allows
+var ErrEmailDomainNotAllowed = errors.BadRequest(v1.ErrorReason_EMAIL_DOMAIN_NOT_ALLOWED.String(), "email domain is not allowed in this tenant")
+
+// emailDomainNotAllowed returns ErrEmailDomainNotAllowed naming email
+func emailDomainNotAllowed(email string) error {
+	return errors.BadRequest(ErrEmailDomainNotAllowed.Reason, fmt.Sprintf("the domain of %s is not allowed in this tenant", email))
+}
+
+// TenantSettings are the settings a tenant manages itself
+type TenantSettings struct {
+	TenantID string
+	// AllowedEmailDomains are the domains employee emails must be of, empty
+	// for any; *.example.com allows the subdomains of example.com
+	AllowedEmailDomains []string
+	// UpdatedBy and UpdatedAt are zero for a tenant that never set them
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+// AllowsEmail reports whether email is of an allowed domain
+func (s *TenantSettings) AllowsEmail(email string) bool {
+	if s == nil || len(s.AllowedEmailDomains) == 0 {
+		return true
+	}
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		return false
+	}
+	domain := strings.ToLower(email[at+1:])
+	return slices.ContainsFunc(s.AllowedEmailDomains, func(allowed string) bool {
+		if parent, ok := strings.CutPrefix(allowed, "*."); ok {
+			return strings.HasSuffix(domain, "."+parent)
+		}
+		return domain == allowed
+	})
+}
+
+// TenantSettingsRepo stores tenant settings
+type TenantSettingsRepo interface {
+	// Get returns the settings of a tenant, empty settings when it has none
+	Get(ctx context.Context, tenantID string) (*TenantSettings, error)
+	// Save replaces the settings of a tenant
+	Save(ctx context.Context, settings *TenantSettings) (*TenantSettings, error)
+}
+
+// TenantSettingsUsecase manages the settings of the caller's tenant
+type TenantSettingsUsecase struct {
+	repo  TenantSettingsRepo
+	clock Clock
+	log   *log.Helper
+}
+
+// NewTenantSettingsUsecase creates a new TenantSettings usecase.
+func NewTenantSettingsUsecase(repo TenantSettingsRepo, clock Clock, logger log.Logger) *TenantSettingsUsecase {
+	return &TenantSettingsUsecase{
+		repo:  repo,
+		clock: clock,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// GetTenantSettings returns the settings of the caller's tenant.
+func (uc *TenantSettingsUsecase) GetTenantSettings(ctx context.Context) (*TenantSettings, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.Get(ctx, tenantID)
+}
+
+// UpdateTenantSettings replaces the settings of the caller's tenant. Domains
+// are lowercased and deduplicated. Narrowing the allowed domains leaves the
+// emails already stored unchanged; it only applies to emails added after.
+func (uc *TenantSettingsUsecase) UpdateTenantSettings(ctx context.Context, settings *TenantSettings) (*TenantSettings, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, _ := GetUserID(ctx)
+
+	domains := make([]string, 0, len(settings.AllowedEmailDomains))
+	for _, domain := range settings.AllowedEmailDomains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if !slices.Contains(domains, domain) {
+			domains = append(domains, domain)
+		}
+	}
+
+	uc.log.WithContext(ctx).Infof("UpdateTenantSettings: tenant=%s, allowed_email_domains=%v", tenantID, domains)
+
+	return uc.repo.Save(ctx, &TenantSettings{
+		TenantID:            tenantID,
+		AllowedEmailDomains: domains,
+		UpdatedBy:           userID,
+		UpdatedAt:           uc.clock.Now().UTC(),
+	})
+}
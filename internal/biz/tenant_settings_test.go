@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTenantSettingsRepo is a mock implementation of TenantSettingsRepo
+type MockTenantSettingsRepo struct {
+	mock.Mock
+}
+
+func (m *MockTenantSettingsRepo) Get(ctx context.Context, tenantID string) (*TenantSettings, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*TenantSettings), args.Error(1)
+}
+
+func (m *MockTenantSettingsRepo) Save(ctx context.Context, settings *TenantSettings) (*TenantSettings, error) {
+	args := m.Called(ctx, settings)
+	return settings, args.Error(0)
+}
+
+// setupAllowedDomains restricts the emails of tenant-123 to domains
+func setupAllowedDomains(uc *EmployeeUsecase, domains ...string) {
+	settings := new(MockTenantSettingsRepo)
+	settings.On("Get", mock.Anything, "tenant-123").Return(&TenantSettings{TenantID: "tenant-123", AllowedEmailDomains: domains}, nil)
+	uc.emails = NewEmailPolicy(nil, settings, nil, log.NewStdLogger(io.Discard))
+}
+
+func TestTenantSettings_AllowsEmail(t *testing.T) {
+	settings := &TenantSettings{AllowedEmailDomains: []string{"example.com", "*.example.org"}}
+
+	tests := []struct {
+		email string
+		want  bool
+	}{
+		{email: "jane@example.com", want: true},
+		{email: "jane@Example.COM", want: true},
+		{email: "jane@eu.example.com", want: false},
+		{email: "jane@eu.example.org", want: true},
+		{email: "jane@example.org", want: false},
+		{email: "jane@badexample.org", want: false},
+		{email: "jane@other.com", want: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, settings.AllowsEmail(tt.email), tt.email)
+	}
+	assert.True(t, (&TenantSettings{}).AllowsEmail("jane@other.com"))
+	assert.True(t, (*TenantSettings)(nil).AllowsEmail("jane@other.com"))
+}
+
+func TestUpdateTenantSettings(t *testing.T) {
+	repo := new(MockTenantSettingsRepo)
+	uc := NewTenantSettingsUsecase(repo, ClockFunc(func() time.Time { return scheduleNow }), log.NewStdLogger(io.Discard))
+	repo.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+	settings, err := uc.UpdateTenantSettings(reviewContext(""), &TenantSettings{AllowedEmailDomains: []string{"Example.com", "*.example.org", "example.com"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, &TenantSettings{
+		TenantID:            "tenant-123",
+		AllowedEmailDomains: []string{"example.com", "*.example.org"},
+		UpdatedBy:           "user-456",
+		UpdatedAt:           scheduleNow.UTC(),
+	}, settings)
+}
+
+func TestCreateEmployee_EmailDomainNotAllowed(t *testing.T) {
+	uc, repo := setupUsecase()
+	setupAllowedDomains(uc, "example.com")
+
+	_, err := uc.CreateEmployee(reviewContext(""), &Employee{Emails: []string{"jane@example.com", "Jane@Other.com"}, FirstName: "Jane", LastName: "Doe"})
+
+	assert.True(t, errors.Is(err, ErrEmailDomainNotAllowed))
+	assert.Contains(t, errors.FromError(err).Message, "jane@other.com")
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateEmployee_EmailDomainNotAllowed(t *testing.T) {
+	id := uuid.New()
+	existing := &Employee{ID: id, Version: 1, Emails: []string{"jane@legacy.com"}}
+
+	t.Run("added email", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		setupAllowedDomains(uc, "example.com")
+		repo.On("GetByID", mock.Anything, "tenant-123", id).Return(existing, nil)
+
+		_, err := uc.UpdateEmployee(reviewContext(""), &Employee{ID: id, Version: 1, Emails: []string{"jane@legacy.com", "jane@other.com"}})
+
+		assert.True(t, errors.Is(err, ErrEmailDomainNotAllowed))
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("kept email", func(t *testing.T) {
+		uc, repo := setupUsecase()
+		setupAllowedDomains(uc, "example.com")
+		pub := new(MockEventPublisher)
+		uc.events = newTestEventBus(pub)
+		pub.On("PublishEmployeeUpdated", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		repo.On("GetByID", mock.Anything, "tenant-123", id).Return(existing, nil)
+		repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"jane@example.com"}).Return(map[string]bool{}, nil)
+		repo.On("Update", mock.Anything, "tenant-123", mock.Anything).Return(&Employee{ID: id, Version: 2}, nil)
+
+		_, err := uc.UpdateEmployee(reviewContext(""), &Employee{ID: id, Version: 1, Emails: []string{"jane@legacy.com", "jane@example.com"}})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestRunImport_EmailDomainNotAllowed(t *testing.T) {
+	imports := new(MockImportRepo)
+	repo := new(MockEmployeeRepo)
+	uc := newTestImportUsecase(imports, repo, 10)
+	settings := new(MockTenantSettingsRepo)
+	settings.On("Get", mock.Anything, "tenant-123").Return(&TenantSettings{AllowedEmailDomains: []string{"example.com"}}, nil)
+	uc.emails = NewEmailPolicy(nil, settings, nil, log.NewStdLogger(io.Discard))
+
+	op := &ImportOperation{ID: uuid.New(), TenantID: "tenant-123"}
+	csv := "first_name,last_name,emails\nAda,Lovelace,ada@example.com\nAlan,Turing,alan@other.com\n"
+
+	repo.On("CheckEmailsExist", mock.Anything, "tenant-123", []string{"ada@example.com"}).Return(map[string]bool{}, nil)
+	repo.On("CreateMany", mock.Anything, "tenant-123", mock.Anything).Return([]*Employee{{FirstName: "Ada"}}, nil)
+	imports.On("SaveProgress", mock.Anything, mock.Anything, importLease).Return(nil)
+
+	err := uc.run(context.Background(), op, []byte(csv))
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), op.CreatedCount)
+	assert.Equal(t, []ImportRowError{{Row: 2, Email: "alan@other.com", Message: "the domain of alan@other.com is not allowed"}}, op.Errors)
+}
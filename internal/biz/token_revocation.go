@@ -0,0 +1,95 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// TokenRevocation rejects JWTs of a tenant before they expire: the token
+// with a jti claim, or every token of a user issued up to RevokedAt
+type TokenRevocation struct {
+	ID       uuid.UUID
+	TenantID string
+	// JTI is the jti claim of the revoked token, empty when UserID is set
+	JTI string
+	// UserID is the sub claim of the user whose tokens are revoked, empty
+	// when JTI is set
+	UserID    string
+	RevokedAt time.Time
+	// ExpiresAt is the expiry of the token revoked by JTI, after which the
+	// revocation is deleted; nil keeps it
+	ExpiresAt *time.Time
+	RevokedBy string
+}
+
+// TokenRevocationRepo stores token revocations
+type TokenRevocationRepo interface {
+	// Save stores a revocation, replacing the one of the same token or user,
+	// and deletes the revocations of the tenant expired before now
+	Save(ctx context.Context, revocation *TokenRevocation, now time.Time) (*TokenRevocation, error)
+	// IsRevoked reports whether the token of a tenant with jti, empty when it
+	// has none, or the tokens of subject issued at issuedAt are revoked
+	IsRevoked(ctx context.Context, tenantID, jti, subject string, issuedAt time.Time) (bool, error)
+}
+
+// TokenRevocationUsecase revokes the tokens of the caller's tenant and
+// checks tokens against the revocations
+type TokenRevocationUsecase struct {
+	repo  TokenRevocationRepo
+	clock Clock
+	ids   IDGenerator
+	log   *log.Helper
+}
+
+// NewTokenRevocationUsecase creates a new TokenRevocation usecase.
+func NewTokenRevocationUsecase(repo TokenRevocationRepo, clock Clock, ids IDGenerator, logger log.Logger) *TokenRevocationUsecase {
+	return &TokenRevocationUsecase{
+		repo:  repo,
+		clock: clock,
+		ids:   ids,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// RevokeToken revokes the token of the caller's tenant with jti. expiresAt
+// is the token's expiry, nil when unknown.
+func (uc *TokenRevocationUsecase) RevokeToken(ctx context.Context, jti string, expiresAt *time.Time) (*TokenRevocation, error) {
+	return uc.revoke(ctx, &TokenRevocation{JTI: jti, ExpiresAt: expiresAt})
+}
+
+// RevokeUserTokens revokes every token of a user of the caller's tenant
+// issued up to now. Tokens issued later are accepted.
+func (uc *TokenRevocationUsecase) RevokeUserTokens(ctx context.Context, userID string) (*TokenRevocation, error) {
+	return uc.revoke(ctx, &TokenRevocation{UserID: userID})
+}
+
+func (uc *TokenRevocationUsecase) revoke(ctx context.Context, revocation *TokenRevocation) (*TokenRevocation, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, _ := GetUserID(ctx)
+
+	uc.log.WithContext(ctx).Infof("RevokeTokens: tenant=%s, jti=%s, user=%s", tenantID, revocation.JTI, revocation.UserID)
+
+	now := uc.clock.Now().UTC()
+	revocation.ID = uc.ids.NewID()
+	revocation.TenantID = tenantID
+	revocation.RevokedAt = now
+	revocation.RevokedBy = userID
+	return uc.repo.Save(ctx, revocation, now)
+}
+
+// IsRevoked reports whether a token of tenantID is revoked. jti is empty for
+// tokens without the claim, and issuedAt nil for tokens without iat, which
+// any revocation of their subject's tokens revokes.
+func (uc *TokenRevocationUsecase) IsRevoked(ctx context.Context, tenantID, jti, subject string, issuedAt *time.Time) (bool, error) {
+	var iat time.Time
+	if issuedAt != nil {
+		iat = issuedAt.UTC()
+	}
+	return uc.repo.IsRevoked(ctx, tenantID, jti, subject, iat)
+}
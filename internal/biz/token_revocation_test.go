@@ -0,0 +1,76 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTokenRevocationRepo is a mock implementation of TokenRevocationRepo
+type MockTokenRevocationRepo struct {
+	mock.Mock
+}
+
+func (m *MockTokenRevocationRepo) Save(ctx context.Context, revocation *TokenRevocation, now time.Time) (*TokenRevocation, error) {
+	args := m.Called(ctx, revocation, now)
+	return revocation, args.Error(0)
+}
+
+func (m *MockTokenRevocationRepo) IsRevoked(ctx context.Context, tenantID, jti, subject string, issuedAt time.Time) (bool, error) {
+	args := m.Called(ctx, tenantID, jti, subject, issuedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func setupTokenRevocationUsecase(id uuid.UUID) (*TokenRevocationUsecase, *MockTokenRevocationRepo) {
+	repo := new(MockTokenRevocationRepo)
+	uc := NewTokenRevocationUsecase(repo, ClockFunc(func() time.Time { return scheduleNow }), IDGeneratorFunc(func() uuid.UUID { return id }), log.NewStdLogger(io.Discard))
+	return uc, repo
+}
+
+func TestRevokeToken(t *testing.T) {
+	id := uuid.New()
+	uc, repo := setupTokenRevocationUsecase(id)
+	expiresAt := scheduleNow.Add(time.Hour)
+	repo.On("Save", mock.Anything, mock.Anything, scheduleNow).Return(nil)
+
+	revocation, err := uc.RevokeToken(reviewContext(""), "token-1", &expiresAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, &TokenRevocation{ID: id, TenantID: "tenant-123", JTI: "token-1", RevokedAt: scheduleNow, ExpiresAt: &expiresAt, RevokedBy: "user-456"}, revocation)
+}
+
+func TestRevokeUserTokens(t *testing.T) {
+	id := uuid.New()
+	uc, repo := setupTokenRevocationUsecase(id)
+	repo.On("Save", mock.Anything, mock.Anything, scheduleNow).Return(nil)
+
+	revocation, err := uc.RevokeUserTokens(reviewContext(""), "user-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, &TokenRevocation{ID: id, TenantID: "tenant-123", UserID: "user-1", RevokedAt: scheduleNow, RevokedBy: "user-456"}, revocation)
+
+	_, err = uc.RevokeUserTokens(context.Background(), "user-1")
+	assert.ErrorIs(t, err, ErrTenantNotFound)
+}
+
+func TestTokenRevocation_IsRevoked(t *testing.T) {
+	uc, repo := setupTokenRevocationUsecase(uuid.New())
+	repo.On("IsRevoked", mock.Anything, "tenant-1", "token-1", "user-1", scheduleNow).Return(true, nil)
+	repo.On("IsRevoked", mock.Anything, "tenant-1", "", "user-1", time.Time{}).Return(false, nil)
+
+	revoked, err := uc.IsRevoked(context.Background(), "tenant-1", "token-1", "user-1", &scheduleNow)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	// Tokens without iat are compared as issued before any revocation
+	revoked, err = uc.IsRevoked(context.Background(), "tenant-1", "", "user-1", nil)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+}
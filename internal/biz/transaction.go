@@ -0,0 +1,19 @@
+package biz
+
+import "context"
+
+// Transaction is the unit of work of a business operation spanning several
+// repository calls, such as checking email uniqueness before a create or
+// loading the employees of a merge before merging them.
+//
+// Transaction runs fn in one database transaction, committing when fn
+// returns nil and rolling back otherwise. Repository calls made with the
+// context passed to fn join the transaction, including the audit entries
+// the repositories record. Transactions started inside fn, by a nested
+// Transaction or by a repository method, become savepoints of it.
+//
+// Domain events must be published after Transaction returns, so that
+// subscribers never observe a change that is rolled back.
+type Transaction interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,157 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// defaultUsageDays is the number of days reported when no range is given
+	defaultUsageDays = 30
+	// maxUsageDays bounds the number of days of a usage report
+	maxUsageDays = 366
+)
+
+// ErrInvalidUsageRange is a usage report range that ends before it starts or
+// spans more than maxUsageDays
+var ErrInvalidUsageRange = errors.BadRequest(v1.ErrorReason_INVALID_USAGE_RANGE.String(), "usage range must end after it starts and cover at most 366 days")
+
+// UsageRecord is the number of requests of one operation of a tenant that
+// ended with one status on one UTC day
+type UsageRecord struct {
+	TenantID string
+	// Day is midnight UTC of the day
+	Day       time.Time
+	Operation string
+	Status    int32
+	Count     int64
+}
+
+// UsageRepo stores the daily usage counters
+type UsageRepo interface {
+	// Add adds the counts of records to the stored counters
+	Add(ctx context.Context, records []*UsageRecord) error
+	// List returns the counters of a tenant from the day from to the day to,
+	// inclusive, ordered by day, operation and status
+	List(ctx context.Context, tenantID string, from, to time.Time) ([]*UsageRecord, error)
+}
+
+// usageKey identifies an in-memory usage counter
+type usageKey struct {
+	tenantID  string
+	day       time.Time
+	operation string
+	status    int32
+}
+
+// UsageTracker counts API requests per tenant, operation and status in memory
+// and periodically adds the counts to the daily usage table, so recording a
+// request never waits on the database
+type UsageTracker struct {
+	repo  UsageRepo
+	clock Clock
+	log   *log.Helper
+
+	mu     sync.Mutex
+	counts map[usageKey]int64
+}
+
+// NewUsageTracker creates a new usage tracker.
+func NewUsageTracker(repo UsageRepo, clock Clock, logger log.Logger) *UsageTracker {
+	return &UsageTracker{
+		repo:   repo,
+		clock:  clock,
+		log:    log.NewHelper(logger),
+		counts: map[usageKey]int64{},
+	}
+}
+
+// Record counts one request of a tenant. status is the HTTP status code of
+// the response.
+func (t *UsageTracker) Record(tenantID, operation string, status int32) {
+	key := usageKey{
+		tenantID:  tenantID,
+		day:       usageDay(t.clock.Now()),
+		operation: operation,
+		status:    status,
+	}
+
+	t.mu.Lock()
+	t.counts[key]++
+	t.mu.Unlock()
+}
+
+// Flush adds the counts recorded since the last flush to the usage table,
+// returning how many counters were written. Counts that fail to be written are
+// kept for the next flush.
+func (t *UsageTracker) Flush(ctx context.Context) (int, error) {
+	t.mu.Lock()
+	counts := t.counts
+	t.counts = map[usageKey]int64{}
+	t.mu.Unlock()
+
+	if len(counts) == 0 {
+		return 0, nil
+	}
+
+	records := make([]*UsageRecord, 0, len(counts))
+	for key, count := range counts {
+		records = append(records, &UsageRecord{
+			TenantID:  key.tenantID,
+			Day:       key.day,
+			Operation: key.operation,
+			Status:    key.status,
+			Count:     count,
+		})
+	}
+
+	if err := t.repo.Add(ctx, records); err != nil {
+		t.mu.Lock()
+		for key, count := range counts {
+			t.counts[key] += count
+		}
+		t.mu.Unlock()
+		return 0, err
+	}
+	return len(records), nil
+}
+
+// GetTenantAPIUsage returns the daily usage of the caller's tenant from the
+// day from to the day to, inclusive. A zero to defaults to today and a zero
+// from to 30 days ending with to. It returns the range after defaults.
+func (t *UsageTracker) GetTenantAPIUsage(ctx context.Context, from, to time.Time) ([]*UsageRecord, time.Time, time.Time, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	if to.IsZero() {
+		to = t.clock.Now()
+	}
+	to = usageDay(to)
+	if from.IsZero() {
+		from = to.AddDate(0, 0, 1-defaultUsageDays)
+	}
+	from = usageDay(from)
+	if to.Before(from) || to.Sub(from) >= maxUsageDays*24*time.Hour {
+		return nil, time.Time{}, time.Time{}, ErrInvalidUsageRange
+	}
+
+	records, err := t.repo.List(ctx, tenantID, from, to)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+	return records, from, to, nil
+}
+
+// usageDay truncates t to midnight UTC of its day
+func usageDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockUsageRepo is a mock implementation of UsageRepo
+type MockUsageRepo struct {
+	mock.Mock
+}
+
+func (m *MockUsageRepo) Add(ctx context.Context, records []*UsageRecord) error {
+	args := m.Called(ctx, records)
+	return args.Error(0)
+}
+
+func (m *MockUsageRepo) List(ctx context.Context, tenantID string, from, to time.Time) ([]*UsageRecord, error) {
+	args := m.Called(ctx, tenantID, from, to)
+	return args.Get(0).([]*UsageRecord), args.Error(1)
+}
+
+func TestUsageTracker_Flush(t *testing.T) {
+	now := time.Date(2026, 3, 1, 23, 59, 0, 0, time.UTC)
+	repo := new(MockUsageRepo)
+	tracker := NewUsageTracker(repo, ClockFunc(func() time.Time { return now }), log.NewStdLogger(io.Discard))
+
+	tracker.Record("tenant-1", "/employee.v1.EmployeeService/GetEmployee", 200)
+	tracker.Record("tenant-1", "/employee.v1.EmployeeService/GetEmployee", 200)
+	tracker.Record("tenant-1", "/employee.v1.EmployeeService/GetEmployee", 404)
+	now = now.Add(2 * time.Minute)
+	tracker.Record("tenant-1", "/employee.v1.EmployeeService/GetEmployee", 200)
+
+	day1 := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	expected := []*UsageRecord{
+		{TenantID: "tenant-1", Day: day1, Operation: "/employee.v1.EmployeeService/GetEmployee", Status: 200, Count: 2},
+		{TenantID: "tenant-1", Day: day1, Operation: "/employee.v1.EmployeeService/GetEmployee", Status: 404, Count: 1},
+		{TenantID: "tenant-1", Day: day2, Operation: "/employee.v1.EmployeeService/GetEmployee", Status: 200, Count: 1},
+	}
+
+	// A failed write keeps the counts for the next flush
+	repo.On("Add", mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+	_, err := tracker.Flush(context.Background())
+	require.Error(t, err)
+
+	var written []*UsageRecord
+	repo.On("Add", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		written = args.Get(1).([]*UsageRecord)
+	}).Return(nil).Once()
+	n, err := tracker.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.ElementsMatch(t, expected, written)
+
+	n, err = tracker.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n, "flushed counts are not written again")
+	repo.AssertExpectations(t)
+}
+
+func TestGetTenantAPIUsage_Range(t *testing.T) {
+	now := time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	ctx := WithTenantID(context.Background(), "tenant-1")
+
+	t.Run("defaults to the last 30 days", func(t *testing.T) {
+		repo := new(MockUsageRepo)
+		tracker := NewUsageTracker(repo, ClockFunc(func() time.Time { return now }), log.NewStdLogger(io.Discard))
+		repo.On("List", mock.Anything, "tenant-1", today.AddDate(0, 0, -29), today).Return([]*UsageRecord{}, nil)
+
+		_, from, to, err := tracker.GetTenantAPIUsage(ctx, time.Time{}, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, today.AddDate(0, 0, -29), from)
+		assert.Equal(t, today, to)
+		repo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+	}{
+		{name: "ends before it starts", from: today, to: today.AddDate(0, 0, -1)},
+		{name: "longer than a year", from: today.AddDate(0, 0, -366), to: today},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewUsageTracker(new(MockUsageRepo), ClockFunc(func() time.Time { return now }), log.NewStdLogger(io.Discard))
+			_, _, _, err := tracker.GetTenantAPIUsage(ctx, tt.from, tt.to)
+			assert.Equal(t, ErrInvalidUsageRange, err)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrInvalidResumeToken is returned when a watch resume token cannot be decoded
+var ErrInvalidResumeToken = errors.BadRequest(v1.ErrorReason_INVALID_RESUME_TOKEN.String(), "invalid resume token")
+
+// ErrResumeTokenExpired is returned when the entry a resume token was issued
+// for has been archived, so the changes after it can no longer be replayed.
+// It is a failed precondition: the client must watch again without a token
+// and reload what it missed.
+var ErrResumeTokenExpired = errors.BadRequest(v1.ErrorReason_RESUME_TOKEN_EXPIRED.String(), "resume token expired, watch again without a token")
+
+const (
+	// defaultWatchPoll is how often Watch checks the audit log for new entries once caught up
+	defaultWatchPoll = time.Second
+	// watchBatchSize is the number of entries read per query while replaying
+	watchBatchSize = 500
+
+	resumeTokenPrefix = "pos:"
+	// legacyResumeTokenPrefix starts the tokens issued before entries had a
+	// position, which encode the seq alone
+	legacyResumeTokenPrefix = "seq:"
+)
+
+// AuditPosition is the place of an audit entry in its tenant's audit log.
+// Entries are ordered by the transaction that recorded them, then by seq, so
+// that an entry is never ordered before one committed earlier by a slower
+// transaction.
+type AuditPosition struct {
+	// TxID is the ID of the transaction that recorded the entry, 0 where
+	// the database does not record it; entries ordered by seq alone are
+	// held back by the repository until slower transactions have committed
+	TxID int64
+	Seq  int64
+}
+
+// Position returns the position of the entry in the audit log
+func (e *AuditEntry) Position() AuditPosition {
+	return AuditPosition{TxID: e.TxID, Seq: e.Seq}
+}
+
+// EncodeResumeToken returns the opaque token that resumes a watch after the entry at the given position
+func EncodeResumeToken(pos AuditPosition) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(resumeTokenPrefix + strconv.FormatInt(pos.TxID, 10) + ":" + strconv.FormatInt(pos.Seq, 10)))
+}
+
+// DecodeResumeToken returns the position encoded in a resume token. Legacy
+// tokens decode to the position of their seq without a transaction, from
+// which entries recorded since they were issued may be delivered again.
+func DecodeResumeToken(token string) (AuditPosition, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return AuditPosition{}, ErrInvalidResumeToken
+	}
+
+	var txID, seq string
+	if s, ok := strings.CutPrefix(string(raw), resumeTokenPrefix); ok {
+		if txID, seq, ok = strings.Cut(s, ":"); !ok {
+			return AuditPosition{}, ErrInvalidResumeToken
+		}
+	} else if seq, ok = strings.CutPrefix(string(raw), legacyResumeTokenPrefix); ok {
+		txID = "0"
+	} else {
+		return AuditPosition{}, ErrInvalidResumeToken
+	}
+
+	var pos AuditPosition
+	if pos.TxID, err = strconv.ParseInt(txID, 10, 64); err != nil || pos.TxID < 0 {
+		return AuditPosition{}, ErrInvalidResumeToken
+	}
+	if pos.Seq, err = strconv.ParseInt(seq, 10, 64); err != nil || pos.Seq < 0 {
+		return AuditPosition{}, ErrInvalidResumeToken
+	}
+
+	return pos, nil
+}
+
+// Watch calls fn for every change to the caller's tenant's employees, in
+// commit-log order, until ctx is done or fn returns an error.
+//
+// Without a resume token only changes made from now on are delivered. With a
+// token, changes recorded after the entry it was issued for are replayed from
+// the audit log first, then the watch switches to tailing new entries. A
+// token whose entry has been archived fails with ErrResumeTokenExpired.
+func (uc *AuditUsecase) Watch(ctx context.Context, resumeToken string, fn func(*AuditEntry) error) error {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	var after AuditPosition
+	if resumeToken != "" {
+		if after, err = DecodeResumeToken(resumeToken); err != nil {
+			return err
+		}
+		retained, err := uc.repo.HasEntry(ctx, tenantID, after.Seq)
+		if err != nil {
+			return err
+		}
+		if !retained {
+			return ErrResumeTokenExpired
+		}
+	} else if after, err = uc.repo.LatestPosition(ctx, tenantID); err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("Watch: tenant=%s, after=%d:%d", tenantID, after.TxID, after.Seq)
+
+	ticker := time.NewTicker(uc.watchPoll)
+	defer ticker.Stop()
+
+	for {
+		entries, err := uc.repo.ListSince(ctx, tenantID, after, watchBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if err := fn(entry); err != nil {
+				return err
+			}
+			after = entry.Position()
+		}
+
+		// A full batch means we are still replaying, keep reading without waiting
+		if len(entries) == watchBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResumeToken(t *testing.T) {
+	for _, pos := range []AuditPosition{{}, {TxID: 0, Seq: 1}, {TxID: 1 << 33, Seq: 1 << 40}} {
+		got, err := DecodeResumeToken(EncodeResumeToken(pos))
+		assert.NoError(t, err)
+		assert.Equal(t, pos, got)
+	}
+
+	// Tokens issued before positions resume at their seq
+	legacy := base64.RawURLEncoding.EncodeToString([]byte("seq:42"))
+	got, err := DecodeResumeToken(legacy)
+	assert.NoError(t, err)
+	assert.Equal(t, AuditPosition{Seq: 42}, got)
+
+	for _, token := range []string{"not base64!", "MTIz", EncodeResumeToken(AuditPosition{Seq: -1}), EncodeResumeToken(AuditPosition{TxID: -1, Seq: 1}),
+		base64.RawURLEncoding.EncodeToString([]byte("pos:12"))} {
+		_, err := DecodeResumeToken(token)
+		assert.Equal(t, ErrInvalidResumeToken, err, token)
+	}
+}
+
+func newTestWatchUsecase(repo AuditRepo) *AuditUsecase {
+	uc := NewAuditUsecase(repo, log.NewStdLogger(io.Discard))
+	uc.watchPoll = time.Millisecond
+	return uc
+}
+
+func TestWatch_ReplaysFromResumeToken(t *testing.T) {
+	repo := new(MockAuditRepo)
+	uc := newTestWatchUsecase(repo)
+	ctx := WithTenantID(context.Background(), "tenant-123")
+
+	repo.On("HasEntry", mock.Anything, "tenant-123", int64(7)).Return(true, nil)
+	repo.On("ListSince", mock.Anything, "tenant-123", AuditPosition{TxID: 100, Seq: 7}, watchBatchSize).
+		Return([]*AuditEntry{{TxID: 100, Seq: 8}, {TxID: 101, Seq: 10}}, nil).Once()
+	repo.On("ListSince", mock.Anything, "tenant-123", AuditPosition{TxID: 101, Seq: 10}, watchBatchSize).
+		Return([]*AuditEntry{}, nil).Once()
+	// A slower transaction that recorded seq 9 commits after seq 10
+	repo.On("ListSince", mock.Anything, "tenant-123", AuditPosition{TxID: 101, Seq: 10}, watchBatchSize).
+		Return([]*AuditEntry{{TxID: 102, Seq: 9}}, nil).Once()
+
+	stop := errors.New("stop")
+	var seen []int64
+	err := uc.Watch(ctx, EncodeResumeToken(AuditPosition{TxID: 100, Seq: 7}), func(e *AuditEntry) error {
+		seen = append(seen, e.Seq)
+		if e.Seq == 9 {
+			return stop
+		}
+		return nil
+	})
+
+	assert.Equal(t, stop, err)
+	assert.Equal(t, []int64{8, 10, 9}, seen)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "LatestPosition", mock.Anything, mock.Anything)
+}
+
+func TestWatch_StartsAtLatestWithoutToken(t *testing.T) {
+	repo := new(MockAuditRepo)
+	uc := newTestWatchUsecase(repo)
+	ctx, cancel := context.WithCancel(WithTenantID(context.Background(), "tenant-123"))
+
+	repo.On("LatestPosition", mock.Anything, "tenant-123").Return(AuditPosition{TxID: 300, Seq: 42}, nil)
+	repo.On("ListSince", mock.Anything, "tenant-123", AuditPosition{TxID: 300, Seq: 42}, watchBatchSize).
+		Run(func(mock.Arguments) { cancel() }).
+		Return([]*AuditEntry{}, nil)
+
+	err := uc.Watch(ctx, "", func(*AuditEntry) error {
+		t.Fatal("unexpected entry")
+		return nil
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "HasEntry", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWatch_InvalidToken(t *testing.T) {
+	repo := new(MockAuditRepo)
+	uc := newTestWatchUsecase(repo)
+
+	err := uc.Watch(WithTenantID(context.Background(), "tenant-123"), "garbage", func(*AuditEntry) error { return nil })
+
+	assert.Equal(t, ErrInvalidResumeToken, err)
+	repo.AssertNotCalled(t, "ListSince", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWatch_ExpiredToken(t *testing.T) {
+	repo := new(MockAuditRepo)
+	uc := newTestWatchUsecase(repo)
+
+	// The entry of the token has been archived
+	repo.On("HasEntry", mock.Anything, "tenant-123", int64(7)).Return(false, nil)
+
+	err := uc.Watch(WithTenantID(context.Background(), "tenant-123"), EncodeResumeToken(AuditPosition{TxID: 100, Seq: 7}), func(*AuditEntry) error {
+		t.Fatal("unexpected entry")
+		return nil
+	})
+
+	assert.Equal(t, ErrResumeTokenExpired, err)
+	repo.AssertNotCalled(t, "ListSince", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
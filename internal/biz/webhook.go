@@ -0,0 +1,379 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// Webhook event types, one per audit action
+const (
+	WebhookEventEmployeeCreated     = "employee.created"
+	WebhookEventEmployeeUpdated     = "employee.updated"
+	WebhookEventEmployeeDeleted     = "employee.deleted"
+	WebhookEventEmployeeMerged      = "employee.merged"
+	WebhookEventEmployeeUnmerged    = "employee.unmerged"
+	WebhookEventEmployeeDeactivated = "employee.deactivated"
+	WebhookEventEmployeeReactivated = "employee.reactivated"
+	// WebhookEventDigest is the event type of a digest batching the events of
+	// a window
+	WebhookEventDigest = "employee.digest"
+)
+
+// Webhook delivery statuses
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+	// WebhookDeliveryQueued is an event of a digest webhook waiting for its
+	// window to close
+	WebhookDeliveryQueued = "queued"
+	// WebhookDeliveryDigested is a queued event that was batched into a digest
+	WebhookDeliveryDigested = "digested"
+)
+
+// Webhook delivery modes
+const (
+	// WebhookDeliveryModeImmediate sends one delivery per event
+	WebhookDeliveryModeImmediate = "immediate"
+	// WebhookDeliveryModeDigest sends the events of each digest interval as a
+	// single delivery
+	WebhookDeliveryModeDigest = "digest"
+)
+
+// DefaultWebhookDigestInterval is the digest window of webhooks created in
+// digest mode without an interval
+const DefaultWebhookDigestInterval = time.Hour
+
+var (
+	// ErrWebhookNotFound is returned when a webhook does not exist in the tenant
+	ErrWebhookNotFound = errors.NotFound(v1.ErrorReason_WEBHOOK_NOT_FOUND.String(), "webhook not found")
+	// ErrInvalidWebhookURL is returned for webhook URLs that are not absolute http(s) URLs
+	ErrInvalidWebhookURL = errors.BadRequest(v1.ErrorReason_INVALID_WEBHOOK_URL.String(), "webhook url must be an absolute http or https URL")
+	// ErrWebhookDeliveryNotFound is returned when a delivery does not exist for the webhook
+	ErrWebhookDeliveryNotFound = errors.NotFound(v1.ErrorReason_WEBHOOK_DELIVERY_NOT_FOUND.String(), "webhook delivery not found")
+	// ErrWebhookDeliveryNotFailed is a replay of a delivery that has not failed
+	ErrWebhookDeliveryNotFailed = errors.BadRequest(v1.ErrorReason_WEBHOOK_DELIVERY_NOT_FAILED.String(), "only failed webhook deliveries can be replayed")
+)
+
+// Webhook is an HTTP callback subscribed to employee events of a tenant
+type Webhook struct {
+	ID         uuid.UUID
+	TenantID   string
+	URL        string
+	Secret     string
+	EventTypes []string
+	Enabled    bool
+	// DeliveryMode is WebhookDeliveryModeImmediate or WebhookDeliveryModeDigest
+	DeliveryMode string
+	// DigestInterval is the length of the digest windows, zero in immediate mode
+	DigestInterval time.Duration
+	// PayloadTemplate renders the body of each delivery from its event, see
+	// RenderWebhookPayload; empty sends the event as is
+	PayloadTemplate string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// WebhookDelivery is one event queued for, or delivered to, a webhook
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	TenantID       string
+	WebhookID      uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	Payload        []byte
+	Status         string
+	Attempts       int32
+	ResponseStatus int32
+	LastError      string
+	NextAttemptAt  time.Time
+	DeliveredAt    *time.Time
+	// DigestID is the digest delivery a digested event was sent in
+	DigestID  *uuid.UUID
+	CreatedAt time.Time
+}
+
+// WebhookUpdate holds the webhook fields to change; nil and empty fields are kept
+type WebhookUpdate struct {
+	URL          *string
+	EventTypes   []string
+	Enabled      *bool
+	RotateSecret bool
+	DeliveryMode *string
+	// DigestInterval changes the digest windows; it applies in digest mode only
+	DigestInterval *time.Duration
+	// PayloadTemplate replaces the payload template; empty removes it
+	PayloadTemplate *string
+}
+
+// WebhookDeliveryFilter selects a page of a webhook's delivery log
+type WebhookDeliveryFilter struct {
+	WebhookID uuid.UUID
+	Page      int32
+	PageSize  int32
+}
+
+// WebhookRepo stores webhooks and their delivery log
+type WebhookRepo interface {
+	Create(ctx context.Context, webhook *Webhook) (*Webhook, error)
+	Get(ctx context.Context, tenantID string, id uuid.UUID) (*Webhook, error)
+	List(ctx context.Context, tenantID string) ([]*Webhook, error)
+	Update(ctx context.Context, webhook *Webhook) (*Webhook, error)
+	Delete(ctx context.Context, tenantID string, id uuid.UUID) error
+	ListDeliveries(ctx context.Context, tenantID string, filter *WebhookDeliveryFilter) ([]*WebhookDelivery, int64, error)
+	// ReplayDelivery queues a failed delivery for a new round of attempts. It
+	// returns ErrWebhookDeliveryNotFound or ErrWebhookDeliveryNotFailed
+	// otherwise.
+	ReplayDelivery(ctx context.Context, tenantID string, webhookID, id uuid.UUID) (*WebhookDelivery, error)
+	// ListEmployeeDeliveries lists the deliveries of the events of an
+	// employee to any webhook, oldest first
+	ListEmployeeDeliveries(ctx context.Context, tenantID string, employeeID uuid.UUID) ([]*WebhookDelivery, error)
+}
+
+// WebhookUsecase manages the tenant's webhooks
+type WebhookUsecase struct {
+	repo WebhookRepo
+	ids  IDGenerator
+	log  *log.Helper
+}
+
+// NewWebhookUsecase creates a new Webhook usecase.
+func NewWebhookUsecase(repo WebhookRepo, ids IDGenerator, logger log.Logger) *WebhookUsecase {
+	return &WebhookUsecase{
+		repo: repo,
+		ids:  ids,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// newWebhookSecret returns a random signing secret
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// validateWebhookURL checks that u is an absolute http(s) URL. The addresses
+// it resolves to are checked by the dispatcher on every delivery, since they
+// can change after the webhook is saved.
+func validateWebhookURL(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+	return nil
+}
+
+// setDeliveryMode switches webhook to mode with the given digest interval,
+// defaulting the interval of digest webhooks
+func setDeliveryMode(webhook *Webhook, mode string, interval time.Duration) {
+	if mode == "" {
+		mode = WebhookDeliveryModeImmediate
+	}
+	webhook.DeliveryMode = mode
+
+	switch {
+	case mode != WebhookDeliveryModeDigest:
+		webhook.DigestInterval = 0
+	case interval > 0:
+		webhook.DigestInterval = interval
+	case webhook.DigestInterval <= 0:
+		webhook.DigestInterval = DefaultWebhookDigestInterval
+	}
+}
+
+// SignWebhookPayload returns the X-Webhook-Signature header value for a
+// delivery body sent at timestamp.
+func SignWebhookPayload(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return fmt.Sprintf("t=%s,v1=%s", t, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// CreateWebhook creates a webhook for the caller's tenant with a new signing
+// secret. An empty delivery mode is immediate. A payload template must render
+// valid JSON from a sample event of the delivery mode.
+func (uc *WebhookUsecase) CreateWebhook(ctx context.Context, webhookURL string, eventTypes []string, deliveryMode string, digestInterval time.Duration, payloadTemplate string) (*Webhook, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhookURL(webhookURL); err != nil {
+		return nil, err
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := &Webhook{
+		ID:              uc.ids.NewID(),
+		TenantID:        tenantID,
+		URL:             webhookURL,
+		Secret:          secret,
+		EventTypes:      eventTypes,
+		Enabled:         true,
+		PayloadTemplate: payloadTemplate,
+	}
+	setDeliveryMode(webhook, deliveryMode, digestInterval)
+	if err := validateWebhookTemplate(webhook.PayloadTemplate, webhook.DeliveryMode); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("CreateWebhook: tenant=%s, events=%v, mode=%s", tenantID, eventTypes, webhook.DeliveryMode)
+
+	return uc.repo.Create(ctx, webhook)
+}
+
+// GetWebhook retrieves a webhook of the caller's tenant.
+func (uc *WebhookUsecase) GetWebhook(ctx context.Context, id uuid.UUID) (*Webhook, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.Get(ctx, tenantID, id)
+}
+
+// ListWebhooks lists all webhooks of the caller's tenant.
+func (uc *WebhookUsecase) ListWebhooks(ctx context.Context) ([]*Webhook, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.List(ctx, tenantID)
+}
+
+// UpdateWebhook applies the update to a webhook of the caller's tenant.
+func (uc *WebhookUsecase) UpdateWebhook(ctx context.Context, id uuid.UUID, update *WebhookUpdate) (*Webhook, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := uc.repo.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.URL != nil {
+		if err := validateWebhookURL(*update.URL); err != nil {
+			return nil, err
+		}
+		webhook.URL = *update.URL
+	}
+	if len(update.EventTypes) > 0 {
+		webhook.EventTypes = update.EventTypes
+	}
+	if update.Enabled != nil {
+		webhook.Enabled = *update.Enabled
+	}
+	if update.RotateSecret {
+		if webhook.Secret, err = newWebhookSecret(); err != nil {
+			return nil, err
+		}
+	}
+	if update.DeliveryMode != nil || update.DigestInterval != nil {
+		mode, interval := webhook.DeliveryMode, time.Duration(0)
+		if update.DeliveryMode != nil {
+			mode = *update.DeliveryMode
+		}
+		if update.DigestInterval != nil {
+			interval = *update.DigestInterval
+		}
+		setDeliveryMode(webhook, mode, interval)
+	}
+	// Templates are checked against the payloads of the delivery mode, so a
+	// change of mode checks the kept template again
+	if update.PayloadTemplate != nil || update.DeliveryMode != nil {
+		if update.PayloadTemplate != nil {
+			webhook.PayloadTemplate = *update.PayloadTemplate
+		}
+		if err := validateWebhookTemplate(webhook.PayloadTemplate, webhook.DeliveryMode); err != nil {
+			return nil, err
+		}
+	}
+
+	uc.log.WithContext(ctx).Infof("UpdateWebhook: tenant=%s, id=%s", tenantID, id)
+
+	return uc.repo.Update(ctx, webhook)
+}
+
+// DeleteWebhook deletes a webhook of the caller's tenant with its deliveries.
+func (uc *WebhookUsecase) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("DeleteWebhook: tenant=%s, id=%s", tenantID, id)
+
+	return uc.repo.Delete(ctx, tenantID, id)
+}
+
+// ListWebhookDeliveries lists the delivery log of a webhook, newest first.
+func (uc *WebhookUsecase) ListWebhookDeliveries(ctx context.Context, filter *WebhookDeliveryFilter) ([]*WebhookDelivery, int64, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Set default pagination values
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 {
+		filter.PageSize = 50
+	}
+	if filter.PageSize > 200 {
+		filter.PageSize = 200
+	}
+
+	// Deliveries of unknown webhooks are reported as not found rather than empty
+	if _, err := uc.repo.Get(ctx, tenantID, filter.WebhookID); err != nil {
+		return nil, 0, err
+	}
+
+	return uc.repo.ListDeliveries(ctx, tenantID, filter)
+}
+
+// ReplayWebhookDelivery sends a failed delivery of a webhook of the caller's
+// tenant again. Replaying a failed digest resends all of its events.
+func (uc *WebhookUsecase) ReplayWebhookDelivery(ctx context.Context, webhookID, id uuid.UUID) (*WebhookDelivery, error) {
+	tenantID, err := GetTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.repo.Get(ctx, tenantID, webhookID); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("ReplayWebhookDelivery: tenant=%s, webhook=%s, id=%s", tenantID, webhookID, id)
+
+	return uc.repo.ReplayDelivery(ctx, tenantID, webhookID, id)
+}
@@ -0,0 +1,336 @@
+package biz
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	// maxWebhookTemplateSize caps the source of a payload template
+	maxWebhookTemplateSize = 16 << 10
+	// maxWebhookPayloadSize caps the body a payload template renders
+	maxWebhookPayloadSize = 256 << 10
+	// maxWebhookTemplateRangeDepth caps the nesting of range actions, and
+	// maxWebhookTemplateNumber the number literals, so that a template cannot
+	// loop for long without producing output
+	maxWebhookTemplateRangeDepth = 2
+	maxWebhookTemplateNumber     = 1000
+	// maxWebhookTemplatePrintfWidth caps the width and precision of printf
+	// verbs, which would otherwise allocate at will without rendering output
+	maxWebhookTemplatePrintfWidth = 64
+	// maxWebhookTemplateSteps caps the range iterations of a rendering, and
+	// webhookTemplateRenderTimeout its duration
+	maxWebhookTemplateSteps      = 100000
+	webhookTemplateRenderTimeout = 100 * time.Millisecond
+
+	// webhookTemplateStep is the function run at each range iteration to
+	// count it against the budget of the rendering
+	webhookTemplateStep = "_step"
+)
+
+var (
+	// errWebhookPayloadTooLarge is returned by the payload writer once a
+	// template rendered more than maxWebhookPayloadSize
+	errWebhookPayloadTooLarge = fmt.Errorf("rendered payload exceeds %d bytes", maxWebhookPayloadSize)
+	// errWebhookTemplateTooSlow is returned when a rendering runs out of its
+	// steps or time
+	errWebhookTemplateTooSlow = fmt.Errorf("rendering exceeds %d range iterations or %s", maxWebhookTemplateSteps, webhookTemplateRenderTimeout)
+)
+
+// webhookTemplateFuncs are the functions available to payload templates on
+// top of the text/template builtins. Templates only see the event payload,
+// decoded from JSON, so none of them reach beyond it.
+var webhookTemplateFuncs = template.FuncMap{
+	// json renders a value as JSON, quoting and escaping strings
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	// join joins the elements of a list, e.g. the emails of an employee
+	"join": func(sep string, list []any) string {
+		parts := make([]string, len(list))
+		for i, v := range list {
+			parts[i] = fmt.Sprint(v)
+		}
+		return strings.Join(parts, sep)
+	},
+	// default returns v, or def when v is missing or empty
+	"default": func(def, v any) any {
+		switch v := v.(type) {
+		case nil:
+			return def
+		case string:
+			if v == "" {
+				return def
+			}
+		}
+		return v
+	},
+	// Replaced for each rendering, see RenderWebhookPayload
+	webhookTemplateStep: func() string { return "" },
+}
+
+// webhookTemplateStepNode is the action counting a range iteration, added
+// to the body of every range action of a payload template
+var webhookTemplateStepNode = template.Must(template.New("step").Funcs(webhookTemplateFuncs).Parse("{{" + webhookTemplateStep + "}}")).Root.Nodes[0]
+
+// webhookTemplateSample is the event payload templates are rendered against
+// when they are created
+var webhookTemplateSample = []byte(`{"id": "00000000-0000-0000-0000-000000000001", "type": "employee.updated",
+ "tenant_id": "tenant", "occurred_at": "2026-01-02T03:04:05.000000Z",
+ "data": {"employee_id": "00000000-0000-0000-0000-000000000002",
+  "employee": {"id": "00000000-0000-0000-0000-000000000002", "emails": ["jane.doe@example.com"], "first_name": "Jane", "last_name": "Doe",
+   "title": "Engineer", "tags": ["remote"], "version": 2, "created_at": "2026-01-01T00:00:00Z", "updated_at": "2026-01-02T03:04:05Z"},
+  "previous": {"id": "00000000-0000-0000-0000-000000000002", "emails": ["jane@example.com"], "first_name": "Jane", "last_name": "Doe",
+   "version": 1, "created_at": "2026-01-01T00:00:00Z", "updated_at": "2026-01-01T00:00:00Z"}}}`)
+
+// webhookTemplateDigestSample wraps webhookTemplateSample in a digest
+var webhookTemplateDigestSample = []byte(`{"id": "00000000-0000-0000-0000-000000000003", "type": "employee.digest", "tenant_id": "tenant",
+ "window_start": "2026-01-02T03:00:00.000000Z", "window_end": "2026-01-02T04:00:00.000000Z", "events": [` +
+	string(webhookTemplateSample) + `]}`)
+
+// ParseWebhookTemplate parses the payload template of a webhook. Templates
+// may not define or invoke other templates, nest range actions deeper than
+// two levels, use numbers beyond ±1000 or printf widths beyond 64.
+func ParseWebhookTemplate(text string) (*template.Template, error) {
+	if len(text) > maxWebhookTemplateSize {
+		return nil, fmt.Errorf("template exceeds %d bytes", maxWebhookTemplateSize)
+	}
+
+	t, err := template.New("payload").Funcs(webhookTemplateFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	if len(t.Templates()) > 1 {
+		return nil, fmt.Errorf("templates may not define other templates")
+	}
+	if err := checkWebhookTemplateNode(t.Root, 0); err != nil {
+		return nil, err
+	}
+	addWebhookTemplateSteps(t.Root)
+	return t, nil
+}
+
+// addWebhookTemplateSteps adds the step action to the range actions of a
+// checked template
+func addWebhookTemplateSteps(node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			addWebhookTemplateSteps(child)
+		}
+	case *parse.RangeNode:
+		addWebhookTemplateSteps(n.List)
+		addWebhookTemplateSteps(n.ElseList)
+		n.List.Nodes = append([]parse.Node{webhookTemplateStepNode}, n.List.Nodes...)
+	case *parse.IfNode:
+		addWebhookTemplateSteps(n.List)
+		addWebhookTemplateSteps(n.ElseList)
+	case *parse.WithNode:
+		addWebhookTemplateSteps(n.List)
+		addWebhookTemplateSteps(n.ElseList)
+	}
+}
+
+// checkWebhookTemplateNode walks a parsed template for the actions payload
+// templates may not use
+func checkWebhookTemplateNode(node parse.Node, ranges int) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkWebhookTemplateNode(child, ranges); err != nil {
+				return err
+			}
+		}
+	case *parse.TemplateNode:
+		return fmt.Errorf("templates may not invoke other templates")
+	case *parse.RangeNode:
+		if ranges >= maxWebhookTemplateRangeDepth {
+			return fmt.Errorf("range actions may not be nested more than %d deep", maxWebhookTemplateRangeDepth)
+		}
+		return checkWebhookTemplateBranch(&n.BranchNode, ranges+1)
+	case *parse.IfNode:
+		return checkWebhookTemplateBranch(&n.BranchNode, ranges)
+	case *parse.WithNode:
+		return checkWebhookTemplateBranch(&n.BranchNode, ranges)
+	case *parse.ActionNode:
+		return checkWebhookTemplateNode(n.Pipe, ranges)
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+		for _, cmd := range n.Cmds {
+			if err := checkWebhookTemplateNode(cmd, ranges); err != nil {
+				return err
+			}
+		}
+	case *parse.CommandNode:
+		if err := checkWebhookTemplatePrintf(n); err != nil {
+			return err
+		}
+		for _, arg := range n.Args {
+			if err := checkWebhookTemplateNode(arg, ranges); err != nil {
+				return err
+			}
+		}
+	case *parse.ChainNode:
+		return checkWebhookTemplateNode(n.Node, ranges)
+	case *parse.NumberNode:
+		if n.IsFloat && (n.Float64 > maxWebhookTemplateNumber || n.Float64 < -maxWebhookTemplateNumber) {
+			return fmt.Errorf("number %s is out of range", n.Text)
+		}
+	}
+	return nil
+}
+
+// checkWebhookTemplatePrintf checks that a printf command has a literal
+// format whose widths and precisions are at most maxWebhookTemplatePrintfWidth
+func checkWebhookTemplatePrintf(n *parse.CommandNode) error {
+	if id, ok := n.Args[0].(*parse.IdentifierNode); !ok || id.Ident != "printf" {
+		return nil
+	}
+	if len(n.Args) < 2 {
+		return fmt.Errorf("printf requires a literal format")
+	}
+	format, ok := n.Args[1].(*parse.StringNode)
+	if !ok {
+		return fmt.Errorf("printf requires a literal format")
+	}
+
+	text := format.Text
+	for i := 0; i < len(text); i++ {
+		if text[i] != '%' {
+			continue
+		}
+		// Flags, argument indexes, width and precision precede the verb
+		number := 0
+		for i++; i < len(text) && strings.IndexByte("+-# 0123456789.*[]", text[i]) >= 0; i++ {
+			switch c := text[i]; {
+			case c == '*':
+				return fmt.Errorf("printf widths must be literal")
+			case c == '[':
+				for i < len(text) && text[i] != ']' {
+					i++
+				}
+				number = 0
+			case c >= '0' && c <= '9':
+				if number = number*10 + int(c-'0'); number > maxWebhookTemplatePrintfWidth {
+					return fmt.Errorf("printf widths and precisions may not exceed %d", maxWebhookTemplatePrintfWidth)
+				}
+			default:
+				number = 0
+			}
+		}
+	}
+	return nil
+}
+
+func checkWebhookTemplateBranch(n *parse.BranchNode, ranges int) error {
+	if err := checkWebhookTemplateNode(n.Pipe, ranges); err != nil {
+		return err
+	}
+	if err := checkWebhookTemplateNode(n.List, ranges); err != nil {
+		return err
+	}
+	return checkWebhookTemplateNode(n.ElseList, ranges)
+}
+
+// webhookPayloadWriter buffers a rendered payload up to maxWebhookPayloadSize
+type webhookPayloadWriter struct {
+	bytes.Buffer
+}
+
+func (w *webhookPayloadWriter) Write(p []byte) (int, error) {
+	if w.Len()+len(p) > maxWebhookPayloadSize {
+		return 0, errWebhookPayloadTooLarge
+	}
+	return w.Buffer.Write(p)
+}
+
+// RenderWebhookPayload renders the body of a delivery from its event payload.
+// The template sees the payload decoded from JSON, numbers kept as written,
+// and must render valid JSON. Renderings running out of their range
+// iterations or time fail.
+func RenderWebhookPayload(t *template.Template, payload []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+	var data any
+	if err := dec.Decode(&data); err != nil {
+		return nil, err
+	}
+
+	t, err := t.Clone()
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(webhookTemplateRenderTimeout)
+	steps := 0
+	t.Funcs(template.FuncMap{webhookTemplateStep: func() (string, error) {
+		if steps++; steps > maxWebhookTemplateSteps || time.Now().After(deadline) {
+			return "", errWebhookTemplateTooSlow
+		}
+		return "", nil
+	}})
+
+	// The steps stop a rendering stuck in ranges; one stuck elsewhere is
+	// abandoned once its time is up
+	var out webhookPayloadWriter
+	done := make(chan error, 1)
+	go func() { done <- t.Execute(&out, data) }()
+	timer := time.NewTimer(webhookTemplateRenderTimeout)
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		if err != nil {
+			return nil, err
+		}
+	case <-timer.C:
+		return nil, errWebhookTemplateTooSlow
+	}
+	if !json.Valid(out.Bytes()) {
+		return nil, fmt.Errorf("template did not render valid JSON")
+	}
+	return out.Bytes(), nil
+}
+
+// validateWebhookTemplate checks that text parses and renders valid JSON from
+// a sample event of the given delivery mode. An empty template is valid.
+func validateWebhookTemplate(text, deliveryMode string) error {
+	if text == "" {
+		return nil
+	}
+
+	t, err := ParseWebhookTemplate(text)
+	if err != nil {
+		return invalidWebhookTemplate(err)
+	}
+	sample := webhookTemplateSample
+	if deliveryMode == WebhookDeliveryModeDigest {
+		sample = webhookTemplateDigestSample
+	}
+	if _, err := RenderWebhookPayload(t, sample); err != nil {
+		return invalidWebhookTemplate(err)
+	}
+	return nil
+}
+
+func invalidWebhookTemplate(err error) error {
+	return errors.BadRequest(v1.ErrorReason_INVALID_WEBHOOK_TEMPLATE.String(), "invalid payload template: "+err.Error())
+}
@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderWebhookPayload(t *testing.T) {
+	tmpl, err := ParseWebhookTemplate(`{"text": {{json (printf "%s %s was updated (%s)" .data.employee.first_name .data.employee.last_name (join ", " .data.employee.emails))}},` +
+		` "title": {{json (upper (default "-" .data.employee.title))}}, "department": {{json (default "none" .data.employee.department_id)}}, "version": {{.data.employee.version}}}`)
+	require.NoError(t, err)
+
+	body, err := RenderWebhookPayload(tmpl, webhookTemplateSample)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text": "Jane Doe was updated (jane.doe@example.com)", "title": "ENGINEER", "department": "none", "version": 2}`, string(body))
+}
+
+func TestParseWebhookTemplate_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+	}{
+		{name: "syntax", text: `{"text": {{.id}`},
+		{name: "unknown function", text: `{"text": {{env "HOME"}}}`},
+		{name: "define", text: `{{define "other"}}{}{{end}}{}`},
+		{name: "template", text: `{{template "payload" .}}`},
+		{name: "nested ranges", text: `[{{range .a}}{{range .b}}{{range .c}}{{end}}{{end}}{{end}}]`},
+		{name: "large number", text: `[{{range 100000000}}{{end}}]`},
+		{name: "printf width", text: `{{range 10}}{{range 10}}{{$x := printf "%0999999d" 1}}{{end}}{{end}}{}`},
+		{name: "printf precision", text: `{"a": {{json (printf "%.999f" 1.0)}}}`},
+		{name: "printf argument width", text: `{"a": {{json (printf "%*d" 1000 1)}}}`},
+		{name: "printf variable format", text: `{{$f := "%0999d"}}{"a": {{json (printf $f 1)}}}`},
+		{name: "printf piped format", text: `{"a": {{"%0999d" | printf | json}}}`},
+		{name: "oversized", text: strings.Repeat(" ", maxWebhookTemplateSize+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseWebhookTemplate(tt.text)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRenderWebhookPayload_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+	}{
+		{name: "not json", text: `text: {{.data.employee.first_name}}`},
+		{name: "unquoted string", text: `{"text": {{.data.employee.first_name}}}`},
+		{name: "oversized", text: `[{{range 1000}}{{json $.data}},{{end}}0]`},
+		{name: "too many iterations", text: `{{range 1000}}{{range 1000}}{{$x := printf "%64d" 1}}{{end}}{{end}}{}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseWebhookTemplate(tt.text)
+			require.NoError(t, err)
+
+			_, err = RenderWebhookPayload(tmpl, webhookTemplateSample)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestValidateWebhookTemplate_Hostile(t *testing.T) {
+	started := time.Now()
+
+	err := validateWebhookTemplate(`{{range 1000}}{{range 1000}}{{$x := printf "%64d" 1}}{{$y := json $.data}}{{end}}{{end}}{}`, WebhookDeliveryModeImmediate)
+
+	assert.Equal(t, "INVALID_WEBHOOK_TEMPLATE", errors.Reason(err))
+	assert.Less(t, time.Since(started), time.Second)
+}
+
+func TestRenderWebhookPayload_Steps(t *testing.T) {
+	tmpl, err := ParseWebhookTemplate(`[{{range $i, $e := .events}}{{if $i}},{{end}}{{range $e.data.employee.emails}}{{json .}}{{end}}{{end}}]`)
+	require.NoError(t, err)
+
+	// Ranges still iterate over the payload once their steps are counted
+	body, err := RenderWebhookPayload(tmpl, webhookTemplateDigestSample)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["jane.doe@example.com"]`, string(body))
+}
+
+func TestCreateWebhook_InvalidPayloadTemplate(t *testing.T) {
+	repo := new(MockWebhookRepo)
+	uc := NewWebhookUsecase(repo, NewRandomIDGenerator(), log.NewStdLogger(io.Discard))
+
+	_, err := uc.CreateWebhook(WithTenantID(context.Background(), "tenant-123"), "https://example.com/hook",
+		[]string{WebhookEventEmployeeUpdated}, "", 0, `{"text": {{.data.employee.first_name}}}`)
+
+	assert.Equal(t, "INVALID_WEBHOOK_TEMPLATE", errors.Reason(err))
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestUpdateWebhook_PayloadTemplateChecksDeliveryMode(t *testing.T) {
+	repo := new(MockWebhookRepo)
+	uc := NewWebhookUsecase(repo, NewRandomIDGenerator(), log.NewStdLogger(io.Discard))
+	id := uuid.New()
+	digest := WebhookDeliveryModeDigest
+
+	// The template reads a single event, which digests do not have
+	repo.On("Get", mock.Anything, "tenant-123", id).Return(&Webhook{
+		ID:              id,
+		DeliveryMode:    WebhookDeliveryModeImmediate,
+		PayloadTemplate: `{"text": {{json .data.employee.first_name}}}`,
+	}, nil)
+
+	_, err := uc.UpdateWebhook(WithTenantID(context.Background(), "tenant-123"), id, &WebhookUpdate{DeliveryMode: &digest})
+
+	assert.Equal(t, "INVALID_WEBHOOK_TEMPLATE", errors.Reason(err))
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
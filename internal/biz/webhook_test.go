@@ -0,0 +1,327 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWebhookRepo is a mock implementation of WebhookRepo
+type MockWebhookRepo struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepo) Create(ctx context.Context, webhook *Webhook) (*Webhook, error) {
+	args := m.Called(ctx, webhook)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepo) Get(ctx context.Context, tenantID string, id uuid.UUID) (*Webhook, error) {
+	args := m.Called(ctx, tenantID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepo) List(ctx context.Context, tenantID string) ([]*Webhook, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepo) Update(ctx context.Context, webhook *Webhook) (*Webhook, error) {
+	args := m.Called(ctx, webhook)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepo) Delete(ctx context.Context, tenantID string, id uuid.UUID) error {
+	args := m.Called(ctx, tenantID, id)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepo) ListDeliveries(ctx context.Context, tenantID string, filter *WebhookDeliveryFilter) ([]*WebhookDelivery, int64, error) {
+	args := m.Called(ctx, tenantID, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*WebhookDelivery), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockWebhookRepo) ReplayDelivery(ctx context.Context, tenantID string, webhookID, id uuid.UUID) (*WebhookDelivery, error) {
+	args := m.Called(ctx, tenantID, webhookID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookRepo) ListEmployeeDeliveries(ctx context.Context, tenantID string, employeeID uuid.UUID) ([]*WebhookDelivery, error) {
+	args := m.Called(ctx, tenantID, employeeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*WebhookDelivery), args.Error(1)
+}
+
+func TestCreateWebhook(t *testing.T) {
+	repo := new(MockWebhookRepo)
+	id := uuid.New()
+	uc := NewWebhookUsecase(repo, IDGeneratorFunc(func() uuid.UUID { return id }), log.NewStdLogger(io.Discard))
+
+	created := &Webhook{ID: id}
+	repo.On("Create", mock.Anything, mock.MatchedBy(func(w *Webhook) bool {
+		return w.ID == id &&
+			w.TenantID == "tenant-123" &&
+			w.URL == "https://example.com/hook" &&
+			w.Enabled &&
+			w.DeliveryMode == WebhookDeliveryModeImmediate &&
+			w.DigestInterval == 0 &&
+			strings.HasPrefix(w.Secret, "whsec_")
+	})).Return(created, nil)
+
+	got, err := uc.CreateWebhook(WithTenantID(context.Background(), "tenant-123"), "https://example.com/hook", []string{WebhookEventEmployeeCreated}, "", 0, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, created, got)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateWebhook_InvalidURL(t *testing.T) {
+	for _, u := range []string{"ftp://example.com", "/relative", "https://", "not a url"} {
+		t.Run(u, func(t *testing.T) {
+			repo := new(MockWebhookRepo)
+			uc := NewWebhookUsecase(repo, NewRandomIDGenerator(), log.NewStdLogger(io.Discard))
+
+			_, err := uc.CreateWebhook(WithTenantID(context.Background(), "tenant-123"), u, []string{WebhookEventEmployeeCreated}, "", 0, "")
+
+			assert.True(t, errors.Is(err, ErrInvalidWebhookURL))
+			repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	repo := new(MockWebhookRepo)
+	uc := NewWebhookUsecase(repo, NewRandomIDGenerator(), log.NewStdLogger(io.Discard))
+	id := uuid.New()
+	existing := &Webhook{
+		ID:         id,
+		TenantID:   "tenant-123",
+		URL:        "https://example.com/hook",
+		Secret:     "whsec_old",
+		EventTypes: []string{WebhookEventEmployeeCreated},
+		Enabled:    true,
+	}
+
+	repo.On("Get", mock.Anything, "tenant-123", id).Return(existing, nil)
+	var got *Webhook
+	repo.On("Update", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { got = args.Get(1).(*Webhook) }).
+		Return(existing, nil)
+
+	disabled := false
+	_, err := uc.UpdateWebhook(WithTenantID(context.Background(), "tenant-123"), id, &WebhookUpdate{
+		Enabled:      &disabled,
+		RotateSecret: true,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.False(t, got.Enabled)
+	assert.Equal(t, "https://example.com/hook", got.URL, "unset fields are kept")
+	assert.Equal(t, []string{WebhookEventEmployeeCreated}, got.EventTypes, "empty event types are kept")
+	assert.NotEqual(t, "whsec_old", got.Secret)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateWebhook_Digest(t *testing.T) {
+	tests := []struct {
+		name         string
+		interval     time.Duration
+		wantInterval time.Duration
+	}{
+		{name: "default interval", wantInterval: DefaultWebhookDigestInterval},
+		{name: "custom interval", interval: 15 * time.Minute, wantInterval: 15 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockWebhookRepo)
+			uc := NewWebhookUsecase(repo, NewRandomIDGenerator(), log.NewStdLogger(io.Discard))
+
+			var got *Webhook
+			repo.On("Create", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) { got = args.Get(1).(*Webhook) }).
+				Return(&Webhook{}, nil)
+
+			_, err := uc.CreateWebhook(WithTenantID(context.Background(), "tenant-123"), "https://example.com/hook",
+				[]string{WebhookEventEmployeeCreated}, WebhookDeliveryModeDigest, tt.interval, "")
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.Equal(t, WebhookDeliveryModeDigest, got.DeliveryMode)
+			assert.Equal(t, tt.wantInterval, got.DigestInterval)
+		})
+	}
+}
+
+func TestUpdateWebhook_DeliveryMode(t *testing.T) {
+	digest, immediate := WebhookDeliveryModeDigest, WebhookDeliveryModeImmediate
+	interval := 30 * time.Minute
+
+	tests := []struct {
+		name         string
+		existing     Webhook
+		update       WebhookUpdate
+		wantMode     string
+		wantInterval time.Duration
+	}{
+		{
+			name:         "switch to digest",
+			existing:     Webhook{DeliveryMode: immediate},
+			update:       WebhookUpdate{DeliveryMode: &digest},
+			wantMode:     digest,
+			wantInterval: DefaultWebhookDigestInterval,
+		},
+		{
+			name:         "change interval",
+			existing:     Webhook{DeliveryMode: digest, DigestInterval: time.Hour},
+			update:       WebhookUpdate{DigestInterval: &interval},
+			wantMode:     digest,
+			wantInterval: interval,
+		},
+		{
+			name:         "switch to immediate",
+			existing:     Webhook{DeliveryMode: digest, DigestInterval: time.Hour},
+			update:       WebhookUpdate{DeliveryMode: &immediate},
+			wantMode:     immediate,
+			wantInterval: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockWebhookRepo)
+			uc := NewWebhookUsecase(repo, NewRandomIDGenerator(), log.NewStdLogger(io.Discard))
+			id := uuid.New()
+			existing := tt.existing
+			existing.ID = id
+
+			repo.On("Get", mock.Anything, "tenant-123", id).Return(&existing, nil)
+			var got *Webhook
+			repo.On("Update", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) { got = args.Get(1).(*Webhook) }).
+				Return(&existing, nil)
+
+			_, err := uc.UpdateWebhook(WithTenantID(context.Background(), "tenant-123"), id, &tt.update)
+
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.Equal(t, tt.wantMode, got.DeliveryMode)
+			assert.Equal(t, tt.wantInterval, got.DigestInterval)
+		})
+	}
+}
+
+func TestReplayWebhookDelivery(t *testing.T) {
+	repo := new(MockWebhookRepo)
+	uc := NewWebhookUsecase(repo, NewRandomIDGenerator(), log.NewStdLogger(io.Discard))
+	webhookID, id := uuid.New(), uuid.New()
+
+	replayed := &WebhookDelivery{ID: id, WebhookID: webhookID, Status: WebhookDeliveryPending}
+	repo.On("Get", mock.Anything, "tenant-123", webhookID).Return(&Webhook{ID: webhookID}, nil)
+	repo.On("ReplayDelivery", mock.Anything, "tenant-123", webhookID, id).Return(replayed, nil)
+
+	got, err := uc.ReplayWebhookDelivery(WithTenantID(context.Background(), "tenant-123"), webhookID, id)
+
+	require.NoError(t, err)
+	assert.Equal(t, replayed, got)
+	repo.AssertExpectations(t)
+}
+
+func TestReplayWebhookDelivery_UnknownWebhook(t *testing.T) {
+	repo := new(MockWebhookRepo)
+	uc := NewWebhookUsecase(repo, NewRandomIDGenerator(), log.NewStdLogger(io.Discard))
+	webhookID := uuid.New()
+
+	repo.On("Get", mock.Anything, "tenant-123", webhookID).Return(nil, ErrWebhookNotFound)
+
+	_, err := uc.ReplayWebhookDelivery(WithTenantID(context.Background(), "tenant-123"), webhookID, uuid.New())
+
+	assert.True(t, errors.Is(err, ErrWebhookNotFound))
+	repo.AssertNotCalled(t, "ReplayDelivery", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	tests := []struct {
+		name         string
+		filter       *WebhookDeliveryFilter
+		wantPage     int32
+		wantPageSize int32
+	}{
+		{name: "defaults", filter: &WebhookDeliveryFilter{}, wantPage: 1, wantPageSize: 50},
+		{name: "page size capped", filter: &WebhookDeliveryFilter{Page: 2, PageSize: 1000}, wantPage: 2, wantPageSize: 200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockWebhookRepo)
+			uc := NewWebhookUsecase(repo, NewRandomIDGenerator(), log.NewStdLogger(io.Discard))
+			id := uuid.New()
+			tt.filter.WebhookID = id
+
+			deliveries := []*WebhookDelivery{{ID: uuid.New(), WebhookID: id}}
+			repo.On("Get", mock.Anything, "tenant-123", id).Return(&Webhook{ID: id}, nil)
+			repo.On("ListDeliveries", mock.Anything, "tenant-123", mock.MatchedBy(func(f *WebhookDeliveryFilter) bool {
+				return f.Page == tt.wantPage && f.PageSize == tt.wantPageSize
+			})).Return(deliveries, int64(1), nil)
+
+			got, total, err := uc.ListWebhookDeliveries(WithTenantID(context.Background(), "tenant-123"), tt.filter)
+
+			assert.NoError(t, err)
+			assert.Equal(t, deliveries, got)
+			assert.Equal(t, int64(1), total)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListWebhookDeliveries_UnknownWebhook(t *testing.T) {
+	repo := new(MockWebhookRepo)
+	uc := NewWebhookUsecase(repo, NewRandomIDGenerator(), log.NewStdLogger(io.Discard))
+	id := uuid.New()
+
+	repo.On("Get", mock.Anything, "tenant-123", id).Return(nil, ErrWebhookNotFound)
+
+	_, _, err := uc.ListWebhookDeliveries(WithTenantID(context.Background(), "tenant-123"), &WebhookDeliveryFilter{WebhookID: id})
+
+	assert.True(t, errors.Is(err, ErrWebhookNotFound))
+	repo.AssertNotCalled(t, "ListDeliveries", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	ts := time.Unix(1700000000, 0)
+
+	sig := SignWebhookPayload("secret", ts, []byte(`{"id":"1"}`))
+
+	// HMAC-SHA256("secret", "1700000000.{\"id\":\"1\"}")
+	assert.Equal(t, "t=1700000000,v1=086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54", sig)
+}
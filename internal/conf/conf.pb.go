@@ -29,6 +29,8 @@ type Bootstrap struct {
 	Auth          *Auth                  `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
 	Observability *Observability         `protobuf:"bytes,4,opt,name=observability,proto3" json:"observability,omitempty"`
 	Environment   string                 `protobuf:"bytes,5,opt,name=environment,proto3" json:"environment,omitempty"`
+	Admin         *Admin                 `protobuf:"bytes,6,opt,name=admin,proto3" json:"admin,omitempty"`
+	Secrets       *Secrets               `protobuf:"bytes,7,opt,name=secrets,proto3" json:"secrets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,17 +100,112 @@ func (x *Bootstrap) GetEnvironment() string {
 	return ""
 }
 
+func (x *Bootstrap) GetAdmin() *Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetSecrets() *Secrets {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+// Secrets loaded outside the config file. auth.jwt_secret and the database
+// password are resolved at startup from, by precedence, Vault, their *_file
+// variant and the config itself; the JWT secret is then refreshed every
+// refresh_interval so that it rotates without a restart.
+type Secrets struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Vault *Secrets_Vault         `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault,omitempty"`
+	// How often the JWT secret is reloaded and the Vault token renewed
+	// (default 1m)
+	RefreshInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`
+	// How long tokens signed with a rotated-out JWT secret are still
+	// accepted (default 1h)
+	JwtSecretGrace *durationpb.Duration `protobuf:"bytes,3,opt,name=jwt_secret_grace,json=jwtSecretGrace,proto3" json:"jwt_secret_grace,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Secrets) Reset() {
+	*x = Secrets{}
+	mi := &file_conf_conf_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secrets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secrets) ProtoMessage() {}
+
+func (x *Secrets) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secrets.ProtoReflect.Descriptor instead.
+func (*Secrets) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Secrets) GetVault() *Secrets_Vault {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+func (x *Secrets) GetRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return nil
+}
+
+func (x *Secrets) GetJwtSecretGrace() *durationpb.Duration {
+	if x != nil {
+		return x.JwtSecretGrace
+	}
+	return nil
+}
+
 type Server struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
-	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Http   *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc   *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Warmup *Server_Warmup         `protobuf:"bytes,3,opt,name=warmup,proto3" json:"warmup,omitempty"`
+	// Deprecated operations, announced to their callers with Deprecation and
+	// Sunset headers (gRPC trailers)
+	Deprecations []*Server_Deprecation `protobuf:"bytes,4,rep,name=deprecations,proto3" json:"deprecations,omitempty"`
+	// Version of the API behavior served to clients not sending X-API-Version
+	// (default the oldest, so that they keep the behavior they were written
+	// against)
+	DefaultApiVersion string `protobuf:"bytes,5,opt,name=default_api_version,json=defaultApiVersion,proto3" json:"default_api_version,omitempty"`
+	// Networks (CIDR) or addresses of the proxies in front of the servers.
+	// The client IP is read from X-Forwarded-For and X-Real-IP only on
+	// requests from these; other requests are attributed to their peer
+	// address.
+	TrustedProxies []string `protobuf:"bytes,6,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Server) Reset() {
 	*x = Server{}
-	mi := &file_conf_conf_proto_msgTypes[1]
+	mi := &file_conf_conf_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -120,7 +217,7 @@ func (x *Server) String() string {
 func (*Server) ProtoMessage() {}
 
 func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[1]
+	mi := &file_conf_conf_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -133,7 +230,7 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server.ProtoReflect.Descriptor instead.
 func (*Server) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+	return file_conf_conf_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Server) GetHttp() *Server_HTTP {
@@ -150,17 +247,58 @@ func (x *Server) GetGrpc() *Server_GRPC {
 	return nil
 }
 
+func (x *Server) GetWarmup() *Server_Warmup {
+	if x != nil {
+		return x.Warmup
+	}
+	return nil
+}
+
+func (x *Server) GetDeprecations() []*Server_Deprecation {
+	if x != nil {
+		return x.Deprecations
+	}
+	return nil
+}
+
+func (x *Server) GetDefaultApiVersion() string {
+	if x != nil {
+		return x.DefaultApiVersion
+	}
+	return ""
+}
+
+func (x *Server) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
 type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Nats          *Data_Nats             `protobuf:"bytes,2,opt,name=nats,proto3" json:"nats,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Database          *Data_Database          `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Nats              *Data_Nats              `protobuf:"bytes,2,opt,name=nats,proto3" json:"nats,omitempty"`
+	Redis             *Data_Redis             `protobuf:"bytes,3,opt,name=redis,proto3" json:"redis,omitempty"`
+	AuditArchive      *Data_AuditArchive      `protobuf:"bytes,4,opt,name=audit_archive,json=auditArchive,proto3" json:"audit_archive,omitempty"`
+	Webhooks          *Data_Webhooks          `protobuf:"bytes,5,opt,name=webhooks,proto3" json:"webhooks,omitempty"`
+	EventSink         *Data_EventSink         `protobuf:"bytes,6,opt,name=event_sink,json=eventSink,proto3" json:"event_sink,omitempty"`
+	Idempotency       *Data_Idempotency       `protobuf:"bytes,7,opt,name=idempotency,proto3" json:"idempotency,omitempty"`
+	EventEnrichment   *Data_EventEnrichment   `protobuf:"bytes,8,opt,name=event_enrichment,json=eventEnrichment,proto3" json:"event_enrichment,omitempty"`
+	ShadowRead        *Data_ShadowRead        `protobuf:"bytes,9,opt,name=shadow_read,json=shadowRead,proto3" json:"shadow_read,omitempty"`
+	TimeoutBudget     *Data_TimeoutBudget     `protobuf:"bytes,10,opt,name=timeout_budget,json=timeoutBudget,proto3" json:"timeout_budget,omitempty"`
+	EventPayload      *Data_EventPayload      `protobuf:"bytes,11,opt,name=event_payload,json=eventPayload,proto3" json:"event_payload,omitempty"`
+	AuthFailureEvents *Data_AuthFailureEvents `protobuf:"bytes,12,opt,name=auth_failure_events,json=authFailureEvents,proto3" json:"auth_failure_events,omitempty"`
+	Photos            *Data_Photos            `protobuf:"bytes,13,opt,name=photos,proto3" json:"photos,omitempty"`
+	Quota             *Data_Quota             `protobuf:"bytes,14,opt,name=quota,proto3" json:"quota,omitempty"`
+	ReadAudit         *Data_ReadAudit         `protobuf:"bytes,15,opt,name=read_audit,json=readAudit,proto3" json:"read_audit,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Data) Reset() {
 	*x = Data{}
-	mi := &file_conf_conf_proto_msgTypes[2]
+	mi := &file_conf_conf_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -172,7 +310,7 @@ func (x *Data) String() string {
 func (*Data) ProtoMessage() {}
 
 func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[2]
+	mi := &file_conf_conf_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -185,7 +323,7 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data.ProtoReflect.Descriptor instead.
 func (*Data) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+	return file_conf_conf_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Data) GetDatabase() *Data_Database {
@@ -202,16 +340,137 @@ func (x *Data) GetNats() *Data_Nats {
 	return nil
 }
 
+func (x *Data) GetRedis() *Data_Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+func (x *Data) GetAuditArchive() *Data_AuditArchive {
+	if x != nil {
+		return x.AuditArchive
+	}
+	return nil
+}
+
+func (x *Data) GetWebhooks() *Data_Webhooks {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *Data) GetEventSink() *Data_EventSink {
+	if x != nil {
+		return x.EventSink
+	}
+	return nil
+}
+
+func (x *Data) GetIdempotency() *Data_Idempotency {
+	if x != nil {
+		return x.Idempotency
+	}
+	return nil
+}
+
+func (x *Data) GetEventEnrichment() *Data_EventEnrichment {
+	if x != nil {
+		return x.EventEnrichment
+	}
+	return nil
+}
+
+func (x *Data) GetShadowRead() *Data_ShadowRead {
+	if x != nil {
+		return x.ShadowRead
+	}
+	return nil
+}
+
+func (x *Data) GetTimeoutBudget() *Data_TimeoutBudget {
+	if x != nil {
+		return x.TimeoutBudget
+	}
+	return nil
+}
+
+func (x *Data) GetEventPayload() *Data_EventPayload {
+	if x != nil {
+		return x.EventPayload
+	}
+	return nil
+}
+
+func (x *Data) GetAuthFailureEvents() *Data_AuthFailureEvents {
+	if x != nil {
+		return x.AuthFailureEvents
+	}
+	return nil
+}
+
+func (x *Data) GetPhotos() *Data_Photos {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
+func (x *Data) GetQuota() *Data_Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *Data) GetReadAudit() *Data_ReadAudit {
+	if x != nil {
+		return x.ReadAudit
+	}
+	return nil
+}
+
 type Auth struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JwtSecret     string                 `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	JwtSecret string                 `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`
+	// Role name (from the JWT "roles" claim) -> operations the role may call.
+	// Operations are full method names such as
+	// /employee.v1.EmployeeService/GetEmployee and may end in "*" to match a
+	// prefix. Authorization is disabled when no roles are configured.
+	Roles map[string]*Role `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Jwks  *Auth_JWKS       `protobuf:"bytes,3,opt,name=jwks,proto3" json:"jwks,omitempty"`
+	// Trusted issuers, e.g. a legacy auth service and an OIDC provider during
+	// a migration. When set, a token's "iss" claim must name one of them and
+	// the token is verified with that issuer's keys; tokens without "iss" are
+	// still verified with jwt_secret and jwks. Without issuers any "iss" is
+	// accepted.
+	Issuers []*Auth_Issuer `protobuf:"bytes,4,rep,name=issuers,proto3" json:"issuers,omitempty"`
+	// Accepted "aud" claims. When set, a token's audience must contain one of
+	// them.
+	Audiences []string `protobuf:"bytes,5,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	// Operations served without authentication, authorization or scope
+	// checks, e.g. a public lookup or an OIDC callback. Names are matched like
+	// role operations, over both gRPC and HTTP; a bare "*" is rejected.
+	// Exempt operations run without a tenant or user in the context.
+	ExemptOperations []string `protobuf:"bytes,6,rep,name=exempt_operations,json=exemptOperations,proto3" json:"exempt_operations,omitempty"`
+	// Roles that may act as another user or tenant with the X-Act-As-User and
+	// X-Act-As-Tenant headers, e.g. support admins. Requests sending the
+	// headers without one of them are rejected; impersonation is disabled
+	// when none are configured.
+	ImpersonationRoles []string             `protobuf:"bytes,7,rep,name=impersonation_roles,json=impersonationRoles,proto3" json:"impersonation_roles,omitempty"`
+	RequestSigning     *Auth_RequestSigning `protobuf:"bytes,8,opt,name=request_signing,json=requestSigning,proto3" json:"request_signing,omitempty"`
+	// File holding jwt_secret, e.g. a mounted Kubernetes secret. It is
+	// reloaded every secrets.refresh_interval, so the secret rotates without
+	// a restart.
+	JwtSecretFile string `protobuf:"bytes,9,opt,name=jwt_secret_file,json=jwtSecretFile,proto3" json:"jwt_secret_file,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Auth) Reset() {
 	*x = Auth{}
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_conf_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -223,7 +482,7 @@ func (x *Auth) String() string {
 func (*Auth) ProtoMessage() {}
 
 func (x *Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_conf_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,7 +495,7 @@ func (x *Auth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Auth.ProtoReflect.Descriptor instead.
 func (*Auth) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+	return file_conf_conf_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Auth) GetJwtSecret() string {
@@ -246,30 +505,3306 @@ func (x *Auth) GetJwtSecret() string {
 	return ""
 }
 
-type Observability struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metrics       *Metrics               `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
-	Tracing       *Tracing               `protobuf:"bytes,2,opt,name=tracing,proto3" json:"tracing,omitempty"`
-	Logging       *Logging               `protobuf:"bytes,3,opt,name=logging,proto3" json:"logging,omitempty"`
+func (x *Auth) GetRoles() map[string]*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *Auth) GetJwks() *Auth_JWKS {
+	if x != nil {
+		return x.Jwks
+	}
+	return nil
+}
+
+func (x *Auth) GetIssuers() []*Auth_Issuer {
+	if x != nil {
+		return x.Issuers
+	}
+	return nil
+}
+
+func (x *Auth) GetAudiences() []string {
+	if x != nil {
+		return x.Audiences
+	}
+	return nil
+}
+
+func (x *Auth) GetExemptOperations() []string {
+	if x != nil {
+		return x.ExemptOperations
+	}
+	return nil
+}
+
+func (x *Auth) GetImpersonationRoles() []string {
+	if x != nil {
+		return x.ImpersonationRoles
+	}
+	return nil
+}
+
+func (x *Auth) GetRequestSigning() *Auth_RequestSigning {
+	if x != nil {
+		return x.RequestSigning
+	}
+	return nil
+}
+
+func (x *Auth) GetJwtSecretFile() string {
+	if x != nil {
+		return x.JwtSecretFile
+	}
+	return ""
+}
+
+type Role struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operations    []string               `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Role) GetOperations() []string {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type Admin struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long a destructive-operation confirmation token stays valid (default 5m)
+	ConfirmationTtl    *durationpb.Duration      `protobuf:"bytes,1,opt,name=confirmation_ttl,json=confirmationTtl,proto3" json:"confirmation_ttl,omitempty"`
+	Import             *Admin_Import             `protobuf:"bytes,2,opt,name=import,proto3" json:"import,omitempty"`
+	Review             *Admin_Review             `protobuf:"bytes,3,opt,name=review,proto3" json:"review,omitempty"`
+	Schedule           *Admin_Schedule           `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Usage              *Admin_Usage              `protobuf:"bytes,5,opt,name=usage,proto3" json:"usage,omitempty"`
+	EmailNormalization *Admin_EmailNormalization `protobuf:"bytes,6,opt,name=email_normalization,json=emailNormalization,proto3" json:"email_normalization,omitempty"`
+	InFlight           *Admin_InFlight           `protobuf:"bytes,7,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	MergeApproval      *Admin_MergeApproval      `protobuf:"bytes,8,opt,name=merge_approval,json=mergeApproval,proto3" json:"merge_approval,omitempty"`
+	Stale              *Admin_Stale              `protobuf:"bytes,9,opt,name=stale,proto3" json:"stale,omitempty"`
+	TenantClone        *Admin_TenantClone        `protobuf:"bytes,10,opt,name=tenant_clone,json=tenantClone,proto3" json:"tenant_clone,omitempty"`
+	MergeLimits        *Admin_MergeLimits        `protobuf:"bytes,11,opt,name=merge_limits,json=mergeLimits,proto3" json:"merge_limits,omitempty"`
+	EmailValidation    *Admin_EmailValidation    `protobuf:"bytes,12,opt,name=email_validation,json=emailValidation,proto3" json:"email_validation,omitempty"`
+	Runbook            *Admin_Runbook            `protobuf:"bytes,13,opt,name=runbook,proto3" json:"runbook,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Admin) Reset() {
+	*x = Admin{}
+	mi := &file_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Admin) ProtoMessage() {}
+
+func (x *Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Admin.ProtoReflect.Descriptor instead.
+func (*Admin) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Admin) GetConfirmationTtl() *durationpb.Duration {
+	if x != nil {
+		return x.ConfirmationTtl
+	}
+	return nil
+}
+
+func (x *Admin) GetImport() *Admin_Import {
+	if x != nil {
+		return x.Import
+	}
+	return nil
+}
+
+func (x *Admin) GetReview() *Admin_Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *Admin) GetSchedule() *Admin_Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *Admin) GetUsage() *Admin_Usage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *Admin) GetEmailNormalization() *Admin_EmailNormalization {
+	if x != nil {
+		return x.EmailNormalization
+	}
+	return nil
+}
+
+func (x *Admin) GetInFlight() *Admin_InFlight {
+	if x != nil {
+		return x.InFlight
+	}
+	return nil
+}
+
+func (x *Admin) GetMergeApproval() *Admin_MergeApproval {
+	if x != nil {
+		return x.MergeApproval
+	}
+	return nil
+}
+
+func (x *Admin) GetStale() *Admin_Stale {
+	if x != nil {
+		return x.Stale
+	}
+	return nil
+}
+
+func (x *Admin) GetTenantClone() *Admin_TenantClone {
+	if x != nil {
+		return x.TenantClone
+	}
+	return nil
+}
+
+func (x *Admin) GetMergeLimits() *Admin_MergeLimits {
+	if x != nil {
+		return x.MergeLimits
+	}
+	return nil
+}
+
+func (x *Admin) GetEmailValidation() *Admin_EmailValidation {
+	if x != nil {
+		return x.EmailValidation
+	}
+	return nil
+}
+
+func (x *Admin) GetRunbook() *Admin_Runbook {
+	if x != nil {
+		return x.Runbook
+	}
+	return nil
+}
+
+type Observability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       *Metrics               `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Tracing       *Tracing               `protobuf:"bytes,2,opt,name=tracing,proto3" json:"tracing,omitempty"`
+	Logging       *Logging               `protobuf:"bytes,3,opt,name=logging,proto3" json:"logging,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Observability) Reset() {
+	*x = Observability{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Observability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observability) ProtoMessage() {}
+
+func (x *Observability) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observability.ProtoReflect.Descriptor instead.
+func (*Observability) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Observability) GetMetrics() *Metrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *Observability) GetTracing() *Tracing {
+	if x != nil {
+		return x.Tracing
+	}
+	return nil
+}
+
+func (x *Observability) GetLogging() *Logging {
+	if x != nil {
+		return x.Logging
+	}
+	return nil
+}
+
+type Metrics struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Enabled   bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Namespace string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Subsystem string                 `protobuf:"bytes,3,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
+	Push      *Metrics_Push          `protobuf:"bytes,4,opt,name=push,proto3" json:"push,omitempty"`
+	// Tenants labeled by name on the per-tenant metrics (default 100);
+	// further tenants share the tenant label "other" to bound cardinality
+	MaxTenantLabels int32 `protobuf:"varint,5,opt,name=max_tenant_labels,json=maxTenantLabels,proto3" json:"max_tenant_labels,omitempty"`
+	// How often employees_per_tenant is counted (default 5m)
+	EmployeeCountInterval *durationpb.Duration `protobuf:"bytes,6,opt,name=employee_count_interval,json=employeeCountInterval,proto3" json:"employee_count_interval,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Metrics) Reset() {
+	*x = Metrics{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Metrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metrics) ProtoMessage() {}
+
+func (x *Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
+func (*Metrics) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Metrics) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Metrics) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Metrics) GetSubsystem() string {
+	if x != nil {
+		return x.Subsystem
+	}
+	return ""
+}
+
+func (x *Metrics) GetPush() *Metrics_Push {
+	if x != nil {
+		return x.Push
+	}
+	return nil
+}
+
+func (x *Metrics) GetMaxTenantLabels() int32 {
+	if x != nil {
+		return x.MaxTenantLabels
+	}
+	return 0
+}
+
+func (x *Metrics) GetEmployeeCountInterval() *durationpb.Duration {
+	if x != nil {
+		return x.EmployeeCountInterval
+	}
+	return nil
+}
+
+type Tracing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	SampleRate    float64                `protobuf:"fixed64,3,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	Insecure      bool                   `protobuf:"varint,4,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tracing) Reset() {
+	*x = Tracing{}
+	mi := &file_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tracing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tracing) ProtoMessage() {}
+
+func (x *Tracing) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tracing.ProtoReflect.Descriptor instead.
+func (*Tracing) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Tracing) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Tracing) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Tracing) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *Tracing) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+type Logging struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	LogRequests   bool                   `protobuf:"varint,2,opt,name=log_requests,json=logRequests,proto3" json:"log_requests,omitempty"`
+	LogResponses  bool                   `protobuf:"varint,3,opt,name=log_responses,json=logResponses,proto3" json:"log_responses,omitempty"`
+	Level         string                 `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"` // debug, info, warn, error
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Logging) Reset() {
+	*x = Logging{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Logging) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Logging) ProtoMessage() {}
+
+func (x *Logging) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Logging.ProtoReflect.Descriptor instead.
+func (*Logging) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Logging) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Logging) GetLogRequests() bool {
+	if x != nil {
+		return x.LogRequests
+	}
+	return false
+}
+
+func (x *Logging) GetLogResponses() bool {
+	if x != nil {
+		return x.LogResponses
+	}
+	return false
+}
+
+func (x *Logging) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// A secret stored in Vault
+type Secrets_VaultSecret struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// API path of the secret without /v1, e.g. secret/data/employee-service
+	// for the KV v2 engine mounted at secret
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Key of the value in the secret
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secrets_VaultSecret) Reset() {
+	*x = Secrets_VaultSecret{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secrets_VaultSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secrets_VaultSecret) ProtoMessage() {}
+
+func (x *Secrets_VaultSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secrets_VaultSecret.ProtoReflect.Descriptor instead.
+func (*Secrets_VaultSecret) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Secrets_VaultSecret) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Secrets_VaultSecret) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// Vault client; disabled without address
+type Secrets_Vault struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// e.g. https://vault.internal:8200
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Vault Enterprise namespace
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Token to authenticate with, or a file holding it, e.g. written by the
+	// Vault agent. Without either, the pod logs in with Kubernetes auth.
+	Token     string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	TokenFile string `protobuf:"bytes,4,opt,name=token_file,json=tokenFile,proto3" json:"token_file,omitempty"`
+	// Role of the Kubernetes auth method
+	KubernetesRole string `protobuf:"bytes,5,opt,name=kubernetes_role,json=kubernetesRole,proto3" json:"kubernetes_role,omitempty"`
+	// Mount path of the Kubernetes auth method (default kubernetes)
+	KubernetesMount string `protobuf:"bytes,6,opt,name=kubernetes_mount,json=kubernetesMount,proto3" json:"kubernetes_mount,omitempty"`
+	// Service account token of the Kubernetes login (default
+	// /var/run/secrets/kubernetes.io/serviceaccount/token)
+	KubernetesTokenFile string `protobuf:"bytes,7,opt,name=kubernetes_token_file,json=kubernetesTokenFile,proto3" json:"kubernetes_token_file,omitempty"`
+	// Timeout of a Vault request (default 10s)
+	Timeout *durationpb.Duration `protobuf:"bytes,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Secrets read from Vault; empty paths are read from the config
+	JwtSecret        *Secrets_VaultSecret `protobuf:"bytes,9,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`
+	DatabasePassword *Secrets_VaultSecret `protobuf:"bytes,10,opt,name=database_password,json=databasePassword,proto3" json:"database_password,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Secrets_Vault) Reset() {
+	*x = Secrets_Vault{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secrets_Vault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secrets_Vault) ProtoMessage() {}
+
+func (x *Secrets_Vault) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secrets_Vault.ProtoReflect.Descriptor instead.
+func (*Secrets_Vault) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Secrets_Vault) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Secrets_Vault) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Secrets_Vault) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Secrets_Vault) GetTokenFile() string {
+	if x != nil {
+		return x.TokenFile
+	}
+	return ""
+}
+
+func (x *Secrets_Vault) GetKubernetesRole() string {
+	if x != nil {
+		return x.KubernetesRole
+	}
+	return ""
+}
+
+func (x *Secrets_Vault) GetKubernetesMount() string {
+	if x != nil {
+		return x.KubernetesMount
+	}
+	return ""
+}
+
+func (x *Secrets_Vault) GetKubernetesTokenFile() string {
+	if x != nil {
+		return x.KubernetesTokenFile
+	}
+	return ""
+}
+
+func (x *Secrets_Vault) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Secrets_Vault) GetJwtSecret() *Secrets_VaultSecret {
+	if x != nil {
+		return x.JwtSecret
+	}
+	return nil
+}
+
+func (x *Secrets_Vault) GetDatabasePassword() *Secrets_VaultSecret {
+	if x != nil {
+		return x.DatabasePassword
+	}
+	return nil
+}
+
+type Server_HTTP struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Network string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr    string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Upper bound for a streaming export, which is not limited by timeout
+	// (default 1h)
+	ExportTimeout *durationpb.Duration `protobuf:"bytes,4,opt,name=export_timeout,json=exportTimeout,proto3" json:"export_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_HTTP) Reset() {
+	*x = Server_HTTP{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP) ProtoMessage() {}
+
+func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
+func (*Server_HTTP) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Server_HTTP) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Server_HTTP) GetExportTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ExportTimeout
+	}
+	return nil
+}
+
+type Server_GRPC struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Tls           *Server_GRPC_TLS       `protobuf:"bytes,4,opt,name=tls,proto3" json:"tls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_GRPC) Reset() {
+	*x = Server_GRPC{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_GRPC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_GRPC) ProtoMessage() {}
+
+func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
+func (*Server_GRPC) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Server_GRPC) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_GRPC) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Server_GRPC) GetTls() *Server_GRPC_TLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+type Server_Warmup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Prime dependencies after start and report ready only once done
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Upper bound for the whole warm-up phase (default 30s)
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Number of database connections to open up front (default 4)
+	DbConnections int32 `protobuf:"varint,3,opt,name=db_connections,json=dbConnections,proto3" json:"db_connections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Warmup) Reset() {
+	*x = Server_Warmup{}
+	mi := &file_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Warmup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Warmup) ProtoMessage() {}
+
+func (x *Server_Warmup) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Warmup.ProtoReflect.Descriptor instead.
+func (*Server_Warmup) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Server_Warmup) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Server_Warmup) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Server_Warmup) GetDbConnections() int32 {
+	if x != nil {
+		return x.DbConnections
+	}
+	return 0
+}
+
+type Server_Deprecation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Full gRPC method name, or a prefix ending in "*"
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// When the operation was deprecated, as an RFC 3339 time
+	Since string `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	// When the operation stops working, as an RFC 3339 time; empty while no
+	// sunset is planned
+	Sunset string `protobuf:"bytes,3,opt,name=sunset,proto3" json:"sunset,omitempty"`
+	// Migration guide, linked with rel="deprecation"
+	Link          string `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Deprecation) Reset() {
+	*x = Server_Deprecation{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Deprecation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Deprecation) ProtoMessage() {}
+
+func (x *Server_Deprecation) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Deprecation.ProtoReflect.Descriptor instead.
+func (*Server_Deprecation) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Server_Deprecation) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *Server_Deprecation) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *Server_Deprecation) GetSunset() string {
+	if x != nil {
+		return x.Sunset
+	}
+	return ""
+}
+
+func (x *Server_Deprecation) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+// TLS of the gRPC server, for mutual TLS between internal services
+type Server_GRPC_TLS struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PEM server certificate and key; TLS is off without them
+	CertFile string `protobuf:"bytes,1,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	KeyFile  string `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// PEM CA bundle verifying client certificates; without it clients
+	// are not asked for one
+	ClientCaFile string `protobuf:"bytes,3,opt,name=client_ca_file,json=clientCaFile,proto3" json:"client_ca_file,omitempty"`
+	// require (default): every client must present a certificate signed
+	// by client_ca_file; request: clients may connect without one
+	ClientAuth    string                        `protobuf:"bytes,4,opt,name=client_auth,json=clientAuth,proto3" json:"client_auth,omitempty"`
+	CertIdentity  *Server_GRPC_TLS_CertIdentity `protobuf:"bytes,5,opt,name=cert_identity,json=certIdentity,proto3" json:"cert_identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_GRPC_TLS) Reset() {
+	*x = Server_GRPC_TLS{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_GRPC_TLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_GRPC_TLS) ProtoMessage() {}
+
+func (x *Server_GRPC_TLS) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_GRPC_TLS.ProtoReflect.Descriptor instead.
+func (*Server_GRPC_TLS) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1, 0}
+}
+
+func (x *Server_GRPC_TLS) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *Server_GRPC_TLS) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *Server_GRPC_TLS) GetClientCaFile() string {
+	if x != nil {
+		return x.ClientCaFile
+	}
+	return ""
+}
+
+func (x *Server_GRPC_TLS) GetClientAuth() string {
+	if x != nil {
+		return x.ClientAuth
+	}
+	return ""
+}
+
+func (x *Server_GRPC_TLS) GetCertIdentity() *Server_GRPC_TLS_CertIdentity {
+	if x != nil {
+		return x.CertIdentity
+	}
+	return nil
+}
+
+// Client certificates whose URI SAN starts with uri_prefix
+// authenticate requests sent without a token or API key, e.g. with
+// spiffe://corp.internal/tenant/ the URI
+// spiffe://corp.internal/tenant/acme/payroll acts in tenant acme
+type Server_GRPC_TLS_CertIdentity struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UriPrefix string                 `protobuf:"bytes,1,opt,name=uri_prefix,json=uriPrefix,proto3" json:"uri_prefix,omitempty"`
+	// Roles of the requests authenticated by certificate
+	Roles         []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_GRPC_TLS_CertIdentity) Reset() {
+	*x = Server_GRPC_TLS_CertIdentity{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_GRPC_TLS_CertIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_GRPC_TLS_CertIdentity) ProtoMessage() {}
+
+func (x *Server_GRPC_TLS_CertIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_GRPC_TLS_CertIdentity.ProtoReflect.Descriptor instead.
+func (*Server_GRPC_TLS_CertIdentity) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1, 0, 0}
+}
+
+func (x *Server_GRPC_TLS_CertIdentity) GetUriPrefix() string {
+	if x != nil {
+		return x.UriPrefix
+	}
+	return ""
+}
+
+func (x *Server_GRPC_TLS_CertIdentity) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type Data_Database struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postgres (default) or cockroachdb
+	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// File holding the source, e.g. a mounted Kubernetes secret; it
+	// replaces source
+	SourceFile string `protobuf:"bytes,3,opt,name=source_file,json=sourceFile,proto3" json:"source_file,omitempty"`
+	// File holding the password of source, e.g. a mounted Kubernetes
+	// secret; it replaces the password of source
+	PasswordFile string `protobuf:"bytes,4,opt,name=password_file,json=passwordFile,proto3" json:"password_file,omitempty"`
+	// Connection pool limits, applied to the pool at startup and to the
+	// pools replacing it; unset keeps the database/sql defaults (no open
+	// limit, 2 idle connections, no lifetime limit)
+	MaxOpenConns    int32                `protobuf:"varint,5,opt,name=max_open_conns,json=maxOpenConns,proto3" json:"max_open_conns,omitempty"`
+	MaxIdleConns    int32                `protobuf:"varint,6,opt,name=max_idle_conns,json=maxIdleConns,proto3" json:"max_idle_conns,omitempty"`
+	ConnMaxLifetime *durationpb.Duration `protobuf:"bytes,7,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"`
+	// CockroachDB does not tell which transactions are still in progress,
+	// so employee watches and webhook fan-out hold back audit entries
+	// younger than this, so that a transaction committing after a later
+	// one is not skipped. It must exceed the duration of the longest
+	// audited transaction (default 2s). Postgres ignores it.
+	AuditSettle   *durationpb.Duration `protobuf:"bytes,8,opt,name=audit_settle,json=auditSettle,proto3" json:"audit_settle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Database) Reset() {
+	*x = Data_Database{}
+	mi := &file_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Database) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Database) ProtoMessage() {}
+
+func (x *Data_Database) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
+func (*Data_Database) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *Data_Database) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Database) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Data_Database) GetSourceFile() string {
+	if x != nil {
+		return x.SourceFile
+	}
+	return ""
+}
+
+func (x *Data_Database) GetPasswordFile() string {
+	if x != nil {
+		return x.PasswordFile
+	}
+	return ""
+}
+
+func (x *Data_Database) GetMaxOpenConns() int32 {
+	if x != nil {
+		return x.MaxOpenConns
+	}
+	return 0
+}
+
+func (x *Data_Database) GetMaxIdleConns() int32 {
+	if x != nil {
+		return x.MaxIdleConns
+	}
+	return 0
+}
+
+func (x *Data_Database) GetConnMaxLifetime() *durationpb.Duration {
+	if x != nil {
+		return x.ConnMaxLifetime
+	}
+	return nil
+}
+
+func (x *Data_Database) GetAuditSettle() *durationpb.Duration {
+	if x != nil {
+		return x.AuditSettle
+	}
+	return nil
+}
+
+type Data_Nats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // subject field removed - using versioned subjects: employees.v1.{created,updated,deleted,merged,unmerged}
+	// Publish through JetStream with acks and Nats-Msg-Id deduplication
+	// instead of fire-and-forget core NATS
+	Jetstream bool `protobuf:"varint,2,opt,name=jetstream,proto3" json:"jetstream,omitempty"`
+	// JetStream stream capturing employees.v1.> (default EMPLOYEES)
+	Stream string `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	// How long to wait for a publish ack (default 5s)
+	PublishTimeout *durationpb.Duration `protobuf:"bytes,4,opt,name=publish_timeout,json=publishTimeout,proto3" json:"publish_timeout,omitempty"`
+	// Window in which duplicate Nats-Msg-Id values are discarded (default 2m)
+	DuplicateWindow *durationpb.Duration `protobuf:"bytes,5,opt,name=duplicate_window,json=duplicateWindow,proto3" json:"duplicate_window,omitempty"`
+	// Publish slim events (employee ID and changed fields only) instead of
+	// the full employee
+	SlimEvents bool `protobuf:"varint,6,opt,name=slim_events,json=slimEvents,proto3" json:"slim_events,omitempty"`
+	// Largest event payload in bytes (default: the server's max_payload).
+	// Larger events are slimmed, and dropped if still too large.
+	MaxEventBytes int64 `protobuf:"varint,7,opt,name=max_event_bytes,json=maxEventBytes,proto3" json:"max_event_bytes,omitempty"`
+	// Event payload encryption keys. The first key listed for a tenant
+	// encrypts its events, events of tenants without a key are published in
+	// cleartext.
+	EncryptionKeys []*Data_Nats_EncryptionKey `protobuf:"bytes,8,rep,name=encryption_keys,json=encryptionKeys,proto3" json:"encryption_keys,omitempty"`
+	Signing        *Data_Nats_Signing         `protobuf:"bytes,9,opt,name=signing,proto3" json:"signing,omitempty"`
+	// Clusters in order of preference; url is ignored when set. On
+	// disconnect the client fails over to the next server, across clusters.
+	Clusters []*Data_Nats_Cluster `protobuf:"bytes,10,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	// Cluster tried first regardless of its position in clusters
+	PreferredCluster string `protobuf:"bytes,11,opt,name=preferred_cluster,json=preferredCluster,proto3" json:"preferred_cluster,omitempty"`
+	// Delay between reconnect attempts to the same server (default 2s)
+	ReconnectWait *durationpb.Duration `protobuf:"bytes,12,opt,name=reconnect_wait,json=reconnectWait,proto3" json:"reconnect_wait,omitempty"`
+	// Upper bound of a random delay added to reconnect_wait, for plaintext
+	// and TLS servers (default 100ms and 1s)
+	ReconnectJitter    *durationpb.Duration `protobuf:"bytes,13,opt,name=reconnect_jitter,json=reconnectJitter,proto3" json:"reconnect_jitter,omitempty"`
+	ReconnectJitterTls *durationpb.Duration `protobuf:"bytes,14,opt,name=reconnect_jitter_tls,json=reconnectJitterTls,proto3" json:"reconnect_jitter_tls,omitempty"`
+	// Reconnect attempts per server before giving up (default: unlimited)
+	MaxReconnects int32 `protobuf:"varint,15,opt,name=max_reconnects,json=maxReconnects,proto3" json:"max_reconnects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Nats) Reset() {
+	*x = Data_Nats{}
+	mi := &file_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Nats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Nats) ProtoMessage() {}
+
+func (x *Data_Nats) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Nats.ProtoReflect.Descriptor instead.
+func (*Data_Nats) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *Data_Nats) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Data_Nats) GetJetstream() bool {
+	if x != nil {
+		return x.Jetstream
+	}
+	return false
+}
+
+func (x *Data_Nats) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *Data_Nats) GetPublishTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PublishTimeout
+	}
+	return nil
+}
+
+func (x *Data_Nats) GetDuplicateWindow() *durationpb.Duration {
+	if x != nil {
+		return x.DuplicateWindow
+	}
+	return nil
+}
+
+func (x *Data_Nats) GetSlimEvents() bool {
+	if x != nil {
+		return x.SlimEvents
+	}
+	return false
+}
+
+func (x *Data_Nats) GetMaxEventBytes() int64 {
+	if x != nil {
+		return x.MaxEventBytes
+	}
+	return 0
+}
+
+func (x *Data_Nats) GetEncryptionKeys() []*Data_Nats_EncryptionKey {
+	if x != nil {
+		return x.EncryptionKeys
+	}
+	return nil
+}
+
+func (x *Data_Nats) GetSigning() *Data_Nats_Signing {
+	if x != nil {
+		return x.Signing
+	}
+	return nil
+}
+
+func (x *Data_Nats) GetClusters() []*Data_Nats_Cluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *Data_Nats) GetPreferredCluster() string {
+	if x != nil {
+		return x.PreferredCluster
+	}
+	return ""
+}
+
+func (x *Data_Nats) GetReconnectWait() *durationpb.Duration {
+	if x != nil {
+		return x.ReconnectWait
+	}
+	return nil
+}
+
+func (x *Data_Nats) GetReconnectJitter() *durationpb.Duration {
+	if x != nil {
+		return x.ReconnectJitter
+	}
+	return nil
+}
+
+func (x *Data_Nats) GetReconnectJitterTls() *durationpb.Duration {
+	if x != nil {
+		return x.ReconnectJitterTls
+	}
+	return nil
+}
+
+func (x *Data_Nats) GetMaxReconnects() int32 {
+	if x != nil {
+		return x.MaxReconnects
+	}
+	return 0
+}
+
+// Read-through cache for employee lookups by ID and email.
+// The cache is disabled when addr is empty.
+type Data_Redis struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Addr     string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Db       int32                  `protobuf:"varint,3,opt,name=db,proto3" json:"db,omitempty"`
+	// How long cached employees are kept (default 5m)
+	Ttl           *durationpb.Duration `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Redis) Reset() {
+	*x = Data_Redis{}
+	mi := &file_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Redis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Redis) ProtoMessage() {}
+
+func (x *Data_Redis) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
+func (*Data_Redis) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *Data_Redis) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetDb() int32 {
+	if x != nil {
+		return x.Db
+	}
+	return 0
+}
+
+func (x *Data_Redis) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// S3-compatible bucket
+type Data_ObjectStore struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Bucket   string                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Key prefix for all objects written by the service
+	Prefix        string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	AccessKey     string `protobuf:"bytes,4,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	SecretKey     string `protobuf:"bytes,5,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	Region        string `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	UseSsl        bool   `protobuf:"varint,7,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_ObjectStore) Reset() {
+	*x = Data_ObjectStore{}
+	mi := &file_conf_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_ObjectStore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_ObjectStore) ProtoMessage() {}
+
+func (x *Data_ObjectStore) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_ObjectStore.ProtoReflect.Descriptor instead.
+func (*Data_ObjectStore) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 3}
+}
+
+func (x *Data_ObjectStore) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Data_ObjectStore) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *Data_ObjectStore) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Data_ObjectStore) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *Data_ObjectStore) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *Data_ObjectStore) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Data_ObjectStore) GetUseSsl() bool {
+	if x != nil {
+		return x.UseSsl
+	}
+	return false
+}
+
+// Periodically moves audit entries older than retention_days to object
+// storage and deletes them from the database
+type Data_AuditArchive struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Entries younger than this stay in the database (default 90)
+	RetentionDays int32 `protobuf:"varint,2,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
+	// How often the archival job runs (default 1h)
+	Interval *durationpb.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Entries per archive object and delete statement (default 1000)
+	BatchSize     int32             `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Store         *Data_ObjectStore `protobuf:"bytes,5,opt,name=store,proto3" json:"store,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_AuditArchive) Reset() {
+	*x = Data_AuditArchive{}
+	mi := &file_conf_conf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_AuditArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_AuditArchive) ProtoMessage() {}
+
+func (x *Data_AuditArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_AuditArchive.ProtoReflect.Descriptor instead.
+func (*Data_AuditArchive) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 4}
+}
+
+func (x *Data_AuditArchive) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Data_AuditArchive) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *Data_AuditArchive) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Data_AuditArchive) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Data_AuditArchive) GetStore() *Data_ObjectStore {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+// Event sink used when NATS is not configured or unreachable at startup,
+// so that events are never silently dropped. Set either file or url.
+type Data_EventSink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path of an NDJSON file events are appended to
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Size at which the file is rotated to file.1 (default 100MB)
+	MaxFileBytes int64 `protobuf:"varint,2,opt,name=max_file_bytes,json=maxFileBytes,proto3" json:"max_file_bytes,omitempty"`
+	// Rotated files kept, file.1 being the newest (default 5)
+	MaxFiles int32 `protobuf:"varint,3,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
+	// Collector URL every event is POSTed to as an NDJSON line
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// HTTP timeout per event (default 5s)
+	Timeout       *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_EventSink) Reset() {
+	*x = Data_EventSink{}
+	mi := &file_conf_conf_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_EventSink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_EventSink) ProtoMessage() {}
+
+func (x *Data_EventSink) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_EventSink.ProtoReflect.Descriptor instead.
+func (*Data_EventSink) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 5}
+}
+
+func (x *Data_EventSink) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *Data_EventSink) GetMaxFileBytes() int64 {
+	if x != nil {
+		return x.MaxFileBytes
+	}
+	return 0
+}
+
+func (x *Data_EventSink) GetMaxFiles() int32 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
+}
+
+func (x *Data_EventSink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Data_EventSink) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// Fans employee events out to tenant webhooks and delivers them
+type Data_Webhooks struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// How often the worker looks for new events and due deliveries (default 1s)
+	PollInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// HTTP timeout per delivery attempt (default 10s)
+	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Attempts before a delivery is marked failed (default 8)
+	MaxAttempts int32 `protobuf:"varint,4,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Delay before the first retry, doubled per attempt (default 30s)
+	InitialBackoff *durationpb.Duration `protobuf:"bytes,5,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"`
+	// Upper bound for the retry delay (default 1h)
+	MaxBackoff *durationpb.Duration `protobuf:"bytes,6,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	// Networks of internal receivers, e.g. 10.20.0.0/16. Deliveries to
+	// loopback, private, link-local and unspecified addresses are refused
+	// unless listed here.
+	AllowedNetworks []string `protobuf:"bytes,7,rep,name=allowed_networks,json=allowedNetworks,proto3" json:"allowed_networks,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Data_Webhooks) Reset() {
+	*x = Data_Webhooks{}
+	mi := &file_conf_conf_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Webhooks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Webhooks) ProtoMessage() {}
+
+func (x *Data_Webhooks) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Webhooks.ProtoReflect.Descriptor instead.
+func (*Data_Webhooks) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 6}
+}
+
+func (x *Data_Webhooks) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Data_Webhooks) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Data_Webhooks) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Data_Webhooks) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Data_Webhooks) GetInitialBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.InitialBackoff
+	}
+	return nil
+}
+
+func (x *Data_Webhooks) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+func (x *Data_Webhooks) GetAllowedNetworks() []string {
+	if x != nil {
+		return x.AllowedNetworks
+	}
+	return nil
+}
+
+// Idempotency keys of create requests
+type Data_Idempotency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long a key replays the original response (default 24h)
+	Ttl *durationpb.Duration `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// How often expired keys are deleted (default 1h)
+	CleanupInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=cleanup_interval,json=cleanupInterval,proto3" json:"cleanup_interval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Data_Idempotency) Reset() {
+	*x = Data_Idempotency{}
+	mi := &file_conf_conf_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Idempotency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Idempotency) ProtoMessage() {}
+
+func (x *Data_Idempotency) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Idempotency.ProtoReflect.Descriptor instead.
+func (*Data_Idempotency) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 7}
+}
+
+func (x *Data_Idempotency) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Data_Idempotency) GetCleanupInterval() *durationpb.Duration {
+	if x != nil {
+		return x.CleanupInterval
+	}
+	return nil
+}
+
+// Metadata stamped onto every published event, on top of the request ID,
+// API version and operation source
+type Data_EventEnrichment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Fixed metadata, e.g. environment or region
+	Static map[string]string `protobuf:"bytes,1,rep,name=static,proto3" json:"static,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Applied in order after static; later entries override earlier ones,
+	// empty values are left out
+	Providers     []*Data_EventEnrichment_Provider `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_EventEnrichment) Reset() {
+	*x = Data_EventEnrichment{}
+	mi := &file_conf_conf_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_EventEnrichment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_EventEnrichment) ProtoMessage() {}
+
+func (x *Data_EventEnrichment) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_EventEnrichment.ProtoReflect.Descriptor instead.
+func (*Data_EventEnrichment) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 8}
+}
+
+func (x *Data_EventEnrichment) GetStatic() map[string]string {
+	if x != nil {
+		return x.Static
+	}
+	return nil
+}
+
+func (x *Data_EventEnrichment) GetProviders() []*Data_EventEnrichment_Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// Shadow reads repeat employee reads against a second repository and
+// compare the results in the background, to gain confidence in a new
+// repository implementation or database before switching to it. Responses
+// always come from the primary repository. Disabled when repo is empty.
+type Data_ShadowRead struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Repository implementation reads are shadowed to: gorm
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Database of the shadow repository (default: database); it must see
+	// the writes of the primary database, e.g. as a replica
+	Database *Data_Database `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	// Fraction of reads shadowed, between 0 and 1 (default 1)
+	SampleRate float64 `protobuf:"fixed64,3,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	// Shadow reads running at once; further reads are not shadowed
+	// (default 16)
+	MaxConcurrency int32 `protobuf:"varint,4,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	// Upper bound of a shadow read (default 5s)
+	Timeout       *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_ShadowRead) Reset() {
+	*x = Data_ShadowRead{}
+	mi := &file_conf_conf_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_ShadowRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_ShadowRead) ProtoMessage() {}
+
+func (x *Data_ShadowRead) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_ShadowRead.ProtoReflect.Descriptor instead.
+func (*Data_ShadowRead) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 9}
+}
+
+func (x *Data_ShadowRead) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *Data_ShadowRead) GetDatabase() *Data_Database {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *Data_ShadowRead) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *Data_ShadowRead) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *Data_ShadowRead) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// Database statements and JetStream publishes get what is left of the
+// deadline of the request issuing them, bounded by their own timeout
+type Data_TimeoutBudget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Upper bound for a database statement (default: only the request
+	// deadline applies)
+	DatabaseTimeout *durationpb.Duration `protobuf:"bytes,1,opt,name=database_timeout,json=databaseTimeout,proto3" json:"database_timeout,omitempty"`
+	// Smallest remaining budget a call is started with (default 5ms); with
+	// less left the call fails right away with DEADLINE_EXHAUSTED
+	MinTimeout    *durationpb.Duration `protobuf:"bytes,2,opt,name=min_timeout,json=minTimeout,proto3" json:"min_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_TimeoutBudget) Reset() {
+	*x = Data_TimeoutBudget{}
+	mi := &file_conf_conf_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_TimeoutBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_TimeoutBudget) ProtoMessage() {}
+
+func (x *Data_TimeoutBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_TimeoutBudget.ProtoReflect.Descriptor instead.
+func (*Data_TimeoutBudget) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 10}
+}
+
+func (x *Data_TimeoutBudget) GetDatabaseTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.DatabaseTimeout
+	}
+	return nil
+}
+
+func (x *Data_TimeoutBudget) GetMinTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.MinTimeout
+	}
+	return nil
+}
+
+// Optional fields of the event payload
+type Data_EventPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Include employee postal addresses, which are personal data most
+	// consumers do not need. Events carrying them are marked with the
+	// address_included=true metadata.
+	IncludeAddress bool `protobuf:"varint,1,opt,name=include_address,json=includeAddress,proto3" json:"include_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Data_EventPayload) Reset() {
+	*x = Data_EventPayload{}
+	mi := &file_conf_conf_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_EventPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_EventPayload) ProtoMessage() {}
+
+func (x *Data_EventPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_EventPayload.ProtoReflect.Descriptor instead.
+func (*Data_EventPayload) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 11}
+}
+
+func (x *Data_EventPayload) GetIncludeAddress() bool {
+	if x != nil {
+		return x.IncludeAddress
+	}
+	return false
+}
+
+// Employee photos, stored in an S3-compatible bucket under
+// <tenant>/<employee>/<photo>; employees only keep the object key
+type Data_Photos struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Store *Data_ObjectStore      `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	// How long pre-signed photo URLs are valid (default 15m)
+	UrlTtl        *durationpb.Duration `protobuf:"bytes,2,opt,name=url_ttl,json=urlTtl,proto3" json:"url_ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Photos) Reset() {
+	*x = Data_Photos{}
+	mi := &file_conf_conf_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Photos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Photos) ProtoMessage() {}
+
+func (x *Data_Photos) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Photos.ProtoReflect.Descriptor instead.
+func (*Data_Photos) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 12}
+}
+
+func (x *Data_Photos) GetStore() *Data_ObjectStore {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+func (x *Data_Photos) GetUrlTtl() *durationpb.Duration {
+	if x != nil {
+		return x.UrlTtl
+	}
+	return nil
+}
+
+// Authentication failures published on security.v1.auth_failure
+type Data_AuthFailureEvents struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At most one event is published per tenant and client IP within this
+	// window (default 1m); the failures in between are counted in the next
+	// event's suppressed
+	Window        *durationpb.Duration `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_AuthFailureEvents) Reset() {
+	*x = Data_AuthFailureEvents{}
+	mi := &file_conf_conf_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_AuthFailureEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_AuthFailureEvents) ProtoMessage() {}
+
+func (x *Data_AuthFailureEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_AuthFailureEvents.ProtoReflect.Descriptor instead.
+func (*Data_AuthFailureEvents) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 13}
+}
+
+func (x *Data_AuthFailureEvents) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// Soft quota on the number of employees of a tenant. Tenants are not
+// rejected at the quota; crossing 80, 90 and 100% of it publishes an event
+// on tenant.v1.quota_threshold, and create responses carry quota headers
+// from 80% on.
+type Data_Quota struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Employees per tenant, counting those pending review (0: no quota)
+	MaxEmployees int64 `protobuf:"varint,1,opt,name=max_employees,json=maxEmployees,proto3" json:"max_employees,omitempty"`
+	// Tenant ID -> max_employees of the tenant, overriding the default
+	TenantMaxEmployees map[string]int64 `protobuf:"bytes,2,rep,name=tenant_max_employees,json=tenantMaxEmployees,proto3" json:"tenant_max_employees,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Data_Quota) Reset() {
+	*x = Data_Quota{}
+	mi := &file_conf_conf_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Quota) ProtoMessage() {}
+
+func (x *Data_Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Quota.ProtoReflect.Descriptor instead.
+func (*Data_Quota) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 14}
+}
+
+func (x *Data_Quota) GetMaxEmployees() int64 {
+	if x != nil {
+		return x.MaxEmployees
+	}
+	return 0
+}
+
+func (x *Data_Quota) GetTenantMaxEmployees() map[string]int64 {
+	if x != nil {
+		return x.TenantMaxEmployees
+	}
+	return nil
+}
+
+// Audit of employee reads. Unlike the audit entries of changes, which are
+// written in the transaction of the change, reads are queued in memory and
+// written in batches in the background, so that auditing does not slow
+// reads down. Under pressure the oldest queued reads are dropped.
+type Data_ReadAudit struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Fraction of reads audited, between 0 and 1 (default 1)
+	SampleRate float64 `protobuf:"fixed64,2,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	// Reads queued at most; beyond it the oldest are dropped (default 10000)
+	QueueSize int32 `protobuf:"varint,3,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	// Reads written per statement; a full batch is written right away
+	// (default 500)
+	BatchSize int32 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// How often queued reads are written (default 1s)
+	FlushInterval *durationpb.Duration `protobuf:"bytes,5,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_ReadAudit) Reset() {
+	*x = Data_ReadAudit{}
+	mi := &file_conf_conf_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_ReadAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_ReadAudit) ProtoMessage() {}
+
+func (x *Data_ReadAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_ReadAudit.ProtoReflect.Descriptor instead.
+func (*Data_ReadAudit) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 15}
+}
+
+func (x *Data_ReadAudit) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Data_ReadAudit) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *Data_ReadAudit) GetQueueSize() int32 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+func (x *Data_ReadAudit) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Data_ReadAudit) GetFlushInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FlushInterval
+	}
+	return nil
+}
+
+// Key used to encrypt the events of a tenant
+type Data_Nats_EncryptionKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Key ID sent in the Employee-Event-Key-Id header
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Tenant whose events are encrypted with this key; empty applies to
+	// tenants without a key of their own
+	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Base64 encoded 32-byte AES-256 key; entries with an empty key are
+	// ignored
+	Key           string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Nats_EncryptionKey) Reset() {
+	*x = Data_Nats_EncryptionKey{}
+	mi := &file_conf_conf_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Nats_EncryptionKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Nats_EncryptionKey) ProtoMessage() {}
+
+func (x *Data_Nats_EncryptionKey) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Nats_EncryptionKey.ProtoReflect.Descriptor instead.
+func (*Data_Nats_EncryptionKey) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 1, 0}
+}
+
+func (x *Data_Nats_EncryptionKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Data_Nats_EncryptionKey) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Data_Nats_EncryptionKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// Ed25519 key signing every event
+type Data_Nats_Signing struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Key ID sent in the Employee-Event-Signature-Key-Id header
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// Base64 encoded 32-byte Ed25519 seed; events are unsigned when empty
+	PrivateKey string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// Still published so that consumers can verify events signed before
+	// a rotation
+	RetiredKeys   []*Data_Nats_Signing_RetiredKey `protobuf:"bytes,3,rep,name=retired_keys,json=retiredKeys,proto3" json:"retired_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Nats_Signing) Reset() {
+	*x = Data_Nats_Signing{}
+	mi := &file_conf_conf_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Nats_Signing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Nats_Signing) ProtoMessage() {}
+
+func (x *Data_Nats_Signing) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Nats_Signing.ProtoReflect.Descriptor instead.
+func (*Data_Nats_Signing) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 1, 1}
+}
+
+func (x *Data_Nats_Signing) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *Data_Nats_Signing) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *Data_Nats_Signing) GetRetiredKeys() []*Data_Nats_Signing_RetiredKey {
+	if x != nil {
+		return x.RetiredKeys
+	}
+	return nil
+}
+
+// TLS material of a cluster. NATS engages TLS for the whole connection,
+// so once one cluster uses TLS every server must offer it; clusters only
+// differ in the CA and client certificate used.
+type Data_Nats_TLS struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PEM CA bundle verifying the servers (default: system roots)
+	CaFile string `protobuf:"bytes,1,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`
+	// PEM client certificate and key for mutual TLS
+	CertFile      string `protobuf:"bytes,2,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	KeyFile       string `protobuf:"bytes,3,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Nats_TLS) Reset() {
+	*x = Data_Nats_TLS{}
+	mi := &file_conf_conf_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Nats_TLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Nats_TLS) ProtoMessage() {}
+
+func (x *Data_Nats_TLS) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Nats_TLS.ProtoReflect.Descriptor instead.
+func (*Data_Nats_TLS) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 1, 2}
+}
+
+func (x *Data_Nats_TLS) GetCaFile() string {
+	if x != nil {
+		return x.CaFile
+	}
+	return ""
+}
+
+func (x *Data_Nats_TLS) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *Data_Nats_TLS) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+// NATS cluster the service can fail over to
+type Data_Nats_Cluster struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name used in logs and the nats_connected metric
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Servers of the cluster, tried in order
+	Urls          []string       `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	Tls           *Data_Nats_TLS `protobuf:"bytes,3,opt,name=tls,proto3" json:"tls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Nats_Cluster) Reset() {
+	*x = Data_Nats_Cluster{}
+	mi := &file_conf_conf_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Nats_Cluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Nats_Cluster) ProtoMessage() {}
+
+func (x *Data_Nats_Cluster) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Nats_Cluster.ProtoReflect.Descriptor instead.
+func (*Data_Nats_Cluster) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 1, 3}
+}
+
+func (x *Data_Nats_Cluster) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Data_Nats_Cluster) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *Data_Nats_Cluster) GetTls() *Data_Nats_TLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+// Public key of a signing key taken out of use
+type Data_Nats_Signing_RetiredKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	KeyId string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// Ed25519 public key as published in the key set (the JWK "x"
+	// member, unpadded base64url)
+	PublicKey     string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Nats_Signing_RetiredKey) Reset() {
+	*x = Data_Nats_Signing_RetiredKey{}
+	mi := &file_conf_conf_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Nats_Signing_RetiredKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Nats_Signing_RetiredKey) ProtoMessage() {}
+
+func (x *Data_Nats_Signing_RetiredKey) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Nats_Signing_RetiredKey.ProtoReflect.Descriptor instead.
+func (*Data_Nats_Signing_RetiredKey) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 1, 1, 0}
+}
+
+func (x *Data_Nats_Signing_RetiredKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *Data_Nats_Signing_RetiredKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+// Metadata read when an event is published
+type Data_EventEnrichment_Provider struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata key the value is stored under
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// One of env (the environment variable named by source), hostname, or
+	// file (the trimmed contents of the file at source, re-read every 30s)
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Source        string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_EventEnrichment_Provider) Reset() {
+	*x = Data_EventEnrichment_Provider{}
+	mi := &file_conf_conf_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_EventEnrichment_Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_EventEnrichment_Provider) ProtoMessage() {}
+
+func (x *Data_EventEnrichment_Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_EventEnrichment_Provider.ProtoReflect.Descriptor instead.
+func (*Data_EventEnrichment_Provider) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 8, 1}
+}
+
+func (x *Data_EventEnrichment_Provider) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Data_EventEnrichment_Provider) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Data_EventEnrichment_Provider) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// Verifies RS256/RS384/RS512 tokens against the keys of an identity
+// provider's JSON Web Key Set, matched by the token's "kid" header. HMAC
+// tokens are still verified with jwt_secret when it is set.
+type Auth_JWKS struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// URL of the key set, e.g. https://idp.example.com/.well-known/jwks.json;
+	// empty disables RSA tokens
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Keys are refetched once older than this (default 1h)
+	RefreshInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`
+	// A token signed with an unknown key ID refetches the keys at most this
+	// often (default 1m), so rotated keys are picked up early without letting
+	// forged key IDs hammer the provider
+	MinRefreshInterval *durationpb.Duration `protobuf:"bytes,3,opt,name=min_refresh_interval,json=minRefreshInterval,proto3" json:"min_refresh_interval,omitempty"`
+	// Timeout of a fetch (default 5s)
+	Timeout       *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_JWKS) Reset() {
+	*x = Auth_JWKS{}
+	mi := &file_conf_conf_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_JWKS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_JWKS) ProtoMessage() {}
+
+func (x *Auth_JWKS) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_JWKS.ProtoReflect.Descriptor instead.
+func (*Auth_JWKS) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *Auth_JWKS) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Auth_JWKS) GetRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return nil
+}
+
+func (x *Auth_JWKS) GetMinRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.MinRefreshInterval
+	}
+	return nil
+}
+
+func (x *Auth_JWKS) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// A trusted token issuer with its own keys
+type Auth_Issuer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The "iss" claim of the issuer's tokens
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Secret of the issuer's HMAC tokens
+	JwtSecret string `protobuf:"bytes,2,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`
+	// Key set of the issuer's RSA tokens
+	Jwks          *Auth_JWKS `protobuf:"bytes,3,opt,name=jwks,proto3" json:"jwks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Issuer) Reset() {
+	*x = Auth_Issuer{}
+	mi := &file_conf_conf_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Issuer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Issuer) ProtoMessage() {}
+
+func (x *Auth_Issuer) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Issuer.ProtoReflect.Descriptor instead.
+func (*Auth_Issuer) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 2}
+}
+
+func (x *Auth_Issuer) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *Auth_Issuer) GetJwtSecret() string {
+	if x != nil {
+		return x.JwtSecret
+	}
+	return ""
+}
+
+func (x *Auth_Issuer) GetJwks() *Auth_JWKS {
+	if x != nil {
+		return x.Jwks
+	}
+	return nil
+}
+
+// Signed requests of API keys, see X-Signature
+type Auth_RequestSigning struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How far the timestamp of a signed request may be from the server
+	// time (default 5m); nonces are remembered twice as long
+	MaxSkew       *durationpb.Duration `protobuf:"bytes,1,opt,name=max_skew,json=maxSkew,proto3" json:"max_skew,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_RequestSigning) Reset() {
+	*x = Auth_RequestSigning{}
+	mi := &file_conf_conf_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_RequestSigning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_RequestSigning) ProtoMessage() {}
+
+func (x *Auth_RequestSigning) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_RequestSigning.ProtoReflect.Descriptor instead.
+func (*Auth_RequestSigning) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 3}
+}
+
+func (x *Auth_RequestSigning) GetMaxSkew() *durationpb.Duration {
+	if x != nil {
+		return x.MaxSkew
+	}
+	return nil
+}
+
+// Bulk employee imports
+type Admin_Import struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rows created per transaction (default 500)
+	BatchSize int32 `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Largest accepted CSV, in data rows (default 100000)
+	MaxRows int32 `protobuf:"varint,2,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	// How often the worker looks for pending imports (default 2s)
+	PollInterval *durationpb.Duration `protobuf:"bytes,3,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// Bucket that source_url imports are read from; imports from
+	// object storage are rejected when unset
+	Store *Data_ObjectStore `protobuf:"bytes,4,opt,name=store,proto3" json:"store,omitempty"`
+	// Imports run concurrently by each replica (default 2)
+	Workers int32 `protobuf:"varint,5,opt,name=workers,proto3" json:"workers,omitempty"`
+	// Imports of one tenant running at the same time across all replicas
+	// (default 1)
+	MaxPerTenant int32 `protobuf:"varint,6,opt,name=max_per_tenant,json=maxPerTenant,proto3" json:"max_per_tenant,omitempty"`
+	// Batches an import runs per turn before yielding to the imports of
+	// other tenants waiting for a worker (default 10)
+	BatchesPerTurn int32 `protobuf:"varint,7,opt,name=batches_per_turn,json=batchesPerTurn,proto3" json:"batches_per_turn,omitempty"`
+	// Turn length multipliers by tenant ID, for weighted round-robin
+	// (default 1)
+	TenantWeights map[string]int32 `protobuf:"bytes,8,rep,name=tenant_weights,json=tenantWeights,proto3" json:"tenant_weights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Admin_Import) Reset() {
+	*x = Admin_Import{}
+	mi := &file_conf_conf_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Admin_Import) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Admin_Import) ProtoMessage() {}
+
+func (x *Admin_Import) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Admin_Import.ProtoReflect.Descriptor instead.
+func (*Admin_Import) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *Admin_Import) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Admin_Import) GetMaxRows() int32 {
+	if x != nil {
+		return x.MaxRows
+	}
+	return 0
+}
+
+func (x *Admin_Import) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Admin_Import) GetStore() *Data_ObjectStore {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+func (x *Admin_Import) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *Admin_Import) GetMaxPerTenant() int32 {
+	if x != nil {
+		return x.MaxPerTenant
+	}
+	return 0
+}
+
+func (x *Admin_Import) GetBatchesPerTurn() int32 {
+	if x != nil {
+		return x.BatchesPerTurn
+	}
+	return 0
+}
+
+func (x *Admin_Import) GetTenantWeights() map[string]int32 {
+	if x != nil {
+		return x.TenantWeights
+	}
+	return nil
+}
+
+// Review of new employees before they become visible
+type Admin_Review struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Creation channels whose employees are held for review: "api" for
+	// tokens without a channel claim, "import" for bulk imports, or the
+	// channel claim of the token, e.g. "public"
+	Channels      []string `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Admin_Review) Reset() {
+	*x = Admin_Review{}
+	mi := &file_conf_conf_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Admin_Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Admin_Review) ProtoMessage() {}
+
+func (x *Admin_Review) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Admin_Review.ProtoReflect.Descriptor instead.
+func (*Admin_Review) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *Admin_Review) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// Creates and updates scheduled with effective_at
+type Admin_Schedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How often the scheduler looks for due changes (default 10s)
+	PollInterval  *durationpb.Duration `protobuf:"bytes,1,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Admin_Schedule) Reset() {
+	*x = Admin_Schedule{}
+	mi := &file_conf_conf_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Admin_Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Admin_Schedule) ProtoMessage() {}
+
+func (x *Admin_Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Admin_Schedule.ProtoReflect.Descriptor instead.
+func (*Admin_Schedule) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 2}
+}
+
+func (x *Admin_Schedule) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+// Per-tenant API usage counters
+type Admin_Usage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How often the counters are added to the daily usage table (default 1m)
+	FlushInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Admin_Usage) Reset() {
+	*x = Admin_Usage{}
+	mi := &file_conf_conf_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Admin_Usage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Admin_Usage) ProtoMessage() {}
+
+func (x *Admin_Usage) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Admin_Usage.ProtoReflect.Descriptor instead.
+func (*Admin_Usage) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 3}
+}
+
+func (x *Admin_Usage) GetFlushInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FlushInterval
+	}
+	return nil
+}
+
+// Normalization of employee emails. Emails are always trimmed and
+// lowercased before they are stored, looked up or checked for uniqueness.
+type Admin_EmailNormalization struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Fold Gmail addresses: dots and +suffixes are dropped from the local
+	// part and googlemail.com becomes gmail.com
+	FoldGmail bool `protobuf:"varint,1,opt,name=fold_gmail,json=foldGmail,proto3" json:"fold_gmail,omitempty"`
+	// Tenant ID -> fold_gmail of the tenant, overriding the default
+	TenantFoldGmail map[string]bool `protobuf:"bytes,2,rep,name=tenant_fold_gmail,json=tenantFoldGmail,proto3" json:"tenant_fold_gmail,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Admin_EmailNormalization) Reset() {
+	*x = Admin_EmailNormalization{}
+	mi := &file_conf_conf_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Admin_EmailNormalization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Admin_EmailNormalization) ProtoMessage() {}
+
+func (x *Admin_EmailNormalization) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Admin_EmailNormalization.ProtoReflect.Descriptor instead.
+func (*Admin_EmailNormalization) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 4}
+}
+
+func (x *Admin_EmailNormalization) GetFoldGmail() bool {
+	if x != nil {
+		return x.FoldGmail
+	}
+	return false
+}
+
+func (x *Admin_EmailNormalization) GetTenantFoldGmail() map[string]bool {
+	if x != nil {
+		return x.TenantFoldGmail
+	}
+	return nil
+}
+
+// Rollout of the strict email validation, which rejects emails the API
+// accepted before: local parts over 64 characters, with leading, trailing
+// or consecutive dots, and domains without a dot or a top-level domain of
+// letters. In log mode violations are only counted and sampled to the
+// logs, so that the breakage can be measured before enforcing them.
+type Admin_EmailValidation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// off, log or enforce (default log); enforce rejects violating emails
+	// with INVALID_EMAIL
+	Strictness string `protobuf:"bytes,1,opt,name=strictness,proto3" json:"strictness,omitempty"`
+	// Tenant ID -> strictness of the tenant, overriding the default
+	TenantStrictness map[string]string `protobuf:"bytes,2,rep,name=tenant_strictness,json=tenantStrictness,proto3" json:"tenant_strictness,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Fraction of violations logged as examples, between 0 and 1 (default
+	// 0.1)
+	SampleRate    float64 `protobuf:"fixed64,3,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Observability) Reset() {
-	*x = Observability{}
-	mi := &file_conf_conf_proto_msgTypes[4]
+func (x *Admin_EmailValidation) Reset() {
+	*x = Admin_EmailValidation{}
+	mi := &file_conf_conf_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Observability) String() string {
+func (x *Admin_EmailValidation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Observability) ProtoMessage() {}
+func (*Admin_EmailValidation) ProtoMessage() {}
 
-func (x *Observability) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[4]
+func (x *Admin_EmailValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,56 +3815,60 @@ func (x *Observability) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Observability.ProtoReflect.Descriptor instead.
-func (*Observability) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Admin_EmailValidation.ProtoReflect.Descriptor instead.
+func (*Admin_EmailValidation) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 5}
 }
 
-func (x *Observability) GetMetrics() *Metrics {
+func (x *Admin_EmailValidation) GetStrictness() string {
 	if x != nil {
-		return x.Metrics
+		return x.Strictness
 	}
-	return nil
+	return ""
 }
 
-func (x *Observability) GetTracing() *Tracing {
+func (x *Admin_EmailValidation) GetTenantStrictness() map[string]string {
 	if x != nil {
-		return x.Tracing
+		return x.TenantStrictness
 	}
 	return nil
 }
 
-func (x *Observability) GetLogging() *Logging {
+func (x *Admin_EmailValidation) GetSampleRate() float64 {
 	if x != nil {
-		return x.Logging
+		return x.SampleRate
 	}
-	return nil
+	return 0
 }
 
-type Metrics struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Subsystem     string                 `protobuf:"bytes,3,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
+// Four-eyes approval of merges of employees with large histories. A merge
+// meeting any of the thresholds is held until a second user approves it;
+// a threshold of 0 is disabled.
+type Admin_MergeApproval struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Audit entries of both employees together
+	MinHistoryEntries int32 `protobuf:"varint,1,opt,name=min_history_entries,json=minHistoryEntries,proto3" json:"min_history_entries,omitempty"`
+	// Emails of both employees together
+	MinEmails     int32 `protobuf:"varint,2,opt,name=min_emails,json=minEmails,proto3" json:"min_emails,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Metrics) Reset() {
-	*x = Metrics{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+func (x *Admin_MergeApproval) Reset() {
+	*x = Admin_MergeApproval{}
+	mi := &file_conf_conf_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Metrics) String() string {
+func (x *Admin_MergeApproval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Metrics) ProtoMessage() {}
+func (*Admin_MergeApproval) ProtoMessage() {}
 
-func (x *Metrics) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+func (x *Admin_MergeApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,57 +3879,59 @@ func (x *Metrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
-func (*Metrics) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *Metrics) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
+// Deprecated: Use Admin_MergeApproval.ProtoReflect.Descriptor instead.
+func (*Admin_MergeApproval) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 6}
 }
 
-func (x *Metrics) GetNamespace() string {
+func (x *Admin_MergeApproval) GetMinHistoryEntries() int32 {
 	if x != nil {
-		return x.Namespace
+		return x.MinHistoryEntries
 	}
-	return ""
+	return 0
 }
 
-func (x *Metrics) GetSubsystem() string {
+func (x *Admin_MergeApproval) GetMinEmails() int32 {
 	if x != nil {
-		return x.Subsystem
+		return x.MinEmails
 	}
-	return ""
+	return 0
 }
 
-type Tracing struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Endpoint      string                 `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	SampleRate    float64                `protobuf:"fixed64,3,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
-	Insecure      bool                   `protobuf:"varint,4,opt,name=insecure,proto3" json:"insecure,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Flagging of stale employees for cleanup. Active, approved employees
+// meeting the signals are flagged stale until changed again; disabled
+// when untouched_for is unset.
+type Admin_Stale struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Employees not changed for this long are stale, e.g. 4380h (6 months)
+	UntouchedFor *durationpb.Duration `protobuf:"bytes,1,opt,name=untouched_for,json=untouchedFor,proto3" json:"untouched_for,omitempty"`
+	// How often the job looks for stale employees (default 1h)
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Employees flagged per batch (default 500)
+	BatchSize int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// ignore (default): undeliverable emails do not matter; require: only
+	// untouched employees having an undeliverable email are stale; accept:
+	// employees having an undeliverable email are stale too
+	UndeliverableEmails string `protobuf:"bytes,4,opt,name=undeliverable_emails,json=undeliverableEmails,proto3" json:"undeliverable_emails,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *Tracing) Reset() {
-	*x = Tracing{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+func (x *Admin_Stale) Reset() {
+	*x = Admin_Stale{}
+	mi := &file_conf_conf_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tracing) String() string {
+func (x *Admin_Stale) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tracing) ProtoMessage() {}
+func (*Admin_Stale) ProtoMessage() {}
 
-func (x *Tracing) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+func (x *Admin_Stale) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,64 +3942,64 @@ func (x *Tracing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tracing.ProtoReflect.Descriptor instead.
-func (*Tracing) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Admin_Stale.ProtoReflect.Descriptor instead.
+func (*Admin_Stale) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 7}
 }
 
-func (x *Tracing) GetEnabled() bool {
+func (x *Admin_Stale) GetUntouchedFor() *durationpb.Duration {
 	if x != nil {
-		return x.Enabled
+		return x.UntouchedFor
 	}
-	return false
+	return nil
 }
 
-func (x *Tracing) GetEndpoint() string {
+func (x *Admin_Stale) GetInterval() *durationpb.Duration {
 	if x != nil {
-		return x.Endpoint
+		return x.Interval
 	}
-	return ""
+	return nil
 }
 
-func (x *Tracing) GetSampleRate() float64 {
+func (x *Admin_Stale) GetBatchSize() int32 {
 	if x != nil {
-		return x.SampleRate
+		return x.BatchSize
 	}
 	return 0
 }
 
-func (x *Tracing) GetInsecure() bool {
+func (x *Admin_Stale) GetUndeliverableEmails() string {
 	if x != nil {
-		return x.Insecure
+		return x.UndeliverableEmails
 	}
-	return false
+	return ""
 }
 
-type Logging struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	LogRequests   bool                   `protobuf:"varint,2,opt,name=log_requests,json=logRequests,proto3" json:"log_requests,omitempty"`
-	LogResponses  bool                   `protobuf:"varint,3,opt,name=log_responses,json=logResponses,proto3" json:"log_responses,omitempty"`
-	Level         string                 `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"` // debug, info, warn, error
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ListInFlightRequests
+type Admin_InFlight struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Roles that may list the in-flight requests of all tenants; other
+	// callers only see those of their own tenant
+	AllTenantsRoles []string `protobuf:"bytes,1,rep,name=all_tenants_roles,json=allTenantsRoles,proto3" json:"all_tenants_roles,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Logging) Reset() {
-	*x = Logging{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+func (x *Admin_InFlight) Reset() {
+	*x = Admin_InFlight{}
+	mi := &file_conf_conf_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Logging) String() string {
+func (x *Admin_InFlight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Logging) ProtoMessage() {}
+func (*Admin_InFlight) ProtoMessage() {}
 
-func (x *Logging) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+func (x *Admin_InFlight) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,63 +4010,47 @@ func (x *Logging) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Logging.ProtoReflect.Descriptor instead.
-func (*Logging) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *Logging) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
-}
-
-func (x *Logging) GetLogRequests() bool {
-	if x != nil {
-		return x.LogRequests
-	}
-	return false
-}
-
-func (x *Logging) GetLogResponses() bool {
-	if x != nil {
-		return x.LogResponses
-	}
-	return false
+// Deprecated: Use Admin_InFlight.ProtoReflect.Descriptor instead.
+func (*Admin_InFlight) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 8}
 }
 
-func (x *Logging) GetLevel() string {
+func (x *Admin_InFlight) GetAllTenantsRoles() []string {
 	if x != nil {
-		return x.Level
+		return x.AllTenantsRoles
 	}
-	return ""
+	return nil
 }
 
-type Server_HTTP struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+// Copies of tenants into sandbox tenants with CloneTenant
+type Admin_TenantClone struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sandbox tenant ID -> ID of the tenant that may be cloned into it.
+	// Clones into other tenants are rejected.
+	Sandboxes map[string]string `protobuf:"bytes,1,rep,name=sandboxes,proto3" json:"sandboxes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Employees copied per batch (default 500)
+	BatchSize int32 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// How often the worker looks for pending clones (default 5s)
+	PollInterval  *durationpb.Duration `protobuf:"bytes,3,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server_HTTP) Reset() {
-	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+func (x *Admin_TenantClone) Reset() {
+	*x = Admin_TenantClone{}
+	mi := &file_conf_conf_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server_HTTP) String() string {
+func (x *Admin_TenantClone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server_HTTP) ProtoMessage() {}
+func (*Admin_TenantClone) ProtoMessage() {}
 
-func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+func (x *Admin_TenantClone) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,56 +4061,63 @@ func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
-func (*Server_HTTP) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use Admin_TenantClone.ProtoReflect.Descriptor instead.
+func (*Admin_TenantClone) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 9}
 }
 
-func (x *Server_HTTP) GetNetwork() string {
+func (x *Admin_TenantClone) GetSandboxes() map[string]string {
 	if x != nil {
-		return x.Network
+		return x.Sandboxes
 	}
-	return ""
+	return nil
 }
 
-func (x *Server_HTTP) GetAddr() string {
+func (x *Admin_TenantClone) GetBatchSize() int32 {
 	if x != nil {
-		return x.Addr
+		return x.BatchSize
 	}
-	return ""
+	return 0
 }
 
-func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+func (x *Admin_TenantClone) GetPollInterval() *durationpb.Duration {
 	if x != nil {
-		return x.Timeout
+		return x.PollInterval
 	}
 	return nil
 }
 
-type Server_GRPC struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+// Per-tenant limit of merges and unmerges running at the same time on
+// each replica. Merges lock the rows of both employees, so a burst of them
+// can exhaust the database locks; merges over the limit wait in a queue
+// and are rejected when it is full or their wait times out.
+type Admin_MergeLimits struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Merges of one tenant running at the same time; 0 disables the limit
+	MaxConcurrent int32 `protobuf:"varint,1,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+	// Merges of one tenant waiting for a slot (default 10)
+	MaxQueued int32 `protobuf:"varint,2,opt,name=max_queued,json=maxQueued,proto3" json:"max_queued,omitempty"`
+	// How long a merge waits for a slot (default 5s)
+	QueueTimeout  *durationpb.Duration `protobuf:"bytes,3,opt,name=queue_timeout,json=queueTimeout,proto3" json:"queue_timeout,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server_GRPC) Reset() {
-	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+func (x *Admin_MergeLimits) Reset() {
+	*x = Admin_MergeLimits{}
+	mi := &file_conf_conf_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server_GRPC) String() string {
+func (x *Admin_MergeLimits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server_GRPC) ProtoMessage() {}
+func (*Admin_MergeLimits) ProtoMessage() {}
 
-func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+func (x *Admin_MergeLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,55 +4128,59 @@ func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
-func (*Server_GRPC) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use Admin_MergeLimits.ProtoReflect.Descriptor instead.
+func (*Admin_MergeLimits) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 10}
 }
 
-func (x *Server_GRPC) GetNetwork() string {
+func (x *Admin_MergeLimits) GetMaxConcurrent() int32 {
 	if x != nil {
-		return x.Network
+		return x.MaxConcurrent
 	}
-	return ""
+	return 0
 }
 
-func (x *Server_GRPC) GetAddr() string {
+func (x *Admin_MergeLimits) GetMaxQueued() int32 {
 	if x != nil {
-		return x.Addr
+		return x.MaxQueued
 	}
-	return ""
+	return 0
 }
 
-func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
+func (x *Admin_MergeLimits) GetQueueTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.Timeout
+		return x.QueueTimeout
 	}
 	return nil
 }
 
-type Data_Database struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+// Runbook operations of the admin service: FlushCaches, ReconnectNATS,
+// RotateDatabasePool and ReloadSecrets
+type Admin_Runbook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Roles that may run them, e.g. operator; they act on the whole
+	// replica, so the admin role of a tenant is not enough. They are
+	// disabled when none are configured.
+	Roles         []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data_Database) Reset() {
-	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[10]
+func (x *Admin_Runbook) Reset() {
+	*x = Admin_Runbook{}
+	mi := &file_conf_conf_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Database) String() string {
+func (x *Admin_Runbook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Database) ProtoMessage() {}
+func (*Admin_Runbook) ProtoMessage() {}
 
-func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[10]
+func (x *Admin_Runbook) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,47 +4191,50 @@ func (x *Data_Database) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
-func (*Data_Database) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 0}
-}
-
-func (x *Data_Database) GetDriver() string {
-	if x != nil {
-		return x.Driver
-	}
-	return ""
+// Deprecated: Use Admin_Runbook.ProtoReflect.Descriptor instead.
+func (*Admin_Runbook) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 11}
 }
 
-func (x *Data_Database) GetSource() string {
+func (x *Admin_Runbook) GetRoles() []string {
 	if x != nil {
-		return x.Source
+		return x.Roles
 	}
-	return ""
+	return nil
 }
 
-type Data_Nats struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // subject field removed - using versioned subjects: employees.v1.{created,updated,deleted,merged}
+// Pushes the metrics to a Prometheus Pushgateway, for job workers that do
+// not live long enough to be scraped; disabled when url is empty
+type Metrics_Push struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pushgateway URL, e.g. http://pushgateway:9091
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Job label of the pushed metrics (default the service name)
+	Job string `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	// How often metrics are pushed while running (default 15s); they are
+	// pushed once more on shutdown
+	Interval *durationpb.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Extra grouping labels; instance defaults to the host name
+	Grouping      map[string]string `protobuf:"bytes,4,rep,name=grouping,proto3" json:"grouping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data_Nats) Reset() {
-	*x = Data_Nats{}
-	mi := &file_conf_conf_proto_msgTypes[11]
+func (x *Metrics_Push) Reset() {
+	*x = Metrics_Push{}
+	mi := &file_conf_conf_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Nats) String() string {
+func (x *Metrics_Push) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Nats) ProtoMessage() {}
+func (*Metrics_Push) ProtoMessage() {}
 
-func (x *Data_Nats) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[11]
+func (x *Metrics_Push) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,60 +4245,393 @@ func (x *Data_Nats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Nats.ProtoReflect.Descriptor instead.
-func (*Data_Nats) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use Metrics_Push.ProtoReflect.Descriptor instead.
+func (*Metrics_Push) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8, 0}
 }
 
-func (x *Data_Nats) GetUrl() string {
+func (x *Metrics_Push) GetUrl() string {
 	if x != nil {
 		return x.Url
 	}
 	return ""
 }
 
+func (x *Metrics_Push) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *Metrics_Push) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Metrics_Push) GetGrouping() map[string]string {
+	if x != nil {
+		return x.Grouping
+	}
+	return nil
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xe6\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xbe\x02\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12$\n" +
 	"\x04auth\x18\x03 \x01(\v2\x10.kratos.api.AuthR\x04auth\x12?\n" +
 	"\robservability\x18\x04 \x01(\v2\x19.kratos.api.ObservabilityR\robservability\x12 \n" +
-	"\venvironment\x18\x05 \x01(\tR\venvironment\"\xb8\x02\n" +
+	"\venvironment\x18\x05 \x01(\tR\venvironment\x12'\n" +
+	"\x05admin\x18\x06 \x01(\v2\x11.kratos.api.AdminR\x05admin\x12-\n" +
+	"\asecrets\x18\a \x01(\v2\x13.kratos.api.SecretsR\asecrets\"\xbc\x05\n" +
+	"\aSecrets\x12/\n" +
+	"\x05vault\x18\x01 \x01(\v2\x19.kratos.api.Secrets.VaultR\x05vault\x12D\n" +
+	"\x10refresh_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0frefreshInterval\x12C\n" +
+	"\x10jwt_secret_grace\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0ejwtSecretGrace\x1a3\n" +
+	"\vVaultSecret\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x1a\xbf\x03\n" +
+	"\x05Vault\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"token_file\x18\x04 \x01(\tR\ttokenFile\x12'\n" +
+	"\x0fkubernetes_role\x18\x05 \x01(\tR\x0ekubernetesRole\x12)\n" +
+	"\x10kubernetes_mount\x18\x06 \x01(\tR\x0fkubernetesMount\x122\n" +
+	"\x15kubernetes_token_file\x18\a \x01(\tR\x13kubernetesTokenFile\x123\n" +
+	"\atimeout\x18\b \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12>\n" +
+	"\n" +
+	"jwt_secret\x18\t \x01(\v2\x1f.kratos.api.Secrets.VaultSecretR\tjwtSecret\x12L\n" +
+	"\x11database_password\x18\n" +
+	" \x01(\v2\x1f.kratos.api.Secrets.VaultSecretR\x10databasePassword\"\x85\t\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x121\n" +
+	"\x06warmup\x18\x03 \x01(\v2\x19.kratos.api.Server.WarmupR\x06warmup\x12B\n" +
+	"\fdeprecations\x18\x04 \x03(\v2\x1e.kratos.api.Server.DeprecationR\fdeprecations\x12.\n" +
+	"\x13default_api_version\x18\x05 \x01(\tR\x11defaultApiVersion\x12'\n" +
+	"\x0ftrusted_proxies\x18\x06 \x03(\tR\x0etrustedProxies\x1a\xab\x01\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12@\n" +
+	"\x0eexport_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\rexportTimeout\x1a\xb3\x03\n" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xbe\x01\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12-\n" +
+	"\x03tls\x18\x04 \x01(\v2\x1b.kratos.api.Server.GRPC.TLSR\x03tls\x1a\x98\x02\n" +
+	"\x03TLS\x12\x1b\n" +
+	"\tcert_file\x18\x01 \x01(\tR\bcertFile\x12\x19\n" +
+	"\bkey_file\x18\x02 \x01(\tR\akeyFile\x12$\n" +
+	"\x0eclient_ca_file\x18\x03 \x01(\tR\fclientCaFile\x12\x1f\n" +
+	"\vclient_auth\x18\x04 \x01(\tR\n" +
+	"clientAuth\x12M\n" +
+	"\rcert_identity\x18\x05 \x01(\v2(.kratos.api.Server.GRPC.TLS.CertIdentityR\fcertIdentity\x1aC\n" +
+	"\fCertIdentity\x12\x1d\n" +
+	"\n" +
+	"uri_prefix\x18\x01 \x01(\tR\turiPrefix\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\x1a~\n" +
+	"\x06Warmup\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12%\n" +
+	"\x0edb_connections\x18\x03 \x01(\x05R\rdbConnections\x1am\n" +
+	"\vDeprecation\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x14\n" +
+	"\x05since\x18\x02 \x01(\tR\x05since\x12\x16\n" +
+	"\x06sunset\x18\x03 \x01(\tR\x06sunset\x12\x12\n" +
+	"\x04link\x18\x04 \x01(\tR\x04link\"\xaf'\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12)\n" +
-	"\x04nats\x18\x02 \x01(\v2\x15.kratos.api.Data.NatsR\x04nats\x1a:\n" +
+	"\x04nats\x18\x02 \x01(\v2\x15.kratos.api.Data.NatsR\x04nats\x12,\n" +
+	"\x05redis\x18\x03 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12B\n" +
+	"\raudit_archive\x18\x04 \x01(\v2\x1d.kratos.api.Data.AuditArchiveR\fauditArchive\x125\n" +
+	"\bwebhooks\x18\x05 \x01(\v2\x19.kratos.api.Data.WebhooksR\bwebhooks\x129\n" +
+	"\n" +
+	"event_sink\x18\x06 \x01(\v2\x1a.kratos.api.Data.EventSinkR\teventSink\x12>\n" +
+	"\vidempotency\x18\a \x01(\v2\x1c.kratos.api.Data.IdempotencyR\vidempotency\x12K\n" +
+	"\x10event_enrichment\x18\b \x01(\v2 .kratos.api.Data.EventEnrichmentR\x0feventEnrichment\x12<\n" +
+	"\vshadow_read\x18\t \x01(\v2\x1b.kratos.api.Data.ShadowReadR\n" +
+	"shadowRead\x12E\n" +
+	"\x0etimeout_budget\x18\n" +
+	" \x01(\v2\x1e.kratos.api.Data.TimeoutBudgetR\rtimeoutBudget\x12B\n" +
+	"\revent_payload\x18\v \x01(\v2\x1d.kratos.api.Data.EventPayloadR\feventPayload\x12R\n" +
+	"\x13auth_failure_events\x18\f \x01(\v2\".kratos.api.Data.AuthFailureEventsR\x11authFailureEvents\x12/\n" +
+	"\x06photos\x18\r \x01(\v2\x17.kratos.api.Data.PhotosR\x06photos\x12,\n" +
+	"\x05quota\x18\x0e \x01(\v2\x16.kratos.api.Data.QuotaR\x05quota\x129\n" +
+	"\n" +
+	"read_audit\x18\x0f \x01(\v2\x1a.kratos.api.Data.ReadAuditR\treadAudit\x1a\xd1\x02\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
-	"\x06source\x18\x02 \x01(\tR\x06source\x1a\x18\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1f\n" +
+	"\vsource_file\x18\x03 \x01(\tR\n" +
+	"sourceFile\x12#\n" +
+	"\rpassword_file\x18\x04 \x01(\tR\fpasswordFile\x12$\n" +
+	"\x0emax_open_conns\x18\x05 \x01(\x05R\fmaxOpenConns\x12$\n" +
+	"\x0emax_idle_conns\x18\x06 \x01(\x05R\fmaxIdleConns\x12E\n" +
+	"\x11conn_max_lifetime\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0fconnMaxLifetime\x12<\n" +
+	"\faudit_settle\x18\b \x01(\v2\x19.google.protobuf.DurationR\vauditSettle\x1a\xe9\t\n" +
 	"\x04Nats\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"%\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1c\n" +
+	"\tjetstream\x18\x02 \x01(\bR\tjetstream\x12\x16\n" +
+	"\x06stream\x18\x03 \x01(\tR\x06stream\x12B\n" +
+	"\x0fpublish_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0epublishTimeout\x12D\n" +
+	"\x10duplicate_window\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0fduplicateWindow\x12\x1f\n" +
+	"\vslim_events\x18\x06 \x01(\bR\n" +
+	"slimEvents\x12&\n" +
+	"\x0fmax_event_bytes\x18\a \x01(\x03R\rmaxEventBytes\x12L\n" +
+	"\x0fencryption_keys\x18\b \x03(\v2#.kratos.api.Data.Nats.EncryptionKeyR\x0eencryptionKeys\x127\n" +
+	"\asigning\x18\t \x01(\v2\x1d.kratos.api.Data.Nats.SigningR\asigning\x129\n" +
+	"\bclusters\x18\n" +
+	" \x03(\v2\x1d.kratos.api.Data.Nats.ClusterR\bclusters\x12+\n" +
+	"\x11preferred_cluster\x18\v \x01(\tR\x10preferredCluster\x12@\n" +
+	"\x0ereconnect_wait\x18\f \x01(\v2\x19.google.protobuf.DurationR\rreconnectWait\x12D\n" +
+	"\x10reconnect_jitter\x18\r \x01(\v2\x19.google.protobuf.DurationR\x0freconnectJitter\x12K\n" +
+	"\x14reconnect_jitter_tls\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\x12reconnectJitterTls\x12%\n" +
+	"\x0emax_reconnects\x18\x0f \x01(\x05R\rmaxReconnects\x1aN\n" +
+	"\rEncryptionKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x1a\xd2\x01\n" +
+	"\aSigning\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x1f\n" +
+	"\vprivate_key\x18\x02 \x01(\tR\n" +
+	"privateKey\x12K\n" +
+	"\fretired_keys\x18\x03 \x03(\v2(.kratos.api.Data.Nats.Signing.RetiredKeyR\vretiredKeys\x1aB\n" +
+	"\n" +
+	"RetiredKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x1aV\n" +
+	"\x03TLS\x12\x17\n" +
+	"\aca_file\x18\x01 \x01(\tR\x06caFile\x12\x1b\n" +
+	"\tcert_file\x18\x02 \x01(\tR\bcertFile\x12\x19\n" +
+	"\bkey_file\x18\x03 \x01(\tR\akeyFile\x1a^\n" +
+	"\aCluster\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04urls\x18\x02 \x03(\tR\x04urls\x12+\n" +
+	"\x03tls\x18\x03 \x01(\v2\x19.kratos.api.Data.Nats.TLSR\x03tls\x1at\n" +
+	"\x05Redis\x12\x12\n" +
+	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x0e\n" +
+	"\x02db\x18\x03 \x01(\x05R\x02db\x12+\n" +
+	"\x03ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x1a\xc8\x01\n" +
+	"\vObjectStore\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06bucket\x18\x02 \x01(\tR\x06bucket\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x1d\n" +
+	"\n" +
+	"access_key\x18\x04 \x01(\tR\taccessKey\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x05 \x01(\tR\tsecretKey\x12\x16\n" +
+	"\x06region\x18\x06 \x01(\tR\x06region\x12\x17\n" +
+	"\ause_ssl\x18\a \x01(\bR\x06useSsl\x1a\xd9\x01\n" +
+	"\fAuditArchive\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12%\n" +
+	"\x0eretention_days\x18\x02 \x01(\x05R\rretentionDays\x125\n" +
+	"\binterval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x122\n" +
+	"\x05store\x18\x05 \x01(\v2\x1c.kratos.api.Data.ObjectStoreR\x05store\x1a\xa9\x01\n" +
+	"\tEventSink\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\tR\x04file\x12$\n" +
+	"\x0emax_file_bytes\x18\x02 \x01(\x03R\fmaxFileBytes\x12\x1b\n" +
+	"\tmax_files\x18\x03 \x01(\x05R\bmaxFiles\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x123\n" +
+	"\atimeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\xe7\x02\n" +
+	"\bWebhooks\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12>\n" +
+	"\rpoll_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12!\n" +
+	"\fmax_attempts\x18\x04 \x01(\x05R\vmaxAttempts\x12B\n" +
+	"\x0finitial_backoff\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0einitialBackoff\x12:\n" +
+	"\vmax_backoff\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\x12)\n" +
+	"\x10allowed_networks\x18\a \x03(\tR\x0fallowedNetworks\x1a\x80\x01\n" +
+	"\vIdempotency\x12+\n" +
+	"\x03ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12D\n" +
+	"\x10cleanup_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0fcleanupInterval\x1a\xa5\x02\n" +
+	"\x0fEventEnrichment\x12D\n" +
+	"\x06static\x18\x01 \x03(\v2,.kratos.api.Data.EventEnrichment.StaticEntryR\x06static\x12G\n" +
+	"\tproviders\x18\x02 \x03(\v2).kratos.api.Data.EventEnrichment.ProviderR\tproviders\x1a9\n" +
+	"\vStaticEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aH\n" +
+	"\bProvider\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x1a\xd6\x01\n" +
+	"\n" +
+	"ShadowRead\x12\x12\n" +
+	"\x04repo\x18\x01 \x01(\tR\x04repo\x125\n" +
+	"\bdatabase\x18\x02 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12\x1f\n" +
+	"\vsample_rate\x18\x03 \x01(\x01R\n" +
+	"sampleRate\x12'\n" +
+	"\x0fmax_concurrency\x18\x04 \x01(\x05R\x0emaxConcurrency\x123\n" +
+	"\atimeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\x91\x01\n" +
+	"\rTimeoutBudget\x12D\n" +
+	"\x10database_timeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x0fdatabaseTimeout\x12:\n" +
+	"\vmin_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"minTimeout\x1a7\n" +
+	"\fEventPayload\x12'\n" +
+	"\x0finclude_address\x18\x01 \x01(\bR\x0eincludeAddress\x1ap\n" +
+	"\x06Photos\x122\n" +
+	"\x05store\x18\x01 \x01(\v2\x1c.kratos.api.Data.ObjectStoreR\x05store\x122\n" +
+	"\aurl_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06urlTtl\x1aF\n" +
+	"\x11AuthFailureEvents\x121\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06window\x1a\xd5\x01\n" +
+	"\x05Quota\x12#\n" +
+	"\rmax_employees\x18\x01 \x01(\x03R\fmaxEmployees\x12`\n" +
+	"\x14tenant_max_employees\x18\x02 \x03(\v2..kratos.api.Data.Quota.TenantMaxEmployeesEntryR\x12tenantMaxEmployees\x1aE\n" +
+	"\x17TenantMaxEmployeesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a\xc6\x01\n" +
+	"\tReadAudit\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vsample_rate\x18\x02 \x01(\x01R\n" +
+	"sampleRate\x12\x1d\n" +
+	"\n" +
+	"queue_size\x18\x03 \x01(\x05R\tqueueSize\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x12@\n" +
+	"\x0eflush_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\rflushInterval\"\x87\a\n" +
 	"\x04Auth\x12\x1d\n" +
 	"\n" +
-	"jwt_secret\x18\x01 \x01(\tR\tjwtSecret\"\x9c\x01\n" +
+	"jwt_secret\x18\x01 \x01(\tR\tjwtSecret\x121\n" +
+	"\x05roles\x18\x02 \x03(\v2\x1b.kratos.api.Auth.RolesEntryR\x05roles\x12)\n" +
+	"\x04jwks\x18\x03 \x01(\v2\x15.kratos.api.Auth.JWKSR\x04jwks\x121\n" +
+	"\aissuers\x18\x04 \x03(\v2\x17.kratos.api.Auth.IssuerR\aissuers\x12\x1c\n" +
+	"\taudiences\x18\x05 \x03(\tR\taudiences\x12+\n" +
+	"\x11exempt_operations\x18\x06 \x03(\tR\x10exemptOperations\x12/\n" +
+	"\x13impersonation_roles\x18\a \x03(\tR\x12impersonationRoles\x12H\n" +
+	"\x0frequest_signing\x18\b \x01(\v2\x1f.kratos.api.Auth.RequestSigningR\x0erequestSigning\x12&\n" +
+	"\x0fjwt_secret_file\x18\t \x01(\tR\rjwtSecretFile\x1aJ\n" +
+	"\n" +
+	"RolesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12&\n" +
+	"\x05value\x18\x02 \x01(\v2\x10.kratos.api.RoleR\x05value:\x028\x01\x1a\xe0\x01\n" +
+	"\x04JWKS\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12D\n" +
+	"\x10refresh_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0frefreshInterval\x12K\n" +
+	"\x14min_refresh_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x12minRefreshInterval\x123\n" +
+	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1aj\n" +
+	"\x06Issuer\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x1d\n" +
+	"\n" +
+	"jwt_secret\x18\x02 \x01(\tR\tjwtSecret\x12)\n" +
+	"\x04jwks\x18\x03 \x01(\v2\x15.kratos.api.Auth.JWKSR\x04jwks\x1aF\n" +
+	"\x0eRequestSigning\x124\n" +
+	"\bmax_skew\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\amaxSkew\"&\n" +
+	"\x04Role\x12\x1e\n" +
+	"\n" +
+	"operations\x18\x01 \x03(\tR\n" +
+	"operations\"\x98\x15\n" +
+	"\x05Admin\x12D\n" +
+	"\x10confirmation_ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x0fconfirmationTtl\x120\n" +
+	"\x06import\x18\x02 \x01(\v2\x18.kratos.api.Admin.ImportR\x06import\x120\n" +
+	"\x06review\x18\x03 \x01(\v2\x18.kratos.api.Admin.ReviewR\x06review\x126\n" +
+	"\bschedule\x18\x04 \x01(\v2\x1a.kratos.api.Admin.ScheduleR\bschedule\x12-\n" +
+	"\x05usage\x18\x05 \x01(\v2\x17.kratos.api.Admin.UsageR\x05usage\x12U\n" +
+	"\x13email_normalization\x18\x06 \x01(\v2$.kratos.api.Admin.EmailNormalizationR\x12emailNormalization\x127\n" +
+	"\tin_flight\x18\a \x01(\v2\x1a.kratos.api.Admin.InFlightR\binFlight\x12F\n" +
+	"\x0emerge_approval\x18\b \x01(\v2\x1f.kratos.api.Admin.MergeApprovalR\rmergeApproval\x12-\n" +
+	"\x05stale\x18\t \x01(\v2\x17.kratos.api.Admin.StaleR\x05stale\x12@\n" +
+	"\ftenant_clone\x18\n" +
+	" \x01(\v2\x1d.kratos.api.Admin.TenantCloneR\vtenantClone\x12@\n" +
+	"\fmerge_limits\x18\v \x01(\v2\x1d.kratos.api.Admin.MergeLimitsR\vmergeLimits\x12L\n" +
+	"\x10email_validation\x18\f \x01(\v2!.kratos.api.Admin.EmailValidationR\x0femailValidation\x123\n" +
+	"\arunbook\x18\r \x01(\v2\x19.kratos.api.Admin.RunbookR\arunbook\x1a\xb6\x03\n" +
+	"\x06Import\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x01 \x01(\x05R\tbatchSize\x12\x19\n" +
+	"\bmax_rows\x18\x02 \x01(\x05R\amaxRows\x12>\n" +
+	"\rpoll_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x122\n" +
+	"\x05store\x18\x04 \x01(\v2\x1c.kratos.api.Data.ObjectStoreR\x05store\x12\x18\n" +
+	"\aworkers\x18\x05 \x01(\x05R\aworkers\x12$\n" +
+	"\x0emax_per_tenant\x18\x06 \x01(\x05R\fmaxPerTenant\x12(\n" +
+	"\x10batches_per_turn\x18\a \x01(\x05R\x0ebatchesPerTurn\x12R\n" +
+	"\x0etenant_weights\x18\b \x03(\v2+.kratos.api.Admin.Import.TenantWeightsEntryR\rtenantWeights\x1a@\n" +
+	"\x12TenantWeightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a$\n" +
+	"\x06Review\x12\x1a\n" +
+	"\bchannels\x18\x01 \x03(\tR\bchannels\x1aJ\n" +
+	"\bSchedule\x12>\n" +
+	"\rpoll_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x1aI\n" +
+	"\x05Usage\x12@\n" +
+	"\x0eflush_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\rflushInterval\x1a\xde\x01\n" +
+	"\x12EmailNormalization\x12\x1d\n" +
+	"\n" +
+	"fold_gmail\x18\x01 \x01(\bR\tfoldGmail\x12e\n" +
+	"\x11tenant_fold_gmail\x18\x02 \x03(\v29.kratos.api.Admin.EmailNormalization.TenantFoldGmailEntryR\x0ftenantFoldGmail\x1aB\n" +
+	"\x14TenantFoldGmailEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\x1a\xfd\x01\n" +
+	"\x0fEmailValidation\x12\x1e\n" +
+	"\n" +
+	"strictness\x18\x01 \x01(\tR\n" +
+	"strictness\x12d\n" +
+	"\x11tenant_strictness\x18\x02 \x03(\v27.kratos.api.Admin.EmailValidation.TenantStrictnessEntryR\x10tenantStrictness\x12\x1f\n" +
+	"\vsample_rate\x18\x03 \x01(\x01R\n" +
+	"sampleRate\x1aC\n" +
+	"\x15TenantStrictnessEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a^\n" +
+	"\rMergeApproval\x12.\n" +
+	"\x13min_history_entries\x18\x01 \x01(\x05R\x11minHistoryEntries\x12\x1d\n" +
+	"\n" +
+	"min_emails\x18\x02 \x01(\x05R\tminEmails\x1a\xd0\x01\n" +
+	"\x05Stale\x12>\n" +
+	"\runtouched_for\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\funtouchedFor\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x121\n" +
+	"\x14undeliverable_emails\x18\x04 \x01(\tR\x13undeliverableEmails\x1a6\n" +
+	"\bInFlight\x12*\n" +
+	"\x11all_tenants_roles\x18\x01 \x03(\tR\x0fallTenantsRoles\x1a\xf6\x01\n" +
+	"\vTenantClone\x12J\n" +
+	"\tsandboxes\x18\x01 \x03(\v2,.kratos.api.Admin.TenantClone.SandboxesEntryR\tsandboxes\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x12>\n" +
+	"\rpoll_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x1a<\n" +
+	"\x0eSandboxesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\x93\x01\n" +
+	"\vMergeLimits\x12%\n" +
+	"\x0emax_concurrent\x18\x01 \x01(\x05R\rmaxConcurrent\x12\x1d\n" +
+	"\n" +
+	"max_queued\x18\x02 \x01(\x05R\tmaxQueued\x12>\n" +
+	"\rqueue_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\fqueueTimeout\x1a\x1f\n" +
+	"\aRunbook\x12\x14\n" +
+	"\x05roles\x18\x01 \x03(\tR\x05roles\"\x9c\x01\n" +
 	"\rObservability\x12-\n" +
 	"\ametrics\x18\x01 \x01(\v2\x13.kratos.api.MetricsR\ametrics\x12-\n" +
 	"\atracing\x18\x02 \x01(\v2\x13.kratos.api.TracingR\atracing\x12-\n" +
-	"\alogging\x18\x03 \x01(\v2\x13.kratos.api.LoggingR\alogging\"_\n" +
+	"\alogging\x18\x03 \x01(\v2\x13.kratos.api.LoggingR\alogging\"\xf1\x03\n" +
 	"\aMetrics\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1c\n" +
-	"\tsubsystem\x18\x03 \x01(\tR\tsubsystem\"|\n" +
+	"\tsubsystem\x18\x03 \x01(\tR\tsubsystem\x12,\n" +
+	"\x04push\x18\x04 \x01(\v2\x18.kratos.api.Metrics.PushR\x04push\x12*\n" +
+	"\x11max_tenant_labels\x18\x05 \x01(\x05R\x0fmaxTenantLabels\x12Q\n" +
+	"\x17employee_count_interval\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x15employeeCountInterval\x1a\xe2\x01\n" +
+	"\x04Push\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x10\n" +
+	"\x03job\x18\x02 \x01(\tR\x03job\x125\n" +
+	"\binterval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12B\n" +
+	"\bgrouping\x18\x04 \x03(\v2&.kratos.api.Metrics.Push.GroupingEntryR\bgrouping\x1a;\n" +
+	"\rGroupingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"|\n" +
 	"\aTracing\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
 	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x1f\n" +
@@ -784,41 +4656,193 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*Auth)(nil),                // 3: kratos.api.Auth
-	(*Observability)(nil),       // 4: kratos.api.Observability
-	(*Metrics)(nil),             // 5: kratos.api.Metrics
-	(*Tracing)(nil),             // 6: kratos.api.Tracing
-	(*Logging)(nil),             // 7: kratos.api.Logging
-	(*Server_HTTP)(nil),         // 8: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 9: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 10: kratos.api.Data.Database
-	(*Data_Nats)(nil),           // 11: kratos.api.Data.Nats
-	(*durationpb.Duration)(nil), // 12: google.protobuf.Duration
+	(*Bootstrap)(nil),                     // 0: kratos.api.Bootstrap
+	(*Secrets)(nil),                       // 1: kratos.api.Secrets
+	(*Server)(nil),                        // 2: kratos.api.Server
+	(*Data)(nil),                          // 3: kratos.api.Data
+	(*Auth)(nil),                          // 4: kratos.api.Auth
+	(*Role)(nil),                          // 5: kratos.api.Role
+	(*Admin)(nil),                         // 6: kratos.api.Admin
+	(*Observability)(nil),                 // 7: kratos.api.Observability
+	(*Metrics)(nil),                       // 8: kratos.api.Metrics
+	(*Tracing)(nil),                       // 9: kratos.api.Tracing
+	(*Logging)(nil),                       // 10: kratos.api.Logging
+	(*Secrets_VaultSecret)(nil),           // 11: kratos.api.Secrets.VaultSecret
+	(*Secrets_Vault)(nil),                 // 12: kratos.api.Secrets.Vault
+	(*Server_HTTP)(nil),                   // 13: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),                   // 14: kratos.api.Server.GRPC
+	(*Server_Warmup)(nil),                 // 15: kratos.api.Server.Warmup
+	(*Server_Deprecation)(nil),            // 16: kratos.api.Server.Deprecation
+	(*Server_GRPC_TLS)(nil),               // 17: kratos.api.Server.GRPC.TLS
+	(*Server_GRPC_TLS_CertIdentity)(nil),  // 18: kratos.api.Server.GRPC.TLS.CertIdentity
+	(*Data_Database)(nil),                 // 19: kratos.api.Data.Database
+	(*Data_Nats)(nil),                     // 20: kratos.api.Data.Nats
+	(*Data_Redis)(nil),                    // 21: kratos.api.Data.Redis
+	(*Data_ObjectStore)(nil),              // 22: kratos.api.Data.ObjectStore
+	(*Data_AuditArchive)(nil),             // 23: kratos.api.Data.AuditArchive
+	(*Data_EventSink)(nil),                // 24: kratos.api.Data.EventSink
+	(*Data_Webhooks)(nil),                 // 25: kratos.api.Data.Webhooks
+	(*Data_Idempotency)(nil),              // 26: kratos.api.Data.Idempotency
+	(*Data_EventEnrichment)(nil),          // 27: kratos.api.Data.EventEnrichment
+	(*Data_ShadowRead)(nil),               // 28: kratos.api.Data.ShadowRead
+	(*Data_TimeoutBudget)(nil),            // 29: kratos.api.Data.TimeoutBudget
+	(*Data_EventPayload)(nil),             // 30: kratos.api.Data.EventPayload
+	(*Data_Photos)(nil),                   // 31: kratos.api.Data.Photos
+	(*Data_AuthFailureEvents)(nil),        // 32: kratos.api.Data.AuthFailureEvents
+	(*Data_Quota)(nil),                    // 33: kratos.api.Data.Quota
+	(*Data_ReadAudit)(nil),                // 34: kratos.api.Data.ReadAudit
+	(*Data_Nats_EncryptionKey)(nil),       // 35: kratos.api.Data.Nats.EncryptionKey
+	(*Data_Nats_Signing)(nil),             // 36: kratos.api.Data.Nats.Signing
+	(*Data_Nats_TLS)(nil),                 // 37: kratos.api.Data.Nats.TLS
+	(*Data_Nats_Cluster)(nil),             // 38: kratos.api.Data.Nats.Cluster
+	(*Data_Nats_Signing_RetiredKey)(nil),  // 39: kratos.api.Data.Nats.Signing.RetiredKey
+	nil,                                   // 40: kratos.api.Data.EventEnrichment.StaticEntry
+	(*Data_EventEnrichment_Provider)(nil), // 41: kratos.api.Data.EventEnrichment.Provider
+	nil,                                   // 42: kratos.api.Data.Quota.TenantMaxEmployeesEntry
+	nil,                                   // 43: kratos.api.Auth.RolesEntry
+	(*Auth_JWKS)(nil),                     // 44: kratos.api.Auth.JWKS
+	(*Auth_Issuer)(nil),                   // 45: kratos.api.Auth.Issuer
+	(*Auth_RequestSigning)(nil),           // 46: kratos.api.Auth.RequestSigning
+	(*Admin_Import)(nil),                  // 47: kratos.api.Admin.Import
+	(*Admin_Review)(nil),                  // 48: kratos.api.Admin.Review
+	(*Admin_Schedule)(nil),                // 49: kratos.api.Admin.Schedule
+	(*Admin_Usage)(nil),                   // 50: kratos.api.Admin.Usage
+	(*Admin_EmailNormalization)(nil),      // 51: kratos.api.Admin.EmailNormalization
+	(*Admin_EmailValidation)(nil),         // 52: kratos.api.Admin.EmailValidation
+	(*Admin_MergeApproval)(nil),           // 53: kratos.api.Admin.MergeApproval
+	(*Admin_Stale)(nil),                   // 54: kratos.api.Admin.Stale
+	(*Admin_InFlight)(nil),                // 55: kratos.api.Admin.InFlight
+	(*Admin_TenantClone)(nil),             // 56: kratos.api.Admin.TenantClone
+	(*Admin_MergeLimits)(nil),             // 57: kratos.api.Admin.MergeLimits
+	(*Admin_Runbook)(nil),                 // 58: kratos.api.Admin.Runbook
+	nil,                                   // 59: kratos.api.Admin.Import.TenantWeightsEntry
+	nil,                                   // 60: kratos.api.Admin.EmailNormalization.TenantFoldGmailEntry
+	nil,                                   // 61: kratos.api.Admin.EmailValidation.TenantStrictnessEntry
+	nil,                                   // 62: kratos.api.Admin.TenantClone.SandboxesEntry
+	(*Metrics_Push)(nil),                  // 63: kratos.api.Metrics.Push
+	nil,                                   // 64: kratos.api.Metrics.Push.GroupingEntry
+	(*durationpb.Duration)(nil),           // 65: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
-	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
-	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	3,  // 2: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
-	4,  // 3: kratos.api.Bootstrap.observability:type_name -> kratos.api.Observability
-	8,  // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	9,  // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	10, // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	11, // 7: kratos.api.Data.nats:type_name -> kratos.api.Data.Nats
-	5,  // 8: kratos.api.Observability.metrics:type_name -> kratos.api.Metrics
-	6,  // 9: kratos.api.Observability.tracing:type_name -> kratos.api.Tracing
-	7,  // 10: kratos.api.Observability.logging:type_name -> kratos.api.Logging
-	12, // 11: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	12, // 12: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	2,   // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
+	3,   // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
+	4,   // 2: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
+	7,   // 3: kratos.api.Bootstrap.observability:type_name -> kratos.api.Observability
+	6,   // 4: kratos.api.Bootstrap.admin:type_name -> kratos.api.Admin
+	1,   // 5: kratos.api.Bootstrap.secrets:type_name -> kratos.api.Secrets
+	12,  // 6: kratos.api.Secrets.vault:type_name -> kratos.api.Secrets.Vault
+	65,  // 7: kratos.api.Secrets.refresh_interval:type_name -> google.protobuf.Duration
+	65,  // 8: kratos.api.Secrets.jwt_secret_grace:type_name -> google.protobuf.Duration
+	13,  // 9: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	14,  // 10: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	15,  // 11: kratos.api.Server.warmup:type_name -> kratos.api.Server.Warmup
+	16,  // 12: kratos.api.Server.deprecations:type_name -> kratos.api.Server.Deprecation
+	19,  // 13: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	20,  // 14: kratos.api.Data.nats:type_name -> kratos.api.Data.Nats
+	21,  // 15: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	23,  // 16: kratos.api.Data.audit_archive:type_name -> kratos.api.Data.AuditArchive
+	25,  // 17: kratos.api.Data.webhooks:type_name -> kratos.api.Data.Webhooks
+	24,  // 18: kratos.api.Data.event_sink:type_name -> kratos.api.Data.EventSink
+	26,  // 19: kratos.api.Data.idempotency:type_name -> kratos.api.Data.Idempotency
+	27,  // 20: kratos.api.Data.event_enrichment:type_name -> kratos.api.Data.EventEnrichment
+	28,  // 21: kratos.api.Data.shadow_read:type_name -> kratos.api.Data.ShadowRead
+	29,  // 22: kratos.api.Data.timeout_budget:type_name -> kratos.api.Data.TimeoutBudget
+	30,  // 23: kratos.api.Data.event_payload:type_name -> kratos.api.Data.EventPayload
+	32,  // 24: kratos.api.Data.auth_failure_events:type_name -> kratos.api.Data.AuthFailureEvents
+	31,  // 25: kratos.api.Data.photos:type_name -> kratos.api.Data.Photos
+	33,  // 26: kratos.api.Data.quota:type_name -> kratos.api.Data.Quota
+	34,  // 27: kratos.api.Data.read_audit:type_name -> kratos.api.Data.ReadAudit
+	43,  // 28: kratos.api.Auth.roles:type_name -> kratos.api.Auth.RolesEntry
+	44,  // 29: kratos.api.Auth.jwks:type_name -> kratos.api.Auth.JWKS
+	45,  // 30: kratos.api.Auth.issuers:type_name -> kratos.api.Auth.Issuer
+	46,  // 31: kratos.api.Auth.request_signing:type_name -> kratos.api.Auth.RequestSigning
+	65,  // 32: kratos.api.Admin.confirmation_ttl:type_name -> google.protobuf.Duration
+	47,  // 33: kratos.api.Admin.import:type_name -> kratos.api.Admin.Import
+	48,  // 34: kratos.api.Admin.review:type_name -> kratos.api.Admin.Review
+	49,  // 35: kratos.api.Admin.schedule:type_name -> kratos.api.Admin.Schedule
+	50,  // 36: kratos.api.Admin.usage:type_name -> kratos.api.Admin.Usage
+	51,  // 37: kratos.api.Admin.email_normalization:type_name -> kratos.api.Admin.EmailNormalization
+	55,  // 38: kratos.api.Admin.in_flight:type_name -> kratos.api.Admin.InFlight
+	53,  // 39: kratos.api.Admin.merge_approval:type_name -> kratos.api.Admin.MergeApproval
+	54,  // 40: kratos.api.Admin.stale:type_name -> kratos.api.Admin.Stale
+	56,  // 41: kratos.api.Admin.tenant_clone:type_name -> kratos.api.Admin.TenantClone
+	57,  // 42: kratos.api.Admin.merge_limits:type_name -> kratos.api.Admin.MergeLimits
+	52,  // 43: kratos.api.Admin.email_validation:type_name -> kratos.api.Admin.EmailValidation
+	58,  // 44: kratos.api.Admin.runbook:type_name -> kratos.api.Admin.Runbook
+	8,   // 45: kratos.api.Observability.metrics:type_name -> kratos.api.Metrics
+	9,   // 46: kratos.api.Observability.tracing:type_name -> kratos.api.Tracing
+	10,  // 47: kratos.api.Observability.logging:type_name -> kratos.api.Logging
+	63,  // 48: kratos.api.Metrics.push:type_name -> kratos.api.Metrics.Push
+	65,  // 49: kratos.api.Metrics.employee_count_interval:type_name -> google.protobuf.Duration
+	65,  // 50: kratos.api.Secrets.Vault.timeout:type_name -> google.protobuf.Duration
+	11,  // 51: kratos.api.Secrets.Vault.jwt_secret:type_name -> kratos.api.Secrets.VaultSecret
+	11,  // 52: kratos.api.Secrets.Vault.database_password:type_name -> kratos.api.Secrets.VaultSecret
+	65,  // 53: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	65,  // 54: kratos.api.Server.HTTP.export_timeout:type_name -> google.protobuf.Duration
+	65,  // 55: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	17,  // 56: kratos.api.Server.GRPC.tls:type_name -> kratos.api.Server.GRPC.TLS
+	65,  // 57: kratos.api.Server.Warmup.timeout:type_name -> google.protobuf.Duration
+	18,  // 58: kratos.api.Server.GRPC.TLS.cert_identity:type_name -> kratos.api.Server.GRPC.TLS.CertIdentity
+	65,  // 59: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	65,  // 60: kratos.api.Data.Database.audit_settle:type_name -> google.protobuf.Duration
+	65,  // 61: kratos.api.Data.Nats.publish_timeout:type_name -> google.protobuf.Duration
+	65,  // 62: kratos.api.Data.Nats.duplicate_window:type_name -> google.protobuf.Duration
+	35,  // 63: kratos.api.Data.Nats.encryption_keys:type_name -> kratos.api.Data.Nats.EncryptionKey
+	36,  // 64: kratos.api.Data.Nats.signing:type_name -> kratos.api.Data.Nats.Signing
+	38,  // 65: kratos.api.Data.Nats.clusters:type_name -> kratos.api.Data.Nats.Cluster
+	65,  // 66: kratos.api.Data.Nats.reconnect_wait:type_name -> google.protobuf.Duration
+	65,  // 67: kratos.api.Data.Nats.reconnect_jitter:type_name -> google.protobuf.Duration
+	65,  // 68: kratos.api.Data.Nats.reconnect_jitter_tls:type_name -> google.protobuf.Duration
+	65,  // 69: kratos.api.Data.Redis.ttl:type_name -> google.protobuf.Duration
+	65,  // 70: kratos.api.Data.AuditArchive.interval:type_name -> google.protobuf.Duration
+	22,  // 71: kratos.api.Data.AuditArchive.store:type_name -> kratos.api.Data.ObjectStore
+	65,  // 72: kratos.api.Data.EventSink.timeout:type_name -> google.protobuf.Duration
+	65,  // 73: kratos.api.Data.Webhooks.poll_interval:type_name -> google.protobuf.Duration
+	65,  // 74: kratos.api.Data.Webhooks.timeout:type_name -> google.protobuf.Duration
+	65,  // 75: kratos.api.Data.Webhooks.initial_backoff:type_name -> google.protobuf.Duration
+	65,  // 76: kratos.api.Data.Webhooks.max_backoff:type_name -> google.protobuf.Duration
+	65,  // 77: kratos.api.Data.Idempotency.ttl:type_name -> google.protobuf.Duration
+	65,  // 78: kratos.api.Data.Idempotency.cleanup_interval:type_name -> google.protobuf.Duration
+	40,  // 79: kratos.api.Data.EventEnrichment.static:type_name -> kratos.api.Data.EventEnrichment.StaticEntry
+	41,  // 80: kratos.api.Data.EventEnrichment.providers:type_name -> kratos.api.Data.EventEnrichment.Provider
+	19,  // 81: kratos.api.Data.ShadowRead.database:type_name -> kratos.api.Data.Database
+	65,  // 82: kratos.api.Data.ShadowRead.timeout:type_name -> google.protobuf.Duration
+	65,  // 83: kratos.api.Data.TimeoutBudget.database_timeout:type_name -> google.protobuf.Duration
+	65,  // 84: kratos.api.Data.TimeoutBudget.min_timeout:type_name -> google.protobuf.Duration
+	22,  // 85: kratos.api.Data.Photos.store:type_name -> kratos.api.Data.ObjectStore
+	65,  // 86: kratos.api.Data.Photos.url_ttl:type_name -> google.protobuf.Duration
+	65,  // 87: kratos.api.Data.AuthFailureEvents.window:type_name -> google.protobuf.Duration
+	42,  // 88: kratos.api.Data.Quota.tenant_max_employees:type_name -> kratos.api.Data.Quota.TenantMaxEmployeesEntry
+	65,  // 89: kratos.api.Data.ReadAudit.flush_interval:type_name -> google.protobuf.Duration
+	39,  // 90: kratos.api.Data.Nats.Signing.retired_keys:type_name -> kratos.api.Data.Nats.Signing.RetiredKey
+	37,  // 91: kratos.api.Data.Nats.Cluster.tls:type_name -> kratos.api.Data.Nats.TLS
+	5,   // 92: kratos.api.Auth.RolesEntry.value:type_name -> kratos.api.Role
+	65,  // 93: kratos.api.Auth.JWKS.refresh_interval:type_name -> google.protobuf.Duration
+	65,  // 94: kratos.api.Auth.JWKS.min_refresh_interval:type_name -> google.protobuf.Duration
+	65,  // 95: kratos.api.Auth.JWKS.timeout:type_name -> google.protobuf.Duration
+	44,  // 96: kratos.api.Auth.Issuer.jwks:type_name -> kratos.api.Auth.JWKS
+	65,  // 97: kratos.api.Auth.RequestSigning.max_skew:type_name -> google.protobuf.Duration
+	65,  // 98: kratos.api.Admin.Import.poll_interval:type_name -> google.protobuf.Duration
+	22,  // 99: kratos.api.Admin.Import.store:type_name -> kratos.api.Data.ObjectStore
+	59,  // 100: kratos.api.Admin.Import.tenant_weights:type_name -> kratos.api.Admin.Import.TenantWeightsEntry
+	65,  // 101: kratos.api.Admin.Schedule.poll_interval:type_name -> google.protobuf.Duration
+	65,  // 102: kratos.api.Admin.Usage.flush_interval:type_name -> google.protobuf.Duration
+	60,  // 103: kratos.api.Admin.EmailNormalization.tenant_fold_gmail:type_name -> kratos.api.Admin.EmailNormalization.TenantFoldGmailEntry
+	61,  // 104: kratos.api.Admin.EmailValidation.tenant_strictness:type_name -> kratos.api.Admin.EmailValidation.TenantStrictnessEntry
+	65,  // 105: kratos.api.Admin.Stale.untouched_for:type_name -> google.protobuf.Duration
+	65,  // 106: kratos.api.Admin.Stale.interval:type_name -> google.protobuf.Duration
+	62,  // 107: kratos.api.Admin.TenantClone.sandboxes:type_name -> kratos.api.Admin.TenantClone.SandboxesEntry
+	65,  // 108: kratos.api.Admin.TenantClone.poll_interval:type_name -> google.protobuf.Duration
+	65,  // 109: kratos.api.Admin.MergeLimits.queue_timeout:type_name -> google.protobuf.Duration
+	65,  // 110: kratos.api.Metrics.Push.interval:type_name -> google.protobuf.Duration
+	64,  // 111: kratos.api.Metrics.Push.grouping:type_name -> kratos.api.Metrics.Push.GroupingEntry
+	112, // [112:112] is the sub-list for method output_type
+	112, // [112:112] is the sub-list for method input_type
+	112, // [112:112] is the sub-list for extension type_name
+	112, // [112:112] is the sub-list for extension extendee
+	0,   // [0:112] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -832,7 +4856,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
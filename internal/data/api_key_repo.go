@@ -0,0 +1,157 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// APIKeyModel is the GORM model for API keys
+type APIKeyModel struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	TenantID  string     `gorm:"type:varchar(255);not null;index:idx_employee_api_keys_tenant_created,priority:1"`
+	Service   string     `gorm:"type:varchar(100);not null"`
+	Roles     []byte     `gorm:"type:jsonb;not null"`
+	Prefix    string     `gorm:"type:varchar(16);not null"`
+	KeyHash   string     `gorm:"type:varchar(64);not null;index:idx_employee_api_keys_key_hash,unique"`
+	CreatedBy string     `gorm:"type:varchar(255);not null"`
+	CreatedAt time.Time  `gorm:"not null;index:idx_employee_api_keys_tenant_created,priority:2"`
+	RevokedAt *time.Time `gorm:""`
+	// SigningSecret is empty for keys created before migration 000045
+	SigningSecret    string `gorm:"type:varchar(64);not null;default:''"`
+	RequireSignature bool   `gorm:"not null;default:false"`
+}
+
+// TableName overrides the table name
+func (APIKeyModel) TableName() string {
+	return "employee_api_keys"
+}
+
+// ToEntity converts APIKeyModel to biz.APIKey
+func (m *APIKeyModel) ToEntity() (*biz.APIKey, error) {
+	var roles []string
+	if len(m.Roles) > 0 {
+		if err := json.Unmarshal(m.Roles, &roles); err != nil {
+			return nil, err
+		}
+	}
+
+	return &biz.APIKey{
+		ID:        m.ID,
+		TenantID:  m.TenantID,
+		Service:   m.Service,
+		Roles:     roles,
+		Prefix:    m.Prefix,
+		CreatedBy: m.CreatedBy,
+		CreatedAt: m.CreatedAt,
+		RevokedAt: m.RevokedAt,
+
+		SigningSecret:    m.SigningSecret,
+		RequireSignature: m.RequireSignature,
+	}, nil
+}
+
+type apiKeyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAPIKeyRepo creates a new API key repository.
+func NewAPIKeyRepo(data *Data, logger log.Logger) biz.APIKeyRepo {
+	return &apiKeyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create stores a new key.
+func (r *apiKeyRepo) Create(ctx context.Context, key *biz.APIKey, hash string) (*biz.APIKey, error) {
+	roles := key.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	encoded, err := json.Marshal(roles)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &APIKeyModel{
+		ID:        key.ID,
+		TenantID:  key.TenantID,
+		Service:   key.Service,
+		Roles:     encoded,
+		Prefix:    key.Prefix,
+		KeyHash:   hash,
+		CreatedBy: key.CreatedBy,
+		CreatedAt: key.CreatedAt,
+
+		SigningSecret:    key.SigningSecret,
+		RequireSignature: key.RequireSignature,
+	}
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+
+	return model.ToEntity()
+}
+
+// List returns the keys of the tenant, newest first.
+func (r *apiKeyRepo) List(ctx context.Context, tenantID string) ([]*biz.APIKey, error) {
+	var models []APIKeyModel
+	if err := r.data.DB(ctx).
+		Where("tenant_id = ?", tenantID).
+		Order("created_at DESC, id").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make([]*biz.APIKey, 0, len(models))
+	for i := range models {
+		key, err := models[i].ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// revokeAPIKeyQuery revokes a key, keeping the time of an earlier revocation
+const revokeAPIKeyQuery = `
+UPDATE employee_api_keys SET revoked_at = COALESCE(revoked_at, ?)
+WHERE id = ? AND tenant_id = ?
+RETURNING *`
+
+// Revoke revokes a key of the tenant.
+func (r *apiKeyRepo) Revoke(ctx context.Context, tenantID string, id uuid.UUID, now time.Time) (*biz.APIKey, error) {
+	var models []APIKeyModel
+	if err := r.data.DB(ctx).
+		Raw(revokeAPIKeyQuery, now, id, tenantID).
+		Scan(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, biz.ErrAPIKeyNotFound
+	}
+	return models[0].ToEntity()
+}
+
+// GetByHash returns the unrevoked key with the hash, of any tenant.
+func (r *apiKeyRepo) GetByHash(ctx context.Context, hash string) (*biz.APIKey, error) {
+	var model APIKeyModel
+	err := r.data.DB(ctx).Where("key_hash = ? AND revoked_at IS NULL", hash).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity()
+}
@@ -0,0 +1,55 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyRepo_Revoke(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &apiKeyRepo{data: d}
+	id := uuid.New()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	query := `UPDATE employee_api_keys SET revoked_at = COALESCE\(revoked_at, \$1\)\s+WHERE id = \$2 AND tenant_id = \$3\s+RETURNING \*`
+
+	mock.ExpectQuery(query).
+		WithArgs(now, id, "tenant-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "service", "roles", "revoked_at"}).
+			AddRow(id, "tenant-1", "payroll-sync", []byte(`["employee_reader"]`), now))
+
+	key, err := repo.Revoke(context.Background(), "tenant-1", id, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"employee_reader"}, key.Roles)
+	assert.Equal(t, &now, key.RevokedAt)
+
+	mock.ExpectQuery(query).
+		WithArgs(now, id, "tenant-2").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err = repo.Revoke(context.Background(), "tenant-2", id, now)
+	assert.ErrorIs(t, err, biz.ErrAPIKeyNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAPIKeyRepo_GetByHash(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &apiKeyRepo{data: d}
+	query := `SELECT \* FROM "employee_api_keys" WHERE key_hash = \$1 AND revoked_at IS NULL`
+
+	mock.ExpectQuery(query).
+		WithArgs("hash", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	key, err := repo.GetByHash(context.Background(), "hash")
+	require.NoError(t, err)
+	assert.Nil(t, key)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,212 @@
+package data
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultAuditRetentionDays is how long audit entries stay in the database
+	defaultAuditRetentionDays = 90
+	// defaultAuditArchiveBatchSize is the number of entries per archive object
+	defaultAuditArchiveBatchSize = 1000
+)
+
+// archivedAuditEntry is one line of an archive object
+type archivedAuditEntry struct {
+	Seq                  int64           `json:"seq"`
+	ID                   uuid.UUID       `json:"id"`
+	TenantID             string          `json:"tenant_id"`
+	EmployeeID           uuid.UUID       `json:"employee_id"`
+	Action               string          `json:"action"`
+	ActorID              string          `json:"actor_id"`
+	RequestID            string          `json:"request_id,omitempty"`
+	ImpersonatorID       string          `json:"impersonator_id,omitempty"`
+	ImpersonatorTenantID string          `json:"impersonator_tenant_id,omitempty"`
+	Before               json.RawMessage `json:"before,omitempty"`
+	After                json.RawMessage `json:"after,omitempty"`
+	CreatedAt            time.Time       `json:"created_at"`
+}
+
+// AuditLogStats describes the size of the audit log kept in the database
+type AuditLogStats struct {
+	Rows int64
+	// OldestAge is the age of the oldest entry, zero when the log is empty
+	OldestAge time.Duration
+}
+
+// AuditArchiver moves old audit entries to object storage.
+//
+// Entries are archived in seq order, one gzipped NDJSON object per batch, and
+// only deleted once their object has been written. A batch that fails after
+// the upload is archived again on the next run under the same key.
+type AuditArchiver struct {
+	data      *Data
+	store     ObjectStore
+	retention time.Duration
+	batchSize int
+	log       *log.Helper
+}
+
+// NewAuditArchiver creates the archiver, or returns nil when archival is disabled
+func NewAuditArchiver(c *conf.Data, d *Data, logger log.Logger) (*AuditArchiver, error) {
+	ac := c.GetAuditArchive()
+	if !ac.GetEnabled() {
+		return nil, nil
+	}
+
+	store, err := NewS3ObjectStore(ac.GetStore())
+	if err != nil {
+		return nil, fmt.Errorf("audit archive: %w", err)
+	}
+
+	return newAuditArchiver(d, store, ac, logger), nil
+}
+
+// auditRetention is how long audit entries stay in the database before they
+// are archived
+func auditRetention(ac *conf.Data_AuditArchive) time.Duration {
+	days := int(ac.GetRetentionDays())
+	if days <= 0 {
+		days = defaultAuditRetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+func newAuditArchiver(d *Data, store ObjectStore, ac *conf.Data_AuditArchive, logger log.Logger) *AuditArchiver {
+	batchSize := int(ac.GetBatchSize())
+	if batchSize <= 0 {
+		batchSize = defaultAuditArchiveBatchSize
+	}
+
+	return &AuditArchiver{
+		data:      d,
+		store:     store,
+		retention: auditRetention(ac),
+		batchSize: batchSize,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// Archive moves all entries older than the retention period to object
+// storage, batch by batch, and returns the number of entries archived. The
+// latest of those entries of an employee that still existed at the cutoff
+// stays until a newer entry is archived, so that ListAsOf can reconstruct
+// every point in time within the retention period.
+func (a *AuditArchiver) Archive(ctx context.Context) (int64, error) {
+	cutoff := a.data.now().UTC().Add(-a.retention)
+
+	var total int64
+	for {
+		n, err := a.archiveBatch(ctx, cutoff)
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+		if n < a.batchSize {
+			return total, nil
+		}
+	}
+}
+
+// archiveBatch archives and deletes the oldest batch of entries created before cutoff
+func (a *AuditArchiver) archiveBatch(ctx context.Context, cutoff time.Time) (int, error) {
+	var models []AuditModel
+	if err := a.data.db.WithContext(ctx).
+		Where("created_at < ?", cutoff).
+		Where(`after IS NULL OR EXISTS (
+			SELECT 1 FROM employee_audit newer
+			WHERE newer.tenant_id = employee_audit.tenant_id AND newer.employee_id = employee_audit.employee_id
+			  AND newer.created_at < ? AND newer.seq > employee_audit.seq)`, cutoff).
+		Order("seq").
+		Limit(a.batchSize).
+		Find(&models).Error; err != nil {
+		return 0, err
+	}
+	if len(models) == 0 {
+		return 0, nil
+	}
+
+	body, err := encodeAuditArchive(models)
+	if err != nil {
+		return 0, err
+	}
+
+	first, last := models[0], models[len(models)-1]
+	key := fmt.Sprintf("employee_audit/%s/%020d-%020d.ndjson.gz", first.CreatedAt.Format("2006/01/02"), first.Seq, last.Seq)
+	if err := a.store.Put(ctx, key, body, "application/x-ndjson", "gzip"); err != nil {
+		return 0, fmt.Errorf("upload %s: %w", key, err)
+	}
+
+	ids := make([]uuid.UUID, len(models))
+	for i := range models {
+		ids[i] = models[i].ID
+	}
+	if err := a.data.db.WithContext(ctx).Where("id IN ?", ids).Delete(&AuditModel{}).Error; err != nil {
+		return 0, err
+	}
+
+	a.log.WithContext(ctx).Infof("archived %d audit entries (seq %d-%d) to %s", len(models), first.Seq, last.Seq, key)
+	return len(models), nil
+}
+
+// encodeAuditArchive renders entries as gzipped newline-delimited JSON
+func encodeAuditArchive(models []AuditModel) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(zw)
+
+	for i := range models {
+		m := &models[i]
+		if err := enc.Encode(archivedAuditEntry{
+			Seq:                  m.Seq,
+			ID:                   m.ID,
+			TenantID:             m.TenantID,
+			EmployeeID:           m.EmployeeID,
+			Action:               m.Action,
+			ActorID:              m.ActorID,
+			RequestID:            m.RequestID,
+			ImpersonatorID:       m.ImpersonatorID,
+			ImpersonatorTenantID: m.ImpersonatorTenantID,
+			Before:               m.Before,
+			After:                m.After,
+			CreatedAt:            m.CreatedAt,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Stats returns the number of entries in the database and the age of the oldest one
+func (a *AuditArchiver) Stats(ctx context.Context) (AuditLogStats, error) {
+	var row struct {
+		RowCount int64
+		Oldest   *time.Time
+	}
+	if err := a.data.db.WithContext(ctx).
+		Model(&AuditModel{}).
+		Select("COUNT(*) AS row_count, MIN(created_at) AS oldest").
+		Scan(&row).Error; err != nil {
+		return AuditLogStats{}, err
+	}
+
+	stats := AuditLogStats{Rows: row.RowCount}
+	if row.Oldest != nil {
+		stats.OldestAge = time.Since(*row.Oldest)
+	}
+	return stats, nil
+}
@@ -0,0 +1,123 @@
+package data
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// memoryObjectStore keeps uploaded objects in memory
+type memoryObjectStore struct {
+	objects map[string][]byte
+	err     error
+}
+
+func (s *memoryObjectStore) Put(_ context.Context, key string, body []byte, _, _ string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.objects[key] = body
+	return nil
+}
+
+func (s *memoryObjectStore) Get(_ context.Context, key string, _ int64) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	body, ok := s.objects[key]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	return body, nil
+}
+
+func newMockData(t *testing.T) (*Data, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+
+	return &Data{db: db}, mock
+}
+
+func auditRows(createdAt time.Time, seqs ...int64) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "tenant_id", "employee_id", "action", "actor_id", "request_id", "before", "after", "seq", "created_at"})
+	for _, seq := range seqs {
+		rows.AddRow(uuid.New(), "tenant-1", uuid.New(), "update", "user-1", "req-1", []byte(`{"first_name":"A"}`), []byte(`{"first_name":"B"}`), seq, createdAt)
+	}
+	return rows
+}
+
+func TestNewAuditArchiver_Defaults(t *testing.T) {
+	a := newAuditArchiver(&Data{}, &memoryObjectStore{}, &conf.Data_AuditArchive{}, log.NewStdLogger(io.Discard))
+
+	assert.Equal(t, 90*24*time.Hour, a.retention)
+	assert.Equal(t, defaultAuditArchiveBatchSize, a.batchSize)
+}
+
+func TestAuditArchiver_Archive(t *testing.T) {
+	d, mock := newMockData(t)
+	store := &memoryObjectStore{objects: map[string][]byte{}}
+	a := newAuditArchiver(d, store, &conf.Data_AuditArchive{RetentionDays: 30, BatchSize: 5}, log.NewStdLogger(io.Discard))
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// The latest entry of an employee that still exists is kept
+	mock.ExpectQuery(`(?s)SELECT \* FROM "employee_audit" WHERE created_at < \$1 AND \(after IS NULL OR EXISTS \(.*newer.created_at < \$2 AND newer.seq > employee_audit.seq\)\) ORDER BY seq LIMIT \$3`).
+		WillReturnRows(auditRows(createdAt, 7, 9))
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "employee_audit" WHERE id IN \(\$1,\$2\)`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	archived, err := a.Archive(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), archived)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	body, ok := store.objects["employee_audit/2026/01/02/00000000000000000007-00000000000000000009.ndjson.gz"]
+	require.True(t, ok, "archive object written")
+
+	zr, err := gzip.NewReader(bytes.NewReader(body))
+	require.NoError(t, err)
+	dec := json.NewDecoder(zr)
+	var seqs []int64
+	for dec.More() {
+		var entry archivedAuditEntry
+		require.NoError(t, dec.Decode(&entry))
+		assert.JSONEq(t, `{"first_name":"B"}`, string(entry.After))
+		seqs = append(seqs, entry.Seq)
+	}
+	assert.Equal(t, []int64{7, 9}, seqs)
+}
+
+func TestAuditArchiver_UploadFailureKeepsRows(t *testing.T) {
+	d, mock := newMockData(t)
+	store := &memoryObjectStore{err: errors.New("bucket unavailable")}
+	a := newAuditArchiver(d, store, &conf.Data_AuditArchive{BatchSize: 5}, log.NewStdLogger(io.Discard))
+
+	mock.ExpectQuery(`SELECT \* FROM "employee_audit"`).WillReturnRows(auditRows(time.Now(), 1))
+
+	archived, err := a.Archive(context.Background())
+
+	assert.ErrorContains(t, err, "bucket unavailable")
+	assert.Zero(t, archived)
+	// No DELETE was issued
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,71 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ListAsOf reconstructs the employees of a tenant at asOf from the after
+// snapshot of the latest audit entry of each employee created up to asOf;
+// employees whose latest entry is a delete or merge did not exist then. The
+// latest entries are found by idx_employee_audit_tenant_employee.
+func (r *auditRepo) ListAsOf(ctx context.Context, tenantID string, asOf time.Time, filter *biz.ListFilter) (*biz.ListResult, error) {
+	// Archival removes entries older than the retention period, except the
+	// latest entry of each existing employee, so only later points in time
+	// can be reconstructed
+	if r.retention > 0 && asOf.Before(r.data.now().Add(-r.retention)) {
+		return nil, errors.BadRequest(v1.ErrorReason_INVALID_AS_OF.String(), fmt.Sprintf("as_of must be within the audit retention of %s", r.retention))
+	}
+
+	latest := r.data.DB(ctx).
+		Model(&AuditModel{}).
+		Select("DISTINCT ON (employee_id) employee_id, after").
+		Where("tenant_id = ? AND created_at <= ?", tenantID, asOf).
+		Order("employee_id, created_at DESC, seq DESC")
+
+	// Snapshots only store the review status of employees pending review
+	query := r.data.DB(ctx).
+		Table("(?) AS latest", latest).
+		Where("after IS NOT NULL AND after->>'review_status' IS NULL")
+	if filter.DepartmentID != nil {
+		query = query.Where("after->>'department_id' = ?", filter.DepartmentID.String())
+	}
+	if len(filter.Tags) > 0 {
+		tags, err := json.Marshal(filter.Tags)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("after->'tags' @> ?::jsonb", string(tags))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	var snapshots [][]byte
+	if err := query.
+		Order("(after->>'created_at')::timestamptz, employee_id").
+		Offset(int((filter.Page-1)*filter.PageSize)).
+		Limit(int(filter.PageSize)).
+		Pluck("after", &snapshots).Error; err != nil {
+		return nil, err
+	}
+
+	employees := make([]*biz.Employee, 0, len(snapshots))
+	for _, raw := range snapshots {
+		employee, err := unmarshalSnapshot(tenantID, raw)
+		if err != nil {
+			return nil, err
+		}
+		employees = append(employees, employee)
+	}
+	return &biz.ListResult{Employees: employees, Total: total}, nil
+}
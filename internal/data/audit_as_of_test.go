@@ -0,0 +1,62 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditRepo_ListAsOf(t *testing.T) {
+	d, mock := newMockData(t)
+	r := &auditRepo{data: d, log: log.NewHelper(log.NewStdLogger(io.Discard))}
+	asOf := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	departmentID := uuid.New()
+	id := uuid.New()
+
+	latest := `SELECT DISTINCT ON \(employee_id\) employee_id, after FROM "employee_audit" WHERE tenant_id = \$1 AND created_at <= \$2 ORDER BY employee_id, created_at DESC, seq DESC`
+	filters := `WHERE \(after IS NOT NULL AND after->>'review_status' IS NULL\) AND after->>'department_id' = \$3 AND after->'tags' @> \$4::jsonb`
+	mock.ExpectQuery(`SELECT count\(\*\) FROM \(`+latest+`\) AS latest `+filters).
+		WithArgs("tenant-1", asOf, departmentID.String(), `["remote"]`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(21))
+	mock.ExpectQuery(`SELECT "after" FROM \(`+latest+`\) AS latest `+filters+` ORDER BY \(after->>'created_at'\)::timestamptz, employee_id LIMIT \$5 OFFSET \$6`).
+		WithArgs("tenant-1", asOf, departmentID.String(), `["remote"]`, 20, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"after"}).
+			AddRow([]byte(`{"id":"` + id.String() + `","emails":["ada@example.com"],"first_name":"Ada","last_name":"Lovelace","version":2,"tags":["remote"]}`)))
+
+	result, err := r.ListAsOf(context.Background(), "tenant-1", asOf, &biz.ListFilter{Page: 2, PageSize: 20, DepartmentID: &departmentID, Tags: []string{"remote"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(21), result.Total)
+	require.Len(t, result.Employees, 1)
+	assert.Equal(t, &biz.Employee{
+		ID:           id,
+		TenantID:     "tenant-1",
+		Emails:       []string{"ada@example.com"},
+		FirstName:    "Ada",
+		LastName:     "Lovelace",
+		Version:      2,
+		ReviewStatus: biz.ReviewStatusApproved,
+		Tags:         []string{"remote"},
+	}, result.Employees[0])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuditRepo_ListAsOf_BeforeRetention(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	d := &Data{clock: biz.ClockFunc(func() time.Time { return now })}
+	r := &auditRepo{data: d, retention: 90 * 24 * time.Hour, log: log.NewHelper(log.NewStdLogger(io.Discard))}
+
+	_, err := r.ListAsOf(context.Background(), "tenant-1", now.AddDate(0, -6, 0), &biz.ListFilter{Page: 1, PageSize: 20})
+
+	assert.True(t, errors.IsBadRequest(err))
+}
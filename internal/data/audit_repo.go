@@ -0,0 +1,497 @@
+package data
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultAuditSettle is how long readers following the audit log hold
+	// back entries on databases that are not commit ordered
+	defaultAuditSettle = 2 * time.Second
+	// auditPositionOrder orders audit entries by position, see
+	// biz.AuditPosition. Entries without a transaction ID come first.
+	auditPositionOrder = "COALESCE(txid, 0), seq"
+	// auditCommittedCondition matches the entries of transactions older than
+	// every transaction in progress: no entry can be committed before them
+	// anymore.
+	auditCommittedCondition = "COALESCE(txid, 0) < pg_snapshot_xmin(pg_current_snapshot())::text::bigint"
+)
+
+// AuditModel is the GORM model for the employee audit log
+type AuditModel struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TenantID   string    `gorm:"type:varchar(255);not null;index:idx_employee_audit_tenant_employee,priority:1"`
+	EmployeeID uuid.UUID `gorm:"type:uuid;not null;index:idx_employee_audit_tenant_employee,priority:2"`
+	Action     string    `gorm:"type:varchar(32);not null"`
+	ActorID    string    `gorm:"type:varchar(255);not null;default:''"`
+	RequestID  string    `gorm:"type:varchar(255);not null;default:''"`
+	ClientIP   string    `gorm:"type:varchar(64);not null;default:''"`
+	// ImpersonatorID and ImpersonatorTenantID are the admin acting as the
+	// actor, empty unless impersonated
+	ImpersonatorID       string `gorm:"type:varchar(255);not null;default:''"`
+	ImpersonatorTenantID string `gorm:"type:varchar(255);not null;default:''"`
+	Before               []byte `gorm:"type:jsonb"`
+	After                []byte `gorm:"type:jsonb"`
+	// TxID, Seq and CreatedAt are assigned by the database (transaction ID,
+	// sequence and transaction start). TxID is NULL where the database does
+	// not record it.
+	TxID      *int64    `gorm:"column:txid;->"`
+	Seq       int64     `gorm:"->"`
+	CreatedAt time.Time `gorm:"->"`
+}
+
+// TableName overrides the table name
+func (AuditModel) TableName() string {
+	return "employee_audit"
+}
+
+// employeeSnapshot is the JSON representation of an employee stored in the audit log
+type employeeSnapshot struct {
+	ID     uuid.UUID `json:"id"`
+	Emails []string  `json:"emails"`
+	// PrimaryEmail is missing from snapshots taken before emails had a
+	// primary
+	PrimaryEmail string    `json:"primary_email,omitempty"`
+	FirstName    string    `json:"first_name"`
+	LastName     string    `json:"last_name"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Version      int64     `json:"version,omitempty"`
+	// ReviewStatus is only stored while the employee is pending review
+	ReviewStatus string     `json:"review_status,omitempty"`
+	DepartmentID *uuid.UUID `json:"department_id,omitempty"`
+	ManagerID    *uuid.UUID `json:"manager_id,omitempty"`
+	Title        string     `json:"title,omitempty"`
+	Tags         []string   `json:"tags,omitempty"`
+	// PhoneNumbers are in their order on the employee
+	PhoneNumbers []phoneNumberSnapshot `json:"phone_numbers,omitempty"`
+	Address      *addressSnapshot      `json:"address,omitempty"`
+	PhotoKey     string                `json:"photo_key,omitempty"`
+	ExternalIDs  map[string]string     `json:"external_ids,omitempty"`
+	// DeactivatedAt is only stored for deactivated employees
+	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"`
+}
+
+// addressSnapshot is the JSON representation of a postal address, stored in
+// audit snapshots and scheduled changes
+type addressSnapshot struct {
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+}
+
+// toAddressSnapshot converts an address to its JSON representation
+func toAddressSnapshot(a *biz.Address) *addressSnapshot {
+	if a == nil {
+		return nil
+	}
+	s := addressSnapshot(*a)
+	return &s
+}
+
+// fromAddressSnapshot converts an address from its JSON representation
+func fromAddressSnapshot(s *addressSnapshot) *biz.Address {
+	if s == nil {
+		return nil
+	}
+	a := biz.Address(*s)
+	return &a
+}
+
+// phoneNumberSnapshot is the JSON representation of a phone number, stored
+// in audit snapshots and scheduled changes
+type phoneNumberSnapshot struct {
+	Type   string `json:"type"`
+	Number string `json:"number"`
+}
+
+// toPhoneNumberSnapshots converts phone numbers to their JSON representation
+func toPhoneNumberSnapshots(phones []biz.PhoneNumber) []phoneNumberSnapshot {
+	if phones == nil {
+		return nil
+	}
+	snapshots := make([]phoneNumberSnapshot, len(phones))
+	for i, phone := range phones {
+		snapshots[i] = phoneNumberSnapshot{Type: phone.Type, Number: phone.Number}
+	}
+	return snapshots
+}
+
+// fromPhoneNumberSnapshots converts phone numbers from their JSON
+// representation
+func fromPhoneNumberSnapshots(snapshots []phoneNumberSnapshot) []biz.PhoneNumber {
+	if snapshots == nil {
+		return nil
+	}
+	phones := make([]biz.PhoneNumber, len(snapshots))
+	for i, s := range snapshots {
+		phones[i] = biz.PhoneNumber{Type: s.Type, Number: s.Number}
+	}
+	return phones
+}
+
+// marshalSnapshot encodes an employee for the audit log, returning nil for nil employees
+func marshalSnapshot(e *biz.Employee) ([]byte, error) {
+	if e == nil {
+		return nil, nil
+	}
+	emails := e.Emails
+	if emails == nil {
+		emails = []string{}
+	}
+	return json.Marshal(employeeSnapshot{
+		ID:            e.ID,
+		Emails:        emails,
+		PrimaryEmail:  e.PrimaryEmail,
+		FirstName:     e.FirstName,
+		LastName:      e.LastName,
+		CreatedAt:     e.CreatedAt,
+		UpdatedAt:     e.UpdatedAt,
+		Version:       e.Version,
+		ReviewStatus:  snapshotReviewStatus(e.ReviewStatus),
+		DepartmentID:  e.DepartmentID,
+		ManagerID:     e.ManagerID,
+		Title:         e.Title,
+		Tags:          e.Tags,
+		PhoneNumbers:  toPhoneNumberSnapshots(e.PhoneNumbers),
+		Address:       toAddressSnapshot(e.Address),
+		PhotoKey:      e.PhotoKey,
+		ExternalIDs:   e.ExternalIDs,
+		DeactivatedAt: e.DeactivatedAt,
+	})
+}
+
+// snapshotReviewStatus returns the review status stored in snapshots, which
+// leave out the approved status of nearly every employee
+func snapshotReviewStatus(status string) string {
+	if status == biz.ReviewStatusApproved {
+		return ""
+	}
+	return status
+}
+
+// unmarshalSnapshot decodes an audit log snapshot into an employee
+func unmarshalSnapshot(tenantID string, raw []byte) (*biz.Employee, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var s employeeSnapshot
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+	return &biz.Employee{
+		ID:            s.ID,
+		TenantID:      tenantID,
+		Emails:        s.Emails,
+		PrimaryEmail:  s.PrimaryEmail,
+		FirstName:     s.FirstName,
+		LastName:      s.LastName,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
+		Version:       s.Version,
+		ReviewStatus:  cmp.Or(s.ReviewStatus, biz.ReviewStatusApproved),
+		DepartmentID:  s.DepartmentID,
+		ManagerID:     s.ManagerID,
+		Title:         s.Title,
+		Tags:          s.Tags,
+		PhoneNumbers:  fromPhoneNumberSnapshots(s.PhoneNumbers),
+		Address:       fromAddressSnapshot(s.Address),
+		PhotoKey:      s.PhotoKey,
+		ExternalIDs:   s.ExternalIDs,
+		DeactivatedAt: s.DeactivatedAt,
+	}, nil
+}
+
+// ToEntity converts AuditModel to biz.AuditEntry
+func (m *AuditModel) ToEntity() (*biz.AuditEntry, error) {
+	before, err := unmarshalSnapshot(m.TenantID, m.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := unmarshalSnapshot(m.TenantID, m.After)
+	if err != nil {
+		return nil, err
+	}
+	var txID int64
+	if m.TxID != nil {
+		txID = *m.TxID
+	}
+
+	return &biz.AuditEntry{
+		ID:                   m.ID,
+		TenantID:             m.TenantID,
+		EmployeeID:           m.EmployeeID,
+		Action:               m.Action,
+		ActorID:              m.ActorID,
+		RequestID:            m.RequestID,
+		ClientIP:             m.ClientIP,
+		ImpersonatorID:       m.ImpersonatorID,
+		ImpersonatorTenantID: m.ImpersonatorTenantID,
+		Before:               before,
+		After:                after,
+		TxID:                 txID,
+		Seq:                  m.Seq,
+		CreatedAt:            m.CreatedAt,
+	}, nil
+}
+
+// newAuditModel builds an audit row for a mutation, taking actor and request metadata from context
+func (d *Data) newAuditModel(ctx context.Context, tenantID, action string, employeeID uuid.UUID, before, after *biz.Employee) (*AuditModel, error) {
+	beforeJSON, err := marshalSnapshot(before)
+	if err != nil {
+		return nil, err
+	}
+	afterJSON, err := marshalSnapshot(after)
+	if err != nil {
+		return nil, err
+	}
+
+	actorID, _ := biz.GetUserID(ctx)
+	md := biz.GetRequestMetadata(ctx)
+	impersonator, _ := biz.GetImpersonator(ctx)
+
+	return &AuditModel{
+		ID:                   d.newID(),
+		TenantID:             tenantID,
+		EmployeeID:           employeeID,
+		Action:               action,
+		ActorID:              actorID,
+		RequestID:            md.RequestID,
+		ClientIP:             md.ClientIP,
+		ImpersonatorID:       impersonator.UserID,
+		ImpersonatorTenantID: impersonator.TenantID,
+		Before:               beforeJSON,
+		After:                afterJSON,
+	}, nil
+}
+
+// recordAudit writes an audit row using the given transaction
+func (d *Data) recordAudit(ctx context.Context, tx *gorm.DB, tenantID, action string, employeeID uuid.UUID, before, after *biz.Employee) error {
+	model, err := d.newAuditModel(ctx, tenantID, action, employeeID, before, after)
+	if err != nil {
+		return err
+	}
+	return tx.Create(model).Error
+}
+
+// auditDeleteQuery inserts a delete audit row for every employee matched by the
+// given WHERE clause (applied to alias e), building the snapshot in SQL so that
+// set-based deletes do not have to load every employee first.
+const auditDeleteQuery = `
+INSERT INTO employee_audit (id, tenant_id, employee_id, action, actor_id, request_id, client_ip, impersonator_id, impersonator_tenant_id, before, created_at)
+SELECT gen_random_uuid(), e.tenant_id, e.id, ?, ?, ?, ?, ?, ?,
+       jsonb_build_object(
+           'id', e.id,
+           'emails', COALESCE((SELECT jsonb_agg(ee.email ORDER BY ee.email) FROM employee_emails ee WHERE ee.employee_id = e.id), '[]'::jsonb),
+           'first_name', e.first_name,
+           'last_name', e.last_name,
+           'created_at', e.created_at,
+           'updated_at', e.updated_at,
+           'version', e.version,
+           'review_status', NULLIF(e.review_status, 'approved')
+       ),
+       CURRENT_TIMESTAMP
+FROM employees e
+WHERE `
+
+// recordDeleteAudits writes delete audit rows for all employees matching the condition
+func recordDeleteAudits(ctx context.Context, tx *gorm.DB, condition string, args ...interface{}) error {
+	actorID, _ := biz.GetUserID(ctx)
+	md := biz.GetRequestMetadata(ctx)
+	impersonator, _ := biz.GetImpersonator(ctx)
+	values := append([]interface{}{biz.AuditActionDelete, actorID, md.RequestID, md.ClientIP, impersonator.UserID, impersonator.TenantID}, args...)
+	return tx.Exec(auditDeleteQuery+condition, values...).Error
+}
+
+type auditRepo struct {
+	data *Data
+	// retention is how long entries stay in the database, 0 when they are
+	// never archived
+	retention time.Duration
+	// settle is how long ListSince holds back entries where the dialect is
+	// not commit ordered
+	settle time.Duration
+	log    *log.Helper
+}
+
+// NewAuditRepo creates a new audit repository.
+func NewAuditRepo(c *conf.Data, data *Data, logger log.Logger) biz.AuditRepo {
+	var retention time.Duration
+	if c.GetAuditArchive().GetEnabled() {
+		retention = auditRetention(c.GetAuditArchive())
+	}
+	return &auditRepo{
+		data:      data,
+		retention: retention,
+		settle:    auditSettle(c.GetDatabase()),
+		log:       log.NewHelper(logger),
+	}
+}
+
+// auditSettle is how long readers following the audit log hold back entries
+// where the dialect is not commit ordered
+func auditSettle(c *conf.Data_Database) time.Duration {
+	if d := c.GetAuditSettle(); d != nil && d.AsDuration() > 0 {
+		return d.AsDuration()
+	}
+	return defaultAuditSettle
+}
+
+// Create records an audit entry outside of a repository transaction.
+func (r *auditRepo) Create(ctx context.Context, entry *biz.AuditEntry) error {
+	model, err := r.data.newAuditModel(ctx, entry.TenantID, entry.Action, entry.EmployeeID, entry.Before, entry.After)
+	if err != nil {
+		return err
+	}
+	if entry.ActorID != "" {
+		model.ActorID = entry.ActorID
+	}
+	if entry.RequestID != "" {
+		model.RequestID = entry.RequestID
+	}
+	return r.data.DB(ctx).Create(model).Error
+}
+
+// List retrieves audit entries within tenant, newest first.
+// A zero PageSize returns all matching entries.
+func (r *auditRepo) List(ctx context.Context, tenantID string, filter *biz.AuditFilter) ([]*biz.AuditEntry, int64, error) {
+	var models []AuditModel
+	var total int64
+
+	query := r.data.DB(ctx).
+		Model(&AuditModel{}).
+		Where("tenant_id = ?", tenantID)
+
+	if filter.EmployeeID != nil {
+		query = query.Where("employee_id = ?", *filter.EmployeeID)
+	}
+	if filter.Since != nil {
+		query = query.Where("created_at >= ?", *filter.Since)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if filter.PageSize > 0 {
+		page := filter.Page
+		if page <= 0 {
+			page = 1
+		}
+		query = query.Offset(int((page - 1) * filter.PageSize)).Limit(int(filter.PageSize))
+	}
+
+	if err := query.Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	entries := make([]*biz.AuditEntry, 0, len(models))
+	for i := range models {
+		entry, err := models[i].ToEntity()
+		if err != nil {
+			return nil, 0, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, total, nil
+}
+
+// ListSince retrieves audit entries within tenant after the position, in
+// position order. Where the dialect is commit ordered, only entries of
+// transactions older than every transaction in progress are listed. Otherwise
+// the result stops before the first entry younger than settle, so that a
+// slower transaction committing a lower seq is not overtaken, and so that a
+// settled entry never skips an unsettled one.
+func (r *auditRepo) ListSince(ctx context.Context, tenantID string, after biz.AuditPosition, limit int) ([]*biz.AuditEntry, error) {
+	var models []AuditModel
+
+	query := r.data.DB(ctx).
+		Where("tenant_id = ?", tenantID).
+		Where("(COALESCE(txid, 0), seq) > (?, ?)", after.TxID, after.Seq)
+	if r.data.dialect.commitOrdered() {
+		query = query.Where(auditCommittedCondition)
+	} else {
+		unsettled := r.data.db.Model(&AuditModel{}).
+			Select("MIN(seq)").
+			Where("tenant_id = ? AND seq > ?", tenantID, after.Seq).
+			Where("created_at > CURRENT_TIMESTAMP - make_interval(secs => ?)", r.settle.Seconds())
+		query = query.Where("seq < COALESCE((?), 9223372036854775807)", unsettled)
+	}
+
+	if err := query.
+		Order(auditPositionOrder).
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	entries := make([]*biz.AuditEntry, 0, len(models))
+	for i := range models {
+		entry, err := models[i].ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// LatestPosition returns the position of the last entry ListSince lists from
+// the start of the tenant's audit log, so that listing after it returns the
+// entries committed from now on. Without commit order it is the position
+// before the oldest unsettled entry.
+func (r *auditRepo) LatestPosition(ctx context.Context, tenantID string) (biz.AuditPosition, error) {
+	var pos biz.AuditPosition
+
+	if !r.data.dialect.commitOrdered() {
+		err := r.data.DB(ctx).Raw(`SELECT COALESCE(
+    (SELECT MIN(seq) - 1 FROM employee_audit WHERE tenant_id = ? AND created_at > CURRENT_TIMESTAMP - make_interval(secs => ?)),
+    (SELECT MAX(seq) FROM employee_audit WHERE tenant_id = ?),
+    0)`, tenantID, r.settle.Seconds(), tenantID).
+			Scan(&pos.Seq).Error
+		return pos, err
+	}
+
+	if err := r.data.DB(ctx).
+		Model(&AuditModel{}).
+		Select("COALESCE(txid, 0) AS tx_id, seq").
+		Where("tenant_id = ?", tenantID).
+		Where(auditCommittedCondition).
+		Order("COALESCE(txid, 0) DESC, seq DESC").
+		Limit(1).
+		Scan(&pos).Error; err != nil {
+		return biz.AuditPosition{}, err
+	}
+
+	return pos, nil
+}
+
+// HasEntry reports whether the tenant's entry with seq is still in the
+// database.
+func (r *auditRepo) HasEntry(ctx context.Context, tenantID string, seq int64) (bool, error) {
+	var count int64
+
+	if err := r.data.DB(ctx).
+		Model(&AuditModel{}).
+		Where("tenant_id = ? AND seq = ?", tenantID, seq).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,116 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestNewAuditModel_Impersonated(t *testing.T) {
+	ctx := biz.WithUserID(context.Background(), "user-7")
+	ctx = biz.WithImpersonator(ctx, biz.Impersonator{TenantID: "support", UserID: "admin-1"})
+
+	model, err := (&Data{}).newAuditModel(ctx, "acme", biz.AuditActionUpdate, uuid.New(), nil, &biz.Employee{ID: uuid.New()})
+	require.NoError(t, err)
+
+	assert.Equal(t, "user-7", model.ActorID)
+	assert.Equal(t, "admin-1", model.ImpersonatorID)
+	assert.Equal(t, "support", model.ImpersonatorTenantID)
+
+	entry, err := model.ToEntity()
+	require.NoError(t, err)
+	assert.Equal(t, "admin-1", entry.ImpersonatorID)
+	assert.Equal(t, "support", entry.ImpersonatorTenantID)
+}
+
+func TestNewAuditModel_NotImpersonated(t *testing.T) {
+	model, err := (&Data{}).newAuditModel(biz.WithUserID(context.Background(), "user-7"), "acme", biz.AuditActionDelete, uuid.New(), &biz.Employee{ID: uuid.New()}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "user-7", model.ActorID)
+	assert.Empty(t, model.ImpersonatorID)
+	assert.Empty(t, model.ImpersonatorTenantID)
+}
+
+func TestAuditRepo_ListSince(t *testing.T) {
+	t.Run("commit ordered", func(t *testing.T) {
+		data, mock := newMockData(t)
+		repo := &auditRepo{data: data, settle: time.Second}
+
+		mock.ExpectQuery(`SELECT \* FROM "employee_audit" WHERE tenant_id = \$1 AND \(COALESCE\(txid, 0\), seq\) > \(\$2, \$3\) `+
+			`AND COALESCE\(txid, 0\) < pg_snapshot_xmin\(pg_current_snapshot\(\)\)::text::bigint ORDER BY COALESCE\(txid, 0\), seq LIMIT \$4`).
+			WithArgs("tenant-1", int64(101), int64(10), 500).
+			WillReturnRows(sqlmock.NewRows([]string{"tenant_id", "employee_id", "action", "txid", "seq"}).
+				AddRow("tenant-1", uuid.New(), "update", int64(102), int64(9)))
+
+		entries, err := repo.ListSince(context.Background(), "tenant-1", biz.AuditPosition{TxID: 101, Seq: 10}, 500)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, biz.AuditPosition{TxID: 102, Seq: 9}, entries[0].Position())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("settled", func(t *testing.T) {
+		data, mock := newMockData(t)
+		data.dialect = dialectCockroachDB
+		repo := &auditRepo{data: data, settle: 5 * time.Second}
+
+		mock.ExpectQuery(`SELECT \* FROM "employee_audit" WHERE tenant_id = \$1 AND \(COALESCE\(txid, 0\), seq\) > \(\$2, \$3\) `+
+			`AND seq < COALESCE\(\(SELECT MIN\(seq\) FROM "employee_audit" WHERE \(tenant_id = \$4 AND seq > \$5\) `+
+			`AND created_at > CURRENT_TIMESTAMP - make_interval\(secs => \$6\)\), 9223372036854775807\) ORDER BY COALESCE\(txid, 0\), seq LIMIT \$7`).
+			WithArgs("tenant-1", int64(0), int64(10), "tenant-1", int64(10), 5.0, 500).
+			WillReturnRows(auditRows(time.Now(), 11))
+
+		entries, err := repo.ListSince(context.Background(), "tenant-1", biz.AuditPosition{Seq: 10}, 500)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, biz.AuditPosition{Seq: 11}, entries[0].Position())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAuditRepo_LatestPosition(t *testing.T) {
+	t.Run("commit ordered", func(t *testing.T) {
+		data, mock := newMockData(t)
+		repo := &auditRepo{data: data}
+
+		mock.ExpectQuery(`SELECT COALESCE\(txid, 0\) AS tx_id, seq FROM "employee_audit" WHERE tenant_id = \$1 `+
+			`AND COALESCE\(txid, 0\) < pg_snapshot_xmin\(pg_current_snapshot\(\)\)::text::bigint ORDER BY COALESCE\(txid, 0\) DESC, seq DESC LIMIT \$2`).
+			WithArgs("tenant-1", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"tx_id", "seq"}).AddRow(int64(300), int64(42)))
+
+		pos, err := repo.LatestPosition(context.Background(), "tenant-1")
+		require.NoError(t, err)
+		assert.Equal(t, biz.AuditPosition{TxID: 300, Seq: 42}, pos)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("settled", func(t *testing.T) {
+		data, mock := newMockData(t)
+		data.dialect = dialectCockroachDB
+		repo := &auditRepo{data: data, settle: 5 * time.Second}
+
+		mock.ExpectQuery(`SELECT COALESCE\(\s+\(SELECT MIN\(seq\) - 1 FROM employee_audit WHERE tenant_id = \$1 AND created_at > CURRENT_TIMESTAMP - make_interval\(secs => \$2\)\),`).
+			WithArgs("tenant-1", 5.0, "tenant-1").
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(int64(41)))
+
+		pos, err := repo.LatestPosition(context.Background(), "tenant-1")
+		require.NoError(t, err)
+		assert.Equal(t, biz.AuditPosition{Seq: 41}, pos)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAuditSettle(t *testing.T) {
+	assert.Equal(t, defaultAuditSettle, auditSettle(nil))
+	assert.Equal(t, 10*time.Second, auditSettle(&conf.Data_Database{AuditSettle: durationpb.New(10 * time.Second)}))
+}
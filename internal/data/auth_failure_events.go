@@ -0,0 +1,151 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	eventsv1 "github.com/cvele/employee-service/api/events/v1"
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/observability"
+	"github.com/cvele/employee-service/pkg/eventcrypto"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SubjectAuthFailure is the subject requests rejected by authentication are
+// published on
+const SubjectAuthFailure = "security.v1.auth_failure"
+
+const (
+	defaultAuthFailureWindow = time.Minute
+	// maxAuthFailureSources bounds the sources tracked at once, so that
+	// failures from many addresses cannot grow memory without limit
+	maxAuthFailureSources = 10000
+)
+
+// authFailureSource is where failures are rate-limited by: the tenant the
+// token claims and the client address
+type authFailureSource struct {
+	tenantID string
+	clientIP string
+}
+
+// authFailureWindow is the rate-limit window of a source, opened by the
+// failure that was published
+type authFailureWindow struct {
+	start      time.Time
+	suppressed int64
+}
+
+// authFailureEvents counts requests rejected by authentication and publishes
+// them on SubjectAuthFailure, at most one event per source and window
+type authFailureEvents struct {
+	nc     *nats.Conn
+	signer *eventcrypto.Signer
+	obs    *observability.Observability
+	window time.Duration
+	clock  biz.Clock
+	log    *log.Helper
+
+	mu      sync.Mutex
+	windows map[authFailureSource]*authFailureWindow
+}
+
+func newAuthFailureEvents(c *conf.Data_AuthFailureEvents, nc *nats.Conn, signer *eventcrypto.Signer, obs *observability.Observability, clock biz.Clock, logger log.Logger) *authFailureEvents {
+	window := c.GetWindow().AsDuration()
+	if window <= 0 {
+		window = defaultAuthFailureWindow
+	}
+	return &authFailureEvents{
+		nc:      nc,
+		signer:  signer,
+		obs:     obs,
+		window:  window,
+		clock:   clock,
+		log:     log.NewHelper(logger),
+		windows: map[authFailureSource]*authFailureWindow{},
+	}
+}
+
+// admit reports whether a failure of source at now is published, and how
+// many failures of source were suppressed since the previous event
+func (e *authFailureEvents) admit(source authFailureSource, now time.Time) (bool, int64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if w, ok := e.windows[source]; ok {
+		if now.Sub(w.start) < e.window {
+			w.suppressed++
+			return false, 0
+		}
+		suppressed := w.suppressed
+		*w = authFailureWindow{start: now}
+		return true, suppressed
+	}
+
+	if len(e.windows) >= maxAuthFailureSources {
+		for s, w := range e.windows {
+			if now.Sub(w.start) >= e.window {
+				delete(e.windows, s)
+			}
+		}
+		// Still full: the failures are counted, but not published
+		if len(e.windows) >= maxAuthFailureSources {
+			return false, 0
+		}
+	}
+	e.windows[source] = &authFailureWindow{start: now}
+	return true, 0
+}
+
+// report counts failure and publishes it unless its source already had an
+// event within the window. Publishing is best-effort.
+func (e *authFailureEvents) report(ctx context.Context, failure biz.AuthFailure, eventID string) {
+	e.obs.RecordAuthFailure(failure.Reason)
+	if e.nc == nil {
+		return
+	}
+
+	now := e.clock.Now()
+	ok, suppressed := e.admit(authFailureSource{tenantID: failure.TenantID, clientIP: failure.ClientIP}, now)
+	if !ok {
+		return
+	}
+
+	data, err := proto.Marshal(&eventsv1.AuthFailureEvent{
+		EventId:    eventID,
+		Timestamp:  timestamppb.New(now),
+		Reason:     failure.Reason,
+		TenantId:   failure.TenantID,
+		ClientIp:   failure.ClientIP,
+		Operation:  failure.Operation,
+		RequestId:  failure.RequestID,
+		Suppressed: suppressed,
+	})
+	if err != nil {
+		e.log.WithContext(ctx).Errorf("failed to marshal auth failure event: %v", err)
+		return
+	}
+
+	msg := &nats.Msg{Subject: SubjectAuthFailure, Data: data}
+	if e.signer != nil {
+		e.signer.Sign(msg)
+	}
+	if err := e.nc.PublishMsg(msg); err != nil {
+		e.log.WithContext(ctx).Errorf("failed to publish event to NATS subject %s: %v", SubjectAuthFailure, err)
+	}
+}
+
+// ReportAuthFailure counts a request rejected by authentication and
+// publishes it on SubjectAuthFailure, rate-limited per tenant and client IP
+func (d *Data) ReportAuthFailure(ctx context.Context, failure biz.AuthFailure) {
+	if d.authFailures == nil {
+		return
+	}
+	d.authFailures.report(ctx, failure, d.newID().String())
+}
@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestNewAuthFailureEvents_Window(t *testing.T) {
+	assert.Equal(t, defaultAuthFailureWindow, newAuthFailureEvents(nil, nil, nil, nil, nil, log.NewStdLogger(io.Discard)).window)
+	assert.Equal(t, 5*time.Second, newAuthFailureEvents(&conf.Data_AuthFailureEvents{Window: durationpb.New(5 * time.Second)}, nil, nil, nil, nil, log.NewStdLogger(io.Discard)).window)
+}
+
+func TestAuthFailureEvents_Admit(t *testing.T) {
+	e := newAuthFailureEvents(nil, nil, nil, nil, nil, log.NewStdLogger(io.Discard))
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := authFailureSource{tenantID: "tenant-1", clientIP: "10.0.0.1"}
+
+	ok, suppressed := e.admit(source, now)
+	assert.True(t, ok)
+	assert.Zero(t, suppressed)
+
+	// Failures within the window are suppressed
+	for i := 0; i < 3; i++ {
+		ok, _ = e.admit(source, now.Add(time.Duration(i)*time.Second))
+		assert.False(t, ok)
+	}
+
+	// Other sources have their own window
+	ok, _ = e.admit(authFailureSource{tenantID: "tenant-1", clientIP: "10.0.0.2"}, now)
+	assert.True(t, ok)
+
+	// The next window's event carries the suppressed count
+	ok, suppressed = e.admit(source, now.Add(time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), suppressed)
+}
+
+func TestAuthFailureEvents_AdmitBoundsSources(t *testing.T) {
+	e := newAuthFailureEvents(nil, nil, nil, nil, nil, log.NewStdLogger(io.Discard))
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < maxAuthFailureSources; i++ {
+		e.windows[authFailureSource{clientIP: string(rune(i))}] = &authFailureWindow{start: now}
+	}
+
+	ok, _ := e.admit(authFailureSource{clientIP: "new"}, now)
+	assert.False(t, ok)
+
+	// Ended windows are pruned to make room
+	ok, _ = e.admit(authFailureSource{clientIP: "new"}, now.Add(time.Minute))
+	assert.True(t, ok)
+	assert.Len(t, e.windows, 1)
+}
+
+func TestData_ReportAuthFailure_WithoutNATS(t *testing.T) {
+	d := &Data{clock: biz.ClockFunc(time.Now)}
+	d.authFailures = newAuthFailureEvents(nil, nil, nil, nil, d.clock, log.NewStdLogger(io.Discard))
+
+	d.ReportAuthFailure(context.Background(), biz.AuthFailure{Reason: biz.AuthFailureMissingToken})
+
+	assert.Empty(t, d.authFailures.windows)
+}
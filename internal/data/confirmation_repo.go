@@ -0,0 +1,106 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ConfirmationModel is the GORM model for destructive operation confirmations
+type ConfirmationModel struct {
+	TokenHash     string     `gorm:"type:varchar(64);primaryKey"`
+	TenantID      string     `gorm:"type:varchar(255);not null;index:idx_destructive_confirmations_tenant_id"`
+	Operation     string     `gorm:"type:varchar(64);not null"`
+	ParamsDigest  string     `gorm:"type:varchar(64);not null;default:''"`
+	AffectedCount int64      `gorm:"not null;default:0"`
+	RequestedBy   string     `gorm:"type:varchar(255);not null"`
+	ExpiresAt     time.Time  `gorm:"not null"`
+	ConsumedAt    *time.Time `gorm:""`
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+}
+
+// TableName overrides the table name
+func (ConfirmationModel) TableName() string {
+	return "destructive_confirmations"
+}
+
+// ToEntity converts ConfirmationModel to biz.Confirmation
+func (m *ConfirmationModel) ToEntity() *biz.Confirmation {
+	return &biz.Confirmation{
+		TokenHash:     m.TokenHash,
+		TenantID:      m.TenantID,
+		Operation:     m.Operation,
+		ParamsDigest:  m.ParamsDigest,
+		AffectedCount: m.AffectedCount,
+		RequestedBy:   m.RequestedBy,
+		ExpiresAt:     m.ExpiresAt,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+type confirmationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewConfirmationRepo creates a new confirmation repository.
+func NewConfirmationRepo(data *Data, logger log.Logger) biz.ConfirmationRepo {
+	return &confirmationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create stores a new pending confirmation.
+func (r *confirmationRepo) Create(ctx context.Context, c *biz.Confirmation) error {
+	return r.data.DB(ctx).Create(&ConfirmationModel{
+		TokenHash:     c.TokenHash,
+		TenantID:      c.TenantID,
+		Operation:     c.Operation,
+		ParamsDigest:  c.ParamsDigest,
+		AffectedCount: c.AffectedCount,
+		RequestedBy:   c.RequestedBy,
+		ExpiresAt:     c.ExpiresAt,
+		CreatedAt:     c.CreatedAt,
+	}).Error
+}
+
+// Consume marks a pending confirmation as used. The row is locked so that a
+// token can only ever be redeemed once, even with concurrent requests.
+func (r *confirmationRepo) Consume(ctx context.Context, tenantID, operation, tokenHash string, now time.Time) (*biz.Confirmation, error) {
+	var result *biz.Confirmation
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var model ConfirmationModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ? AND tenant_id = ? AND operation = ?", tokenHash, tenantID, operation).
+			Where("consumed_at IS NULL AND expires_at > ?", now).
+			First(&model).Error
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&ConfirmationModel{}).
+			Where("token_hash = ?", tokenHash).
+			Update("consumed_at", now).Error; err != nil {
+			return err
+		}
+
+		result = model.ToEntity()
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -1,71 +1,204 @@
 package data
 
 import (
+	"context"
+	"github.com/cvele/employee-service/internal/biz"
 	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/observability"
+	"github.com/cvele/employee-service/pkg/eventcrypto"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	"github.com/google/wire"
 	"github.com/nats-io/nats.go"
-	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewEmployeeRepo)
+var ProviderSet = wire.NewSet(NewData, NewTransaction, NewEmployeeEventPublisher, NewEventBus, NewEmployeeRepo, NewConfirmationRepo, NewIdempotencyRepo, NewAuditRepo, NewAuditArchiver, NewWebhookRepo, NewWebhookDispatcher, NewImportRepo, NewScheduleRepo, NewMergeApprovalRepo, NewTenantSettingsRepo, NewAPIKeyRepo, NewTokenRevocationRepo, NewTenantCloneRepo, NewImportSource, NewUsageRepo, NewDepartmentRepo, NewTeamRepo, NewEmploymentHistoryRepo, NewPhotoStore, NewQuotaRepo, NewQuotaEventPublisher, NewReadAuditWriter, NewReadAuditor, NewMergeLimitMetrics, NewStrictEmailMetrics, NewRequestNonces, NewEmployeeStats, NewRunbookRepo)
 
 // Data .
 type Data struct {
-	db        *gorm.DB
+	db *gorm.DB
+	// pool is the rotatable pool behind db, nil for a Data built around a
+	// given gorm DB
+	pool      *dbPool
+	dialect   dialect
 	nc        *nats.Conn
-	publisher *EventPublisher
+	publisher biz.EventPublisher
+	cache     *employeeCache
+	// shadow is nil unless employee reads are shadowed
+	shadow *shadowRead
+
+	// clock and ids stamp new rows and events; see now and newID
+	clock biz.Clock
+	ids   biz.IDGenerator
+
+	// signer signs events published outside the event publisher, nil
+	// without signing keys
+	signer *eventcrypto.Signer
+	// signingKeys are the public keys events are verified with
+	signingKeys *eventcrypto.JWKS
+
+	// authFailures counts and publishes requests rejected by authentication
+	authFailures *authFailureEvents
 }
 
 // NewData .
-func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
+func NewData(c *conf.Data, clock biz.Clock, ids biz.IDGenerator, obs *observability.Observability, logger log.Logger) (*Data, func(), error) {
 	logHelper := log.NewHelper(logger)
 
+	// Event keys are checked up front; signing keys are published even
+	// while NATS is unavailable
+	encryption, err := newEventEncryption(c.GetNats().GetEncryptionKeys())
+	if err != nil {
+		logHelper.Errorf("invalid event encryption keys: %v", err)
+		return nil, nil, err
+	}
+	signer, signingKeys, err := newEventSigner(c.GetNats().GetSigning())
+	if err != nil {
+		logHelper.Errorf("invalid event signing keys: %v", err)
+		return nil, nil, err
+	}
+	natsConn, err := newNATSConnection(c.GetNats(), obs, logger)
+	if err != nil {
+		logHelper.Errorf("invalid NATS configuration: %v", err)
+		return nil, nil, err
+	}
+	sink, err := newEventSink(c.GetEventSink())
+	if err != nil {
+		logHelper.Errorf("invalid event sink: %v", err)
+		return nil, nil, err
+	}
+	enrichers, err := newEventEnrichers(c.GetEventEnrichment())
+	if err != nil {
+		logHelper.Errorf("invalid event enrichment: %v", err)
+		return nil, nil, err
+	}
+
 	// Open database connection
-	db, err := gorm.Open(postgres.Open(c.Database.Source), &gorm.Config{})
+	dialect, err := newDialect(c.GetDatabase().GetDriver())
+	if err != nil {
+		logHelper.Errorf("invalid database configuration: %v", err)
+		return nil, nil, err
+	}
+	db, pool, err := dialect.openPool(c.Database.Source, c.Database, clock)
 	if err != nil {
 		logHelper.Errorf("failed to connect to database: %v", err)
 		return nil, nil, err
 	}
 
 	logHelper.Info("database connected successfully")
+	obs.ObserveDBStats(pool.stats)
+
+	// Attribute query time to the repo phase of request latency
+	if err := db.Use(phaseTimingPlugin{}); err != nil {
+		logHelper.Errorf("failed to register phase timing plugin: %v", err)
+		return nil, nil, err
+	}
+
+	// Trace each statement as a child of the repository or request span
+	if err := db.Use(tracingPlugin{system: dialect.system()}); err != nil {
+		logHelper.Errorf("failed to register tracing plugin: %v", err)
+		return nil, nil, err
+	}
+
+	// Bound statements by what is left of the request deadline
+	budget := newTimeoutBudget(c.GetTimeoutBudget(), obs)
+	if err := db.Use(timeoutBudgetPlugin{budget: budget, timeout: c.GetTimeoutBudget().GetDatabaseTimeout().AsDuration()}); err != nil {
+		logHelper.Errorf("failed to register timeout budget plugin: %v", err)
+		return nil, nil, err
+	}
+
+	shadow, err := newShadowRead(c.GetShadowRead(), db, dialect, clock, ids, logger)
+	if err != nil {
+		logHelper.Errorf("invalid shadow read configuration: %v", err)
+		return nil, nil, err
+	}
+	if shadow != nil {
+		logHelper.Infof("shadowing employee reads to the %s repository", c.GetShadowRead().GetRepo())
+	}
 
 	// Connect to NATS (optional)
 	var nc *nats.Conn
-	var publisher *EventPublisher
-
-	if c.Nats != nil && c.Nats.Url != "" {
-		nc, err = nats.Connect(c.Nats.Url,
-			nats.MaxReconnects(-1), // Infinite reconnects
-			nats.ReconnectWait(2*time.Second),
-			nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
-				logHelper.Warnf("NATS disconnected: %v", err)
-			}),
-			nats.ReconnectHandler(func(nc *nats.Conn) {
-				logHelper.Infof("NATS reconnected to %s", nc.ConnectedUrl())
-			}),
-		)
+	var publisher biz.EventPublisher
+	messages := eventMessages{clock: clock, ids: ids, enrichers: enrichers, includeAddress: c.GetEventPayload().GetIncludeAddress()}
+
+	if natsConn != nil {
+		nc, err = nats.Connect(natsConn.servers, natsConn.options...)
 		if err != nil {
 			logHelper.Warnf("failed to connect to NATS (continuing without events): %v", err)
 			nc = nil
 		} else {
-			logHelper.Infof("connected to NATS at %s", c.Nats.Url)
+			logHelper.Infof("connected to NATS at %s", nc.ConnectedUrl())
 			// Using versioned subjects (employees.v1.{created,updated,deleted,merged})
-			publisher = NewEventPublisher(nc, "", logger)
+			natsPublisher := NewEventPublisher(nc, "", logger)
+
+			if c.Nats.Jetstream {
+				js, streamCfg, err := setupJetStream(nc, c.Nats)
+				if err != nil {
+					logHelper.Warnf("failed to set up JetStream stream %s (falling back to core NATS): %v", streamCfg.Name, err)
+				} else {
+					logHelper.Infof("publishing through JetStream stream %s", streamCfg.Name)
+					natsPublisher = NewJetStreamEventPublisher(nc, js, publishTimeout(c.Nats), logger)
+				}
+			}
+			natsPublisher.configurePayload(c.Nats, encryption, signer, obs)
+			natsPublisher.messages = messages
+			natsPublisher.budget = budget
+			publisher = natsPublisher
 		}
-	} else {
+	} else if sink == nil {
 		logHelper.Warn("NATS not configured, events disabled")
 	}
 
+	// Without NATS events go to the fallback sink, if one is configured
+	if sink != nil {
+		if publisher == nil {
+			logHelper.Infof("publishing events to %s", sink)
+			sinkPublisher := NewSinkEventPublisher(sink, logger)
+			sinkPublisher.messages = messages
+			publisher = sinkPublisher
+		} else {
+			sink.close()
+			sink = nil
+		}
+	}
+
+	// Redis cache for employee lookups (optional)
+	cache := newEmployeeCache(c.Redis)
+	if cache != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := cache.rdb.Ping(ctx).Err(); err != nil {
+			// Lookups fall back to the database while Redis is unavailable
+			logHelper.Warnf("failed to reach Redis at %s (cache will retry): %v", c.Redis.Addr, err)
+		} else {
+			logHelper.Infof("employee cache enabled at %s", c.Redis.Addr)
+		}
+		cancel()
+	}
+
 	cleanup := func() {
+		if cache != nil {
+			if err := cache.close(); err != nil {
+				logHelper.Errorf("failed to close Redis client: %v", err)
+			}
+		}
 		if nc != nil {
 			nc.Close()
 			logHelper.Info("NATS connection closed")
 		}
+		if sink != nil {
+			if err := sink.close(); err != nil {
+				logHelper.Errorf("failed to close event sink: %v", err)
+			}
+		}
+		if shadow != nil && shadow.close != nil {
+			if err := shadow.close(); err != nil {
+				logHelper.Errorf("failed to close shadow database: %v", err)
+			}
+		}
 
 		sqlDB, err := db.DB()
 		if err != nil {
@@ -78,7 +211,27 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 		logHelper.Info("closing the data resources")
 	}
 
-	return &Data{db: db, nc: nc, publisher: publisher}, cleanup, nil
+	authFailures := newAuthFailureEvents(c.GetAuthFailureEvents(), nc, signer, obs, clock, logger)
+
+	return &Data{db: db, pool: pool, dialect: dialect, nc: nc, publisher: publisher, cache: cache, shadow: shadow, clock: clock, ids: ids, signer: signer, signingKeys: signingKeys, authFailures: authFailures}, cleanup, nil
+}
+
+// now returns the current time of the injected clock, or the wall clock for
+// a Data built without one
+func (d *Data) now() time.Time {
+	if d.clock == nil {
+		return time.Now()
+	}
+	return d.clock.Now()
+}
+
+// newID returns a new ID from the injected generator, or a random UUID for a
+// Data built without one
+func (d *Data) newID() uuid.UUID {
+	if d.ids == nil {
+		return uuid.New()
+	}
+	return d.ids.NewID()
 }
 
 // GetDB returns the database connection for health checking
@@ -86,6 +239,11 @@ func (d *Data) GetDB() *gorm.DB {
 	return d.db
 }
 
+// EventSigningKeys returns the public keys consumers verify events with
+func (d *Data) EventSigningKeys() *eventcrypto.JWKS {
+	return d.signingKeys
+}
+
 // GetNATS returns the NATS connection for health checking
 func (d *Data) GetNATS() *nats.Conn {
 	return d.nc
@@ -0,0 +1,139 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dbPool is the connection pool behind the gorm DB of the repositories. It
+// can be replaced while the service runs, e.g. after a database failover or
+// a credential rotation, without the repositories noticing: statements and
+// transactions started after a rotation use the new pool.
+type dbPool struct {
+	db   atomic.Pointer[sql.DB]
+	open func(source string) (*sql.DB, error)
+
+	// mu serializes rotations
+	mu     sync.Mutex
+	source string
+
+	// Limits of the pool, 0 for the database/sql defaults
+	maxOpenConns    int
+	connMaxLifetime time.Duration
+	// maxIdleConns is the configured idle limit, raised by WarmPool
+	maxIdleConns atomic.Int32
+}
+
+// newDBPool connects to the database at source with the pool limits of c
+func newDBPool(source string, c *conf.Data_Database) (*dbPool, error) {
+	p := &dbPool{
+		open:            openSQLDB,
+		source:          source,
+		maxOpenConns:    int(c.GetMaxOpenConns()),
+		connMaxLifetime: c.GetConnMaxLifetime().AsDuration(),
+	}
+	p.maxIdleConns.Store(c.GetMaxIdleConns())
+	db, err := p.open(source)
+	if err != nil {
+		return nil, err
+	}
+	p.configure(db)
+	p.db.Store(db)
+	return p, nil
+}
+
+// openSQLDB connects to the database at source the way the gorm driver does
+// and checks the connection
+func openSQLDB(source string) (*sql.DB, error) {
+	db, err := gorm.Open(postgres.Open(source), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	return db.DB()
+}
+
+// rotate replaces the pool with one connected to source, or to the current
+// source when empty. The replaced pool is closed once its connections are
+// returned, and the number of its open connections is returned.
+func (p *dbPool) rotate(ctx context.Context, source string) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if source == "" {
+		source = p.source
+	}
+	next, err := p.open(source)
+	if err != nil {
+		return 0, err
+	}
+	if err := next.PingContext(ctx); err != nil {
+		_ = next.Close()
+		return 0, err
+	}
+
+	prev := p.db.Load()
+	stats := prev.Stats()
+	p.configure(next)
+	p.db.Store(next)
+	p.source = source
+
+	// Close waits for the queries running on the replaced pool
+	go func() { _ = prev.Close() }()
+	return stats.OpenConnections, nil
+}
+
+// configure applies the limits of the pool to db
+func (p *dbPool) configure(db *sql.DB) {
+	if p.maxOpenConns > 0 {
+		db.SetMaxOpenConns(p.maxOpenConns)
+	}
+	if n := p.maxIdleConns.Load(); n > 0 {
+		db.SetMaxIdleConns(int(n))
+	}
+	if p.connMaxLifetime > 0 {
+		db.SetConnMaxLifetime(p.connMaxLifetime)
+	}
+}
+
+// stats returns the statistics of the current pool
+func (p *dbPool) stats() sql.DBStats {
+	return p.db.Load().Stats()
+}
+
+// PrepareContext implements gorm.ConnPool
+func (p *dbPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return p.db.Load().PrepareContext(ctx, query)
+}
+
+// ExecContext implements gorm.ConnPool
+func (p *dbPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return p.db.Load().ExecContext(ctx, query, args...)
+}
+
+// QueryContext implements gorm.ConnPool
+func (p *dbPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return p.db.Load().QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext implements gorm.ConnPool
+func (p *dbPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return p.db.Load().QueryRowContext(ctx, query, args...)
+}
+
+// BeginTx implements gorm.TxBeginner
+func (p *dbPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return p.db.Load().BeginTx(ctx, opts)
+}
+
+// GetDBConn implements gorm.GetDBConnector, returning the current pool
+func (p *dbPool) GetDBConn() (*sql.DB, error) {
+	return p.db.Load(), nil
+}
@@ -0,0 +1,55 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestDBPool_Limits(t *testing.T) {
+	first, _, err := sqlmock.New()
+	require.NoError(t, err)
+	second, _, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = second.Close() })
+
+	dbs := []*sql.DB{first, second}
+	open := func(string) (*sql.DB, error) {
+		db := dbs[0]
+		dbs = dbs[1:]
+		return db, nil
+	}
+	c := &conf.Data_Database{MaxOpenConns: 10, MaxIdleConns: 4, ConnMaxLifetime: durationpb.New(30 * time.Minute)}
+
+	pool := &dbPool{open: open, maxOpenConns: int(c.GetMaxOpenConns()), connMaxLifetime: c.GetConnMaxLifetime().AsDuration()}
+	pool.maxIdleConns.Store(c.GetMaxIdleConns())
+	db, err := pool.open("")
+	require.NoError(t, err)
+	pool.configure(db)
+	pool.db.Store(db)
+	assert.Equal(t, 10, pool.stats().MaxOpenConnections)
+
+	// The pool replacing it has the same limits
+	_, err = pool.rotate(context.Background(), "host=db")
+	require.NoError(t, err)
+	assert.Same(t, second, pool.db.Load())
+	assert.Equal(t, 10, pool.stats().MaxOpenConnections)
+}
+
+func TestDBPool_DefaultLimits(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	pool := &dbPool{}
+	pool.configure(db)
+	assert.Equal(t, 0, db.Stats().MaxOpenConnections, "unlimited")
+}
@@ -0,0 +1,158 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DepartmentModel is the GORM model for departments
+type DepartmentModel struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TenantID    string    `gorm:"type:varchar(255);not null"`
+	Name        string    `gorm:"type:varchar(100);not null"`
+	Description string    `gorm:"type:text;not null"`
+	CreatedAt   time.Time `gorm:"not null"`
+	UpdatedAt   time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (DepartmentModel) TableName() string {
+	return "employee_departments"
+}
+
+// ToEntity converts DepartmentModel to biz.Department
+func (m *DepartmentModel) ToEntity() *biz.Department {
+	return &biz.Department{
+		ID:          m.ID,
+		TenantID:    m.TenantID,
+		Name:        m.Name,
+		Description: m.Description,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+type departmentRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewDepartmentRepo creates a new department repository.
+func NewDepartmentRepo(data *Data, logger log.Logger) biz.DepartmentRepo {
+	return &departmentRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create stores a new department.
+func (r *departmentRepo) Create(ctx context.Context, department *biz.Department) (*biz.Department, error) {
+	now := r.data.now()
+	model := &DepartmentModel{
+		ID:          department.ID,
+		TenantID:    department.TenantID,
+		Name:        department.Name,
+		Description: department.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
+		if isUniqueViolation(err) {
+			return nil, biz.ErrDepartmentAlreadyExists
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+// Get retrieves a department of a tenant.
+func (r *departmentRepo) Get(ctx context.Context, tenantID string, id uuid.UUID) (*biz.Department, error) {
+	var model DepartmentModel
+	err := r.data.DB(ctx).
+		Where("id = ? AND tenant_id = ?", id, tenantID).
+		First(&model).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, biz.ErrDepartmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+// List returns a page of the tenant's departments ordered by name.
+func (r *departmentRepo) List(ctx context.Context, tenantID string, page, pageSize int32) ([]*biz.Department, int64, error) {
+	query := r.data.DB(ctx).Model(&DepartmentModel{}).Where("tenant_id = ?", tenantID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []DepartmentModel
+	if err := query.
+		Order("lower(name), id").
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	departments := make([]*biz.Department, len(models))
+	for i := range models {
+		departments[i] = models[i].ToEntity()
+	}
+	return departments, total, nil
+}
+
+// Update stores the name and description of a department.
+func (r *departmentRepo) Update(ctx context.Context, department *biz.Department) (*biz.Department, error) {
+	now := r.data.now()
+	result := r.data.DB(ctx).
+		Model(&DepartmentModel{}).
+		Where("id = ? AND tenant_id = ?", department.ID, department.TenantID).
+		Updates(map[string]interface{}{
+			"name":        department.Name,
+			"description": department.Description,
+			"updated_at":  now,
+		})
+	if isUniqueViolation(result.Error) {
+		return nil, biz.ErrDepartmentAlreadyExists
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, biz.ErrDepartmentNotFound
+	}
+
+	updated := *department
+	updated.UpdatedAt = now
+	return &updated, nil
+}
+
+// Delete deletes a department; the foreign key of employees.department_id
+// rejects the delete while employees belong to it.
+func (r *departmentRepo) Delete(ctx context.Context, tenantID string, id uuid.UUID) error {
+	result := r.data.DB(ctx).
+		Where("id = ? AND tenant_id = ?", id, tenantID).
+		Delete(&DepartmentModel{})
+	if isForeignKeyViolation(result.Error) {
+		return biz.ErrDepartmentNotEmpty
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrDepartmentNotFound
+	}
+	return nil
+}
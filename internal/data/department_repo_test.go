@@ -0,0 +1,66 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepartmentRepo_CreateNameTaken(t *testing.T) {
+	d, mock := newMockData(t)
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	d.clock = biz.ClockFunc(func() time.Time { return now })
+	repo := &departmentRepo{data: d}
+	id := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "employee_departments"`).
+		WithArgs(id, "tenant-1", "Engineering", "", now, now).
+		WillReturnError(&pgconn.PgError{Code: "23505"})
+	mock.ExpectRollback()
+
+	_, err := repo.Create(context.Background(), &biz.Department{ID: id, TenantID: "tenant-1", Name: "Engineering"})
+	assert.ErrorIs(t, err, biz.ErrDepartmentAlreadyExists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDepartmentRepo_DeleteNotEmpty(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &departmentRepo{data: d}
+	id := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "employee_departments" WHERE id = \$1 AND tenant_id = \$2`).
+		WithArgs(id, "tenant-1").
+		WillReturnError(&pgconn.PgError{Code: "23503"})
+	mock.ExpectRollback()
+
+	err := repo.Delete(context.Background(), "tenant-1", id)
+	assert.ErrorIs(t, err, biz.ErrDepartmentNotEmpty)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEmployeeRepo_ListByDepartment(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	departmentID := uuid.New()
+
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "employees" WHERE tenant_id = \$1 AND department_id = \$2 AND review_status = \$3`).
+		WithArgs("tenant-1", departmentID, biz.ReviewStatusApproved).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(`SELECT \* FROM "employees" WHERE tenant_id = \$1 AND department_id = \$2 AND review_status = \$3`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	result, err := repo.List(context.Background(), "tenant-1", &biz.ListFilter{Page: 1, PageSize: 20, DepartmentID: &departmentID})
+	require.NoError(t, err)
+	assert.Empty(t, result.Employees)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
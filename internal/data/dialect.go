@@ -0,0 +1,90 @@
+package data
+
+import (
+	"fmt"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dialect is the SQL database the repositories run against. The queries are
+// written for Postgres; dialects speaking its wire protocol and SQL run them
+// as they are, except where a method of dialect says otherwise.
+type dialect string
+
+const (
+	// dialectPostgres is the default
+	dialectPostgres dialect = "postgres"
+	// dialectCockroachDB lacks advisory locks; its transactions are
+	// serializable instead
+	dialectCockroachDB dialect = "cockroachdb"
+)
+
+// newDialect returns the dialect of the configured database driver,
+// Postgres when unset
+func newDialect(driver string) (dialect, error) {
+	switch driver {
+	case "", string(dialectPostgres):
+		return dialectPostgres, nil
+	case string(dialectCockroachDB):
+		return dialectCockroachDB, nil
+	case "mysql":
+		// The repositories rely on jsonb, arrays, RETURNING and partial
+		// indexes, which MySQL has no equivalent of for every query
+		return "", fmt.Errorf("database driver mysql is not supported")
+	default:
+		return "", fmt.Errorf("unknown database driver %q", driver)
+	}
+}
+
+// open connects to the database at source. Both dialects use the pgx
+// driver.
+func (d dialect) open(source string, clock biz.Clock) (*gorm.DB, error) {
+	return gorm.Open(postgres.Open(source), &gorm.Config{NowFunc: clock.Now})
+}
+
+// openPool connects to the database at source like open, behind a pool that
+// can be rotated while the service runs and has the limits of c
+func (d dialect) openPool(source string, c *conf.Data_Database, clock biz.Clock) (*gorm.DB, *dbPool, error) {
+	pool, err := newDBPool(source, c)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: pool}), &gorm.Config{NowFunc: clock.Now})
+	if err != nil {
+		_ = pool.db.Load().Close()
+		return nil, nil, err
+	}
+	return db, pool, nil
+}
+
+// system is the db.system attribute of the spans of the dialect
+func (d dialect) system() attribute.KeyValue {
+	if d == dialectCockroachDB {
+		return semconv.DBSystemCockroachdb
+	}
+	return semconv.DBSystemPostgreSQL
+}
+
+// advisoryLocks reports whether the database has transaction-level advisory
+// locks (pg_advisory_xact_lock). Without them, concurrent transactions that
+// would need one conflict under serializable isolation instead, and all but
+// one fail with a retryable error.
+func (d dialect) advisoryLocks() bool {
+	return d != dialectCockroachDB
+}
+
+// commitOrdered reports whether audit entries record the ID of their
+// transaction (employee_audit.txid) and the database tells the oldest
+// transaction still in progress (pg_snapshot_xmin). Readers following the
+// audit log then only read entries of transactions older than it. Without
+// it, entries are ordered by seq alone and held back for a settle period
+// instead, which must exceed the longest audited transaction.
+func (d dialect) commitOrdered() bool {
+	return d != dialectCockroachDB
+}
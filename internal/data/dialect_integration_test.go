@@ -0,0 +1,163 @@
+//go:build integration
+
+package data
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/migrations"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/cockroachdb"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/google/uuid"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// integrationDatabases are the environment variables holding the connection
+// string of an empty database of each dialect. A dialect whose variable is
+// unset is skipped; docker-compose.test.yml starts both databases.
+var integrationDatabases = map[dialect]string{
+	dialectPostgres:    "TEST_POSTGRES_URL",
+	dialectCockroachDB: "TEST_COCKROACHDB_URL",
+}
+
+// migrateIntegrationDatabase runs the migrations of d up against the database
+// at source, and down again once the test is done
+func migrateIntegrationDatabase(t *testing.T, d dialect, source string) {
+	db, err := sql.Open("pgx", source)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	var driver database.Driver
+	if d == dialectCockroachDB {
+		driver, err = cockroachdb.WithInstance(db, &cockroachdb.Config{})
+	} else {
+		driver, err = postgres.WithInstance(db, &postgres.Config{})
+	}
+	require.NoError(t, err)
+
+	src, err := migrations.NewSource(migrations.FS, string(d))
+	require.NoError(t, err)
+	m, err := migrate.NewWithInstance("migrations", src, string(d), driver)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Up())
+	t.Cleanup(func() { assert.NoError(t, m.Down(), "migrating down") })
+}
+
+// TestDialects_Integration migrates a database of every dialect and runs the
+// queries that differ between dialects, and a sample of those that do not,
+// against it. Run it with make test-integration.
+func TestDialects_Integration(t *testing.T) {
+	for d, env := range integrationDatabases {
+		t.Run(string(d), func(t *testing.T) {
+			source := os.Getenv(env)
+			if source == "" {
+				t.Skipf("%s is not set", env)
+			}
+			migrateIntegrationDatabase(t, d, source)
+
+			db, pool, err := d.openPool(source, &conf.Data_Database{}, biz.NewSystemClock())
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = pool.db.Load().Close() })
+			data := &Data{db: db, pool: pool, dialect: d}
+
+			ctx := context.Background()
+			tenantID := "tenant-" + uuid.NewString()
+
+			employees := &employeeRepo{data: data}
+			created, err := employees.Create(ctx, tenantID, &biz.Employee{
+				Emails:    []string{"Ada.Lovelace@Example.com"},
+				FirstName: "Ada",
+				LastName:  "Lovelace",
+				Tags:      []string{"engineering"},
+			})
+			require.NoError(t, err)
+
+			t.Run("get by email ignores case", func(t *testing.T) {
+				got, err := employees.GetByEmail(ctx, tenantID, "ada.lovelace@example.com")
+				require.NoError(t, err)
+				assert.Equal(t, created.ID, got.ID)
+
+				exists, err := employees.CheckEmailExists(ctx, tenantID, "ADA.LOVELACE@EXAMPLE.COM")
+				require.NoError(t, err)
+				assert.True(t, exists)
+			})
+
+			t.Run("list by name prefix and tag", func(t *testing.T) {
+				result, err := employees.List(ctx, tenantID, &biz.ListFilter{
+					Page:       1,
+					PageSize:   10,
+					NamePrefix: "lov",
+					Tags:       []string{"engineering"},
+				})
+				require.NoError(t, err)
+				require.Len(t, result.Employees, 1)
+				assert.Equal(t, created.ID, result.Employees[0].ID)
+				assert.Equal(t, int64(1), result.Total)
+			})
+
+			t.Run("list audit entries since", func(t *testing.T) {
+				audit := &auditRepo{data: data, settle: time.Millisecond}
+				time.Sleep(audit.settle)
+
+				entries, err := audit.ListSince(ctx, tenantID, biz.AuditPosition{}, 10)
+				require.NoError(t, err)
+				require.NotEmpty(t, entries)
+				assert.Equal(t, created.ID, entries[0].EmployeeID)
+				assert.Equal(t, d.commitOrdered(), entries[0].TxID > 0)
+
+				latest, err := audit.LatestPosition(ctx, tenantID)
+				require.NoError(t, err)
+				assert.Equal(t, entries[len(entries)-1].Position(), latest)
+
+				retained, err := audit.HasEntry(ctx, tenantID, entries[0].Seq)
+				require.NoError(t, err)
+				assert.True(t, retained)
+			})
+
+			t.Run("claim import", func(t *testing.T) {
+				op, _, err := (&importRepo{data: data}).ClaimNext(ctx, time.Minute, 1)
+				require.NoError(t, err)
+				assert.Nil(t, op)
+			})
+
+			t.Run("revoke API key", func(t *testing.T) {
+				keys := &apiKeyRepo{data: data}
+				key, err := keys.Create(ctx, &biz.APIKey{
+					ID:        uuid.New(),
+					TenantID:  tenantID,
+					Service:   "payroll-sync",
+					Roles:     []string{"reader"},
+					Prefix:    "esk_test",
+					CreatedBy: "user-1",
+					CreatedAt: time.Now(),
+				}, "hash-"+uuid.NewString())
+				require.NoError(t, err)
+
+				now := time.Now().UTC().Truncate(time.Microsecond)
+				revoked, err := keys.Revoke(ctx, tenantID, key.ID, now)
+				require.NoError(t, err)
+				require.NotNil(t, revoked.RevokedAt)
+				assert.True(t, now.Equal(*revoked.RevokedAt))
+
+				// Revoking again keeps the first revocation time
+				again, err := keys.Revoke(ctx, tenantID, key.ID, now.Add(time.Hour))
+				require.NoError(t, err)
+				assert.True(t, now.Equal(*again.RevokedAt))
+			})
+
+			require.NoError(t, employees.Delete(ctx, tenantID, created.ID))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDialect(t *testing.T) {
+	tests := []struct {
+		driver  string
+		want    dialect
+		wantErr string
+	}{
+		{driver: "", want: dialectPostgres},
+		{driver: "postgres", want: dialectPostgres},
+		{driver: "cockroachdb", want: dialectCockroachDB},
+		{driver: "mysql", wantErr: "database driver mysql is not supported"},
+		{driver: "sqlite", wantErr: `unknown database driver "sqlite"`},
+	}
+
+	for _, tt := range tests {
+		got, err := newDialect(tt.driver)
+		if tt.wantErr != "" {
+			assert.EqualError(t, err, tt.wantErr, tt.driver)
+			continue
+		}
+		require.NoError(t, err, tt.driver)
+		assert.Equal(t, tt.want, got)
+	}
+}
+
+// TestDialects checks the SQL of the queries that differ between dialects,
+// and a sample of those that do not, for every supported dialect against a
+// mock; TestDialects_Integration runs them against the databases
+func TestDialects(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	for _, d := range []dialect{dialectPostgres, dialectCockroachDB} {
+		t.Run(string(d), func(t *testing.T) {
+			t.Run("claim import", func(t *testing.T) {
+				data, mock := newMockData(t)
+				data.dialect = d
+				data.clock = biz.ClockFunc(func() time.Time { return now })
+
+				mock.ExpectBegin()
+				if d.advisoryLocks() {
+					mock.ExpectExec(`SELECT pg_advisory_xact_lock\(\$1\)`).
+						WithArgs(importClaimLock).
+						WillReturnResult(sqlmock.NewResult(0, 0))
+				}
+				mock.ExpectQuery(`UPDATE employee_imports SET status = 'running'.* FOR UPDATE SKIP LOCKED\s+\)\s+RETURNING \*`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectCommit()
+
+				op, _, err := (&importRepo{data: data}).ClaimNext(context.Background(), time.Minute, 1)
+				require.NoError(t, err)
+				assert.Nil(t, op)
+				assert.NoError(t, mock.ExpectationsWereMet())
+			})
+
+			t.Run("revoke API key", func(t *testing.T) {
+				data, mock := newMockData(t)
+				data.dialect = d
+				id := uuid.New()
+
+				mock.ExpectQuery(`UPDATE employee_api_keys SET revoked_at = COALESCE\(revoked_at, \$1\).*RETURNING \*`).
+					WithArgs(now, id, "tenant-1").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id"}).AddRow(id, "tenant-1"))
+
+				key, err := (&apiKeyRepo{data: data}).Revoke(context.Background(), "tenant-1", id, now)
+				require.NoError(t, err)
+				assert.Equal(t, id, key.ID)
+				assert.NoError(t, mock.ExpectationsWereMet())
+			})
+		})
+	}
+}
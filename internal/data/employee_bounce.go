@@ -0,0 +1,50 @@
+package data
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/google/uuid"
+)
+
+// markUndeliverableQuery marks an email of the tenant undeliverable unless it
+// already is
+const markUndeliverableQuery = `
+UPDATE employee_emails SET undeliverable_reason = ?, undeliverable_at = ?
+WHERE tenant_id = ? AND email = ? AND undeliverable_at IS NULL
+RETURNING employee_id`
+
+// MarkEmailsUndeliverable marks bounced emails one by one, so that a bounce
+// reported twice in a batch marks its email once. Like FlagStale, marking
+// leaves updated_at and the version unchanged and is not audited.
+func (r *employeeRepo) MarkEmailsUndeliverable(ctx context.Context, tenantID string, bounces []biz.EmailBounce) ([]string, []*biz.Employee, error) {
+	var marked []string
+	var ids []uuid.UUID
+	for _, bounce := range bounces {
+		var rows []struct {
+			EmployeeID uuid.UUID
+		}
+		if err := r.data.DB(ctx).
+			Raw(markUndeliverableQuery, bounce.Reason, bounce.OccurredAt.UTC(), tenantID, bounce.Email).
+			Scan(&rows).Error; err != nil {
+			return nil, nil, err
+		}
+		for _, row := range rows {
+			marked = append(marked, bounce.Email)
+			if !slices.Contains(ids, row.EmployeeID) {
+				ids = append(ids, row.EmployeeID)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return marked, nil, nil
+	}
+
+	employees, err := r.GetByIDs(ctx, tenantID, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	return marked, employees, nil
+}
@@ -0,0 +1,290 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/observability"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// defaultCacheTTL bounds how long a cached employee can outlive a missed invalidation
+	defaultCacheTTL = 5 * time.Minute
+
+	cacheKeyPrefix = "employees:"
+
+	cacheResultHit   = "hit"
+	cacheResultMiss  = "miss"
+	cacheResultError = "error"
+)
+
+// employeeCache stores employees in Redis.
+//
+// Employees are stored once under their ID; email keys only map an email to
+// the employee ID. Invalidating the ID key is therefore enough after any
+// change: a lookup by email that resolves to a missing employee, or to one
+// that no longer owns the email, is treated as a miss.
+type employeeCache struct {
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+// newEmployeeCache creates the cache, or returns nil when no Redis address is configured
+func newEmployeeCache(c *conf.Data_Redis) *employeeCache {
+	if c == nil || c.Addr == "" {
+		return nil
+	}
+
+	ttl := defaultCacheTTL
+	if c.Ttl != nil && c.Ttl.AsDuration() > 0 {
+		ttl = c.Ttl.AsDuration()
+	}
+
+	return &employeeCache{
+		rdb: redis.NewClient(&redis.Options{
+			Addr:     c.Addr,
+			Password: c.Password,
+			DB:       int(c.Db),
+		}),
+		ttl: ttl,
+	}
+}
+
+// tenantKeyPrefix returns the prefix of all keys of a tenant
+func tenantKeyPrefix(tenantID string) string {
+	return cacheKeyPrefix + tenantID + ":"
+}
+
+func idCacheKey(tenantID string, id uuid.UUID) string {
+	return tenantKeyPrefix(tenantID) + "id:" + id.String()
+}
+
+// emailCacheKey ignores the case of email, like employee_emails
+func emailCacheKey(tenantID, email string) string {
+	return tenantKeyPrefix(tenantID) + "email:" + strings.ToLower(email)
+}
+
+// get returns the cached employee, or nil on a miss
+func (c *employeeCache) get(ctx context.Context, tenantID string, id uuid.UUID) (*biz.Employee, error) {
+	raw, err := c.rdb.Get(ctx, idCacheKey(tenantID, id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	employee, err := unmarshalSnapshot(tenantID, raw)
+	if err != nil || employee == nil || employee.Version == 0 {
+		// Entries cached before employees had versions are misses
+		return nil, err
+	}
+	return employee, nil
+}
+
+// getByEmail returns the cached employee owning the email, or nil on a miss
+func (c *employeeCache) getByEmail(ctx context.Context, tenantID, email string) (*biz.Employee, error) {
+	raw, err := c.rdb.Get(ctx, emailCacheKey(tenantID, email)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, nil
+	}
+
+	employee, err := c.get(ctx, tenantID, id)
+	if err != nil || employee == nil {
+		return nil, err
+	}
+
+	// The email may have moved to another employee since it was cached
+	if !slices.ContainsFunc(employee.Emails, func(e string) bool { return strings.EqualFold(e, email) }) {
+		return nil, nil
+	}
+	return employee, nil
+}
+
+// set stores the employee and maps each of its emails to it
+func (c *employeeCache) set(ctx context.Context, tenantID string, employee *biz.Employee) error {
+	raw, err := marshalSnapshot(employee)
+	if err != nil {
+		return err
+	}
+
+	pipe := c.rdb.Pipeline()
+	pipe.Set(ctx, idCacheKey(tenantID, employee.ID), raw, c.ttl)
+	for _, email := range employee.Emails {
+		pipe.Set(ctx, emailCacheKey(tenantID, email), employee.ID.String(), c.ttl)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// invalidate removes the given employees from the cache
+func (c *employeeCache) invalidate(ctx context.Context, tenantID string, ids ...uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = idCacheKey(tenantID, id)
+	}
+	return c.rdb.Del(ctx, keys...).Err()
+}
+
+// invalidateTenant removes all cached entries of a tenant
+func (c *employeeCache) invalidateTenant(ctx context.Context, tenantID string) error {
+	iter := c.rdb.Scan(ctx, 0, escapeGlob(tenantKeyPrefix(tenantID))+"*", 1000).Iterator()
+
+	var keys []string
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.rdb.Del(ctx, keys...).Err()
+}
+
+// flush removes the cached entries of all tenants and returns how many were
+// removed
+func (c *employeeCache) flush(ctx context.Context) (int64, error) {
+	var flushed int64
+	var cursor uint64
+	for {
+		keys, next, err := c.rdb.Scan(ctx, cursor, cacheKeyPrefix+"*", 1000).Result()
+		if err != nil {
+			return flushed, err
+		}
+		if len(keys) > 0 {
+			n, err := c.rdb.Del(ctx, keys...).Result()
+			flushed += n
+			if err != nil {
+				return flushed, err
+			}
+		}
+		if next == 0 {
+			return flushed, nil
+		}
+		cursor = next
+	}
+}
+
+// escapeGlob escapes the characters Redis treats specially in MATCH patterns
+func escapeGlob(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// close closes the Redis client
+func (c *employeeCache) close() error {
+	return c.rdb.Close()
+}
+
+// cachedEmployeeRepo is a read-through cache in front of an EmployeeRepo.
+// Cache failures are logged and fall back to the wrapped repository. Changed
+// employees are evicted by the cacheInvalidator event subscriber.
+type cachedEmployeeRepo struct {
+	biz.EmployeeRepo
+
+	cache *employeeCache
+	obs   *observability.Observability
+	log   *log.Helper
+}
+
+// newCachedEmployeeRepo wraps repo with the cache
+func newCachedEmployeeRepo(repo biz.EmployeeRepo, cache *employeeCache, obs *observability.Observability, logger log.Logger) biz.EmployeeRepo {
+	return &cachedEmployeeRepo{
+		EmployeeRepo: repo,
+		cache:        cache,
+		obs:          obs,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// record counts the lookup result and logs cache errors
+func (r *cachedEmployeeRepo) record(ctx context.Context, operation string, hit bool, err error) {
+	switch {
+	case err != nil:
+		r.log.WithContext(ctx).Warnf("employee cache %s failed: %v", operation, err)
+		r.obs.RecordCacheLookup(operation, cacheResultError)
+	case hit:
+		r.obs.RecordCacheLookup(operation, cacheResultHit)
+	default:
+		r.obs.RecordCacheLookup(operation, cacheResultMiss)
+	}
+}
+
+// fill stores an employee loaded from the wrapped repository
+func (r *cachedEmployeeRepo) fill(ctx context.Context, tenantID string, employee *biz.Employee) {
+	if err := r.cache.set(ctx, tenantID, employee); err != nil {
+		r.log.WithContext(ctx).Warnf("employee cache set failed: %v", err)
+	}
+}
+
+// GetByID retrieves an employee by ID, from the cache when possible. Inside
+// a transaction the cache is bypassed: the transaction must see its own
+// writes, and rows it has not committed yet must not be cached.
+func (r *cachedEmployeeRepo) GetByID(ctx context.Context, tenantID string, id uuid.UUID) (*biz.Employee, error) {
+	if inTransaction(ctx) {
+		return r.EmployeeRepo.GetByID(ctx, tenantID, id)
+	}
+
+	cached, err := r.cache.get(ctx, tenantID, id)
+	r.record(ctx, "get_by_id", cached != nil, err)
+	if cached != nil {
+		return cached, nil
+	}
+
+	employee, err := r.EmployeeRepo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	r.fill(ctx, tenantID, employee)
+	return employee, nil
+}
+
+// GetByEmail retrieves an employee by email, from the cache when possible,
+// bypassing it inside a transaction like GetByID.
+func (r *cachedEmployeeRepo) GetByEmail(ctx context.Context, tenantID string, email string) (*biz.Employee, error) {
+	if inTransaction(ctx) {
+		return r.EmployeeRepo.GetByEmail(ctx, tenantID, email)
+	}
+
+	cached, err := r.cache.getByEmail(ctx, tenantID, email)
+	r.record(ctx, "get_by_email", cached != nil, err)
+	if cached != nil {
+		return cached, nil
+	}
+
+	employee, err := r.EmployeeRepo.GetByEmail(ctx, tenantID, email)
+	if err != nil {
+		return nil, err
+	}
+	r.fill(ctx, tenantID, employee)
+	return employee, nil
+}
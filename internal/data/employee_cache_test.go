@@ -0,0 +1,248 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// stubEmployeeRepo serves employees from a map and counts lookups
+type stubEmployeeRepo struct {
+	biz.EmployeeRepo
+
+	employees map[uuid.UUID]*biz.Employee
+	lookups   int
+}
+
+func (r *stubEmployeeRepo) GetByID(_ context.Context, _ string, id uuid.UUID) (*biz.Employee, error) {
+	r.lookups++
+	if e, ok := r.employees[id]; ok {
+		return e, nil
+	}
+	return nil, biz.ErrEmployeeNotFound
+}
+
+func (r *stubEmployeeRepo) GetByEmail(_ context.Context, _ string, email string) (*biz.Employee, error) {
+	r.lookups++
+	for _, e := range r.employees {
+		for _, em := range e.Emails {
+			if em == email {
+				return e, nil
+			}
+		}
+	}
+	return nil, biz.ErrEmployeeNotFound
+}
+
+func (r *stubEmployeeRepo) Update(_ context.Context, _ string, employee *biz.Employee) (*biz.Employee, error) {
+	r.employees[employee.ID] = employee
+	return employee, nil
+}
+
+func (r *stubEmployeeRepo) Delete(_ context.Context, _ string, id uuid.UUID) error {
+	delete(r.employees, id)
+	return nil
+}
+
+func (r *stubEmployeeRepo) DeleteAll(_ context.Context, _ string) (int64, error) {
+	n := int64(len(r.employees))
+	r.employees = map[uuid.UUID]*biz.Employee{}
+	return n, nil
+}
+
+func newTestCachedRepo(t *testing.T, employees ...*biz.Employee) (biz.EmployeeRepo, *stubEmployeeRepo) {
+	mr := miniredis.RunT(t)
+	cache := newEmployeeCache(&conf.Data_Redis{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = cache.close() })
+
+	stub := &stubEmployeeRepo{employees: map[uuid.UUID]*biz.Employee{}}
+	for _, e := range employees {
+		stub.employees[e.ID] = e
+	}
+	return newCachedEmployeeRepo(stub, cache, nil, log.NewStdLogger(io.Discard)), stub
+}
+
+func testEmployee(emails ...string) *biz.Employee {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	return &biz.Employee{
+		ID:           uuid.New(),
+		TenantID:     "tenant-1",
+		Emails:       emails,
+		FirstName:    "Jane",
+		LastName:     "Doe",
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		Version:      1,
+		ReviewStatus: biz.ReviewStatusApproved,
+	}
+}
+
+func TestNewEmployeeCache(t *testing.T) {
+	assert.Nil(t, newEmployeeCache(nil))
+	assert.Nil(t, newEmployeeCache(&conf.Data_Redis{}))
+
+	cache := newEmployeeCache(&conf.Data_Redis{Addr: "localhost:6379"})
+	require.NotNil(t, cache)
+	assert.Equal(t, defaultCacheTTL, cache.ttl)
+	_ = cache.close()
+}
+
+func TestCachedEmployeeRepo_ReadThrough(t *testing.T) {
+	ctx := context.Background()
+	e := testEmployee("jane@example.com", "j.doe@example.com")
+	repo, stub := newTestCachedRepo(t, e)
+
+	got, err := repo.GetByID(ctx, "tenant-1", e.ID)
+	require.NoError(t, err)
+	assert.Equal(t, e, got)
+	assert.Equal(t, 1, stub.lookups)
+
+	// Served from the cache, by ID and by any of the emails
+	got, err = repo.GetByID(ctx, "tenant-1", e.ID)
+	require.NoError(t, err)
+	assert.Equal(t, e, got)
+	got, err = repo.GetByEmail(ctx, "tenant-1", "j.doe@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, e, got)
+	got, err = repo.GetByEmail(ctx, "tenant-1", "J.Doe@Example.com")
+	require.NoError(t, err)
+	assert.Equal(t, e, got, "emails are cached ignoring case")
+	assert.Equal(t, 1, stub.lookups)
+
+	// Entries are scoped to the tenant
+	_, err = repo.GetByID(ctx, "tenant-2", e.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stub.lookups)
+}
+
+func TestCachedEmployeeRepo_InTransaction(t *testing.T) {
+	e := testEmployee("jane@example.com")
+	repo, stub := newTestCachedRepo(t, e)
+	ctx := context.WithValue(context.Background(), txKey{}, &gorm.DB{})
+
+	// Transactions read the database and fill nothing
+	for range 2 {
+		_, err := repo.GetByID(ctx, "tenant-1", e.ID)
+		require.NoError(t, err)
+		_, err = repo.GetByEmail(ctx, "tenant-1", "jane@example.com")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 4, stub.lookups)
+
+	_, err := repo.GetByID(context.Background(), "tenant-1", e.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 5, stub.lookups, "uncached before the first read outside a transaction")
+}
+
+// invalidate hands event to the cache invalidator of repo
+func invalidate(t *testing.T, repo biz.EmployeeRepo, event *biz.DomainEvent) {
+	t.Helper()
+	inv := &cacheInvalidator{cache: repo.(*cachedEmployeeRepo).cache}
+	require.NoError(t, inv.HandleEvent(context.Background(), event))
+}
+
+func TestCacheInvalidator(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("update", func(t *testing.T) {
+		e := testEmployee("jane@example.com")
+		repo, stub := newTestCachedRepo(t, e)
+		_, _ = repo.GetByEmail(ctx, "tenant-1", "jane@example.com")
+
+		updated := *e
+		updated.Emails = []string{"jane.doe@example.com"}
+		_, err := repo.Update(ctx, "tenant-1", &updated)
+		require.NoError(t, err)
+		invalidate(t, repo, &biz.DomainEvent{Type: biz.EventEmployeeUpdated, TenantID: "tenant-1", Employee: &updated})
+
+		// The removed email no longer resolves through the stale mapping
+		_, err = repo.GetByEmail(ctx, "tenant-1", "jane@example.com")
+		assert.Equal(t, biz.ErrEmployeeNotFound, err)
+		got, err := repo.GetByID(ctx, "tenant-1", e.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"jane.doe@example.com"}, got.Emails)
+		assert.Equal(t, 3, stub.lookups)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		e := testEmployee("jane@example.com")
+		repo, _ := newTestCachedRepo(t, e)
+		_, _ = repo.GetByID(ctx, "tenant-1", e.ID)
+
+		require.NoError(t, repo.Delete(ctx, "tenant-1", e.ID))
+		invalidate(t, repo, &biz.DomainEvent{Type: biz.EventEmployeeDeleted, TenantID: "tenant-1", Employee: e})
+
+		_, err := repo.GetByID(ctx, "tenant-1", e.ID)
+		assert.Equal(t, biz.ErrEmployeeNotFound, err)
+		_, err = repo.GetByEmail(ctx, "tenant-1", "jane@example.com")
+		assert.Equal(t, biz.ErrEmployeeNotFound, err)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		a, b := testEmployee("a@example.com"), testEmployee("b@example.com")
+		repo, stub := newTestCachedRepo(t, a, b)
+		_, _ = repo.GetByID(ctx, "tenant-1", a.ID)
+		_, _ = repo.GetByID(ctx, "tenant-1", b.ID)
+
+		merged := *a
+		merged.Emails = []string{"a@example.com", "b@example.com"}
+		stub.employees[a.ID] = &merged
+		delete(stub.employees, b.ID)
+		invalidate(t, repo, &biz.DomainEvent{
+			Type:     biz.EventEmployeeMerged,
+			TenantID: "tenant-1",
+			Employee: &merged,
+			Merge:    &biz.Merge{Primary: &merged, Secondary: b},
+		})
+
+		got, err := repo.GetByEmail(ctx, "tenant-1", "b@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, a.ID, got.ID)
+		_, err = repo.GetByID(ctx, "tenant-1", b.ID)
+		assert.Equal(t, biz.ErrEmployeeNotFound, err)
+	})
+
+	t.Run("delete all", func(t *testing.T) {
+		a, b := testEmployee("a@example.com"), testEmployee("b@example.com")
+		repo, _ := newTestCachedRepo(t, a, b)
+		_, _ = repo.GetByID(ctx, "tenant-1", a.ID)
+		_, _ = repo.GetByID(ctx, "tenant-1", b.ID)
+
+		_, err := repo.DeleteAll(ctx, "tenant-1")
+		require.NoError(t, err)
+		invalidate(t, repo, &biz.DomainEvent{Type: biz.EventTenantPurged, TenantID: "tenant-1"})
+
+		_, err = repo.GetByID(ctx, "tenant-1", a.ID)
+		assert.Equal(t, biz.ErrEmployeeNotFound, err)
+		_, err = repo.GetByEmail(ctx, "tenant-1", "b@example.com")
+		assert.Equal(t, biz.ErrEmployeeNotFound, err)
+	})
+}
+
+func TestCachedEmployeeRepo_RedisDown(t *testing.T) {
+	ctx := context.Background()
+	e := testEmployee("jane@example.com")
+	repo, stub := newTestCachedRepo(t, e)
+	_ = repo.(*cachedEmployeeRepo).cache.close()
+
+	// Lookups fall back to the repository
+	got, err := repo.GetByID(ctx, "tenant-1", e.ID)
+	require.NoError(t, err)
+	assert.Equal(t, e, got)
+	assert.Equal(t, 1, stub.lookups)
+}
+
+func TestEscapeGlob(t *testing.T) {
+	assert.Equal(t, `employees:a\*b\?c\[d\]:`, escapeGlob("employees:a*b?c[d]:"))
+}
@@ -0,0 +1,196 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/lib/pq"
+)
+
+// streamQuery selects employees together with their emails, undeliverable
+// emails, phone numbers and external IDs aggregated per row, so that each employee is complete after reading a
+// single row.
+const streamQuery = `
+SELECT e.id, e.tenant_id, e.first_name, e.last_name, e.created_at, e.updated_at, e.version, e.review_status, e.department_id, e.manager_id, e.title, e.tags,
+       e.address_line1, e.address_line2, e.address_city, e.address_region, e.address_postal_code, e.address_country, e.photo_key, e.deactivated_at, e.stale_at,
+       COALESCE((SELECT json_agg(ee.email ORDER BY ee.is_primary DESC, ee.created_at, ee.id) FROM employee_emails ee WHERE ee.employee_id = e.id), '[]'::json) AS emails,
+       COALESCE((SELECT ee.email FROM employee_emails ee WHERE ee.employee_id = e.id AND ee.is_primary), '') AS primary_email,
+       COALESCE((SELECT json_agg(json_build_object('email', ee.email, 'reason', ee.undeliverable_reason, 'since', ee.undeliverable_at AT TIME ZONE 'UTC') ORDER BY ee.is_primary DESC, ee.created_at, ee.id) FROM employee_emails ee WHERE ee.employee_id = e.id AND ee.undeliverable_at IS NOT NULL), '[]'::json) AS undeliverable_emails,
+       COALESCE((SELECT json_agg(json_build_object('type', ep.type, 'number', ep.number) ORDER BY ep.position) FROM employee_phone_numbers ep WHERE ep.employee_id = e.id), '[]'::json) AS phone_numbers,
+       COALESCE((SELECT json_object_agg(ex.system, ex.external_id) FROM employee_external_ids ex WHERE ex.employee_id = e.id), '{}'::json) AS external_ids
+FROM employees e
+WHERE `
+
+// Stream returns an iterator over the employees of the tenant ordered by
+// (created_at, id). Rows are read from the database cursor as the iterator
+// advances, holding one connection until the iterator is closed.
+func (r *employeeRepo) Stream(ctx context.Context, tenantID string, filter *biz.StreamFilter) (biz.EmployeeIterator, error) {
+	// Employees pending review are never streamed
+	conditions := []string{"e.tenant_id = ?", "e.review_status = ?"}
+	args := []interface{}{tenantID, biz.ReviewStatusApproved}
+
+	if filter.CreatedAfter != nil {
+		conditions = append(conditions, "e.created_at >= ?")
+		args = append(args, *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		conditions = append(conditions, "e.created_at <= ?")
+		args = append(args, *filter.CreatedBefore)
+	}
+	if filter.UpdatedSince != nil {
+		conditions = append(conditions, "e.updated_at >= ?")
+		args = append(args, *filter.UpdatedSince)
+	}
+	if filter.ActiveOnly {
+		conditions = append(conditions, "e.deactivated_at IS NULL")
+	}
+	// The stale flag lapses once the employee changes, see biz.Employee.IsStale
+	if filter.Stale != nil {
+		if *filter.Stale {
+			conditions = append(conditions, "e.stale_at >= e.updated_at")
+		} else {
+			conditions = append(conditions, "(e.stale_at IS NULL OR e.stale_at < e.updated_at)")
+		}
+	}
+	// The name and email conditions are those of filterEmployees, served by
+	// the same indexes
+	if filter.NamePrefix != "" {
+		prefix := escapeLike(filter.NamePrefix) + "%"
+		if strings.Contains(filter.NamePrefix, " ") {
+			conditions = append(conditions, "lower(e.first_name || ' ' || e.last_name) LIKE ?")
+			args = append(args, prefix)
+		} else {
+			conditions = append(conditions, "(lower(e.first_name) LIKE ? OR lower(e.last_name) LIKE ?)")
+			args = append(args, prefix, prefix)
+		}
+	}
+	if filter.EmailDomain != "" {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM employee_emails ee WHERE ee.employee_id = e.id AND ee.tenant_id = ? AND lower(split_part(ee.email, '@', 2)) = ?)")
+		args = append(args, tenantID, filter.EmailDomain)
+	}
+	if filter.EmailContains != "" {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM employee_emails ee WHERE ee.employee_id = e.id AND ee.tenant_id = ? AND lower(ee.email) LIKE ?)")
+		args = append(args, tenantID, "%"+escapeLike(filter.EmailContains)+"%")
+	}
+	if filter.DepartmentID != nil {
+		conditions = append(conditions, "e.department_id = ?")
+		args = append(args, *filter.DepartmentID)
+	}
+	if len(filter.Tags) > 0 {
+		conditions = append(conditions, "e.tags @> ?")
+		args = append(args, pq.StringArray(filter.Tags))
+	}
+	if filter.After != nil {
+		conditions = append(conditions, "(e.created_at, e.id) > (?, ?)")
+		args = append(args, filter.After.CreatedAt, filter.After.ID)
+	}
+
+	query := streamQuery + strings.Join(conditions, " AND ") + " ORDER BY e.created_at, e.id"
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := r.data.DB(ctx).Raw(query, args...).Rows()
+	if err != nil {
+		return nil, err
+	}
+
+	return &employeeIterator{rows: rows}, nil
+}
+
+// employeeIterator implements biz.EmployeeIterator on top of sql.Rows
+type employeeIterator struct {
+	rows    *sql.Rows
+	current *biz.Employee
+	err     error
+}
+
+// Next advances to the next employee, returning false when the stream is
+// exhausted or failed.
+func (it *employeeIterator) Next() bool {
+	if it.err != nil || !it.rows.Next() {
+		it.current = nil
+		return false
+	}
+
+	var (
+		e             biz.Employee
+		emails        []byte
+		undeliverable []byte
+		phones        []byte
+		externalIDs   []byte
+		addr          addressColumns
+	)
+	if err := it.rows.Scan(&e.ID, &e.TenantID, &e.FirstName, &e.LastName, &e.CreatedAt, &e.UpdatedAt, &e.Version, &e.ReviewStatus, &e.DepartmentID, &e.ManagerID, &e.Title, (*tagArray)(&e.Tags),
+		&addr.Line1, &addr.Line2, &addr.City, &addr.Region, &addr.PostalCode, &addr.Country, &e.PhotoKey, &e.DeactivatedAt, &e.StaleAt, &emails, &e.PrimaryEmail, &undeliverable, &phones, &externalIDs); err != nil {
+		it.err = err
+		it.current = nil
+		return false
+	}
+	if err := json.Unmarshal(emails, &e.Emails); err != nil {
+		it.err = err
+		it.current = nil
+		return false
+	}
+	if err := json.Unmarshal(undeliverable, &e.UndeliverableEmails); err != nil {
+		it.err = err
+		it.current = nil
+		return false
+	}
+	if len(e.UndeliverableEmails) == 0 {
+		e.UndeliverableEmails = nil
+	}
+	var snapshots []phoneNumberSnapshot
+	if err := json.Unmarshal(phones, &snapshots); err != nil {
+		it.err = err
+		it.current = nil
+		return false
+	}
+	if len(snapshots) > 0 {
+		e.PhoneNumbers = fromPhoneNumberSnapshots(snapshots)
+	}
+	if err := json.Unmarshal(externalIDs, &e.ExternalIDs); err != nil {
+		it.err = err
+		it.current = nil
+		return false
+	}
+	if len(e.ExternalIDs) == 0 {
+		e.ExternalIDs = nil
+	}
+	e.Address = addr.toEntity()
+
+	it.current = &e
+	return true
+}
+
+// Employee returns the employee at the current position
+func (it *employeeIterator) Employee() *biz.Employee {
+	return it.current
+}
+
+// Cursor returns the position of the current employee, usable as
+// StreamFilter.After to resume the stream
+func (it *employeeIterator) Cursor() biz.StreamCursor {
+	if it.current == nil {
+		return biz.StreamCursor{}
+	}
+	return biz.StreamCursor{CreatedAt: it.current.CreatedAt, ID: it.current.ID}
+}
+
+// Err returns the first error encountered while iterating
+func (it *employeeIterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.rows.Err()
+}
+
+// Close releases the underlying database cursor
+func (it *employeeIterator) Close() error {
+	return it.rows.Close()
+}
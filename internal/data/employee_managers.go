@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// maxManagementDepth bounds the walk up a management chain. The usecase
+// refuses managers that would close a cycle, but two concurrent updates can
+// still create one; the walk then stops at the first repeated employee.
+const maxManagementDepth = 100
+
+// managementChainQuery walks up the managers of an employee, recording the
+// employees visited so far in path to stop at cycles
+const managementChainQuery = `
+WITH RECURSIVE chain (id, depth, path) AS (
+    SELECT e.manager_id, 1, ARRAY[e.id]
+    FROM employees e
+    WHERE e.id = ? AND e.tenant_id = ? AND e.manager_id IS NOT NULL
+  UNION ALL
+    SELECT e.manager_id, c.depth + 1, c.path || e.id
+    FROM chain c
+    JOIN employees e ON e.id = c.id AND e.tenant_id = ?
+    WHERE e.manager_id IS NOT NULL AND NOT e.manager_id = ANY(c.path || e.id) AND c.depth < ?
+)
+SELECT id FROM chain ORDER BY depth`
+
+// managementChainTx returns the IDs of the managers above an employee, its
+// direct manager first, using the given transaction
+func managementChainTx(tx *gorm.DB, tenantID string, id uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := tx.Raw(managementChainQuery, id, tenantID, tenantID, maxManagementDepth).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var managerID uuid.UUID
+		if err := rows.Scan(&managerID); err != nil {
+			return nil, err
+		}
+		ids = append(ids, managerID)
+	}
+	return ids, rows.Err()
+}
+
+// GetManagementChain returns the managers above an employee, its direct
+// manager first. An unknown employee has no managers.
+func (r *employeeRepo) GetManagementChain(ctx context.Context, tenantID string, id uuid.UUID) ([]*biz.Employee, error) {
+	ids, err := managementChainTx(r.data.DB(ctx), tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []*biz.Employee{}, nil
+	}
+
+	var models []EmployeeModel
+	if err := r.data.DB(ctx).
+		Preload("Emails", orderedEmails).
+		Preload("PhoneNumbers", orderedPhoneNumbers).
+		Preload("ExternalIDs").
+		Where("id IN ? AND tenant_id = ?", ids, tenantID).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	managers := make(map[uuid.UUID]*biz.Employee, len(models))
+	for i := range models {
+		managers[models[i].ID] = models[i].ToEntity()
+	}
+
+	// Keep the chain order; managers deleted since the chain was read are
+	// left out
+	chain := make([]*biz.Employee, 0, len(ids))
+	for _, managerID := range ids {
+		if m, ok := managers[managerID]; ok {
+			chain = append(chain, m)
+		}
+	}
+	return chain, nil
+}
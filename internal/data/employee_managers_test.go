@@ -0,0 +1,62 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmployeeRepo_GetManagementChain(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	id, managerID, topID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery(`WITH RECURSIVE chain`).
+		WithArgs(id, "tenant-1", "tenant-1", maxManagementDepth).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(managerID).AddRow(topID))
+	// The managers load in any order
+	mock.ExpectQuery(`SELECT \* FROM "employees" WHERE id IN \(\$1,\$2\) AND tenant_id = \$3`).
+		WithArgs(managerID, topID, "tenant-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "manager_id"}).
+			AddRow(topID, "tenant-1", nil).
+			AddRow(managerID, "tenant-1", topID))
+	mock.ExpectQuery(`SELECT \* FROM "employee_emails"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "email"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_external_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "system", "external_id"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_phone_numbers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "number"}))
+
+	chain, err := repo.GetManagementChain(context.Background(), "tenant-1", id)
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+	assert.Equal(t, managerID, chain[0].ID)
+	assert.Equal(t, &topID, chain[0].ManagerID)
+	assert.Equal(t, topID, chain[1].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEmployeeRepo_GetManagementChain_NoManager(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+
+	mock.ExpectQuery(`WITH RECURSIVE chain`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	chain, err := repo.GetManagementChain(context.Background(), "tenant-1", uuid.New())
+	require.NoError(t, err)
+	assert.Empty(t, chain)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReferenceNotFound(t *testing.T) {
+	assert.Equal(t, biz.ErrManagerNotFound, referenceNotFound(&pgconn.PgError{Code: "23503", ConstraintName: "fk_employees_manager"}))
+	assert.Equal(t, biz.ErrDepartmentNotFound, referenceNotFound(&pgconn.PgError{Code: "23503", ConstraintName: "fk_employees_department"}))
+}
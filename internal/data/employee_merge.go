@@ -0,0 +1,440 @@
+package data
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MergeModel is the GORM model for employee merges
+type MergeModel struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	TenantID   string     `gorm:"type:varchar(255);not null;index:idx_employee_merges_tenant_primary,priority:1"`
+	PrimaryID  uuid.UUID  `gorm:"type:uuid;not null;index:idx_employee_merges_tenant_primary,priority:2"`
+	Secondary  []byte     `gorm:"type:jsonb;not null"`
+	MergedBy   string     `gorm:"type:varchar(255);not null"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime"`
+	UnmergedBy string     `gorm:"type:varchar(255);not null"`
+	UnmergedAt *time.Time `gorm:""`
+}
+
+// TableName overrides the table name
+func (MergeModel) TableName() string {
+	return "employee_merges"
+}
+
+// ToEntity converts MergeModel to biz.Merge; Primary is left for the caller to load
+func (m *MergeModel) ToEntity() (*biz.Merge, error) {
+	secondary, err := unmarshalSnapshot(m.TenantID, m.Secondary)
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.Merge{
+		ID:         m.ID,
+		TenantID:   m.TenantID,
+		Secondary:  secondary,
+		MergedBy:   m.MergedBy,
+		CreatedAt:  m.CreatedAt,
+		UnmergedBy: m.UnmergedBy,
+		UnmergedAt: m.UnmergedAt,
+	}, nil
+}
+
+// MergeEmployeesByID merges two employees by ID, like MergeEmployees.
+func (r *employeeRepo) MergeEmployeesByID(ctx context.Context, tenantID string, primaryID, secondaryID uuid.UUID) (*biz.Merge, error) {
+	var merge *MergeModel
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		merge, err = r.data.mergeTx(ctx, tx, tenantID, primaryID, secondaryID)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mergeResult(ctx, merge)
+}
+
+// mergeTx transfers all emails, team memberships, phone numbers, tags and reports of the secondary
+// employee to the primary employee, deletes the secondary employee and records the
+// merge and its audit entries using the given transaction.
+func (d *Data) mergeTx(ctx context.Context, tx *gorm.DB, tenantID string, primaryID, secondaryID uuid.UUID) (*MergeModel, error) {
+	// Capture both employees for the audit log
+	primaryBefore, err := getByIDTx(tx, tenantID, primaryID)
+	if err != nil {
+		return nil, err
+	}
+	secondaryBefore, err := getByIDTx(tx, tenantID, secondaryID)
+	if err != nil {
+		return nil, err
+	}
+	if primaryBefore.IsPending() || secondaryBefore.IsPending() {
+		return nil, biz.ErrEmployeePendingReview
+	}
+
+	// Transfer all emails from secondary employee to primary employee, whose
+	// primary email stays
+	if err := tx.Model(&EmployeeEmailModel{}).
+		Where("employee_id = ? AND tenant_id = ?", secondaryID, tenantID).
+		Updates(map[string]interface{}{"employee_id": primaryID, "is_primary": false}).Error; err != nil {
+		return nil, err
+	}
+	if err := d.touchTx(tx, tenantID, primaryID); err != nil {
+		return nil, err
+	}
+
+	// The primary employee joins the teams of the secondary employee; the
+	// secondary memberships are deleted with the employee
+	if err := tx.Exec(`INSERT INTO employee_team_members (team_id, employee_id, tenant_id, created_at)
+		SELECT team_id, ?, tenant_id, created_at FROM employee_team_members
+		WHERE employee_id = ? AND tenant_id = ?
+		ON CONFLICT DO NOTHING`, primaryID, secondaryID, tenantID).Error; err != nil {
+		return nil, err
+	}
+
+	// The primary employee gains the phone numbers of the secondary employee
+	// it does not have, after its own
+	if len(secondaryBefore.PhoneNumbers) > 0 {
+		if err := tx.Exec(`INSERT INTO employee_phone_numbers (employee_id, tenant_id, position, type, number, created_at)
+			SELECT ?, tenant_id, position + ?, type, number, created_at FROM employee_phone_numbers
+			WHERE employee_id = ? AND tenant_id = ?
+			ON CONFLICT DO NOTHING`, primaryID, len(primaryBefore.PhoneNumbers), secondaryID, tenantID).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// The primary employee gains the external IDs of the secondary employee
+	// in systems it has no ID in; the others are deleted with the secondary
+	// employee
+	if len(secondaryBefore.ExternalIDs) > 0 {
+		if err := tx.Exec(`UPDATE employee_external_ids SET employee_id = ?
+			WHERE employee_id = ? AND tenant_id = ?
+			AND system NOT IN (SELECT system FROM employee_external_ids WHERE employee_id = ?)`,
+			primaryID, secondaryID, tenantID, primaryID).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// The primary employee gains the tags of the secondary employee
+	if len(secondaryBefore.Tags) > 0 {
+		if err := tx.Exec(`UPDATE employees SET tags = ARRAY(SELECT DISTINCT unnest(tags || ?::text[]) ORDER BY 1)
+			WHERE id = ? AND tenant_id = ?`, pq.StringArray(secondaryBefore.Tags), primaryID, tenantID).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// The reports of the secondary employee now report to the primary
+	// employee. The primary and its own managers would close a cycle; they
+	// lose their manager with the secondary employee instead.
+	excluded, err := managementChainTx(tx, tenantID, primaryID)
+	if err != nil {
+		return nil, err
+	}
+	excluded = append(excluded, primaryID)
+	if err := tx.Model(&EmployeeModel{}).
+		Where("tenant_id = ? AND manager_id = ? AND id NOT IN ?", tenantID, secondaryID, excluded).
+		Updates(map[string]interface{}{
+			"manager_id": primaryID,
+			"updated_at": d.now(),
+			"version":    gorm.Expr("version + 1"),
+		}).Error; err != nil {
+		return nil, err
+	}
+
+	// Delete secondary employee record
+	if err := tx.Where("id = ? AND tenant_id = ?", secondaryID, tenantID).
+		Delete(&EmployeeModel{}).Error; err != nil {
+		return nil, err
+	}
+
+	primaryAfter, err := getByIDTx(tx, tenantID, primaryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the secondary employee for UnmergeEmployees
+	secondaryJSON, err := marshalSnapshot(secondaryBefore)
+	if err != nil {
+		return nil, err
+	}
+	mergedBy, _ := biz.GetUserID(ctx)
+	merge := &MergeModel{
+		ID:        d.newID(),
+		TenantID:  tenantID,
+		PrimaryID: primaryID,
+		Secondary: secondaryJSON,
+		MergedBy:  mergedBy,
+	}
+	if err := tx.Create(merge).Error; err != nil {
+		return nil, err
+	}
+
+	if err := d.recordAudit(ctx, tx, tenantID, biz.AuditActionMerge, primaryID, primaryBefore, primaryAfter); err != nil {
+		return nil, err
+	}
+	if err := d.recordAudit(ctx, tx, tenantID, biz.AuditActionMerge, secondaryID, secondaryBefore, nil); err != nil {
+		return nil, err
+	}
+	// The reports of the secondary employee moved to the primary employee or
+	// lost their manager
+	if err := d.recordReportsJobTx(tx, tenantID, []uuid.UUID{secondaryID}); err != nil {
+		return nil, err
+	}
+
+	return merge, nil
+}
+
+// mergeResult converts a committed merge, loading the merged primary employee
+func (r *employeeRepo) mergeResult(ctx context.Context, merge *MergeModel) (*biz.Merge, error) {
+	result, err := merge.ToEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the merged employee with all emails
+	result.Primary, err = r.GetByID(ctx, merge.TenantID, merge.PrimaryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UnmergeEmployees undoes a merge: the secondary employee is recreated with
+// its original ID and its emails are moved back from the primary employee.
+// The merge is refused with ErrUnmergeConflict when the primary employee was
+// deleted, no longer owns all of the secondary's emails, or would be left
+// without an email.
+func (r *employeeRepo) UnmergeEmployees(ctx context.Context, tenantID string, mergeID uuid.UUID) (*biz.Merge, error) {
+	var result *biz.Merge
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the merge so that concurrent unmerges cannot both succeed
+		var merge MergeModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND tenant_id = ?", mergeID, tenantID).
+			First(&merge).Error
+		if err == gorm.ErrRecordNotFound {
+			return biz.ErrMergeNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if merge.UnmergedAt != nil {
+			return biz.ErrMergeNotFound
+		}
+
+		result, err = merge.ToEntity()
+		if err != nil {
+			return err
+		}
+		secondary := result.Secondary
+
+		primaryBefore, err := getByIDTx(tx, tenantID, merge.PrimaryID)
+		if err == biz.ErrEmployeeNotFound {
+			return biz.ErrUnmergeConflict
+		}
+		if err != nil {
+			return err
+		}
+
+		remaining := 0
+		for _, email := range primaryBefore.Emails {
+			if !slices.Contains(secondary.Emails, email) {
+				remaining++
+			}
+		}
+		if remaining == 0 || remaining+len(secondary.Emails) != len(primaryBefore.Emails) {
+			return biz.ErrUnmergeConflict
+		}
+
+		// Recreate the secondary employee as it was before the merge, in its
+		// department and with its manager unless they were deleted since;
+		// its reports stay with the primary employee
+		managerID := secondary.ManagerID
+		if managerID != nil {
+			var count int64
+			if err := tx.Model(&EmployeeModel{}).
+				Where("id = ? AND tenant_id = ?", *managerID, tenantID).
+				Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				managerID = nil
+			}
+		}
+		departmentID := secondary.DepartmentID
+		if departmentID != nil {
+			var count int64
+			if err := tx.Model(&DepartmentModel{}).
+				Where("id = ? AND tenant_id = ?", *departmentID, tenantID).
+				Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				departmentID = nil
+			}
+		}
+		if err := tx.Create(&EmployeeModel{
+			ID:            secondary.ID,
+			TenantID:      tenantID,
+			FirstName:     secondary.FirstName,
+			LastName:      secondary.LastName,
+			CreatedAt:     secondary.CreatedAt,
+			DepartmentID:  departmentID,
+			ManagerID:     managerID,
+			Title:         secondary.Title,
+			Tags:          tagArray(secondary.Tags),
+			Address:       toAddressColumns(secondary.Address),
+			PhotoKey:      secondary.PhotoKey,
+			DeactivatedAt: secondary.DeactivatedAt,
+		}).Error; err != nil {
+			if isUniqueViolation(err) {
+				return biz.ErrUnmergeConflict
+			}
+			return err
+		}
+
+		if err := tx.Model(&EmployeeEmailModel{}).
+			Where("tenant_id = ? AND employee_id = ? AND email IN ?", tenantID, merge.PrimaryID, secondary.Emails).
+			Updates(map[string]interface{}{"employee_id": secondary.ID, "is_primary": false}).Error; err != nil {
+			return err
+		}
+		if err := restorePrimaryEmails(tx, tenantID, merge.PrimaryID, primaryBefore, secondary); err != nil {
+			return err
+		}
+		// Like its tags, the phone numbers the primary employee gained stay
+		if err := insertPhoneNumbers(tx, tenantID, secondary.ID, secondary.PhoneNumbers); err != nil {
+			return err
+		}
+		if err := restoreExternalIDs(tx, tenantID, merge.PrimaryID, secondary); err != nil {
+			return err
+		}
+		if err := r.data.touchTx(tx, tenantID, merge.PrimaryID); err != nil {
+			return err
+		}
+
+		primaryAfter, err := getByIDTx(tx, tenantID, merge.PrimaryID)
+		if err != nil {
+			return err
+		}
+		secondaryAfter, err := getByIDTx(tx, tenantID, secondary.ID)
+		if err != nil {
+			return err
+		}
+
+		unmergedBy, _ := biz.GetUserID(ctx)
+		now := r.data.now()
+		if err := tx.Model(&MergeModel{}).
+			Where("id = ?", merge.ID).
+			Updates(map[string]interface{}{"unmerged_by": unmergedBy, "unmerged_at": now}).Error; err != nil {
+			return err
+		}
+		result.UnmergedBy = unmergedBy
+		result.UnmergedAt = &now
+		result.Primary = primaryAfter
+		result.Secondary = secondaryAfter
+
+		if err := r.data.recordAudit(ctx, tx, tenantID, biz.AuditActionUnmerge, merge.PrimaryID, primaryBefore, primaryAfter); err != nil {
+			return err
+		}
+
+		if err := r.data.recordAudit(ctx, tx, tenantID, biz.AuditActionUnmerge, secondary.ID, nil, secondaryAfter); err != nil {
+			return err
+		}
+		// The history of the secondary employee was deleted with it; it
+		// starts over with the restored job
+		return r.data.recordJobTx(tx, tenantID, nil, secondaryAfter)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// restorePrimaryEmails designates the primary emails of both employees once
+// the emails of secondary moved back to it: secondary gets its primary email
+// from before the merge, and the primary employee keeps its primary email
+// unless it was one of them, in which case its first remaining email is.
+func restorePrimaryEmails(tx *gorm.DB, tenantID string, primaryID uuid.UUID, primaryBefore, secondary *biz.Employee) error {
+	if i := primaryEmailIndex(secondary.Emails, secondary.PrimaryEmail); i >= 0 {
+		if err := tx.Model(&EmployeeEmailModel{}).
+			Where("tenant_id = ? AND employee_id = ? AND email = ?", tenantID, secondary.ID, secondary.Emails[i]).
+			Update("is_primary", true).Error; err != nil {
+			return err
+		}
+	}
+
+	if !slices.Contains(secondary.Emails, primaryBefore.PrimaryEmail) {
+		return nil
+	}
+	for _, email := range primaryBefore.Emails {
+		if !slices.Contains(secondary.Emails, email) {
+			return tx.Model(&EmployeeEmailModel{}).
+				Where("tenant_id = ? AND employee_id = ? AND email = ?", tenantID, primaryID, email).
+				Update("is_primary", true).Error
+		}
+	}
+	return nil
+}
+
+// restoreExternalIDs gives the external IDs of secondary back to it on
+// unmerge. IDs the primary employee gained from it move back; IDs deleted
+// by the merge are recreated unless they were given to another employee
+// since.
+func restoreExternalIDs(tx *gorm.DB, tenantID string, primaryID uuid.UUID, secondary *biz.Employee) error {
+	for _, system := range slices.Sorted(maps.Keys(secondary.ExternalIDs)) {
+		externalID := secondary.ExternalIDs[system]
+		result := tx.Model(&EmployeeExternalIDModel{}).
+			Where("tenant_id = ? AND employee_id = ? AND system = ? AND external_id = ?", tenantID, primaryID, system, externalID).
+			Update("employee_id", secondary.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			continue
+		}
+		if err := tx.Exec(`INSERT INTO employee_external_ids (employee_id, tenant_id, system, external_id)
+			VALUES (?, ?, ?, ?) ON CONFLICT DO NOTHING`, secondary.ID, tenantID, system, externalID).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetMergeRedirect returns the latest merge, not undone, that removed the
+// employee with secondaryID, or nil when there is none.
+func (r *employeeRepo) GetMergeRedirect(ctx context.Context, tenantID string, secondaryID uuid.UUID) (*biz.MergeRedirect, error) {
+	var model MergeModel
+	err := r.data.DB(ctx).
+		Select("id", "primary_id", "created_at").
+		Where("tenant_id = ? AND secondary->>'id' = ? AND unmerged_at IS NULL", tenantID, secondaryID.String()).
+		Order("created_at DESC").
+		First(&model).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.MergeRedirect{
+		MergeID:  model.ID,
+		FromID:   secondaryID,
+		ToID:     model.PrimaryID,
+		MergedAt: model.CreatedAt,
+	}, nil
+}
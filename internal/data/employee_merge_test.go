@@ -0,0 +1,132 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func mergeRows(id, primaryID uuid.UUID, secondary string, unmergedAt *time.Time) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "tenant_id", "primary_id", "secondary", "merged_by", "created_at", "unmerged_by", "unmerged_at"}).
+		AddRow(id, "tenant-1", primaryID, []byte(secondary), "user-1", time.Now(), "", unmergedAt)
+}
+
+func TestMergeEmployeesByID_SecondaryNotFound(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	primaryID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "employees"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id"}).AddRow(primaryID, "tenant-1"))
+	mock.ExpectQuery(`SELECT \* FROM "employee_emails"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "tenant_id", "email"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_external_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "system", "external_id"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_phone_numbers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "number"}))
+	mock.ExpectQuery(`SELECT \* FROM "employees"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	_, err := repo.MergeEmployeesByID(context.Background(), "tenant-1", primaryID, uuid.New())
+
+	assert.Equal(t, biz.ErrEmployeeNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnmergeEmployees_NotFound(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "employee_merges" .* FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	_, err := repo.UnmergeEmployees(context.Background(), "tenant-1", uuid.New())
+
+	assert.Equal(t, biz.ErrMergeNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnmergeEmployees_AlreadyUnmerged(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	mergeID := uuid.New()
+	unmergedAt := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "employee_merges"`).
+		WillReturnRows(mergeRows(mergeID, uuid.New(), `{}`, &unmergedAt))
+	mock.ExpectRollback()
+
+	_, err := repo.UnmergeEmployees(context.Background(), "tenant-1", mergeID)
+
+	assert.Equal(t, biz.ErrMergeNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnmergeEmployees_EmailsMovedSinceMerge(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	mergeID, primaryID, secondaryID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "employee_merges"`).
+		WillReturnRows(mergeRows(mergeID, primaryID, `{"id":"`+secondaryID.String()+`","emails":["b@example.com","c@example.com"]}`, nil))
+	mock.ExpectQuery(`SELECT \* FROM "employees"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id"}).AddRow(primaryID, "tenant-1"))
+	// c@example.com was removed from the primary employee after the merge
+	mock.ExpectQuery(`SELECT \* FROM "employee_emails"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "tenant_id", "email"}).
+			AddRow(uuid.New(), primaryID, "tenant-1", "a@example.com").
+			AddRow(uuid.New(), primaryID, "tenant-1", "b@example.com"))
+	mock.ExpectQuery(`SELECT \* FROM "employee_external_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "system", "external_id"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_phone_numbers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "number"}))
+	mock.ExpectRollback()
+
+	_, err := repo.UnmergeEmployees(context.Background(), "tenant-1", mergeID)
+
+	assert.Equal(t, biz.ErrUnmergeConflict, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetMergeRedirect(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	mergeID, primaryID, secondaryID := uuid.New(), uuid.New(), uuid.New()
+	mergedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT "id","primary_id","created_at" FROM "employee_merges" WHERE tenant_id = \$1 AND secondary->>'id' = \$2 AND unmerged_at IS NULL ORDER BY created_at DESC`).
+		WithArgs("tenant-1", secondaryID.String(), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "primary_id", "created_at"}).AddRow(mergeID, primaryID, mergedAt))
+
+	redirect, err := repo.GetMergeRedirect(context.Background(), "tenant-1", secondaryID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &biz.MergeRedirect{MergeID: mergeID, FromID: secondaryID, ToID: primaryID, MergedAt: mergedAt}, redirect)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetMergeRedirect_NotMerged(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+
+	mock.ExpectQuery(`SELECT "id","primary_id","created_at" FROM "employee_merges"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	redirect, err := repo.GetMergeRedirect(context.Background(), "tenant-1", uuid.New())
+
+	assert.NoError(t, err)
+	assert.Nil(t, redirect)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,11 +1,15 @@
 package data
 
 import (
+	"database/sql/driver"
+	"strings"
 	"time"
 
 	"github.com/cvele/employee-service/internal/biz"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
 )
 
 // EmployeeEmailModel is the GORM model for employee emails
@@ -13,8 +17,13 @@ type EmployeeEmailModel struct {
 	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
 	EmployeeID uuid.UUID `gorm:"type:uuid;not null;index:idx_employee_emails_employee_id"`
 	TenantID   string    `gorm:"type:varchar(255);not null;index:idx_employee_emails_tenant_email,unique,priority:1"`
-	Email      string    `gorm:"type:varchar(255);not null;index:idx_employee_emails_tenant_email,unique,priority:2"`
-	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	Email      string    `gorm:"type:citext;not null;index:idx_employee_emails_tenant_email,unique,priority:2"`
+	// IsPrimary marks the primary email, one per employee
+	IsPrimary bool      `gorm:"not null;default:false"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	// UndeliverableReason and UndeliverableAt are set once the email bounced
+	UndeliverableReason string     `gorm:"type:varchar(16);not null;default:''"`
+	UndeliverableAt     *time.Time `gorm:""`
 }
 
 // TableName overrides the table name
@@ -22,15 +31,148 @@ func (EmployeeEmailModel) TableName() string {
 	return "employee_emails"
 }
 
+// EmployeePhoneNumberModel is the GORM model for employee phone numbers
+type EmployeePhoneNumberModel struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	EmployeeID uuid.UUID `gorm:"type:uuid;not null;index:idx_employee_phone_numbers_employee_number,unique,priority:1"`
+	TenantID   string    `gorm:"type:varchar(255);not null"`
+	// Position keeps the phone numbers in the order they were given
+	Position  int       `gorm:"not null"`
+	Type      string    `gorm:"type:varchar(16);not null"`
+	Number    string    `gorm:"type:varchar(16);not null;index:idx_employee_phone_numbers_employee_number,unique,priority:2"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName overrides the table name
+func (EmployeePhoneNumberModel) TableName() string {
+	return "employee_phone_numbers"
+}
+
+// EmployeeExternalIDModel is the GORM model for the IDs of employees in
+// external systems
+type EmployeeExternalIDModel struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	EmployeeID uuid.UUID `gorm:"type:uuid;not null;index:idx_employee_external_ids_employee_system,unique,priority:1"`
+	TenantID   string    `gorm:"type:varchar(255);not null;index:idx_employee_external_ids_tenant_system_id,unique,priority:1"`
+	System     string    `gorm:"type:varchar(32);not null;index:idx_employee_external_ids_tenant_system_id,unique,priority:2;index:idx_employee_external_ids_employee_system,unique,priority:2"`
+	ExternalID string    `gorm:"type:varchar(255);not null;index:idx_employee_external_ids_tenant_system_id,unique,priority:3"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName overrides the table name
+func (EmployeeExternalIDModel) TableName() string {
+	return "employee_external_ids"
+}
+
+// orderedEmails preloads emails primary first, then in the order they were
+// added
+func orderedEmails(db *gorm.DB) *gorm.DB {
+	return db.Order("is_primary DESC, created_at, id")
+}
+
+// orderedPhoneNumbers preloads phone numbers in their position order
+func orderedPhoneNumbers(db *gorm.DB) *gorm.DB {
+	return db.Order("position")
+}
+
+// addressColumns are the postal address columns of an employee, empty
+// when the employee has no address
+type addressColumns struct {
+	Line1      string `gorm:"type:varchar(200);not null"`
+	Line2      string `gorm:"type:varchar(200);not null"`
+	City       string `gorm:"type:varchar(100);not null"`
+	Region     string `gorm:"type:varchar(100);not null"`
+	PostalCode string `gorm:"type:varchar(20);not null"`
+	Country    string `gorm:"type:varchar(2);not null"`
+}
+
+// toAddressColumns converts a biz.Address, nil meaning no address
+func toAddressColumns(a *biz.Address) addressColumns {
+	if a == nil {
+		return addressColumns{}
+	}
+	return addressColumns{
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+	}
+}
+
+// toEntity returns the address, nil when the columns are empty
+func (c addressColumns) toEntity() *biz.Address {
+	if c == (addressColumns{}) {
+		return nil
+	}
+	return &biz.Address{
+		Line1:      c.Line1,
+		Line2:      c.Line2,
+		City:       c.City,
+		Region:     c.Region,
+		PostalCode: c.PostalCode,
+		Country:    c.Country,
+	}
+}
+
+// updates returns the column updates replacing the address
+func (c addressColumns) updates() map[string]interface{} {
+	return map[string]interface{}{
+		"address_line1":       c.Line1,
+		"address_line2":       c.Line2,
+		"address_city":        c.City,
+		"address_region":      c.Region,
+		"address_postal_code": c.PostalCode,
+		"address_country":     c.Country,
+	}
+}
+
+// tagArray is a text[] column of tags; nil is stored as an empty array
+type tagArray []string
+
+// Value implements driver.Valuer
+func (a tagArray) Value() (driver.Value, error) {
+	if a == nil {
+		return "{}", nil
+	}
+	return pq.StringArray(a).Value()
+}
+
+// Scan implements sql.Scanner
+func (a *tagArray) Scan(src interface{}) error {
+	return (*pq.StringArray)(a).Scan(src)
+}
+
 // EmployeeModel is the GORM model for Employee
 type EmployeeModel struct {
-	ID        uuid.UUID            `gorm:"type:uuid;primaryKey"`
-	TenantID  string               `gorm:"type:varchar(255);not null;index:idx_tenant_id"`
-	FirstName string               `gorm:"type:varchar(255);not null"`
-	LastName  string               `gorm:"type:varchar(255);not null"`
-	CreatedAt time.Time            `gorm:"autoCreateTime"`
-	UpdatedAt time.Time            `gorm:"autoUpdateTime"`
-	Emails    []EmployeeEmailModel `gorm:"foreignKey:EmployeeID;constraint:OnDelete:CASCADE"`
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TenantID  string    `gorm:"type:varchar(255);not null;index:idx_tenant_id"`
+	FirstName string    `gorm:"type:varchar(255);not null"`
+	LastName  string    `gorm:"type:varchar(255);not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	Version   int64     `gorm:"not null;default:1"`
+	// ReviewStatus is approved or pending, see biz.ReviewStatusPending
+	ReviewStatus string `gorm:"type:varchar(16);not null;default:approved"`
+	// DepartmentID references a department of the same tenant
+	DepartmentID *uuid.UUID `gorm:"type:uuid"`
+	// ManagerID references an employee of the same tenant
+	ManagerID *uuid.UUID `gorm:"type:uuid"`
+	Title     string     `gorm:"type:varchar(100);not null;default:''"`
+	// Tags are served by a GIN index for the tags filter of listings
+	Tags         tagArray                   `gorm:"type:text[];not null"`
+	Address      addressColumns             `gorm:"embedded;embeddedPrefix:address_"`
+	Emails       []EmployeeEmailModel       `gorm:"foreignKey:EmployeeID;constraint:OnDelete:CASCADE"`
+	PhoneNumbers []EmployeePhoneNumberModel `gorm:"foreignKey:EmployeeID;constraint:OnDelete:CASCADE"`
+	// PhotoKey is the object key of the photo in the photo bucket, empty
+	// without photo
+	PhotoKey    string                    `gorm:"type:varchar(255);not null;default:''"`
+	ExternalIDs []EmployeeExternalIDModel `gorm:"foreignKey:EmployeeID;constraint:OnDelete:CASCADE"`
+	// DeactivatedAt is set while the employee is deactivated
+	DeactivatedAt *time.Time `gorm:""`
+	// StaleAt is when the stale-record job last flagged the employee
+	StaleAt *time.Time `gorm:""`
 }
 
 // TableName overrides the table name
@@ -41,39 +183,127 @@ func (EmployeeModel) TableName() string {
 // ToEntity converts EmployeeModel to biz.Employee
 func (m *EmployeeModel) ToEntity() *biz.Employee {
 	emails := make([]string, len(m.Emails))
+	var primaryEmail string
+	var undeliverable []biz.UndeliverableEmail
 	for i, emailModel := range m.Emails {
 		emails[i] = emailModel.Email
+		if emailModel.IsPrimary {
+			primaryEmail = emailModel.Email
+		}
+		if emailModel.UndeliverableAt != nil {
+			undeliverable = append(undeliverable, biz.UndeliverableEmail{Email: emailModel.Email, Reason: emailModel.UndeliverableReason, Since: *emailModel.UndeliverableAt})
+		}
+	}
+	var phones []biz.PhoneNumber
+	for _, phoneModel := range m.PhoneNumbers {
+		phones = append(phones, biz.PhoneNumber{Type: phoneModel.Type, Number: phoneModel.Number})
+	}
+	var externalIDs map[string]string
+	if len(m.ExternalIDs) > 0 {
+		externalIDs = make(map[string]string, len(m.ExternalIDs))
+		for _, idModel := range m.ExternalIDs {
+			externalIDs[idModel.System] = idModel.ExternalID
+		}
 	}
 
 	return &biz.Employee{
-		ID:        m.ID,
-		TenantID:  m.TenantID,
-		Emails:    emails,
-		FirstName: m.FirstName,
-		LastName:  m.LastName,
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
+		ID:                  m.ID,
+		TenantID:            m.TenantID,
+		Emails:              emails,
+		PrimaryEmail:        primaryEmail,
+		FirstName:           m.FirstName,
+		LastName:            m.LastName,
+		CreatedAt:           m.CreatedAt,
+		UpdatedAt:           m.UpdatedAt,
+		Version:             m.Version,
+		ReviewStatus:        m.ReviewStatus,
+		DepartmentID:        m.DepartmentID,
+		ManagerID:           m.ManagerID,
+		Title:               m.Title,
+		Tags:                []string(m.Tags),
+		PhoneNumbers:        phones,
+		Address:             m.Address.toEntity(),
+		PhotoKey:            m.PhotoKey,
+		ExternalIDs:         externalIDs,
+		DeactivatedAt:       m.DeactivatedAt,
+		StaleAt:             m.StaleAt,
+		UndeliverableEmails: undeliverable,
 	}
 }
 
-// FromEntity converts biz.Employee to EmployeeModel
+// FromEntity converts biz.Employee to EmployeeModel. The primary email is
+// e.PrimaryEmail when listed in e.Emails, otherwise the first email.
 func FromEntity(e *biz.Employee) *EmployeeModel {
+	primary := primaryEmailIndex(e.Emails, e.PrimaryEmail)
 	emailModels := make([]EmployeeEmailModel, len(e.Emails))
 	for i, email := range e.Emails {
 		emailModels[i] = EmployeeEmailModel{
 			EmployeeID: e.ID,
 			TenantID:   e.TenantID,
 			Email:      email,
+			IsPrimary:  i == primary,
+		}
+	}
+
+	phoneModels := make([]EmployeePhoneNumberModel, len(e.PhoneNumbers))
+	for i, phone := range e.PhoneNumbers {
+		phoneModels[i] = EmployeePhoneNumberModel{
+			EmployeeID: e.ID,
+			TenantID:   e.TenantID,
+			Position:   i,
+			Type:       phone.Type,
+			Number:     phone.Number,
 		}
 	}
 
+	var idModels []EmployeeExternalIDModel
+	for system, id := range e.ExternalIDs {
+		idModels = append(idModels, EmployeeExternalIDModel{
+			EmployeeID: e.ID,
+			TenantID:   e.TenantID,
+			System:     system,
+			ExternalID: id,
+		})
+	}
+
 	return &EmployeeModel{
-		ID:        e.ID,
-		TenantID:  e.TenantID,
-		FirstName: e.FirstName,
-		LastName:  e.LastName,
-		CreatedAt: e.CreatedAt,
-		UpdatedAt: e.UpdatedAt,
-		Emails:    emailModels,
+		ID:            e.ID,
+		TenantID:      e.TenantID,
+		FirstName:     e.FirstName,
+		LastName:      e.LastName,
+		CreatedAt:     e.CreatedAt,
+		UpdatedAt:     e.UpdatedAt,
+		ReviewStatus:  e.ReviewStatus,
+		DepartmentID:  e.DepartmentID,
+		ManagerID:     e.ManagerID,
+		Title:         e.Title,
+		Tags:          tagArray(e.Tags),
+		Emails:        emailModels,
+		PhoneNumbers:  phoneModels,
+		Address:       toAddressColumns(e.Address),
+		PhotoKey:      e.PhotoKey,
+		ExternalIDs:   idModels,
+		DeactivatedAt: e.DeactivatedAt,
+		StaleAt:       e.StaleAt,
+	}
+}
+
+// primaryEmailIndex returns the index of the first of candidates found in
+// emails, compared case-insensitively like the citext column, or 0 when none
+// is. It returns -1 when there are no emails.
+func primaryEmailIndex(emails []string, candidates ...string) int {
+	if len(emails) == 0 {
+		return -1
+	}
+	for _, c := range candidates {
+		if c == "" {
+			continue
+		}
+		for i, email := range emails {
+			if strings.EqualFold(email, c) {
+				return i
+			}
+		}
 	}
+	return 0
 }
@@ -2,12 +2,18 @@ package data
 
 import (
 	"context"
-	"time"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
 
 	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/observability"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
@@ -16,74 +22,169 @@ type employeeRepo struct {
 	log  *log.Helper
 }
 
-// NewEmployeeRepo creates a new employee repository.
-func NewEmployeeRepo(data *Data, logger log.Logger) biz.EmployeeRepo {
-	return &employeeRepo{
+// NewEmployeeRepo creates a new employee repository, fronted by the Redis
+// cache when one is configured. Shadow reads sit behind the cache, so that
+// they compare database reads.
+func NewEmployeeRepo(data *Data, obs *observability.Observability, logger log.Logger) biz.EmployeeRepo {
+	var repo biz.EmployeeRepo = &employeeRepo{
 		data: data,
 		log:  log.NewHelper(logger),
 	}
+	if data.shadow != nil {
+		repo = newShadowEmployeeRepo(repo, data.shadow, obs, logger)
+	}
+	if data.cache != nil {
+		repo = newCachedEmployeeRepo(repo, data.cache, obs, logger)
+	}
+	return newTracedEmployeeRepo(repo, data.dialect.system())
 }
 
-// GetEventPublisher returns the event publisher
-func (r *employeeRepo) GetEventPublisher() biz.EventPublisher {
-	if r.data.publisher == nil {
+// Create creates a new employee in the database.
+func (r *employeeRepo) Create(ctx context.Context, tenantID string, employee *biz.Employee) (*biz.Employee, error) {
+	// Use transaction to create employee and emails
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		_, err := r.data.createTx(ctx, tx, tenantID, employee)
+		return err
+	})
+
+	if isUniqueViolation(err) {
+		return nil, biz.ErrEmployeeAlreadyExists
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch and return the created employee with emails
+	return r.GetByID(ctx, tenantID, employee.ID)
+}
+
+// CreateMany creates all employees in a single transaction.
+func (r *employeeRepo) CreateMany(ctx context.Context, tenantID string, employees []*biz.Employee) ([]*biz.Employee, error) {
+	created := make([]*biz.Employee, 0, len(employees))
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, employee := range employees {
+			after, err := r.data.createTx(ctx, tx, tenantID, employee)
+			if err != nil {
+				return err
+			}
+			created = append(created, after)
+		}
 		return nil
+	})
+
+	if isUniqueViolation(err) {
+		return nil, biz.ErrEmployeeAlreadyExists
+	}
+	if err != nil {
+		return nil, err
 	}
-	return r.data.publisher
+
+	return created, nil
 }
 
-// Create creates a new employee in the database.
-func (r *employeeRepo) Create(ctx context.Context, tenantID string, employee *biz.Employee) (*biz.Employee, error) {
+// createTx inserts an employee with its emails and audit entry using the
+// given transaction and returns the stored employee
+func (d *Data) createTx(ctx context.Context, tx *gorm.DB, tenantID string, employee *biz.Employee) (*biz.Employee, error) {
 	// Generate UUID if not set
 	if employee.ID == uuid.Nil {
-		employee.ID = uuid.New()
+		employee.ID = d.newID()
 	}
 
 	model := FromEntity(employee)
 	model.TenantID = tenantID
 
-	// Use transaction to create employee and emails
-	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Create employee record
-		if err := tx.Create(&EmployeeModel{
-			ID:        model.ID,
-			TenantID:  model.TenantID,
-			FirstName: model.FirstName,
-			LastName:  model.LastName,
-			CreatedAt: model.CreatedAt,
-			UpdatedAt: model.UpdatedAt,
-		}).Error; err != nil {
-			return err
+	// Create employee record
+	if model.ReviewStatus == "" {
+		model.ReviewStatus = biz.ReviewStatusApproved
+	}
+	if err := tx.Create(&EmployeeModel{
+		ID:           model.ID,
+		TenantID:     model.TenantID,
+		FirstName:    model.FirstName,
+		LastName:     model.LastName,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+		ReviewStatus: model.ReviewStatus,
+		DepartmentID: model.DepartmentID,
+		ManagerID:    model.ManagerID,
+		Title:        model.Title,
+		Address:      model.Address,
+	}).Error; err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, referenceNotFound(err)
 		}
+		return nil, err
+	}
 
-		// Create email records
-		for _, emailModel := range model.Emails {
-			emailModel.EmployeeID = model.ID
-			emailModel.TenantID = tenantID
-			if err := tx.Create(&emailModel).Error; err != nil {
-				return err
-			}
+	// Create email records
+	for _, emailModel := range model.Emails {
+		emailModel.EmployeeID = model.ID
+		emailModel.TenantID = tenantID
+		if err := tx.Create(&emailModel).Error; err != nil {
+			return nil, err
 		}
+	}
 
-		return nil
-	})
+	if err := insertPhoneNumbers(tx, tenantID, model.ID, employee.PhoneNumbers); err != nil {
+		return nil, err
+	}
+	if err := insertExternalIDs(tx, tenantID, model.ID, employee.ExternalIDs); err != nil {
+		return nil, err
+	}
 
+	after, err := getByIDTx(tx, tenantID, model.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Fetch and return the created employee with emails
-	return r.GetByID(ctx, tenantID, employee.ID)
+	if err := d.recordAudit(ctx, tx, tenantID, biz.AuditActionCreate, model.ID, nil, after); err != nil {
+		return nil, err
+	}
+	if err := d.recordJobTx(tx, tenantID, nil, after); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+// isUniqueViolation reports whether err is a Postgres unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// isForeignKeyViolation reports whether err is a Postgres foreign key
+// violation, such as an employee referencing a department of another tenant
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23503"
+}
+
+// referenceNotFound maps a foreign key violation of an employee write to the
+// missing department or manager
+func referenceNotFound(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "fk_employees_manager" {
+		return biz.ErrManagerNotFound
+	}
+	return biz.ErrDepartmentNotFound
 }
 
 // Update updates an existing employee in the database.
 func (r *employeeRepo) Update(ctx context.Context, tenantID string, employee *biz.Employee) (*biz.Employee, error) {
-	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// Capture the current state for the audit log
+		before, err := getByIDTx(tx, tenantID, employee.ID)
+		if err != nil {
+			return err
+		}
+
 		// Build update map with only non-empty fields
 		updateFields := make(map[string]interface{})
 
-		// Always update timestamp
-		updateFields["updated_at"] = time.Now()
+		// Always update timestamp and version
+		updateFields["updated_at"] = r.data.now()
+		updateFields["version"] = gorm.Expr("version + 1")
 
 		// Only update first name if provided
 		if employee.FirstName != "" {
@@ -95,17 +196,60 @@ func (r *employeeRepo) Update(ctx context.Context, tenantID string, employee *bi
 			updateFields["last_name"] = employee.LastName
 		}
 
-		// Update employee record
-		result := tx.Model(&EmployeeModel{}).
-			Where("id = ? AND tenant_id = ?", employee.ID, tenantID).
-			Updates(updateFields)
+		// uuid.Nil removes the employee from its department
+		if employee.DepartmentID != nil {
+			if *employee.DepartmentID == uuid.Nil {
+				updateFields["department_id"] = nil
+			} else {
+				updateFields["department_id"] = *employee.DepartmentID
+			}
+		}
 
+		if employee.Title != "" {
+			updateFields["title"] = employee.Title
+		}
+
+		// Non-nil tags replace the tags, an empty slice removing them all
+		if employee.Tags != nil {
+			updateFields["tags"] = tagArray(employee.Tags)
+		}
+
+		// An empty address removes the address
+		if employee.Address != nil {
+			maps.Copy(updateFields, toAddressColumns(employee.Address).updates())
+		}
+
+		if employee.PhotoKey != "" {
+			updateFields["photo_key"] = employee.PhotoKey
+		}
+
+		// uuid.Nil removes the employee's manager
+		if employee.ManagerID != nil {
+			if *employee.ManagerID == uuid.Nil {
+				updateFields["manager_id"] = nil
+			} else {
+				updateFields["manager_id"] = *employee.ManagerID
+			}
+		}
+
+		// Update employee record, only if still at the expected version
+		query := tx.Model(&EmployeeModel{}).
+			Where("id = ? AND tenant_id = ?", employee.ID, tenantID)
+		if employee.Version > 0 {
+			query = query.Where("version = ?", employee.Version)
+		}
+		result := query.Updates(updateFields)
+
+		if isForeignKeyViolation(result.Error) {
+			return referenceNotFound(result.Error)
+		}
 		if result.Error != nil {
 			return result.Error
 		}
 
 		if result.RowsAffected == 0 {
-			return biz.ErrEmployeeNotFound
+			// The employee exists (before was loaded), so its version moved on
+			return biz.ErrVersionMismatch
 		}
 
 		// Update emails if provided
@@ -116,20 +260,66 @@ func (r *employeeRepo) Update(ctx context.Context, tenantID string, employee *bi
 				return err
 			}
 
-			// Insert new emails
-			for _, email := range employee.Emails {
+			// Insert new emails, keeping the primary email when it is still
+			// listed and not replaced
+			primary := primaryEmailIndex(employee.Emails, employee.PrimaryEmail, before.PrimaryEmail)
+			for i, email := range employee.Emails {
 				emailModel := EmployeeEmailModel{
 					EmployeeID: employee.ID,
 					TenantID:   tenantID,
 					Email:      email,
+					IsPrimary:  i == primary,
+				}
+				// Kept emails stay undeliverable
+				if j := slices.IndexFunc(before.UndeliverableEmails, func(u biz.UndeliverableEmail) bool { return strings.EqualFold(u.Email, email) }); j >= 0 {
+					emailModel.UndeliverableReason = before.UndeliverableEmails[j].Reason
+					emailModel.UndeliverableAt = &before.UndeliverableEmails[j].Since
 				}
 				if err := tx.Create(&emailModel).Error; err != nil {
+					// Claimed by another employee since it was checked
+					if isUniqueViolation(err) {
+						return biz.ErrEmployeeAlreadyExists
+					}
 					return err
 				}
 			}
+		} else if employee.PrimaryEmail != "" {
+			if err := setPrimaryEmail(tx, tenantID, before, employee.PrimaryEmail); err != nil {
+				return err
+			}
 		}
 
-		return nil
+		// Non-nil phone numbers replace the phone numbers
+		if employee.PhoneNumbers != nil {
+			if err := tx.Where("employee_id = ? AND tenant_id = ?", employee.ID, tenantID).
+				Delete(&EmployeePhoneNumberModel{}).Error; err != nil {
+				return err
+			}
+			if err := insertPhoneNumbers(tx, tenantID, employee.ID, employee.PhoneNumbers); err != nil {
+				return err
+			}
+		}
+
+		// Non-nil external IDs replace the external IDs
+		if employee.ExternalIDs != nil {
+			if err := tx.Where("employee_id = ? AND tenant_id = ?", employee.ID, tenantID).
+				Delete(&EmployeeExternalIDModel{}).Error; err != nil {
+				return err
+			}
+			if err := insertExternalIDs(tx, tenantID, employee.ID, employee.ExternalIDs); err != nil {
+				return err
+			}
+		}
+
+		after, err := getByIDTx(tx, tenantID, employee.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := r.data.recordAudit(ctx, tx, tenantID, biz.AuditActionUpdate, employee.ID, before, after); err != nil {
+			return err
+		}
+		return r.data.recordJobTx(tx, tenantID, before, after)
 	})
 
 	if err != nil {
@@ -140,29 +330,306 @@ func (r *employeeRepo) Update(ctx context.Context, tenantID string, employee *bi
 	return r.GetByID(ctx, tenantID, employee.ID)
 }
 
+// setPrimaryEmail designates email, one of the emails of employee, as its
+// primary email. The unique index on primary emails is checked per row, so
+// the current primary is cleared before the new one is set.
+func setPrimaryEmail(tx *gorm.DB, tenantID string, employee *biz.Employee, email string) error {
+	if !slices.ContainsFunc(employee.Emails, func(e string) bool { return strings.EqualFold(e, email) }) {
+		return biz.ErrEmployeeEmailNotFound
+	}
+
+	if err := tx.Model(&EmployeeEmailModel{}).
+		Where("employee_id = ? AND tenant_id = ? AND is_primary AND email <> ?", employee.ID, tenantID, email).
+		Update("is_primary", false).Error; err != nil {
+		return err
+	}
+	return tx.Model(&EmployeeEmailModel{}).
+		Where("employee_id = ? AND tenant_id = ? AND email = ?", employee.ID, tenantID, email).
+		Update("is_primary", true).Error
+}
+
+// upsertEmailQuery claims an email for the employee being created or, when
+// another employee owns it, locks the email and returns that owner instead.
+// A concurrent upsert of the same email waits for this transaction.
+const upsertEmailQuery = `
+INSERT INTO employee_emails (employee_id, tenant_id, email, is_primary, created_at)
+VALUES (?, ?, ?, true, ?)
+ON CONFLICT (tenant_id, email) DO UPDATE SET email = EXCLUDED.email
+RETURNING employee_id`
+
+// UpsertByEmail creates the employee owning email, or updates the names of
+// the employee that already owns it, in one transaction.
+func (r *employeeRepo) UpsertByEmail(ctx context.Context, tenantID string, email string, employee *biz.Employee) (*biz.Employee, *biz.Employee, error) {
+	var after, before *biz.Employee
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// The new employee row is inserted first so that the email can
+		// reference it; it is dropped again when the email is taken
+		id := employee.ID
+		if id == uuid.Nil {
+			id = r.data.newID()
+		}
+		reviewStatus := employee.ReviewStatus
+		if reviewStatus == "" {
+			reviewStatus = biz.ReviewStatusApproved
+		}
+		if err := tx.Create(&EmployeeModel{
+			ID:           id,
+			TenantID:     tenantID,
+			FirstName:    employee.FirstName,
+			LastName:     employee.LastName,
+			ReviewStatus: reviewStatus,
+		}).Error; err != nil {
+			return err
+		}
+
+		var owners []uuid.UUID
+		if err := tx.Raw(upsertEmailQuery, id, tenantID, email, r.data.now()).Scan(&owners).Error; err != nil {
+			return err
+		}
+		if len(owners) == 0 {
+			return errors.New("upsert of employee email returned no owner")
+		}
+
+		if owners[0] == id {
+			if employee.FirstName == "" || employee.LastName == "" {
+				return biz.ErrEmployeeNameRequired
+			}
+			var err error
+			if after, err = getByIDTx(tx, tenantID, id); err != nil {
+				return err
+			}
+			if err := r.data.recordAudit(ctx, tx, tenantID, biz.AuditActionCreate, id, nil, after); err != nil {
+				return err
+			}
+			return r.data.recordJobTx(tx, tenantID, nil, after)
+		}
+
+		if err := tx.Where("id = ? AND tenant_id = ?", id, tenantID).Delete(&EmployeeModel{}).Error; err != nil {
+			return err
+		}
+
+		owner := owners[0]
+		var err error
+		if before, err = getByIDTx(tx, tenantID, owner); err != nil {
+			return err
+		}
+
+		updateFields := make(map[string]interface{})
+		if employee.FirstName != "" && employee.FirstName != before.FirstName {
+			updateFields["first_name"] = employee.FirstName
+		}
+		if employee.LastName != "" && employee.LastName != before.LastName {
+			updateFields["last_name"] = employee.LastName
+		}
+		// Nothing changed: keep the version, so syncs do not churn it
+		if len(updateFields) == 0 {
+			after = before
+			return nil
+		}
+		updateFields["updated_at"] = r.data.now()
+		updateFields["version"] = gorm.Expr("version + 1")
+
+		if err := tx.Model(&EmployeeModel{}).
+			Where("id = ? AND tenant_id = ?", owner, tenantID).
+			Updates(updateFields).Error; err != nil {
+			return err
+		}
+		if after, err = getByIDTx(tx, tenantID, owner); err != nil {
+			return err
+		}
+		return r.data.recordAudit(ctx, tx, tenantID, biz.AuditActionUpdate, owner, before, after)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return after, before, nil
+}
+
 // Delete deletes an employee from the database.
 func (r *employeeRepo) Delete(ctx context.Context, tenantID string, id uuid.UUID) error {
-	result := r.data.db.WithContext(ctx).
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// Capture the current state for the audit log
+		before, err := getByIDTx(tx, tenantID, id)
+		if err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ? AND tenant_id = ?", id, tenantID).
+			Delete(&EmployeeModel{})
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return biz.ErrEmployeeNotFound
+		}
+
+		if err := r.data.recordAudit(ctx, tx, tenantID, biz.AuditActionDelete, id, before, nil); err != nil {
+			return err
+		}
+		// The reports of the employee lost their manager
+		return r.data.recordReportsJobTx(tx, tenantID, []uuid.UUID{id})
+	})
+}
+
+// Approve marks an employee pending review as approved, auditing the change.
+func (r *employeeRepo) Approve(ctx context.Context, tenantID string, id uuid.UUID) (*biz.Employee, error) {
+	var after *biz.Employee
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		before, err := getByIDTx(tx, tenantID, id)
+		if err != nil {
+			return err
+		}
+
+		result := tx.Model(&EmployeeModel{}).
+			Where("id = ? AND tenant_id = ? AND review_status = ?", id, tenantID, biz.ReviewStatusPending).
+			Updates(map[string]interface{}{
+				"review_status": biz.ReviewStatusApproved,
+				"updated_at":    r.data.now(),
+				"version":       gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrEmployeeNotPendingReview
+		}
+
+		after, err = getByIDTx(tx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		return r.data.recordAudit(ctx, tx, tenantID, biz.AuditActionApprove, id, before, after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+// SetActive deactivates or reactivates an employee, auditing the change. The
+// state is checked by the update itself, so that of concurrent callers only
+// one changes the employee.
+func (r *employeeRepo) SetActive(ctx context.Context, tenantID string, id uuid.UUID, active bool) (*biz.Employee, bool, error) {
+	var after *biz.Employee
+	changed := false
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		before, err := getByIDTx(tx, tenantID, id)
+		if err != nil {
+			return err
+		}
+
+		now := r.data.now()
+		state, deactivatedAt, action := "deactivated_at IS NULL", &now, biz.AuditActionDeactivate
+		if active {
+			state, deactivatedAt, action = "deactivated_at IS NOT NULL", nil, biz.AuditActionReactivate
+		}
+		result := tx.Model(&EmployeeModel{}).
+			Where("id = ? AND tenant_id = ? AND "+state, id, tenantID).
+			Updates(map[string]interface{}{
+				"deactivated_at": deactivatedAt,
+				"updated_at":     now,
+				"version":        gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			after = before
+			return nil
+		}
+
+		changed = true
+		after, err = getByIDTx(tx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		return r.data.recordAudit(ctx, tx, tenantID, action, id, before, after)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return after, changed, nil
+}
+
+// touchTx bumps the updated_at and version of an employee whose emails were changed by
+// another operation, such as a merge, so that updated_since syncs see it.
+func (d *Data) touchTx(tx *gorm.DB, tenantID string, id uuid.UUID) error {
+	return tx.Model(&EmployeeModel{}).
 		Where("id = ? AND tenant_id = ?", id, tenantID).
-		Delete(&EmployeeModel{})
+		Updates(map[string]interface{}{"updated_at": d.now(), "version": gorm.Expr("version + 1")}).Error
+}
 
-	if result.Error != nil {
-		return result.Error
+// insertPhoneNumbers stores the phone numbers of an employee in their order
+func insertPhoneNumbers(tx *gorm.DB, tenantID string, employeeID uuid.UUID, phones []biz.PhoneNumber) error {
+	if len(phones) == 0 {
+		return nil
+	}
+	models := make([]EmployeePhoneNumberModel, len(phones))
+	for i, phone := range phones {
+		models[i] = EmployeePhoneNumberModel{
+			EmployeeID: employeeID,
+			TenantID:   tenantID,
+			Position:   i,
+			Type:       phone.Type,
+			Number:     phone.Number,
+		}
 	}
+	return tx.Create(&models).Error
+}
 
-	if result.RowsAffected == 0 {
-		return biz.ErrEmployeeNotFound
+// insertExternalIDs stores the external IDs of an employee, failing with
+// ErrExternalIDAlreadyExists when one belongs to another employee
+func insertExternalIDs(tx *gorm.DB, tenantID string, employeeID uuid.UUID, ids map[string]string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	models := make([]EmployeeExternalIDModel, 0, len(ids))
+	for _, system := range slices.Sorted(maps.Keys(ids)) {
+		models = append(models, EmployeeExternalIDModel{
+			EmployeeID: employeeID,
+			TenantID:   tenantID,
+			System:     system,
+			ExternalID: ids[system],
+		})
 	}
+	err := tx.Create(&models).Error
+	if isUniqueViolation(err) {
+		return biz.ErrExternalIDAlreadyExists
+	}
+	return err
+}
 
-	return nil
+// getByIDTx loads an employee with emails using the given transaction.
+func getByIDTx(tx *gorm.DB, tenantID string, id uuid.UUID) (*biz.Employee, error) {
+	var model EmployeeModel
+
+	err := tx.Preload("Emails", orderedEmails).
+		Preload("PhoneNumbers", orderedPhoneNumbers).
+		Preload("ExternalIDs").
+		Where("id = ? AND tenant_id = ?", id, tenantID).
+		First(&model).Error
+
+	if err == gorm.ErrRecordNotFound {
+		return nil, biz.ErrEmployeeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
 }
 
 // GetByID retrieves an employee by ID within tenant.
 func (r *employeeRepo) GetByID(ctx context.Context, tenantID string, id uuid.UUID) (*biz.Employee, error) {
 	var model EmployeeModel
 
-	err := r.data.db.WithContext(ctx).
-		Preload("Emails").
+	err := r.data.DB(ctx).
+		Preload("Emails", orderedEmails).
+		Preload("PhoneNumbers", orderedPhoneNumbers).
+		Preload("ExternalIDs").
 		Where("id = ? AND tenant_id = ?", id, tenantID).
 		First(&model).Error
 
@@ -176,12 +643,13 @@ func (r *employeeRepo) GetByID(ctx context.Context, tenantID string, id uuid.UUI
 	return model.ToEntity(), nil
 }
 
-// GetByEmail retrieves an employee by email within tenant.
+// GetByEmail retrieves an employee by email within tenant. employee_emails.email
+// is citext, so the email matches in any case.
 func (r *employeeRepo) GetByEmail(ctx context.Context, tenantID string, email string) (*biz.Employee, error) {
 	var emailModel EmployeeEmailModel
 
 	// Find the email record first
-	err := r.data.db.WithContext(ctx).
+	err := r.data.DB(ctx).
 		Where("email = ? AND tenant_id = ?", email, tenantID).
 		First(&emailModel).Error
 
@@ -196,35 +664,64 @@ func (r *employeeRepo) GetByEmail(ctx context.Context, tenantID string, email st
 	return r.GetByID(ctx, tenantID, emailModel.EmployeeID)
 }
 
+// GetByExternalID retrieves the employee with an ID in an external system
+// within tenant.
+func (r *employeeRepo) GetByExternalID(ctx context.Context, tenantID string, system string, externalID string) (*biz.Employee, error) {
+	var idModel EmployeeExternalIDModel
+
+	err := r.data.DB(ctx).
+		Where("tenant_id = ? AND system = ? AND external_id = ?", tenantID, system, externalID).
+		First(&idModel).Error
+
+	if err == gorm.ErrRecordNotFound {
+		return nil, biz.ErrEmployeeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, tenantID, idModel.EmployeeID)
+}
+
 // List retrieves employees with pagination and filtering within tenant.
 func (r *employeeRepo) List(ctx context.Context, tenantID string, filter *biz.ListFilter) (*biz.ListResult, error) {
 	var models []EmployeeModel
 	var total int64
 
-	query := r.data.db.WithContext(ctx).
-		Model(&EmployeeModel{}).
-		Where("tenant_id = ?", tenantID)
+	query := filterEmployees(r.data.DB(ctx).Model(&EmployeeModel{}), tenantID, filter)
 
-	// Apply date filters
-	if filter.CreatedAfter != nil {
-		query = query.Where("created_at >= ?", filter.CreatedAfter)
-	}
-	if filter.CreatedBefore != nil {
-		query = query.Where("created_at <= ?", filter.CreatedBefore)
+	// Employees pending review are only listed by the review queue
+	reviewStatus := filter.ReviewStatus
+	if reviewStatus == "" {
+		reviewStatus = biz.ReviewStatusApproved
 	}
+	query = query.Where("review_status = ?", reviewStatus)
 
 	// Get total count
 	if err := query.Count(&total).Error; err != nil {
 		return nil, err
 	}
 
+	// Incremental syncs read changes oldest first, so that the last
+	// updated_at seen is where the next poll starts
+	order := "created_at DESC"
+	switch {
+	case filter.UpdatedSince != nil:
+		order = "updated_at, id"
+	case reviewStatus == biz.ReviewStatusPending:
+		// The review queue is worked oldest first
+		order = "created_at, id"
+	}
+
 	// Apply pagination and preload emails
 	offset := (filter.Page - 1) * filter.PageSize
 	if err := query.
-		Preload("Emails").
+		Preload("Emails", orderedEmails).
+		Preload("PhoneNumbers", orderedPhoneNumbers).
+		Preload("ExternalIDs").
 		Offset(int(offset)).
 		Limit(int(filter.PageSize)).
-		Order("created_at DESC").
+		Order(order).
 		Find(&models).Error; err != nil {
 		return nil, err
 	}
@@ -241,11 +738,76 @@ func (r *employeeRepo) List(ctx context.Context, tenantID string, filter *biz.Li
 	}, nil
 }
 
-// CheckEmailExists checks if an email exists within tenant.
+// filterEmployees applies the tenant, date, name and email filters of a
+// listing to query; the review status is left to the caller
+func filterEmployees(query *gorm.DB, tenantID string, filter *biz.ListFilter) *gorm.DB {
+	query = query.Where("tenant_id = ?", tenantID)
+
+	// Apply date filters
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at <= ?", filter.CreatedBefore)
+	}
+	if filter.UpdatedSince != nil {
+		query = query.Where("updated_at >= ?", filter.UpdatedSince)
+	}
+	if filter.ActiveOnly {
+		query = query.Where("deactivated_at IS NULL")
+	}
+	// The stale flag lapses once the employee changes, see biz.Employee.IsStale
+	if filter.Stale != nil {
+		if *filter.Stale {
+			query = query.Where("stale_at >= updated_at")
+		} else {
+			query = query.Where("stale_at IS NULL OR stale_at < updated_at")
+		}
+	}
+
+	// Apply name and email filters; each has a matching index (migration 000010)
+	if filter.NamePrefix != "" {
+		prefix := escapeLike(filter.NamePrefix) + "%"
+		if strings.Contains(filter.NamePrefix, " ") {
+			query = query.Where("lower(first_name || ' ' || last_name) LIKE ?", prefix)
+		} else {
+			query = query.Where("lower(first_name) LIKE ? OR lower(last_name) LIKE ?", prefix, prefix)
+		}
+	}
+	if filter.EmailDomain != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM employee_emails ee WHERE ee.employee_id = employees.id AND ee.tenant_id = ? AND lower(split_part(ee.email, '@', 2)) = ?)",
+			tenantID, filter.EmailDomain)
+	}
+	if filter.EmailContains != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM employee_emails ee WHERE ee.employee_id = employees.id AND ee.tenant_id = ? AND lower(ee.email) LIKE ?)",
+			tenantID, "%"+escapeLike(filter.EmailContains)+"%")
+	}
+	if filter.DepartmentID != nil {
+		query = query.Where("department_id = ?", *filter.DepartmentID)
+	}
+	if filter.ManagerID != nil {
+		query = query.Where("manager_id = ?", *filter.ManagerID)
+	}
+	if len(filter.Tags) > 0 {
+		query = query.Where("tags @> ?", pq.StringArray(filter.Tags))
+	}
+	return query
+}
+
+// likeEscaper escapes the LIKE wildcards of user input; backslash is the
+// default LIKE escape character in PostgreSQL
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike returns s matching itself literally in a LIKE pattern
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// CheckEmailExists checks if an email exists within tenant, in any case.
 func (r *employeeRepo) CheckEmailExists(ctx context.Context, tenantID string, email string) (bool, error) {
 	var count int64
 
-	err := r.data.db.WithContext(ctx).
+	err := r.data.DB(ctx).
 		Model(&EmployeeEmailModel{}).
 		Where("email = ? AND tenant_id = ?", email, tenantID).
 		Count(&count).Error
@@ -257,12 +819,42 @@ func (r *employeeRepo) CheckEmailExists(ctx context.Context, tenantID string, em
 	return count > 0, nil
 }
 
+// CheckEmailsExist checks which of the given emails exist within tenant,
+// using one query. Emails are matched ignoring case, so an email is reported
+// taken in whatever case it was stored.
+func (r *employeeRepo) CheckEmailsExist(ctx context.Context, tenantID string, emails []string) (map[string]bool, error) {
+	exists := make(map[string]bool, len(emails))
+	if len(emails) == 0 {
+		return exists, nil
+	}
+
+	var existing []string
+	err := r.data.DB(ctx).
+		Model(&EmployeeEmailModel{}).
+		Where("tenant_id = ? AND email IN ?", tenantID, emails).
+		Pluck("email", &existing).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	taken := make(map[string]bool, len(existing))
+	for _, email := range existing {
+		taken[strings.ToLower(email)] = true
+	}
+	for _, email := range emails {
+		exists[email] = taken[strings.ToLower(email)]
+	}
+	return exists, nil
+}
+
 // MergeEmployees merges two employees by transferring all emails from secondary to primary.
-func (r *employeeRepo) MergeEmployees(ctx context.Context, tenantID string, primaryEmail string, secondaryEmail string) (*biz.Employee, error) {
-	var result *biz.Employee
+// The secondary employee is kept in employee_merges so that the merge can be undone.
+func (r *employeeRepo) MergeEmployees(ctx context.Context, tenantID string, primaryEmail string, secondaryEmail string) (*biz.Merge, error) {
+	var merge *MergeModel
 
 	// Start transaction
-	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
 		// Get primary employee email record
 		var primaryEmailModel EmployeeEmailModel
 		if err := tx.Where("email = ? AND tenant_id = ?", primaryEmail, tenantID).First(&primaryEmailModel).Error; err != nil {
@@ -275,41 +867,111 @@ func (r *employeeRepo) MergeEmployees(ctx context.Context, tenantID string, prim
 			return err
 		}
 
-		primaryEmployeeID := primaryEmailModel.EmployeeID
-		secondaryEmployeeID := secondaryEmailModel.EmployeeID
+		var err error
+		merge, err = r.data.mergeTx(ctx, tx, tenantID, primaryEmailModel.EmployeeID, secondaryEmailModel.EmployeeID)
+		return err
+	})
 
-		// Transfer all emails from secondary employee to primary employee
-		if err := tx.Model(&EmployeeEmailModel{}).
-			Where("employee_id = ? AND tenant_id = ?", secondaryEmployeeID, tenantID).
-			Update("employee_id", primaryEmployeeID).Error; err != nil {
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mergeResult(ctx, merge)
+}
+
+// GetByIDs retrieves the employees with the given IDs within tenant.
+// IDs that do not exist in the tenant are skipped.
+func (r *employeeRepo) GetByIDs(ctx context.Context, tenantID string, ids []uuid.UUID) ([]*biz.Employee, error) {
+	var models []EmployeeModel
+
+	if err := r.data.DB(ctx).
+		Preload("Emails", orderedEmails).
+		Preload("PhoneNumbers", orderedPhoneNumbers).
+		Preload("ExternalIDs").
+		Where("id IN ? AND tenant_id = ?", ids, tenantID).
+		Order("created_at DESC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	employees := make([]*biz.Employee, len(models))
+	for i, model := range models {
+		employees[i] = model.ToEntity()
+	}
+
+	return employees, nil
+}
+
+// Count returns the number of employees matching the filter within tenant.
+// Pagination fields of the filter are ignored.
+func (r *employeeRepo) Count(ctx context.Context, tenantID string, filter *biz.ListFilter) (int64, error) {
+	var total int64
+
+	query := filterEmployees(r.data.DB(ctx).Model(&EmployeeModel{}), tenantID, filter)
+	if filter.ReviewStatus != "" {
+		query = query.Where("review_status = ?", filter.ReviewStatus)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// DeleteByIDs deletes the employees with the given IDs within tenant.
+// Emails are removed by the ON DELETE CASCADE constraint.
+func (r *employeeRepo) DeleteByIDs(ctx context.Context, tenantID string, ids []uuid.UUID) (int64, error) {
+	var deleted int64
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := recordDeleteAudits(ctx, tx, "e.id IN ? AND e.tenant_id = ?", ids, tenantID); err != nil {
 			return err
 		}
 
-		// Delete secondary employee record
-		if err := tx.Where("id = ? AND tenant_id = ?", secondaryEmployeeID, tenantID).
-			Delete(&EmployeeModel{}).Error; err != nil {
-			return err
+		result := tx.Where("id IN ? AND tenant_id = ?", ids, tenantID).
+			Delete(&EmployeeModel{})
+		if result.Error != nil {
+			return result.Error
 		}
+		deleted = result.RowsAffected
 
-		return nil
+		return r.data.recordReportsJobTx(tx, tenantID, ids)
 	})
 
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	// Fetch the merged employee with all emails
-	primaryEmailModel := EmployeeEmailModel{}
-	if err := r.data.db.WithContext(ctx).
-		Where("email = ? AND tenant_id = ?", primaryEmail, tenantID).
-		First(&primaryEmailModel).Error; err != nil {
-		return nil, err
-	}
+	return deleted, nil
+}
+
+// DeleteAll deletes every employee of the tenant.
+func (r *employeeRepo) DeleteAll(ctx context.Context, tenantID string) (int64, error) {
+	var deleted int64
+
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := recordDeleteAudits(ctx, tx, "e.tenant_id = ?", tenantID); err != nil {
+			return err
+		}
+
+		if err := tx.Where("tenant_id = ?", tenantID).
+			Delete(&EmployeeEmailModel{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("tenant_id = ?", tenantID).Delete(&EmployeeModel{})
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted = result.RowsAffected
+
+		return nil
+	})
 
-	result, err = r.GetByID(ctx, tenantID, primaryEmailModel.EmployeeID)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return result, nil
+	return deleted, nil
 }
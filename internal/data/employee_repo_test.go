@@ -0,0 +1,508 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapeLike(t *testing.T) {
+	assert.Equal(t, `jo\_n\%`, escapeLike("jo_n%"))
+	assert.Equal(t, `a\\b`, escapeLike(`a\b`))
+	assert.Equal(t, "plain", escapeLike("plain"))
+}
+
+func TestList_NameAndEmailFilters(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *biz.ListFilter
+		where  string
+		args   []driver.Value
+	}{
+		{
+			name:   "name prefix",
+			filter: &biz.ListFilter{NamePrefix: "jo_"},
+			where:  `lower\(first_name\) LIKE \$2 OR lower\(last_name\) LIKE \$3`,
+			args:   []driver.Value{"tenant-1", `jo\_%`, `jo\_%`, "approved"},
+		},
+		{
+			name:   "full name prefix",
+			filter: &biz.ListFilter{NamePrefix: "john sm"},
+			where:  `lower\(first_name \|\| ' ' \|\| last_name\) LIKE \$2`,
+			args:   []driver.Value{"tenant-1", "john sm%", "approved"},
+		},
+		{
+			name:   "email domain",
+			filter: &biz.ListFilter{EmailDomain: "example.com"},
+			where:  `EXISTS \(SELECT 1 FROM employee_emails ee .* lower\(split_part\(ee.email, '@', 2\)\) = \$3\)`,
+			args:   []driver.Value{"tenant-1", "tenant-1", "example.com", "approved"},
+		},
+		{
+			name:   "email contains",
+			filter: &biz.ListFilter{EmailContains: "50%"},
+			where:  `EXISTS \(SELECT 1 FROM employee_emails ee .* lower\(ee.email\) LIKE \$3\)`,
+			args:   []driver.Value{"tenant-1", "tenant-1", `%50\%%`, "approved"},
+		},
+		{
+			name:   "tags",
+			filter: &biz.ListFilter{Tags: []string{"remote", "contractor"}},
+			where:  `tags @> \$2`,
+			args:   []driver.Value{"tenant-1", `{"remote","contractor"}`, "approved"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, mock := newMockData(t)
+			repo := &employeeRepo{data: d}
+			tt.filter.Page, tt.filter.PageSize = 1, 20
+
+			mock.ExpectQuery(`SELECT count\(\*\) FROM "employees" WHERE tenant_id = \$1 AND \(?` + tt.where).
+				WithArgs(tt.args...).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			mock.ExpectQuery(`SELECT \* FROM "employees" WHERE tenant_id = \$1 AND \(?` + tt.where).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			result, err := repo.List(context.Background(), "tenant-1", tt.filter)
+
+			require.NoError(t, err)
+			assert.Empty(t, result.Employees)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestList_UpdatedSince(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	since := time.Now().Add(-time.Hour)
+
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "employees" WHERE tenant_id = \$1 AND updated_at >= \$2 AND review_status = \$3`).
+		WithArgs("tenant-1", since, "approved").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(`SELECT \* FROM "employees" WHERE tenant_id = \$1 AND updated_at >= \$2 AND review_status = \$3 ORDER BY updated_at, id LIMIT \$4`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := repo.List(context.Background(), "tenant-1", &biz.ListFilter{Page: 1, PageSize: 20, UpdatedSince: &since})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCount_Filters(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *biz.ListFilter
+		query  string
+		args   []driver.Value
+	}{
+		{
+			name:   "any review status",
+			filter: &biz.ListFilter{},
+			query:  `SELECT count\(\*\) FROM "employees" WHERE tenant_id = \$1$`,
+			args:   []driver.Value{"tenant-1"},
+		},
+		{
+			name:   "list filters",
+			filter: &biz.ListFilter{EmailDomain: "example.com", ReviewStatus: biz.ReviewStatusApproved},
+			query:  `SELECT count\(\*\) FROM "employees" WHERE tenant_id = \$1 AND \(?EXISTS \(.* lower\(split_part\(ee.email, '@', 2\)\) = \$3\)\)? AND review_status = \$4`,
+			args:   []driver.Value{"tenant-1", "tenant-1", "example.com", "approved"},
+		},
+		{
+			name:   "stale",
+			filter: &biz.ListFilter{Stale: &[]bool{true}[0]},
+			query:  `SELECT count\(\*\) FROM "employees" WHERE tenant_id = \$1 AND stale_at >= updated_at$`,
+			args:   []driver.Value{"tenant-1"},
+		},
+		{
+			name:   "active only",
+			filter: &biz.ListFilter{ActiveOnly: true},
+			query:  `SELECT count\(\*\) FROM "employees" WHERE tenant_id = \$1 AND deactivated_at IS NULL$`,
+			args:   []driver.Value{"tenant-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, mock := newMockData(t)
+			repo := &employeeRepo{data: d}
+
+			mock.ExpectQuery(tt.query).
+				WithArgs(tt.args...).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+			total, err := repo.Count(context.Background(), "tenant-1", tt.filter)
+
+			require.NoError(t, err)
+			assert.Equal(t, int64(7), total)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCheckEmailsExist(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+
+	mock.ExpectQuery(`SELECT "email" FROM "employee_emails" WHERE tenant_id = \$1 AND email IN \(\$2,\$3\)`).
+		WithArgs("tenant-1", "a@example.com", "b@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow("b@example.com"))
+
+	exists, err := repo.CheckEmailsExist(context.Background(), "tenant-1", []string{"a@example.com", "b@example.com"})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"a@example.com": false, "b@example.com": true}, exists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	exists, err = repo.CheckEmailsExist(context.Background(), "tenant-1", nil)
+	require.NoError(t, err)
+	assert.Empty(t, exists, "no emails need no query")
+}
+
+func TestCheckEmailsExist_IgnoresCase(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+
+	// employee_emails.email is citext: the database matches in any case and
+	// returns the email as stored
+	mock.ExpectQuery(`SELECT "email" FROM "employee_emails" WHERE tenant_id = \$1 AND email IN \(\$2\)`).
+		WithArgs("tenant-1", "foo@x.com").
+		WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow("Foo@X.com"))
+
+	exists, err := repo.CheckEmailsExist(context.Background(), "tenant-1", []string{"foo@x.com"})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"foo@x.com": true}, exists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInsertExternalIDs_Taken(t *testing.T) {
+	d, mock := newMockData(t)
+	id := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "employee_external_ids" .* VALUES \(\$1,\$2,\$3,\$4,\$5\),\(\$6,\$7,\$8,\$9,\$10\)`).
+		WithArgs(id, "tenant-1", "bamboohr", "42", sqlmock.AnyArg(), id, "tenant-1", "workday", "W-1", sqlmock.AnyArg()).
+		WillReturnError(&pgconn.PgError{Code: "23505"})
+	mock.ExpectRollback()
+
+	err := insertExternalIDs(d.db, "tenant-1", id, map[string]string{"workday": "W-1", "bamboohr": "42"})
+
+	assert.Equal(t, biz.ErrExternalIDAlreadyExists, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdate_VersionMismatch(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	id := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "employees" WHERE id = \$1 AND tenant_id = \$2`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "first_name", "last_name", "version"}).AddRow(id, "tenant-1", "Jane", "Doe", 3))
+	mock.ExpectQuery(`SELECT \* FROM "employee_emails"`).
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id", "email"}).AddRow(id, "jane@example.com"))
+	mock.ExpectQuery(`SELECT \* FROM "employee_external_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "system", "external_id"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_phone_numbers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "number"}))
+	mock.ExpectExec(`UPDATE "employees" SET .*"version"=version \+ 1 WHERE \(id = \$\d AND tenant_id = \$\d\) AND version = \$\d`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err := repo.Update(context.Background(), "tenant-1", &biz.Employee{ID: id, FirstName: "Janet", Version: 2})
+
+	assert.Equal(t, biz.ErrVersionMismatch, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetActive_Unchanged(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	id := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "employees" WHERE id = \$1 AND tenant_id = \$2`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "first_name", "last_name", "version", "deactivated_at"}).AddRow(id, "tenant-1", "Jane", "Doe", 3, time.Now()))
+	mock.ExpectQuery(`SELECT \* FROM "employee_emails"`).
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id", "email"}).AddRow(id, "jane@example.com"))
+	mock.ExpectQuery(`SELECT \* FROM "employee_external_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "system", "external_id"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_phone_numbers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "number"}))
+	// Already deactivated, so the update matches no row and nothing is audited
+	mock.ExpectExec(`UPDATE "employees" SET .*"version"=version \+ 1 WHERE id = \$\d AND tenant_id = \$\d AND deactivated_at IS NULL`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	employee, changed, err := repo.SetActive(context.Background(), "tenant-1", id, false)
+
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.False(t, employee.IsActive())
+	assert.Equal(t, int64(3), employee.Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStream_Filters(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	since := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	departmentID := uuid.New()
+
+	mock.ExpectQuery(`FROM employees e\s+WHERE e.tenant_id = \$1 AND e.review_status = \$2 AND e.updated_at >= \$3 AND e.deactivated_at IS NULL ` +
+		`AND \(lower\(e.first_name\) LIKE \$4 OR lower\(e.last_name\) LIKE \$5\) ` +
+		`AND EXISTS \(SELECT 1 FROM employee_emails ee WHERE ee.employee_id = e.id AND ee.tenant_id = \$6 AND lower\(split_part\(ee.email, '@', 2\)\) = \$7\) ` +
+		`AND EXISTS \(SELECT 1 FROM employee_emails ee WHERE ee.employee_id = e.id AND ee.tenant_id = \$8 AND lower\(ee.email\) LIKE \$9\) ` +
+		`AND e.department_id = \$10 AND e.tags @> \$11 ORDER BY e.created_at, e.id`).
+		WithArgs("tenant-1", biz.ReviewStatusApproved, since, `jo\_%`, `jo\_%`, "tenant-1", "example.com", "tenant-1", "%smith%", departmentID, pq.StringArray{"remote"}).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	it, err := repo.Stream(context.Background(), "tenant-1", &biz.StreamFilter{
+		NamePrefix:    "jo_",
+		EmailDomain:   "example.com",
+		EmailContains: "smith",
+		UpdatedSince:  &since,
+		DepartmentID:  &departmentID,
+		Tags:          []string{"remote"},
+		ActiveOnly:    true,
+	})
+	require.NoError(t, err)
+	defer it.Close()
+
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Err())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFlagStale(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	before := now.AddDate(0, -6, 0)
+	idA, idB := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(`UPDATE employees SET stale_at = \$1 .* FOR UPDATE SKIP LOCKED\s+\)\s+RETURNING id, tenant_id`).
+		WithArgs(now, before, biz.ReviewStatusApproved, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id"}).AddRow(idA, "tenant-1").AddRow(idB, "tenant-2"))
+	for _, f := range []struct {
+		id     uuid.UUID
+		tenant string
+	}{{idA, "tenant-1"}, {idB, "tenant-2"}} {
+		mock.ExpectQuery(`SELECT \* FROM "employees" WHERE id IN \(\$1\) AND tenant_id = \$2`).
+			WithArgs(f.id, f.tenant).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "updated_at", "stale_at"}).AddRow(f.id, f.tenant, before.AddDate(0, -1, 0), now))
+		mock.ExpectQuery(`SELECT \* FROM "employee_emails"`).
+			WillReturnRows(sqlmock.NewRows([]string{"employee_id", "email"}))
+		mock.ExpectQuery(`SELECT \* FROM "employee_external_ids"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "system", "external_id"}))
+		mock.ExpectQuery(`SELECT \* FROM "employee_phone_numbers"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "number"}))
+	}
+
+	employees, err := repo.FlagStale(context.Background(), &biz.StaleCriteria{UntouchedBefore: before, Undeliverable: biz.StaleUndeliverableIgnore}, now, 100)
+
+	require.NoError(t, err)
+	require.Len(t, employees, 2)
+	assert.Equal(t, "tenant-1", employees[0].TenantID)
+	assert.Equal(t, "tenant-2", employees[1].TenantID)
+	assert.True(t, employees[0].IsStale())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFlagStale_Undeliverable(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	before := now.AddDate(0, -6, 0)
+
+	tests := []struct {
+		undeliverable string
+		wantCondition string
+	}{
+		{undeliverable: biz.StaleUndeliverableIgnore, wantCondition: `WHERE updated_at < \$2 AND deactivated_at IS NULL`},
+		{undeliverable: biz.StaleUndeliverableRequire, wantCondition: `WHERE updated_at < \$2 AND EXISTS \(SELECT 1 FROM employee_emails ee WHERE ee.employee_id = employees.id AND ee.undeliverable_at IS NOT NULL\) AND deactivated_at IS NULL`},
+		{undeliverable: biz.StaleUndeliverableAccept, wantCondition: `WHERE \(updated_at < \$2 OR EXISTS \(SELECT 1 FROM employee_emails ee WHERE ee.employee_id = employees.id AND ee.undeliverable_at IS NOT NULL\)\) AND deactivated_at IS NULL`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.undeliverable, func(t *testing.T) {
+			d, mock := newMockData(t)
+			repo := &employeeRepo{data: d}
+			mock.ExpectQuery(tt.wantCondition).
+				WithArgs(now, before, biz.ReviewStatusApproved, 100).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id"}))
+
+			employees, err := repo.FlagStale(context.Background(), &biz.StaleCriteria{UntouchedBefore: before, Undeliverable: tt.undeliverable}, now, 100)
+
+			require.NoError(t, err)
+			assert.Empty(t, employees)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMarkEmailsUndeliverable(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	id := uuid.New()
+
+	mock.ExpectQuery(`UPDATE employee_emails SET undeliverable_reason = \$1, undeliverable_at = \$2 WHERE tenant_id = \$3 AND email = \$4 AND undeliverable_at IS NULL RETURNING employee_id`).
+		WithArgs(biz.EmailBounceReasonBounce, now, "tenant-1", "jane@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id"}).AddRow(id))
+	// Already marked, or of no employee
+	mock.ExpectQuery(`UPDATE employee_emails SET undeliverable_reason`).
+		WithArgs(biz.EmailBounceReasonComplaint, now, "tenant-1", "jane.doe@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id"}))
+	mock.ExpectQuery(`SELECT \* FROM "employees" WHERE id IN \(\$1\) AND tenant_id = \$2`).
+		WithArgs(id, "tenant-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id"}).AddRow(id, "tenant-1"))
+	mock.ExpectQuery(`SELECT \* FROM "employee_emails"`).
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id", "email", "is_primary", "undeliverable_reason", "undeliverable_at"}).
+			AddRow(id, "jane@example.com", true, biz.EmailBounceReasonBounce, now).
+			AddRow(id, "jane.doe@example.com", false, "", nil))
+	mock.ExpectQuery(`SELECT \* FROM "employee_external_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "system", "external_id"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_phone_numbers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "number"}))
+
+	marked, employees, err := repo.MarkEmailsUndeliverable(context.Background(), "tenant-1", []biz.EmailBounce{
+		{Email: "jane@example.com", Reason: biz.EmailBounceReasonBounce, OccurredAt: now},
+		{Email: "jane.doe@example.com", Reason: biz.EmailBounceReasonComplaint, OccurredAt: now},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jane@example.com"}, marked)
+	require.Len(t, employees, 1)
+	assert.Equal(t, []biz.UndeliverableEmail{{Email: "jane@example.com", Reason: biz.EmailBounceReasonBounce, Since: now}}, employees[0].UndeliverableEmails)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdate_SetPrimaryEmail(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	id := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "employees" WHERE id = \$1 AND tenant_id = \$2`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "first_name", "last_name", "version"}).AddRow(id, "tenant-1", "Jane", "Doe", 3))
+	mock.ExpectQuery(`SELECT \* FROM "employee_emails" .* ORDER BY is_primary DESC, created_at, id`).
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id", "email", "is_primary"}).
+			AddRow(id, "jane@example.com", true).
+			AddRow(id, "jane.doe@example.com", false))
+	mock.ExpectQuery(`SELECT \* FROM "employee_external_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "system", "external_id"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_phone_numbers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "number"}))
+	mock.ExpectExec(`UPDATE "employees" SET`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// The current primary is cleared first, as the unique index on primary
+	// emails is checked per row
+	mock.ExpectExec(`UPDATE "employee_emails" SET "is_primary"=\$1 WHERE employee_id = \$2 AND tenant_id = \$3 AND is_primary AND email <> \$4`).
+		WithArgs(false, id, "tenant-1", "jane.doe@example.com").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "employee_emails" SET "is_primary"=\$1 WHERE employee_id = \$2 AND tenant_id = \$3 AND email = \$4`).
+		WithArgs(true, id, "tenant-1", "jane.doe@example.com").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// Fails reading the employee back, ending the test
+	mock.ExpectQuery(`SELECT \* FROM "employees"`).
+		WillReturnError(errors.New("connection reset"))
+	mock.ExpectRollback()
+
+	_, err := repo.Update(context.Background(), "tenant-1", &biz.Employee{ID: id, Version: 3, PrimaryEmail: "jane.doe@example.com"})
+
+	assert.EqualError(t, err, "connection reset")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdate_PrimaryEmailNotListed(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	id := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "employees" WHERE id = \$1 AND tenant_id = \$2`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "first_name", "last_name", "version"}).AddRow(id, "tenant-1", "Jane", "Doe", 3))
+	mock.ExpectQuery(`SELECT \* FROM "employee_emails"`).
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id", "email", "is_primary"}).AddRow(id, "jane@example.com", true))
+	mock.ExpectQuery(`SELECT \* FROM "employee_external_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "system", "external_id"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_phone_numbers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "number"}))
+	mock.ExpectExec(`UPDATE "employees" SET`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	_, err := repo.Update(context.Background(), "tenant-1", &biz.Employee{ID: id, Version: 3, PrimaryEmail: "john@example.com"})
+
+	assert.Equal(t, biz.ErrEmployeeEmailNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFromEntity_PrimaryEmail(t *testing.T) {
+	tests := []struct {
+		name    string
+		primary string
+		want    []bool
+	}{
+		{name: "designated", primary: "JANE.DOE@example.com", want: []bool{false, true}},
+		{name: "defaults to the first email", want: []bool{true, false}},
+		{name: "not listed", primary: "john@example.com", want: []bool{true, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := FromEntity(&biz.Employee{Emails: []string{"jane@example.com", "jane.doe@example.com"}, PrimaryEmail: tt.primary})
+
+			var got []bool
+			for _, email := range m.Emails {
+				got = append(got, email.IsPrimary)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUpsertByEmail_UnchangedOwner(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	newID, ownerID := uuid.New(), uuid.New()
+	d.ids = biz.IDGeneratorFunc(func() uuid.UUID { return newID })
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "employees"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO employee_emails .* ON CONFLICT \(tenant_id, email\) DO UPDATE SET email = EXCLUDED.email\s+RETURNING employee_id`).
+		WithArgs(newID, "tenant-1", "jane@example.com", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id"}).AddRow(ownerID))
+	mock.ExpectExec(`DELETE FROM "employees" WHERE id = \$1 AND tenant_id = \$2`).
+		WithArgs(newID, "tenant-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT \* FROM "employees" WHERE id = \$1 AND tenant_id = \$2`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "first_name", "last_name", "version"}).AddRow(ownerID, "tenant-1", "Jane", "Doe", 3))
+	mock.ExpectQuery(`SELECT \* FROM "employee_emails"`).
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id", "email"}).AddRow(ownerID, "jane@example.com"))
+	mock.ExpectQuery(`SELECT \* FROM "employee_external_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "system", "external_id"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_phone_numbers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "number"}))
+	mock.ExpectCommit()
+
+	after, before, err := repo.UpsertByEmail(context.Background(), "tenant-1", "jane@example.com", &biz.Employee{FirstName: "Jane", LastName: "Doe"})
+
+	require.NoError(t, err)
+	assert.Equal(t, ownerID, after.ID)
+	assert.Equal(t, int64(3), after.Version, "an upsert changing nothing keeps the version")
+	assert.Same(t, before, after)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,306 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/observability"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultShadowConcurrency = 16
+	defaultShadowTimeout     = 5 * time.Second
+
+	shadowResultMatch    = "match"
+	shadowResultMismatch = "mismatch"
+	shadowResultError    = "error"
+	shadowResultSkipped  = "skipped"
+)
+
+// shadowRepos are the repository implementations employee reads can be
+// shadowed to, by the name used in data.shadow_read.repo
+var shadowRepos = map[string]func(d *Data, logger log.Logger) biz.EmployeeRepo{
+	"gorm": func(d *Data, logger log.Logger) biz.EmployeeRepo {
+		return &employeeRepo{data: d, log: log.NewHelper(logger)}
+	},
+}
+
+// shadowRead holds the configured shadow repository
+type shadowRead struct {
+	repo       biz.EmployeeRepo
+	sampleRate float64
+	timeout    time.Duration
+	slots      chan struct{}
+	// close releases the shadow database, nil when it is the primary one
+	close func() error
+}
+
+// newShadowRead creates the shadow repository configured in c, or returns nil
+// when shadow reads are disabled. The shadow repository reads primary unless
+// c names a database of its own, with its own driver.
+func newShadowRead(c *conf.Data_ShadowRead, primary *gorm.DB, primaryDialect dialect, clock biz.Clock, ids biz.IDGenerator, logger log.Logger) (*shadowRead, error) {
+	if c.GetRepo() == "" {
+		return nil, nil
+	}
+	newRepo, ok := shadowRepos[c.GetRepo()]
+	if !ok {
+		return nil, fmt.Errorf("unknown shadow repository %q", c.GetRepo())
+	}
+	if c.GetSampleRate() < 0 || c.GetSampleRate() > 1 {
+		return nil, fmt.Errorf("shadow sample rate %v is not between 0 and 1", c.GetSampleRate())
+	}
+
+	s := &shadowRead{
+		sampleRate: c.GetSampleRate(),
+		timeout:    defaultShadowTimeout,
+		slots:      make(chan struct{}, defaultShadowConcurrency),
+	}
+	if s.sampleRate == 0 {
+		s.sampleRate = 1
+	}
+	if c.GetTimeout() != nil && c.GetTimeout().AsDuration() > 0 {
+		s.timeout = c.GetTimeout().AsDuration()
+	}
+	if c.GetMaxConcurrency() > 0 {
+		s.slots = make(chan struct{}, c.GetMaxConcurrency())
+	}
+
+	db, dialect := primary, primaryDialect
+	if c.GetDatabase().GetSource() != "" {
+		var err error
+		if dialect, err = newDialect(c.GetDatabase().GetDriver()); err != nil {
+			return nil, fmt.Errorf("shadow database: %w", err)
+		}
+		db, err = dialect.open(c.GetDatabase().GetSource(), clock)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to shadow database: %w", err)
+		}
+		s.close = func() error {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return err
+			}
+			return sqlDB.Close()
+		}
+	}
+	s.repo = newRepo(&Data{db: db, dialect: dialect, clock: clock, ids: ids}, logger)
+	return s, nil
+}
+
+// shadowEmployeeRepo serves reads from the wrapped repository and repeats a
+// sample of them against a shadow repository in the background, counting
+// whether the results match. Shadow reads never affect responses: they run
+// after the primary read returned, are skipped when all slots are busy, and
+// their failures are only counted. Reads inside transactions, which the
+// shadow repository cannot see, and writes are not shadowed.
+//
+// A write committed between the primary and the shadow read shows up as a
+// mismatch, so a low mismatch rate is expected on busy tenants.
+type shadowEmployeeRepo struct {
+	biz.EmployeeRepo
+
+	shadow *shadowRead
+	obs    *observability.Observability
+	log    *log.Helper
+	// done is called with the result of every finished shadow read, for tests
+	done func(operation, result string)
+}
+
+// newShadowEmployeeRepo wraps repo with shadow reads
+func newShadowEmployeeRepo(repo biz.EmployeeRepo, shadow *shadowRead, obs *observability.Observability, logger log.Logger) biz.EmployeeRepo {
+	return &shadowEmployeeRepo{
+		EmployeeRepo: repo,
+		shadow:       shadow,
+		obs:          obs,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// compareShadow repeats read against the shadow repository in the background
+// and records whether its result matches the primary result, using equal to
+// compare successful results. Failed primary reads other than domain errors
+// such as ErrEmployeeNotFound are not shadowed.
+func compareShadow[T any](r *shadowEmployeeRepo, ctx context.Context, operation, tenantID string, primary T, primaryErr error,
+	read func(ctx context.Context, repo biz.EmployeeRepo) (T, error), equal func(a, b T) bool) {
+	if inTransaction(ctx) || isServerError(primaryErr) || rand.Float64() >= r.shadow.sampleRate {
+		return
+	}
+	select {
+	case r.shadow.slots <- struct{}{}:
+	default:
+		r.obs.RecordShadowRead(operation, shadowResultSkipped)
+		return
+	}
+
+	go func() {
+		defer func() { <-r.shadow.slots }()
+
+		shadowCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.shadow.timeout)
+		defer cancel()
+		got, err := read(shadowCtx, r.shadow.repo)
+
+		result := shadowResultMatch
+		switch {
+		case isServerError(err):
+			r.log.WithContext(ctx).Warnf("shadow %s of tenant %s failed: %v", operation, tenantID, err)
+			result = shadowResultError
+		case errors.Reason(err) != errors.Reason(primaryErr):
+			result = shadowResultMismatch
+		case err == nil && !equal(primary, got):
+			result = shadowResultMismatch
+		}
+		if result == shadowResultMismatch {
+			r.log.WithContext(ctx).Warnf("shadow %s of tenant %s diverged from the primary repository", operation, tenantID)
+		}
+		r.obs.RecordShadowRead(operation, result)
+		if r.done != nil {
+			r.done(operation, result)
+		}
+	}()
+}
+
+// GetByID retrieves an employee by ID.
+func (r *shadowEmployeeRepo) GetByID(ctx context.Context, tenantID string, id uuid.UUID) (*biz.Employee, error) {
+	employee, err := r.EmployeeRepo.GetByID(ctx, tenantID, id)
+	compareShadow(r, ctx, "get_by_id", tenantID, employee, err, func(ctx context.Context, repo biz.EmployeeRepo) (*biz.Employee, error) {
+		return repo.GetByID(ctx, tenantID, id)
+	}, sameEmployee)
+	return employee, err
+}
+
+// GetByEmail retrieves an employee by email.
+func (r *shadowEmployeeRepo) GetByEmail(ctx context.Context, tenantID string, email string) (*biz.Employee, error) {
+	employee, err := r.EmployeeRepo.GetByEmail(ctx, tenantID, email)
+	compareShadow(r, ctx, "get_by_email", tenantID, employee, err, func(ctx context.Context, repo biz.EmployeeRepo) (*biz.Employee, error) {
+		return repo.GetByEmail(ctx, tenantID, email)
+	}, sameEmployee)
+	return employee, err
+}
+
+// GetByIDs retrieves the employees with the given IDs.
+func (r *shadowEmployeeRepo) GetByIDs(ctx context.Context, tenantID string, ids []uuid.UUID) ([]*biz.Employee, error) {
+	employees, err := r.EmployeeRepo.GetByIDs(ctx, tenantID, ids)
+	compareShadow(r, ctx, "get_by_ids", tenantID, employees, err, func(ctx context.Context, repo biz.EmployeeRepo) ([]*biz.Employee, error) {
+		return repo.GetByIDs(ctx, tenantID, ids)
+	}, sameEmployeeSet)
+	return employees, err
+}
+
+// List lists a page of employees.
+func (r *shadowEmployeeRepo) List(ctx context.Context, tenantID string, filter *biz.ListFilter) (*biz.ListResult, error) {
+	// Copy the filter, which the caller may reuse
+	shadowFilter := *filter
+	result, err := r.EmployeeRepo.List(ctx, tenantID, filter)
+	compareShadow(r, ctx, "list", tenantID, result, err, func(ctx context.Context, repo biz.EmployeeRepo) (*biz.ListResult, error) {
+		return repo.List(ctx, tenantID, &shadowFilter)
+	}, func(a, b *biz.ListResult) bool {
+		return a.Total == b.Total && sameEmployeeSet(a.Employees, b.Employees)
+	})
+	return result, err
+}
+
+// Count counts the employees matching a filter.
+func (r *shadowEmployeeRepo) Count(ctx context.Context, tenantID string, filter *biz.ListFilter) (int64, error) {
+	shadowFilter := *filter
+	count, err := r.EmployeeRepo.Count(ctx, tenantID, filter)
+	compareShadow(r, ctx, "count", tenantID, count, err, func(ctx context.Context, repo biz.EmployeeRepo) (int64, error) {
+		return repo.Count(ctx, tenantID, &shadowFilter)
+	}, func(a, b int64) bool { return a == b })
+	return count, err
+}
+
+// CheckEmailExists checks whether an email is taken.
+func (r *shadowEmployeeRepo) CheckEmailExists(ctx context.Context, tenantID string, email string) (bool, error) {
+	exists, err := r.EmployeeRepo.CheckEmailExists(ctx, tenantID, email)
+	compareShadow(r, ctx, "check_email_exists", tenantID, exists, err, func(ctx context.Context, repo biz.EmployeeRepo) (bool, error) {
+		return repo.CheckEmailExists(ctx, tenantID, email)
+	}, func(a, b bool) bool { return a == b })
+	return exists, err
+}
+
+// GetManagementChain returns the managers above an employee.
+func (r *shadowEmployeeRepo) GetManagementChain(ctx context.Context, tenantID string, id uuid.UUID) ([]*biz.Employee, error) {
+	chain, err := r.EmployeeRepo.GetManagementChain(ctx, tenantID, id)
+	compareShadow(r, ctx, "get_management_chain", tenantID, chain, err, func(ctx context.Context, repo biz.EmployeeRepo) ([]*biz.Employee, error) {
+		return repo.GetManagementChain(ctx, tenantID, id)
+	}, func(a, b []*biz.Employee) bool {
+		return slices.EqualFunc(a, b, sameEmployee)
+	})
+	return chain, err
+}
+
+// isServerError reports whether err is a failure rather than a domain error
+// such as ErrEmployeeNotFound
+func isServerError(err error) bool {
+	return err != nil && errors.FromError(err).Code >= 500
+}
+
+// sameEmployee reports whether two reads returned the same employee. Emails
+// are compared in any order, as only the primary email is ordered.
+func sameEmployee(a, b *biz.Employee) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.ID == b.ID && a.TenantID == b.TenantID &&
+		a.FirstName == b.FirstName && a.LastName == b.LastName &&
+		a.CreatedAt.Equal(b.CreatedAt) && a.UpdatedAt.Equal(b.UpdatedAt) &&
+		a.Version == b.Version && a.ReviewStatus == b.ReviewStatus &&
+		sameUUIDRef(a.DepartmentID, b.DepartmentID) && sameUUIDRef(a.ManagerID, b.ManagerID) &&
+		a.Title == b.Title && slices.Equal(a.Tags, b.Tags) && slices.Equal(a.PhoneNumbers, b.PhoneNumbers) &&
+		toAddressColumns(a.Address) == toAddressColumns(b.Address) && a.PhotoKey == b.PhotoKey &&
+		maps.Equal(a.ExternalIDs, b.ExternalIDs) && a.PrimaryEmail == b.PrimaryEmail &&
+		sameTimeRef(a.DeactivatedAt, b.DeactivatedAt) && sameTimeRef(a.StaleAt, b.StaleAt) &&
+		slices.EqualFunc(a.UndeliverableEmails, b.UndeliverableEmails, sameUndeliverableEmail) &&
+		slices.Equal(slices.Sorted(slices.Values(a.Emails)), slices.Sorted(slices.Values(b.Emails)))
+}
+
+// sameEmployeeSet reports whether two reads returned the same employees in
+// any order; employees created in the same instant are listed in no
+// particular order
+func sameEmployeeSet(a, b []*biz.Employee) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	byID := make(map[uuid.UUID]*biz.Employee, len(a))
+	for _, e := range a {
+		byID[e.ID] = e
+	}
+	for _, e := range b {
+		if !sameEmployee(byID[e.ID], e) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameUUIDRef reports whether two optional references are equal
+func sameUUIDRef(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// sameUndeliverableEmail reports whether two undeliverable emails are the same
+func sameUndeliverableEmail(a, b biz.UndeliverableEmail) bool {
+	return a.Email == b.Email && a.Reason == b.Reason && a.Since.Equal(b.Since)
+}
+
+// sameTimeRef reports whether two optional times are both unset or equal
+func sameTimeRef(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,106 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// newTestShadowRepo shadows primary to shadow and returns the repository with
+// a channel receiving the result of every shadow read
+func newTestShadowRepo(primary, shadow biz.EmployeeRepo) (*shadowEmployeeRepo, chan string) {
+	results := make(chan string, 8)
+	repo := newShadowEmployeeRepo(primary, &shadowRead{
+		repo:       shadow,
+		sampleRate: 1,
+		timeout:    defaultShadowTimeout,
+		slots:      make(chan struct{}, 1),
+	}, nil, log.NewStdLogger(io.Discard)).(*shadowEmployeeRepo)
+	repo.done = func(_, result string) { results <- result }
+	return repo, results
+}
+
+func TestShadowEmployeeRepo_GetByID(t *testing.T) {
+	e := testEmployee("jane@example.com", "j.doe@example.com")
+	reordered := *e
+	reordered.Emails = []string{"j.doe@example.com", "jane@example.com"}
+	renamed := *e
+	renamed.FirstName = "Janet"
+
+	tests := []struct {
+		name   string
+		shadow map[uuid.UUID]*biz.Employee
+		want   string
+	}{
+		{name: "match", shadow: map[uuid.UUID]*biz.Employee{e.ID: e}, want: shadowResultMatch},
+		{name: "emails in another order", shadow: map[uuid.UUID]*biz.Employee{e.ID: &reordered}, want: shadowResultMatch},
+		{name: "different employee", shadow: map[uuid.UUID]*biz.Employee{e.ID: &renamed}, want: shadowResultMismatch},
+		{name: "missing from shadow", shadow: map[uuid.UUID]*biz.Employee{}, want: shadowResultMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := &stubEmployeeRepo{employees: map[uuid.UUID]*biz.Employee{e.ID: e}}
+			repo, results := newTestShadowRepo(primary, &stubEmployeeRepo{employees: tt.shadow})
+
+			got, err := repo.GetByID(context.Background(), "tenant-1", e.ID)
+			require.NoError(t, err)
+			assert.Same(t, e, got, "responses come from the primary repository")
+			assert.Equal(t, tt.want, <-results)
+		})
+	}
+}
+
+func TestShadowEmployeeRepo_NotFoundInBoth(t *testing.T) {
+	repo, results := newTestShadowRepo(
+		&stubEmployeeRepo{employees: map[uuid.UUID]*biz.Employee{}},
+		&stubEmployeeRepo{employees: map[uuid.UUID]*biz.Employee{}},
+	)
+
+	_, err := repo.GetByEmail(context.Background(), "tenant-1", "jane@example.com")
+	assert.Equal(t, biz.ErrEmployeeNotFound, err)
+	assert.Equal(t, shadowResultMatch, <-results)
+}
+
+func TestShadowEmployeeRepo_InTransaction(t *testing.T) {
+	e := testEmployee("jane@example.com")
+	shadow := &stubEmployeeRepo{employees: map[uuid.UUID]*biz.Employee{e.ID: e}}
+	repo, _ := newTestShadowRepo(&stubEmployeeRepo{employees: map[uuid.UUID]*biz.Employee{e.ID: e}}, shadow)
+	ctx := context.WithValue(context.Background(), txKey{}, &gorm.DB{})
+
+	_, err := repo.GetByID(ctx, "tenant-1", e.ID)
+	require.NoError(t, err)
+	assert.Zero(t, shadow.lookups)
+}
+
+func TestShadowEmployeeRepo_SlotsBusy(t *testing.T) {
+	e := testEmployee("jane@example.com")
+	shadow := &stubEmployeeRepo{employees: map[uuid.UUID]*biz.Employee{e.ID: e}}
+	repo, _ := newTestShadowRepo(&stubEmployeeRepo{employees: map[uuid.UUID]*biz.Employee{e.ID: e}}, shadow)
+	repo.shadow.slots <- struct{}{}
+
+	_, err := repo.GetByID(context.Background(), "tenant-1", e.ID)
+	require.NoError(t, err)
+	assert.Zero(t, shadow.lookups)
+}
+
+func TestNewShadowRead(t *testing.T) {
+	s, err := newShadowRead(&conf.Data_ShadowRead{}, nil, "", nil, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, s, "disabled without a repository")
+
+	_, err = newShadowRead(&conf.Data_ShadowRead{Repo: "sharded"}, nil, "", nil, nil, nil)
+	assert.Error(t, err)
+
+	_, err = newShadowRead(&conf.Data_ShadowRead{Repo: "gorm", SampleRate: 2}, nil, "", nil, nil, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/google/uuid"
+)
+
+// flagStaleQuery flags the employees untouched the longest among those
+// meeting the condition, skipping those another replica is flagging at the
+// same time. An employee changed since it was last flagged is flagged again.
+const flagStaleQuery = `
+UPDATE employees SET stale_at = ?
+WHERE id IN (
+    SELECT id FROM employees
+    WHERE %s AND deactivated_at IS NULL AND review_status = ?
+      AND (stale_at IS NULL OR stale_at < updated_at)
+    ORDER BY updated_at
+    LIMIT ?
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, tenant_id`
+
+// hasUndeliverableEmail matches employees having an undeliverable email
+const hasUndeliverableEmail = `EXISTS (SELECT 1 FROM employee_emails ee WHERE ee.employee_id = employees.id AND ee.undeliverable_at IS NOT NULL)`
+
+// staleCondition returns the condition of flagStaleQuery selecting the
+// employees meeting criteria, taking the untouched cutoff
+func staleCondition(criteria *biz.StaleCriteria) string {
+	switch criteria.Undeliverable {
+	case biz.StaleUndeliverableRequire:
+		return "updated_at < ? AND " + hasUndeliverableEmail
+	case biz.StaleUndeliverableAccept:
+		return "(updated_at < ? OR " + hasUndeliverableEmail + ")"
+	default:
+		return "updated_at < ?"
+	}
+}
+
+// FlagStale flags stale employees across all tenants. Flagging leaves
+// updated_at and the version unchanged, so that it is not itself a change
+// lifting the flag, and is not audited.
+func (r *employeeRepo) FlagStale(ctx context.Context, criteria *biz.StaleCriteria, now time.Time, limit int) ([]*biz.Employee, error) {
+	var flagged []struct {
+		ID       uuid.UUID
+		TenantID string
+	}
+	if err := r.data.DB(ctx).
+		Raw(fmt.Sprintf(flagStaleQuery, staleCondition(criteria)), now, criteria.UntouchedBefore, biz.ReviewStatusApproved, limit).
+		Scan(&flagged).Error; err != nil {
+		return nil, err
+	}
+
+	// Load the flagged employees tenant by tenant, for their events
+	var tenants []string
+	ids := make(map[string][]uuid.UUID)
+	for _, f := range flagged {
+		if _, ok := ids[f.TenantID]; !ok {
+			tenants = append(tenants, f.TenantID)
+		}
+		ids[f.TenantID] = append(ids[f.TenantID], f.ID)
+	}
+	employees := make([]*biz.Employee, 0, len(flagged))
+	for _, tenantID := range tenants {
+		batch, err := r.GetByIDs(ctx, tenantID, ids[tenantID])
+		if err != nil {
+			return nil, err
+		}
+		employees = append(employees, batch...)
+	}
+	return employees, nil
+}
@@ -0,0 +1,35 @@
+package data
+
+import "context"
+
+// EmployeeStats counts the employees of all tenants for the service metrics
+type EmployeeStats struct {
+	data *Data
+}
+
+// NewEmployeeStats creates the employee counter
+func NewEmployeeStats(d *Data) *EmployeeStats {
+	return &EmployeeStats{data: d}
+}
+
+// CountByTenant returns the number of employees of each tenant, pending and
+// deactivated ones included. Tenants without employees are absent.
+func (s *EmployeeStats) CountByTenant(ctx context.Context) (map[string]int64, error) {
+	var rows []struct {
+		TenantID  string
+		Employees int64
+	}
+	if err := s.data.db.WithContext(ctx).
+		Model(&EmployeeModel{}).
+		Select("tenant_id, COUNT(*) AS employees").
+		Group("tenant_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.TenantID] = row.Employees
+	}
+	return counts, nil
+}
@@ -0,0 +1,23 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmployeeStats_CountByTenant(t *testing.T) {
+	d, mock := newMockData(t)
+	mock.ExpectQuery(`SELECT tenant_id, COUNT\(\*\) AS employees FROM "employees" GROUP BY "tenant_id"`).
+		WillReturnRows(sqlmock.NewRows([]string{"tenant_id", "employees"}).
+			AddRow("tenant-a", 3).
+			AddRow("tenant-b", 1))
+
+	counts, err := NewEmployeeStats(d).CountByTenant(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"tenant-a": 3, "tenant-b": 1}, counts)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,234 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedEmployeeRepo traces each method of an EmployeeRepo in a span named
+// employeeRepo.<method>, so that traces show the time spent in the
+// repository, cache hits included, with its statements as children
+type tracedEmployeeRepo struct {
+	next   biz.EmployeeRepo
+	system attribute.KeyValue
+}
+
+func newTracedEmployeeRepo(next biz.EmployeeRepo, system attribute.KeyValue) biz.EmployeeRepo {
+	return &tracedEmployeeRepo{next: next, system: system}
+}
+
+// start starts the span of method, tenantID is empty for methods across
+// tenants
+func (r *tracedEmployeeRepo) start(ctx context.Context, method, tenantID string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{r.system}
+	if tenantID != "" {
+		attrs = append(attrs, attrTenantID(tenantID))
+	}
+	return tracer().Start(ctx, "employeeRepo."+method, trace.WithAttributes(attrs...))
+}
+
+// Create implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) Create(ctx context.Context, tenantID string, employee *biz.Employee) (*biz.Employee, error) {
+	ctx, span := r.start(ctx, "Create", tenantID)
+	v, err := r.next.Create(ctx, tenantID, employee)
+	endSpan(span, err)
+	return v, err
+}
+
+// CreateMany implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) CreateMany(ctx context.Context, tenantID string, employees []*biz.Employee) ([]*biz.Employee, error) {
+	ctx, span := r.start(ctx, "CreateMany", tenantID)
+	v, err := r.next.CreateMany(ctx, tenantID, employees)
+	endSpan(span, err)
+	return v, err
+}
+
+// Update implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) Update(ctx context.Context, tenantID string, employee *biz.Employee) (*biz.Employee, error) {
+	ctx, span := r.start(ctx, "Update", tenantID)
+	v, err := r.next.Update(ctx, tenantID, employee)
+	endSpan(span, err)
+	return v, err
+}
+
+// UpsertByEmail implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) UpsertByEmail(ctx context.Context, tenantID string, email string, employee *biz.Employee) (*biz.Employee, *biz.Employee, error) {
+	ctx, span := r.start(ctx, "UpsertByEmail", tenantID)
+	after, before, err := r.next.UpsertByEmail(ctx, tenantID, email, employee)
+	endSpan(span, err)
+	return after, before, err
+}
+
+// Delete implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) Delete(ctx context.Context, tenantID string, id uuid.UUID) error {
+	ctx, span := r.start(ctx, "Delete", tenantID)
+	err := r.next.Delete(ctx, tenantID, id)
+	endSpan(span, err)
+	return err
+}
+
+// Approve implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) Approve(ctx context.Context, tenantID string, id uuid.UUID) (*biz.Employee, error) {
+	ctx, span := r.start(ctx, "Approve", tenantID)
+	v, err := r.next.Approve(ctx, tenantID, id)
+	endSpan(span, err)
+	return v, err
+}
+
+// SetActive implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) SetActive(ctx context.Context, tenantID string, id uuid.UUID, active bool) (*biz.Employee, bool, error) {
+	ctx, span := r.start(ctx, "SetActive", tenantID)
+	employee, changed, err := r.next.SetActive(ctx, tenantID, id, active)
+	endSpan(span, err)
+	return employee, changed, err
+}
+
+// FlagStale implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) FlagStale(ctx context.Context, criteria *biz.StaleCriteria, now time.Time, limit int) ([]*biz.Employee, error) {
+	ctx, span := r.start(ctx, "FlagStale", "")
+	v, err := r.next.FlagStale(ctx, criteria, now, limit)
+	endSpan(span, err)
+	return v, err
+}
+
+// MarkEmailsUndeliverable implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) MarkEmailsUndeliverable(ctx context.Context, tenantID string, bounces []biz.EmailBounce) ([]string, []*biz.Employee, error) {
+	ctx, span := r.start(ctx, "MarkEmailsUndeliverable", tenantID)
+	emails, employees, err := r.next.MarkEmailsUndeliverable(ctx, tenantID, bounces)
+	endSpan(span, err)
+	return emails, employees, err
+}
+
+// GetByID implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) GetByID(ctx context.Context, tenantID string, id uuid.UUID) (*biz.Employee, error) {
+	ctx, span := r.start(ctx, "GetByID", tenantID)
+	v, err := r.next.GetByID(ctx, tenantID, id)
+	endSpan(span, err)
+	return v, err
+}
+
+// GetByEmail implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) GetByEmail(ctx context.Context, tenantID string, email string) (*biz.Employee, error) {
+	ctx, span := r.start(ctx, "GetByEmail", tenantID)
+	v, err := r.next.GetByEmail(ctx, tenantID, email)
+	endSpan(span, err)
+	return v, err
+}
+
+// GetByExternalID implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) GetByExternalID(ctx context.Context, tenantID string, system string, externalID string) (*biz.Employee, error) {
+	ctx, span := r.start(ctx, "GetByExternalID", tenantID)
+	v, err := r.next.GetByExternalID(ctx, tenantID, system, externalID)
+	endSpan(span, err)
+	return v, err
+}
+
+// List implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) List(ctx context.Context, tenantID string, filter *biz.ListFilter) (*biz.ListResult, error) {
+	ctx, span := r.start(ctx, "List", tenantID)
+	v, err := r.next.List(ctx, tenantID, filter)
+	endSpan(span, err)
+	return v, err
+}
+
+// CheckEmailExists implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) CheckEmailExists(ctx context.Context, tenantID string, email string) (bool, error) {
+	ctx, span := r.start(ctx, "CheckEmailExists", tenantID)
+	v, err := r.next.CheckEmailExists(ctx, tenantID, email)
+	endSpan(span, err)
+	return v, err
+}
+
+// CheckEmailsExist implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) CheckEmailsExist(ctx context.Context, tenantID string, emails []string) (map[string]bool, error) {
+	ctx, span := r.start(ctx, "CheckEmailsExist", tenantID)
+	v, err := r.next.CheckEmailsExist(ctx, tenantID, emails)
+	endSpan(span, err)
+	return v, err
+}
+
+// MergeEmployees implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) MergeEmployees(ctx context.Context, tenantID string, primaryEmail string, secondaryEmail string) (*biz.Merge, error) {
+	ctx, span := r.start(ctx, "MergeEmployees", tenantID)
+	v, err := r.next.MergeEmployees(ctx, tenantID, primaryEmail, secondaryEmail)
+	endSpan(span, err)
+	return v, err
+}
+
+// MergeEmployeesByID implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) MergeEmployeesByID(ctx context.Context, tenantID string, primaryID, secondaryID uuid.UUID) (*biz.Merge, error) {
+	ctx, span := r.start(ctx, "MergeEmployeesByID", tenantID)
+	v, err := r.next.MergeEmployeesByID(ctx, tenantID, primaryID, secondaryID)
+	endSpan(span, err)
+	return v, err
+}
+
+// UnmergeEmployees implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) UnmergeEmployees(ctx context.Context, tenantID string, mergeID uuid.UUID) (*biz.Merge, error) {
+	ctx, span := r.start(ctx, "UnmergeEmployees", tenantID)
+	v, err := r.next.UnmergeEmployees(ctx, tenantID, mergeID)
+	endSpan(span, err)
+	return v, err
+}
+
+// GetMergeRedirect implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) GetMergeRedirect(ctx context.Context, tenantID string, secondaryID uuid.UUID) (*biz.MergeRedirect, error) {
+	ctx, span := r.start(ctx, "GetMergeRedirect", tenantID)
+	v, err := r.next.GetMergeRedirect(ctx, tenantID, secondaryID)
+	endSpan(span, err)
+	return v, err
+}
+
+// GetByIDs implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) GetByIDs(ctx context.Context, tenantID string, ids []uuid.UUID) ([]*biz.Employee, error) {
+	ctx, span := r.start(ctx, "GetByIDs", tenantID)
+	v, err := r.next.GetByIDs(ctx, tenantID, ids)
+	endSpan(span, err)
+	return v, err
+}
+
+// Count implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) Count(ctx context.Context, tenantID string, filter *biz.ListFilter) (int64, error) {
+	ctx, span := r.start(ctx, "Count", tenantID)
+	v, err := r.next.Count(ctx, tenantID, filter)
+	endSpan(span, err)
+	return v, err
+}
+
+// DeleteByIDs implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) DeleteByIDs(ctx context.Context, tenantID string, ids []uuid.UUID) (int64, error) {
+	ctx, span := r.start(ctx, "DeleteByIDs", tenantID)
+	v, err := r.next.DeleteByIDs(ctx, tenantID, ids)
+	endSpan(span, err)
+	return v, err
+}
+
+// DeleteAll implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) DeleteAll(ctx context.Context, tenantID string) (int64, error) {
+	ctx, span := r.start(ctx, "DeleteAll", tenantID)
+	v, err := r.next.DeleteAll(ctx, tenantID)
+	endSpan(span, err)
+	return v, err
+}
+
+// Stream implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) Stream(ctx context.Context, tenantID string, filter *biz.StreamFilter) (biz.EmployeeIterator, error) {
+	ctx, span := r.start(ctx, "Stream", tenantID)
+	v, err := r.next.Stream(ctx, tenantID, filter)
+	endSpan(span, err)
+	return v, err
+}
+
+// GetManagementChain implements biz.EmployeeRepo.
+func (r *tracedEmployeeRepo) GetManagementChain(ctx context.Context, tenantID string, id uuid.UUID) ([]*biz.Employee, error) {
+	ctx, span := r.start(ctx, "GetManagementChain", tenantID)
+	v, err := r.next.GetManagementChain(ctx, tenantID, id)
+	endSpan(span, err)
+	return v, err
+}
@@ -0,0 +1,142 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EmploymentHistoryModel is the GORM model for a job an employee held
+type EmploymentHistoryModel struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	TenantID     string     `gorm:"type:varchar(255);not null"`
+	EmployeeID   uuid.UUID  `gorm:"type:uuid;not null"`
+	Title        string     `gorm:"type:varchar(100);not null"`
+	DepartmentID *uuid.UUID `gorm:"type:uuid"`
+	ManagerID    *uuid.UUID `gorm:"type:uuid"`
+	// EffectiveTo is NULL for the current job of the employee
+	EffectiveFrom time.Time  `gorm:"not null"`
+	EffectiveTo   *time.Time `gorm:""`
+}
+
+// TableName overrides the table name
+func (EmploymentHistoryModel) TableName() string {
+	return "employment_history"
+}
+
+// ToEntity converts EmploymentHistoryModel to biz.EmploymentHistoryEntry
+func (m *EmploymentHistoryModel) ToEntity() *biz.EmploymentHistoryEntry {
+	return &biz.EmploymentHistoryEntry{
+		ID:            m.ID,
+		TenantID:      m.TenantID,
+		EmployeeID:    m.EmployeeID,
+		Title:         m.Title,
+		DepartmentID:  m.DepartmentID,
+		ManagerID:     m.ManagerID,
+		EffectiveFrom: m.EffectiveFrom,
+		EffectiveTo:   m.EffectiveTo,
+	}
+}
+
+// syncEmploymentHistoryQuery ends the current job of every employee matched by
+// the condition (formatted in as %[1]s, applied to alias e) whose title,
+// department or manager differs from it, and starts a new job for those and
+// for employees without a current job. Employees whose job did not change
+// are left alone, so the query can be run for any set of employees after a
+// change.
+const syncEmploymentHistoryQuery = `
+WITH ended AS (
+    UPDATE employment_history h SET effective_to = ?
+    FROM employees e
+    WHERE h.tenant_id = e.tenant_id AND h.employee_id = e.id AND h.effective_to IS NULL
+      AND (h.title, h.department_id, h.manager_id) IS DISTINCT FROM (e.title, e.department_id, e.manager_id)
+      AND %[1]s
+    RETURNING h.employee_id
+)
+INSERT INTO employment_history (id, tenant_id, employee_id, title, department_id, manager_id, effective_from)
+SELECT gen_random_uuid(), e.tenant_id, e.id, e.title, e.department_id, e.manager_id, ?
+FROM employees e
+WHERE %[1]s
+  AND (e.id IN (SELECT employee_id FROM ended)
+       OR NOT EXISTS (SELECT 1 FROM employment_history h WHERE h.tenant_id = e.tenant_id AND h.employee_id = e.id AND h.effective_to IS NULL))`
+
+// syncEmploymentHistoryTx brings the employment history of the employees
+// matching condition up to date using the given transaction
+func (d *Data) syncEmploymentHistoryTx(tx *gorm.DB, condition string, args ...interface{}) error {
+	now := d.now()
+	values := make([]interface{}, 0, 2*len(args)+2)
+	values = append(values, now)
+	values = append(values, args...)
+	values = append(values, now)
+	values = append(values, args...)
+	return tx.Exec(fmt.Sprintf(syncEmploymentHistoryQuery, condition), values...).Error
+}
+
+// recordJobTx starts a new job in the employment history when a create or
+// change of an employee, from before to after, touched its title, department
+// or manager
+func (d *Data) recordJobTx(tx *gorm.DB, tenantID string, before, after *biz.Employee) error {
+	if after == nil || (before != nil && sameJob(before, after)) {
+		return nil
+	}
+	return d.syncEmploymentHistoryTx(tx, "e.tenant_id = ? AND e.id = ?", tenantID, after.ID)
+}
+
+// recordReportsJobTx starts a new job for the employees whose current job
+// reports to one of managerIDs but who report to someone else by now, e.g.
+// because their manager was deleted or merged
+func (d *Data) recordReportsJobTx(tx *gorm.DB, tenantID string, managerIDs []uuid.UUID) error {
+	return d.syncEmploymentHistoryTx(tx,
+		"e.tenant_id = ? AND e.id IN (SELECT employee_id FROM employment_history WHERE tenant_id = ? AND manager_id IN ? AND effective_to IS NULL)",
+		tenantID, tenantID, managerIDs)
+}
+
+// sameJob reports whether two states of an employee hold the same job
+func sameJob(a, b *biz.Employee) bool {
+	return a.Title == b.Title && sameUUIDRef(a.DepartmentID, b.DepartmentID) && sameUUIDRef(a.ManagerID, b.ManagerID)
+}
+
+type employmentHistoryRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewEmploymentHistoryRepo creates a new employment history repository.
+func NewEmploymentHistoryRepo(data *Data, logger log.Logger) biz.EmploymentHistoryRepo {
+	return &employmentHistoryRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// List returns a page of the jobs of an employee, the current one first.
+func (r *employmentHistoryRepo) List(ctx context.Context, tenantID string, employeeID uuid.UUID, page, pageSize int32) ([]*biz.EmploymentHistoryEntry, int64, error) {
+	query := r.data.DB(ctx).Model(&EmploymentHistoryModel{}).
+		Where("tenant_id = ? AND employee_id = ?", tenantID, employeeID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []EmploymentHistoryModel
+	if err := query.
+		Order("effective_from DESC, effective_to DESC NULLS FIRST").
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	entries := make([]*biz.EmploymentHistoryEntry, len(models))
+	for i := range models {
+		entries[i] = models[i].ToEntity()
+	}
+	return entries, total, nil
+}
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordJobTx(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	departmentID := uuid.New()
+	before := &biz.Employee{ID: uuid.New(), FirstName: "Jane", Title: "Engineer"}
+
+	tests := []struct {
+		name    string
+		before  *biz.Employee
+		after   biz.Employee
+		started bool
+	}{
+		{name: "created", after: *before, started: true},
+		{name: "renamed", before: before, after: biz.Employee{ID: before.ID, FirstName: "Janet", Title: "Engineer"}},
+		{name: "promoted", before: before, after: biz.Employee{ID: before.ID, FirstName: "Jane", Title: "Senior Engineer"}, started: true},
+		{name: "moved", before: before, after: biz.Employee{ID: before.ID, FirstName: "Jane", Title: "Engineer", DepartmentID: &departmentID}, started: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, mock := newMockData(t)
+			d.clock = biz.ClockFunc(func() time.Time { return now })
+
+			if tt.started {
+				mock.ExpectExec(`WITH ended AS \(\s+UPDATE employment_history h SET effective_to = \$1`).
+					WithArgs(now, "tenant-1", before.ID, now, "tenant-1", before.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			require.NoError(t, d.recordJobTx(d.db, "tenant-1", tt.before, &tt.after))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEmploymentHistoryRepo_List(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employmentHistoryRepo{data: d}
+	employeeID, managerID := uuid.New(), uuid.New()
+	from := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "employment_history" WHERE tenant_id = \$1 AND employee_id = \$2`).
+		WithArgs("tenant-1", employeeID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(`SELECT \* FROM "employment_history" WHERE tenant_id = \$1 AND employee_id = \$2 ORDER BY effective_from DESC, effective_to DESC NULLS FIRST LIMIT \$3 OFFSET \$4`).
+		WithArgs("tenant-1", employeeID, 1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "employee_id", "title", "manager_id", "effective_from", "effective_to"}).
+			AddRow(uuid.New(), "tenant-1", employeeID, "Engineer", managerID, from.Add(-time.Hour), from))
+
+	entries, total, err := repo.List(context.Background(), "tenant-1", employeeID, 2, 1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, total)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Engineer", entries[0].Title)
+	assert.Equal(t, &managerID, entries[0].ManagerID)
+	assert.Nil(t, entries[0].DepartmentID)
+	require.NotNil(t, entries[0].EffectiveTo)
+	assert.True(t, from.Equal(*entries[0].EffectiveTo))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
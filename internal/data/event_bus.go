@@ -0,0 +1,87 @@
+package data
+
+import (
+	"context"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/observability"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// NewEmployeeEventPublisher returns the publisher employee events are sent
+// with: NATS, the fallback sink, or nil when events are disabled.
+func NewEmployeeEventPublisher(data *Data) biz.EventPublisher {
+	return data.publisher
+}
+
+// NewEventBus creates the domain event bus with the data layer subscribers:
+// cache invalidation, the event publisher (when not nil), change statistics
+// and quota tracking. The cache is invalidated first so that consumers reading an
+// employee back after an event never see the cached state from before the
+// change.
+func NewEventBus(data *Data, publisher biz.EventPublisher, quota *biz.QuotaUsecase, obs *observability.Observability, logger log.Logger) *biz.EventBus {
+	bus := biz.NewEventBus(logger)
+	if data.cache != nil {
+		bus.Subscribe("cache", &cacheInvalidator{cache: data.cache})
+	}
+	if publisher != nil {
+		bus.Subscribe("publisher", biz.NewPublisherSubscriber(publisher))
+	}
+	bus.Subscribe("stats", biz.EventSubscriberFunc(func(_ context.Context, e *biz.DomainEvent) error {
+		obs.RecordEmployeeChange(string(e.Type))
+		switch e.Type {
+		case biz.EventEmployeeCreated:
+			obs.RecordEmployeeCreated(e.TenantID)
+		case biz.EventEmployeeMerged:
+			obs.RecordEmployeeMerged(e.TenantID)
+		}
+		return nil
+	}))
+	if quota != nil {
+		bus.Subscribe("quota", quota)
+	}
+	return bus
+}
+
+// cacheInvalidator evicts changed employees from the employee cache
+type cacheInvalidator struct {
+	cache *employeeCache
+}
+
+// HandleEvent implements biz.EventSubscriber.
+func (c *cacheInvalidator) HandleEvent(ctx context.Context, e *biz.DomainEvent) error {
+	var ids []uuid.UUID
+	switch e.Type {
+	case biz.EventEmployeeCreated:
+		// An approved employee may have been cached while pending review
+		if e.Employee.Version > 1 {
+			ids = append(ids, e.Employee.ID)
+		}
+	case biz.EventEmployeeUpdated, biz.EventEmployeeDeleted, biz.EventEmployeeDeactivated, biz.EventEmployeeReactivated, biz.EventEmployeeStale:
+		ids = append(ids, e.Employee.ID)
+	case biz.EventEmployeeMerged, biz.EventEmployeeUnmerged:
+		ids = append(ids, e.Merge.Primary.ID, e.Merge.Secondary.ID)
+	case biz.EventTenantPurged:
+		return c.cache.invalidateTenant(ctx, e.TenantID)
+	}
+
+	if len(ids) == 0 {
+		// Nothing cached can be stale, e.g. for created employees
+		return nil
+	}
+	return c.cache.invalidate(ctx, e.TenantID, ids...)
+}
+
+// NewMergeLimitMetrics reports the merges of the biz.MergeLimiter through
+// the service metrics
+func NewMergeLimitMetrics(obs *observability.Observability) biz.MergeLimitMetrics {
+	return obs
+}
+
+// NewStrictEmailMetrics counts the violations of the strict email validation
+// of the biz.EmailPolicy in the service metrics
+func NewStrictEmailMetrics(obs *observability.Observability) biz.StrictEmailMetrics {
+	return obs
+}
@@ -0,0 +1,46 @@
+package data
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventBus(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	event := &biz.DomainEvent{
+		Type:     biz.EventEmployeeCreated,
+		TenantID: "tenant-1",
+		Employee: &biz.Employee{ID: uuid.New(), Emails: []string{"jane@example.com"}},
+	}
+
+	t.Run("events disabled", func(t *testing.T) {
+		d := &Data{}
+		bus := NewEventBus(d, NewEmployeeEventPublisher(d), nil, nil, logger)
+
+		assert.NotPanics(t, func() { bus.Publish(context.Background(), event) })
+	})
+
+	t.Run("publishes through the configured publisher", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "events.ndjson")
+		sink, err := openFileSink(path, defaultSinkMaxFileBytes, defaultSinkMaxFiles)
+		require.NoError(t, err)
+		defer sink.close()
+		d := &Data{publisher: NewSinkEventPublisher(sink, logger)}
+
+		bus := NewEventBus(d, NewEmployeeEventPublisher(d), nil, nil, logger)
+		bus.Publish(context.Background(), event)
+
+		lines := readSinkLines(t, path)
+		require.Len(t, lines, 1)
+		assert.Equal(t, SubjectEmployeeCreated, lines[0].Subject)
+	})
+}
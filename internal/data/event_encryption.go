@@ -0,0 +1,91 @@
+package data
+
+import (
+	"fmt"
+
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/pkg/eventcrypto"
+
+	"github.com/nats-io/nats.go"
+)
+
+// eventEncryption seals event payloads with the key of the event's tenant
+type eventEncryption struct {
+	keys *eventcrypto.Keyring
+	// tenantKeys maps a tenant to the ID of the key encrypting its events
+	tenantKeys map[string]string
+	// defaultKey encrypts the events of tenants without a key of their own
+	defaultKey string
+}
+
+// newEventEncryption builds the keyring from the configured keys. It returns
+// nil when no key is configured.
+func newEventEncryption(keys []*conf.Data_Nats_EncryptionKey) (*eventEncryption, error) {
+	e := &eventEncryption{
+		keys:       eventcrypto.NewKeyring(),
+		tenantKeys: make(map[string]string),
+	}
+
+	configured := 0
+	for _, k := range keys {
+		if k.GetKey() == "" {
+			continue
+		}
+		if e.keys.Has(k.GetId()) {
+			return nil, fmt.Errorf("duplicate event encryption key %s", k.GetId())
+		}
+		if err := e.keys.AddBase64(k.GetId(), k.GetKey()); err != nil {
+			return nil, err
+		}
+		configured++
+
+		// The first key listed for a tenant is the active one, later keys
+		// are only kept around for rotation
+		if k.GetTenantId() == "" {
+			if e.defaultKey == "" {
+				e.defaultKey = k.GetId()
+			}
+		} else if _, ok := e.tenantKeys[k.GetTenantId()]; !ok {
+			e.tenantKeys[k.GetTenantId()] = k.GetId()
+		}
+	}
+
+	if configured == 0 {
+		return nil, nil
+	}
+	return e, nil
+}
+
+// keyFor returns the ID of the key encrypting the tenant's events, or an
+// empty string when they are published in cleartext
+func (e *eventEncryption) keyFor(tenantID string) string {
+	if e == nil {
+		return ""
+	}
+	if id, ok := e.tenantKeys[tenantID]; ok {
+		return id
+	}
+	return e.defaultKey
+}
+
+// seal encrypts payload with the key keyID and returns it with the envelope
+// headers
+func (e *eventEncryption) seal(keyID, subject string, payload []byte) ([]byte, nats.Header, error) {
+	sealed, err := e.keys.Seal(keyID, subject, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := nats.Header{}
+	header.Set(eventcrypto.HeaderEncryption, eventcrypto.AlgorithmAES256GCM)
+	header.Set(eventcrypto.HeaderKeyID, keyID)
+	return sealed, header, nil
+}
+
+// encryptionOverhead is the number of bytes encryption with keyID adds to a
+// published event: the nonce and tag plus the envelope headers
+func encryptionOverhead(keyID string) int64 {
+	headers := len(eventcrypto.HeaderEncryption+": "+eventcrypto.AlgorithmAES256GCM+"\r\n") +
+		len(eventcrypto.HeaderKeyID+": "+keyID+"\r\n")
+	return int64(eventcrypto.Overhead + headers)
+}
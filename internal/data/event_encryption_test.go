@@ -0,0 +1,67 @@
+package data
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/pkg/eventcrypto"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEncryptionKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, eventcrypto.KeySize))
+}
+
+func TestNewEventEncryption(t *testing.T) {
+	e, err := newEventEncryption([]*conf.Data_Nats_EncryptionKey{
+		{Id: "default-1", Key: testEncryptionKey(1)},
+		{Id: "tenant-a-2", TenantId: "tenant-a", Key: testEncryptionKey(2)},
+		{Id: "tenant-a-1", TenantId: "tenant-a", Key: testEncryptionKey(3)},
+		{Id: "unset", TenantId: "tenant-b"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "tenant-a-2", e.keyFor("tenant-a"))
+	assert.Equal(t, "default-1", e.keyFor("tenant-b"))
+	assert.True(t, e.keys.Has("tenant-a-1"))
+}
+
+func TestNewEventEncryption_NoKeys(t *testing.T) {
+	e, err := newEventEncryption([]*conf.Data_Nats_EncryptionKey{{Id: "unset"}})
+
+	require.NoError(t, err)
+	assert.Nil(t, e)
+	assert.Empty(t, e.keyFor("tenant-a"))
+}
+
+func TestNewEventEncryption_Invalid(t *testing.T) {
+	_, err := newEventEncryption([]*conf.Data_Nats_EncryptionKey{
+		{Id: "k1", Key: testEncryptionKey(1)},
+		{Id: "k1", TenantId: "tenant-a", Key: testEncryptionKey(2)},
+	})
+	assert.ErrorContains(t, err, "duplicate event encryption key k1")
+
+	_, err = newEventEncryption([]*conf.Data_Nats_EncryptionKey{{Id: "k1", Key: "c2hvcnQ="}})
+	assert.ErrorContains(t, err, "must be 32 bytes")
+}
+
+func TestEventEncryption_Seal(t *testing.T) {
+	e, err := newEventEncryption([]*conf.Data_Nats_EncryptionKey{{Id: "k1", TenantId: "tenant-a", Key: testEncryptionKey(1)}})
+	require.NoError(t, err)
+
+	sealed, header, err := e.seal("k1", SubjectEmployeeCreated, []byte("payload"))
+	require.NoError(t, err)
+	assert.Equal(t, "k1", header.Get(eventcrypto.HeaderKeyID))
+
+	// Consumers decrypt with the same keys
+	consumerKeys := eventcrypto.NewKeyring()
+	require.NoError(t, consumerKeys.AddBase64("k1", testEncryptionKey(1)))
+	payload, err := consumerKeys.Decrypt(&nats.Msg{Subject: SubjectEmployeeCreated, Data: sealed, Header: header})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("payload"), payload)
+}
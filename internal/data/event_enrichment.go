@@ -0,0 +1,181 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+)
+
+// Event enrichment provider types
+const (
+	EventEnrichmentEnv      = "env"
+	EventEnrichmentHostname = "hostname"
+	EventEnrichmentFile     = "file"
+)
+
+// eventEnrichmentFileRefresh is how long the contents of a file provider are
+// reused before the file is read again
+const eventEnrichmentFileRefresh = 30 * time.Second
+
+// eventEnricher adds metadata to an event before it is published. Enrichers
+// run in order on every event type, and must be safe for concurrent use.
+type eventEnricher interface {
+	Enrich(ctx context.Context, metadata map[string]string)
+}
+
+// eventEnricherFunc adapts a function to eventEnricher
+type eventEnricherFunc func(ctx context.Context, metadata map[string]string)
+
+// Enrich calls f
+func (f eventEnricherFunc) Enrich(ctx context.Context, metadata map[string]string) {
+	f(ctx, metadata)
+}
+
+// reservedEventMetadata are the keys set from the request, which enrichment
+// cannot override
+var reservedEventMetadata = map[string]bool{
+	EventMetadataRequestID:  true,
+	EventMetadataAPIVersion: true,
+	EventMetadataSource:     true,
+
+	EventMetadataImpersonatorID:       true,
+	EventMetadataImpersonatorTenantID: true,
+}
+
+// enrichEvent runs the enrichers on a separate map and merges their
+// non-empty values into metadata, keeping the reserved keys. Providers leave
+// out empty values, so they do not clear what earlier enrichers set.
+func enrichEvent(ctx context.Context, enrichers []eventEnricher, metadata map[string]string) {
+	if len(enrichers) == 0 {
+		return
+	}
+
+	extra := map[string]string{}
+	for _, e := range enrichers {
+		e.Enrich(ctx, extra)
+	}
+	for k, v := range extra {
+		if v != "" && !reservedEventMetadata[k] {
+			metadata[k] = v
+		}
+	}
+}
+
+// newEventEnrichers builds the enricher chain configured in c: the static
+// metadata followed by the providers in order
+func newEventEnrichers(c *conf.Data_EventEnrichment) ([]eventEnricher, error) {
+	var enrichers []eventEnricher
+
+	if static := c.GetStatic(); len(static) > 0 {
+		for k := range static {
+			if err := checkEventMetadataKey(k); err != nil {
+				return nil, err
+			}
+		}
+		static = maps.Clone(static)
+		enrichers = append(enrichers, eventEnricherFunc(func(_ context.Context, metadata map[string]string) {
+			maps.Copy(metadata, static)
+		}))
+	}
+
+	for i, p := range c.GetProviders() {
+		if err := checkEventMetadataKey(p.GetKey()); err != nil {
+			return nil, fmt.Errorf("provider %d: %w", i, err)
+		}
+		enricher, err := newEventEnrichmentProvider(p)
+		if err != nil {
+			return nil, fmt.Errorf("provider %d (%s): %w", i, p.GetKey(), err)
+		}
+		enrichers = append(enrichers, enricher)
+	}
+
+	return enrichers, nil
+}
+
+// checkEventMetadataKey rejects empty and reserved metadata keys
+func checkEventMetadataKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("empty event metadata key")
+	}
+	if reservedEventMetadata[key] {
+		return fmt.Errorf("event metadata key %q is reserved", key)
+	}
+	return nil
+}
+
+// newEventEnrichmentProvider builds the enricher of one dynamic provider
+func newEventEnrichmentProvider(p *conf.Data_EventEnrichment_Provider) (eventEnricher, error) {
+	key, source := p.GetKey(), p.GetSource()
+
+	switch p.GetType() {
+	case EventEnrichmentEnv:
+		if source == "" {
+			return nil, fmt.Errorf("env provider needs the variable name as source")
+		}
+		return eventEnricherFunc(func(_ context.Context, metadata map[string]string) {
+			if v := os.Getenv(source); v != "" {
+				metadata[key] = v
+			}
+		}), nil
+
+	case EventEnrichmentHostname:
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		return eventEnricherFunc(func(_ context.Context, metadata map[string]string) {
+			metadata[key] = hostname
+		}), nil
+
+	case EventEnrichmentFile:
+		if source == "" {
+			return nil, fmt.Errorf("file provider needs the file path as source")
+		}
+		if _, err := os.Stat(source); err != nil {
+			return nil, err
+		}
+		return &fileEnricher{key: key, path: source, refresh: eventEnrichmentFileRefresh}, nil
+	}
+	return nil, fmt.Errorf("unknown event enrichment provider type %q", p.GetType())
+}
+
+// fileEnricher stamps the contents of a file, such as a deployment ID written
+// by the deploy tooling, re-reading it at most once per refresh
+type fileEnricher struct {
+	key     string
+	path    string
+	refresh time.Duration
+	now     func() time.Time
+
+	mu     sync.Mutex
+	value  string
+	readAt time.Time
+}
+
+// Enrich adds the trimmed file contents; a file that cannot be read keeps the
+// last value read, and an empty file adds nothing
+func (f *fileEnricher) Enrich(_ context.Context, metadata map[string]string) {
+	now := time.Now()
+	if f.now != nil {
+		now = f.now()
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.readAt.IsZero() || now.Sub(f.readAt) >= f.refresh {
+		if raw, err := os.ReadFile(f.path); err == nil {
+			f.value = strings.TrimSpace(string(raw))
+		}
+		f.readAt = now
+	}
+	if f.value != "" {
+		metadata[f.key] = f.value
+	}
+}
@@ -0,0 +1,98 @@
+package data
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventEnrichers(t *testing.T) {
+	t.Setenv("TEST_DEPLOYMENT_ID", "deploy-42")
+	t.Setenv("TEST_EMPTY", "")
+
+	enrichers, err := newEventEnrichers(&conf.Data_EventEnrichment{
+		Static: map[string]string{"environment": "staging", "region": "eu-west-1"},
+		Providers: []*conf.Data_EventEnrichment_Provider{
+			{Key: "deployment_id", Type: EventEnrichmentEnv, Source: "TEST_DEPLOYMENT_ID"},
+			{Key: "region", Type: EventEnrichmentEnv, Source: "TEST_EMPTY"},
+		},
+	})
+	require.NoError(t, err)
+
+	metadata := map[string]string{EventMetadataRequestID: "req-1"}
+	enrichEvent(context.Background(), enrichers, metadata)
+
+	assert.Equal(t, map[string]string{
+		EventMetadataRequestID: "req-1",
+		"environment":          "staging",
+		"region":               "eu-west-1",
+		"deployment_id":        "deploy-42",
+	}, metadata, "empty provider values do not override static ones")
+}
+
+func TestNewEventEnrichers_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		c    *conf.Data_EventEnrichment
+	}{
+		{name: "reserved static key", c: &conf.Data_EventEnrichment{Static: map[string]string{EventMetadataRequestID: "x"}}},
+		{name: "empty provider key", c: &conf.Data_EventEnrichment{Providers: []*conf.Data_EventEnrichment_Provider{{Type: EventEnrichmentHostname}}}},
+		{name: "unknown provider", c: &conf.Data_EventEnrichment{Providers: []*conf.Data_EventEnrichment_Provider{{Key: "k", Type: "vault"}}}},
+		{name: "env without source", c: &conf.Data_EventEnrichment{Providers: []*conf.Data_EventEnrichment_Provider{{Key: "k", Type: EventEnrichmentEnv}}}},
+		{name: "missing file", c: &conf.Data_EventEnrichment{Providers: []*conf.Data_EventEnrichment_Provider{{Key: "k", Type: EventEnrichmentFile, Source: "/nonexistent/deploy-id"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newEventEnrichers(tt.c)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFileEnricher_Refresh(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deployment-id")
+	require.NoError(t, os.WriteFile(path, []byte("deploy-1\n"), 0o600))
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	f := &fileEnricher{key: "deployment_id", path: path, refresh: time.Minute, now: func() time.Time { return now }}
+
+	metadata := map[string]string{}
+	f.Enrich(context.Background(), metadata)
+	assert.Equal(t, "deploy-1", metadata["deployment_id"])
+
+	require.NoError(t, os.WriteFile(path, []byte("deploy-2\n"), 0o600))
+	f.Enrich(context.Background(), metadata)
+	assert.Equal(t, "deploy-1", metadata["deployment_id"], "the file is cached until the refresh interval passes")
+
+	now = now.Add(time.Minute)
+	f.Enrich(context.Background(), metadata)
+	assert.Equal(t, "deploy-2", metadata["deployment_id"])
+
+	require.NoError(t, os.Remove(path))
+	now = now.Add(time.Minute)
+	f.Enrich(context.Background(), metadata)
+	assert.Equal(t, "deploy-2", metadata["deployment_id"], "an unreadable file keeps the last value")
+}
+
+func TestEventMessages_Enrichment(t *testing.T) {
+	enrichers, err := newEventEnrichers(&conf.Data_EventEnrichment{Static: map[string]string{"environment": "production"}})
+	require.NoError(t, err)
+	m := eventMessages{enrichers: enrichers}
+	ctx := biz.WithRequestMetadata(context.Background(), biz.RequestMetadata{RequestID: "req-1"})
+
+	created := m.employeeCreatedEvent(ctx, "tenant-1", "user-1", &biz.Employee{})
+	merged := m.employeeMergedEvent(ctx, "tenant-1", "user-1", &biz.Merge{Primary: &biz.Employee{}, Secondary: &biz.Employee{}}, "old@example.com")
+
+	for _, md := range []map[string]string{created.Event.Metadata, merged.Event.Metadata} {
+		assert.Equal(t, map[string]string{EventMetadataRequestID: "req-1", "environment": "production"}, md)
+	}
+}
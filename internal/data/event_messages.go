@@ -0,0 +1,240 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	eventsv1 "github.com/cvele/employee-service/api/events/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Keys of the event metadata
+const (
+	// EventMetadataRequestID is the ID of the request that caused the event
+	EventMetadataRequestID = "request_id"
+	// EventMetadataAPIVersion is the API version of that request
+	EventMetadataAPIVersion = "api_version"
+	// EventMetadataSource is the operation source, e.g. api or import, see
+	// biz.GetSource
+	EventMetadataSource = "source"
+	// EventMetadataAddressIncluded is "true" on employee events carrying
+	// the employee's postal address
+	EventMetadataAddressIncluded = "address_included"
+	// EventMetadataBackfill is "true" on created events published by an
+	// event backfill for employees created before
+	EventMetadataBackfill = "backfill"
+	// EventMetadataImpersonatorID and EventMetadataImpersonatorTenantID are
+	// the user and tenant of the admin that caused the event acting as the
+	// event's user, see biz.GetImpersonator
+	EventMetadataImpersonatorID       = "impersonator_id"
+	EventMetadataImpersonatorTenantID = "impersonator_tenant_id"
+)
+
+// eventMessages builds the event messages shared by every EventPublisher
+// implementation so that consumers see the same events whichever transport
+// carries them. Event IDs and timestamps come from ids and clock; the zero
+// value uses random IDs and the wall clock. enrichers add the configured
+// metadata to every event. Postal addresses are left out of the employee
+// data unless includeAddress is set.
+type eventMessages struct {
+	clock          biz.Clock
+	ids            biz.IDGenerator
+	enrichers      []eventEnricher
+	includeAddress bool
+}
+
+// newEmployeeEvent builds the event metadata shared by all event types
+func (m eventMessages) newEmployeeEvent(ctx context.Context, eventType eventsv1.EventType, tenantID, userID string, employee *biz.Employee) *eventsv1.EmployeeEvent {
+	id, now := uuid.New(), time.Now()
+	if m.ids != nil {
+		id = m.ids.NewID()
+	}
+	if m.clock != nil {
+		now = m.clock.Now()
+	}
+
+	metadata := eventMetadata(biz.GetRequestMetadata(ctx))
+	if source := biz.GetSource(ctx); source != "" {
+		metadata[EventMetadataSource] = source
+	}
+	if biz.GetSource(ctx) == biz.SourceBackfill {
+		metadata[EventMetadataBackfill] = "true"
+	}
+	if impersonator, ok := biz.GetImpersonator(ctx); ok {
+		metadata[EventMetadataImpersonatorID] = impersonator.UserID
+		metadata[EventMetadataImpersonatorTenantID] = impersonator.TenantID
+	}
+	enrichEvent(ctx, m.enrichers, metadata)
+	if m.includeAddress && employee != nil {
+		metadata[EventMetadataAddressIncluded] = "true"
+	}
+
+	return &eventsv1.EmployeeEvent{
+		EventId:   id.String(),
+		EventType: eventType,
+		TenantId:  tenantID,
+		Timestamp: timestamppb.New(now),
+		UserId:    userID,
+		Employee:  m.employeeData(employee),
+		Metadata:  metadata,
+	}
+}
+
+// employeeData converts an employee to the event employee data, with its
+// address when addresses are included
+func (m eventMessages) employeeData(employee *biz.Employee) *eventsv1.EmployeeData {
+	data := toProtoEmployeeData(employee)
+	if data != nil && m.includeAddress && employee.Address != nil {
+		a := employee.Address
+		data.Address = &eventsv1.PostalAddress{
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+		}
+	}
+	return data
+}
+
+// eventMetadata returns the event metadata of the request that caused the
+// event, so that consumers can correlate events with requests
+func eventMetadata(md biz.RequestMetadata) map[string]string {
+	metadata := map[string]string{}
+	if md.RequestID != "" {
+		metadata[EventMetadataRequestID] = md.RequestID
+	}
+	if md.APIVersion != "" {
+		metadata[EventMetadataAPIVersion] = md.APIVersion
+	}
+	return metadata
+}
+
+// employeeCreatedEvent builds an employee created event
+func (m eventMessages) employeeCreatedEvent(ctx context.Context, tenantID, userID string, employee *biz.Employee) *eventsv1.EmployeeCreatedEvent {
+	return &eventsv1.EmployeeCreatedEvent{
+		Event: m.newEmployeeEvent(ctx, eventsv1.EventType_EVENT_TYPE_CREATED, tenantID, userID, employee),
+	}
+}
+
+// employeeUpdatedEvent builds an employee updated event
+func (m eventMessages) employeeUpdatedEvent(ctx context.Context, tenantID, userID string, employee *biz.Employee, updatedFields []string, emails biz.EmailChanges) *eventsv1.EmployeeUpdatedEvent {
+	if updatedFields == nil {
+		updatedFields = []string{}
+	}
+
+	return &eventsv1.EmployeeUpdatedEvent{
+		Event:         m.newEmployeeEvent(ctx, eventsv1.EventType_EVENT_TYPE_UPDATED, tenantID, userID, employee),
+		UpdatedFields: updatedFields,
+		AddedEmails:   emails.Added,
+		RemovedEmails: emails.Removed,
+	}
+}
+
+// employeeDeletedEvent builds an employee deleted event
+func (m eventMessages) employeeDeletedEvent(ctx context.Context, tenantID, userID string, employee *biz.Employee) *eventsv1.EmployeeDeletedEvent {
+	return &eventsv1.EmployeeDeletedEvent{
+		Event: m.newEmployeeEvent(ctx, eventsv1.EventType_EVENT_TYPE_DELETED, tenantID, userID, employee),
+	}
+}
+
+// employeeMergedEvent builds an employee merged event; the event employee is
+// the merged primary employee. The old to new ID and email mapping lets
+// integrations keyed by the secondary employee follow the merge.
+func (m eventMessages) employeeMergedEvent(ctx context.Context, tenantID, userID string, merge *biz.Merge, mergedFromEmail string) *eventsv1.EmployeeMergedEvent {
+	movedEmails := merge.MovedEmails()
+	if movedEmails == nil {
+		movedEmails = []string{}
+	}
+	return &eventsv1.EmployeeMergedEvent{
+		Event:            m.newEmployeeEvent(ctx, eventsv1.EventType_EVENT_TYPE_MERGED, tenantID, userID, merge.Primary),
+		MergedFromEmail:  mergedFromEmail,
+		MergeId:          merge.ID.String(),
+		SecondaryId:      merge.Secondary.ID.String(),
+		PrimaryId:        merge.Primary.ID.String(),
+		MovedEmails:      movedEmails,
+		MovedExternalIds: merge.MovedExternalIDs(),
+	}
+}
+
+// employeeUnmergedEvent builds an employee unmerged event; the event employee is
+// the recreated secondary employee
+func (m eventMessages) employeeUnmergedEvent(ctx context.Context, tenantID, userID string, merge *biz.Merge) *eventsv1.EmployeeUnmergedEvent {
+	return &eventsv1.EmployeeUnmergedEvent{
+		Event:   m.newEmployeeEvent(ctx, eventsv1.EventType_EVENT_TYPE_UNMERGED, tenantID, userID, merge.Secondary),
+		Primary: m.employeeData(merge.Primary),
+		MergeId: merge.ID.String(),
+	}
+}
+
+// employeeDeactivatedEvent builds an employee deactivated event
+func (m eventMessages) employeeDeactivatedEvent(ctx context.Context, tenantID, userID string, employee *biz.Employee) *eventsv1.EmployeeDeactivatedEvent {
+	return &eventsv1.EmployeeDeactivatedEvent{
+		Event: m.newEmployeeEvent(ctx, eventsv1.EventType_EVENT_TYPE_DEACTIVATED, tenantID, userID, employee),
+	}
+}
+
+// employeeReactivatedEvent builds an employee reactivated event
+func (m eventMessages) employeeReactivatedEvent(ctx context.Context, tenantID, userID string, employee *biz.Employee) *eventsv1.EmployeeReactivatedEvent {
+	return &eventsv1.EmployeeReactivatedEvent{
+		Event: m.newEmployeeEvent(ctx, eventsv1.EventType_EVENT_TYPE_REACTIVATED, tenantID, userID, employee),
+	}
+}
+
+// employeeStaleEvent builds an employee stale event
+func (m eventMessages) employeeStaleEvent(ctx context.Context, tenantID, userID string, employee *biz.Employee, reasons []string) *eventsv1.EmployeeStaleEvent {
+	return &eventsv1.EmployeeStaleEvent{
+		Event:   m.newEmployeeEvent(ctx, eventsv1.EventType_EVENT_TYPE_STALE, tenantID, userID, employee),
+		Reasons: reasons,
+	}
+}
+
+// departmentEvent builds a department event of the given type; the event
+// carries no employee
+func (m eventMessages) departmentEvent(ctx context.Context, eventType eventsv1.EventType, tenantID, userID string, department *biz.Department) *eventsv1.DepartmentEvent {
+	return &eventsv1.DepartmentEvent{
+		Event:      m.newEmployeeEvent(ctx, eventType, tenantID, userID, nil),
+		Department: toProtoDepartmentData(department),
+	}
+}
+
+// toProtoDepartmentData converts biz.Department to proto DepartmentData
+func toProtoDepartmentData(d *biz.Department) *eventsv1.DepartmentData {
+	if d == nil {
+		return nil
+	}
+	return &eventsv1.DepartmentData{
+		Id:          d.ID.String(),
+		Name:        d.Name,
+		Description: d.Description,
+		CreatedAt:   timestamppb.New(d.CreatedAt),
+		UpdatedAt:   timestamppb.New(d.UpdatedAt),
+	}
+}
+
+// teamEvent builds a team event of the given type; member is the employee
+// of membership changes and nil for created teams
+func (m eventMessages) teamEvent(ctx context.Context, eventType eventsv1.EventType, tenantID, userID string, team *biz.Team, member *biz.Employee) *eventsv1.TeamEvent {
+	return &eventsv1.TeamEvent{
+		Event: m.newEmployeeEvent(ctx, eventType, tenantID, userID, member),
+		Team:  toProtoTeamData(team),
+	}
+}
+
+// toProtoTeamData converts biz.Team to proto TeamData
+func toProtoTeamData(t *biz.Team) *eventsv1.TeamData {
+	if t == nil {
+		return nil
+	}
+	return &eventsv1.TeamData{
+		Id:          t.ID.String(),
+		Name:        t.Name,
+		Description: t.Description,
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+		UpdatedAt:   timestamppb.New(t.UpdatedAt),
+	}
+}
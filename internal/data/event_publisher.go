@@ -2,29 +2,77 @@ package data
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
 
 	eventsv1 "github.com/cvele/employee-service/api/events/v1"
 	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/observability"
+	"github.com/cvele/employee-service/pkg/eventcrypto"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/google/uuid"
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // NATS subject constants for versioned event types
 const (
-	SubjectEmployeeCreated = "employees.v1.created"
-	SubjectEmployeeUpdated = "employees.v1.updated"
-	SubjectEmployeeDeleted = "employees.v1.deleted"
-	SubjectEmployeeMerged  = "employees.v1.merged"
+	SubjectEmployeeCreated  = "employees.v1.created"
+	SubjectEmployeeUpdated  = "employees.v1.updated"
+	SubjectEmployeeDeleted  = "employees.v1.deleted"
+	SubjectEmployeeMerged   = "employees.v1.merged"
+	SubjectEmployeeUnmerged = "employees.v1.unmerged"
+	// Deactivations and reactivations
+	SubjectEmployeeDeactivated = "employees.v1.deactivated"
+	SubjectEmployeeReactivated = "employees.v1.reactivated"
+	// Employees flagged by the stale-record job
+	SubjectEmployeeStale = "employees.v1.stale"
+
+	// Department events share the employee events stream
+	SubjectDepartmentCreated = "employees.v1.departments.created"
+	SubjectDepartmentUpdated = "employees.v1.departments.updated"
+	SubjectDepartmentDeleted = "employees.v1.departments.deleted"
+
+	// Team events share the employee events stream
+	SubjectTeamCreated       = "employees.v1.teams.created"
+	SubjectTeamMemberAdded   = "employees.v1.teams.members.added"
+	SubjectTeamMemberRemoved = "employees.v1.teams.members.removed"
 )
 
+// ErrEventTooLarge is returned when an event exceeds the maximum payload size
+// even after slimming
+var ErrEventTooLarge = errors.New("event exceeds maximum payload size")
+
 // EventPublisher publishes events to NATS using Protocol Buffers
 type EventPublisher struct {
-	nc  *nats.Conn
-	log *log.Helper
+	nc       *nats.Conn
+	log      *log.Helper
+	messages eventMessages
+
+	// js is set when publishing through JetStream; nil means core NATS
+	js             jetstream.JetStream
+	publishTimeout time.Duration
+	// budget shortens publishTimeout to the remaining request deadline
+	budget timeoutBudget
+
+	// slim publishes only the employee ID and changed fields
+	slim bool
+	// maxEventBytes overrides the server's max_payload when positive
+	maxEventBytes int64
+	obs           *observability.Observability
+
+	// encryption is nil when events are published in cleartext
+	encryption *eventEncryption
+	// signer is nil when events are unsigned
+	signer *eventcrypto.Signer
 }
 
 // NewEventPublisher creates a new event publisher
@@ -36,6 +84,47 @@ func NewEventPublisher(nc *nats.Conn, subjectPrefix string, logger log.Logger) *
 	}
 }
 
+// NewJetStreamEventPublisher creates an event publisher that publishes through
+// JetStream, waiting for the stream ack and setting Nats-Msg-Id to the event ID
+// so that retried publishes are deduplicated by the server.
+func NewJetStreamEventPublisher(nc *nats.Conn, js jetstream.JetStream, publishTimeout time.Duration, logger log.Logger) *EventPublisher {
+	return &EventPublisher{
+		nc:             nc,
+		log:            log.NewHelper(logger),
+		js:             js,
+		publishTimeout: publishTimeout,
+	}
+}
+
+// configurePayload applies the slim event, payload size, encryption and
+// signing settings
+func (p *EventPublisher) configurePayload(c *conf.Data_Nats, encryption *eventEncryption, signer *eventcrypto.Signer, obs *observability.Observability) {
+	p.slim = c.GetSlimEvents()
+	p.maxEventBytes = c.GetMaxEventBytes()
+	p.obs = obs
+	p.encryption = encryption
+	p.signer = signer
+}
+
+// maxPayload returns the largest event the publisher sends, 0 meaning no limit
+func (p *EventPublisher) maxPayload() int64 {
+	if p.maxEventBytes > 0 {
+		return p.maxEventBytes
+	}
+	if p.nc != nil {
+		return p.nc.MaxPayload()
+	}
+	return 0
+}
+
+// employeeDataAlloc groups a proto EmployeeData with its timestamps so that a
+// conversion needs one allocation instead of three.
+type employeeDataAlloc struct {
+	data      eventsv1.EmployeeData
+	createdAt timestamppb.Timestamp
+	updatedAt timestamppb.Timestamp
+}
+
 // toProtoEmployeeData converts biz.Employee to proto EmployeeData
 func toProtoEmployeeData(emp *biz.Employee) *eventsv1.EmployeeData {
 	if emp == nil {
@@ -47,14 +136,104 @@ func toProtoEmployeeData(emp *biz.Employee) *eventsv1.EmployeeData {
 		emails = []string{}
 	}
 
-	return &eventsv1.EmployeeData{
-		Id:        emp.ID.String(),
-		Emails:    emails,
-		FirstName: emp.FirstName,
-		LastName:  emp.LastName,
-		CreatedAt: timestamppb.New(emp.CreatedAt),
-		UpdatedAt: timestamppb.New(emp.UpdatedAt),
+	a := new(employeeDataAlloc)
+	a.createdAt.Seconds, a.createdAt.Nanos = emp.CreatedAt.Unix(), int32(emp.CreatedAt.Nanosecond())
+	a.updatedAt.Seconds, a.updatedAt.Nanos = emp.UpdatedAt.Unix(), int32(emp.UpdatedAt.Nanosecond())
+
+	a.data.Id = emp.ID.String()
+	a.data.Emails = emails
+	a.data.PrimaryEmail = emp.PrimaryEmail
+	a.data.FirstName = emp.FirstName
+	a.data.LastName = emp.LastName
+	if emp.DepartmentID != nil {
+		a.data.DepartmentId = emp.DepartmentID.String()
+	}
+	if emp.ManagerID != nil {
+		a.data.ManagerId = emp.ManagerID.String()
+	}
+	a.data.Title = emp.Title
+	a.data.Tags = emp.Tags
+	if len(emp.PhoneNumbers) > 0 {
+		a.data.PhoneNumbers = make([]*eventsv1.PhoneNumber, len(emp.PhoneNumbers))
+		for i, phone := range emp.PhoneNumbers {
+			a.data.PhoneNumbers[i] = &eventsv1.PhoneNumber{Type: phone.Type, Number: phone.Number}
+		}
+	}
+	a.data.ExternalIds = emp.ExternalIDs
+	a.data.CreatedAt = &a.createdAt
+	a.data.UpdatedAt = &a.updatedAt
+	a.data.Active = emp.IsActive()
+	if emp.DeactivatedAt != nil {
+		a.data.DeactivatedAt = timestamppb.New(*emp.DeactivatedAt)
 	}
+	if emp.IsStale() {
+		a.data.Stale = true
+		a.data.StaleSince = timestamppb.New(*emp.StaleAt)
+	}
+	for _, u := range emp.UndeliverableEmails {
+		a.data.UndeliverableEmails = append(a.data.UndeliverableEmails, u.Email)
+	}
+	return &a.data
+}
+
+// slimEvent reduces the event's employee to its ID, updated_at and the
+// changed fields so that consumers fetch the rest back with GetEmployee
+func slimEvent(event *eventsv1.EmployeeEvent, changedFields []string) {
+	event.Slim = true
+	full := event.Employee
+	if full == nil {
+		return
+	}
+
+	slim := &eventsv1.EmployeeData{Id: full.Id, UpdatedAt: full.UpdatedAt}
+	for _, field := range changedFields {
+		switch field {
+		case "emails":
+			slim.Emails = full.Emails
+			slim.PrimaryEmail = full.PrimaryEmail
+		case "primary_email":
+			slim.PrimaryEmail = full.PrimaryEmail
+		case "first_name":
+			slim.FirstName = full.FirstName
+		case "last_name":
+			slim.LastName = full.LastName
+		case "department_id":
+			slim.DepartmentId = full.DepartmentId
+		case "manager_id":
+			slim.ManagerId = full.ManagerId
+		case "title":
+			slim.Title = full.Title
+		case "tags":
+			slim.Tags = full.Tags
+		case "phone_numbers":
+			slim.PhoneNumbers = full.PhoneNumbers
+		case "address":
+			slim.Address = full.Address
+		case "external_ids":
+			slim.ExternalIds = full.ExternalIds
+		case "active":
+			slim.Active = full.Active
+			slim.DeactivatedAt = full.DeactivatedAt
+		case "stale":
+			slim.Stale = full.Stale
+			slim.StaleSince = full.StaleSince
+		case "undeliverable_emails":
+			slim.UndeliverableEmails = full.UndeliverableEmails
+		}
+	}
+	event.Employee = slim
+}
+
+// maxPooledBufferSize bounds the marshal buffers kept in marshalBufferPool so
+// that an occasional large event does not pin memory.
+const maxPooledBufferSize = 64 << 10
+
+// marshalBufferPool reuses event marshal buffers across publishes
+var marshalBufferPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, 1024)
+		return &buf
+	},
 }
 
 // PublishEmployeeCreated publishes an employee created event
@@ -68,19 +247,9 @@ func (p *EventPublisher) PublishEmployeeCreated(
 		return nil
 	}
 
-	event := &eventsv1.EmployeeCreatedEvent{
-		Event: &eventsv1.EmployeeEvent{
-			EventId:   uuid.New().String(),
-			EventType: eventsv1.EventType_EVENT_TYPE_CREATED,
-			TenantId:  tenantID,
-			Timestamp: timestamppb.Now(),
-			UserId:    userID,
-			Employee:  toProtoEmployeeData(employee),
-			Metadata:  map[string]string{},
-		},
-	}
+	event := p.messages.employeeCreatedEvent(ctx, tenantID, userID, employee)
 
-	return p.publishProtoEvent(SubjectEmployeeCreated, event)
+	return p.publishProtoEvent(ctx, SubjectEmployeeCreated, event.Event, nil, event)
 }
 
 // PublishEmployeeUpdated publishes an employee updated event
@@ -89,30 +258,16 @@ func (p *EventPublisher) PublishEmployeeUpdated(
 	tenantID, userID string,
 	employee *biz.Employee,
 	updatedFields []string,
+	emails biz.EmailChanges,
 ) error {
 	if p == nil || p.nc == nil {
 		// NATS not configured, skip publishing
 		return nil
 	}
 
-	if updatedFields == nil {
-		updatedFields = []string{}
-	}
+	event := p.messages.employeeUpdatedEvent(ctx, tenantID, userID, employee, updatedFields, emails)
 
-	event := &eventsv1.EmployeeUpdatedEvent{
-		Event: &eventsv1.EmployeeEvent{
-			EventId:   uuid.New().String(),
-			EventType: eventsv1.EventType_EVENT_TYPE_UPDATED,
-			TenantId:  tenantID,
-			Timestamp: timestamppb.Now(),
-			UserId:    userID,
-			Employee:  toProtoEmployeeData(employee),
-			Metadata:  map[string]string{},
-		},
-		UpdatedFields: updatedFields,
-	}
-
-	return p.publishProtoEvent(SubjectEmployeeUpdated, event)
+	return p.publishProtoEvent(ctx, SubjectEmployeeUpdated, event.Event, event.UpdatedFields, event)
 }
 
 // PublishEmployeeDeleted publishes an employee deleted event
@@ -126,26 +281,16 @@ func (p *EventPublisher) PublishEmployeeDeleted(
 		return nil
 	}
 
-	event := &eventsv1.EmployeeDeletedEvent{
-		Event: &eventsv1.EmployeeEvent{
-			EventId:   uuid.New().String(),
-			EventType: eventsv1.EventType_EVENT_TYPE_DELETED,
-			TenantId:  tenantID,
-			Timestamp: timestamppb.Now(),
-			UserId:    userID,
-			Employee:  toProtoEmployeeData(employee),
-			Metadata:  map[string]string{},
-		},
-	}
+	event := p.messages.employeeDeletedEvent(ctx, tenantID, userID, employee)
 
-	return p.publishProtoEvent(SubjectEmployeeDeleted, event)
+	return p.publishProtoEvent(ctx, SubjectEmployeeDeleted, event.Event, nil, event)
 }
 
 // PublishEmployeeMerged publishes an employee merged event
 func (p *EventPublisher) PublishEmployeeMerged(
 	ctx context.Context,
 	tenantID, userID string,
-	employee *biz.Employee,
+	merge *biz.Merge,
 	mergedFromEmail string,
 ) error {
 	if p == nil || p.nc == nil {
@@ -153,33 +298,173 @@ func (p *EventPublisher) PublishEmployeeMerged(
 		return nil
 	}
 
-	event := &eventsv1.EmployeeMergedEvent{
-		Event: &eventsv1.EmployeeEvent{
-			EventId:   uuid.New().String(),
-			EventType: eventsv1.EventType_EVENT_TYPE_MERGED,
-			TenantId:  tenantID,
-			Timestamp: timestamppb.Now(),
-			UserId:    userID,
-			Employee:  toProtoEmployeeData(employee),
-			Metadata:  map[string]string{},
-		},
-		MergedFromEmail: mergedFromEmail,
+	event := p.messages.employeeMergedEvent(ctx, tenantID, userID, merge, mergedFromEmail)
+
+	return p.publishProtoEvent(ctx, SubjectEmployeeMerged, event.Event, nil, event)
+}
+
+// PublishEmployeeUnmerged publishes an employee unmerged event
+func (p *EventPublisher) PublishEmployeeUnmerged(
+	ctx context.Context,
+	tenantID, userID string,
+	merge *biz.Merge,
+) error {
+	if p == nil || p.nc == nil {
+		// NATS not configured, skip publishing
+		return nil
+	}
+
+	event := p.messages.employeeUnmergedEvent(ctx, tenantID, userID, merge)
+
+	return p.publishProtoEvent(ctx, SubjectEmployeeUnmerged, event.Event, nil, event)
+}
+
+// PublishEmployeeDeactivated publishes an employee deactivated event
+func (p *EventPublisher) PublishEmployeeDeactivated(ctx context.Context, tenantID, userID string, employee *biz.Employee) error {
+	if p == nil || p.nc == nil {
+		// NATS not configured, skip publishing
+		return nil
+	}
+
+	event := p.messages.employeeDeactivatedEvent(ctx, tenantID, userID, employee)
+
+	// Slim events keep the active state they are about
+	return p.publishProtoEvent(ctx, SubjectEmployeeDeactivated, event.Event, []string{"active"}, event)
+}
+
+// PublishEmployeeReactivated publishes an employee reactivated event
+func (p *EventPublisher) PublishEmployeeReactivated(ctx context.Context, tenantID, userID string, employee *biz.Employee) error {
+	if p == nil || p.nc == nil {
+		// NATS not configured, skip publishing
+		return nil
+	}
+
+	event := p.messages.employeeReactivatedEvent(ctx, tenantID, userID, employee)
+
+	return p.publishProtoEvent(ctx, SubjectEmployeeReactivated, event.Event, []string{"active"}, event)
+}
+
+// PublishEmployeeStale publishes an employee stale event
+func (p *EventPublisher) PublishEmployeeStale(ctx context.Context, tenantID, userID string, employee *biz.Employee, reasons []string) error {
+	if p == nil || p.nc == nil {
+		// NATS not configured, skip publishing
+		return nil
+	}
+
+	event := p.messages.employeeStaleEvent(ctx, tenantID, userID, employee, reasons)
+
+	return p.publishProtoEvent(ctx, SubjectEmployeeStale, event.Event, []string{"stale"}, event)
+}
+
+// PublishDepartmentCreated publishes a department created event
+func (p *EventPublisher) PublishDepartmentCreated(ctx context.Context, tenantID, userID string, department *biz.Department) error {
+	return p.publishDepartmentEvent(ctx, SubjectDepartmentCreated, eventsv1.EventType_EVENT_TYPE_DEPARTMENT_CREATED, tenantID, userID, department)
+}
+
+// PublishDepartmentUpdated publishes a department updated event
+func (p *EventPublisher) PublishDepartmentUpdated(ctx context.Context, tenantID, userID string, department *biz.Department) error {
+	return p.publishDepartmentEvent(ctx, SubjectDepartmentUpdated, eventsv1.EventType_EVENT_TYPE_DEPARTMENT_UPDATED, tenantID, userID, department)
+}
+
+// PublishDepartmentDeleted publishes a department deleted event
+func (p *EventPublisher) PublishDepartmentDeleted(ctx context.Context, tenantID, userID string, department *biz.Department) error {
+	return p.publishDepartmentEvent(ctx, SubjectDepartmentDeleted, eventsv1.EventType_EVENT_TYPE_DEPARTMENT_DELETED, tenantID, userID, department)
+}
+
+// publishDepartmentEvent publishes a department event on subject
+func (p *EventPublisher) publishDepartmentEvent(ctx context.Context, subject string, eventType eventsv1.EventType, tenantID, userID string, department *biz.Department) error {
+	if p == nil || p.nc == nil {
+		// NATS not configured, skip publishing
+		return nil
+	}
+
+	event := p.messages.departmentEvent(ctx, eventType, tenantID, userID, department)
+
+	return p.publishProtoEvent(ctx, subject, event.Event, nil, event)
+}
+
+// PublishTeamCreated publishes a team created event
+func (p *EventPublisher) PublishTeamCreated(ctx context.Context, tenantID, userID string, team *biz.Team) error {
+	return p.publishTeamEvent(ctx, SubjectTeamCreated, eventsv1.EventType_EVENT_TYPE_TEAM_CREATED, tenantID, userID, team, nil)
+}
+
+// PublishTeamMemberAdded publishes a team member added event
+func (p *EventPublisher) PublishTeamMemberAdded(ctx context.Context, tenantID, userID string, team *biz.Team, member *biz.Employee) error {
+	return p.publishTeamEvent(ctx, SubjectTeamMemberAdded, eventsv1.EventType_EVENT_TYPE_TEAM_MEMBER_ADDED, tenantID, userID, team, member)
+}
+
+// PublishTeamMemberRemoved publishes a team member removed event
+func (p *EventPublisher) PublishTeamMemberRemoved(ctx context.Context, tenantID, userID string, team *biz.Team, member *biz.Employee) error {
+	return p.publishTeamEvent(ctx, SubjectTeamMemberRemoved, eventsv1.EventType_EVENT_TYPE_TEAM_MEMBER_REMOVED, tenantID, userID, team, member)
+}
+
+// publishTeamEvent publishes a team event on subject
+func (p *EventPublisher) publishTeamEvent(ctx context.Context, subject string, eventType eventsv1.EventType, tenantID, userID string, team *biz.Team, member *biz.Employee) error {
+	if p == nil || p.nc == nil {
+		// NATS not configured, skip publishing
+		return nil
 	}
 
-	return p.publishProtoEvent(SubjectEmployeeMerged, event)
+	event := p.messages.teamEvent(ctx, eventType, tenantID, userID, team, member)
+
+	return p.publishProtoEvent(ctx, subject, event.Event, nil, event)
 }
 
-// publishProtoEvent marshals and publishes a protobuf message to NATS
-func (p *EventPublisher) publishProtoEvent(subject string, msg proto.Message) error {
-	// Marshal event to Protocol Buffers
-	data, err := proto.Marshal(msg)
+// publishProtoEvent marshals and publishes a protobuf message to NATS. event
+// is the EmployeeEvent embedded in msg and changedFields the fields kept when
+// it is slimmed. Each publish is traced in a producer span and failures are
+// counted by tenant and subject.
+func (p *EventPublisher) publishProtoEvent(ctx context.Context, subject string, event *eventsv1.EmployeeEvent, changedFields []string, msg proto.Message) (err error) {
+	defer observability.StartPhase(ctx, observability.PhasePublish)()
+	ctx, span := tracer().Start(ctx, subject+" publish", trace.WithSpanKind(trace.SpanKindProducer), trace.WithAttributes(
+		semconv.MessagingSystem("nats"),
+		semconv.MessagingOperationPublish,
+		semconv.MessagingDestinationName(subject),
+		semconv.MessagingMessageID(event.EventId),
+		attrTenantID(event.TenantId),
+	))
+	defer func() {
+		if err != nil {
+			p.obs.RecordEventPublishFailure(event.TenantId, subject)
+		}
+		endSpan(span, err)
+	}()
+
+	// Marshal event to Protocol Buffers into a pooled buffer; NATS copies the
+	// payload into its write buffer, so it can be reused once publish returns
+	bufp := marshalBufferPool.Get().(*[]byte)
+	defer func() {
+		if cap(*bufp) <= maxPooledBufferSize {
+			marshalBufferPool.Put(bufp)
+		}
+	}()
+
+	keyID := p.encryption.keyFor(event.TenantId)
+	data, err := p.encodeEvent(*bufp, subject, event, changedFields, keyID, msg)
 	if err != nil {
-		p.log.Errorf("failed to marshal proto event: %v", err)
+		p.log.Errorf("failed to encode event %s for subject %s: %v", event.EventId, subject, err)
 		return err
 	}
+	*bufp = data
+
+	out := &nats.Msg{Subject: subject, Data: data}
+	if keyID != "" {
+		if out.Data, out.Header, err = p.encryption.seal(keyID, subject, data); err != nil {
+			p.log.Errorf("failed to encrypt event %s with key %s: %v", event.EventId, keyID, err)
+			return err
+		}
+	}
+	if p.signer != nil {
+		p.signer.Sign(out)
+	}
+	span.SetAttributes(semconv.MessagingMessagePayloadSizeBytes(len(out.Data)))
+
+	if p.js != nil {
+		return p.publishJetStream(ctx, out, event.EventId)
+	}
 
 	// Publish to NATS (best-effort)
-	if err := p.nc.Publish(subject, data); err != nil {
+	if err := p.nc.PublishMsg(out); err != nil {
 		p.log.Errorf("failed to publish event to NATS subject %s: %v", subject, err)
 		return err
 	}
@@ -187,3 +472,82 @@ func (p *EventPublisher) publishProtoEvent(subject string, msg proto.Message) er
 	p.log.Infof("published event to subject: %s", subject)
 	return nil
 }
+
+// envelopeOverhead is the number of bytes encryption with keyID and signing
+// add to a published event
+func (p *EventPublisher) envelopeOverhead(keyID string) int64 {
+	var n int64
+	if keyID != "" {
+		n += encryptionOverhead(keyID)
+	}
+	if p.signer != nil {
+		n += signatureOverhead(p.signer.KeyID())
+	}
+	if n > 0 {
+		n += int64(natsHeaderFraming)
+	}
+	return n
+}
+
+// encodeEvent marshals msg into buf, slimming event when slim events are
+// enabled or when the full event does not fit the payload limit. keyID is
+// the key the payload will be encrypted with, if any.
+func (p *EventPublisher) encodeEvent(buf []byte, subject string, event *eventsv1.EmployeeEvent, changedFields []string, keyID string, msg proto.Message) ([]byte, error) {
+	if p.slim {
+		slimEvent(event, changedFields)
+	}
+
+	limit := p.maxPayload()
+	if limit > 0 {
+		limit -= p.envelopeOverhead(keyID)
+	}
+	for {
+		data, err := proto.MarshalOptions{}.MarshalAppend(buf[:0], msg)
+		if err != nil {
+			return nil, err
+		}
+		if limit <= 0 || int64(len(data)) <= limit {
+			return data, nil
+		}
+
+		if event.Slim {
+			p.obs.RecordOversizedEvent(subject, "dropped")
+			return nil, fmt.Errorf("%w: %d bytes, limit %d", ErrEventTooLarge, len(data), limit)
+		}
+
+		p.obs.RecordOversizedEvent(subject, "slimmed")
+		p.log.Warnf("event %s for subject %s is %d bytes (limit %d), publishing it slim", event.EventId, subject, len(data), limit)
+		slimEvent(event, changedFields)
+		buf = data
+	}
+}
+
+// publishJetStream publishes to JetStream and waits for the stream to persist the event
+func (p *EventPublisher) publishJetStream(ctx context.Context, msg *nats.Msg, eventID string) error {
+	ctx, cancel, err := p.budget.derive(ctx, budgetNATS, p.publishTimeout)
+	if err != nil {
+		p.log.Errorf("not publishing event to JetStream subject %s: %v", msg.Subject, err)
+		return err
+	}
+	defer cancel()
+
+	ack, err := p.js.PublishMsg(ctx, msg, jetstream.WithMsgID(eventID))
+	if err != nil {
+		p.budget.observe(ctx, budgetNATS, err)
+		p.log.Errorf("failed to publish event to JetStream subject %s: %v", msg.Subject, err)
+		return err
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.String("messaging.nats.stream", ack.Stream),
+		attribute.Int64("messaging.nats.sequence", int64(ack.Sequence)),
+		attribute.Bool("messaging.nats.duplicate", ack.Duplicate),
+	)
+	if ack.Duplicate {
+		p.log.Infof("event %s already stored in stream %s", eventID, ack.Stream)
+		return nil
+	}
+
+	p.log.Infof("published event to subject: %s (stream=%s, seq=%d)", msg.Subject, ack.Stream, ack.Sequence)
+	return nil
+}
@@ -1,14 +1,19 @@
 package data
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"testing"
 	"time"
 
 	eventsv1 "github.com/cvele/employee-service/api/events/v1"
 	"github.com/cvele/employee-service/internal/biz"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -75,6 +80,23 @@ func TestToProtoEmployeeData(t *testing.T) {
 	}
 }
 
+func BenchmarkToProtoEmployeeData(b *testing.B) {
+	employee := &biz.Employee{
+		ID:        uuid.New(),
+		Emails:    []string{"test@example.com", "secondary@example.com"},
+		FirstName: "John",
+		LastName:  "Doe",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = toProtoEmployeeData(employee)
+	}
+}
+
 func TestEmployeeCreatedEventContract(t *testing.T) {
 	// Test that EmployeeCreatedEvent can be marshaled and unmarshaled
 	employee := &biz.Employee{
@@ -221,6 +243,32 @@ func TestEmployeeMergedEventContract(t *testing.T) {
 	assert.Equal(t, "secondary@example.com", decoded.MergedFromEmail)
 }
 
+func TestEmployeeMergedEvent_Mapping(t *testing.T) {
+	merge := &biz.Merge{
+		ID: uuid.New(),
+		Primary: &biz.Employee{
+			ID:          uuid.New(),
+			Emails:      []string{"primary@example.com", "old@example.com"},
+			ExternalIDs: map[string]string{"workday": "W-1", "bamboohr": "42"},
+		},
+		Secondary: &biz.Employee{
+			ID:          uuid.New(),
+			Emails:      []string{"old@example.com"},
+			ExternalIDs: map[string]string{"workday": "W-2", "bamboohr": "42"},
+		},
+	}
+
+	event := eventMessages{}.employeeMergedEvent(context.Background(), "tenant-123", "user-456", merge, "old@example.com")
+
+	assert.Equal(t, merge.ID.String(), event.MergeId)
+	assert.Equal(t, merge.Secondary.ID.String(), event.SecondaryId)
+	assert.Equal(t, merge.Primary.ID.String(), event.PrimaryId)
+	assert.Equal(t, merge.Primary.ID.String(), event.Event.Employee.Id)
+	assert.Equal(t, []string{"old@example.com"}, event.MovedEmails)
+	// The primary employee kept its own workday ID
+	assert.Equal(t, map[string]string{"bamboohr": "42"}, event.MovedExternalIds)
+}
+
 func TestEventTypeEnum(t *testing.T) {
 	// Test that all event types are defined
 	assert.Equal(t, "EVENT_TYPE_UNSPECIFIED", eventsv1.EventType_EVENT_TYPE_UNSPECIFIED.String())
@@ -251,4 +299,100 @@ func TestSubjectConstants(t *testing.T) {
 	assert.Equal(t, "employees.v1.updated", SubjectEmployeeUpdated)
 	assert.Equal(t, "employees.v1.deleted", SubjectEmployeeDeleted)
 	assert.Equal(t, "employees.v1.merged", SubjectEmployeeMerged)
+	assert.Equal(t, "employees.v1.unmerged", SubjectEmployeeUnmerged)
+}
+
+// newUpdatedEvent builds an updated event for an employee with n emails
+func newUpdatedEvent(n int, updatedFields []string) *eventsv1.EmployeeUpdatedEvent {
+	emails := make([]string, n)
+	for i := range emails {
+		emails[i] = fmt.Sprintf("employee.%d@example.com", i)
+	}
+	return &eventsv1.EmployeeUpdatedEvent{
+		Event: &eventsv1.EmployeeEvent{
+			EventId:   uuid.New().String(),
+			EventType: eventsv1.EventType_EVENT_TYPE_UPDATED,
+			TenantId:  "tenant-123",
+			Employee: toProtoEmployeeData(&biz.Employee{
+				ID:        uuid.New(),
+				Emails:    emails,
+				FirstName: "Jane",
+				LastName:  "Doe",
+				UpdatedAt: time.Now(),
+			}),
+		},
+		UpdatedFields: updatedFields,
+	}
+}
+
+func TestSlimEvent(t *testing.T) {
+	event := newUpdatedEvent(3, []string{"last_name"})
+	full := event.Event.Employee
+
+	slimEvent(event.Event, event.UpdatedFields)
+
+	assert.True(t, event.Event.Slim)
+	assert.Equal(t, full.Id, event.Event.Employee.Id)
+	assert.Equal(t, full.UpdatedAt, event.Event.Employee.UpdatedAt)
+	assert.Equal(t, "Doe", event.Event.Employee.LastName)
+	assert.Empty(t, event.Event.Employee.FirstName)
+	assert.Empty(t, event.Event.Employee.Emails)
+}
+
+func TestEventMessages_IncludeAddress(t *testing.T) {
+	employee := &biz.Employee{ID: uuid.New(), Address: &biz.Address{Line1: "Main St 1", City: "Berlin", Country: "DE"}}
+
+	event := eventMessages{}.employeeCreatedEvent(context.Background(), "tenant-1", "user-1", employee)
+	assert.Nil(t, event.Event.Employee.Address)
+	assert.NotContains(t, event.Event.Metadata, EventMetadataAddressIncluded)
+
+	event = eventMessages{includeAddress: true}.employeeCreatedEvent(context.Background(), "tenant-1", "user-1", employee)
+	assert.Equal(t, "Main St 1", event.Event.Employee.Address.GetLine1())
+	assert.Equal(t, "DE", event.Event.Employee.Address.GetCountry())
+	assert.Equal(t, "true", event.Event.Metadata[EventMetadataAddressIncluded])
+
+	// An employee without an address is still marked, as addresses are included
+	event = eventMessages{includeAddress: true}.employeeCreatedEvent(context.Background(), "tenant-1", "user-1", &biz.Employee{ID: uuid.New()})
+	assert.Nil(t, event.Event.Employee.Address)
+	assert.Equal(t, "true", event.Event.Metadata[EventMetadataAddressIncluded])
+}
+
+func TestEncodeEvent(t *testing.T) {
+	tests := []struct {
+		name          string
+		publisher     *EventPublisher
+		emails        int
+		updatedFields []string
+		wantSlim      bool
+		wantErr       error
+	}{
+		{name: "fits", publisher: &EventPublisher{maxEventBytes: 1024}, emails: 2, updatedFields: []string{"emails"}},
+		{name: "no limit", publisher: &EventPublisher{}, emails: 200},
+		{name: "slim mode", publisher: &EventPublisher{slim: true}, emails: 2, wantSlim: true},
+		{name: "oversized is slimmed", publisher: &EventPublisher{maxEventBytes: 1024}, emails: 200, updatedFields: []string{"first_name"}, wantSlim: true},
+		{name: "oversized changed fields are dropped", publisher: &EventPublisher{maxEventBytes: 1024}, emails: 200, updatedFields: []string{"emails"}, wantErr: ErrEventTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.publisher.log = log.NewHelper(log.NewStdLogger(io.Discard))
+			event := newUpdatedEvent(tt.emails, tt.updatedFields)
+
+			data, err := tt.publisher.encodeEvent(nil, SubjectEmployeeUpdated, event.Event, event.UpdatedFields, "", event)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var decoded eventsv1.EmployeeUpdatedEvent
+			require.NoError(t, proto.Unmarshal(data, &decoded))
+			assert.Equal(t, tt.wantSlim, decoded.Event.Slim)
+			assert.Equal(t, event.Event.Employee.Id, decoded.Event.Employee.Id)
+			if tt.publisher.maxEventBytes > 0 {
+				assert.LessOrEqual(t, int64(len(data)), tt.publisher.maxEventBytes)
+			}
+		})
+	}
 }
@@ -0,0 +1,54 @@
+package data
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/pkg/eventcrypto"
+)
+
+// natsHeaderFraming is the size of the NATS header version line and the
+// blank line ending the headers
+const natsHeaderFraming = len("NATS/1.0\r\n\r\n")
+
+// newEventSigner creates the signer for events and the set of public keys
+// consumers verify them with. The signer is nil when no signing key is
+// configured; retired keys are published either way.
+func newEventSigner(c *conf.Data_Nats_Signing) (*eventcrypto.Signer, *eventcrypto.JWKS, error) {
+	keys := &eventcrypto.JWKS{Keys: []eventcrypto.JWK{}}
+
+	var signer *eventcrypto.Signer
+	if c.GetPrivateKey() != "" {
+		seed, err := base64.StdEncoding.DecodeString(c.GetPrivateKey())
+		if err != nil {
+			return nil, nil, fmt.Errorf("event signing key %s is not valid base64: %w", c.GetKeyId(), err)
+		}
+		if signer, err = eventcrypto.NewSigner(c.GetKeyId(), seed); err != nil {
+			return nil, nil, err
+		}
+		keys.Keys = append(keys.Keys, eventcrypto.PublicJWK(signer.KeyID(), signer.PublicKey()))
+	}
+
+	for _, k := range c.GetRetiredKeys() {
+		public, err := base64.RawURLEncoding.DecodeString(k.GetPublicKey())
+		if err != nil || len(public) != ed25519.PublicKeySize {
+			return nil, nil, fmt.Errorf("retired event signing key %s must be a base64url encoded %d-byte public key", k.GetKeyId(), ed25519.PublicKeySize)
+		}
+		if signer != nil && k.GetKeyId() == signer.KeyID() {
+			return nil, nil, fmt.Errorf("retired event signing key %s reuses the active key ID", k.GetKeyId())
+		}
+		keys.Keys = append(keys.Keys, eventcrypto.PublicJWK(k.GetKeyId(), public))
+	}
+
+	return signer, keys, nil
+}
+
+// signatureOverhead is the number of bytes the signature headers of a key
+// add to a published event
+func signatureOverhead(keyID string) int64 {
+	sig := base64.StdEncoding.EncodedLen(ed25519.SignatureSize)
+	return int64(len(eventcrypto.HeaderSignature+": \r\n") + sig +
+		len(eventcrypto.HeaderSignatureKeyID+": "+keyID+"\r\n"))
+}
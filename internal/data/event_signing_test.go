@@ -0,0 +1,68 @@
+package data
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/pkg/eventcrypto"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSigningSeed = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{5}, 32))
+
+func TestNewEventSigner(t *testing.T) {
+	retired, err := eventcrypto.NewSigner("sig-0", bytes.Repeat([]byte{4}, 32))
+	require.NoError(t, err)
+
+	signer, keys, err := newEventSigner(&conf.Data_Nats_Signing{
+		KeyId:      "sig-1",
+		PrivateKey: testSigningSeed,
+		RetiredKeys: []*conf.Data_Nats_Signing_RetiredKey{
+			{KeyId: "sig-0", PublicKey: eventcrypto.PublicJWK("sig-0", retired.PublicKey()).X},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, keys.Keys, 2)
+	assert.Equal(t, "sig-1", keys.Keys[0].Kid)
+	assert.Equal(t, "sig-0", keys.Keys[1].Kid)
+
+	// Consumers verify events signed with either key from the published set
+	verifier := eventcrypto.NewVerifier()
+	require.NoError(t, verifier.AddJWKS(keys))
+	for _, s := range []*eventcrypto.Signer{signer, retired} {
+		msg := &nats.Msg{Subject: SubjectEmployeeCreated, Data: []byte("payload")}
+		s.Sign(msg)
+		assert.NoError(t, verifier.Verify(msg))
+	}
+}
+
+func TestNewEventSigner_Disabled(t *testing.T) {
+	signer, keys, err := newEventSigner(nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, signer)
+	assert.NotNil(t, keys.Keys)
+	assert.Empty(t, keys.Keys)
+}
+
+func TestNewEventSigner_Invalid(t *testing.T) {
+	_, _, err := newEventSigner(&conf.Data_Nats_Signing{KeyId: "sig-1", PrivateKey: "not base64!"})
+	assert.ErrorContains(t, err, "not valid base64")
+
+	_, _, err = newEventSigner(&conf.Data_Nats_Signing{
+		KeyId:       "sig-1",
+		PrivateKey:  testSigningSeed,
+		RetiredKeys: []*conf.Data_Nats_Signing_RetiredKey{{KeyId: "sig-1", PublicKey: base64.RawURLEncoding.EncodeToString(bytes.Repeat([]byte{6}, 32))}},
+	})
+	assert.ErrorContains(t, err, "reuses the active key ID")
+
+	_, _, err = newEventSigner(&conf.Data_Nats_Signing{
+		RetiredKeys: []*conf.Data_Nats_Signing_RetiredKey{{KeyId: "sig-0", PublicKey: "c2hvcnQ"}},
+	})
+	assert.ErrorContains(t, err, "32-byte public key")
+}
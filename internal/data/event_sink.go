@@ -0,0 +1,339 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	eventsv1 "github.com/cvele/employee-service/api/events/v1"
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/observability"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultSinkMaxFileBytes is the size at which the sink file is rotated
+	defaultSinkMaxFileBytes = 100 << 20
+	// defaultSinkMaxFiles is the number of rotated sink files kept
+	defaultSinkMaxFiles = 5
+	// defaultSinkTimeout bounds a POST to the collector
+	defaultSinkTimeout = 5 * time.Second
+	// sinkErrorLimit bounds the collector response body kept in errors
+	sinkErrorLimit = 1 << 10
+)
+
+// sinkLine is one NDJSON line written by the event sink. The event is the
+// protojson encoding of the message published on subject.
+type sinkLine struct {
+	Subject string          `json:"subject"`
+	Event   json.RawMessage `json:"event"`
+}
+
+// eventSink stores encoded NDJSON lines
+type eventSink interface {
+	write(ctx context.Context, line []byte) error
+	close() error
+	String() string
+}
+
+// newEventSink creates the sink configured in c, returning nil when no sink is
+// configured
+func newEventSink(c *conf.Data_EventSink) (eventSink, error) {
+	switch {
+	case c.GetFile() != "" && c.GetUrl() != "":
+		return nil, fmt.Errorf("event sink file and url are mutually exclusive")
+	case c.GetFile() != "":
+		maxBytes := int64(defaultSinkMaxFileBytes)
+		if c.GetMaxFileBytes() > 0 {
+			maxBytes = c.GetMaxFileBytes()
+		}
+		maxFiles := defaultSinkMaxFiles
+		if c.GetMaxFiles() > 0 {
+			maxFiles = int(c.GetMaxFiles())
+		}
+		return openFileSink(c.GetFile(), maxBytes, maxFiles)
+	case c.GetUrl() != "":
+		u, err := url.Parse(c.GetUrl())
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid event sink url %q", c.GetUrl())
+		}
+		timeout := defaultSinkTimeout
+		if c.GetTimeout() != nil {
+			timeout = c.GetTimeout().AsDuration()
+		}
+		return &httpSink{url: c.GetUrl(), client: &http.Client{Timeout: timeout}}, nil
+	}
+	return nil, nil
+}
+
+// fileSink appends lines to a file, rotating it to path.1 ... path.N once it
+// would grow past maxBytes
+type fileSink struct {
+	path     string
+	maxBytes int64
+	maxFiles int
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+// openFileSink opens path for appending, creating it if needed
+func openFileSink(path string, maxBytes int64, maxFiles int) (*fileSink, error) {
+	s := &fileSink{path: path, maxBytes: maxBytes, maxFiles: maxFiles}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *fileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.f, s.size = f, info.Size()
+	return nil
+}
+
+func (s *fileSink) write(_ context.Context, line []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.f == nil {
+		// A failed rotation closed the file; try again
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+	if s.size > 0 && s.size+int64(len(line)) > s.maxBytes {
+		if err := s.rotate(); err != nil {
+			return fmt.Errorf("rotate event sink file: %w", err)
+		}
+	}
+
+	n, err := s.f.Write(line)
+	s.size += int64(n)
+	return err
+}
+
+// rotate shifts path.i to path.i+1, dropping the oldest file, moves the
+// current file to path.1 and starts a new one
+func (s *fileSink) rotate() error {
+	if err := s.f.Close(); err != nil {
+		return err
+	}
+	s.f = nil
+
+	for i := s.maxFiles - 1; i > 0; i-- {
+		err := os.Rename(fmt.Sprintf("%s.%d", s.path, i), fmt.Sprintf("%s.%d", s.path, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(s.path, s.path+".1"); err != nil {
+		return err
+	}
+	return s.open()
+}
+
+func (s *fileSink) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
+
+func (s *fileSink) String() string {
+	return "file " + s.path
+}
+
+// httpSink POSTs every line to a collector
+type httpSink struct {
+	url    string
+	client *http.Client
+}
+
+func (s *httpSink) write(ctx context.Context, line []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(line))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	req.Header.Set("User-Agent", "employee-service-events")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, sinkErrorLimit))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+func (s *httpSink) close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+func (s *httpSink) String() string {
+	return "collector " + s.url
+}
+
+// SinkEventPublisher writes events to an eventSink as NDJSON. It is the
+// fallback for deployments without NATS: events are full (never slimmed) and
+// neither encrypted nor signed.
+type SinkEventPublisher struct {
+	sink     eventSink
+	log      *log.Helper
+	messages eventMessages
+}
+
+// NewSinkEventPublisher creates an event publisher writing to sink
+func NewSinkEventPublisher(sink eventSink, logger log.Logger) *SinkEventPublisher {
+	return &SinkEventPublisher{
+		sink: sink,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// PublishEmployeeCreated writes an employee created event
+func (p *SinkEventPublisher) PublishEmployeeCreated(ctx context.Context, tenantID, userID string, employee *biz.Employee) error {
+	event := p.messages.employeeCreatedEvent(ctx, tenantID, userID, employee)
+	return p.write(ctx, SubjectEmployeeCreated, event.Event, event)
+}
+
+// PublishEmployeeUpdated writes an employee updated event
+func (p *SinkEventPublisher) PublishEmployeeUpdated(ctx context.Context, tenantID, userID string, employee *biz.Employee, updatedFields []string, emails biz.EmailChanges) error {
+	event := p.messages.employeeUpdatedEvent(ctx, tenantID, userID, employee, updatedFields, emails)
+	return p.write(ctx, SubjectEmployeeUpdated, event.Event, event)
+}
+
+// PublishEmployeeDeleted writes an employee deleted event
+func (p *SinkEventPublisher) PublishEmployeeDeleted(ctx context.Context, tenantID, userID string, employee *biz.Employee) error {
+	event := p.messages.employeeDeletedEvent(ctx, tenantID, userID, employee)
+	return p.write(ctx, SubjectEmployeeDeleted, event.Event, event)
+}
+
+// PublishEmployeeMerged writes an employee merged event
+func (p *SinkEventPublisher) PublishEmployeeMerged(ctx context.Context, tenantID, userID string, merge *biz.Merge, mergedFromEmail string) error {
+	event := p.messages.employeeMergedEvent(ctx, tenantID, userID, merge, mergedFromEmail)
+	return p.write(ctx, SubjectEmployeeMerged, event.Event, event)
+}
+
+// PublishEmployeeUnmerged writes an employee unmerged event
+func (p *SinkEventPublisher) PublishEmployeeUnmerged(ctx context.Context, tenantID, userID string, merge *biz.Merge) error {
+	event := p.messages.employeeUnmergedEvent(ctx, tenantID, userID, merge)
+	return p.write(ctx, SubjectEmployeeUnmerged, event.Event, event)
+}
+
+// PublishEmployeeDeactivated writes an employee deactivated event
+func (p *SinkEventPublisher) PublishEmployeeDeactivated(ctx context.Context, tenantID, userID string, employee *biz.Employee) error {
+	event := p.messages.employeeDeactivatedEvent(ctx, tenantID, userID, employee)
+	return p.write(ctx, SubjectEmployeeDeactivated, event.Event, event)
+}
+
+// PublishEmployeeReactivated writes an employee reactivated event
+func (p *SinkEventPublisher) PublishEmployeeReactivated(ctx context.Context, tenantID, userID string, employee *biz.Employee) error {
+	event := p.messages.employeeReactivatedEvent(ctx, tenantID, userID, employee)
+	return p.write(ctx, SubjectEmployeeReactivated, event.Event, event)
+}
+
+// PublishEmployeeStale writes an employee stale event
+func (p *SinkEventPublisher) PublishEmployeeStale(ctx context.Context, tenantID, userID string, employee *biz.Employee, reasons []string) error {
+	event := p.messages.employeeStaleEvent(ctx, tenantID, userID, employee, reasons)
+	return p.write(ctx, SubjectEmployeeStale, event.Event, event)
+}
+
+// PublishDepartmentCreated writes a department created event
+func (p *SinkEventPublisher) PublishDepartmentCreated(ctx context.Context, tenantID, userID string, department *biz.Department) error {
+	event := p.messages.departmentEvent(ctx, eventsv1.EventType_EVENT_TYPE_DEPARTMENT_CREATED, tenantID, userID, department)
+	return p.write(ctx, SubjectDepartmentCreated, event.Event, event)
+}
+
+// PublishDepartmentUpdated writes a department updated event
+func (p *SinkEventPublisher) PublishDepartmentUpdated(ctx context.Context, tenantID, userID string, department *biz.Department) error {
+	event := p.messages.departmentEvent(ctx, eventsv1.EventType_EVENT_TYPE_DEPARTMENT_UPDATED, tenantID, userID, department)
+	return p.write(ctx, SubjectDepartmentUpdated, event.Event, event)
+}
+
+// PublishDepartmentDeleted writes a department deleted event
+func (p *SinkEventPublisher) PublishDepartmentDeleted(ctx context.Context, tenantID, userID string, department *biz.Department) error {
+	event := p.messages.departmentEvent(ctx, eventsv1.EventType_EVENT_TYPE_DEPARTMENT_DELETED, tenantID, userID, department)
+	return p.write(ctx, SubjectDepartmentDeleted, event.Event, event)
+}
+
+// PublishTeamCreated writes a team created event
+func (p *SinkEventPublisher) PublishTeamCreated(ctx context.Context, tenantID, userID string, team *biz.Team) error {
+	event := p.messages.teamEvent(ctx, eventsv1.EventType_EVENT_TYPE_TEAM_CREATED, tenantID, userID, team, nil)
+	return p.write(ctx, SubjectTeamCreated, event.Event, event)
+}
+
+// PublishTeamMemberAdded writes a team member added event
+func (p *SinkEventPublisher) PublishTeamMemberAdded(ctx context.Context, tenantID, userID string, team *biz.Team, member *biz.Employee) error {
+	event := p.messages.teamEvent(ctx, eventsv1.EventType_EVENT_TYPE_TEAM_MEMBER_ADDED, tenantID, userID, team, member)
+	return p.write(ctx, SubjectTeamMemberAdded, event.Event, event)
+}
+
+// PublishTeamMemberRemoved writes a team member removed event
+func (p *SinkEventPublisher) PublishTeamMemberRemoved(ctx context.Context, tenantID, userID string, team *biz.Team, member *biz.Employee) error {
+	event := p.messages.teamEvent(ctx, eventsv1.EventType_EVENT_TYPE_TEAM_MEMBER_REMOVED, tenantID, userID, team, member)
+	return p.write(ctx, SubjectTeamMemberRemoved, event.Event, event)
+}
+
+// write encodes msg as an NDJSON line and hands it to the sink
+func (p *SinkEventPublisher) write(ctx context.Context, subject string, event *eventsv1.EmployeeEvent, msg proto.Message) error {
+	defer observability.StartPhase(ctx, observability.PhasePublish)()
+
+	line, err := encodeSinkLine(subject, msg)
+	if err != nil {
+		p.log.Errorf("failed to encode event %s for subject %s: %v", event.EventId, subject, err)
+		return err
+	}
+
+	if err := p.sink.write(ctx, line); err != nil {
+		p.log.Errorf("failed to write event %s to %s: %v", event.EventId, p.sink, err)
+		return err
+	}
+
+	p.log.Infof("wrote event for subject %s to %s", subject, p.sink)
+	return nil
+}
+
+// encodeSinkLine returns the newline terminated NDJSON line for msg
+func encodeSinkLine(subject string, msg proto.Message) ([]byte, error) {
+	event, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	line, err := json.Marshal(sinkLine{Subject: subject, Event: event})
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
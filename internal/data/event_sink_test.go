@@ -0,0 +1,216 @@
+package data
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	eventsv1 "github.com/cvele/employee-service/api/events/v1"
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func readSinkLines(t *testing.T, path string) []sinkLine {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var lines []sinkLine
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line sinkLine
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
+
+func TestNewEventSink(t *testing.T) {
+	sink, err := newEventSink(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, sink, "the sink is optional")
+
+	_, err = newEventSink(&conf.Data_EventSink{File: filepath.Join(t.TempDir(), "events.ndjson"), Url: "http://collector"})
+	assert.ErrorContains(t, err, "mutually exclusive")
+
+	_, err = newEventSink(&conf.Data_EventSink{Url: "collector:8080/events"})
+	assert.ErrorContains(t, err, "invalid event sink url")
+
+	sink, err = newEventSink(&conf.Data_EventSink{Url: "https://collector/events"})
+	require.NoError(t, err)
+	assert.Equal(t, "collector https://collector/events", sink.String())
+}
+
+func TestSinkEventPublisher_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	sink, err := openFileSink(path, defaultSinkMaxFileBytes, defaultSinkMaxFiles)
+	require.NoError(t, err)
+	defer sink.close()
+	p := NewSinkEventPublisher(sink, log.NewStdLogger(io.Discard))
+
+	employee := &biz.Employee{ID: uuid.New(), Emails: []string{"jane@example.com"}, FirstName: "Jane", LastName: "Doe", UpdatedAt: time.Now()}
+	require.NoError(t, p.PublishEmployeeCreated(context.Background(), "tenant-1", "user-1", employee))
+	require.NoError(t, p.PublishEmployeeUpdated(context.Background(), "tenant-1", "user-1", employee, []string{"last_name"}, biz.EmailChanges{}))
+
+	lines := readSinkLines(t, path)
+	require.Len(t, lines, 2)
+	assert.Equal(t, SubjectEmployeeCreated, lines[0].Subject)
+	assert.Equal(t, SubjectEmployeeUpdated, lines[1].Subject)
+
+	var updated eventsv1.EmployeeUpdatedEvent
+	require.NoError(t, protojson.Unmarshal(lines[1].Event, &updated))
+	assert.Equal(t, eventsv1.EventType_EVENT_TYPE_UPDATED, updated.Event.EventType)
+	assert.Equal(t, "tenant-1", updated.Event.TenantId)
+	assert.Equal(t, employee.ID.String(), updated.Event.Employee.Id)
+	assert.Equal(t, []string{"last_name"}, updated.UpdatedFields)
+}
+
+func TestFileSink_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	sink, err := openFileSink(path, 10, 2)
+	require.NoError(t, err)
+	defer sink.close()
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		require.NoError(t, sink.write(context.Background(), []byte(line)))
+	}
+
+	for name, want := range map[string]string{
+		path:        "fourth\n",
+		path + ".1": "third\n",
+		path + ".2": "second\n",
+	} {
+		got, err := os.ReadFile(name)
+		require.NoError(t, err)
+		assert.Equal(t, want, string(got), name)
+	}
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err), "only max_files rotated files are kept")
+
+	// Reopening appends to the current file
+	require.NoError(t, sink.close())
+	sink, err = openFileSink(path, 10, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("fourth\n")), sink.size)
+}
+
+func TestSinkEventPublisher_HTTP(t *testing.T) {
+	var got *http.Request
+	var body []byte
+	status := http.StatusAccepted
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	sink, err := newEventSink(&conf.Data_EventSink{Url: srv.URL})
+	require.NoError(t, err)
+	p := NewSinkEventPublisher(sink, log.NewStdLogger(io.Discard))
+	employee := &biz.Employee{ID: uuid.New(), Emails: []string{"jane@example.com"}}
+
+	require.NoError(t, p.PublishEmployeeDeleted(context.Background(), "tenant-1", "user-1", employee))
+	assert.Equal(t, http.MethodPost, got.Method)
+	assert.Equal(t, "application/x-ndjson", got.Header.Get("Content-Type"))
+	assert.Equal(t, byte('\n'), body[len(body)-1])
+	var line sinkLine
+	require.NoError(t, json.Unmarshal(body, &line))
+	assert.Equal(t, SubjectEmployeeDeleted, line.Subject)
+
+	status = http.StatusServiceUnavailable
+	err = p.PublishEmployeeDeleted(context.Background(), "tenant-1", "user-1", employee)
+	assert.ErrorContains(t, err, "unexpected status 503")
+}
+
+func TestSinkEventPublisher_InjectedClockAndIDs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	sink, err := openFileSink(path, defaultSinkMaxFileBytes, defaultSinkMaxFiles)
+	require.NoError(t, err)
+	defer sink.close()
+	p := NewSinkEventPublisher(sink, log.NewStdLogger(io.Discard))
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	id := uuid.New()
+	p.messages = eventMessages{
+		clock: biz.ClockFunc(func() time.Time { return now }),
+		ids:   biz.IDGeneratorFunc(func() uuid.UUID { return id }),
+	}
+
+	require.NoError(t, p.PublishEmployeeDeleted(context.Background(), "tenant-1", "user-1", &biz.Employee{ID: uuid.New()}))
+
+	lines := readSinkLines(t, path)
+	require.Len(t, lines, 1)
+	var deleted eventsv1.EmployeeDeletedEvent
+	require.NoError(t, protojson.Unmarshal(lines[0].Event, &deleted))
+	assert.Equal(t, id.String(), deleted.Event.EventId)
+	assert.Equal(t, now, deleted.Event.Timestamp.AsTime())
+}
+
+func TestSinkEventPublisher_RequestMetadata(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	sink, err := openFileSink(path, defaultSinkMaxFileBytes, defaultSinkMaxFiles)
+	require.NoError(t, err)
+	defer sink.close()
+	p := NewSinkEventPublisher(sink, log.NewStdLogger(io.Discard))
+	ctx := biz.WithRequestMetadata(context.Background(), biz.RequestMetadata{RequestID: "req-1", ClientIP: "192.0.2.1", APIVersion: "v1"})
+	ctx = biz.WithSource(ctx, biz.SourceImport)
+
+	require.NoError(t, p.PublishEmployeeDeleted(ctx, "tenant-1", "user-1", &biz.Employee{ID: uuid.New()}))
+
+	lines := readSinkLines(t, path)
+	require.Len(t, lines, 1)
+	var deleted eventsv1.EmployeeDeletedEvent
+	require.NoError(t, protojson.Unmarshal(lines[0].Event, &deleted))
+	assert.Equal(t, map[string]string{EventMetadataRequestID: "req-1", EventMetadataAPIVersion: "v1", EventMetadataSource: "import"}, deleted.Event.Metadata)
+}
+
+func TestSinkEventPublisher_Backfill(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	sink, err := openFileSink(path, defaultSinkMaxFileBytes, defaultSinkMaxFiles)
+	require.NoError(t, err)
+	defer sink.close()
+	p := NewSinkEventPublisher(sink, log.NewStdLogger(io.Discard))
+	ctx := biz.WithSource(context.Background(), biz.SourceBackfill)
+
+	require.NoError(t, p.PublishEmployeeCreated(ctx, "tenant-1", "user-1", &biz.Employee{ID: uuid.New()}))
+
+	lines := readSinkLines(t, path)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "employees.v1.created", lines[0].Subject)
+	var created eventsv1.EmployeeCreatedEvent
+	require.NoError(t, protojson.Unmarshal(lines[0].Event, &created))
+	assert.Equal(t, map[string]string{EventMetadataSource: "backfill", EventMetadataBackfill: "true"}, created.Event.Metadata)
+}
+
+func TestSinkEventPublisher_Impersonated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	sink, err := openFileSink(path, defaultSinkMaxFileBytes, defaultSinkMaxFiles)
+	require.NoError(t, err)
+	defer sink.close()
+	p := NewSinkEventPublisher(sink, log.NewStdLogger(io.Discard))
+	ctx := biz.WithImpersonator(context.Background(), biz.Impersonator{TenantID: "support", UserID: "admin-1"})
+
+	require.NoError(t, p.PublishEmployeeCreated(ctx, "tenant-1", "user-1", &biz.Employee{ID: uuid.New()}))
+
+	lines := readSinkLines(t, path)
+	require.Len(t, lines, 1)
+	var created eventsv1.EmployeeCreatedEvent
+	require.NoError(t, protojson.Unmarshal(lines[0].Event, &created))
+	assert.Equal(t, "user-1", created.Event.UserId)
+	assert.Equal(t, map[string]string{EventMetadataImpersonatorID: "admin-1", EventMetadataImpersonatorTenantID: "support"}, created.Event.Metadata)
+}
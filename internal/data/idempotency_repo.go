@@ -0,0 +1,91 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// IdempotencyKeyModel is the GORM model for idempotency keys of create requests
+type IdempotencyKeyModel struct {
+	TenantID       string    `gorm:"type:varchar(255);primaryKey"`
+	IdempotencyKey string    `gorm:"type:varchar(255);primaryKey"`
+	Fingerprint    string    `gorm:"type:varchar(64);not null"`
+	Employee       []byte    `gorm:"type:jsonb"`
+	ExpiresAt      time.Time `gorm:"not null;index:idx_employee_idempotency_keys_expires_at"`
+	CreatedAt      time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (IdempotencyKeyModel) TableName() string {
+	return "employee_idempotency_keys"
+}
+
+// claimIdempotencyKeyQuery inserts a key, taking over an expired one. A key
+// held by an uncommitted transaction blocks until that transaction ends.
+const claimIdempotencyKeyQuery = `
+INSERT INTO employee_idempotency_keys (tenant_id, idempotency_key, fingerprint, expires_at, created_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (tenant_id, idempotency_key) DO UPDATE
+SET fingerprint = EXCLUDED.fingerprint, employee = NULL, expires_at = EXCLUDED.expires_at, created_at = EXCLUDED.created_at
+WHERE employee_idempotency_keys.expires_at <= EXCLUDED.created_at`
+
+type idempotencyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewIdempotencyRepo creates a new idempotency key repository.
+func NewIdempotencyRepo(data *Data, logger log.Logger) biz.IdempotencyRepo {
+	return &idempotencyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Claim inserts the key, or returns the unexpired record already holding it
+func (r *idempotencyRepo) Claim(ctx context.Context, tenantID, key, fingerprint string, now, expiresAt time.Time) (*biz.IdempotencyRecord, error) {
+	db := r.data.DB(ctx)
+	result := db.Exec(claimIdempotencyKeyQuery, tenantID, key, fingerprint, expiresAt, now)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil, nil
+	}
+
+	var model IdempotencyKeyModel
+	if err := db.Where("tenant_id = ? AND idempotency_key = ?", tenantID, key).First(&model).Error; err != nil {
+		return nil, err
+	}
+	employee, err := unmarshalSnapshot(tenantID, model.Employee)
+	if err != nil {
+		return nil, err
+	}
+	return &biz.IdempotencyRecord{
+		Key:         model.IdempotencyKey,
+		Fingerprint: model.Fingerprint,
+		Employee:    employee,
+		ExpiresAt:   model.ExpiresAt,
+	}, nil
+}
+
+// Complete stores the created employee as the response of the key
+func (r *idempotencyRepo) Complete(ctx context.Context, tenantID, key string, employee *biz.Employee) error {
+	snapshot, err := marshalSnapshot(employee)
+	if err != nil {
+		return err
+	}
+	return r.data.DB(ctx).Model(&IdempotencyKeyModel{}).
+		Where("tenant_id = ? AND idempotency_key = ?", tenantID, key).
+		Update("employee", snapshot).Error
+}
+
+// DeleteExpired deletes the keys of all tenants expired before now
+func (r *idempotencyRepo) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.data.DB(ctx).Where("expires_at <= ?", now).Delete(&IdempotencyKeyModel{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,61 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyRepo_Claim(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &idempotencyRepo{data: d}
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	id := uuid.New()
+
+	// A new key is claimed
+	mock.ExpectExec(`INSERT INTO employee_idempotency_keys .* ON CONFLICT \(tenant_id, idempotency_key\) DO UPDATE .* WHERE employee_idempotency_keys.expires_at <= EXCLUDED.created_at`).
+		WithArgs("tenant-1", "key-1", "fp", now.Add(time.Hour), now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	record, err := repo.Claim(context.Background(), "tenant-1", "key-1", "fp", now, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Nil(t, record)
+
+	// A key in use returns the stored employee
+	mock.ExpectExec(`INSERT INTO employee_idempotency_keys`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT \* FROM "employee_idempotency_keys" WHERE tenant_id = \$1 AND idempotency_key = \$2`).
+		WithArgs("tenant-1", "key-1", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"tenant_id", "idempotency_key", "fingerprint", "employee", "expires_at"}).
+			AddRow("tenant-1", "key-1", "fp", []byte(`{"id":"`+id.String()+`","emails":["jane@example.com"],"first_name":"Jane","version":1}`), now.Add(time.Hour)))
+
+	record, err = repo.Claim(context.Background(), "tenant-1", "key-1", "fp", now, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NotNil(t, record.Employee)
+	assert.Equal(t, "fp", record.Fingerprint)
+	assert.Equal(t, id, record.Employee.ID)
+	assert.Equal(t, "tenant-1", record.Employee.TenantID)
+	assert.Equal(t, []string{"jane@example.com"}, record.Employee.Emails)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdempotencyRepo_DeleteExpired(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &idempotencyRepo{data: d}
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "employee_idempotency_keys" WHERE expires_at <= \$1`).
+		WithArgs(now).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	deleted, err := repo.DeleteExpired(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
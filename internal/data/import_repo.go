@@ -0,0 +1,284 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ImportModel is the GORM model for bulk employee imports
+type ImportModel struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	TenantID      string     `gorm:"type:varchar(255);not null;index:idx_employee_imports_tenant_id"`
+	Status        string     `gorm:"type:varchar(16);not null"`
+	SourceURL     string     `gorm:"type:text;not null"`
+	CSV           []byte     `gorm:"column:csv;type:bytea"`
+	TotalRows     int32      `gorm:"not null"`
+	ProcessedRows int32      `gorm:"not null"`
+	CreatedCount  int32      `gorm:"not null"`
+	FailedCount   int32      `gorm:"not null"`
+	Errors        []byte     `gorm:"type:jsonb;not null"`
+	Error         string     `gorm:"type:text;not null"`
+	CreatedBy     string     `gorm:"type:varchar(255);not null"`
+	LockedUntil   *time.Time `gorm:""`
+	ReadyAt       time.Time  `gorm:"not null"`
+	TurnAt        *time.Time `gorm:""`
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `gorm:"autoUpdateTime"`
+	CompletedAt   *time.Time `gorm:""`
+}
+
+// TableName overrides the table name
+func (ImportModel) TableName() string {
+	return "employee_imports"
+}
+
+// ToEntity converts ImportModel to biz.ImportOperation
+func (m *ImportModel) ToEntity() (*biz.ImportOperation, error) {
+	var rowErrors []biz.ImportRowError
+	if len(m.Errors) > 0 {
+		if err := json.Unmarshal(m.Errors, &rowErrors); err != nil {
+			return nil, err
+		}
+	}
+
+	return &biz.ImportOperation{
+		ID:            m.ID,
+		TenantID:      m.TenantID,
+		Status:        m.Status,
+		SourceURL:     m.SourceURL,
+		TotalRows:     m.TotalRows,
+		ProcessedRows: m.ProcessedRows,
+		CreatedCount:  m.CreatedCount,
+		FailedCount:   m.FailedCount,
+		Errors:        rowErrors,
+		Error:         m.Error,
+		CreatedBy:     m.CreatedBy,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+		CompletedAt:   m.CompletedAt,
+	}, nil
+}
+
+// marshalImportErrors encodes row errors for the errors column
+func marshalImportErrors(rowErrors []biz.ImportRowError) ([]byte, error) {
+	if rowErrors == nil {
+		rowErrors = []biz.ImportRowError{}
+	}
+	return json.Marshal(rowErrors)
+}
+
+// importColumns are the columns returned for an import; csv is only read when claiming
+var importColumns = []string{
+	"id", "tenant_id", "status", "source_url", "total_rows", "processed_rows", "created_count",
+	"failed_count", "errors", "error", "created_by", "locked_until", "created_at", "updated_at", "completed_at",
+}
+
+type importRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewImportRepo creates a new import repository.
+func NewImportRepo(data *Data, logger log.Logger) biz.ImportRepo {
+	return &importRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create stores a new pending import with its inline CSV.
+func (r *importRepo) Create(ctx context.Context, op *biz.ImportOperation, csv []byte) (*biz.ImportOperation, error) {
+	rowErrors, err := marshalImportErrors(op.Errors)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &ImportModel{
+		ID:        op.ID,
+		TenantID:  op.TenantID,
+		Status:    op.Status,
+		SourceURL: op.SourceURL,
+		CSV:       csv,
+		Errors:    rowErrors,
+		CreatedBy: op.CreatedBy,
+		ReadyAt:   r.data.now().UTC(),
+	}
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+
+	return model.ToEntity()
+}
+
+// Get retrieves an import by ID within tenant.
+func (r *importRepo) Get(ctx context.Context, tenantID string, id uuid.UUID) (*biz.ImportOperation, error) {
+	var model ImportModel
+	err := r.data.DB(ctx).
+		Select(importColumns).
+		Where("id = ? AND tenant_id = ?", id, tenantID).
+		First(&model).Error
+
+	if err == gorm.ErrRecordNotFound {
+		return nil, biz.ErrImportNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ToEntity()
+}
+
+// importClaimLock is the transaction-level advisory lock serializing import
+// claims, so that two workers cannot both take a tenant's last free slot.
+// Databases without advisory locks abort all but one of the conflicting
+// claims instead; their workers retry on their next poll.
+const importClaimLock = 0x696d706f7274 // "import"
+
+// runnableImportCondition matches imports no worker holds a lease on
+const runnableImportCondition = `(status = 'pending' OR (status = 'running' AND (locked_until IS NULL OR locked_until < @now)))`
+
+// claimImportQuery leases the runnable import of the tenant whose last turn
+// started the longest ago, skipping tenants at their concurrency cap
+const claimImportQuery = `
+UPDATE employee_imports SET status = 'running', locked_until = @locked_until, turn_at = @now, updated_at = CURRENT_TIMESTAMP
+WHERE id = (
+    SELECT i.id FROM employee_imports i
+    WHERE (i.status = 'pending' OR (i.status = 'running' AND (i.locked_until IS NULL OR i.locked_until < @now)))
+      AND (
+        SELECT count(*) FROM employee_imports a
+        WHERE a.tenant_id = i.tenant_id AND a.status = 'running' AND a.locked_until >= @now
+      ) < @max_per_tenant
+    ORDER BY (SELECT max(t.turn_at) FROM employee_imports t WHERE t.tenant_id = i.tenant_id) NULLS FIRST, i.ready_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+// ClaimNext leases the next runnable import across all tenants.
+func (r *importRepo) ClaimNext(ctx context.Context, lease time.Duration, maxPerTenant int) (*biz.ImportOperation, []byte, error) {
+	var models []ImportModel
+	now := r.data.now().UTC()
+	err := r.data.Transaction(ctx, func(ctx context.Context) error {
+		if r.data.dialect.advisoryLocks() {
+			if err := r.data.DB(ctx).Exec("SELECT pg_advisory_xact_lock(?)", importClaimLock).Error; err != nil {
+				return err
+			}
+		}
+		return r.data.DB(ctx).
+			Raw(claimImportQuery, map[string]interface{}{
+				"locked_until":   now.Add(lease),
+				"now":            now,
+				"max_per_tenant": maxPerTenant,
+			}).
+			Scan(&models).Error
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(models) == 0 {
+		return nil, nil, nil
+	}
+
+	op, err := models[0].ToEntity()
+	if err != nil {
+		return nil, nil, err
+	}
+	return op, models[0].CSV, nil
+}
+
+// SaveProgress stores the state of a running import and extends its lease.
+// Completed imports release the lease and drop the inline CSV.
+func (r *importRepo) SaveProgress(ctx context.Context, op *biz.ImportOperation, lease time.Duration) error {
+	rowErrors, err := marshalImportErrors(op.Errors)
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{
+		"status":         op.Status,
+		"total_rows":     op.TotalRows,
+		"processed_rows": op.ProcessedRows,
+		"created_count":  op.CreatedCount,
+		"failed_count":   op.FailedCount,
+		"errors":         rowErrors,
+		"error":          op.Error,
+		"completed_at":   op.CompletedAt,
+		"locked_until":   r.data.now().UTC().Add(lease),
+		"updated_at":     r.data.now().UTC(),
+	}
+	if op.CompletedAt != nil {
+		updates["locked_until"] = nil
+		updates["csv"] = nil
+	}
+
+	return r.data.DB(ctx).
+		Model(&ImportModel{}).
+		Where("id = ?", op.ID).
+		Updates(updates).Error
+}
+
+// Yield stores the progress of an import between turns, releasing its lease.
+// It stays running and becomes runnable again as of now.
+func (r *importRepo) Yield(ctx context.Context, op *biz.ImportOperation) error {
+	rowErrors, err := marshalImportErrors(op.Errors)
+	if err != nil {
+		return err
+	}
+
+	now := r.data.now().UTC()
+	return r.data.DB(ctx).
+		Model(&ImportModel{}).
+		Where("id = ?", op.ID).
+		Updates(map[string]interface{}{
+			"total_rows":     op.TotalRows,
+			"processed_rows": op.ProcessedRows,
+			"created_count":  op.CreatedCount,
+			"failed_count":   op.FailedCount,
+			"errors":         rowErrors,
+			"locked_until":   nil,
+			"ready_at":       now,
+			"updated_at":     now,
+		}).Error
+}
+
+// OthersWaiting reports whether another tenant has a runnable import
+func (r *importRepo) OthersWaiting(ctx context.Context, tenantID string) (bool, error) {
+	var ids []uuid.UUID
+	err := r.data.DB(ctx).
+		Model(&ImportModel{}).
+		Where(runnableImportCondition, map[string]interface{}{"now": r.data.now().UTC()}).
+		Where("tenant_id <> ?", tenantID).
+		Limit(1).
+		Pluck("id", &ids).Error
+	return len(ids) > 0, err
+}
+
+// QueueStats counts the runnable imports and finds the longest wait
+func (r *importRepo) QueueStats(ctx context.Context) (*biz.ImportQueueStats, error) {
+	now := r.data.now().UTC()
+	var row struct {
+		Waiting        int64
+		WaitingTenants int64
+		OldestReadyAt  *time.Time
+	}
+	if err := r.data.DB(ctx).
+		Model(&ImportModel{}).
+		Select("count(*) AS waiting, count(DISTINCT tenant_id) AS waiting_tenants, min(ready_at) AS oldest_ready_at").
+		Where(runnableImportCondition, map[string]interface{}{"now": now}).
+		Scan(&row).Error; err != nil {
+		return nil, err
+	}
+
+	stats := &biz.ImportQueueStats{Waiting: row.Waiting, WaitingTenants: row.WaitingTenants}
+	if row.OldestReadyAt != nil {
+		stats.OldestWait = now.Sub(*row.OldestReadyAt)
+	}
+	return stats, nil
+}
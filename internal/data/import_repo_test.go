@@ -0,0 +1,39 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportRepo_ClaimNext(t *testing.T) {
+	d, mock := newMockData(t)
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	d.clock = biz.ClockFunc(func() time.Time { return now })
+	repo := &importRepo{data: d}
+	id := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT pg_advisory_xact_lock\(\$1\)`).
+		WithArgs(importClaimLock).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`UPDATE employee_imports SET status = 'running', locked_until = \$1, turn_at = \$2,.*`+
+		`a.status = 'running' AND a.locked_until >= \$\d+\s+\) < \$\d+\s+ORDER BY \(SELECT max\(t.turn_at\) .*\) NULLS FIRST, i.ready_at`).
+		WithArgs(now.Add(time.Minute), now, now, now, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "status", "csv"}).
+			AddRow(id, "tenant-1", "running", []byte("first_name,last_name,emails\n")))
+	mock.ExpectCommit()
+
+	op, csv, err := repo.ClaimNext(context.Background(), time.Minute, 1)
+	require.NoError(t, err)
+	assert.Equal(t, id, op.ID)
+	assert.Equal(t, "first_name,last_name,emails\n", string(csv))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
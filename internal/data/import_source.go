@@ -0,0 +1,80 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// maxImportObjectSize caps the size of CSV objects read for imports
+const maxImportObjectSize = 64 << 20
+
+// importSource reads import CSVs from the configured import bucket
+type importSource struct {
+	store  ObjectStore
+	bucket string
+	prefix string
+}
+
+// NewImportSource creates the import source, or returns nil when no import
+// bucket is configured
+func NewImportSource(c *conf.Admin) (biz.ImportSource, error) {
+	sc := c.GetImport().GetStore()
+	if sc.GetEndpoint() == "" || sc.GetBucket() == "" {
+		return nil, nil
+	}
+
+	store, err := NewS3ObjectStore(sc)
+	if err != nil {
+		return nil, fmt.Errorf("import store: %w", err)
+	}
+
+	return &importSource{store: store, bucket: sc.Bucket, prefix: strings.Trim(sc.Prefix, "/")}, nil
+}
+
+// objectKey maps s3://<bucket>/<prefix>/<key> to the key below the store prefix.
+// Objects outside the import bucket and prefix are rejected.
+func (s *importSource) objectKey(sourceURL string) (string, error) {
+	location := "s3://" + path.Join(s.bucket, s.prefix) + "/"
+
+	u, err := url.Parse(sourceURL)
+	if err != nil || u.Scheme != "s3" || u.Host != s.bucket {
+		return "", errors.BadRequest(v1.ErrorReason_INVALID_IMPORT_SOURCE.String(), "source_url must be below "+location)
+	}
+
+	key := strings.TrimPrefix(u.Path, "/")
+	if s.prefix != "" {
+		if !strings.HasPrefix(key, s.prefix+"/") {
+			return "", errors.BadRequest(v1.ErrorReason_INVALID_IMPORT_SOURCE.String(), "source_url must be below "+location)
+		}
+		key = strings.TrimPrefix(key, s.prefix+"/")
+	}
+	if key == "" || strings.HasSuffix(key, "/") {
+		return "", errors.BadRequest(v1.ErrorReason_INVALID_IMPORT_SOURCE.String(), "source_url must name an object")
+	}
+
+	return key, nil
+}
+
+// Validate checks that sourceURL points into the import bucket
+func (s *importSource) Validate(sourceURL string) error {
+	_, err := s.objectKey(sourceURL)
+	return err
+}
+
+// Fetch downloads the CSV at sourceURL
+func (s *importSource) Fetch(ctx context.Context, sourceURL string) ([]byte, error) {
+	key, err := s.objectKey(sourceURL)
+	if err != nil {
+		return nil, err
+	}
+	return s.store.Get(ctx, key, maxImportObjectSize)
+}
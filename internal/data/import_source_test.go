@@ -0,0 +1,46 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportSource_Fetch(t *testing.T) {
+	store := &memoryObjectStore{objects: map[string][]byte{"tenant-1/employees.csv": []byte("first_name,last_name,emails\n")}}
+	s := &importSource{store: store, bucket: "imports", prefix: "uploads"}
+
+	body, err := s.Fetch(context.Background(), "s3://imports/uploads/tenant-1/employees.csv")
+
+	require.NoError(t, err)
+	assert.Equal(t, "first_name,last_name,emails\n", string(body))
+}
+
+func TestImportSource_Validate(t *testing.T) {
+	s := &importSource{store: &memoryObjectStore{}, bucket: "imports", prefix: "uploads"}
+
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{url: "s3://imports/uploads/employees.csv", valid: true},
+		{url: "s3://other/uploads/employees.csv"},
+		{url: "s3://imports/archive/employees.csv"},
+		{url: "s3://imports/uploads/"},
+		{url: "https://imports/uploads/employees.csv"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			err := s.Validate(tt.url)
+			if tt.valid {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, "INVALID_IMPORT_SOURCE", errors.Reason(err))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// defaultStreamName is the JetStream stream capturing employee events
+	defaultStreamName = "EMPLOYEES"
+	// streamSubjects are the subjects captured by the employee events stream
+	streamSubjects = "employees.v1.>"
+	// defaultPublishTimeout bounds how long a publish waits for the stream ack
+	defaultPublishTimeout = 5 * time.Second
+	// defaultDuplicateWindow is how long the stream remembers Nats-Msg-Id values
+	defaultDuplicateWindow = 2 * time.Minute
+)
+
+// employeeStreamConfig builds the stream configuration from the NATS config
+func employeeStreamConfig(c *conf.Data_Nats) jetstream.StreamConfig {
+	name := c.GetStream()
+	if name == "" {
+		name = defaultStreamName
+	}
+
+	duplicates := defaultDuplicateWindow
+	if c.GetDuplicateWindow() != nil {
+		duplicates = c.GetDuplicateWindow().AsDuration()
+	}
+
+	return jetstream.StreamConfig{
+		Name:       name,
+		Subjects:   []string{streamSubjects},
+		Storage:    jetstream.FileStorage,
+		Duplicates: duplicates,
+	}
+}
+
+// publishTimeout returns the configured JetStream publish ack timeout
+func publishTimeout(c *conf.Data_Nats) time.Duration {
+	if c.GetPublishTimeout() != nil {
+		return c.GetPublishTimeout().AsDuration()
+	}
+	return defaultPublishTimeout
+}
+
+// setupJetStream creates the employee events stream, or updates it to the
+// expected configuration if it already exists
+func setupJetStream(nc *nats.Conn, c *conf.Data_Nats) (jetstream.JetStream, jetstream.StreamConfig, error) {
+	cfg := employeeStreamConfig(c)
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return nil, cfg, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := js.CreateOrUpdateStream(ctx, cfg); err != nil {
+		return nil, cfg, err
+	}
+
+	return js, cfg, nil
+}
@@ -0,0 +1,37 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestEmployeeStreamConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		cfg := employeeStreamConfig(&conf.Data_Nats{})
+
+		assert.Equal(t, "EMPLOYEES", cfg.Name)
+		assert.Equal(t, []string{"employees.v1.>"}, cfg.Subjects)
+		assert.Equal(t, jetstream.FileStorage, cfg.Storage)
+		assert.Equal(t, 2*time.Minute, cfg.Duplicates)
+		assert.Equal(t, 5*time.Second, publishTimeout(&conf.Data_Nats{}))
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		c := &conf.Data_Nats{
+			Stream:          "EMPLOYEES_TEST",
+			DuplicateWindow: durationpb.New(10 * time.Minute),
+			PublishTimeout:  durationpb.New(time.Second),
+		}
+		cfg := employeeStreamConfig(c)
+
+		assert.Equal(t, "EMPLOYEES_TEST", cfg.Name)
+		assert.Equal(t, 10*time.Minute, cfg.Duplicates)
+		assert.Equal(t, time.Second, publishTimeout(c))
+	})
+}
@@ -0,0 +1,171 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MergeApprovalModel is the GORM model for merges held for approval
+type MergeApprovalModel struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	TenantID       string     `gorm:"type:varchar(255);not null;index:idx_employee_merge_approvals_tenant_status,priority:1"`
+	PrimaryID      uuid.UUID  `gorm:"type:uuid;not null"`
+	SecondaryID    uuid.UUID  `gorm:"type:uuid;not null"`
+	Status         string     `gorm:"type:varchar(16);not null;index:idx_employee_merge_approvals_tenant_status,priority:2"`
+	Reasons        []byte     `gorm:"type:jsonb;not null"`
+	HistoryEntries int64      `gorm:"not null"`
+	Emails         int        `gorm:"not null"`
+	RequestedBy    string     `gorm:"type:varchar(255);not null"`
+	CreatedAt      time.Time  `gorm:"not null;index:idx_employee_merge_approvals_tenant_status,priority:3"`
+	DecidedBy      string     `gorm:"type:varchar(255);not null"`
+	DecidedAt      *time.Time `gorm:""`
+	MergeID        *uuid.UUID `gorm:"type:uuid"`
+	Error          string     `gorm:"type:text;not null"`
+}
+
+// TableName overrides the table name
+func (MergeApprovalModel) TableName() string {
+	return "employee_merge_approvals"
+}
+
+// ToEntity converts MergeApprovalModel to biz.MergeApproval
+func (m *MergeApprovalModel) ToEntity() (*biz.MergeApproval, error) {
+	var reasons []string
+	if len(m.Reasons) > 0 {
+		if err := json.Unmarshal(m.Reasons, &reasons); err != nil {
+			return nil, err
+		}
+	}
+
+	return &biz.MergeApproval{
+		ID:             m.ID,
+		TenantID:       m.TenantID,
+		PrimaryID:      m.PrimaryID,
+		SecondaryID:    m.SecondaryID,
+		Status:         m.Status,
+		Reasons:        reasons,
+		HistoryEntries: m.HistoryEntries,
+		Emails:         m.Emails,
+		RequestedBy:    m.RequestedBy,
+		CreatedAt:      m.CreatedAt,
+		DecidedBy:      m.DecidedBy,
+		DecidedAt:      m.DecidedAt,
+		MergeID:        m.MergeID,
+		Error:          m.Error,
+	}, nil
+}
+
+type mergeApprovalRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMergeApprovalRepo creates a new merge approval repository.
+func NewMergeApprovalRepo(data *Data, logger log.Logger) biz.MergeApprovalRepo {
+	return &mergeApprovalRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create stores a new pending approval.
+func (r *mergeApprovalRepo) Create(ctx context.Context, approval *biz.MergeApproval) (*biz.MergeApproval, error) {
+	reasons := approval.Reasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+	encoded, err := json.Marshal(reasons)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &MergeApprovalModel{
+		ID:             approval.ID,
+		TenantID:       approval.TenantID,
+		PrimaryID:      approval.PrimaryID,
+		SecondaryID:    approval.SecondaryID,
+		Status:         approval.Status,
+		Reasons:        encoded,
+		HistoryEntries: approval.HistoryEntries,
+		Emails:         approval.Emails,
+		RequestedBy:    approval.RequestedBy,
+		CreatedAt:      approval.CreatedAt,
+	}
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+
+	return model.ToEntity()
+}
+
+// Get returns an approval of the tenant.
+func (r *mergeApprovalRepo) Get(ctx context.Context, tenantID string, id uuid.UUID) (*biz.MergeApproval, error) {
+	var model MergeApprovalModel
+	err := r.data.DB(ctx).Where("id = ? AND tenant_id = ?", id, tenantID).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrMergeApprovalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity()
+}
+
+// List returns a page of the tenant's approvals, oldest first.
+func (r *mergeApprovalRepo) List(ctx context.Context, tenantID string, filter *biz.MergeApprovalFilter) ([]*biz.MergeApproval, int64, error) {
+	query := r.data.DB(ctx).Model(&MergeApprovalModel{}).
+		Where("tenant_id = ? AND status = ?", tenantID, filter.Status)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []MergeApprovalModel
+	if err := query.
+		Order("created_at, id").
+		Offset(int((filter.Page - 1) * filter.PageSize)).
+		Limit(int(filter.PageSize)).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	approvals := make([]*biz.MergeApproval, 0, len(models))
+	for i := range models {
+		approval, err := models[i].ToEntity()
+		if err != nil {
+			return nil, 0, err
+		}
+		approvals = append(approvals, approval)
+	}
+	return approvals, total, nil
+}
+
+// decideMergeApprovalQuery records the decision on an approval that is
+// still pending
+const decideMergeApprovalQuery = `
+UPDATE employee_merge_approvals SET status = ?, decided_by = ?, decided_at = ?, merge_id = ?, error = ?
+WHERE id = ? AND tenant_id = ? AND status = 'pending'
+RETURNING *`
+
+// Decide records the decision on a pending approval.
+func (r *mergeApprovalRepo) Decide(ctx context.Context, approval *biz.MergeApproval) (*biz.MergeApproval, error) {
+	var models []MergeApprovalModel
+	if err := r.data.DB(ctx).
+		Raw(decideMergeApprovalQuery, approval.Status, approval.DecidedBy, approval.DecidedAt, approval.MergeID, approval.Error, approval.ID, approval.TenantID).
+		Scan(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, biz.ErrMergeApprovalNotPending
+	}
+	return models[0].ToEntity()
+}
@@ -0,0 +1,40 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeApprovalRepo_Decide(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &mergeApprovalRepo{data: d}
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	id, mergeID := uuid.New(), uuid.New()
+	approval := &biz.MergeApproval{ID: id, TenantID: "tenant-1", Status: biz.MergeApprovalApproved, DecidedBy: "user-2", DecidedAt: &now, MergeID: &mergeID}
+
+	mock.ExpectQuery(`UPDATE employee_merge_approvals SET status = \$1, .* WHERE id = \$6 AND tenant_id = \$7 AND status = 'pending'`).
+		WithArgs("approved", "user-2", &now, &mergeID, "", id, "tenant-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "status", "reasons", "decided_by", "merge_id"}).
+			AddRow(id, "tenant-1", "approved", []byte(`["history_entries"]`), "user-2", mergeID))
+
+	decided, err := repo.Decide(context.Background(), approval)
+	require.NoError(t, err)
+	assert.Equal(t, biz.MergeApprovalApproved, decided.Status)
+	assert.Equal(t, []string{"history_entries"}, decided.Reasons)
+	assert.Equal(t, &mergeID, decided.MergeID)
+
+	mock.ExpectQuery(`UPDATE employee_merge_approvals SET status`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err = repo.Decide(context.Background(), approval)
+	assert.Equal(t, biz.ErrMergeApprovalNotPending, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,283 @@
+package data
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/observability"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	// defaultNATSCluster names the cluster of data.nats.url
+	defaultNATSCluster = "default"
+	// defaultReconnectWait is the delay between reconnects to the same server
+	defaultReconnectWait = 2 * time.Second
+)
+
+// natsCluster is a configured cluster with the TLS material its servers are
+// verified and authenticated with
+type natsCluster struct {
+	name string
+	urls []string
+	// cert is the client certificate; nil when the cluster has none
+	cert *tls.Certificate
+	// roots verify the servers; nil uses the system roots
+	roots *x509.CertPool
+}
+
+// natsClusters returns the configured clusters in the order they are tried:
+// the preferred cluster first, then the others as listed. Without clusters
+// data.nats.url is used as a single cluster.
+func natsClusters(c *conf.Data_Nats) ([]*natsCluster, error) {
+	if len(c.GetClusters()) == 0 {
+		if c.GetUrl() == "" {
+			return nil, nil
+		}
+		return []*natsCluster{{name: defaultNATSCluster, urls: strings.Split(c.GetUrl(), ",")}}, nil
+	}
+
+	clusters := make([]*natsCluster, 0, len(c.GetClusters()))
+	preferred := -1
+	for _, cc := range c.GetClusters() {
+		if cc.GetName() == "" {
+			return nil, fmt.Errorf("nats cluster without a name")
+		}
+		if len(cc.GetUrls()) == 0 {
+			return nil, fmt.Errorf("nats cluster %s has no urls", cc.GetName())
+		}
+
+		cluster := &natsCluster{name: cc.GetName(), urls: cc.GetUrls()}
+		if err := cluster.loadTLS(cc.GetTls()); err != nil {
+			return nil, fmt.Errorf("nats cluster %s: %w", cc.GetName(), err)
+		}
+		if cc.GetName() == c.GetPreferredCluster() {
+			preferred = len(clusters)
+		}
+		clusters = append(clusters, cluster)
+	}
+
+	if c.GetPreferredCluster() != "" {
+		if preferred < 0 {
+			return nil, fmt.Errorf("preferred nats cluster %s is not configured", c.GetPreferredCluster())
+		}
+		first := clusters[preferred]
+		copy(clusters[1:preferred+1], clusters[:preferred])
+		clusters[0] = first
+	}
+	return clusters, nil
+}
+
+// loadTLS reads the CA bundle and client certificate of the cluster
+func (c *natsCluster) loadTLS(t *conf.Data_Nats_TLS) error {
+	if t.GetCaFile() != "" {
+		pem, err := os.ReadFile(t.GetCaFile())
+		if err != nil {
+			return err
+		}
+		c.roots = x509.NewCertPool()
+		if !c.roots.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in %s", t.GetCaFile())
+		}
+	}
+
+	if t.GetCertFile() != "" || t.GetKeyFile() != "" {
+		cert, err := tls.LoadX509KeyPair(t.GetCertFile(), t.GetKeyFile())
+		if err != nil {
+			return err
+		}
+		c.cert = &cert
+	}
+	return nil
+}
+
+// hasTLS reports whether the cluster has TLS material of its own
+func (c *natsCluster) hasTLS() bool {
+	return c.cert != nil || c.roots != nil
+}
+
+// natsDialer dials NATS servers and remembers the cluster of the server
+// connected to, so that the TLS handshake that follows uses the material of
+// that cluster. Servers discovered through gossip are not configured; they
+// are attributed to the cluster of the last configured server dialled, which
+// announced them.
+type natsDialer struct {
+	dialer net.Dialer
+	// byHost maps the host:port of every configured server to its cluster
+	byHost map[string]*natsCluster
+
+	mu      sync.Mutex
+	current *natsCluster
+}
+
+// newNATSDialer creates a dialer for the given clusters
+func newNATSDialer(clusters []*natsCluster) *natsDialer {
+	d := &natsDialer{
+		dialer: net.Dialer{Timeout: nats.DefaultTimeout},
+		byHost: make(map[string]*natsCluster),
+	}
+	for _, c := range clusters {
+		for _, raw := range c.urls {
+			if host := natsHost(raw); host != "" {
+				d.byHost[host] = c
+			}
+		}
+	}
+	if len(clusters) > 0 {
+		d.current = clusters[0]
+	}
+	return d
+}
+
+// natsHost returns the host:port of a server URL, defaulting the scheme and
+// port the way the NATS client does
+func natsHost(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if !strings.Contains(raw, "://") {
+		raw = "nats://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	if u.Port() == "" {
+		return net.JoinHostPort(u.Hostname(), "4222")
+	}
+	return u.Host
+}
+
+// Dial implements nats.CustomDialer.
+func (d *natsDialer) Dial(network, address string) (net.Conn, error) {
+	conn, err := d.dialer.Dial(network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if c, ok := d.byHost[address]; ok {
+		d.mu.Lock()
+		d.current = c
+		d.mu.Unlock()
+	}
+	return conn, nil
+}
+
+// cluster returns the cluster of the server last connected to
+func (d *natsDialer) cluster() *natsCluster {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.current
+}
+
+// clusterName returns the name of the cluster last connected to
+func (d *natsDialer) clusterName() string {
+	if c := d.cluster(); c != nil {
+		return c.name
+	}
+	return ""
+}
+
+// clientCert implements nats.TLSCertHandler. An empty certificate sends none.
+func (d *natsDialer) clientCert() (tls.Certificate, error) {
+	if c := d.cluster(); c != nil && c.cert != nil {
+		return *c.cert, nil
+	}
+	return tls.Certificate{}, nil
+}
+
+// rootCAs implements nats.RootCAsHandler. A nil pool uses the system roots.
+func (d *natsDialer) rootCAs() (*x509.CertPool, error) {
+	if c := d.cluster(); c != nil {
+		return c.roots, nil
+	}
+	return nil, nil
+}
+
+// natsConnection is the server list and options of the NATS connection
+type natsConnection struct {
+	servers string
+	options []nats.Option
+}
+
+// newNATSConnection builds the NATS connection settings from config,
+// returning nil when NATS is not configured.
+func newNATSConnection(c *conf.Data_Nats, obs *observability.Observability, logger log.Logger) (*natsConnection, error) {
+	clusters, err := natsClusters(c)
+	if err != nil || len(clusters) == 0 {
+		return nil, err
+	}
+	logHelper := log.NewHelper(logger)
+
+	var servers []string
+	secure := false
+	for _, cluster := range clusters {
+		servers = append(servers, cluster.urls...)
+		secure = secure || cluster.hasTLS()
+	}
+
+	reconnectWait := defaultReconnectWait
+	if c.GetReconnectWait() != nil {
+		reconnectWait = c.GetReconnectWait().AsDuration()
+	}
+	maxReconnects := -1 // Infinite reconnects
+	if c.GetMaxReconnects() > 0 {
+		maxReconnects = int(c.GetMaxReconnects())
+	}
+
+	dialer := newNATSDialer(clusters)
+	options := []nats.Option{
+		nats.MaxReconnects(maxReconnects),
+		nats.ReconnectWait(reconnectWait),
+		// Keep the configured order so that the preferred cluster is tried first
+		nats.DontRandomize(),
+		// Dial configured host names so that the dialer can tell the clusters apart
+		nats.SkipHostLookup(),
+		nats.SetCustomDialer(dialer),
+		nats.ConnectHandler(func(nc *nats.Conn) {
+			obs.RecordNATSConnection(dialer.clusterName(), observability.NATSConnected)
+		}),
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			logHelper.Warnf("NATS disconnected from cluster %s: %v", dialer.clusterName(), err)
+			obs.RecordNATSConnection(dialer.clusterName(), observability.NATSDisconnected)
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			logHelper.Infof("NATS reconnected to %s (cluster %s)", nc.ConnectedUrl(), dialer.clusterName())
+			obs.RecordNATSConnection(dialer.clusterName(), observability.NATSReconnected)
+		}),
+		nats.ClosedHandler(func(nc *nats.Conn) {
+			obs.RecordNATSConnection(dialer.clusterName(), observability.NATSClosed)
+		}),
+	}
+
+	if c.GetReconnectJitter() != nil || c.GetReconnectJitterTls() != nil {
+		jitter, jitterTLS := nats.DefaultReconnectJitter, nats.DefaultReconnectJitterTLS
+		if c.GetReconnectJitter() != nil {
+			jitter = c.GetReconnectJitter().AsDuration()
+		}
+		if c.GetReconnectJitterTls() != nil {
+			jitterTLS = c.GetReconnectJitterTls().AsDuration()
+		}
+		options = append(options, nats.ReconnectJitter(jitter, jitterTLS))
+	}
+
+	if secure {
+		// Pick the CA and client certificate of the cluster being connected
+		// to on every handshake
+		options = append(options, func(o *nats.Options) error {
+			o.TLSCertCB = dialer.clientCert
+			o.RootCAsCB = dialer.rootCAs
+			return nil
+		})
+	}
+
+	return &natsConnection{servers: strings.Join(servers, ","), options: options}, nil
+}
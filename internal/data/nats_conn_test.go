@@ -0,0 +1,146 @@
+package data
+
+import (
+	"io"
+	"net"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func clusterNames(clusters []*natsCluster) []string {
+	names := make([]string, len(clusters))
+	for i, c := range clusters {
+		names[i] = c.name
+	}
+	return names
+}
+
+func TestNATSClusters_PreferredFirst(t *testing.T) {
+	clusters, err := natsClusters(&conf.Data_Nats{
+		Url: "nats://ignored:4222",
+		Clusters: []*conf.Data_Nats_Cluster{
+			{Name: "eu", Urls: []string{"nats://eu-1:4222"}},
+			{Name: "us", Urls: []string{"nats://us-1:4222"}},
+			{Name: "ap", Urls: []string{"nats://ap-1:4222", "nats://ap-2:4222"}},
+		},
+		PreferredCluster: "ap",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ap", "eu", "us"}, clusterNames(clusters))
+	assert.Equal(t, []string{"nats://ap-1:4222", "nats://ap-2:4222"}, clusters[0].urls)
+}
+
+func TestNATSClusters_URL(t *testing.T) {
+	clusters, err := natsClusters(&conf.Data_Nats{Url: "nats://a:4222,nats://b:4222"})
+
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+	assert.Equal(t, defaultNATSCluster, clusters[0].name)
+	assert.Equal(t, []string{"nats://a:4222", "nats://b:4222"}, clusters[0].urls)
+
+	clusters, err = natsClusters(&conf.Data_Nats{})
+	assert.NoError(t, err)
+	assert.Empty(t, clusters)
+}
+
+func TestNATSClusters_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		c    *conf.Data_Nats
+		want string
+	}{
+		{
+			name: "unnamed cluster",
+			c:    &conf.Data_Nats{Clusters: []*conf.Data_Nats_Cluster{{Urls: []string{"nats://a:4222"}}}},
+			want: "without a name",
+		},
+		{
+			name: "no urls",
+			c:    &conf.Data_Nats{Clusters: []*conf.Data_Nats_Cluster{{Name: "eu"}}},
+			want: "has no urls",
+		},
+		{
+			name: "unknown preferred cluster",
+			c: &conf.Data_Nats{
+				Clusters:         []*conf.Data_Nats_Cluster{{Name: "eu", Urls: []string{"nats://a:4222"}}},
+				PreferredCluster: "us",
+			},
+			want: "preferred nats cluster us is not configured",
+		},
+		{
+			name: "missing CA file",
+			c: &conf.Data_Nats{Clusters: []*conf.Data_Nats_Cluster{{
+				Name: "eu",
+				Urls: []string{"tls://a:4222"},
+				Tls:  &conf.Data_Nats_TLS{CaFile: "/nonexistent/ca.pem"},
+			}}},
+			want: "nats cluster eu",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := natsClusters(tt.c)
+			assert.ErrorContains(t, err, tt.want)
+		})
+	}
+}
+
+func TestNATSHost(t *testing.T) {
+	assert.Equal(t, "eu-1:4222", natsHost("nats://eu-1:4222"))
+	assert.Equal(t, "eu-1:4222", natsHost("tls://eu-1"))
+	assert.Equal(t, "eu-1:5222", natsHost(" eu-1:5222"))
+}
+
+func TestNATSDialer_TracksCluster(t *testing.T) {
+	eu, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer eu.Close()
+	us, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer us.Close()
+
+	clusters := []*natsCluster{
+		{name: "eu", urls: []string{"nats://" + eu.Addr().String()}},
+		{name: "us", urls: []string{"nats://" + us.Addr().String()}},
+	}
+	d := newNATSDialer(clusters)
+	assert.Equal(t, "eu", d.clusterName())
+
+	conn, err := d.Dial("tcp", us.Addr().String())
+	require.NoError(t, err)
+	conn.Close()
+	assert.Equal(t, "us", d.clusterName())
+
+	// Failed dials keep the cluster connected to last
+	_, err = d.Dial("tcp", "127.0.0.1:1")
+	assert.Error(t, err)
+	assert.Equal(t, "us", d.clusterName())
+
+	cert, err := d.clientCert()
+	assert.NoError(t, err)
+	assert.Empty(t, cert.Certificate, "clusters without a client certificate send none")
+}
+
+func TestNewNATSConnection(t *testing.T) {
+	nc, err := newNATSConnection(&conf.Data_Nats{
+		Clusters: []*conf.Data_Nats_Cluster{
+			{Name: "eu", Urls: []string{"nats://eu-1:4222", "nats://eu-2:4222"}},
+			{Name: "us", Urls: []string{"nats://us-1:4222"}},
+		},
+		PreferredCluster: "us",
+	}, nil, log.NewStdLogger(io.Discard))
+
+	require.NoError(t, err)
+	assert.Equal(t, "nats://us-1:4222,nats://eu-1:4222,nats://eu-2:4222", nc.servers)
+
+	nc, err = newNATSConnection(nil, nil, log.NewStdLogger(io.Discard))
+	assert.NoError(t, err)
+	assert.Nil(t, nc, "NATS is optional")
+}
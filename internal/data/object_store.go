@@ -0,0 +1,101 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// ObjectStore reads and writes objects in durable storage
+type ObjectStore interface {
+	Put(ctx context.Context, key string, body []byte, contentType, contentEncoding string) error
+	// Get reads an object, failing when it is larger than maxSize bytes
+	Get(ctx context.Context, key string, maxSize int64) ([]byte, error)
+}
+
+// s3ObjectStore implements ObjectStore on an S3-compatible bucket
+type s3ObjectStore struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+// NewS3ObjectStore creates an object store for the configured bucket. Without
+// an access key, credentials are read from the standard AWS_* environment variables.
+func NewS3ObjectStore(c *conf.Data_ObjectStore) (ObjectStore, error) {
+	return newS3ObjectStore(c)
+}
+
+func newS3ObjectStore(c *conf.Data_ObjectStore) (*s3ObjectStore, error) {
+	if c.GetEndpoint() == "" || c.GetBucket() == "" {
+		return nil, fmt.Errorf("object store endpoint and bucket are required")
+	}
+
+	creds := credentials.NewEnvAWS()
+	if c.AccessKey != "" {
+		creds = credentials.NewStaticV4(c.AccessKey, c.SecretKey, "")
+	}
+
+	client, err := minio.New(c.Endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: c.UseSsl,
+		Region: c.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3ObjectStore{client: client, bucket: c.Bucket, prefix: c.Prefix}, nil
+}
+
+// Put uploads body under the configured prefix, replacing any existing object
+func (s *s3ObjectStore) Put(ctx context.Context, key string, body []byte, contentType, contentEncoding string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, path.Join(s.prefix, key), bytes.NewReader(body), int64(len(body)), minio.PutObjectOptions{
+		ContentType:     contentType,
+		ContentEncoding: contentEncoding,
+	})
+	return err
+}
+
+// Get downloads the object stored under key below the configured prefix
+func (s *s3ObjectStore) Get(ctx context.Context, key string, maxSize int64) ([]byte, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, path.Join(s.prefix, key), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	body, err := io.ReadAll(io.LimitReader(obj, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > maxSize {
+		return nil, fmt.Errorf("object %s is larger than %d bytes", key, maxSize)
+	}
+	return body, nil
+}
+
+// Delete removes the object stored under key below the configured prefix
+func (s *s3ObjectStore) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, path.Join(s.prefix, key), minio.RemoveObjectOptions{})
+}
+
+// PresignedGetURL returns a URL the object stored under key below the
+// configured prefix can be downloaded from without credentials until expiry
+// has passed
+func (s *s3ObjectStore) PresignedGetURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	u, err := s.client.PresignedGetObject(ctx, s.bucket, path.Join(s.prefix, key), expiry, url.Values{})
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
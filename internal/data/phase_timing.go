@@ -0,0 +1,54 @@
+package data
+
+import (
+	"github.com/cvele/employee-service/internal/observability"
+
+	"gorm.io/gorm"
+)
+
+// phaseStopKey stores the function ending the repo phase of a statement
+const phaseStopKey = "phase_timing:stop"
+
+// phaseTimingPlugin attributes the time spent executing statements to the
+// repo phase of the request that issued them.
+type phaseTimingPlugin struct{}
+
+// Name returns the plugin name
+func (phaseTimingPlugin) Name() string {
+	return "phase_timing"
+}
+
+// Initialize registers the timing callbacks around every statement type
+func (phaseTimingPlugin) Initialize(db *gorm.DB) error {
+	before := func(db *gorm.DB) {
+		db.InstanceSet(phaseStopKey, observability.StartPhase(db.Statement.Context, observability.PhaseRepo))
+	}
+	after := func(db *gorm.DB) {
+		if stop, ok := db.InstanceGet(phaseStopKey); ok {
+			stop.(func())()
+		}
+	}
+
+	cb := db.Callback()
+	registrations := []func() error{
+		func() error { return cb.Create().Before("*").Register("phase_timing:before_create", before) },
+		func() error { return cb.Create().After("*").Register("phase_timing:after_create", after) },
+		func() error { return cb.Query().Before("*").Register("phase_timing:before_query", before) },
+		func() error { return cb.Query().After("*").Register("phase_timing:after_query", after) },
+		func() error { return cb.Update().Before("*").Register("phase_timing:before_update", before) },
+		func() error { return cb.Update().After("*").Register("phase_timing:after_update", after) },
+		func() error { return cb.Delete().Before("*").Register("phase_timing:before_delete", before) },
+		func() error { return cb.Delete().After("*").Register("phase_timing:after_delete", after) },
+		func() error { return cb.Row().Before("*").Register("phase_timing:before_row", before) },
+		func() error { return cb.Row().After("*").Register("phase_timing:after_row", after) },
+		func() error { return cb.Raw().Before("*").Register("phase_timing:before_raw", before) },
+		func() error { return cb.Raw().After("*").Register("phase_timing:after_raw", after) },
+	}
+	for _, register := range registrations {
+		if err := register(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
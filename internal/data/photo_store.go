@@ -0,0 +1,60 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+)
+
+const defaultPhotoURLTTL = 15 * time.Minute
+
+// photoStore implements biz.PhotoStore on the configured photo bucket
+type photoStore struct {
+	store *s3ObjectStore
+	ttl   time.Duration
+	clock biz.Clock
+}
+
+// NewPhotoStore creates the photo store, or returns nil when no photo bucket
+// is configured
+func NewPhotoStore(c *conf.Data, clock biz.Clock) (biz.PhotoStore, error) {
+	pc := c.GetPhotos()
+	if pc.GetStore().GetEndpoint() == "" || pc.GetStore().GetBucket() == "" {
+		return nil, nil
+	}
+
+	store, err := newS3ObjectStore(pc.GetStore())
+	if err != nil {
+		return nil, fmt.Errorf("photo store: %w", err)
+	}
+
+	ttl := pc.GetUrlTtl().AsDuration()
+	if ttl <= 0 {
+		ttl = defaultPhotoURLTTL
+	}
+	return &photoStore{store: store, ttl: ttl, clock: clock}, nil
+}
+
+// Put uploads a photo, replacing any photo stored under key
+func (s *photoStore) Put(ctx context.Context, key string, photo []byte, contentType string) error {
+	return s.store.Put(ctx, key, photo, contentType, "")
+}
+
+// Delete removes the photo stored under key
+func (s *photoStore) Delete(ctx context.Context, key string) error {
+	return s.store.Delete(ctx, key)
+}
+
+// URL pre-signs a download URL of the photo stored under key, valid for the
+// configured TTL
+func (s *photoStore) URL(ctx context.Context, key string) (string, time.Time, error) {
+	expiresAt := s.clock.Now().Add(s.ttl)
+	u, err := s.store.PresignedGetURL(ctx, key, s.ttl)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return u, expiresAt, nil
+}
@@ -0,0 +1,48 @@
+package data
+
+import (
+	"context"
+
+	eventsv1 "github.com/cvele/employee-service/api/events/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SubjectQuotaThreshold is the subject crossed quota thresholds are
+// published on
+const SubjectQuotaThreshold = "tenant.v1.quota_threshold"
+
+// NewQuotaEventPublisher returns the publisher of quota threshold events, nil
+// without NATS
+func NewQuotaEventPublisher(data *Data) biz.QuotaEventPublisher {
+	if data.nc == nil {
+		return nil
+	}
+	return data
+}
+
+// PublishQuotaThreshold publishes the crossing of threshold by a tenant quota
+// on SubjectQuotaThreshold
+func (d *Data) PublishQuotaThreshold(ctx context.Context, usage *biz.QuotaUsage, threshold int32) error {
+	data, err := proto.Marshal(&eventsv1.QuotaThresholdEvent{
+		EventId:   d.newID().String(),
+		Timestamp: timestamppb.New(d.now()),
+		TenantId:  usage.TenantID,
+		Resource:  usage.Resource,
+		Threshold: threshold,
+		Used:      usage.Used,
+		Limit:     usage.Limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	msg := &nats.Msg{Subject: SubjectQuotaThreshold, Data: data}
+	if d.signer != nil {
+		d.signer.Sign(msg)
+	}
+	return d.nc.PublishMsg(msg)
+}
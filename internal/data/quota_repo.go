@@ -0,0 +1,64 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+// QuotaThresholdModel is the GORM model for the threshold a tenant quota
+// reached
+type QuotaThresholdModel struct {
+	TenantID  string    `gorm:"type:varchar(255);primaryKey"`
+	Resource  string    `gorm:"type:varchar(64);primaryKey"`
+	Threshold int32     `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (QuotaThresholdModel) TableName() string {
+	return "employee_quota_thresholds"
+}
+
+type quotaRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewQuotaRepo creates a new quota threshold repository.
+func NewQuotaRepo(data *Data, logger log.Logger) biz.QuotaRepo {
+	return &quotaRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// RecordThreshold raises the stored threshold to threshold in a single
+// upsert, so that of concurrent callers only one sees it crossed, or lowers
+// it after usage fell.
+func (r *quotaRepo) RecordThreshold(ctx context.Context, tenantID, resource string, threshold int32) (bool, error) {
+	db := r.data.DB(ctx)
+	if threshold > 0 {
+		result := db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "resource"}},
+			DoUpdates: clause.AssignmentColumns([]string{"threshold", "updated_at"}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Expr{SQL: "employee_quota_thresholds.threshold < EXCLUDED.threshold"},
+			}},
+		}).Create(&QuotaThresholdModel{TenantID: tenantID, Resource: resource, Threshold: threshold, UpdatedAt: r.data.now()})
+		if result.Error != nil {
+			return false, result.Error
+		}
+		if result.RowsAffected > 0 {
+			return true, nil
+		}
+	}
+
+	return false, db.Model(&QuotaThresholdModel{}).
+		Where("tenant_id = ? AND resource = ? AND threshold > ?", tenantID, resource, threshold).
+		Updates(map[string]interface{}{"threshold": threshold, "updated_at": r.data.now()}).Error
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaRepo_RecordThreshold(t *testing.T) {
+	upsert := `INSERT INTO "employee_quota_thresholds" .* ON CONFLICT \("tenant_id","resource"\) DO UPDATE SET "threshold"="excluded"."threshold","updated_at"="excluded"."updated_at" WHERE employee_quota_thresholds.threshold < EXCLUDED.threshold`
+	lower := `UPDATE "employee_quota_thresholds" SET "threshold"=\$1,"updated_at"=\$2 WHERE tenant_id = \$3 AND resource = \$4 AND threshold > \$5`
+
+	t.Run("raised", func(t *testing.T) {
+		d, mock := newMockData(t)
+		repo := &quotaRepo{data: d}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(upsert).
+			WithArgs("tenant-1", "employees", int32(90), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		crossed, err := repo.RecordThreshold(context.Background(), "tenant-1", "employees", 90)
+
+		require.NoError(t, err)
+		assert.True(t, crossed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already reached", func(t *testing.T) {
+		d, mock := newMockData(t)
+		repo := &quotaRepo{data: d}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(upsert).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec(lower).
+			WithArgs(int32(80), sqlmock.AnyArg(), "tenant-1", "employees", int32(80)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		crossed, err := repo.RecordThreshold(context.Background(), "tenant-1", "employees", 80)
+
+		require.NoError(t, err)
+		assert.False(t, crossed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("below the thresholds", func(t *testing.T) {
+		d, mock := newMockData(t)
+		repo := &quotaRepo{data: d}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(lower).
+			WithArgs(int32(0), sqlmock.AnyArg(), "tenant-1", "employees", int32(0)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		crossed, err := repo.RecordThreshold(context.Background(), "tenant-1", "employees", 0)
+
+		require.NoError(t, err)
+		assert.False(t, crossed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,204 @@
+package data
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/observability"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultReadAuditQueueSize = 10000
+	defaultReadAuditBatchSize = 500
+)
+
+// ReadAuditModel is the GORM model for audited employee reads
+type ReadAuditModel struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TenantID   string    `gorm:"type:varchar(255);not null"`
+	EmployeeID uuid.UUID `gorm:"type:uuid;not null"`
+	Operation  string    `gorm:"type:varchar(32);not null"`
+	ActorID    string    `gorm:"type:varchar(255);not null;default:''"`
+	RequestID  string    `gorm:"type:varchar(255);not null;default:''"`
+	ClientIP   string    `gorm:"type:varchar(64);not null;default:''"`
+	CreatedAt  time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (ReadAuditModel) TableName() string {
+	return "employee_read_audit"
+}
+
+// ReadAuditWriter queues audited employee reads in memory and writes them in
+// batches, so that reads never wait on the audit table.
+//
+// The queue is a fixed ring: recording a read does not allocate, and when the
+// queue is full the oldest queued read is dropped to make room for the new
+// one. Dropped and failed reads are counted in the read audit metric.
+type ReadAuditWriter struct {
+	data       *Data
+	obs        *observability.Observability
+	sampleRate float64
+	batchSize  int
+	log        *log.Helper
+
+	mu    sync.Mutex
+	queue []biz.ReadAuditEntry
+	head  int // index of the oldest queued read
+	n     int // number of queued reads
+	// ready is signalled when a full batch is queued
+	ready chan struct{}
+}
+
+// NewReadAuditWriter creates the writer configured in c, or returns nil when
+// reads are not audited
+func NewReadAuditWriter(c *conf.Data, d *Data, obs *observability.Observability, logger log.Logger) *ReadAuditWriter {
+	rc := c.GetReadAudit()
+	if !rc.GetEnabled() {
+		return nil
+	}
+
+	queueSize := int(rc.GetQueueSize())
+	if queueSize <= 0 {
+		queueSize = defaultReadAuditQueueSize
+	}
+	batchSize := int(rc.GetBatchSize())
+	if batchSize <= 0 {
+		batchSize = defaultReadAuditBatchSize
+	}
+	sampleRate := rc.GetSampleRate()
+	if sampleRate <= 0 || sampleRate > 1 {
+		sampleRate = 1
+	}
+
+	return &ReadAuditWriter{
+		data:       d,
+		obs:        obs,
+		sampleRate: sampleRate,
+		batchSize:  min(batchSize, queueSize),
+		log:        log.NewHelper(logger),
+		queue:      make([]biz.ReadAuditEntry, queueSize),
+		ready:      make(chan struct{}, 1),
+	}
+}
+
+// NewReadAuditor returns w as the biz.ReadAuditor of the employee usecase,
+// nil when reads are not audited
+func NewReadAuditor(w *ReadAuditWriter) biz.ReadAuditor {
+	if w == nil {
+		return nil
+	}
+	return w
+}
+
+// RecordRead implements biz.ReadAuditor. It queues the read without blocking
+// on the database, dropping the oldest queued read when the queue is full.
+func (w *ReadAuditWriter) RecordRead(ctx context.Context, operation, tenantID string, employeeID uuid.UUID) {
+	if w == nil {
+		return
+	}
+	if w.sampleRate < 1 && rand.Float64() >= w.sampleRate {
+		return
+	}
+
+	actorID, _ := biz.GetUserID(ctx)
+	md := biz.GetRequestMetadata(ctx)
+	entry := biz.ReadAuditEntry{
+		TenantID:   tenantID,
+		EmployeeID: employeeID,
+		Operation:  operation,
+		ActorID:    actorID,
+		RequestID:  md.RequestID,
+		ClientIP:   md.ClientIP,
+		CreatedAt:  w.data.now(),
+	}
+
+	w.mu.Lock()
+	dropped := w.n == len(w.queue)
+	if dropped {
+		w.queue[w.head] = entry
+		w.head = (w.head + 1) % len(w.queue)
+	} else {
+		w.queue[(w.head+w.n)%len(w.queue)] = entry
+		w.n++
+	}
+	batchReady := w.n >= w.batchSize
+	w.mu.Unlock()
+
+	if dropped {
+		w.obs.RecordReadAudit(observability.ReadAuditDropped, 1)
+	}
+	if batchReady {
+		select {
+		case w.ready <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Ready is signalled when a full batch of reads is queued, so that it can be
+// written before the next flush interval
+func (w *ReadAuditWriter) Ready() <-chan struct{} {
+	return w.ready
+}
+
+// Queued returns the number of reads waiting to be written
+func (w *ReadAuditWriter) Queued() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.n
+}
+
+// take removes up to a batch of the oldest queued reads from the queue
+func (w *ReadAuditWriter) take() []ReadAuditModel {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	models := make([]ReadAuditModel, min(w.n, w.batchSize))
+	for i := range models {
+		e := &w.queue[w.head]
+		models[i] = ReadAuditModel{
+			TenantID:   e.TenantID,
+			EmployeeID: e.EmployeeID,
+			Operation:  e.Operation,
+			ActorID:    e.ActorID,
+			RequestID:  e.RequestID,
+			ClientIP:   e.ClientIP,
+			CreatedAt:  e.CreatedAt,
+		}
+		*e = biz.ReadAuditEntry{}
+		w.head = (w.head + 1) % len(w.queue)
+		w.n--
+	}
+	return models
+}
+
+// Flush writes the queued reads, a batch per statement, and returns the
+// number written. A batch that fails to be written is dropped rather than
+// retried, so that a database outage does not hold the queue back.
+func (w *ReadAuditWriter) Flush(ctx context.Context) (int, error) {
+	written := 0
+	for {
+		models := w.take()
+		if len(models) == 0 {
+			return written, nil
+		}
+		for i := range models {
+			models[i].ID = w.data.newID()
+		}
+
+		if err := w.data.DB(ctx).Create(&models).Error; err != nil {
+			w.obs.RecordReadAudit(observability.ReadAuditFailed, len(models))
+			return written, err
+		}
+		w.obs.RecordReadAudit(observability.ReadAuditWritten, len(models))
+		written += len(models)
+	}
+}
@@ -0,0 +1,92 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReadAuditWriter(d *Data, queueSize, batchSize int32) *ReadAuditWriter {
+	return NewReadAuditWriter(&conf.Data{ReadAudit: &conf.Data_ReadAudit{Enabled: true, QueueSize: queueSize, BatchSize: batchSize}}, d, nil, log.NewStdLogger(io.Discard))
+}
+
+func TestNewReadAuditWriter_Disabled(t *testing.T) {
+	w := NewReadAuditWriter(&conf.Data{}, &Data{}, nil, log.NewStdLogger(io.Discard))
+
+	assert.Nil(t, w)
+	assert.Nil(t, NewReadAuditor(w))
+}
+
+func TestReadAuditWriter_RecordReadDoesNotAllocate(t *testing.T) {
+	w := newTestReadAuditWriter(&Data{clock: biz.ClockFunc(time.Now)}, 16, 8)
+	ctx := biz.WithRequestMetadata(biz.WithUserID(context.Background(), "user-1"), biz.RequestMetadata{RequestID: "req-1"})
+	id := uuid.New()
+
+	allocs := testing.AllocsPerRun(100, func() {
+		w.RecordRead(ctx, biz.ReadOperationGet, "tenant-1", id)
+	})
+
+	assert.Zero(t, allocs)
+}
+
+func TestReadAuditWriter_DropsOldest(t *testing.T) {
+	w := newTestReadAuditWriter(&Data{clock: biz.ClockFunc(time.Now)}, 3, 2)
+	ctx := context.Background()
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+
+	for _, id := range ids {
+		w.RecordRead(ctx, biz.ReadOperationGet, "tenant-1", id)
+	}
+
+	require.Equal(t, 3, w.Queued())
+	first := w.take()
+	require.Len(t, first, 2)
+	assert.Equal(t, ids[2], first[0].EmployeeID)
+	assert.Equal(t, ids[3], first[1].EmployeeID)
+	second := w.take()
+	require.Len(t, second, 1)
+	assert.Equal(t, ids[4], second[0].EmployeeID)
+	select {
+	case <-w.Ready():
+	default:
+		t.Fatal("a full batch was queued without signalling Ready")
+	}
+}
+
+func TestReadAuditWriter_Flush(t *testing.T) {
+	d, mock := newMockData(t)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	d.clock = biz.ClockFunc(func() time.Time { return now })
+	w := newTestReadAuditWriter(d, 10, 2)
+	ctx := biz.WithRequestMetadata(biz.WithUserID(context.Background(), "user-1"), biz.RequestMetadata{RequestID: "req-1", ClientIP: "10.0.0.1"})
+	for range 3 {
+		w.RecordRead(ctx, biz.ReadOperationGetByEmail, "tenant-1", uuid.New())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "employee_read_audit" .*\(\$1,\$2,\$3,\$4,\$5,\$6,\$7,\$8\),\(\$9`).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "employee_read_audit"`).
+		WithArgs(sqlmock.AnyArg(), "tenant-1", sqlmock.AnyArg(), biz.ReadOperationGetByEmail, "user-1", "req-1", "10.0.0.1", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	written, err := w.Flush(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, written)
+	assert.Zero(t, w.Queued())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
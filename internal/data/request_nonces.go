@@ -0,0 +1,69 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// requestNonceKeyPrefix prefixes the Redis keys of request nonces
+const requestNonceKeyPrefix = "request-nonces:"
+
+// requestNonces remembers the nonces of signed requests in Redis when the
+// employee cache is configured, so that all replicas reject a replayed
+// request, or else in the memory of this replica
+type requestNonces struct {
+	data *Data
+	// rdb is nil without Redis
+	rdb *redis.Client
+
+	mu sync.Mutex
+	// seen maps the nonces remembered in memory to when they expire
+	seen map[string]time.Time
+	// nextSweep is when expired nonces are next dropped from seen
+	nextSweep time.Time
+}
+
+// NewRequestNonces creates the store of request nonces
+func NewRequestNonces(data *Data) biz.RequestNonces {
+	n := &requestNonces{data: data, seen: make(map[string]time.Time)}
+	if data.cache != nil {
+		n.rdb = data.cache.rdb
+	}
+	return n
+}
+
+func requestNonceKey(keyID uuid.UUID, nonce string) string {
+	return requestNonceKeyPrefix + keyID.String() + ":" + nonce
+}
+
+// Claim records the nonce unless already recorded and not yet expired.
+func (n *requestNonces) Claim(ctx context.Context, keyID uuid.UUID, nonce string, ttl time.Duration) (bool, error) {
+	key := requestNonceKey(keyID, nonce)
+	if n.rdb != nil {
+		return n.rdb.SetNX(ctx, key, 1, ttl).Result()
+	}
+
+	now := n.data.now()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !now.Before(n.nextSweep) {
+		for k, expiresAt := range n.seen {
+			if !now.Before(expiresAt) {
+				delete(n.seen, k)
+			}
+		}
+		n.nextSweep = now.Add(ttl)
+	}
+	if expiresAt, ok := n.seen[key]; ok && now.Before(expiresAt) {
+		return false, nil
+	}
+	n.seen[key] = now.Add(ttl)
+	return true, nil
+}
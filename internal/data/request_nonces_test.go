@@ -0,0 +1,58 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestNonces_Memory(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	nonces := NewRequestNonces(&Data{clock: biz.ClockFunc(func() time.Time { return now })})
+	ctx := context.Background()
+	keyID := uuid.New()
+
+	claimed, err := nonces.Claim(ctx, keyID, "n-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, _ = nonces.Claim(ctx, keyID, "n-1", time.Minute)
+	assert.False(t, claimed, "nonce reused")
+	claimed, _ = nonces.Claim(ctx, uuid.New(), "n-1", time.Minute)
+	assert.True(t, claimed, "nonces are per API key")
+
+	// Expired nonces may be used again and are dropped
+	now = now.Add(time.Minute)
+	claimed, _ = nonces.Claim(ctx, keyID, "n-1", time.Minute)
+	assert.True(t, claimed)
+	assert.Len(t, nonces.(*requestNonces).seen, 1)
+}
+
+func TestRequestNonces_Redis(t *testing.T) {
+	mr := miniredis.RunT(t)
+	cache := newEmployeeCache(&conf.Data_Redis{Addr: mr.Addr()})
+	defer cache.close()
+	nonces := NewRequestNonces(&Data{cache: cache})
+	ctx := context.Background()
+	keyID := uuid.New()
+
+	claimed, err := nonces.Claim(ctx, keyID, "n-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	claimed, err = nonces.Claim(ctx, keyID, "n-1", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	mr.FastForward(time.Minute)
+	claimed, err = nonces.Claim(ctx, keyID, "n-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+}
@@ -0,0 +1,56 @@
+package data
+
+import (
+	"context"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/secrets"
+)
+
+// runbookRepo runs the runbook actions on the connections of Data
+type runbookRepo struct {
+	data    *Data
+	secrets *secrets.Source
+}
+
+// NewRunbookRepo creates the runbook repository. Database pools are
+// reconnected with the source resolved by secrets.
+func NewRunbookRepo(data *Data, secrets *secrets.Source) biz.RunbookRepo {
+	return &runbookRepo{data: data, secrets: secrets}
+}
+
+// FlushCaches implements biz.RunbookRepo.
+func (r *runbookRepo) FlushCaches(ctx context.Context) (int64, error) {
+	if r.data.cache == nil {
+		return 0, biz.RunbookActionUnavailable("the employee cache is not configured")
+	}
+	return r.data.cache.flush(ctx)
+}
+
+// ReconnectNATS implements biz.RunbookRepo. The connection reconnects in the
+// background, publishing once connected again.
+func (r *runbookRepo) ReconnectNATS(ctx context.Context) (string, error) {
+	if r.data.nc == nil {
+		return "", biz.RunbookActionUnavailable("NATS is not connected")
+	}
+	previous := r.data.nc.ConnectedUrlRedacted()
+	if err := r.data.nc.ForceReconnect(); err != nil {
+		return "", err
+	}
+	return previous, nil
+}
+
+// RotateDatabasePool implements biz.RunbookRepo.
+func (r *runbookRepo) RotateDatabasePool(ctx context.Context) (int, error) {
+	if r.data.pool == nil {
+		return 0, biz.RunbookActionUnavailable("the database pool cannot be rotated")
+	}
+	var source string
+	if r.secrets != nil {
+		var err error
+		if source, err = r.secrets.DatabaseSource(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return r.data.pool.rotate(ctx, source)
+}
@@ -0,0 +1,90 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestRunbookRepo_FlushCaches(t *testing.T) {
+	mr := miniredis.RunT(t)
+	cache := newEmployeeCache(&conf.Data_Redis{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = cache.close() })
+	ctx := context.Background()
+
+	require.NoError(t, cache.set(ctx, "tenant-1", testEmployee("jane@example.com")))
+	require.NoError(t, cache.set(ctx, "tenant-2", testEmployee()))
+	require.NoError(t, mr.Set(requestNonceKeyPrefix+"nonce", "1"))
+
+	flushed, err := NewRunbookRepo(&Data{cache: cache}, nil).FlushCaches(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), flushed, "two ID keys and one email key")
+	assert.Equal(t, []string{requestNonceKeyPrefix + "nonce"}, mr.Keys(), "only employees are flushed")
+}
+
+func TestRunbookRepo_Unavailable(t *testing.T) {
+	repo := NewRunbookRepo(&Data{}, nil)
+	ctx := context.Background()
+
+	_, err := repo.FlushCaches(ctx)
+	assert.Equal(t, "RUNBOOK_ACTION_UNAVAILABLE", errors.Reason(err))
+	_, err = repo.ReconnectNATS(ctx)
+	assert.Equal(t, "RUNBOOK_ACTION_UNAVAILABLE", errors.Reason(err))
+	_, err = repo.RotateDatabasePool(ctx)
+	assert.Equal(t, "RUNBOOK_ACTION_UNAVAILABLE", errors.Reason(err))
+}
+
+func TestRunbookRepo_RotateDatabasePool(t *testing.T) {
+	first, firstMock, err := sqlmock.New()
+	require.NoError(t, err)
+	second, secondMock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = second.Close() })
+
+	var opened []string
+	pool := &dbPool{source: "host=db password=old", open: func(source string) (*sql.DB, error) {
+		opened = append(opened, source)
+		return second, nil
+	}}
+	pool.db.Store(first)
+	pool.maxIdleConns.Store(8)
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: pool}), &gorm.Config{})
+	require.NoError(t, err)
+	repo := NewRunbookRepo(&Data{db: db, pool: pool}, nil)
+	ctx := context.Background()
+
+	firstMock.ExpectQuery(`SELECT 1`).WillReturnRows(sqlmock.NewRows([]string{"n"}).AddRow(1))
+	var n int
+	require.NoError(t, db.Raw("SELECT 1").Scan(&n).Error)
+
+	firstMock.ExpectClose()
+	_, err = repo.RotateDatabasePool(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host=db password=old"}, opened, "reconnected with the current source")
+
+	// Statements and transactions use the new pool
+	secondMock.ExpectBegin()
+	secondMock.ExpectExec(`DELETE FROM employees`).WillReturnResult(sqlmock.NewResult(0, 1))
+	secondMock.ExpectCommit()
+	require.NoError(t, db.Transaction(func(tx *gorm.DB) error {
+		return tx.Exec("DELETE FROM employees").Error
+	}))
+	current, err := db.DB()
+	require.NoError(t, err)
+	assert.Same(t, second, current)
+
+	require.Eventually(t, func() bool { return firstMock.ExpectationsWereMet() == nil }, time.Second, 10*time.Millisecond, "the replaced pool is closed")
+	require.NoError(t, secondMock.ExpectationsWereMet())
+}
@@ -0,0 +1,274 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// ScheduledChangeModel is the GORM model for scheduled creates and updates
+type ScheduledChangeModel struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	TenantID    string     `gorm:"type:varchar(255);not null;index:idx_employee_scheduled_changes_tenant_effective_at,priority:1"`
+	Operation   string     `gorm:"type:varchar(16);not null"`
+	EmployeeID  uuid.UUID  `gorm:"type:uuid;not null"`
+	Emails      []byte     `gorm:"type:jsonb;not null"`
+	FirstName   string     `gorm:"type:varchar(100);not null"`
+	LastName    string     `gorm:"type:varchar(100);not null"`
+	Channel     string     `gorm:"type:varchar(64);not null"`
+	EffectiveAt time.Time  `gorm:"not null;index:idx_employee_scheduled_changes_tenant_effective_at,priority:3"`
+	Status      string     `gorm:"type:varchar(16);not null;index:idx_employee_scheduled_changes_tenant_effective_at,priority:2"`
+	Error       string     `gorm:"type:text;not null"`
+	CreatedBy   string     `gorm:"type:varchar(255);not null"`
+	LockedUntil *time.Time `gorm:""`
+	CreatedAt   time.Time  `gorm:"not null"`
+	AppliedAt   *time.Time `gorm:""`
+	// DepartmentID is NULL to keep the department and the nil UUID to
+	// remove it
+	DepartmentID *uuid.UUID `gorm:"type:uuid"`
+	// ManagerID follows DepartmentID
+	ManagerID *uuid.UUID `gorm:"type:uuid"`
+	Title     string     `gorm:"type:varchar(100);not null;default:''"`
+	// PhoneNumbers is NULL to keep the phone numbers
+	PhoneNumbers []byte `gorm:"type:jsonb"`
+	// Address is NULL to keep the address; an empty address removes it
+	Address []byte `gorm:"type:jsonb"`
+	// ExternalIDs is NULL to keep the external IDs; an empty ID removes the
+	// ID in its system
+	ExternalIDs []byte `gorm:"type:jsonb"`
+}
+
+// TableName overrides the table name
+func (ScheduledChangeModel) TableName() string {
+	return "employee_scheduled_changes"
+}
+
+// ToEntity converts ScheduledChangeModel to biz.ScheduledChange
+func (m *ScheduledChangeModel) ToEntity() (*biz.ScheduledChange, error) {
+	var emails []string
+	if len(m.Emails) > 0 {
+		if err := json.Unmarshal(m.Emails, &emails); err != nil {
+			return nil, err
+		}
+	}
+	var phones []phoneNumberSnapshot
+	if len(m.PhoneNumbers) > 0 {
+		if err := json.Unmarshal(m.PhoneNumbers, &phones); err != nil {
+			return nil, err
+		}
+	}
+	var address *addressSnapshot
+	if len(m.Address) > 0 {
+		if err := json.Unmarshal(m.Address, &address); err != nil {
+			return nil, err
+		}
+	}
+	var externalIDs map[string]string
+	if len(m.ExternalIDs) > 0 {
+		if err := json.Unmarshal(m.ExternalIDs, &externalIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	return &biz.ScheduledChange{
+		ID:           m.ID,
+		TenantID:     m.TenantID,
+		Operation:    m.Operation,
+		EmployeeID:   m.EmployeeID,
+		Emails:       emails,
+		FirstName:    m.FirstName,
+		LastName:     m.LastName,
+		Channel:      m.Channel,
+		EffectiveAt:  m.EffectiveAt,
+		Status:       m.Status,
+		Error:        m.Error,
+		CreatedBy:    m.CreatedBy,
+		CreatedAt:    m.CreatedAt,
+		AppliedAt:    m.AppliedAt,
+		DepartmentID: m.DepartmentID,
+		ManagerID:    m.ManagerID,
+		Title:        m.Title,
+		PhoneNumbers: fromPhoneNumberSnapshots(phones),
+		Address:      fromAddressSnapshot(address),
+		ExternalIDs:  externalIDs,
+	}, nil
+}
+
+type scheduleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewScheduleRepo creates a new scheduled change repository.
+func NewScheduleRepo(data *Data, logger log.Logger) biz.ScheduleRepo {
+	return &scheduleRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create stores a new pending change.
+func (r *scheduleRepo) Create(ctx context.Context, change *biz.ScheduledChange) (*biz.ScheduledChange, error) {
+	emails := change.Emails
+	if emails == nil {
+		emails = []string{}
+	}
+	encoded, err := json.Marshal(emails)
+	if err != nil {
+		return nil, err
+	}
+	var phones []byte
+	if change.PhoneNumbers != nil {
+		if phones, err = json.Marshal(toPhoneNumberSnapshots(change.PhoneNumbers)); err != nil {
+			return nil, err
+		}
+	}
+	var address []byte
+	if change.Address != nil {
+		if address, err = json.Marshal(toAddressSnapshot(change.Address)); err != nil {
+			return nil, err
+		}
+	}
+	var externalIDs []byte
+	if change.ExternalIDs != nil {
+		if externalIDs, err = json.Marshal(change.ExternalIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	model := &ScheduledChangeModel{
+		ID:           change.ID,
+		TenantID:     change.TenantID,
+		Operation:    change.Operation,
+		EmployeeID:   change.EmployeeID,
+		Emails:       encoded,
+		FirstName:    change.FirstName,
+		LastName:     change.LastName,
+		Channel:      change.Channel,
+		EffectiveAt:  change.EffectiveAt,
+		Status:       change.Status,
+		CreatedBy:    change.CreatedBy,
+		CreatedAt:    change.CreatedAt,
+		DepartmentID: change.DepartmentID,
+		ManagerID:    change.ManagerID,
+		Title:        change.Title,
+		PhoneNumbers: phones,
+		Address:      address,
+		ExternalIDs:  externalIDs,
+	}
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+
+	return model.ToEntity()
+}
+
+// List returns a page of the tenant's changes, next due first.
+func (r *scheduleRepo) List(ctx context.Context, tenantID string, filter *biz.ScheduledChangeFilter) ([]*biz.ScheduledChange, int64, error) {
+	query := r.data.DB(ctx).Model(&ScheduledChangeModel{}).
+		Where("tenant_id = ? AND status = ?", tenantID, filter.Status)
+	if filter.EmployeeID != nil {
+		query = query.Where("employee_id = ?", *filter.EmployeeID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []ScheduledChangeModel
+	if err := query.
+		Order("effective_at, id").
+		Offset(int((filter.Page - 1) * filter.PageSize)).
+		Limit(int(filter.PageSize)).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	changes := make([]*biz.ScheduledChange, 0, len(models))
+	for i := range models {
+		change, err := models[i].ToEntity()
+		if err != nil {
+			return nil, 0, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, total, nil
+}
+
+// cancelScheduledChangeQuery cancels a pending change unless a worker holds
+// its lease
+const cancelScheduledChangeQuery = `
+UPDATE employee_scheduled_changes SET status = 'cancelled'
+WHERE id = ? AND tenant_id = ? AND status = 'pending' AND (locked_until IS NULL OR locked_until < ?)
+RETURNING *`
+
+// Cancel cancels a pending change that no worker is applying.
+func (r *scheduleRepo) Cancel(ctx context.Context, tenantID string, id uuid.UUID) (*biz.ScheduledChange, error) {
+	var models []ScheduledChangeModel
+	now := r.data.now().UTC()
+	if err := r.data.DB(ctx).
+		Raw(cancelScheduledChangeQuery, id, tenantID, now).
+		Scan(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) > 0 {
+		return models[0].ToEntity()
+	}
+
+	var count int64
+	if err := r.data.DB(ctx).Model(&ScheduledChangeModel{}).
+		Where("id = ? AND tenant_id = ?", id, tenantID).
+		Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, biz.ErrScheduledChangeNotFound
+	}
+	return nil, biz.ErrScheduledChangeNotPending
+}
+
+// claimScheduledChangeQuery leases the pending change due first, skipping
+// changes that another worker is claiming at the same time
+const claimScheduledChangeQuery = `
+UPDATE employee_scheduled_changes SET locked_until = ?
+WHERE id = (
+    SELECT id FROM employee_scheduled_changes
+    WHERE status = 'pending' AND effective_at <= ? AND (locked_until IS NULL OR locked_until < ?)
+    ORDER BY effective_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+// ClaimDue leases the next due change across all tenants.
+func (r *scheduleRepo) ClaimDue(ctx context.Context, now time.Time, lease time.Duration) (*biz.ScheduledChange, error) {
+	var models []ScheduledChangeModel
+	if err := r.data.DB(ctx).
+		Raw(claimScheduledChangeQuery, now.Add(lease), now, now).
+		Scan(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+	return models[0].ToEntity()
+}
+
+// Finish stores the outcome of a claimed change and releases its lease.
+func (r *scheduleRepo) Finish(ctx context.Context, change *biz.ScheduledChange) error {
+	return r.data.DB(ctx).
+		Model(&ScheduledChangeModel{}).
+		Where("id = ?", change.ID).
+		Updates(map[string]interface{}{
+			"status":       change.Status,
+			"error":        change.Error,
+			"applied_at":   change.AppliedAt,
+			"locked_until": nil,
+		}).Error
+}
@@ -0,0 +1,64 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleRepo_ClaimDue(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &scheduleRepo{data: d}
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	id, employeeID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(`UPDATE employee_scheduled_changes SET locked_until = \$1 .* FOR UPDATE SKIP LOCKED`).
+		WithArgs(now.Add(time.Minute), now, now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "operation", "employee_id", "emails", "first_name", "status", "effective_at"}).
+			AddRow(id, "tenant-1", "create", employeeID, []byte(`["jane@example.com"]`), "Jane", "pending", now))
+
+	change, err := repo.ClaimDue(context.Background(), now, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, id, change.ID)
+	assert.Equal(t, employeeID, change.EmployeeID)
+	assert.Equal(t, []string{"jane@example.com"}, change.Emails)
+
+	mock.ExpectQuery(`UPDATE employee_scheduled_changes SET locked_until`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	change, err = repo.ClaimDue(context.Background(), now, time.Minute)
+	assert.NoError(t, err)
+	assert.Nil(t, change, "nothing is due")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestScheduleRepo_Cancel(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &scheduleRepo{data: d}
+	id := uuid.New()
+
+	for _, tt := range []struct {
+		count int
+		want  error
+	}{
+		{count: 0, want: biz.ErrScheduledChangeNotFound},
+		{count: 1, want: biz.ErrScheduledChangeNotPending},
+	} {
+		mock.ExpectQuery(`UPDATE employee_scheduled_changes SET status = 'cancelled'`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectQuery(`SELECT count\(\*\) FROM "employee_scheduled_changes" WHERE id = \$1 AND tenant_id = \$2`).
+			WithArgs(id, "tenant-1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(tt.count))
+
+		_, err := repo.Cancel(context.Background(), "tenant-1", id)
+		assert.Equal(t, tt.want, err)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,189 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TeamModel is the GORM model for teams
+type TeamModel struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TenantID    string    `gorm:"type:varchar(255);not null"`
+	Name        string    `gorm:"type:varchar(100);not null"`
+	Description string    `gorm:"type:text;not null"`
+	CreatedAt   time.Time `gorm:"not null"`
+	UpdatedAt   time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (TeamModel) TableName() string {
+	return "employee_teams"
+}
+
+// ToEntity converts TeamModel to biz.Team
+func (m *TeamModel) ToEntity() *biz.Team {
+	return &biz.Team{
+		ID:          m.ID,
+		TenantID:    m.TenantID,
+		Name:        m.Name,
+		Description: m.Description,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+// TeamMemberModel is the GORM model for the membership of an employee in a
+// team
+type TeamMemberModel struct {
+	TeamID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	EmployeeID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TenantID   string    `gorm:"type:varchar(255);not null"`
+	CreatedAt  time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (TeamMemberModel) TableName() string {
+	return "employee_team_members"
+}
+
+type teamRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTeamRepo creates a new team repository.
+func NewTeamRepo(data *Data, logger log.Logger) biz.TeamRepo {
+	return &teamRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create stores a new team.
+func (r *teamRepo) Create(ctx context.Context, team *biz.Team) (*biz.Team, error) {
+	now := r.data.now()
+	model := &TeamModel{
+		ID:          team.ID,
+		TenantID:    team.TenantID,
+		Name:        team.Name,
+		Description: team.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
+		if isUniqueViolation(err) {
+			return nil, biz.ErrTeamAlreadyExists
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+// Get retrieves a team of a tenant.
+func (r *teamRepo) Get(ctx context.Context, tenantID string, id uuid.UUID) (*biz.Team, error) {
+	var model TeamModel
+	err := r.data.DB(ctx).
+		Where("id = ? AND tenant_id = ?", id, tenantID).
+		First(&model).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, biz.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+// AddMember adds an employee to a team; the foreign keys of
+// employee_team_members reject teams and employees of other tenants.
+func (r *teamRepo) AddMember(ctx context.Context, tenantID string, teamID, employeeID uuid.UUID) (bool, error) {
+	result := r.data.DB(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&TeamMemberModel{
+			TeamID:     teamID,
+			EmployeeID: employeeID,
+			TenantID:   tenantID,
+			CreatedAt:  r.data.now(),
+		})
+	if isForeignKeyViolation(result.Error) {
+		// The employee was deleted in the meantime
+		return false, biz.ErrEmployeeNotFound
+	}
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// RemoveMember removes an employee from a team.
+func (r *teamRepo) RemoveMember(ctx context.Context, tenantID string, teamID, employeeID uuid.UUID) error {
+	result := r.data.DB(ctx).
+		Where("team_id = ? AND employee_id = ? AND tenant_id = ?", teamID, employeeID, tenantID).
+		Delete(&TeamMemberModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrTeamMemberNotFound
+	}
+	return nil
+}
+
+// ListMembers returns a page of the members of a team, oldest members first.
+func (r *teamRepo) ListMembers(ctx context.Context, tenantID string, teamID uuid.UUID, page, pageSize int32) ([]*biz.TeamMember, int64, error) {
+	query := r.data.DB(ctx).Model(&TeamMemberModel{}).Where("team_id = ? AND tenant_id = ?", teamID, tenantID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var members []TeamMemberModel
+	if err := query.
+		Order("created_at, employee_id").
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&members).Error; err != nil {
+		return nil, 0, err
+	}
+	if len(members) == 0 {
+		return []*biz.TeamMember{}, total, nil
+	}
+
+	ids := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		ids[i] = m.EmployeeID
+	}
+	var models []EmployeeModel
+	if err := r.data.DB(ctx).
+		Preload("Emails", orderedEmails).
+		Preload("PhoneNumbers", orderedPhoneNumbers).
+		Preload("ExternalIDs").
+		Where("id IN ? AND tenant_id = ?", ids, tenantID).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+	employees := make(map[uuid.UUID]*biz.Employee, len(models))
+	for i := range models {
+		employees[models[i].ID] = models[i].ToEntity()
+	}
+
+	// Keep the membership order; employees deleted since the page was read
+	// are left out
+	result := make([]*biz.TeamMember, 0, len(members))
+	for _, m := range members {
+		if e, ok := employees[m.EmployeeID]; ok {
+			result = append(result, &biz.TeamMember{Employee: e, JoinedAt: m.CreatedAt})
+		}
+	}
+	return result, total, nil
+}
@@ -0,0 +1,61 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamRepo_AddMember(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	teamID, employeeID := uuid.New(), uuid.New()
+
+	for _, tt := range []struct {
+		name     string
+		affected int64
+		added    bool
+	}{
+		{name: "new member", affected: 1, added: true},
+		{name: "already a member", affected: 0, added: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d, mock := newMockData(t)
+			d.clock = biz.ClockFunc(func() time.Time { return now })
+			repo := &teamRepo{data: d}
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`INSERT INTO "employee_team_members" .* ON CONFLICT DO NOTHING`).
+				WithArgs(teamID, employeeID, "tenant-1", now).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			mock.ExpectCommit()
+
+			added, err := repo.AddMember(context.Background(), "tenant-1", teamID, employeeID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.added, added)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTeamRepo_RemoveMemberNotMember(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &teamRepo{data: d}
+	teamID, employeeID := uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "employee_team_members" WHERE team_id = \$1 AND employee_id = \$2 AND tenant_id = \$3`).
+		WithArgs(teamID, employeeID, "tenant-1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := repo.RemoveMember(context.Background(), "tenant-1", teamID, employeeID)
+	assert.ErrorIs(t, err, biz.ErrTeamMemberNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,327 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TenantCloneModel is the GORM model for copies of tenants into sandbox
+// tenants
+type TenantCloneModel struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	TenantID        string     `gorm:"type:varchar(255);not null"`
+	TargetTenantID  string     `gorm:"type:varchar(255);not null"`
+	Anonymize       bool       `gorm:"not null"`
+	Status          string     `gorm:"type:varchar(16);not null"`
+	Phase           string     `gorm:"type:varchar(16);not null"`
+	CursorCreatedAt *time.Time `gorm:""`
+	CursorID        *uuid.UUID `gorm:"type:uuid"`
+	CopiedEmployees int32      `gorm:"not null"`
+	Error           string     `gorm:"type:text;not null"`
+	CreatedBy       string     `gorm:"type:varchar(255);not null"`
+	LockedUntil     *time.Time `gorm:""`
+	CreatedAt       time.Time  `gorm:"not null"`
+	UpdatedAt       time.Time  `gorm:"not null"`
+	CompletedAt     *time.Time `gorm:""`
+}
+
+// TableName overrides the table name
+func (TenantCloneModel) TableName() string {
+	return "employee_tenant_clones"
+}
+
+// ToEntity converts TenantCloneModel to biz.TenantClone
+func (m *TenantCloneModel) ToEntity() *biz.TenantClone {
+	clone := &biz.TenantClone{
+		ID:              m.ID,
+		TenantID:        m.TenantID,
+		TargetTenantID:  m.TargetTenantID,
+		Anonymize:       m.Anonymize,
+		Status:          m.Status,
+		Phase:           m.Phase,
+		CopiedEmployees: m.CopiedEmployees,
+		Error:           m.Error,
+		CreatedBy:       m.CreatedBy,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+		CompletedAt:     m.CompletedAt,
+	}
+	if m.CursorCreatedAt != nil && m.CursorID != nil {
+		clone.Cursor = &biz.StreamCursor{CreatedAt: *m.CursorCreatedAt, ID: *m.CursorID}
+	}
+	return clone
+}
+
+type tenantCloneRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTenantCloneRepo creates a new tenant clone repository.
+func NewTenantCloneRepo(data *Data, logger log.Logger) biz.TenantCloneRepo {
+	return &tenantCloneRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create stores a new pending clone; the unique index on the active clones
+// of a target rejects a second one.
+func (r *tenantCloneRepo) Create(ctx context.Context, clone *biz.TenantClone) (*biz.TenantClone, error) {
+	model := &TenantCloneModel{
+		ID:             clone.ID,
+		TenantID:       clone.TenantID,
+		TargetTenantID: clone.TargetTenantID,
+		Anonymize:      clone.Anonymize,
+		Status:         clone.Status,
+		Phase:          clone.Phase,
+		CreatedBy:      clone.CreatedBy,
+		CreatedAt:      clone.CreatedAt,
+		UpdatedAt:      clone.UpdatedAt,
+	}
+	err := r.data.DB(ctx).Create(model).Error
+	if isUniqueViolation(err) {
+		return nil, biz.ErrTenantCloneTargetNotEmpty
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+// Get returns a clone of the tenant.
+func (r *tenantCloneRepo) Get(ctx context.Context, tenantID string, id uuid.UUID) (*biz.TenantClone, error) {
+	var model TenantCloneModel
+	err := r.data.DB(ctx).Where("id = ? AND tenant_id = ?", id, tenantID).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrTenantCloneNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+// isTenantEmptyQuery reports whether a tenant has no employees, departments
+// or teams
+const isTenantEmptyQuery = `
+SELECT NOT EXISTS (SELECT 1 FROM employees WHERE tenant_id = ?)
+   AND NOT EXISTS (SELECT 1 FROM employee_departments WHERE tenant_id = ?)
+   AND NOT EXISTS (SELECT 1 FROM employee_teams WHERE tenant_id = ?)`
+
+// IsTenantEmpty reports whether a tenant has no employees, departments or
+// teams.
+func (r *tenantCloneRepo) IsTenantEmpty(ctx context.Context, tenantID string) (bool, error) {
+	var empty bool
+	if err := r.data.DB(ctx).Raw(isTenantEmptyQuery, tenantID, tenantID, tenantID).Scan(&empty).Error; err != nil {
+		return false, err
+	}
+	return empty, nil
+}
+
+// claimTenantCloneQuery leases the active clone updated first, skipping
+// clones that another worker is claiming at the same time
+const claimTenantCloneQuery = `
+UPDATE employee_tenant_clones SET status = 'running', locked_until = ?
+WHERE id = (
+    SELECT id FROM employee_tenant_clones
+    WHERE status IN ('pending', 'running') AND (locked_until IS NULL OR locked_until < ?)
+    ORDER BY updated_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+// ClaimNext leases the next clone to run across all tenants.
+func (r *tenantCloneRepo) ClaimNext(ctx context.Context, now time.Time, lease time.Duration) (*biz.TenantClone, error) {
+	var models []TenantCloneModel
+	if err := r.data.DB(ctx).
+		Raw(claimTenantCloneQuery, now.Add(lease), now).
+		Scan(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+	return models[0].ToEntity(), nil
+}
+
+// SaveProgress stores the progress of a claimed clone and releases its lease.
+func (r *tenantCloneRepo) SaveProgress(ctx context.Context, clone *biz.TenantClone) error {
+	var cursorCreatedAt *time.Time
+	var cursorID *uuid.UUID
+	if clone.Cursor != nil {
+		cursorCreatedAt, cursorID = &clone.Cursor.CreatedAt, &clone.Cursor.ID
+	}
+	return r.data.DB(ctx).
+		Model(&TenantCloneModel{}).
+		Where("id = ?", clone.ID).
+		Updates(map[string]interface{}{
+			"status":            clone.Status,
+			"phase":             clone.Phase,
+			"cursor_created_at": cursorCreatedAt,
+			"cursor_id":         cursorID,
+			"copied_employees":  clone.CopiedEmployees,
+			"error":             clone.Error,
+			"updated_at":        clone.UpdatedAt,
+			"completed_at":      clone.CompletedAt,
+			"locked_until":      nil,
+		}).Error
+}
+
+// CopyStructure copies the settings, departments and teams of the clone's
+// tenant in one transaction. The settings replace those of the target.
+func (r *tenantCloneRepo) CopyStructure(ctx context.Context, clone *biz.TenantClone) error {
+	now := r.data.now()
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var settings []TenantSettingsModel
+		if err := tx.Where("tenant_id = ?", clone.TenantID).Find(&settings).Error; err != nil {
+			return err
+		}
+		for _, s := range settings {
+			s.TenantID = clone.TargetTenantID
+			s.UpdatedBy = clone.CreatedBy
+			s.UpdatedAt = now
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "tenant_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"allowed_email_domains", "updated_by", "updated_at"}),
+			}).Create(&s).Error; err != nil {
+				return err
+			}
+		}
+
+		var departments []DepartmentModel
+		if err := tx.Where("tenant_id = ?", clone.TenantID).Find(&departments).Error; err != nil {
+			return err
+		}
+		for i := range departments {
+			departments[i].ID = clone.TargetID(departments[i].ID)
+			departments[i].TenantID = clone.TargetTenantID
+		}
+		if len(departments) > 0 {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&departments).Error; err != nil {
+				return err
+			}
+		}
+
+		var teams []TeamModel
+		if err := tx.Where("tenant_id = ?", clone.TenantID).Find(&teams).Error; err != nil {
+			return err
+		}
+		for i := range teams {
+			teams[i].ID = clone.TargetID(teams[i].ID)
+			teams[i].TenantID = clone.TargetTenantID
+		}
+		if len(teams) > 0 {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&teams).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// CopyEmployees creates the employees not copied yet in one transaction,
+// audited like creates, and sets their tags and deactivation, which creates
+// leave unset.
+func (r *tenantCloneRepo) CopyEmployees(ctx context.Context, clone *biz.TenantClone, employees []*biz.Employee) (int, error) {
+	if len(employees) == 0 {
+		return 0, nil
+	}
+	ids := make([]uuid.UUID, len(employees))
+	for i, employee := range employees {
+		ids[i] = employee.ID
+	}
+
+	copied := 0
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []uuid.UUID
+		if err := tx.Model(&EmployeeModel{}).
+			Where("tenant_id = ? AND id IN ?", clone.TargetTenantID, ids).
+			Pluck("id", &existing).Error; err != nil {
+			return err
+		}
+		skip := make(map[uuid.UUID]bool, len(existing))
+		for _, id := range existing {
+			skip[id] = true
+		}
+
+		for _, employee := range employees {
+			if skip[employee.ID] {
+				continue
+			}
+			if _, err := r.data.createTx(ctx, tx, clone.TargetTenantID, employee); err != nil {
+				return err
+			}
+			if len(employee.Tags) > 0 || employee.DeactivatedAt != nil {
+				if err := tx.Model(&EmployeeModel{}).
+					Where("tenant_id = ? AND id = ?", clone.TargetTenantID, employee.ID).
+					Updates(map[string]interface{}{
+						"tags":           tagArray(employee.Tags),
+						"deactivated_at": employee.DeactivatedAt,
+					}).Error; err != nil {
+					return err
+				}
+			}
+			copied++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return copied, nil
+}
+
+// CopyTeamMembers copies the memberships of the employees, keeping when they
+// joined.
+func (r *tenantCloneRepo) CopyTeamMembers(ctx context.Context, clone *biz.TenantClone, employeeIDs []uuid.UUID) error {
+	if len(employeeIDs) == 0 {
+		return nil
+	}
+	var members []TeamMemberModel
+	if err := r.data.DB(ctx).
+		Where("tenant_id = ? AND employee_id IN ?", clone.TenantID, employeeIDs).
+		Find(&members).Error; err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		return nil
+	}
+	for i := range members {
+		members[i].TeamID = clone.TargetID(members[i].TeamID)
+		members[i].EmployeeID = clone.TargetID(members[i].EmployeeID)
+		members[i].TenantID = clone.TargetTenantID
+	}
+	return r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&members).Error
+}
+
+// setCloneManagerQuery sets the manager of a copied employee once the
+// manager was copied too; managers pending review are not
+const setCloneManagerQuery = `
+UPDATE employees SET manager_id = ?
+WHERE tenant_id = ? AND id = ?
+  AND EXISTS (SELECT 1 FROM employees m WHERE m.tenant_id = ? AND m.id = ?)`
+
+// SetManagers sets the managers of the copied employees in one transaction
+// and brings their employment history up to date.
+func (r *tenantCloneRepo) SetManagers(ctx context.Context, clone *biz.TenantClone, managers map[uuid.UUID]uuid.UUID) error {
+	ids := make([]uuid.UUID, 0, len(managers))
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		for id, managerID := range managers {
+			if err := tx.Exec(setCloneManagerQuery, managerID, clone.TargetTenantID, id, clone.TargetTenantID, managerID).Error; err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		return r.data.syncEmploymentHistoryTx(tx, "e.tenant_id = ? AND e.id IN ?", clone.TargetTenantID, ids)
+	})
+}
@@ -0,0 +1,75 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantCloneRepo_ClaimNext(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &tenantCloneRepo{data: d}
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	id, cursorID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(`UPDATE employee_tenant_clones SET status = 'running', locked_until = \$1 .* FOR UPDATE SKIP LOCKED`).
+		WithArgs(now.Add(time.Minute), now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "target_tenant_id", "anonymize", "status", "phase", "cursor_created_at", "cursor_id"}).
+			AddRow(id, "tenant-1", "tenant-1-sandbox", true, "running", "employees", now, cursorID))
+
+	clone, err := repo.ClaimNext(context.Background(), now, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, id, clone.ID)
+	assert.Equal(t, "tenant-1-sandbox", clone.TargetTenantID)
+	assert.Equal(t, &biz.StreamCursor{CreatedAt: now, ID: cursorID}, clone.Cursor)
+
+	mock.ExpectQuery(`UPDATE employee_tenant_clones SET status = 'running'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	clone, err = repo.ClaimNext(context.Background(), now, time.Minute)
+	assert.NoError(t, err)
+	assert.Nil(t, clone, "nothing to run")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTenantCloneRepo_IsTenantEmpty(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &tenantCloneRepo{data: d}
+
+	mock.ExpectQuery(`SELECT NOT EXISTS \(SELECT 1 FROM employees WHERE tenant_id = \$1\)`).
+		WithArgs("tenant-1", "tenant-1", "tenant-1").
+		WillReturnRows(sqlmock.NewRows([]string{"empty"}).AddRow(false))
+
+	empty, err := repo.IsTenantEmpty(context.Background(), "tenant-1")
+	require.NoError(t, err)
+	assert.False(t, empty)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTenantCloneRepo_CopyTeamMembers(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &tenantCloneRepo{data: d}
+	clone := &biz.TenantClone{ID: uuid.New(), TenantID: "tenant-1", TargetTenantID: "tenant-1-sandbox"}
+	teamID, employeeID := uuid.New(), uuid.New()
+	joinedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT \* FROM "employee_team_members" WHERE tenant_id = \$1 AND employee_id IN \(\$2\)`).
+		WithArgs("tenant-1", employeeID).
+		WillReturnRows(sqlmock.NewRows([]string{"team_id", "employee_id", "tenant_id", "created_at"}).
+			AddRow(teamID, employeeID, "tenant-1", joinedAt))
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "employee_team_members" .* ON CONFLICT DO NOTHING`).
+		WithArgs(clone.TargetID(teamID), clone.TargetID(employeeID), "tenant-1-sandbox", joinedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.CopyTeamMembers(context.Background(), clone, []uuid.UUID{employeeID}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
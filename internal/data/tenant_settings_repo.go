@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TenantSettingsModel is the GORM model for the settings of a tenant
+type TenantSettingsModel struct {
+	TenantID            string    `gorm:"type:varchar(255);primaryKey"`
+	AllowedEmailDomains []byte    `gorm:"type:jsonb;not null"`
+	UpdatedBy           string    `gorm:"type:varchar(255);not null"`
+	UpdatedAt           time.Time `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (TenantSettingsModel) TableName() string {
+	return "employee_tenant_settings"
+}
+
+// ToEntity converts TenantSettingsModel to biz.TenantSettings
+func (m *TenantSettingsModel) ToEntity() (*biz.TenantSettings, error) {
+	var domains []string
+	if len(m.AllowedEmailDomains) > 0 {
+		if err := json.Unmarshal(m.AllowedEmailDomains, &domains); err != nil {
+			return nil, err
+		}
+	}
+
+	return &biz.TenantSettings{
+		TenantID:            m.TenantID,
+		AllowedEmailDomains: domains,
+		UpdatedBy:           m.UpdatedBy,
+		UpdatedAt:           m.UpdatedAt,
+	}, nil
+}
+
+type tenantSettingsRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTenantSettingsRepo creates a new tenant settings repository.
+func NewTenantSettingsRepo(data *Data, logger log.Logger) biz.TenantSettingsRepo {
+	return &tenantSettingsRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Get returns the settings of a tenant, empty settings when it has none.
+func (r *tenantSettingsRepo) Get(ctx context.Context, tenantID string) (*biz.TenantSettings, error) {
+	var model TenantSettingsModel
+	err := r.data.DB(ctx).Where("tenant_id = ?", tenantID).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &biz.TenantSettings{TenantID: tenantID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity()
+}
+
+// Save replaces the settings of a tenant in a single upsert.
+func (r *tenantSettingsRepo) Save(ctx context.Context, settings *biz.TenantSettings) (*biz.TenantSettings, error) {
+	domains := settings.AllowedEmailDomains
+	if domains == nil {
+		domains = []string{}
+	}
+	encoded, err := json.Marshal(domains)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &TenantSettingsModel{
+		TenantID:            settings.TenantID,
+		AllowedEmailDomains: encoded,
+		UpdatedBy:           settings.UpdatedBy,
+		UpdatedAt:           settings.UpdatedAt,
+	}
+	if err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tenant_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"allowed_email_domains", "updated_by", "updated_at"}),
+	}).Create(model).Error; err != nil {
+		return nil, err
+	}
+
+	return model.ToEntity()
+}
@@ -0,0 +1,55 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantSettingsRepo_Get(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &tenantSettingsRepo{data: d}
+	query := `SELECT \* FROM "employee_tenant_settings" WHERE tenant_id = \$1`
+
+	mock.ExpectQuery(query).
+		WithArgs("tenant-1", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"tenant_id", "allowed_email_domains", "updated_by"}).
+			AddRow("tenant-1", []byte(`["example.com"]`), "user-1"))
+
+	settings, err := repo.Get(context.Background(), "tenant-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"example.com"}, settings.AllowedEmailDomains)
+
+	mock.ExpectQuery(query).
+		WithArgs("tenant-2", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"tenant_id"}))
+
+	settings, err = repo.Get(context.Background(), "tenant-2")
+	require.NoError(t, err)
+	assert.Equal(t, &biz.TenantSettings{TenantID: "tenant-2"}, settings)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTenantSettingsRepo_Save(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &tenantSettingsRepo{data: d}
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "employee_tenant_settings" .* ON CONFLICT \("tenant_id"\) DO UPDATE SET "allowed_email_domains"="excluded"."allowed_email_domains","updated_by"="excluded"."updated_by","updated_at"="excluded"."updated_at"`).
+		WithArgs("tenant-1", []byte(`[]`), "user-1", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	settings, err := repo.Save(context.Background(), &biz.TenantSettings{TenantID: "tenant-1", UpdatedBy: "user-1", UpdatedAt: now})
+
+	require.NoError(t, err)
+	assert.Empty(t, settings.AllowedEmailDomains)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,133 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/observability"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultMinTimeout = 5 * time.Millisecond
+
+	budgetDatabase = "database"
+	budgetNATS     = "nats"
+
+	budgetSkipped  = "skipped"
+	budgetTimedOut = "timed_out"
+
+	// budgetCancelKey stores the function releasing the deadline of a statement
+	budgetCancelKey = "timeout_budget:cancel"
+)
+
+// timeoutBudget derives the timeouts of dependency calls from the deadline
+// of the request making them, so that a caller allowing 200ms is not kept
+// waiting for a call with a timeout of seconds
+type timeoutBudget struct {
+	// minTimeout is the smallest remaining budget a call is started with
+	minTimeout time.Duration
+	obs        *observability.Observability
+}
+
+// newTimeoutBudget creates the timeout budget configured in c
+func newTimeoutBudget(c *conf.Data_TimeoutBudget, obs *observability.Observability) timeoutBudget {
+	b := timeoutBudget{minTimeout: defaultMinTimeout, obs: obs}
+	if c.GetMinTimeout() != nil && c.GetMinTimeout().AsDuration() > 0 {
+		b.minTimeout = c.GetMinTimeout().AsDuration()
+	}
+	return b
+}
+
+// derive returns ctx bounded by timeout, or by the remaining request
+// deadline when that is shorter; a zero timeout leaves only the deadline.
+// When less than minTimeout is left, the call is not worth starting:
+// biz.ErrDeadlineExhausted is returned and counted as skipped.
+func (b timeoutBudget) derive(ctx context.Context, dependency string, timeout time.Duration) (context.Context, context.CancelFunc, error) {
+	deadline, ok := ctx.Deadline()
+	if ok {
+		remaining := time.Until(deadline)
+		if remaining < b.minTimeout {
+			b.obs.RecordTimeoutBudgetExhausted(dependency, budgetSkipped)
+			return ctx, func() {}, biz.ErrDeadlineExhausted
+		}
+		if timeout <= 0 || remaining < timeout {
+			timeout = remaining
+		}
+	}
+	if timeout <= 0 {
+		return ctx, func() {}, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, nil
+}
+
+// observe counts a call that failed with err as timed out when it ran out
+// of the deadline of ctx, the context derive returned for it
+func (b timeoutBudget) observe(ctx context.Context, dependency string, err error) {
+	if err == nil {
+		return
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		b.obs.RecordTimeoutBudgetExhausted(dependency, budgetTimedOut)
+	}
+}
+
+// timeoutBudgetPlugin bounds every database statement by the remaining
+// deadline of its request and the configured statement timeout. Row and
+// Rows statements, which are read after the callbacks returned, keep the
+// request context.
+type timeoutBudgetPlugin struct {
+	budget  timeoutBudget
+	timeout time.Duration
+}
+
+// Name returns the plugin name
+func (timeoutBudgetPlugin) Name() string {
+	return "timeout_budget"
+}
+
+// Initialize registers the budget callbacks around statements
+func (p timeoutBudgetPlugin) Initialize(db *gorm.DB) error {
+	before := func(db *gorm.DB) {
+		ctx, cancel, err := p.budget.derive(db.Statement.Context, budgetDatabase, p.timeout)
+		if err != nil {
+			// Callbacks skip the statement once an error is set
+			_ = db.AddError(err)
+			return
+		}
+		db.Statement.Context = ctx
+		db.InstanceSet(budgetCancelKey, cancel)
+	}
+	after := func(db *gorm.DB) {
+		if cancel, ok := db.InstanceGet(budgetCancelKey); ok {
+			p.budget.observe(db.Statement.Context, budgetDatabase, db.Error)
+			cancel.(context.CancelFunc)()
+		}
+	}
+
+	cb := db.Callback()
+	registrations := []func() error{
+		func() error { return cb.Create().Before("*").Register("timeout_budget:before_create", before) },
+		func() error { return cb.Create().After("*").Register("timeout_budget:after_create", after) },
+		func() error { return cb.Query().Before("*").Register("timeout_budget:before_query", before) },
+		func() error { return cb.Query().After("*").Register("timeout_budget:after_query", after) },
+		func() error { return cb.Update().Before("*").Register("timeout_budget:before_update", before) },
+		func() error { return cb.Update().After("*").Register("timeout_budget:after_update", after) },
+		func() error { return cb.Delete().Before("*").Register("timeout_budget:before_delete", before) },
+		func() error { return cb.Delete().After("*").Register("timeout_budget:after_delete", after) },
+		func() error { return cb.Raw().Before("*").Register("timeout_budget:before_raw", before) },
+		func() error { return cb.Raw().After("*").Register("timeout_budget:after_raw", after) },
+	}
+	for _, register := range registrations {
+		if err := register(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestNewTimeoutBudget(t *testing.T) {
+	assert.Equal(t, defaultMinTimeout, newTimeoutBudget(nil, nil).minTimeout)
+	assert.Equal(t, 20*time.Millisecond, newTimeoutBudget(&conf.Data_TimeoutBudget{MinTimeout: durationpb.New(20 * time.Millisecond)}, nil).minTimeout)
+}
+
+func TestTimeoutBudget_Derive(t *testing.T) {
+	b := timeoutBudget{minTimeout: 10 * time.Millisecond}
+
+	tests := []struct {
+		name     string
+		deadline time.Duration
+		timeout  time.Duration
+		// want is the derived timeout, 0 meaning no deadline
+		want    time.Duration
+		wantErr error
+	}{
+		{name: "no deadline or timeout"},
+		{name: "timeout only", timeout: time.Second, want: time.Second},
+		{name: "deadline only", deadline: time.Minute, want: time.Minute},
+		{name: "timeout within deadline", deadline: time.Minute, timeout: time.Second, want: time.Second},
+		{name: "deadline shorter than timeout", deadline: 200 * time.Millisecond, timeout: time.Second, want: 200 * time.Millisecond},
+		{name: "deadline below floor", deadline: 5 * time.Millisecond, timeout: time.Second, wantErr: biz.ErrDeadlineExhausted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.deadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.deadline)
+				defer cancel()
+			}
+
+			derived, cancel, err := b.derive(ctx, budgetDatabase, tt.timeout)
+			defer cancel()
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+
+			deadline, ok := derived.Deadline()
+			if tt.want == 0 {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.InDelta(t, tt.want, time.Until(deadline), float64(50*time.Millisecond))
+		})
+	}
+}
+
+func TestTimeoutBudgetPlugin_SkipsStatementWithoutBudget(t *testing.T) {
+	d, mock := newMockData(t)
+	require.NoError(t, d.db.Use(timeoutBudgetPlugin{budget: timeoutBudget{minTimeout: time.Second}}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	var count int64
+	err := d.db.WithContext(ctx).Model(&EmployeeModel{}).Count(&count).Error
+
+	assert.Equal(t, biz.ErrDeadlineExhausted, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTimeoutBudgetPlugin_BoundsStatement(t *testing.T) {
+	d, mock := newMockData(t)
+	require.NoError(t, d.db.Use(timeoutBudgetPlugin{budget: timeoutBudget{minTimeout: time.Millisecond}, timeout: 20 * time.Millisecond}))
+
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "employees"`).
+		WillDelayFor(time.Second).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	start := time.Now()
+	var count int64
+	err := d.db.WithContext(context.Background()).Model(&EmployeeModel{}).Count(&count).Error
+
+	// The driver reports the cancellation in its own words
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
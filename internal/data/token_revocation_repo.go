@@ -0,0 +1,116 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// TokenRevocationModel is the GORM model for token revocations
+type TokenRevocationModel struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	TenantID  string     `gorm:"type:varchar(255);not null"`
+	JTI       *string    `gorm:"column:jti;type:varchar(255)"`
+	UserID    *string    `gorm:"type:varchar(255)"`
+	RevokedAt time.Time  `gorm:"not null"`
+	ExpiresAt *time.Time `gorm:""`
+	RevokedBy string     `gorm:"type:varchar(255);not null"`
+}
+
+// TableName overrides the table name
+func (TokenRevocationModel) TableName() string {
+	return "employee_token_revocations"
+}
+
+// ToEntity converts TokenRevocationModel to biz.TokenRevocation
+func (m *TokenRevocationModel) ToEntity() *biz.TokenRevocation {
+	r := &biz.TokenRevocation{
+		ID:        m.ID,
+		TenantID:  m.TenantID,
+		RevokedAt: m.RevokedAt,
+		ExpiresAt: m.ExpiresAt,
+		RevokedBy: m.RevokedBy,
+	}
+	if m.JTI != nil {
+		r.JTI = *m.JTI
+	}
+	if m.UserID != nil {
+		r.UserID = *m.UserID
+	}
+	return r
+}
+
+type tokenRevocationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTokenRevocationRepo creates a new token revocation repository.
+func NewTokenRevocationRepo(data *Data, logger log.Logger) biz.TokenRevocationRepo {
+	return &tokenRevocationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// saveJTIRevocationQuery and saveUserRevocationQuery upsert the revocation of
+// a token and of the tokens of a user. A token revoked again keeps its first
+// revocation; the tokens of a user revoked again are revoked up to now.
+const (
+	saveJTIRevocationQuery = `
+INSERT INTO employee_token_revocations (id, tenant_id, jti, revoked_at, expires_at, revoked_by)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (tenant_id, jti) WHERE jti IS NOT NULL
+DO UPDATE SET expires_at = EXCLUDED.expires_at
+RETURNING *`
+	saveUserRevocationQuery = `
+INSERT INTO employee_token_revocations (id, tenant_id, user_id, revoked_at, revoked_by)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (tenant_id, user_id) WHERE user_id IS NOT NULL
+DO UPDATE SET revoked_at = EXCLUDED.revoked_at, revoked_by = EXCLUDED.revoked_by
+RETURNING *`
+)
+
+// Save stores a revocation and deletes the expired ones of the tenant.
+func (r *tokenRevocationRepo) Save(ctx context.Context, revocation *biz.TokenRevocation, now time.Time) (*biz.TokenRevocation, error) {
+	var model TokenRevocationModel
+	err := r.data.Transaction(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		if err := db.Where("tenant_id = ? AND expires_at < ?", revocation.TenantID, now).
+			Delete(&TokenRevocationModel{}).Error; err != nil {
+			return err
+		}
+
+		if revocation.JTI != "" {
+			return db.Raw(saveJTIRevocationQuery, revocation.ID, revocation.TenantID, revocation.JTI,
+				revocation.RevokedAt, revocation.ExpiresAt, revocation.RevokedBy).Scan(&model).Error
+		}
+		return db.Raw(saveUserRevocationQuery, revocation.ID, revocation.TenantID, revocation.UserID,
+			revocation.RevokedAt, revocation.RevokedBy).Scan(&model).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return model.ToEntity(), nil
+}
+
+// isTokenRevokedQuery matches the revocation of a token by jti and of the
+// tokens of its subject issued up to the revocation
+const isTokenRevokedQuery = `
+SELECT EXISTS (
+    SELECT 1 FROM employee_token_revocations
+    WHERE tenant_id = ? AND (jti = NULLIF(?, '') OR (user_id = ? AND revoked_at >= ?))
+)`
+
+// IsRevoked reports whether a token is revoked.
+func (r *tokenRevocationRepo) IsRevoked(ctx context.Context, tenantID, jti, subject string, issuedAt time.Time) (bool, error) {
+	var revoked bool
+	if err := r.data.DB(ctx).Raw(isTokenRevokedQuery, tenantID, jti, subject, issuedAt).Scan(&revoked).Error; err != nil {
+		return false, err
+	}
+	return revoked, nil
+}
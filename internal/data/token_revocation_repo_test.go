@@ -0,0 +1,51 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenRevocationRepo_Save(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &tokenRevocationRepo{data: d}
+	id := uuid.New()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "employee_token_revocations" WHERE tenant_id = \$1 AND expires_at < \$2`).
+		WithArgs("tenant-1", now).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(`INSERT INTO employee_token_revocations \(id, tenant_id, user_id, revoked_at, revoked_by\)\s+VALUES \(\$1, \$2, \$3, \$4, \$5\)\s+ON CONFLICT \(tenant_id, user_id\) WHERE user_id IS NOT NULL`).
+		WithArgs(id, "tenant-1", "user-1", now, "admin-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "jti", "user_id", "revoked_at", "revoked_by"}).
+			AddRow(id, "tenant-1", nil, "user-1", now, "admin-1"))
+	mock.ExpectCommit()
+
+	revocation, err := repo.Save(context.Background(), &biz.TokenRevocation{ID: id, TenantID: "tenant-1", UserID: "user-1", RevokedAt: now, RevokedBy: "admin-1"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, &biz.TokenRevocation{ID: id, TenantID: "tenant-1", UserID: "user-1", RevokedAt: now, RevokedBy: "admin-1"}, revocation)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRevocationRepo_IsRevoked(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &tokenRevocationRepo{data: d}
+	issuedAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`WHERE tenant_id = \$1 AND \(jti = NULLIF\(\$2, ''\) OR \(user_id = \$3 AND revoked_at >= \$4\)\)`).
+		WithArgs("tenant-1", "token-1", "user-1", issuedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	revoked, err := repo.IsRevoked(context.Background(), "tenant-1", "token-1", "user-1", issuedAt)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,118 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const (
+	// tracerName names the tracer of the data layer spans
+	tracerName = "github.com/cvele/employee-service/internal/data"
+
+	// maxTracedStatementLength bounds db.statement; statements are traced
+	// with their placeholders, never with their values
+	maxTracedStatementLength = 1024
+
+	tracingSpanKey = "tracing:span"
+)
+
+// tracer returns the tracer of the data layer. It follows the global
+// provider, so spans are dropped while tracing is disabled.
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// attrTenantID is the tenant of a repository or publish span
+func attrTenantID(tenantID string) attribute.KeyValue {
+	return attribute.String("tenant.id", tenantID)
+}
+
+// endSpan ends span with the outcome err. Client errors, such as a missing
+// employee, are recorded without marking the span as failed.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		if errors.Code(err) >= 500 {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+	span.End()
+}
+
+// tracingPlugin traces every statement in a client span named after its
+// summary, e.g. "SELECT employees", with the statement and its placeholders
+// as db.statement. The spans are children of the span of the repository
+// method, or of the request, that issued them.
+type tracingPlugin struct {
+	system attribute.KeyValue
+}
+
+// Name returns the plugin name
+func (tracingPlugin) Name() string {
+	return "tracing"
+}
+
+// Initialize registers the tracing callbacks around every statement type
+func (p tracingPlugin) Initialize(db *gorm.DB) error {
+	before := func(db *gorm.DB) {
+		_, span := tracer().Start(db.Statement.Context, "db", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(p.system))
+		db.InstanceSet(tracingSpanKey, span)
+	}
+	after := func(db *gorm.DB) {
+		v, ok := db.InstanceGet(tracingSpanKey)
+		if !ok {
+			return
+		}
+		span := v.(trace.Span)
+
+		statement := db.Statement.SQL.String()
+		operation, _, _ := strings.Cut(strings.TrimSpace(statement), " ")
+		operation = strings.ToUpper(operation)
+		if len(statement) > maxTracedStatementLength {
+			statement = statement[:maxTracedStatementLength]
+		}
+		summary := operation
+		if db.Statement.Table != "" {
+			summary += " " + db.Statement.Table
+			span.SetAttributes(semconv.DBSQLTable(db.Statement.Table))
+		}
+		span.SetName(summary)
+		span.SetAttributes(semconv.DBOperation(operation), semconv.DBStatement(statement), attribute.Int64("db.rows_affected", db.Statement.RowsAffected))
+
+		err := db.Error
+		if err == gorm.ErrRecordNotFound {
+			err = nil
+		}
+		endSpan(span, err)
+	}
+
+	cb := db.Callback()
+	registrations := []func() error{
+		func() error { return cb.Create().Before("*").Register("tracing:before_create", before) },
+		func() error { return cb.Create().After("*").Register("tracing:after_create", after) },
+		func() error { return cb.Query().Before("*").Register("tracing:before_query", before) },
+		func() error { return cb.Query().After("*").Register("tracing:after_query", after) },
+		func() error { return cb.Update().Before("*").Register("tracing:before_update", before) },
+		func() error { return cb.Update().After("*").Register("tracing:after_update", after) },
+		func() error { return cb.Delete().Before("*").Register("tracing:before_delete", before) },
+		func() error { return cb.Delete().After("*").Register("tracing:after_delete", after) },
+		func() error { return cb.Row().Before("*").Register("tracing:before_row", before) },
+		func() error { return cb.Row().After("*").Register("tracing:after_row", after) },
+		func() error { return cb.Raw().Before("*").Register("tracing:before_raw", before) },
+		func() error { return cb.Raw().After("*").Register("tracing:after_raw", after) },
+	}
+	for _, register := range registrations {
+		if err := register(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
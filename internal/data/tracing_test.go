@@ -0,0 +1,94 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+)
+
+// recordSpans records the spans of the data layer for the test
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func newTracedMockRepo(t *testing.T) (biz.EmployeeRepo, sqlmock.Sqlmock) {
+	d, mock := newMockData(t)
+	require.NoError(t, d.db.Use(tracingPlugin{system: semconv.DBSystemPostgreSQL}))
+	repo := &employeeRepo{data: d, log: log.NewHelper(log.DefaultLogger)}
+	return newTracedEmployeeRepo(repo, semconv.DBSystemPostgreSQL), mock
+}
+
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestTracing_RepositorySpans(t *testing.T) {
+	recorder := recordSpans(t)
+	repo, mock := newTracedMockRepo(t)
+
+	mock.ExpectQuery(`SELECT \* FROM "employees" WHERE id = \$1 AND tenant_id = \$2`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := repo.GetByID(context.Background(), "tenant-1", uuid.New())
+	assert.Equal(t, biz.ErrEmployeeNotFound, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	statement, method := spans[0], spans[1]
+
+	assert.Equal(t, "employeeRepo.GetByID", method.Name())
+	assert.Equal(t, "tenant-1", spanAttr(method, "tenant.id").AsString())
+	assert.Equal(t, "postgresql", spanAttr(method, semconv.DBSystemKey).AsString())
+
+	assert.Equal(t, "SELECT employees", statement.Name())
+	assert.Equal(t, method.SpanContext().SpanID(), statement.Parent().SpanID())
+	assert.Equal(t, "employees", spanAttr(statement, semconv.DBSQLTableKey).AsString())
+	assert.Contains(t, spanAttr(statement, semconv.DBStatementKey).AsString(), "WHERE id = $1 AND tenant_id = $2")
+	assert.NotContains(t, spanAttr(statement, semconv.DBStatementKey).AsString(), "tenant-1")
+
+	// A missing employee is not a failure
+	assert.Equal(t, codes.Unset, statement.Status().Code)
+	assert.Equal(t, codes.Unset, method.Status().Code)
+	assert.Len(t, method.Events(), 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTracing_RepositoryFailure(t *testing.T) {
+	recorder := recordSpans(t)
+	repo, mock := newTracedMockRepo(t)
+
+	mock.ExpectQuery(`SELECT \* FROM "employees"`).WillReturnError(errors.New("connection reset"))
+
+	_, err := repo.GetByID(context.Background(), "tenant-1", uuid.New())
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	for _, span := range spans {
+		assert.Equal(t, codes.Error, span.Status().Code, span.Name())
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
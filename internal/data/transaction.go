@@ -0,0 +1,40 @@
+package data
+
+import (
+	"context"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// txKey is the context key of the transaction started by Data.Transaction
+type txKey struct{}
+
+// NewTransaction exposes the database transactions of Data to the usecases
+func NewTransaction(d *Data) biz.Transaction {
+	return d
+}
+
+// Transaction runs fn in a database transaction, see biz.Transaction.
+func (d *Data) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return d.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// DB returns the transaction ctx runs in, or the database outside of
+// Data.Transaction. Repositories use it for every statement so that they
+// join the usecase's unit of work.
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return d.db.WithContext(ctx)
+}
+
+// inTransaction reports whether ctx runs in a Data.Transaction
+func inTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(*gorm.DB)
+	return ok
+}
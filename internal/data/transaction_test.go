@@ -0,0 +1,60 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransaction_RepositoriesJoin(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &employeeRepo{data: d}
+	id := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "employee_emails"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	// The repository's own transaction becomes a savepoint
+	mock.ExpectExec(`SAVEPOINT`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT \* FROM "employees"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id"}).AddRow(id, "tenant-1"))
+	mock.ExpectQuery(`SELECT \* FROM "employee_emails"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "tenant_id", "email"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_external_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "system", "external_id"}))
+	mock.ExpectQuery(`SELECT \* FROM "employee_phone_numbers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "number"}))
+	mock.ExpectExec(`DELETE FROM "employees"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO "employee_audit"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "seq", "created_at"}).AddRow(uuid.New(), 1, time.Now()))
+	mock.ExpectExec(`WITH ended AS \(\s+UPDATE employment_history`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err := d.Transaction(context.Background(), func(ctx context.Context) error {
+		assert.True(t, inTransaction(ctx))
+		if _, err := repo.CheckEmailExists(ctx, "tenant-1", "jane@example.com"); err != nil {
+			return err
+		}
+		if err := repo.Delete(ctx, "tenant-1", id); err != nil {
+			return err
+		}
+		return biz.ErrEmployeeNotFound
+	})
+
+	assert.Equal(t, biz.ErrEmployeeNotFound, err, "an error from fn rolls back the whole unit of work")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDB_OutsideTransaction(t *testing.T) {
+	d, _ := newMockData(t)
+
+	assert.False(t, inTransaction(context.Background()))
+	assert.NotNil(t, d.DB(context.Background()))
+}
@@ -0,0 +1,87 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+// APIUsageModel is the GORM model for daily per-tenant request counters
+type APIUsageModel struct {
+	TenantID  string    `gorm:"type:varchar(255);primaryKey"`
+	Day       time.Time `gorm:"type:date;primaryKey"`
+	Operation string    `gorm:"type:varchar(255);primaryKey"`
+	Status    int32     `gorm:"primaryKey"`
+	Count     int64     `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (APIUsageModel) TableName() string {
+	return "employee_api_usage"
+}
+
+type usageRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewUsageRepo creates a new API usage repository.
+func NewUsageRepo(data *Data, logger log.Logger) biz.UsageRepo {
+	return &usageRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Add upserts the counters, adding to the counts already stored
+func (r *usageRepo) Add(ctx context.Context, records []*biz.UsageRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	models := make([]APIUsageModel, len(records))
+	for i, rec := range records {
+		models[i] = APIUsageModel{
+			TenantID:  rec.TenantID,
+			Day:       rec.Day,
+			Operation: rec.Operation,
+			Status:    rec.Status,
+			Count:     rec.Count,
+		}
+	}
+
+	return r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "tenant_id"}, {Name: "day"}, {Name: "operation"}, {Name: "status"}},
+		DoUpdates: clause.Set{{
+			Column: clause.Column{Name: "count"},
+			Value:  clause.Expr{SQL: "employee_api_usage.count + EXCLUDED.count"},
+		}},
+	}).Create(&models).Error
+}
+
+// List returns the counters of a tenant within the days, inclusive
+func (r *usageRepo) List(ctx context.Context, tenantID string, from, to time.Time) ([]*biz.UsageRecord, error) {
+	var models []APIUsageModel
+	if err := r.data.DB(ctx).
+		Where("tenant_id = ? AND day BETWEEN ? AND ?", tenantID, from, to).
+		Order("day, operation, status").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	records := make([]*biz.UsageRecord, len(models))
+	for i, m := range models {
+		records[i] = &biz.UsageRecord{
+			TenantID:  m.TenantID,
+			Day:       m.Day.UTC(),
+			Operation: m.Operation,
+			Status:    m.Status,
+			Count:     m.Count,
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,31 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageRepo_Add(t *testing.T) {
+	d, mock := newMockData(t)
+	repo := &usageRepo{data: d}
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "employee_api_usage" .* ON CONFLICT \("tenant_id","day","operation","status"\) DO UPDATE SET "count"=employee_api_usage.count \+ EXCLUDED.count`).
+		WithArgs("tenant-1", day, "/employee.v1.EmployeeService/GetEmployee", int32(200), int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.Add(context.Background(), []*biz.UsageRecord{
+		{TenantID: "tenant-1", Day: day, Operation: "/employee.v1.EmployeeService/GetEmployee", Status: 200, Count: 3},
+	})
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
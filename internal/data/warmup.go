@@ -0,0 +1,109 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/google/uuid"
+)
+
+// defaultMaxIdleConns is the database/sql default idle connection limit
+const defaultMaxIdleConns = 2
+
+// WarmPool opens n database connections up front so that the first requests
+// do not pay for connection setup, or as many as the pool's open limit allows.
+// The pool's idle limit is raised to n when below it, otherwise the primed
+// connections would be closed right away.
+func (d *Data) WarmPool(ctx context.Context, n int) error {
+	sqlDB, err := d.db.DB()
+	if err != nil {
+		return err
+	}
+
+	if maxOpen := sqlDB.Stats().MaxOpenConnections; maxOpen > 0 && n > maxOpen {
+		n = maxOpen
+	}
+	maxIdle := defaultMaxIdleConns
+	if d.pool != nil && d.pool.maxIdleConns.Load() > 0 {
+		maxIdle = int(d.pool.maxIdleConns.Load())
+	}
+	if n > maxIdle {
+		sqlDB.SetMaxIdleConns(n)
+		// Kept for the pools replacing this one
+		if d.pool != nil {
+			d.pool.maxIdleConns.Store(int32(n))
+		}
+	}
+
+	conns := make([]*sql.Conn, 0, n)
+	defer func() {
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+	}()
+
+	// Hold all connections at once so that each one is a distinct connection
+	for i := 0; i < n; i++ {
+		conn, err := sqlDB.Conn(ctx)
+		if err != nil {
+			return err
+		}
+		conns = append(conns, conn)
+
+		if err := conn.PingContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrimeStatements runs the hot read queries of the employee repository from
+// concurrency goroutines, spreading them over the pooled connections so that
+// each connection has the statements prepared and cached. Queries use an
+// empty tenant and therefore never return data.
+func PrimeStatements(ctx context.Context, repo biz.EmployeeRepo, concurrency int) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := primeEmployeeQueries(ctx, repo); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// primeEmployeeQueries issues one of each hot employee read query
+func primeEmployeeQueries(ctx context.Context, repo biz.EmployeeRepo) error {
+	if _, err := repo.GetByID(ctx, "", uuid.Nil); err != nil && !errors.Is(err, biz.ErrEmployeeNotFound) {
+		return err
+	}
+	if _, err := repo.GetByEmail(ctx, "", ""); err != nil && !errors.Is(err, biz.ErrEmployeeNotFound) {
+		return err
+	}
+	if _, err := repo.CheckEmailExists(ctx, "", ""); err != nil {
+		return err
+	}
+	if _, err := repo.List(ctx, "", &biz.ListFilter{Page: 1, PageSize: 1}); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// errWebhookDestinationNotAllowed is the dial error of a delivery to an
+// address webhooks may not reach
+var errWebhookDestinationNotAllowed = errors.New("webhook destination is not allowed")
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), internal to
+// many cloud networks
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// newWebhookClient returns the HTTP client of webhook deliveries. Webhook URLs
+// are chosen by tenants, so the client only connects to public addresses,
+// checked after DNS resolution, and to the allowed networks of internal
+// receivers. Redirects are not followed: a redirect is an unexpected status.
+// Proxies are not used, since they would connect on the client's behalf.
+func newWebhookClient(timeout time.Duration, allowed []netip.Prefix) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			return checkWebhookDestination(address, allowed)
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// checkWebhookDestination checks the resolved address a delivery connects to
+func checkWebhookDestination(address string, allowed []netip.Prefix) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errWebhookDestinationNotAllowed, address)
+	}
+	ip := addrPort.Addr().Unmap()
+	for _, prefix := range allowed {
+		if prefix.Contains(ip) {
+			return nil
+		}
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() || sharedAddressSpace.Contains(ip) {
+		return fmt.Errorf("%w: %s", errWebhookDestinationNotAllowed, ip)
+	}
+	return nil
+}
+
+// parseWebhookNetworks parses the allowed networks of internal receivers
+func parseWebhookNetworks(networks []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(networks))
+	for _, network := range networks {
+		prefix, err := netip.ParsePrefix(network)
+		if err != nil {
+			return nil, fmt.Errorf("webhooks.allowed_networks: %w", err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
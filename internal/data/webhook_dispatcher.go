@@ -0,0 +1,563 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// defaultWebhookTimeout is the HTTP timeout per delivery attempt
+	defaultWebhookTimeout = 10 * time.Second
+	// defaultWebhookMaxAttempts is the number of attempts before a delivery fails
+	defaultWebhookMaxAttempts = 8
+	// defaultWebhookInitialBackoff is the delay before the first retry
+	defaultWebhookInitialBackoff = 30 * time.Second
+	// defaultWebhookMaxBackoff caps the retry delay
+	defaultWebhookMaxBackoff = time.Hour
+	// webhookFanOutBatchSize is the number of audit entries fanned out per transaction
+	webhookFanOutBatchSize = 500
+	// webhookDeliveryBatchSize is the number of deliveries claimed and sent concurrently
+	webhookDeliveryBatchSize = 20
+	// webhookErrorLimit caps the response body logged and the error text kept
+	// in the delivery log
+	webhookErrorLimit = 1024
+	// webhookDigestMaxEvents caps the events batched into one digest; the rest
+	// of a busy window is sent in further digests of the same window
+	webhookDigestMaxEvents = 1000
+	// webhookTimeLayout formats the timestamps of webhook payloads
+	webhookTimeLayout = "2006-01-02T15:04:05.000000Z"
+)
+
+// webhookFanOutQuery queues one delivery per audit entry whose position is
+// in ((?, ?), (?, ?)] and enabled webhook of the entry's tenant subscribed to
+// its event type. The
+// audit entry ID doubles as the event ID, so re-running a range is a no-op.
+// Changes of employees pending review are skipped; their approval is
+// delivered as employee.created. Deliveries of digest webhooks are queued
+// until BuildDigests batches them.
+const webhookFanOutQuery = `
+INSERT INTO webhook_deliveries (id, tenant_id, webhook_id, event_id, event_type, payload, status, next_attempt_at, created_at, updated_at)
+SELECT gen_random_uuid(), a.tenant_id, w.id, a.id, ev.type,
+       jsonb_build_object(
+           'id', a.id,
+           'type', ev.type,
+           'tenant_id', a.tenant_id,
+           'occurred_at', to_char(a.created_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+           'data', jsonb_build_object(
+               'employee_id', a.employee_id,
+               'employee', a.after,
+               'previous', a.before
+           )
+       ),
+       CASE WHEN w.delivery_mode = 'digest' THEN 'queued' ELSE 'pending' END,
+       CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+FROM employee_audit a
+CROSS JOIN LATERAL (
+    SELECT CASE a.action
+        WHEN 'create' THEN 'employee.created'
+        WHEN 'update' THEN 'employee.updated'
+        WHEN 'delete' THEN 'employee.deleted'
+        WHEN 'merge' THEN 'employee.merged'
+        WHEN 'unmerge' THEN 'employee.unmerged'
+        WHEN 'approve' THEN 'employee.created'
+        WHEN 'deactivate' THEN 'employee.deactivated'
+        WHEN 'reactivate' THEN 'employee.reactivated'
+    END AS type
+) ev
+JOIN webhooks w ON w.tenant_id = a.tenant_id AND w.enabled AND w.event_types @> jsonb_build_array(ev.type)
+WHERE (COALESCE(a.txid, 0), a.seq) > (?, ?) AND (COALESCE(a.txid, 0), a.seq) <= (?, ?)
+  AND (a.action = 'approve' OR NOT (COALESCE(a.before->>'review_status', '') = 'pending' OR COALESCE(a.after->>'review_status', '') = 'pending'))
+ON CONFLICT (webhook_id, event_id) DO NOTHING`
+
+// webhookDigestCandidatesQuery lists the enabled digest webhooks with queued
+// events
+const webhookDigestCandidatesQuery = `
+SELECT w.id, w.tenant_id, w.digest_interval_seconds FROM webhooks w
+WHERE w.enabled AND w.delivery_mode = 'digest'
+  AND EXISTS (SELECT 1 FROM webhook_deliveries d WHERE d.webhook_id = w.id AND d.status = 'queued')`
+
+// webhookDigestCandidate is a digest webhook with queued events
+type webhookDigestCandidate struct {
+	ID                    uuid.UUID
+	TenantID              string
+	DigestIntervalSeconds int64
+}
+
+// webhookDigestPayload is the body of an employee.digest delivery. Events are
+// the payloads the webhook would have received in immediate mode.
+type webhookDigestPayload struct {
+	ID          uuid.UUID         `json:"id"`
+	Type        string            `json:"type"`
+	TenantID    string            `json:"tenant_id"`
+	WindowStart string            `json:"window_start"`
+	WindowEnd   string            `json:"window_end"`
+	Events      []json.RawMessage `json:"events"`
+}
+
+// WebhookDeliveryStats counts the outcome of delivery attempts
+type WebhookDeliveryStats struct {
+	Succeeded int
+	Retried   int
+	Failed    int
+}
+
+// dueWebhookDelivery is a claimed delivery with its webhook's endpoint
+type dueWebhookDelivery struct {
+	WebhookDeliveryModel `gorm:"embedded"`
+	URL                  string
+	Secret               string
+	PayloadTemplate      string
+}
+
+// webhookAttempt is the outcome of one HTTP delivery attempt
+type webhookAttempt struct {
+	status int
+	err    error
+	// permanent failures are not retried, e.g. payloads the webhook's
+	// template cannot render
+	permanent bool
+}
+
+// WebhookDispatcher turns audit entries into webhook deliveries and sends them.
+//
+// Fan-out follows employee_audit with a single cursor on the position of the
+// entries, so every committed mutation is delivered at least once to every
+// matching webhook, in the same way WatchEmployees follows it. Deliveries are claimed with SKIP LOCKED and
+// leased for the duration of an attempt, so several replicas can share the work.
+type WebhookDispatcher struct {
+	data           *Data
+	client         *http.Client
+	timeout        time.Duration
+	maxAttempts    int32
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	// settle is how long fan-out holds back audit entries where the dialect
+	// is not commit ordered
+	settle time.Duration
+	log    *log.Helper
+}
+
+// NewWebhookDispatcher creates the dispatcher, or returns nil when webhooks are disabled
+func NewWebhookDispatcher(c *conf.Data, d *Data, logger log.Logger) *WebhookDispatcher {
+	wc := c.GetWebhooks()
+	if !wc.GetEnabled() {
+		return nil
+	}
+	w := newWebhookDispatcher(d, wc, logger)
+	w.settle = auditSettle(c.GetDatabase())
+	return w
+}
+
+func newWebhookDispatcher(d *Data, wc *conf.Data_Webhooks, logger log.Logger) *WebhookDispatcher {
+	w := &WebhookDispatcher{
+		data:           d,
+		timeout:        defaultWebhookTimeout,
+		maxAttempts:    defaultWebhookMaxAttempts,
+		initialBackoff: defaultWebhookInitialBackoff,
+		maxBackoff:     defaultWebhookMaxBackoff,
+		settle:         defaultAuditSettle,
+		log:            log.NewHelper(logger),
+	}
+	if t := wc.GetTimeout(); t != nil && t.AsDuration() > 0 {
+		w.timeout = t.AsDuration()
+	}
+	if n := wc.GetMaxAttempts(); n > 0 {
+		w.maxAttempts = n
+	}
+	if b := wc.GetInitialBackoff(); b != nil && b.AsDuration() > 0 {
+		w.initialBackoff = b.AsDuration()
+	}
+	if b := wc.GetMaxBackoff(); b != nil && b.AsDuration() > 0 {
+		w.maxBackoff = b.AsDuration()
+	}
+	allowed, err := parseWebhookNetworks(wc.GetAllowedNetworks())
+	if err != nil {
+		// Without the allowed networks internal receivers are refused
+		w.log.Errorf("invalid webhook configuration, no internal receivers allowed: %v", err)
+	}
+	w.client = newWebhookClient(w.timeout, allowed)
+	return w
+}
+
+// backoff returns the delay before the next attempt after the given number of attempts
+func (w *WebhookDispatcher) backoff(attempts int32) time.Duration {
+	delay := w.initialBackoff
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= w.maxBackoff {
+			return w.maxBackoff
+		}
+	}
+	return delay
+}
+
+// FanOut queues deliveries for settled audit entries past the dispatch cursor,
+// batch by batch, and returns the number of deliveries queued.
+func (w *WebhookDispatcher) FanOut(ctx context.Context) (int64, error) {
+	var total int64
+	for {
+		queued, advanced, err := w.fanOutBatch(ctx)
+		total += queued
+		if err != nil || !advanced {
+			return total, err
+		}
+	}
+}
+
+// fanOutBatch queues deliveries for the next batch of audit entries and
+// advances the cursor past them. It reports whether the cursor moved. The
+// batch ends before entries that a transaction in progress could be ordered
+// after, like the entries listed by auditRepo.ListSince.
+func (w *WebhookDispatcher) fanOutBatch(ctx context.Context) (int64, bool, error) {
+	var queued int64
+	var advanced bool
+	err := w.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var after biz.AuditPosition
+		if err := tx.Raw("SELECT txid AS tx_id, seq FROM webhook_dispatch_cursor WHERE id = 1 FOR UPDATE").Scan(&after).Error; err != nil {
+			return err
+		}
+
+		batch := tx.Model(&AuditModel{}).
+			Select("COALESCE(txid, 0) AS tx_id, seq").
+			Where("(COALESCE(txid, 0), seq) > (?, ?)", after.TxID, after.Seq)
+		if w.data.dialect.commitOrdered() {
+			batch = batch.Where(auditCommittedCondition)
+		} else {
+			unsettled := w.data.db.Model(&AuditModel{}).
+				Select("MIN(seq)").
+				Where("seq > ?", after.Seq).
+				Where("created_at > CURRENT_TIMESTAMP - make_interval(secs => ?)", w.settle.Seconds())
+			batch = batch.Where("seq < COALESCE((?), 9223372036854775807)", unsettled)
+		}
+		var positions []biz.AuditPosition
+		if err := batch.Order(auditPositionOrder).Limit(webhookFanOutBatchSize).Scan(&positions).Error; err != nil {
+			return err
+		}
+		if len(positions) == 0 {
+			return nil
+		}
+		upTo := positions[len(positions)-1]
+
+		result := tx.Exec(webhookFanOutQuery, after.TxID, after.Seq, upTo.TxID, upTo.Seq)
+		if result.Error != nil {
+			return result.Error
+		}
+		queued = result.RowsAffected
+		advanced = true
+
+		return tx.Exec("UPDATE webhook_dispatch_cursor SET txid = ?, seq = ? WHERE id = 1", upTo.TxID, upTo.Seq).Error
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return queued, advanced, nil
+}
+
+// BuildDigests batches the queued events of digest webhooks into one
+// employee.digest delivery per closed window, and returns the number of
+// digests queued. Windows are aligned to multiples of the webhook's digest
+// interval, so an hourly digest covers a clock hour.
+func (w *WebhookDispatcher) BuildDigests(ctx context.Context) (int, error) {
+	var candidates []webhookDigestCandidate
+	if err := w.data.db.WithContext(ctx).Raw(webhookDigestCandidatesQuery).Scan(&candidates).Error; err != nil {
+		return 0, err
+	}
+
+	var built int
+	for i := range candidates {
+		for {
+			ok, err := w.buildDigest(ctx, &candidates[i])
+			if err != nil {
+				return built, err
+			}
+			if !ok {
+				break
+			}
+			built++
+		}
+	}
+	return built, nil
+}
+
+// buildDigest turns the queued events of the webhook's oldest closed window
+// into a digest delivery. It reports whether a digest was queued. The webhook
+// row is locked so that only one replica builds its digests at a time.
+func (w *WebhookDispatcher) buildDigest(ctx context.Context, c *webhookDigestCandidate) (bool, error) {
+	interval := time.Duration(c.DigestIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = biz.DefaultWebhookDigestInterval
+	}
+
+	var built bool
+	err := w.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked []uuid.UUID
+		if err := tx.Raw("SELECT id FROM webhooks WHERE id = ? FOR UPDATE SKIP LOCKED", c.ID).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if len(locked) == 0 {
+			return nil
+		}
+
+		var oldest *time.Time
+		if err := tx.Model(&WebhookDeliveryModel{}).
+			Select("MIN(created_at)").
+			Where("webhook_id = ? AND status = ?", c.ID, biz.WebhookDeliveryQueued).
+			Scan(&oldest).Error; err != nil {
+			return err
+		}
+		if oldest == nil {
+			return nil
+		}
+
+		now := w.data.now().UTC()
+		start := oldest.UTC().Truncate(interval)
+		end := start.Add(interval)
+		if end.After(now) {
+			return nil
+		}
+
+		var events []WebhookDeliveryModel
+		if err := tx.Where("webhook_id = ? AND status = ? AND created_at < ?", c.ID, biz.WebhookDeliveryQueued, end).
+			Order("created_at, id").
+			Limit(webhookDigestMaxEvents).
+			Find(&events).Error; err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		digestID := w.data.newID()
+		payload := webhookDigestPayload{
+			ID:          digestID,
+			Type:        biz.WebhookEventDigest,
+			TenantID:    c.TenantID,
+			WindowStart: start.Format(webhookTimeLayout),
+			WindowEnd:   end.Format(webhookTimeLayout),
+			Events:      make([]json.RawMessage, len(events)),
+		}
+		ids := make([]uuid.UUID, len(events))
+		for i := range events {
+			payload.Events[i] = events[i].Payload
+			ids[i] = events[i].ID
+		}
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+
+		// The digest ID doubles as its event ID, deduplicating retries and
+		// replays of the digest
+		if err := tx.Create(&WebhookDeliveryModel{
+			ID:            digestID,
+			TenantID:      c.TenantID,
+			WebhookID:     c.ID,
+			EventID:       digestID,
+			EventType:     biz.WebhookEventDigest,
+			Payload:       body,
+			Status:        biz.WebhookDeliveryPending,
+			NextAttemptAt: now,
+		}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&WebhookDeliveryModel{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"status":     biz.WebhookDeliveryDigested,
+				"digest_id":  digestID,
+				"updated_at": now,
+			}).Error; err != nil {
+			return err
+		}
+		built = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return built, nil
+}
+
+// DeliverDue sends deliveries whose next attempt is due, batch by batch,
+// until none are left.
+func (w *WebhookDispatcher) DeliverDue(ctx context.Context) (WebhookDeliveryStats, error) {
+	var stats WebhookDeliveryStats
+	for {
+		due, err := w.claimDue(ctx)
+		if err != nil {
+			return stats, err
+		}
+		if len(due) == 0 {
+			return stats, nil
+		}
+
+		attempts := make([]webhookAttempt, len(due))
+		var wg sync.WaitGroup
+		for i := range due {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				attempts[i] = w.send(ctx, &due[i])
+			}(i)
+		}
+		wg.Wait()
+
+		// Attempts cut short by shutdown are not counted; their lease expires
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		for i := range due {
+			status, err := w.record(ctx, &due[i].WebhookDeliveryModel, attempts[i])
+			if err != nil {
+				return stats, err
+			}
+			switch status {
+			case biz.WebhookDeliverySucceeded:
+				stats.Succeeded++
+			case biz.WebhookDeliveryFailed:
+				stats.Failed++
+			default:
+				stats.Retried++
+			}
+		}
+
+		if len(due) < webhookDeliveryBatchSize {
+			return stats, nil
+		}
+	}
+}
+
+// claimDue locks a batch of due deliveries of enabled webhooks and leases them
+// for one attempt by moving their next attempt past the HTTP timeout.
+func (w *WebhookDispatcher) claimDue(ctx context.Context) ([]dueWebhookDelivery, error) {
+	var due []dueWebhookDelivery
+	err := w.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&WebhookDeliveryModel{}).
+			Select("webhook_deliveries.*, webhooks.url, webhooks.secret, webhooks.payload_template").
+			Joins("JOIN webhooks ON webhooks.id = webhook_deliveries.webhook_id AND webhooks.enabled").
+			Where("webhook_deliveries.status = ? AND webhook_deliveries.next_attempt_at <= ?", biz.WebhookDeliveryPending, w.data.now().UTC()).
+			Order("webhook_deliveries.next_attempt_at").
+			Limit(webhookDeliveryBatchSize).
+			Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "webhook_deliveries"}, Options: "SKIP LOCKED"}).
+			Scan(&due).Error; err != nil {
+			return err
+		}
+		if len(due) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(due))
+		for i := range due {
+			ids[i] = due[i].ID
+		}
+		return tx.Model(&WebhookDeliveryModel{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", w.data.now().UTC().Add(2*w.timeout)).Error
+	})
+	return due, err
+}
+
+// send POSTs the delivery payload to the webhook, rendered through its payload
+// template if it has one and signed with its secret
+func (w *WebhookDispatcher) send(ctx context.Context, d *dueWebhookDelivery) webhookAttempt {
+	body := d.Payload
+	if d.PayloadTemplate != "" {
+		t, err := biz.ParseWebhookTemplate(d.PayloadTemplate)
+		if err == nil {
+			body, err = biz.RenderWebhookPayload(t, d.Payload)
+		}
+		if err != nil {
+			return webhookAttempt{err: fmt.Errorf("payload template: %w", err), permanent: true}
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(body))
+	if err != nil {
+		return webhookAttempt{err: err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "employee-service-webhooks")
+	req.Header.Set("X-Webhook-ID", d.EventID.String())
+	req.Header.Set("X-Webhook-Event", d.EventType)
+	req.Header.Set("X-Webhook-Signature", biz.SignWebhookPayload(d.Secret, w.data.now(), body))
+
+	resp, err := w.client.Do(req)
+	if errors.Is(err, errWebhookDestinationNotAllowed) {
+		return webhookAttempt{err: errWebhookDestinationNotAllowed, permanent: true}
+	}
+	if err != nil {
+		return webhookAttempt{err: err}
+	}
+	defer resp.Body.Close()
+
+	// The response body stays out of the delivery log, which tenants read:
+	// it is only logged here
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, webhookErrorLimit))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		w.log.WithContext(ctx).Debugf("webhook delivery %s to webhook %s got status %d: %s", d.ID, d.WebhookID, resp.StatusCode, respBody)
+		return webhookAttempt{status: resp.StatusCode, err: fmt.Errorf("unexpected status %d", resp.StatusCode)}
+	}
+	return webhookAttempt{status: resp.StatusCode}
+}
+
+// record stores the outcome of an attempt and schedules the next one, returning
+// the new delivery status
+func (w *WebhookDispatcher) record(ctx context.Context, d *WebhookDeliveryModel, attempt webhookAttempt) (string, error) {
+	now := w.data.now().UTC()
+	attempts := d.Attempts + 1
+	updates := map[string]interface{}{
+		"attempts":        attempts,
+		"response_status": attempt.status,
+		"updated_at":      now,
+	}
+
+	status := biz.WebhookDeliveryPending
+	switch {
+	case attempt.err == nil:
+		status = biz.WebhookDeliverySucceeded
+		updates["last_error"] = ""
+		updates["delivered_at"] = now
+	case attempt.permanent || attempts >= w.maxAttempts:
+		status = biz.WebhookDeliveryFailed
+		updates["last_error"] = truncateWebhookError(attempt.err)
+		w.log.WithContext(ctx).Warnf("webhook delivery %s to webhook %s failed after %d attempts: %v", d.ID, d.WebhookID, attempts, attempt.err)
+	default:
+		updates["last_error"] = truncateWebhookError(attempt.err)
+		updates["next_attempt_at"] = now.Add(w.backoff(attempts))
+	}
+	updates["status"] = status
+
+	if err := w.data.db.WithContext(ctx).
+		Model(&WebhookDeliveryModel{}).
+		Where("id = ?", d.ID).
+		Updates(updates).Error; err != nil {
+		return "", err
+	}
+	return status, nil
+}
+
+// truncateWebhookError renders err for the delivery log
+func truncateWebhookError(err error) string {
+	msg := err.Error()
+	if len(msg) > webhookErrorLimit {
+		msg = msg[:webhookErrorLimit]
+	}
+	return msg
+}
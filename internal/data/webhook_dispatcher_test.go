@@ -0,0 +1,350 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// loopbackWebhooks lets deliveries reach the test servers on loopback
+var loopbackWebhooks = &conf.Data_Webhooks{AllowedNetworks: []string{"127.0.0.0/8", "::1/128"}}
+
+func TestWebhookDispatcher_Backoff(t *testing.T) {
+	w := newWebhookDispatcher(&Data{}, &conf.Data_Webhooks{
+		InitialBackoff: durationpb.New(30 * time.Second),
+		MaxBackoff:     durationpb.New(5 * time.Minute),
+	}, log.NewStdLogger(io.Discard))
+
+	assert.Equal(t, 30*time.Second, w.backoff(1))
+	assert.Equal(t, 60*time.Second, w.backoff(2))
+	assert.Equal(t, 240*time.Second, w.backoff(4))
+	assert.Equal(t, 5*time.Minute, w.backoff(5))
+	assert.Equal(t, 5*time.Minute, w.backoff(20))
+}
+
+func TestWebhookDispatcher_FanOutBatch(t *testing.T) {
+	t.Run("commit ordered", func(t *testing.T) {
+		data, mock := newMockData(t)
+		w := newWebhookDispatcher(data, &conf.Data_Webhooks{}, log.NewStdLogger(io.Discard))
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT txid AS tx_id, seq FROM webhook_dispatch_cursor WHERE id = 1 FOR UPDATE`).
+			WillReturnRows(sqlmock.NewRows([]string{"tx_id", "seq"}).AddRow(int64(100), int64(10)))
+		mock.ExpectQuery(`SELECT COALESCE\(txid, 0\) AS tx_id, seq FROM "employee_audit" WHERE \(COALESCE\(txid, 0\), seq\) > \(\$1, \$2\) `+
+			`AND COALESCE\(txid, 0\) < pg_snapshot_xmin\(pg_current_snapshot\(\)\)::text::bigint ORDER BY COALESCE\(txid, 0\), seq LIMIT \$3`).
+			WithArgs(int64(100), int64(10), webhookFanOutBatchSize).
+			// seq 9 was committed by a slower transaction after seq 11
+			WillReturnRows(sqlmock.NewRows([]string{"tx_id", "seq"}).AddRow(int64(101), int64(11)).AddRow(int64(102), int64(9)))
+		mock.ExpectExec(`INSERT INTO webhook_deliveries .* WHERE \(COALESCE\(a.txid, 0\), a.seq\) > \(\$1, \$2\) AND \(COALESCE\(a.txid, 0\), a.seq\) <= \(\$3, \$4\)`).
+			WithArgs(int64(100), int64(10), int64(102), int64(9)).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(`UPDATE webhook_dispatch_cursor SET txid = \$1, seq = \$2 WHERE id = 1`).
+			WithArgs(int64(102), int64(9)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		queued, advanced, err := w.fanOutBatch(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), queued)
+		assert.True(t, advanced)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("settled", func(t *testing.T) {
+		data, mock := newMockData(t)
+		data.dialect = dialectCockroachDB
+		w := NewWebhookDispatcher(&conf.Data{
+			Database: &conf.Data_Database{AuditSettle: durationpb.New(5 * time.Second)},
+			Webhooks: &conf.Data_Webhooks{Enabled: true},
+		}, data, log.NewStdLogger(io.Discard))
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT txid AS tx_id, seq FROM webhook_dispatch_cursor WHERE id = 1 FOR UPDATE`).
+			WillReturnRows(sqlmock.NewRows([]string{"tx_id", "seq"}).AddRow(int64(0), int64(10)))
+		mock.ExpectQuery(`SELECT COALESCE\(txid, 0\) AS tx_id, seq FROM "employee_audit" WHERE \(COALESCE\(txid, 0\), seq\) > \(\$1, \$2\) `+
+			`AND seq < COALESCE\(\(SELECT MIN\(seq\) FROM "employee_audit" WHERE seq > \$3 AND created_at > CURRENT_TIMESTAMP - make_interval\(secs => \$4\)\), 9223372036854775807\) `+
+			`ORDER BY COALESCE\(txid, 0\), seq LIMIT \$5`).
+			WithArgs(int64(0), int64(10), int64(10), 5.0, webhookFanOutBatchSize).
+			// Nothing has settled yet
+			WillReturnRows(sqlmock.NewRows([]string{"tx_id", "seq"}))
+		mock.ExpectCommit()
+
+		queued, advanced, err := w.fanOutBatch(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, queued)
+		assert.False(t, advanced)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestWebhookDispatcher_SendSignsPayload(t *testing.T) {
+	payload := []byte(`{"id":"1","type":"employee.created"}`)
+	eventID := uuid.New()
+
+	var got *http.Request
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	w := newWebhookDispatcher(&Data{}, loopbackWebhooks, log.NewStdLogger(io.Discard))
+	attempt := w.send(context.Background(), &dueWebhookDelivery{
+		WebhookDeliveryModel: WebhookDeliveryModel{EventID: eventID, EventType: biz.WebhookEventEmployeeCreated, Payload: payload},
+		URL:                  srv.URL,
+		Secret:               "whsec_test",
+	})
+
+	require.NoError(t, attempt.err)
+	assert.Equal(t, http.StatusNoContent, attempt.status)
+	assert.Equal(t, payload, body)
+	assert.Equal(t, "application/json", got.Header.Get("Content-Type"))
+	assert.Equal(t, eventID.String(), got.Header.Get("X-Webhook-ID"))
+	assert.Equal(t, biz.WebhookEventEmployeeCreated, got.Header.Get("X-Webhook-Event"))
+
+	sig := got.Header.Get("X-Webhook-Signature")
+	var ts int64
+	_, err := fmt.Sscanf(sig, "t=%d,", &ts)
+	require.NoError(t, err)
+	assert.Equal(t, biz.SignWebhookPayload("whsec_test", time.Unix(ts, 0), payload), sig)
+}
+
+func TestWebhookDispatcher_SendRejectsNon2xx(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		http.Error(rw, "boom", http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	w := newWebhookDispatcher(&Data{}, loopbackWebhooks, log.NewStdLogger(io.Discard))
+	attempt := w.send(context.Background(), &dueWebhookDelivery{URL: srv.URL, Secret: "s", WebhookDeliveryModel: WebhookDeliveryModel{Payload: []byte(`{}`)}})
+
+	assert.Equal(t, http.StatusBadGateway, attempt.status)
+	assert.EqualError(t, attempt.err, "unexpected status 502")
+}
+
+func TestWebhookDispatcher_SendRefusesInternalDestinations(t *testing.T) {
+	var called bool
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	w := newWebhookDispatcher(&Data{}, &conf.Data_Webhooks{}, log.NewStdLogger(io.Discard))
+	attempt := w.send(context.Background(), &dueWebhookDelivery{URL: srv.URL, Secret: "s", WebhookDeliveryModel: WebhookDeliveryModel{Payload: []byte(`{}`)}})
+
+	assert.True(t, attempt.permanent)
+	assert.ErrorIs(t, attempt.err, errWebhookDestinationNotAllowed)
+	assert.False(t, called)
+}
+
+func TestWebhookDispatcher_SendDoesNotFollowRedirects(t *testing.T) {
+	var redirected bool
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/metadata" {
+			redirected = true
+			return
+		}
+		http.Redirect(rw, r, "/metadata", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	w := newWebhookDispatcher(&Data{}, loopbackWebhooks, log.NewStdLogger(io.Discard))
+	attempt := w.send(context.Background(), &dueWebhookDelivery{URL: srv.URL, Secret: "s", WebhookDeliveryModel: WebhookDeliveryModel{Payload: []byte(`{}`)}})
+
+	assert.Equal(t, http.StatusFound, attempt.status)
+	assert.Error(t, attempt.err)
+	assert.False(t, redirected)
+}
+
+func TestCheckWebhookDestination(t *testing.T) {
+	allowed, err := parseWebhookNetworks([]string{"10.20.0.0/16"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{address: "93.184.216.34:443", allowed: true},
+		{address: "[2606:2800:220:1:248:1893:25c8:1946]:443", allowed: true},
+		{address: "10.20.1.2:80", allowed: true},
+		{address: "10.30.1.2:80"},
+		{address: "127.0.0.1:8000"},
+		{address: "[::1]:8000"},
+		{address: "[::ffff:127.0.0.1]:8000"},
+		{address: "169.254.169.254:80"},
+		{address: "192.168.1.1:80"},
+		{address: "100.100.100.200:80"},
+		{address: "[fd00::1]:80"},
+		{address: "0.0.0.0:80"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			err := checkWebhookDestination(tt.address, allowed)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, errWebhookDestinationNotAllowed)
+			}
+		})
+	}
+
+	_, err = parseWebhookNetworks([]string{"10.20.0.0"})
+	assert.Error(t, err)
+}
+
+func TestWebhookDispatcher_SendRendersPayloadTemplate(t *testing.T) {
+	payload := []byte(`{"id":"1","type":"employee.created","data":{"employee":{"first_name":"Jane"}}}`)
+
+	var body []byte
+	var sig string
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		sig = r.Header.Get("X-Webhook-Signature")
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	w := newWebhookDispatcher(&Data{}, loopbackWebhooks, log.NewStdLogger(io.Discard))
+	attempt := w.send(context.Background(), &dueWebhookDelivery{
+		WebhookDeliveryModel: WebhookDeliveryModel{EventType: biz.WebhookEventEmployeeCreated, Payload: payload},
+		URL:                  srv.URL,
+		Secret:               "whsec_test",
+		PayloadTemplate:      `{"text": {{json (printf "%s: %s" .type .data.employee.first_name)}}}`,
+	})
+
+	require.NoError(t, attempt.err)
+	assert.JSONEq(t, `{"text": "employee.created: Jane"}`, string(body))
+
+	var ts int64
+	_, err := fmt.Sscanf(sig, "t=%d,", &ts)
+	require.NoError(t, err)
+	assert.Equal(t, biz.SignWebhookPayload("whsec_test", time.Unix(ts, 0), body), sig)
+}
+
+func TestWebhookDispatcher_SendTemplateErrorIsPermanent(t *testing.T) {
+	var called bool
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	w := newWebhookDispatcher(&Data{}, loopbackWebhooks, log.NewStdLogger(io.Discard))
+	attempt := w.send(context.Background(), &dueWebhookDelivery{
+		WebhookDeliveryModel: WebhookDeliveryModel{Payload: []byte(`{"type":"employee.created"}`)},
+		URL:                  srv.URL,
+		Secret:               "s",
+		PayloadTemplate:      `{"text": {{.type}}}`,
+	})
+
+	assert.True(t, attempt.permanent)
+	assert.ErrorContains(t, attempt.err, "payload template")
+	assert.False(t, called)
+}
+
+func TestWebhookDispatcher_Record(t *testing.T) {
+	tests := []struct {
+		name       string
+		attempts   int32
+		attempt    webhookAttempt
+		wantStatus string
+	}{
+		{name: "success", attempts: 0, attempt: webhookAttempt{status: 200}, wantStatus: biz.WebhookDeliverySucceeded},
+		{name: "retry", attempts: 1, attempt: webhookAttempt{status: 500, err: errors.New("unexpected status 500")}, wantStatus: biz.WebhookDeliveryPending},
+		{name: "attempts exhausted", attempts: 2, attempt: webhookAttempt{err: errors.New("connection refused")}, wantStatus: biz.WebhookDeliveryFailed},
+		{name: "permanent", attempts: 0, attempt: webhookAttempt{err: errors.New("payload template: invalid"), permanent: true}, wantStatus: biz.WebhookDeliveryFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, mock := newMockData(t)
+			w := newWebhookDispatcher(d, &conf.Data_Webhooks{MaxAttempts: 3}, log.NewStdLogger(io.Discard))
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE "webhook_deliveries" SET .*"status"=`).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			status, err := w.record(context.Background(), &WebhookDeliveryModel{ID: uuid.New(), Attempts: tt.attempts}, tt.attempt)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, status)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWebhookDispatcher_BuildDigest(t *testing.T) {
+	d, mock := newMockData(t)
+	now := time.Date(2026, 1, 2, 4, 0, 30, 0, time.UTC)
+	digestID := uuid.New()
+	d.clock = biz.ClockFunc(func() time.Time { return now })
+	d.ids = biz.IDGeneratorFunc(func() uuid.UUID { return digestID })
+	w := newWebhookDispatcher(d, &conf.Data_Webhooks{}, log.NewStdLogger(io.Discard))
+
+	webhookID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+	events := sqlmock.NewRows([]string{"id", "tenant_id", "webhook_id", "event_type", "payload", "status", "created_at"}).
+		AddRow(first, "tenant-123", webhookID, biz.WebhookEventEmployeeCreated, []byte(`{"id":"1"}`), biz.WebhookDeliveryQueued, now.Add(-50*time.Minute)).
+		AddRow(second, "tenant-123", webhookID, biz.WebhookEventEmployeeUpdated, []byte(`{"id":"2"}`), biz.WebhookDeliveryQueued, now.Add(-10*time.Minute))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM webhooks WHERE id = \$1 FOR UPDATE SKIP LOCKED`).
+		WithArgs(webhookID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(webhookID))
+	mock.ExpectQuery(`SELECT MIN\(created_at\) FROM "webhook_deliveries"`).
+		WithArgs(webhookID, biz.WebhookDeliveryQueued).
+		WillReturnRows(sqlmock.NewRows([]string{"min"}).AddRow(now.Add(-50 * time.Minute)))
+	mock.ExpectQuery(`SELECT \* FROM "webhook_deliveries" WHERE .*created_at < \$3 ORDER BY created_at, id LIMIT \$4`).
+		WithArgs(webhookID, biz.WebhookDeliveryQueued, time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC), webhookDigestMaxEvents).
+		WillReturnRows(events)
+	mock.ExpectExec(`INSERT INTO "webhook_deliveries"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "webhook_deliveries" SET "digest_id"=\$1,"status"=\$2,"updated_at"=\$3 WHERE id IN \(\$4,\$5\)`).
+		WithArgs(digestID, biz.WebhookDeliveryDigested, now, first, second).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	built, err := w.buildDigest(context.Background(), &webhookDigestCandidate{ID: webhookID, TenantID: "tenant-123", DigestIntervalSeconds: 3600})
+
+	require.NoError(t, err)
+	assert.True(t, built)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhookDispatcher_BuildDigestOpenWindow(t *testing.T) {
+	d, mock := newMockData(t)
+	now := time.Date(2026, 1, 2, 3, 59, 0, 0, time.UTC)
+	d.clock = biz.ClockFunc(func() time.Time { return now })
+	w := newWebhookDispatcher(d, &conf.Data_Webhooks{}, log.NewStdLogger(io.Discard))
+	webhookID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FOR UPDATE SKIP LOCKED`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(webhookID))
+	mock.ExpectQuery(`SELECT MIN\(created_at\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"min"}).AddRow(now.Add(-50 * time.Minute)))
+	mock.ExpectCommit()
+
+	built, err := w.buildDigest(context.Background(), &webhookDigestCandidate{ID: webhookID, TenantID: "tenant-123", DigestIntervalSeconds: 3600})
+
+	require.NoError(t, err)
+	assert.False(t, built, "the window of the oldest event closes at 04:00")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,344 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WebhookModel is the GORM model for webhook subscriptions
+type WebhookModel struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TenantID   string    `gorm:"type:varchar(255);not null;index:idx_webhooks_tenant_id"`
+	URL        string    `gorm:"type:text;not null"`
+	Secret     string    `gorm:"type:varchar(255);not null"`
+	EventTypes []byte    `gorm:"type:jsonb;not null"`
+	Enabled    bool      `gorm:"not null"`
+	// DeliveryMode is immediate or digest
+	DeliveryMode          string    `gorm:"type:varchar(16);not null"`
+	DigestIntervalSeconds int64     `gorm:"not null"`
+	PayloadTemplate       string    `gorm:"type:text;not null;default:''"`
+	CreatedAt             time.Time `gorm:"autoCreateTime"`
+	UpdatedAt             time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName overrides the table name
+func (WebhookModel) TableName() string {
+	return "webhooks"
+}
+
+// ToEntity converts WebhookModel to biz.Webhook
+func (m *WebhookModel) ToEntity() (*biz.Webhook, error) {
+	var eventTypes []string
+	if err := json.Unmarshal(m.EventTypes, &eventTypes); err != nil {
+		return nil, err
+	}
+
+	return &biz.Webhook{
+		ID:              m.ID,
+		TenantID:        m.TenantID,
+		URL:             m.URL,
+		Secret:          m.Secret,
+		EventTypes:      eventTypes,
+		Enabled:         m.Enabled,
+		DeliveryMode:    m.DeliveryMode,
+		DigestInterval:  time.Duration(m.DigestIntervalSeconds) * time.Second,
+		PayloadTemplate: m.PayloadTemplate,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}, nil
+}
+
+// webhookModelFromEntity converts biz.Webhook to WebhookModel
+func webhookModelFromEntity(w *biz.Webhook) (*WebhookModel, error) {
+	eventTypes := w.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	raw, err := json.Marshal(eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookModel{
+		ID:                    w.ID,
+		TenantID:              w.TenantID,
+		URL:                   w.URL,
+		Secret:                w.Secret,
+		EventTypes:            raw,
+		Enabled:               w.Enabled,
+		DeliveryMode:          w.DeliveryMode,
+		DigestIntervalSeconds: int64(w.DigestInterval / time.Second),
+		PayloadTemplate:       w.PayloadTemplate,
+		CreatedAt:             w.CreatedAt,
+		UpdatedAt:             w.UpdatedAt,
+	}, nil
+}
+
+// WebhookDeliveryModel is the GORM model for the webhook delivery queue and log
+type WebhookDeliveryModel struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	TenantID       string     `gorm:"type:varchar(255);not null"`
+	WebhookID      uuid.UUID  `gorm:"type:uuid;not null"`
+	EventID        uuid.UUID  `gorm:"type:uuid;not null"`
+	EventType      string     `gorm:"type:varchar(64);not null"`
+	Payload        []byte     `gorm:"type:jsonb;not null"`
+	Status         string     `gorm:"type:varchar(16);not null"`
+	Attempts       int32      `gorm:"not null"`
+	ResponseStatus int32      `gorm:"not null"`
+	LastError      string     `gorm:"type:text;not null"`
+	NextAttemptAt  time.Time  `gorm:"not null"`
+	DeliveredAt    *time.Time `gorm:""`
+	DigestID       *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt      time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time  `gorm:"autoUpdateTime"`
+}
+
+// TableName overrides the table name
+func (WebhookDeliveryModel) TableName() string {
+	return "webhook_deliveries"
+}
+
+// ToEntity converts WebhookDeliveryModel to biz.WebhookDelivery
+func (m *WebhookDeliveryModel) ToEntity() *biz.WebhookDelivery {
+	return &biz.WebhookDelivery{
+		ID:             m.ID,
+		TenantID:       m.TenantID,
+		WebhookID:      m.WebhookID,
+		EventID:        m.EventID,
+		EventType:      m.EventType,
+		Payload:        m.Payload,
+		Status:         m.Status,
+		Attempts:       m.Attempts,
+		ResponseStatus: m.ResponseStatus,
+		LastError:      m.LastError,
+		NextAttemptAt:  m.NextAttemptAt,
+		DeliveredAt:    m.DeliveredAt,
+		DigestID:       m.DigestID,
+		CreatedAt:      m.CreatedAt,
+	}
+}
+
+type webhookRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewWebhookRepo creates a new webhook repository.
+func NewWebhookRepo(data *Data, logger log.Logger) biz.WebhookRepo {
+	return &webhookRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create stores a new webhook.
+func (r *webhookRepo) Create(ctx context.Context, webhook *biz.Webhook) (*biz.Webhook, error) {
+	model, err := webhookModelFromEntity(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.data.DB(ctx).Create(model).Error; err != nil {
+		return nil, err
+	}
+
+	return model.ToEntity()
+}
+
+// Get retrieves a webhook by ID within tenant.
+func (r *webhookRepo) Get(ctx context.Context, tenantID string, id uuid.UUID) (*biz.Webhook, error) {
+	var model WebhookModel
+	err := r.data.DB(ctx).
+		Where("id = ? AND tenant_id = ?", id, tenantID).
+		First(&model).Error
+
+	if err == gorm.ErrRecordNotFound {
+		return nil, biz.ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ToEntity()
+}
+
+// List retrieves all webhooks within tenant, oldest first.
+func (r *webhookRepo) List(ctx context.Context, tenantID string) ([]*biz.Webhook, error) {
+	var models []WebhookModel
+	if err := r.data.DB(ctx).
+		Where("tenant_id = ?", tenantID).
+		Order("created_at").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*biz.Webhook, 0, len(models))
+	for i := range models {
+		webhook, err := models[i].ToEntity()
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+// Update saves the mutable fields of a webhook. Events queued for a digest
+// are released as regular deliveries when the webhook leaves digest mode.
+func (r *webhookRepo) Update(ctx context.Context, webhook *biz.Webhook) (*biz.Webhook, error) {
+	model, err := webhookModelFromEntity(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.data.Transaction(ctx, func(ctx context.Context) error {
+		now := r.data.now()
+		result := r.data.DB(ctx).
+			Model(&WebhookModel{}).
+			Where("id = ? AND tenant_id = ?", webhook.ID, webhook.TenantID).
+			Updates(map[string]interface{}{
+				"url":                     model.URL,
+				"secret":                  model.Secret,
+				"event_types":             model.EventTypes,
+				"enabled":                 model.Enabled,
+				"delivery_mode":           model.DeliveryMode,
+				"digest_interval_seconds": model.DigestIntervalSeconds,
+				"payload_template":        model.PayloadTemplate,
+				"updated_at":              now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrWebhookNotFound
+		}
+
+		if model.DeliveryMode == biz.WebhookDeliveryModeDigest {
+			return nil
+		}
+		return r.data.DB(ctx).
+			Model(&WebhookDeliveryModel{}).
+			Where("webhook_id = ? AND status = ?", webhook.ID, biz.WebhookDeliveryQueued).
+			Updates(map[string]interface{}{
+				"status":          biz.WebhookDeliveryPending,
+				"next_attempt_at": now.UTC(),
+				"updated_at":      now,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, webhook.TenantID, webhook.ID)
+}
+
+// Delete removes a webhook within tenant. Its deliveries are removed by the
+// foreign key cascade.
+func (r *webhookRepo) Delete(ctx context.Context, tenantID string, id uuid.UUID) error {
+	result := r.data.DB(ctx).
+		Where("id = ? AND tenant_id = ?", id, tenantID).
+		Delete(&WebhookModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrWebhookNotFound
+	}
+	return nil
+}
+
+// ListDeliveries retrieves a page of a webhook's deliveries, newest first.
+func (r *webhookRepo) ListDeliveries(ctx context.Context, tenantID string, filter *biz.WebhookDeliveryFilter) ([]*biz.WebhookDelivery, int64, error) {
+	var models []WebhookDeliveryModel
+	var total int64
+
+	query := r.data.DB(ctx).
+		Model(&WebhookDeliveryModel{}).
+		Where("tenant_id = ? AND webhook_id = ?", tenantID, filter.WebhookID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (filter.Page - 1) * filter.PageSize
+	if err := query.
+		Order("created_at DESC").
+		Offset(int(offset)).
+		Limit(int(filter.PageSize)).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	deliveries := make([]*biz.WebhookDelivery, len(models))
+	for i := range models {
+		deliveries[i] = models[i].ToEntity()
+	}
+
+	return deliveries, total, nil
+}
+
+// ListEmployeeDeliveries lists the deliveries of the events of an employee,
+// found through the audit entries they were fanned out from.
+func (r *webhookRepo) ListEmployeeDeliveries(ctx context.Context, tenantID string, employeeID uuid.UUID) ([]*biz.WebhookDelivery, error) {
+	var models []WebhookDeliveryModel
+
+	entries := r.data.DB(ctx).
+		Model(&AuditModel{}).
+		Select("id").
+		Where("tenant_id = ? AND employee_id = ?", tenantID, employeeID)
+	if err := r.data.DB(ctx).
+		Where("tenant_id = ? AND event_id IN (?)", tenantID, entries).
+		Order("created_at").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*biz.WebhookDelivery, len(models))
+	for i := range models {
+		deliveries[i] = models[i].ToEntity()
+	}
+
+	return deliveries, nil
+}
+
+// ReplayDelivery resets a failed delivery to pending with no attempts, keeping
+// the last error until the next attempt.
+func (r *webhookRepo) ReplayDelivery(ctx context.Context, tenantID string, webhookID, id uuid.UUID) (*biz.WebhookDelivery, error) {
+	now := r.data.now()
+	result := r.data.DB(ctx).
+		Model(&WebhookDeliveryModel{}).
+		Where("id = ? AND tenant_id = ? AND webhook_id = ? AND status = ?", id, tenantID, webhookID, biz.WebhookDeliveryFailed).
+		Updates(map[string]interface{}{
+			"status":          biz.WebhookDeliveryPending,
+			"attempts":        0,
+			"next_attempt_at": now.UTC(),
+			"updated_at":      now,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var model WebhookDeliveryModel
+	err := r.data.DB(ctx).
+		Where("id = ? AND tenant_id = ? AND webhook_id = ?", id, tenantID, webhookID).
+		First(&model).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, biz.ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected == 0 {
+		return nil, biz.ErrWebhookDeliveryNotFailed
+	}
+
+	return model.ToEntity(), nil
+}
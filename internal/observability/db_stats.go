@@ -0,0 +1,80 @@
+package observability
+
+import (
+	"database/sql"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// dbStatsCollector reports the statistics of the database connection pool
+// at scrape time. The pool is read through a function so that the pool in
+// use is reported after it has been rotated; the counters then start over
+// from the new pool.
+type dbStatsCollector struct {
+	stats atomic.Pointer[func() sql.DBStats]
+
+	maxOpen           *prometheus.Desc
+	open              *prometheus.Desc
+	inUse             *prometheus.Desc
+	idle              *prometheus.Desc
+	waitCount         *prometheus.Desc
+	waitDuration      *prometheus.Desc
+	maxIdleClosed     *prometheus.Desc
+	maxIdleTimeClosed *prometheus.Desc
+	maxLifetimeClosed *prometheus.Desc
+}
+
+func newDBStatsCollector(namespace, subsystem string) *dbStatsCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, name), help, nil, nil)
+	}
+	return &dbStatsCollector{
+		maxOpen:           desc("db_pool_max_open_connections", "Maximum number of open connections to the database, 0 for unlimited."),
+		open:              desc("db_pool_open_connections", "Number of established connections to the database, in use and idle."),
+		inUse:             desc("db_pool_in_use_connections", "Number of database connections in use."),
+		idle:              desc("db_pool_idle_connections", "Number of idle database connections."),
+		waitCount:         desc("db_pool_wait_count_total", "Number of times a statement waited for a database connection."),
+		waitDuration:      desc("db_pool_wait_duration_seconds_total", "Time spent waiting for a database connection in seconds."),
+		maxIdleClosed:     desc("db_pool_max_idle_closed_total", "Database connections closed because of the idle connection limit."),
+		maxIdleTimeClosed: desc("db_pool_max_idle_time_closed_total", "Database connections closed because of the idle time limit."),
+		maxLifetimeClosed: desc("db_pool_max_lifetime_closed_total", "Database connections closed because of the connection lifetime limit."),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *dbStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxOpen
+	ch <- c.open
+	ch <- c.inUse
+	ch <- c.idle
+	ch <- c.waitCount
+	ch <- c.waitDuration
+	ch <- c.maxIdleClosed
+	ch <- c.maxIdleTimeClosed
+	ch <- c.maxLifetimeClosed
+}
+
+// Collect implements prometheus.Collector. Nothing is reported until a pool
+// is observed.
+func (c *dbStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	fn := c.stats.Load()
+	if fn == nil {
+		return
+	}
+	s := (*fn)()
+	ch <- prometheus.MustNewConstMetric(c.maxOpen, prometheus.GaugeValue, float64(s.MaxOpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.open, prometheus.GaugeValue, float64(s.OpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.inUse, prometheus.GaugeValue, float64(s.InUse))
+	ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(s.Idle))
+	ch <- prometheus.MustNewConstMetric(c.waitCount, prometheus.CounterValue, float64(s.WaitCount))
+	ch <- prometheus.MustNewConstMetric(c.waitDuration, prometheus.CounterValue, s.WaitDuration.Seconds())
+	ch <- prometheus.MustNewConstMetric(c.maxIdleClosed, prometheus.CounterValue, float64(s.MaxIdleClosed))
+	ch <- prometheus.MustNewConstMetric(c.maxIdleTimeClosed, prometheus.CounterValue, float64(s.MaxIdleTimeClosed))
+	ch <- prometheus.MustNewConstMetric(c.maxLifetimeClosed, prometheus.CounterValue, float64(s.MaxLifetimeClosed))
+}
+
+// observe reports the statistics returned by stats from now on
+func (c *dbStatsCollector) observe(stats func() sql.DBStats) {
+	c.stats.Store(&stats)
+}
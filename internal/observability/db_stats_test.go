@@ -0,0 +1,44 @@
+package observability
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBStatsCollector(t *testing.T) {
+	c := newDBStatsCollector("employee_service", "")
+	assert.Equal(t, 0, testutil.CollectAndCount(c), "nothing is reported before a pool is observed")
+
+	c.observe(func() sql.DBStats {
+		return sql.DBStats{MaxOpenConnections: 20, OpenConnections: 5, InUse: 3, Idle: 2, WaitCount: 7, WaitDuration: 1500 * time.Millisecond}
+	})
+	require.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP employee_service_db_pool_in_use_connections Number of database connections in use.
+# TYPE employee_service_db_pool_in_use_connections gauge
+employee_service_db_pool_in_use_connections 3
+# HELP employee_service_db_pool_open_connections Number of established connections to the database, in use and idle.
+# TYPE employee_service_db_pool_open_connections gauge
+employee_service_db_pool_open_connections 5
+# HELP employee_service_db_pool_wait_count_total Number of times a statement waited for a database connection.
+# TYPE employee_service_db_pool_wait_count_total counter
+employee_service_db_pool_wait_count_total 7
+# HELP employee_service_db_pool_wait_duration_seconds_total Time spent waiting for a database connection in seconds.
+# TYPE employee_service_db_pool_wait_duration_seconds_total counter
+employee_service_db_pool_wait_duration_seconds_total 1.5
+`), "employee_service_db_pool_in_use_connections", "employee_service_db_pool_open_connections",
+		"employee_service_db_pool_wait_count_total", "employee_service_db_pool_wait_duration_seconds_total"))
+
+	// The pool replacing the observed one is reported from then on
+	c.observe(func() sql.DBStats { return sql.DBStats{OpenConnections: 1} })
+	require.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP employee_service_db_pool_open_connections Number of established connections to the database, in use and idle.
+# TYPE employee_service_db_pool_open_connections gauge
+employee_service_db_pool_open_connections 1
+`), "employee_service_db_pool_open_connections"))
+}
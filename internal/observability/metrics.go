@@ -10,6 +10,49 @@ import (
 type MetricsProvider struct {
 	Seconds  *prometheus.HistogramVec
 	Requests *prometheus.CounterVec
+	Phases   *prometheus.HistogramVec
+	Cache    *prometheus.CounterVec
+
+	AuditRows      prometheus.Gauge
+	AuditOldestAge prometheus.Gauge
+	AuditArchived  prometheus.Counter
+
+	WebhookDeliveries *prometheus.CounterVec
+
+	OversizedEvents *prometheus.CounterVec
+
+	NATSConnected        *prometheus.GaugeVec
+	NATSConnectionEvents *prometheus.CounterVec
+
+	EmployeeChanges *prometheus.CounterVec
+
+	ImportQueueWaiting        prometheus.Gauge
+	ImportQueueWaitingTenants prometheus.Gauge
+	ImportQueueOldestWait     prometheus.Gauge
+
+	DeprecatedCalls *prometheus.CounterVec
+
+	ShadowReads *prometheus.CounterVec
+
+	TimeoutBudgetExhausted *prometheus.CounterVec
+
+	AuthFailures *prometheus.CounterVec
+
+	ReadAuditEntries *prometheus.CounterVec
+
+	MergesRunning  prometheus.Gauge
+	MergesQueued   prometheus.Gauge
+	MergesRejected *prometheus.CounterVec
+
+	StrictEmailViolations *prometheus.CounterVec
+
+	EmployeesCreated     *prometheus.CounterVec
+	EmployeesMerged      *prometheus.CounterVec
+	EmployeesPerTenant   *prometheus.GaugeVec
+	EventPublishFailures *prometheus.CounterVec
+
+	// dbStats reports the database connection pool once it is observed
+	dbStats *dbStatsCollector
 }
 
 func NewMetricsProvider(namespace, subsystem string) *MetricsProvider {
@@ -28,14 +71,248 @@ func NewMetricsProvider(namespace, subsystem string) *MetricsProvider {
 		Help:      "Total number of requests.",
 	}, []string{"kind", "operation", "code", "reason"})
 
-	prometheus.MustRegister(seconds, requests)
+	phases := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "request_phase_duration_seconds",
+		Help:      "Time spent per request phase (auth, validation, usecase, repo, publish) in seconds.",
+		Buckets:   []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1.0, 2.5},
+	}, []string{"operation", "phase"})
+
+	cache := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "cache_lookups_total",
+		Help:      "Employee cache lookups by operation and result (hit, miss, error).",
+	}, []string{"operation", "result"})
+
+	auditRows := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "audit_log_rows",
+		Help:      "Number of audit log entries kept in the database.",
+	})
+
+	auditOldestAge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "audit_log_oldest_age_seconds",
+		Help:      "Age of the oldest audit log entry kept in the database in seconds.",
+	})
+
+	auditArchived := prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "audit_log_archived_total",
+		Help:      "Total number of audit log entries moved to object storage.",
+	})
+
+	webhookDeliveries := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "webhook_deliveries_total",
+		Help:      "Webhook delivery attempts by result (succeeded, retry, failed).",
+	}, []string{"result"})
+
+	oversizedEvents := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "events_oversized_total",
+		Help:      "Events exceeding the maximum payload size by subject and action (slimmed, dropped).",
+	}, []string{"subject", "action"})
+
+	natsConnected := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "nats_connected",
+		Help:      "1 for the NATS cluster the service is connected to, absent while disconnected.",
+	}, []string{"cluster"})
+
+	natsConnectionEvents := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "nats_connection_events_total",
+		Help:      "NATS connection state changes by cluster and event (connected, disconnected, reconnected, closed).",
+	}, []string{"cluster", "event"})
+
+	employeeChanges := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "employee_changes_total",
+		Help:      "Committed employee changes by event type (employee.created, employee.updated, ...).",
+	}, []string{"type"})
+
+	importQueueWaiting := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "import_queue_waiting",
+		Help:      "Number of runnable imports no worker is running.",
+	})
+
+	importQueueWaitingTenants := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "import_queue_waiting_tenants",
+		Help:      "Number of tenants with a runnable import no worker is running.",
+	})
+
+	importQueueOldestWait := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "import_queue_oldest_wait_seconds",
+		Help:      "How long the import waiting longest for a worker has been runnable in seconds.",
+	})
+
+	deprecatedCalls := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "deprecated_calls_total",
+		Help:      "Calls of deprecated operations by operation and client (the channel claim of the caller).",
+	}, []string{"operation", "client"})
+
+	shadowReads := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "shadow_reads_total",
+		Help:      "Employee reads repeated against the shadow repository by operation and result (match, mismatch, error, skipped).",
+	}, []string{"operation", "result"})
+
+	timeoutBudgetExhausted := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "timeout_budget_exhausted_total",
+		Help:      "Database and NATS calls that ran out of the request deadline by dependency and outcome (skipped before the call, timed_out during it).",
+	}, []string{"dependency", "outcome"})
+
+	authFailures := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "auth_failures_total",
+		Help:      "Requests rejected by authentication by reason (missing_token, malformed_header, malformed_token, invalid_signature, token_expired, token_not_yet_valid, missing_subject, missing_tenant, invalid_issuer, invalid_audience, invalid_api_key, token_revoked).",
+	}, []string{"reason"})
+
+	readAuditEntries := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "read_audit_entries_total",
+		Help:      "Audited employee reads by result (written, dropped when the queue was full, failed to be written).",
+	}, []string{"result"})
+
+	mergesRunning := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "merges_running",
+		Help:      "Number of merges and unmerges holding a slot of the per-tenant merge limit.",
+	})
+
+	mergesQueued := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "merge_queue_depth",
+		Help:      "Number of merges and unmerges waiting for a slot of the per-tenant merge limit.",
+	})
+
+	mergesRejected := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "merges_rejected_total",
+		Help:      "Merges and unmerges rejected by the per-tenant merge limit by reason (queue_full, timeout).",
+	}, []string{"reason"})
+
+	strictEmailViolations := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "email_strict_violations_total",
+		Help:      "New employee emails failing the strict email validation by rule (local_part_length, local_part_dots, domain_without_dot, invalid_tld) and mode (log, enforce).",
+	}, []string{"rule", "mode"})
+
+	employeesCreated := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "employees_created_total",
+		Help:      "Employees created by tenant.",
+	}, []string{"tenant"})
+
+	employeesMerged := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "employees_merged_total",
+		Help:      "Employee merges by tenant.",
+	}, []string{"tenant"})
+
+	employeesPerTenant := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "employees_per_tenant",
+		Help:      "Number of employees by tenant, counted periodically.",
+	}, []string{"tenant"})
+
+	eventPublishFailures := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "event_publish_failures_total",
+		Help:      "Events that failed to be encoded, encrypted or published to NATS by tenant and subject.",
+	}, []string{"tenant", "subject"})
+
+	dbStats := newDBStatsCollector(namespace, subsystem)
+
+	prometheus.MustRegister(seconds, requests, phases, cache, auditRows, auditOldestAge, auditArchived, webhookDeliveries, oversizedEvents,
+		natsConnected, natsConnectionEvents, employeeChanges, importQueueWaiting, importQueueWaitingTenants, importQueueOldestWait,
+		deprecatedCalls, shadowReads, timeoutBudgetExhausted, authFailures, readAuditEntries, mergesRunning, mergesQueued, mergesRejected,
+		strictEmailViolations, employeesCreated, employeesMerged, employeesPerTenant, eventPublishFailures,
+		dbStats)
 
 	return &MetricsProvider{
 		Seconds:  seconds,
 		Requests: requests,
+		Phases:   phases,
+		Cache:    cache,
+
+		AuditRows:      auditRows,
+		AuditOldestAge: auditOldestAge,
+		AuditArchived:  auditArchived,
+
+		WebhookDeliveries: webhookDeliveries,
+
+		OversizedEvents: oversizedEvents,
+
+		NATSConnected:        natsConnected,
+		NATSConnectionEvents: natsConnectionEvents,
+
+		EmployeeChanges: employeeChanges,
+
+		ImportQueueWaiting:        importQueueWaiting,
+		ImportQueueWaitingTenants: importQueueWaitingTenants,
+		ImportQueueOldestWait:     importQueueOldestWait,
+
+		DeprecatedCalls: deprecatedCalls,
+
+		ShadowReads: shadowReads,
+
+		TimeoutBudgetExhausted: timeoutBudgetExhausted,
+
+		AuthFailures: authFailures,
+
+		ReadAuditEntries: readAuditEntries,
+
+		MergesRunning:  mergesRunning,
+		MergesQueued:   mergesQueued,
+		MergesRejected: mergesRejected,
+
+		StrictEmailViolations: strictEmailViolations,
+
+		EmployeesCreated:     employeesCreated,
+		EmployeesMerged:      employeesMerged,
+		EmployeesPerTenant:   employeesPerTenant,
+		EventPublishFailures: eventPublishFailures,
+
+		dbStats: dbStats,
 	}
 }
 
+// MetricsHandler serves the metrics in the format negotiated with the
+// scraper, OpenMetrics included
 func MetricsHandler() http.Handler {
-	return promhttp.Handler()
+	return promhttp.InstrumentMetricHandler(prometheus.DefaultRegisterer,
+		promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{EnableOpenMetrics: true}))
 }
@@ -2,6 +2,7 @@ package observability
 
 import (
 	"context"
+	"database/sql"
 	"github.com/cvele/employee-service/internal/conf"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/google/wire"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 var ProviderSet = wire.NewSet(NewObservability)
@@ -37,6 +39,10 @@ func NewServiceInfo(name ServiceName, version ServiceVersion) *ServiceInfo {
 
 type Observability struct {
 	metrics *MetricsProvider
+	// tenants bounds the tenant label of the per-tenant metrics
+	tenants *tenantLabels
+	// pusher is nil unless metrics are pushed to a Pushgateway
+	pusher  *Pusher
 	tracing *TracingProvider
 	logger  log.Logger
 	conf    *conf.Observability
@@ -50,6 +56,13 @@ func NewObservability(c *conf.Observability, info *ServiceInfo, logger log.Logge
 	}
 
 	cleanup := func() {
+		if o.pusher != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := o.pusher.Stop(ctx); err != nil {
+				logHelper.Errorf("failed to push final metrics: %v", err)
+			}
+		}
 		if o.tracing != nil {
 			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 			defer cancel()
@@ -62,7 +75,18 @@ func NewObservability(c *conf.Observability, info *ServiceInfo, logger log.Logge
 	// Initialize metrics
 	if c.Metrics != nil && c.Metrics.Enabled {
 		o.metrics = NewMetricsProvider(c.Metrics.Namespace, c.Metrics.Subsystem)
+		o.tenants = newTenantLabels(int(c.Metrics.GetMaxTenantLabels()))
 		logHelper.Info("Metrics enabled")
+
+		if push := c.Metrics.GetPush(); push.GetUrl() != "" {
+			job := push.GetJob()
+			if job == "" {
+				job = string(info.Name)
+			}
+			o.pusher = NewPusher(push.GetUrl(), job, push.GetGrouping(), prometheus.DefaultGatherer, logger)
+			o.pusher.Start(push.GetInterval().AsDuration())
+			logHelper.Infof("pushing metrics to %s as job %s", push.GetUrl(), job)
+		}
 	}
 
 	// Initialize tracing
@@ -104,5 +128,236 @@ func (o *Observability) ServerMiddleware() []middleware.Middleware {
 		mws = append(mws, metrics.Server())
 	}
 
+	// Phase timings need the server span and/or the phase histogram
+	if o.tracing != nil || o.metrics != nil {
+		mws = append(mws, o.PhaseTimings())
+	}
+
 	return mws
 }
+
+// RecordCacheLookup counts a cache lookup for the given operation with its
+// result (hit, miss or error). It is a no-op when metrics are disabled.
+func (o *Observability) RecordCacheLookup(operation, result string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.Cache.WithLabelValues(operation, result).Inc()
+}
+
+// RecordAuditLog reports the size of the audit log kept in the database.
+// It is a no-op when metrics are disabled.
+func (o *Observability) RecordAuditLog(rows int64, oldestAge time.Duration, archived int64) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.AuditRows.Set(float64(rows))
+	o.metrics.AuditOldestAge.Set(oldestAge.Seconds())
+	o.metrics.AuditArchived.Add(float64(archived))
+}
+
+// RecordWebhookDeliveries counts webhook delivery attempts by result.
+// It is a no-op when metrics are disabled.
+func (o *Observability) RecordWebhookDeliveries(succeeded, retried, failed int) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.WebhookDeliveries.WithLabelValues("succeeded").Add(float64(succeeded))
+	o.metrics.WebhookDeliveries.WithLabelValues("retry").Add(float64(retried))
+	o.metrics.WebhookDeliveries.WithLabelValues("failed").Add(float64(failed))
+}
+
+// RecordOversizedEvent counts an event that exceeded the maximum payload size
+// and whether it was slimmed or dropped. It is a no-op when metrics are disabled.
+func (o *Observability) RecordOversizedEvent(subject, action string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.OversizedEvents.WithLabelValues(subject, action).Inc()
+}
+
+// NATS connection events
+const (
+	NATSConnected    = "connected"
+	NATSDisconnected = "disconnected"
+	NATSReconnected  = "reconnected"
+	NATSClosed       = "closed"
+)
+
+// RecordNATSConnection counts a NATS connection state change and tracks the
+// cluster currently connected to. It is a no-op when metrics are disabled.
+func (o *Observability) RecordNATSConnection(cluster, event string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.NATSConnectionEvents.WithLabelValues(cluster, event).Inc()
+
+	o.metrics.NATSConnected.Reset()
+	if event == NATSConnected || event == NATSReconnected {
+		o.metrics.NATSConnected.WithLabelValues(cluster).Set(1)
+	}
+}
+
+// RecordEmployeeChange counts a committed employee change of the given event
+// type. It is a no-op when metrics are disabled.
+func (o *Observability) RecordEmployeeChange(eventType string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.EmployeeChanges.WithLabelValues(eventType).Inc()
+}
+
+// RecordImportQueue reports the imports waiting for a worker, the tenants
+// they belong to and the longest wait, which grows when a tenant is starved.
+// It is a no-op when metrics are disabled.
+func (o *Observability) RecordImportQueue(waiting, waitingTenants int64, oldestWait time.Duration) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.ImportQueueWaiting.Set(float64(waiting))
+	o.metrics.ImportQueueWaitingTenants.Set(float64(waitingTenants))
+	o.metrics.ImportQueueOldestWait.Set(oldestWait.Seconds())
+}
+
+// RecordDeprecatedCall counts a call of a deprecated operation by a client.
+// It is a no-op when metrics are disabled.
+func (o *Observability) RecordDeprecatedCall(operation, client string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.DeprecatedCalls.WithLabelValues(operation, client).Inc()
+}
+
+// RecordShadowRead counts a shadowed employee read by operation and result
+// (match, mismatch, error or skipped). It is a no-op when metrics are
+// disabled.
+func (o *Observability) RecordShadowRead(operation, result string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.ShadowReads.WithLabelValues(operation, result).Inc()
+}
+
+// RecordTimeoutBudgetExhausted counts a call of a dependency (database or
+// nats) that ran out of the request deadline, either skipped because too
+// little was left or timed out during the call. It is a no-op when metrics
+// are disabled.
+func (o *Observability) RecordTimeoutBudgetExhausted(dependency, outcome string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.TimeoutBudgetExhausted.WithLabelValues(dependency, outcome).Inc()
+}
+
+// RecordAuthFailure counts a request rejected by authentication by reason.
+// It is a no-op when metrics are disabled.
+func (o *Observability) RecordAuthFailure(reason string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.AuthFailures.WithLabelValues(reason).Inc()
+}
+
+// Results of audited employee reads
+const (
+	ReadAuditWritten = "written"
+	ReadAuditDropped = "dropped"
+	ReadAuditFailed  = "failed"
+)
+
+// RecordReadAudit counts audited employee reads by result (written, dropped
+// or failed). It is a no-op when metrics are disabled.
+func (o *Observability) RecordReadAudit(result string, n int) {
+	if o == nil || o.metrics == nil || n == 0 {
+		return
+	}
+	o.metrics.ReadAuditEntries.WithLabelValues(result).Add(float64(n))
+}
+
+// RecordMergeQueue reports the merges holding a slot of the per-tenant merge
+// limit and those waiting for one. It is a no-op when metrics are disabled.
+func (o *Observability) RecordMergeQueue(running, queued int) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.MergesRunning.Set(float64(running))
+	o.metrics.MergesQueued.Set(float64(queued))
+}
+
+// RecordMergeRejected counts a merge rejected by the per-tenant merge limit
+// by reason. It is a no-op when metrics are disabled.
+func (o *Observability) RecordMergeRejected(reason string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.MergesRejected.WithLabelValues(reason).Inc()
+}
+
+// RecordStrictEmailViolation counts a new employee email failing the strict
+// email validation by rule and mode. It is a no-op when metrics are
+// disabled.
+func (o *Observability) RecordStrictEmailViolation(rule, mode string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.StrictEmailViolations.WithLabelValues(rule, mode).Inc()
+}
+
+// RecordEmployeeCreated counts an employee created in a tenant. It is a
+// no-op when metrics are disabled.
+func (o *Observability) RecordEmployeeCreated(tenantID string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.EmployeesCreated.WithLabelValues(o.tenants.label(tenantID)).Inc()
+}
+
+// RecordEmployeeMerged counts an employee merge in a tenant. It is a no-op
+// when metrics are disabled.
+func (o *Observability) RecordEmployeeMerged(tenantID string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.EmployeesMerged.WithLabelValues(o.tenants.label(tenantID)).Inc()
+}
+
+// RecordEmployeesPerTenant reports the number of employees of each tenant,
+// replacing the previous counts so that purged tenants disappear. It is a
+// no-op when metrics are disabled.
+func (o *Observability) RecordEmployeesPerTenant(counts map[string]int64) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	byLabel := make(map[string]int64, len(counts))
+	for tenantID, n := range counts {
+		byLabel[o.tenants.label(tenantID)] += n
+	}
+	o.metrics.EmployeesPerTenant.Reset()
+	for label, n := range byLabel {
+		o.metrics.EmployeesPerTenant.WithLabelValues(label).Set(float64(n))
+	}
+}
+
+// RecordEventPublishFailure counts an event of a tenant that could not be
+// published on subject. It is a no-op when metrics are disabled.
+func (o *Observability) RecordEventPublishFailure(tenantID, subject string) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.EventPublishFailures.WithLabelValues(o.tenants.label(tenantID), subject).Inc()
+}
+
+// ObserveDBStats reports the database connection pool statistics returned by
+// stats on every scrape, replacing the pool observed before. It is a no-op
+// when metrics are disabled.
+func (o *Observability) ObserveDBStats(stats func() sql.DBStats) {
+	if o == nil || o.metrics == nil {
+		return
+	}
+	o.metrics.dbStats.observe(stats)
+}
+
+// MetricsEnabled reports whether metrics are collected
+func (o *Observability) MetricsEnabled() bool {
+	return o != nil && o.metrics != nil
+}
@@ -0,0 +1,144 @@
+package observability
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Phase is a named part of request handling whose latency is tracked separately
+type Phase int
+
+// Request phases. PhaseUsecase is the time spent in service and business
+// logic itself, excluding the repo and publish phases it triggers.
+const (
+	PhaseAuth Phase = iota
+	PhaseValidation
+	PhaseUsecase
+	PhaseRepo
+	PhasePublish
+	numPhases
+)
+
+// String returns the phase name used in metric labels and span attributes
+func (p Phase) String() string {
+	switch p {
+	case PhaseAuth:
+		return "auth"
+	case PhaseValidation:
+		return "validation"
+	case PhaseUsecase:
+		return "usecase"
+	case PhaseRepo:
+		return "repo"
+	case PhasePublish:
+		return "publish"
+	default:
+		return "unknown"
+	}
+}
+
+type phaseTimingsKey struct{}
+
+// phaseTimings accumulates the time spent per phase during one request.
+// Phases may be entered repeatedly and concurrently, e.g. one repo phase per query.
+type phaseTimings struct {
+	d [numPhases]atomic.Int64
+}
+
+func (t *phaseTimings) add(p Phase, d time.Duration) {
+	t.d[p].Add(int64(d))
+}
+
+func (t *phaseTimings) get(p Phase) time.Duration {
+	return time.Duration(t.d[p].Load())
+}
+
+// StartPhase starts timing a phase of the current request and returns the
+// function that stops it. It is a no-op outside of a request handled by
+// the phase timing middleware.
+func StartPhase(ctx context.Context, p Phase) func() {
+	timings, ok := ctx.Value(phaseTimingsKey{}).(*phaseTimings)
+	if !ok {
+		return func() {}
+	}
+
+	start := time.Now()
+	return func() {
+		timings.add(p, time.Since(start))
+	}
+}
+
+// TimePhase attributes the time a middleware spends before calling the next
+// handler (or before rejecting the request) to the given phase.
+func TimePhase(p Phase, m middleware.Middleware) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			stop := StartPhase(ctx, p)
+			stopped := false
+
+			reply, err := m(func(ctx context.Context, req interface{}) (interface{}, error) {
+				stop()
+				stopped = true
+				return handler(ctx, req)
+			})(ctx, req)
+
+			if !stopped {
+				stop()
+			}
+			return reply, err
+		}
+	}
+}
+
+// PhaseTimings creates a middleware that collects per-phase timings of the
+// request, records them as attributes on the current span and observes
+// them in the phase histogram when metrics are enabled.
+func (o *Observability) PhaseTimings() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			timings := &phaseTimings{}
+			start := time.Now()
+
+			reply, err := handler(context.WithValue(ctx, phaseTimingsKey{}, timings), req)
+
+			// Whatever is not attributed to another phase was spent in the usecase
+			usecase := time.Since(start)
+			for p := Phase(0); p < numPhases; p++ {
+				if p != PhaseUsecase {
+					usecase -= timings.get(p)
+				}
+			}
+			if usecase > 0 {
+				timings.add(PhaseUsecase, usecase)
+			}
+
+			o.recordPhases(ctx, timings)
+			return reply, err
+		}
+	}
+}
+
+// recordPhases exports the collected timings to the trace and metrics
+func (o *Observability) recordPhases(ctx context.Context, timings *phaseTimings) {
+	operation := ""
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		operation = tr.Operation()
+	}
+
+	span := trace.SpanFromContext(ctx)
+	attrs := make([]attribute.KeyValue, 0, numPhases)
+	for p := Phase(0); p < numPhases; p++ {
+		d := timings.get(p)
+		attrs = append(attrs, attribute.Float64("latency."+p.String()+"_ms", float64(d)/float64(time.Millisecond)))
+		if o.metrics != nil && d > 0 {
+			o.metrics.Phases.WithLabelValues(operation, p.String()).Observe(d.Seconds())
+		}
+	}
+	span.SetAttributes(attrs...)
+}
@@ -0,0 +1,80 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartPhase_NoRecorder(t *testing.T) {
+	// Outside of a request StartPhase must be safe to call
+	stop := StartPhase(context.Background(), PhaseRepo)
+	assert.NotPanics(t, stop)
+}
+
+func TestTimePhase(t *testing.T) {
+	sleepy := func(d time.Duration, reject bool) middleware.Middleware {
+		return func(handler middleware.Handler) middleware.Handler {
+			return func(ctx context.Context, req interface{}) (interface{}, error) {
+				time.Sleep(d)
+				if reject {
+					return nil, errors.New("rejected")
+				}
+				return handler(ctx, req)
+			}
+		}
+	}
+
+	t.Run("excludes the next handler", func(t *testing.T) {
+		timings := &phaseTimings{}
+		ctx := context.WithValue(context.Background(), phaseTimingsKey{}, timings)
+
+		handler := TimePhase(PhaseAuth, sleepy(5*time.Millisecond, false))(func(ctx context.Context, req interface{}) (interface{}, error) {
+			time.Sleep(50 * time.Millisecond)
+			return "ok", nil
+		})
+		_, err := handler(ctx, nil)
+
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, timings.get(PhaseAuth), 5*time.Millisecond)
+		assert.Less(t, timings.get(PhaseAuth), 50*time.Millisecond)
+	})
+
+	t.Run("rejection is timed", func(t *testing.T) {
+		timings := &phaseTimings{}
+		ctx := context.WithValue(context.Background(), phaseTimingsKey{}, timings)
+
+		handler := TimePhase(PhaseValidation, sleepy(5*time.Millisecond, true))(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		})
+		_, err := handler(ctx, nil)
+
+		assert.Error(t, err)
+		assert.GreaterOrEqual(t, timings.get(PhaseValidation), 5*time.Millisecond)
+	})
+}
+
+func TestPhaseTimings_UsecaseIsRemainder(t *testing.T) {
+	o := &Observability{}
+	var timings *phaseTimings
+
+	handler := o.PhaseTimings()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		timings = ctx.Value(phaseTimingsKey{}).(*phaseTimings)
+
+		stop := StartPhase(ctx, PhaseRepo)
+		time.Sleep(20 * time.Millisecond)
+		stop()
+		time.Sleep(5 * time.Millisecond)
+		return "ok", nil
+	})
+	_, err := handler(context.Background(), nil)
+
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, timings.get(PhaseRepo), 20*time.Millisecond)
+	assert.GreaterOrEqual(t, timings.get(PhaseUsecase), 5*time.Millisecond)
+	assert.Less(t, timings.get(PhaseUsecase), 20*time.Millisecond)
+}
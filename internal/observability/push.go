@@ -0,0 +1,91 @@
+package observability
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+)
+
+const (
+	defaultPushInterval = 15 * time.Second
+	defaultPushTimeout  = 10 * time.Second
+)
+
+// Pusher pushes the metrics of a process to a Prometheus Pushgateway, so that
+// CLI commands and job workers ending before they are scraped keep their
+// metrics. Every push replaces the metrics previously pushed for the same job
+// and grouping labels.
+type Pusher struct {
+	pusher *push.Pusher
+	log    *log.Helper
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+// NewPusher creates a pusher of the metrics gathered by g to the Pushgateway
+// at url, grouped by job and the grouping labels. The instance label
+// defaults to the host name, so that replicas do not replace each other's
+// metrics.
+func NewPusher(url, job string, grouping map[string]string, g prometheus.Gatherer, logger log.Logger) *Pusher {
+	p := push.New(url, job).Gatherer(g)
+	if _, ok := grouping["instance"]; !ok {
+		if host, err := os.Hostname(); err == nil {
+			p = p.Grouping("instance", host)
+		}
+	}
+	for name, value := range grouping {
+		p = p.Grouping(name, value)
+	}
+	return &Pusher{pusher: p, log: log.NewHelper(logger)}
+}
+
+// Push pushes the current metrics once
+func (p *Pusher) Push(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultPushTimeout)
+	defer cancel()
+	return p.pusher.PushContext(ctx)
+}
+
+// Start pushes the metrics every interval until Stop is called. Failed
+// pushes are logged and retried on the next tick.
+func (p *Pusher) Start(interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultPushInterval
+	}
+	p.stop = make(chan struct{})
+	p.done = make(chan struct{})
+
+	go func() {
+		defer close(p.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.stop:
+				return
+			case <-ticker.C:
+				if err := p.Push(context.Background()); err != nil {
+					p.log.Warnf("failed to push metrics: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops pushing and pushes the final metrics
+func (p *Pusher) Stop(ctx context.Context) error {
+	p.once.Do(func() {
+		if p.stop != nil {
+			close(p.stop)
+			<-p.done
+		}
+	})
+	return p.Push(ctx)
+}
@@ -0,0 +1,80 @@
+package observability
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pushRecorder is a Pushgateway recording the paths pushed to
+type pushRecorder struct {
+	paths chan string
+}
+
+func newPushRecorder(t *testing.T) (*pushRecorder, string) {
+	r := &pushRecorder{paths: make(chan string, 10)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = io.Copy(io.Discard, req.Body)
+		r.paths <- req.Method + " " + req.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	return r, srv.URL
+}
+
+func testGatherer() prometheus.Gatherer {
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "test_total", Help: "Test counter."})
+	counter.Inc()
+	registry.MustRegister(counter)
+	return registry
+}
+
+func TestPusher_Push(t *testing.T) {
+	rec, url := newPushRecorder(t)
+
+	p := NewPusher(url, "migrate", map[string]string{"instance": "job-1", "env": "test"}, testGatherer(), log.NewStdLogger(io.Discard))
+	require.NoError(t, p.Push(context.Background()))
+
+	path := <-rec.paths
+	assert.Contains(t, path, "PUT /metrics/job/migrate/")
+	assert.Contains(t, path, "/instance/job-1")
+	assert.Contains(t, path, "/env/test")
+}
+
+func TestPusher_StartAndStop(t *testing.T) {
+	rec, url := newPushRecorder(t)
+
+	p := NewPusher(url, "worker", nil, testGatherer(), log.NewStdLogger(io.Discard))
+	p.Start(10 * time.Millisecond)
+
+	select {
+	case <-rec.paths:
+	case <-time.After(time.Second):
+		t.Fatal("metrics were not pushed periodically")
+	}
+
+	require.NoError(t, p.Stop(context.Background()))
+	// Stop pushes the final metrics and may be called again
+	require.NoError(t, p.Stop(context.Background()))
+}
+
+func TestMetricsHandler_OpenMetrics(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	rec := httptest.NewRecorder()
+
+	MetricsHandler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "application/openmetrics-text")
+	assert.Contains(t, rec.Body.String(), "# EOF")
+}
@@ -0,0 +1,42 @@
+package observability
+
+import "sync"
+
+const (
+	// defaultMaxTenantLabels is how many tenants are labeled by name
+	defaultMaxTenantLabels = 100
+	// OtherTenant is the tenant label shared by the tenants beyond the
+	// limit
+	OtherTenant = "other"
+)
+
+// tenantLabels bounds the cardinality of the tenant label: the first max
+// tenants seen keep their own label, later ones are labeled OtherTenant
+type tenantLabels struct {
+	max int
+
+	mu   sync.Mutex
+	seen map[string]struct{}
+}
+
+func newTenantLabels(max int) *tenantLabels {
+	if max <= 0 {
+		max = defaultMaxTenantLabels
+	}
+	return &tenantLabels{max: max, seen: make(map[string]struct{})}
+}
+
+// label returns the tenant label of tenantID
+func (t *tenantLabels) label(tenantID string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.seen[tenantID]; ok {
+		return tenantID
+	}
+	if len(t.seen) >= t.max {
+		return OtherTenant
+	}
+	t.seen[tenantID] = struct{}{}
+	return tenantID
+}
@@ -0,0 +1,22 @@
+package observability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTenantLabels(t *testing.T) {
+	labels := newTenantLabels(2)
+
+	assert.Equal(t, "tenant-a", labels.label("tenant-a"))
+	assert.Equal(t, "tenant-b", labels.label("tenant-b"))
+	assert.Equal(t, OtherTenant, labels.label("tenant-c"))
+	// Tenants already labeled keep their label
+	assert.Equal(t, "tenant-a", labels.label("tenant-a"))
+	assert.Equal(t, OtherTenant, labels.label("tenant-c"))
+}
+
+func TestTenantLabels_Default(t *testing.T) {
+	assert.Equal(t, defaultMaxTenantLabels, newTenantLabels(0).max)
+}
@@ -0,0 +1,211 @@
+// Package secrets loads the secrets of the service from files, such as
+// mounted Kubernetes secrets, and Vault instead of the config file.
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultRefreshInterval is how often the JWT secret is reloaded
+	defaultRefreshInterval = time.Minute
+	// defaultJWTSecretGrace is how long a rotated-out JWT secret is accepted
+	defaultJWTSecretGrace = time.Hour
+)
+
+// Source resolves the JWT secret from Vault or auth.jwt_secret_file, and
+// keeps the Vault token alive, so that the secret can be reloaded while the
+// service runs. It resolves the database source again for a rotation of the
+// connection pool.
+type Source struct {
+	// vault is nil without Vault
+	vault     *vaultClient
+	jwtSecret *conf.Secrets_VaultSecret
+	jwtFile   string
+	// database is the database config as configured, nil without database
+	database         *conf.Data_Database
+	databasePassword *conf.Secrets_VaultSecret
+
+	refreshInterval time.Duration
+	jwtSecretGrace  time.Duration
+}
+
+// Load resolves the secrets of bc in place: auth.jwt_secret and the
+// database source and password, from Vault, their *_file variants or the
+// config, by that precedence. It returns the source reloading the JWT
+// secret.
+func Load(ctx context.Context, bc *conf.Bootstrap) (*Source, error) {
+	c := bc.GetSecrets()
+	vault, err := newVaultClient(c.GetVault())
+	if err != nil {
+		return nil, err
+	}
+	s := &Source{
+		vault:           vault,
+		jwtFile:         bc.GetAuth().GetJwtSecretFile(),
+		refreshInterval: defaultRefreshInterval,
+		jwtSecretGrace:  defaultJWTSecretGrace,
+	}
+	if vault != nil && c.GetVault().GetJwtSecret().GetPath() != "" {
+		s.jwtSecret = c.GetVault().GetJwtSecret()
+	}
+	if d := c.GetRefreshInterval().AsDuration(); d > 0 {
+		s.refreshInterval = d
+	}
+	if d := c.GetJwtSecretGrace().AsDuration(); d > 0 {
+		s.jwtSecretGrace = d
+	}
+
+	if vault != nil {
+		if err := vault.login(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	jwtSecret, err := s.JWTSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if jwtSecret != "" {
+		if bc.Auth == nil {
+			bc.Auth = &conf.Auth{}
+		}
+		bc.Auth.JwtSecret = jwtSecret
+	}
+
+	if db := bc.GetData().GetDatabase(); db != nil {
+		s.database = proto.Clone(db).(*conf.Data_Database)
+		s.databasePassword = c.GetVault().GetDatabasePassword()
+		if err := s.loadDatabase(ctx, db, s.databasePassword); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// loadDatabase resolves the source and password of db
+func (s *Source) loadDatabase(ctx context.Context, db *conf.Data_Database, vaultPassword *conf.Secrets_VaultSecret) error {
+	if db.SourceFile != "" {
+		source, err := readFile(db.SourceFile)
+		if err != nil {
+			return fmt.Errorf("data.database.source_file: %w", err)
+		}
+		db.Source = source
+	}
+
+	var password string
+	var err error
+	switch {
+	case s.vault != nil && vaultPassword.GetPath() != "":
+		password, err = s.vault.read(ctx, vaultPassword)
+	case db.PasswordFile != "":
+		password, err = readFile(db.PasswordFile)
+		if err != nil {
+			err = fmt.Errorf("data.database.password_file: %w", err)
+		}
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	db.Source, err = withPassword(db.Source, password)
+	return err
+}
+
+// JWTSecret returns the current JWT secret from Vault or the secret file,
+// empty when it is only configured statically
+func (s *Source) JWTSecret(ctx context.Context) (string, error) {
+	switch {
+	case s.jwtSecret != nil:
+		return s.vault.read(ctx, s.jwtSecret)
+	case s.jwtFile != "":
+		secret, err := readFile(s.jwtFile)
+		if err != nil {
+			return "", fmt.Errorf("auth.jwt_secret_file: %w", err)
+		}
+		return secret, nil
+	default:
+		return "", nil
+	}
+}
+
+// DatabaseSource resolves the database source again from its file, Vault
+// or the config, empty without database
+func (s *Source) DatabaseSource(ctx context.Context) (string, error) {
+	if s.database == nil {
+		return "", nil
+	}
+	db := proto.Clone(s.database).(*conf.Data_Database)
+	if err := s.loadDatabase(ctx, db, s.databasePassword); err != nil {
+		return "", err
+	}
+	return db.Source, nil
+}
+
+// Renew renews the Vault token when it is due, logging in again when it
+// cannot be renewed
+func (s *Source) Renew(ctx context.Context) error {
+	if s.vault == nil {
+		return nil
+	}
+	return s.vault.renew(ctx)
+}
+
+// Refreshes reports whether secrets change while the service runs, i.e.
+// whether Vault or a JWT secret file is used
+func (s *Source) Refreshes() bool {
+	return s.vault != nil || s.jwtFile != ""
+}
+
+// RefreshInterval is how often the JWT secret is reloaded
+func (s *Source) RefreshInterval() time.Duration {
+	return s.refreshInterval
+}
+
+// JWTSecretGrace is how long tokens signed with a rotated-out JWT secret
+// are still accepted
+func (s *Source) JWTSecretGrace() time.Duration {
+	return s.jwtSecretGrace
+}
+
+// readFile reads a secret file, ignoring surrounding whitespace such as the
+// trailing newline of files written by hand
+func readFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	secret := strings.TrimSpace(string(b))
+	if secret == "" {
+		return "", fmt.Errorf("%s is empty", path)
+	}
+	return secret, nil
+}
+
+// withPassword sets the password of a database source, either a URL
+// (postgres://user@host/db) or key/value pairs (host=... user=...)
+func withPassword(source, password string) (string, error) {
+	if strings.Contains(source, "://") {
+		u, err := url.Parse(source)
+		if err != nil {
+			return "", fmt.Errorf("data.database.source: %w", err)
+		}
+		u.User = url.UserPassword(u.User.Username(), password)
+		return u.String(), nil
+	}
+
+	// A later key overrides an earlier one; values are quoted with
+	// backslash escapes
+	escaped := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(password)
+	return strings.TrimSpace(source + " password='" + escaped + "'"), nil
+}
@@ -0,0 +1,198 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// fakeVault serves the Kubernetes login, token renewal and a KV v2 secret
+type fakeVault struct {
+	mu       sync.Mutex
+	jwtKey   string
+	ttl      int64
+	logins   int
+	renewals int
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/v1/auth/kubernetes/login":
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["role"] != "employee-service" || body["jwt"] != "sa-token" {
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": []string{"permission denied"}})
+			return
+		}
+		f.logins++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"auth": map[string]interface{}{"client_token": "s.login", "lease_duration": f.ttl, "renewable": true}})
+	case "/v1/auth/token/renew-self":
+		f.renewals++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"auth": map[string]interface{}{"client_token": "s.login", "lease_duration": 3600, "renewable": true}})
+	case "/v1/secret/data/employee-service":
+		if r.Header.Get("X-Vault-Token") != "s.login" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{
+			"data":     map[string]interface{}{"jwt_secret": f.jwtKey, "db_password": "p@ss'word"},
+			"metadata": map[string]interface{}{"version": 3},
+		}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad_Vault(t *testing.T) {
+	vault := &fakeVault{jwtKey: "vault-secret", ttl: 1}
+	srv := httptest.NewServer(vault)
+	defer srv.Close()
+	bc := &conf.Bootstrap{
+		Auth: &conf.Auth{JwtSecret: "config-secret"},
+		Data: &conf.Data{Database: &conf.Data_Database{Source: "host=db user=app password=old dbname=employees"}},
+		Secrets: &conf.Secrets{Vault: &conf.Secrets_Vault{
+			Address:             srv.URL,
+			KubernetesRole:      "employee-service",
+			KubernetesTokenFile: writeFile(t, "token", "sa-token\n"),
+			JwtSecret:           &conf.Secrets_VaultSecret{Path: "secret/data/employee-service", Key: "jwt_secret"},
+			DatabasePassword:    &conf.Secrets_VaultSecret{Path: "secret/data/employee-service", Key: "db_password"},
+		}},
+	}
+	ctx := context.Background()
+
+	s, err := Load(ctx, bc)
+	require.NoError(t, err)
+
+	assert.Equal(t, "vault-secret", bc.Auth.JwtSecret)
+	cfg, err := pgconn.ParseConfig(bc.Data.Database.Source)
+	require.NoError(t, err)
+	assert.Equal(t, "p@ss'word", cfg.Password)
+	assert.Equal(t, "app", cfg.User)
+	assert.True(t, s.Refreshes())
+
+	// The secret is reloaded, and the token renewed at half its TTL
+	vault.mu.Lock()
+	vault.jwtKey = "rotated-secret"
+	vault.mu.Unlock()
+	time.Sleep(600 * time.Millisecond)
+	require.NoError(t, s.Renew(ctx))
+	secret, err := s.JWTSecret(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "rotated-secret", secret)
+	vault.mu.Lock()
+	defer vault.mu.Unlock()
+	assert.Equal(t, 1, vault.renewals)
+	assert.Equal(t, 1, vault.logins)
+}
+
+func TestLoad_VaultLoginFails(t *testing.T) {
+	srv := httptest.NewServer(&fakeVault{})
+	defer srv.Close()
+	bc := &conf.Bootstrap{Secrets: &conf.Secrets{Vault: &conf.Secrets_Vault{
+		Address:             srv.URL,
+		KubernetesRole:      "other",
+		KubernetesTokenFile: writeFile(t, "token", "sa-token"),
+	}}}
+
+	_, err := Load(context.Background(), bc)
+
+	assert.ErrorContains(t, err, "permission denied")
+}
+
+func TestLoad_Files(t *testing.T) {
+	jwtFile := writeFile(t, "jwt", "file-secret\n")
+	passwordFile := writeFile(t, "password", "s3cret/word")
+	bc := &conf.Bootstrap{
+		Auth: &conf.Auth{JwtSecretFile: jwtFile},
+		Data: &conf.Data{Database: &conf.Data_Database{
+			SourceFile:   writeFile(t, "source", "postgres://app:old@db:5432/employees?sslmode=disable\n"),
+			PasswordFile: passwordFile,
+		}},
+		Secrets: &conf.Secrets{RefreshInterval: durationpb.New(time.Second)},
+	}
+	ctx := context.Background()
+
+	s, err := Load(ctx, bc)
+	require.NoError(t, err)
+
+	assert.Equal(t, "file-secret", bc.Auth.JwtSecret)
+	cfg, err := pgconn.ParseConfig(bc.Data.Database.Source)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret/word", cfg.Password)
+	assert.Equal(t, "app", cfg.User)
+	assert.True(t, s.Refreshes())
+	assert.Equal(t, time.Second, s.RefreshInterval())
+	assert.Equal(t, defaultJWTSecretGrace, s.JWTSecretGrace())
+
+	require.NoError(t, os.WriteFile(jwtFile, []byte("rotated-secret"), 0o600))
+	secret, err := s.JWTSecret(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "rotated-secret", secret)
+
+	// The database source is resolved again with the rotated password
+	require.NoError(t, os.WriteFile(passwordFile, []byte("rotated-password"), 0o600))
+	source, err := s.DatabaseSource(ctx)
+	require.NoError(t, err)
+	cfg, err = pgconn.ParseConfig(source)
+	require.NoError(t, err)
+	assert.Equal(t, "rotated-password", cfg.Password)
+}
+
+func TestLoad_Static(t *testing.T) {
+	bc := &conf.Bootstrap{
+		Auth: &conf.Auth{JwtSecret: "config-secret"},
+		Data: &conf.Data{Database: &conf.Data_Database{Source: "host=db password=postgres"}},
+	}
+
+	s, err := Load(context.Background(), bc)
+	require.NoError(t, err)
+
+	assert.Equal(t, "config-secret", bc.Auth.JwtSecret)
+	assert.Equal(t, "host=db password=postgres", bc.Data.Database.Source)
+	assert.False(t, s.Refreshes())
+
+	source, err := s.DatabaseSource(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "host=db password=postgres", source)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		bc   *conf.Bootstrap
+	}{
+		{name: "vault without credentials", bc: &conf.Bootstrap{Secrets: &conf.Secrets{Vault: &conf.Secrets_Vault{Address: "http://vault:8200"}}}},
+		{name: "missing secret file", bc: &conf.Bootstrap{Auth: &conf.Auth{JwtSecretFile: "/nonexistent/jwt"}}},
+		{name: "empty password file", bc: &conf.Bootstrap{Data: &conf.Data{Database: &conf.Data_Database{PasswordFile: writeFile(t, "password", "\n")}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(context.Background(), tt.bc)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+)
+
+const (
+	defaultVaultTimeout        = 10 * time.Second
+	defaultKubernetesMount     = "kubernetes"
+	defaultKubernetesTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	vaultTokenHeader           = "X-Vault-Token"
+	vaultNamespaceHeader       = "X-Vault-Namespace"
+	maxVaultResponseBytes      = 1 << 20
+)
+
+// vaultClient reads secrets from Vault over its HTTP API. It logs in with a
+// token, a token file or Kubernetes auth, and renews its token once half of
+// its TTL has passed, logging in again when it cannot be renewed.
+type vaultClient struct {
+	c       *conf.Secrets_Vault
+	address string
+	http    *http.Client
+
+	mu        sync.Mutex
+	token     string
+	renewable bool
+	ttl       time.Duration
+	// expiresAt is zero for tokens that do not expire
+	expiresAt time.Time
+}
+
+// vaultAuth is the auth block of Vault login and renewal responses
+type vaultAuth struct {
+	ClientToken   string `json:"client_token"`
+	LeaseDuration int64  `json:"lease_duration"`
+	Renewable     bool   `json:"renewable"`
+}
+
+// vaultResponse is a Vault API response
+type vaultResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Auth   *vaultAuth             `json:"auth"`
+	Errors []string               `json:"errors"`
+}
+
+// newVaultClient creates the client configured in c, nil without address
+func newVaultClient(c *conf.Secrets_Vault) (*vaultClient, error) {
+	if c.GetAddress() == "" {
+		return nil, nil
+	}
+	if c.GetToken() == "" && c.GetTokenFile() == "" && c.GetKubernetesRole() == "" {
+		return nil, fmt.Errorf("secrets.vault: token, token_file or kubernetes_role is required")
+	}
+	timeout := defaultVaultTimeout
+	if d := c.GetTimeout().AsDuration(); d > 0 {
+		timeout = d
+	}
+	return &vaultClient{
+		c:       c,
+		address: strings.TrimSuffix(c.GetAddress(), "/"),
+		http:    &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// login obtains a token: the configured one, the one in token_file or one
+// of a Kubernetes login
+func (v *vaultClient) login(ctx context.Context) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.c.GetToken() == "" && v.c.GetTokenFile() == "" {
+		return v.kubernetesLogin(ctx)
+	}
+
+	token := v.c.GetToken()
+	if token == "" {
+		var err error
+		if token, err = readFile(v.c.GetTokenFile()); err != nil {
+			return fmt.Errorf("vault token: %w", err)
+		}
+	}
+	v.token = token
+	resp, err := v.do(ctx, http.MethodGet, "auth/token/lookup-self", nil)
+	if err != nil {
+		return fmt.Errorf("vault token lookup: %w", err)
+	}
+	ttl, _ := resp.Data["ttl"].(float64)
+	renewable, _ := resp.Data["renewable"].(bool)
+	v.setLease(time.Duration(ttl)*time.Second, renewable)
+	return nil
+}
+
+// kubernetesLogin logs in with the service account token of the pod.
+// v.mu must be held.
+func (v *vaultClient) kubernetesLogin(ctx context.Context) error {
+	tokenFile := v.c.GetKubernetesTokenFile()
+	if tokenFile == "" {
+		tokenFile = defaultKubernetesTokenFile
+	}
+	jwt, err := readFile(tokenFile)
+	if err != nil {
+		return fmt.Errorf("kubernetes service account token: %w", err)
+	}
+	mount := v.c.GetKubernetesMount()
+	if mount == "" {
+		mount = defaultKubernetesMount
+	}
+
+	v.token = ""
+	resp, err := v.do(ctx, http.MethodPost, "auth/"+mount+"/login", map[string]string{"role": v.c.GetKubernetesRole(), "jwt": jwt})
+	if err != nil {
+		return fmt.Errorf("vault kubernetes login: %w", err)
+	}
+	if resp.Auth == nil || resp.Auth.ClientToken == "" {
+		return fmt.Errorf("vault kubernetes login: no token returned")
+	}
+	v.token = resp.Auth.ClientToken
+	v.setLease(time.Duration(resp.Auth.LeaseDuration)*time.Second, resp.Auth.Renewable)
+	return nil
+}
+
+// setLease records the TTL of the token. v.mu must be held.
+func (v *vaultClient) setLease(ttl time.Duration, renewable bool) {
+	v.ttl, v.renewable = ttl, renewable
+	v.expiresAt = time.Time{}
+	if ttl > 0 {
+		v.expiresAt = time.Now().Add(ttl)
+	}
+}
+
+// renew renews the token once half of its TTL has passed, or logs in again
+// when it is not renewable or its renewal fails
+func (v *vaultClient) renew(ctx context.Context) error {
+	v.mu.Lock()
+	if v.expiresAt.IsZero() || time.Until(v.expiresAt) > v.ttl/2 {
+		v.mu.Unlock()
+		return nil
+	}
+	if v.renewable {
+		resp, err := v.do(ctx, http.MethodPost, "auth/token/renew-self", map[string]string{})
+		if err == nil && resp.Auth != nil {
+			v.setLease(time.Duration(resp.Auth.LeaseDuration)*time.Second, resp.Auth.Renewable)
+			v.mu.Unlock()
+			return nil
+		}
+	}
+	v.mu.Unlock()
+	return v.login(ctx)
+}
+
+// read returns the value of a secret. Secrets of the KV v2 engine are
+// unwrapped from their data and metadata.
+func (v *vaultClient) read(ctx context.Context, secret *conf.Secrets_VaultSecret) (string, error) {
+	v.mu.Lock()
+	resp, err := v.do(ctx, http.MethodGet, strings.TrimPrefix(secret.GetPath(), "/"), nil)
+	v.mu.Unlock()
+	if err != nil {
+		return "", fmt.Errorf("vault secret %s: %w", secret.GetPath(), err)
+	}
+
+	data := resp.Data
+	if inner, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"]; ok {
+			data = inner
+		}
+	}
+	value, ok := data[secret.GetKey()].(string)
+	if !ok || value == "" {
+		return "", fmt.Errorf("vault secret %s has no key %q", secret.GetPath(), secret.GetKey())
+	}
+	return value, nil
+}
+
+// do calls the Vault API at /v1/path. v.mu must be held.
+func (v *vaultClient) do(ctx context.Context, method, path string, body interface{}) (*vaultResponse, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, v.address+"/v1/"+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if v.token != "" {
+		req.Header.Set(vaultTokenHeader, v.token)
+	}
+	if ns := v.c.GetNamespace(); ns != "" {
+		req.Header.Set(vaultNamespaceHeader, ns)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := v.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var resp vaultResponse
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxVaultResponseBytes)).Decode(&resp); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("status %d: %w", res.StatusCode, err)
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, fmt.Errorf("status %d: %s", res.StatusCode, strings.Join(resp.Errors, "; "))
+	}
+	return &resp, nil
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/server/middleware"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// initialAPIVersion is the API behavior before versions were negotiated
+const initialAPIVersion = "2026-01-01"
+
+// apiVersionChanges are the changes of the API behavior, oldest first. A
+// change is added with the date it ships on and a Downgrade restoring the
+// previous behavior; handlers then implement the new behavior only.
+var apiVersionChanges = []middleware.APIVersionChange{
+	{
+		Version:     "2026-10-16",
+		Description: "EMPLOYEE_ALREADY_EXISTS is 409 Conflict (gRPC ABORTED) instead of 400 Bad Request",
+		Downgrade:   conflictAsBadRequest(v1.ErrorReason_EMPLOYEE_ALREADY_EXISTS.String()),
+	},
+}
+
+// conflictAsBadRequest downgrades the conflict errors of a reason to the bad
+// requests they were before
+func conflictAsBadRequest(reason string) func(string, interface{}, error) (interface{}, error) {
+	return func(_ string, reply interface{}, err error) (interface{}, error) {
+		if e := errors.FromError(err); e != nil && e.Code == http.StatusConflict && e.Reason == reason {
+			return reply, errors.BadRequest(e.Reason, e.Message).WithMetadata(e.Metadata)
+		}
+		return reply, err
+	}
+}
+
+// apiVersions returns the API versions negotiated by the servers, serving the
+// configured default version to clients not asking for one
+func apiVersions(c *conf.Server) (*middleware.APIVersions, error) {
+	versions := &middleware.APIVersions{
+		Initial: initialAPIVersion,
+		Changes: apiVersionChanges,
+		Default: initialAPIVersion,
+	}
+	if d := c.GetDefaultApiVersion(); d != "" {
+		if !slices.Contains(versions.Supported(), d) {
+			return nil, fmt.Errorf("default_api_version %q is not one of %v", d, versions.Supported())
+		}
+		versions.Default = d
+	}
+	return versions, nil
+}
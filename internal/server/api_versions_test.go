@@ -0,0 +1,38 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIVersions_Default(t *testing.T) {
+	versions, err := apiVersions(&conf.Server{})
+	require.NoError(t, err)
+	assert.Equal(t, initialAPIVersion, versions.Default)
+
+	versions, err = apiVersions(&conf.Server{DefaultApiVersion: "2026-10-16"})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-16", versions.Default)
+
+	_, err = apiVersions(&conf.Server{DefaultApiVersion: "2025-01-01"})
+	assert.Error(t, err)
+}
+
+// Clients of versions before 2026-10-16 keep getting 400 for taken emails
+func TestConflictAsBadRequest(t *testing.T) {
+	downgrade := apiVersionChanges[0].Downgrade
+
+	_, err := downgrade("/employee.v1.EmployeeService/CreateEmployee", nil, biz.ErrEmployeeAlreadyExists)
+	assert.Equal(t, http.StatusBadRequest, int(errors.Code(err)))
+	assert.Equal(t, biz.ErrEmployeeAlreadyExists.Reason, errors.Reason(err))
+
+	_, err = downgrade("/employee.v1.EmployeeService/UpdateEmployee", nil, biz.ErrVersionMismatch)
+	assert.Equal(t, biz.ErrVersionMismatch, err)
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/data"
+	"github.com/cvele/employee-service/internal/observability"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultAuditArchiveInterval is how often the archival job runs
+const defaultAuditArchiveInterval = time.Hour
+
+// AuditArchiveJob periodically archives old audit entries and reports the
+// audit log depth and age
+type AuditArchiveJob struct {
+	archiver *data.AuditArchiver
+	interval time.Duration
+	obs      *observability.Observability
+	log      *log.Helper
+}
+
+// NewAuditArchiveJob creates the archival job. It does nothing when archival is disabled.
+func NewAuditArchiveJob(c *conf.Data, archiver *data.AuditArchiver, obs *observability.Observability, logger log.Logger) *AuditArchiveJob {
+	j := &AuditArchiveJob{
+		archiver: archiver,
+		interval: defaultAuditArchiveInterval,
+		obs:      obs,
+		log:      log.NewHelper(logger),
+	}
+	if interval := c.GetAuditArchive().GetInterval(); interval != nil && interval.AsDuration() > 0 {
+		j.interval = interval.AsDuration()
+	}
+	return j
+}
+
+// Start runs the job in the background until ctx is done. It is meant for kratos.AfterStart.
+func (j *AuditArchiveJob) Start(ctx context.Context) error {
+	if j.archiver == nil {
+		return nil
+	}
+
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.Run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Run archives everything past retention once and refreshes the audit log metrics
+func (j *AuditArchiveJob) Run(ctx context.Context) {
+	start := time.Now()
+	archived, err := j.archiver.Archive(ctx)
+	if err != nil {
+		j.log.Errorf("audit archival failed after %d entries: %v", archived, err)
+	} else if archived > 0 {
+		j.log.Infof("archived %d audit entries in %s", archived, time.Since(start))
+	}
+
+	stats, err := j.archiver.Stats(ctx)
+	if err != nil {
+		j.log.Warnf("failed to read audit log stats: %v", err)
+		return
+	}
+	j.obs.RecordAuditLog(stats.Rows, stats.OldestAge, archived)
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultClonePollInterval is how often the clone worker looks for clones
+const defaultClonePollInterval = 5 * time.Second
+
+// CloneWorker copies tenants into sandbox tenants in the background
+type CloneWorker struct {
+	uc       *biz.TenantCloneUsecase
+	interval time.Duration
+	log      *log.Helper
+}
+
+// NewCloneWorker creates the clone worker.
+func NewCloneWorker(c *conf.Admin, uc *biz.TenantCloneUsecase, logger log.Logger) *CloneWorker {
+	w := &CloneWorker{
+		uc:       uc,
+		interval: defaultClonePollInterval,
+		log:      log.NewHelper(logger),
+	}
+	if interval := c.GetTenantClone().GetPollInterval(); interval != nil && interval.AsDuration() > 0 {
+		w.interval = interval.AsDuration()
+	}
+	return w
+}
+
+// Start runs the worker in the background until ctx is done. It is meant for kratos.AfterStart.
+func (w *CloneWorker) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			w.Run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Run runs the steps of clones until none are left
+func (w *CloneWorker) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		found, err := w.uc.RunNext(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				w.log.Errorf("tenant clone failed: %v", err)
+			}
+			return
+		}
+		if !found {
+			return
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/data"
+	"github.com/cvele/employee-service/internal/observability"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultEmployeeCountInterval is how often employees are counted per tenant
+const defaultEmployeeCountInterval = 5 * time.Minute
+
+// EmployeeCountJob periodically reports the number of employees of each
+// tenant
+type EmployeeCountJob struct {
+	stats    *data.EmployeeStats
+	interval time.Duration
+	obs      *observability.Observability
+	log      *log.Helper
+}
+
+// NewEmployeeCountJob creates the employee count job. It does nothing when metrics are disabled.
+func NewEmployeeCountJob(c *conf.Observability, stats *data.EmployeeStats, obs *observability.Observability, logger log.Logger) *EmployeeCountJob {
+	j := &EmployeeCountJob{
+		stats:    stats,
+		interval: defaultEmployeeCountInterval,
+		obs:      obs,
+		log:      log.NewHelper(logger),
+	}
+	if interval := c.GetMetrics().GetEmployeeCountInterval(); interval != nil && interval.AsDuration() > 0 {
+		j.interval = interval.AsDuration()
+	}
+	return j
+}
+
+// Start runs the job in the background until ctx is done. It is meant for kratos.AfterStart.
+func (j *EmployeeCountJob) Start(ctx context.Context) error {
+	if !j.obs.MetricsEnabled() {
+		return nil
+	}
+
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.Run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Run counts the employees of each tenant once
+func (j *EmployeeCountJob) Run(ctx context.Context) {
+	counts, err := j.stats.CountByTenant(ctx)
+	if err != nil {
+		j.log.Warnf("failed to count employees per tenant: %v", err)
+		return
+	}
+	j.obs.RecordEmployeesPerTenant(counts)
+}
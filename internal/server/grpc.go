@@ -1,12 +1,17 @@
 package server
 
 import (
+	admin "github.com/cvele/employee-service/api/admin/v1"
+	department "github.com/cvele/employee-service/api/department/v1"
 	employee "github.com/cvele/employee-service/api/employee/v1"
+	team "github.com/cvele/employee-service/api/team/v1"
+	webhook "github.com/cvele/employee-service/api/webhook/v1"
+	"github.com/cvele/employee-service/internal/biz"
 	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/data"
 	"github.com/cvele/employee-service/internal/observability"
 	"github.com/cvele/employee-service/internal/server/middleware"
 	"github.com/cvele/employee-service/internal/service"
-	"os"
 
 	"github.com/go-kratos/kratos/v2/log"
 	kratosMiddleware "github.com/go-kratos/kratos/v2/middleware"
@@ -18,35 +23,76 @@ import (
 func NewGRPCServer(
 	c *conf.Server,
 	auth *conf.Auth,
+	verifier *middleware.TokenVerifier,
+	apiKeys *biz.APIKeyUsecase,
+	revocations *biz.TokenRevocationUsecase,
+	signatures *biz.RequestSignatures,
 	obs *observability.Observability,
 	employeeSvc *service.EmployeeService,
+	adminSvc *service.AdminService,
+	webhookSvc *service.WebhookService,
+	departmentSvc *service.DepartmentService,
+	teamSvc *service.TeamService,
+	usage *biz.UsageTracker,
+	inFlight *biz.InFlightRegistry,
+	extras ExtraMiddlewares,
+	d *data.Data,
 	logger log.Logger,
 ) *grpc.Server {
-	// Get JWT secret from environment variable or config
-	jwtSecret := auth.JwtSecret
-	if jwtSecret == "" {
-		jwtSecret = os.Getenv("JWT_SECRET")
+	deprecations, err := deprecationRegistry(c)
+	if err != nil {
+		log.Fatal(err)
 	}
-	if jwtSecret == "" {
-		log.Fatal("JWT_SECRET is not configured")
+	versions, err := apiVersions(c)
+	if err != nil {
+		log.Fatal(err)
+	}
+	exempt, err := exemptOperations(auth)
+	if err != nil {
+		log.Fatal(err)
+	}
+	proxies, err := trustedProxies(c)
+	if err != nil {
+		log.Fatal(err)
+	}
+	tlsConfig, certs, err := grpcTLS(c.GetGrpc().GetTls())
+	if err != nil {
+		log.Fatal(err)
 	}
 
 	// Build middleware chain
 	middlewares := []kratosMiddleware.Middleware{
 		recovery.Recovery(),
 	}
+	middlewares = append(middlewares, extras.at(BeforeObservability)...)
 
 	// Add observability middleware (tracing, logging, metrics)
 	middlewares = append(middlewares, obs.ServerMiddleware()...)
 
 	// Add business middleware
-	middlewares = append(middlewares,
-		middleware.ProtoValidate(),
-		middleware.JWTAuth(jwtSecret),
-	)
+	business := []kratosMiddleware.Middleware{
+		middleware.RequestMetadata(proxies),
+		middleware.APIVersioning(versions),
+		observability.TimePhase(observability.PhaseValidation, middleware.ProtoValidate()),
+	}
+	business = append(business, extras.at(BeforeAuth)...)
+	business = append(business, observability.TimePhase(observability.PhaseAuth, middleware.ExemptOperations(exempt, kratosMiddleware.Chain(
+		middleware.JWTAuth(verifier, revocations, apiKeys, certs, d.ReportAuthFailure),
+		middleware.RequestSigning(signatures, d.ReportAuthFailure),
+		middleware.Impersonation(auth.GetImpersonationRoles(), logger),
+		middleware.InFlight(inFlight),
+		middleware.UsageTracking(usage),
+		middleware.Authorize(rolePermissions(auth)),
+		middleware.RequireScopes(operationScopes()),
+	))))
+	business = append(business, extras.at(AfterAuth)...)
+	business = append(business, middleware.Deprecations(deprecations, obs.RecordDeprecatedCall))
+	middlewares = append(middlewares, business...)
 
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(middlewares...),
+		// Streaming RPCs (WatchEmployees) get the same request ID, validation and auth
+		grpc.StreamInterceptor(middleware.StreamServerInterceptor(business...)),
 	}
 
 	if c.Grpc.Network != "" {
@@ -58,9 +104,16 @@ func NewGRPCServer(
 	if c.Grpc.Timeout != nil {
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.TLSConfig(tlsConfig))
+	}
 
 	srv := grpc.NewServer(opts...)
 	employee.RegisterEmployeeServiceServer(srv, employeeSvc)
+	admin.RegisterAdminServiceServer(srv, adminSvc)
+	webhook.RegisterWebhookServiceServer(srv, webhookSvc)
+	department.RegisterDepartmentServiceServer(srv, departmentSvc)
+	team.RegisterTeamServiceServer(srv, teamSvc)
 
 	return srv
 }
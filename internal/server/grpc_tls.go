@@ -0,0 +1,96 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/server/middleware"
+)
+
+// grpcTLS returns the TLS config of the gRPC server and the identity of its
+// client certificates, both nil when TLS is off
+func grpcTLS(c *conf.Server_GRPC_TLS) (*tls.Config, *middleware.ClientCertIdentity, error) {
+	if c.GetCertFile() == "" {
+		if c.GetClientCaFile() != "" || c.GetCertIdentity() != nil {
+			return nil, nil, fmt.Errorf("grpc.tls: client certificates need cert_file and key_file")
+		}
+		return nil, nil, nil
+	}
+
+	cert := &certReloader{certFile: c.GetCertFile(), keyFile: c.GetKeyFile()}
+	if err := cert.load(); err != nil {
+		return nil, nil, fmt.Errorf("grpc.tls: %w", err)
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12, GetCertificate: cert.getCertificate}
+
+	if c.GetClientCaFile() != "" {
+		pem, err := os.ReadFile(c.GetClientCaFile())
+		if err != nil {
+			return nil, nil, fmt.Errorf("grpc.tls: %w", err)
+		}
+		config.ClientCAs = x509.NewCertPool()
+		if !config.ClientCAs.AppendCertsFromPEM(pem) {
+			return nil, nil, fmt.Errorf("grpc.tls: no certificates found in %s", c.GetClientCaFile())
+		}
+		switch c.GetClientAuth() {
+		case "", "require":
+			config.ClientAuth = tls.RequireAndVerifyClientCert
+		case "request":
+			config.ClientAuth = tls.VerifyClientCertIfGiven
+		default:
+			return nil, nil, fmt.Errorf("grpc.tls: unknown client_auth %q", c.GetClientAuth())
+		}
+	}
+
+	var certs *middleware.ClientCertIdentity
+	if identity := c.GetCertIdentity(); identity != nil {
+		if c.GetClientCaFile() == "" || identity.GetUriPrefix() == "" {
+			return nil, nil, fmt.Errorf("grpc.tls.cert_identity needs client_ca_file and uri_prefix")
+		}
+		certs = middleware.NewClientCertIdentity(identity.GetUriPrefix(), identity.GetRoles())
+	}
+	return config, certs, nil
+}
+
+// certReloader serves the server certificate, reloading it once its file
+// changes, so that short-lived certificates rotate without a restart
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	modTime time.Time
+}
+
+// load reads the certificate and key
+func (r *certReloader) load() error {
+	info, err := os.Stat(r.certFile)
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	r.cert, r.modTime = &cert, info.ModTime()
+	return nil
+}
+
+// getCertificate implements tls.Config.GetCertificate. A certificate that
+// fails to reload, e.g. while being rewritten, is retried on the next
+// handshake while the previous one keeps being served.
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if info, err := os.Stat(r.certFile); err == nil && !info.ModTime().Equal(r.modTime) {
+		_ = r.load()
+	}
+	return r.cert, nil
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCert writes a self-signed certificate for name and its key to dir
+func writeCert(t *testing.T, dir, name string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certFile, keyFile
+}
+
+func TestGRPCTLS(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCert(t, dir, "server")
+	caFile, _ := writeCert(t, dir, "ca")
+
+	t.Run("off", func(t *testing.T) {
+		config, certs, err := grpcTLS(nil)
+		require.NoError(t, err)
+		assert.Nil(t, config)
+		assert.Nil(t, certs)
+	})
+
+	t.Run("server only", func(t *testing.T) {
+		config, certs, err := grpcTLS(&conf.Server_GRPC_TLS{CertFile: certFile, KeyFile: keyFile})
+		require.NoError(t, err)
+		assert.Equal(t, tls.NoClientCert, config.ClientAuth)
+		assert.Nil(t, certs)
+	})
+
+	t.Run("mutual", func(t *testing.T) {
+		config, certs, err := grpcTLS(&conf.Server_GRPC_TLS{
+			CertFile:     certFile,
+			KeyFile:      keyFile,
+			ClientCaFile: caFile,
+			CertIdentity: &conf.Server_GRPC_TLS_CertIdentity{UriPrefix: "spiffe://corp.internal/tenant/"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tls.RequireAndVerifyClientCert, config.ClientAuth)
+		assert.NotNil(t, config.ClientCAs)
+		assert.NotNil(t, certs)
+
+		config, _, err = grpcTLS(&conf.Server_GRPC_TLS{CertFile: certFile, KeyFile: keyFile, ClientCaFile: caFile, ClientAuth: "request"})
+		require.NoError(t, err)
+		assert.Equal(t, tls.VerifyClientCertIfGiven, config.ClientAuth)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, c := range []*conf.Server_GRPC_TLS{
+			{ClientCaFile: caFile},
+			{CertFile: certFile},
+			{CertFile: certFile, KeyFile: keyFile, ClientCaFile: keyFile},
+			{CertFile: certFile, KeyFile: keyFile, ClientCaFile: caFile, ClientAuth: "optional"},
+			{CertFile: certFile, KeyFile: keyFile, CertIdentity: &conf.Server_GRPC_TLS_CertIdentity{UriPrefix: "spiffe://corp.internal/tenant/"}},
+		} {
+			_, _, err := grpcTLS(c)
+			assert.Error(t, err, c)
+		}
+	})
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCert(t, dir, "server")
+	reloader := &certReloader{certFile: certFile, keyFile: keyFile}
+	require.NoError(t, reloader.load())
+	first, err := reloader.getCertificate(nil)
+	require.NoError(t, err)
+
+	// A rotated certificate is served once its file changes
+	writeCert(t, dir, "server")
+	require.NoError(t, os.Chtimes(certFile, time.Now(), time.Now().Add(time.Minute)))
+	second, err := reloader.getCertificate(nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Certificate[0], second.Certificate[0])
+
+	// A broken rewrite keeps the previous certificate
+	require.NoError(t, os.WriteFile(certFile, []byte("partial"), 0o600))
+	require.NoError(t, os.Chtimes(certFile, time.Now(), time.Now().Add(2*time.Minute)))
+	third, err := reloader.getCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, second, third)
+}
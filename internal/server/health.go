@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/nats-io/nats.go"
@@ -15,6 +16,9 @@ type HealthChecker struct {
 	db     *gorm.DB
 	nc     *nats.Conn
 	logger *log.Helper
+
+	// warmingUp keeps readiness failing until the startup warm-up is done
+	warmingUp atomic.Bool
 }
 
 // NewHealthChecker creates a new health checker
@@ -36,6 +40,10 @@ func (h *HealthChecker) CheckLiveness(ctx context.Context) error {
 // CheckReadiness performs a readiness check on all dependencies
 // This checks if the service is ready to handle requests
 func (h *HealthChecker) CheckReadiness(ctx context.Context) error {
+	if h.warmingUp.Load() {
+		return fmt.Errorf("warming up")
+	}
+
 	// Check database connection
 	if err := h.checkDatabase(ctx); err != nil {
 		h.logger.Warnf("database health check failed: %v", err)
@@ -55,6 +63,11 @@ func (h *HealthChecker) CheckReadiness(ctx context.Context) error {
 	return nil
 }
 
+// SetWarmingUp marks whether the service is still warming up
+func (h *HealthChecker) SetWarmingUp(warmingUp bool) {
+	h.warmingUp.Store(warmingUp)
+}
+
 // checkDatabase verifies the database connection is healthy
 func (h *HealthChecker) checkDatabase(ctx context.Context) error {
 	sqlDB, err := h.db.DB()
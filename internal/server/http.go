@@ -1,12 +1,18 @@
 package server
 
 import (
+	admin "github.com/cvele/employee-service/api/admin/v1"
+	department "github.com/cvele/employee-service/api/department/v1"
 	employee "github.com/cvele/employee-service/api/employee/v1"
+	team "github.com/cvele/employee-service/api/team/v1"
+	webhook "github.com/cvele/employee-service/api/webhook/v1"
+	"github.com/cvele/employee-service/internal/biz"
 	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/data"
 	"github.com/cvele/employee-service/internal/observability"
 	"github.com/cvele/employee-service/internal/server/middleware"
 	"github.com/cvele/employee-service/internal/service"
-	"os"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	kratosMiddleware "github.com/go-kratos/kratos/v2/middleware"
@@ -18,33 +24,68 @@ import (
 func NewHTTPServer(
 	c *conf.Server,
 	auth *conf.Auth,
+	verifier *middleware.TokenVerifier,
+	apiKeys *biz.APIKeyUsecase,
+	revocations *biz.TokenRevocationUsecase,
+	signatures *biz.RequestSignatures,
 	obs *observability.Observability,
 	employeeSvc *service.EmployeeService,
+	adminSvc *service.AdminService,
+	webhookSvc *service.WebhookService,
+	departmentSvc *service.DepartmentService,
+	teamSvc *service.TeamService,
+	usage *biz.UsageTracker,
+	inFlight *biz.InFlightRegistry,
+	extras ExtraMiddlewares,
 	healthChecker *HealthChecker,
+	info *observability.ServiceInfo,
+	d *data.Data,
 	logger log.Logger,
 ) *http.Server {
-	// Get JWT secret from environment variable or config
-	jwtSecret := auth.JwtSecret
-	if jwtSecret == "" {
-		jwtSecret = os.Getenv("JWT_SECRET")
+	deprecations, err := deprecationRegistry(c)
+	if err != nil {
+		log.Fatal(err)
 	}
-	if jwtSecret == "" {
-		log.Fatal("JWT_SECRET is not configured")
+	versions, err := apiVersions(c)
+	if err != nil {
+		log.Fatal(err)
+	}
+	exempt, err := exemptOperations(auth)
+	if err != nil {
+		log.Fatal(err)
+	}
+	proxies, err := trustedProxies(c)
+	if err != nil {
+		log.Fatal(err)
 	}
 
 	// Build middleware chain
 	middlewares := []kratosMiddleware.Middleware{
 		recovery.Recovery(),
 	}
+	middlewares = append(middlewares, extras.at(BeforeObservability)...)
 
 	// Add observability middleware (tracing, logging, metrics)
 	middlewares = append(middlewares, obs.ServerMiddleware()...)
 
 	// Add business middleware
 	middlewares = append(middlewares,
-		middleware.ProtoValidate(),
-		middleware.JWTAuth(jwtSecret),
+		middleware.RequestMetadata(proxies),
+		middleware.APIVersioning(versions),
+		observability.TimePhase(observability.PhaseValidation, middleware.ProtoValidate()),
 	)
+	middlewares = append(middlewares, extras.at(BeforeAuth)...)
+	middlewares = append(middlewares, observability.TimePhase(observability.PhaseAuth, middleware.ExemptOperations(exempt, kratosMiddleware.Chain(
+		middleware.JWTAuth(verifier, revocations, apiKeys, nil, d.ReportAuthFailure),
+		middleware.RequestSigning(signatures, d.ReportAuthFailure),
+		middleware.Impersonation(auth.GetImpersonationRoles(), logger),
+		middleware.InFlight(inFlight),
+		middleware.UsageTracking(usage),
+		middleware.Authorize(rolePermissions(auth)),
+		middleware.RequireScopes(operationScopes()),
+	))))
+	middlewares = append(middlewares, extras.at(AfterAuth)...)
+	middlewares = append(middlewares, middleware.Deprecations(deprecations, obs.RecordDeprecatedCall))
 
 	var opts = []http.ServerOption{
 		http.Middleware(middlewares...),
@@ -64,6 +105,14 @@ func NewHTTPServer(
 
 	// Register service
 	employee.RegisterEmployeeServiceHTTPServer(srv, employeeSvc)
+	admin.RegisterAdminServiceHTTPServer(srv, adminSvc)
+	webhook.RegisterWebhookServiceHTTPServer(srv, webhookSvc)
+	department.RegisterDepartmentServiceHTTPServer(srv, departmentSvc)
+	team.RegisterTeamServiceHTTPServer(srv, teamSvc)
+
+	// Streaming export, registered by hand as HTTP bodies cannot be streamed
+	// through generated handlers
+	srv.Route("/").GET("/api/v1/employees:export", employeeSvc.ExportEmployeesHandler(exportTimeout(c.Http)))
 
 	// Register metrics endpoint (no auth required)
 	srv.Handle("/metrics", observability.MetricsHandler())
@@ -72,5 +121,22 @@ func NewHTTPServer(
 	srv.HandleFunc("/health/live", healthChecker.LivenessHandler())
 	srv.HandleFunc("/health/ready", healthChecker.ReadinessHandler())
 
+	// Register the public event signing keys (no auth required)
+	srv.HandleFunc(EventSigningKeysPath, EventSigningKeysHandler(d.EventSigningKeys()))
+
+	// Register the service and bindings versions (no auth required)
+	srv.HandleFunc(InfoPath, InfoHandler(NewServerInfo(info, versions)))
+
 	return srv
 }
+
+// defaultExportTimeout bounds a streaming export when none is configured
+const defaultExportTimeout = time.Hour
+
+// exportTimeout returns the configured export timeout or the default
+func exportTimeout(c *conf.Server_HTTP) time.Duration {
+	if c.GetExportTimeout() != nil {
+		return c.GetExportTimeout().AsDuration()
+	}
+	return defaultExportTimeout
+}
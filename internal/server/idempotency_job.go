@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultIdempotencyCleanupInterval is how often expired idempotency keys are deleted
+const defaultIdempotencyCleanupInterval = time.Hour
+
+// IdempotencyCleanupJob periodically deletes expired idempotency keys
+type IdempotencyCleanupJob struct {
+	idempotency *biz.Idempotency
+	interval    time.Duration
+	log         *log.Helper
+}
+
+// NewIdempotencyCleanupJob creates the idempotency key cleanup job
+func NewIdempotencyCleanupJob(c *conf.Data, idempotency *biz.Idempotency, logger log.Logger) *IdempotencyCleanupJob {
+	j := &IdempotencyCleanupJob{
+		idempotency: idempotency,
+		interval:    defaultIdempotencyCleanupInterval,
+		log:         log.NewHelper(logger),
+	}
+	if interval := c.GetIdempotency().GetCleanupInterval(); interval != nil && interval.AsDuration() > 0 {
+		j.interval = interval.AsDuration()
+	}
+	return j
+}
+
+// Start runs the job in the background until ctx is done. It is meant for kratos.AfterStart.
+func (j *IdempotencyCleanupJob) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				j.Run(ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+// Run deletes the expired idempotency keys once
+func (j *IdempotencyCleanupJob) Run(ctx context.Context) {
+	deleted, err := j.idempotency.DeleteExpired(ctx)
+	if err != nil {
+		j.log.Errorf("failed to delete expired idempotency keys: %v", err)
+		return
+	}
+	if deleted > 0 {
+		j.log.Infof("deleted %d expired idempotency keys", deleted)
+	}
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/observability"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// defaultImportPollInterval is how often the worker looks for pending imports
+	defaultImportPollInterval = 2 * time.Second
+	// defaultImportWorkers is the number of imports run concurrently
+	defaultImportWorkers = 2
+)
+
+// ImportWorker runs queued bulk imports in the background on a pool of
+// workers. Imports take turns between tenants, see biz.ImportRepo.ClaimNext.
+type ImportWorker struct {
+	uc       *biz.ImportUsecase
+	workers  int
+	interval time.Duration
+	obs      *observability.Observability
+	log      *log.Helper
+}
+
+// NewImportWorker creates the import worker.
+func NewImportWorker(c *conf.Admin, uc *biz.ImportUsecase, obs *observability.Observability, logger log.Logger) *ImportWorker {
+	w := &ImportWorker{
+		uc:       uc,
+		workers:  defaultImportWorkers,
+		interval: defaultImportPollInterval,
+		obs:      obs,
+		log:      log.NewHelper(logger),
+	}
+	if interval := c.GetImport().GetPollInterval(); interval != nil && interval.AsDuration() > 0 {
+		w.interval = interval.AsDuration()
+	}
+	if n := c.GetImport().GetWorkers(); n > 0 {
+		w.workers = int(n)
+	}
+	return w
+}
+
+// Start runs the workers and the queue metrics in the background until ctx
+// is done. It is meant for kratos.AfterStart.
+func (w *ImportWorker) Start(ctx context.Context) error {
+	for range w.workers {
+		go w.poll(ctx, w.Run)
+	}
+	go w.poll(ctx, w.RecordQueue)
+	return nil
+}
+
+// poll calls fn right away and then on every tick until ctx is done
+func (w *ImportWorker) poll(ctx context.Context, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		fn(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run runs queued imports until none are left
+func (w *ImportWorker) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		found, err := w.uc.RunNext(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				w.log.Errorf("import failed: %v", err)
+			}
+			return
+		}
+		if !found {
+			return
+		}
+	}
+}
+
+// RecordQueue reports the imports waiting for a worker
+func (w *ImportWorker) RecordQueue(ctx context.Context) {
+	stats, err := w.uc.QueueStats(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.log.Errorf("failed to read the import queue: %v", err)
+		}
+		return
+	}
+	w.obs.RecordImportQueue(stats.Waiting, stats.WaitingTenants, stats.OldestWait)
+}
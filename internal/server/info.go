@@ -0,0 +1,104 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/cvele/employee-service/internal/observability"
+	"github.com/cvele/employee-service/internal/server/middleware"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// InfoPath is where the service describes its version and the language
+// bindings generated from its protos
+const InfoPath = "/info"
+
+// apiGoPackagePrefix marks the protos of this service among the registered ones
+const apiGoPackagePrefix = "employee-service/api/"
+
+// BindingPackage is a language binding of the API and event protos, published
+// from gen/ with every release
+type BindingPackage struct {
+	Language string `json:"language"`
+	Package  string `json:"package"`
+	Version  string `json:"version"`
+}
+
+// ServerInfo is the body served at InfoPath
+type ServerInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// APIs are the proto packages served and published, e.g. employee.v1
+	APIs     []string         `json:"apis"`
+	Bindings []BindingPackage `json:"bindings"`
+	// APIVersions are the versions of the API behavior clients can select
+	// with the X-API-Version header, oldest first
+	APIVersions []APIVersionInfo `json:"api_versions"`
+	// DefaultAPIVersion is served to clients not sending X-API-Version
+	DefaultAPIVersion string `json:"default_api_version"`
+}
+
+// APIVersionInfo describes a version of the API behavior
+type APIVersionInfo struct {
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// bindingPackages are the packages published by make bindings, as named in
+// gen/python/pyproject.toml and gen/ts/package.json
+var bindingPackages = []BindingPackage{
+	{Language: "python", Package: "employee-service-api"},
+	{Language: "typescript", Package: "@cvele/employee-service-api"},
+}
+
+// NewServerInfo describes the running service. Bindings are released with
+// the service, so their version is the service version without the v prefix.
+func NewServerInfo(info *observability.ServiceInfo, versions *middleware.APIVersions) *ServerInfo {
+	version := string(info.Version)
+	bindings := make([]BindingPackage, len(bindingPackages))
+	for i, b := range bindingPackages {
+		b.Version = strings.TrimPrefix(version, "v")
+		bindings[i] = b
+	}
+
+	apiVersions := []APIVersionInfo{{Version: versions.Initial}}
+	for _, c := range versions.Changes {
+		apiVersions = append(apiVersions, APIVersionInfo{Version: c.Version, Description: c.Description})
+	}
+
+	return &ServerInfo{
+		Name:              string(info.Name),
+		Version:           version,
+		APIs:              apiPackages(),
+		Bindings:          bindings,
+		APIVersions:       apiVersions,
+		DefaultAPIVersion: versions.Default,
+	}
+}
+
+// apiPackages lists the proto packages of this service linked into the binary
+func apiPackages() []string {
+	var packages []string
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		opts, ok := fd.Options().(*descriptorpb.FileOptions)
+		if ok && strings.HasPrefix(opts.GetGoPackage(), apiGoPackagePrefix) {
+			packages = append(packages, string(fd.Package()))
+		}
+		return true
+	})
+	slices.Sort(packages)
+	return slices.Compact(packages)
+}
+
+// InfoHandler serves info as JSON
+func InfoHandler(info *ServerInfo) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(info)
+	}
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/observability"
+	"github.com/cvele/employee-service/internal/server/middleware"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfoHandler(t *testing.T) {
+	versions := &middleware.APIVersions{
+		Initial: "2026-01-01",
+		Changes: []middleware.APIVersionChange{{Version: "2026-10-16", Description: "Conflicts are 409"}},
+		Default: "2026-01-01",
+	}
+	srv := httptest.NewServer(InfoHandler(NewServerInfo(observability.NewServiceInfo("employee-service", "v1.4.2"), versions)))
+	defer srv.Close()
+
+	resp, err := srv.Client().Get(srv.URL + InfoPath)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var info ServerInfo
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&info))
+	assert.Equal(t, "v1.4.2", info.Version)
+	assert.Equal(t, []string{"admin.v1", "department.v1", "employee.v1", "events.v1", "team.v1", "webhook.v1"}, info.APIs)
+	assert.Equal(t, []BindingPackage{
+		{Language: "python", Package: "employee-service-api", Version: "1.4.2"},
+		{Language: "typescript", Package: "@cvele/employee-service-api", Version: "1.4.2"},
+	}, info.Bindings)
+	assert.Equal(t, []APIVersionInfo{{Version: "2026-01-01"}, {Version: "2026-10-16", Description: "Conflicts are 409"}}, info.APIVersions)
+	assert.Equal(t, "2026-01-01", info.DefaultAPIVersion)
+}
+
+// The served package names must be the ones make bindings publishes
+func TestBindingPackagesMatchGen(t *testing.T) {
+	pyproject, err := os.ReadFile("../../gen/python/pyproject.toml")
+	require.NoError(t, err)
+	name := regexp.MustCompile(`(?m)^name = "([^"]+)"`).FindSubmatch(pyproject)
+	require.NotNil(t, name)
+	assert.Equal(t, bindingPackages[0].Package, string(name[1]))
+
+	raw, err := os.ReadFile("../../gen/ts/package.json")
+	require.NoError(t, err)
+	var pkg struct {
+		Name string `json:"name"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &pkg))
+	assert.Equal(t, bindingPackages[1].Package, pkg.Name)
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// APIVersionHeader selects the version of the API behavior a client was
+// written against, for both HTTP and gRPC (as x-api-version metadata)
+const APIVersionHeader = "X-API-Version"
+
+// APIVersionChange is a change of the API behavior, served to clients of its
+// version and later. Handlers implement the latest behavior; Downgrade turns
+// a reply or error back into what clients of earlier versions expect.
+type APIVersionChange struct {
+	// Version names the change, as a YYYY-MM-DD date
+	Version string
+	// Description tells clients what changed
+	Description string
+	// Downgrade shims a reply or error of operation for earlier versions
+	Downgrade func(operation string, reply interface{}, err error) (interface{}, error)
+}
+
+// APIVersions are the versions of the API behavior a server negotiates
+type APIVersions struct {
+	// Initial is the oldest version, the behavior before any change
+	Initial string
+	// Changes are the changes since Initial, oldest first
+	Changes []APIVersionChange
+	// Default is served to clients not sending APIVersionHeader
+	Default string
+}
+
+// Supported returns the negotiable versions, oldest first
+func (v *APIVersions) Supported() []string {
+	versions := []string{v.Initial}
+	for _, c := range v.Changes {
+		versions = append(versions, c.Version)
+	}
+	return versions
+}
+
+// Latest returns the newest version
+func (v *APIVersions) Latest() string {
+	if len(v.Changes) == 0 {
+		return v.Initial
+	}
+	return v.Changes[len(v.Changes)-1].Version
+}
+
+// downgrade applies the changes newer than version to a reply, newest first
+func (v *APIVersions) downgrade(version, operation string, reply interface{}, err error) (interface{}, error) {
+	for i := len(v.Changes) - 1; i >= 0 && v.Changes[i].Version > version; i-- {
+		if c := v.Changes[i]; c.Downgrade != nil {
+			reply, err = c.Downgrade(operation, reply, err)
+		}
+	}
+	return reply, err
+}
+
+// APIVersioning creates a middleware negotiating the API version of each
+// call: the caller's APIVersionHeader, or versions.Default when absent. The
+// version is echoed in the reply header and put into the request metadata,
+// and replies are downgraded to it, so that the behavior a client was written
+// against stays frozen while the API evolves. Unknown versions are rejected.
+// It must run after RequestMetadata.
+func APIVersioning(versions *APIVersions) middleware.Middleware {
+	supported := versions.Supported()
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			version := strings.TrimSpace(tr.RequestHeader().Get(APIVersionHeader))
+			if version == "" {
+				version = versions.Default
+			}
+			if !slices.Contains(supported, version) {
+				return nil, errors.BadRequest("UNSUPPORTED_API_VERSION",
+					fmt.Sprintf("unsupported API version %q, supported versions are %s", version, strings.Join(supported, ", ")))
+			}
+			tr.ReplyHeader().Set(APIVersionHeader, version)
+
+			md := biz.GetRequestMetadata(ctx)
+			md.ClientAPIVersion = version
+			ctx = biz.WithRequestMetadata(ctx, md)
+
+			reply, err := handler(ctx, req)
+			return versions.downgrade(version, tr.Operation(), reply, err)
+		}
+	}
+}
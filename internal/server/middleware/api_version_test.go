@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIVersioning(t *testing.T) {
+	versions := &APIVersions{
+		Initial: "2026-01-01",
+		Changes: []APIVersionChange{
+			{Version: "2026-03-01", Downgrade: func(_ string, reply interface{}, err error) (interface{}, error) {
+				return reply.(string) + " before 2026-03-01", err
+			}},
+			{Version: "2026-06-01", Downgrade: func(_ string, reply interface{}, err error) (interface{}, error) {
+				return reply.(string) + " before 2026-06-01", err
+			}},
+		},
+		Default: "2026-01-01",
+	}
+
+	tests := []struct {
+		name        string
+		header      string
+		wantVersion string
+		wantReply   string
+	}{
+		{name: "default", wantVersion: "2026-01-01", wantReply: "latest before 2026-06-01 before 2026-03-01"},
+		{name: "intermediate", header: "2026-03-01", wantVersion: "2026-03-01", wantReply: "latest before 2026-06-01"},
+		{name: "latest", header: " 2026-06-01 ", wantVersion: "2026-06-01", wantReply: "latest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var negotiated string
+			handler := APIVersioning(versions)(func(ctx context.Context, _ interface{}) (interface{}, error) {
+				negotiated = biz.GetRequestMetadata(ctx).ClientAPIVersion
+				return "latest", nil
+			})
+			reply := &mockHeader{data: map[string][]string{}}
+			tr := &httpReplyTransport{reply: reply}
+			tr.On("RequestHeader").Return(&mockHeader{data: map[string][]string{APIVersionHeader: {tt.header}}})
+
+			got, err := handler(transport.NewServerContext(context.Background(), tr), nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantReply, got)
+			assert.Equal(t, tt.wantVersion, negotiated)
+			assert.Equal(t, tt.wantVersion, reply.Get(APIVersionHeader))
+		})
+	}
+}
+
+func TestAPIVersioning_Unsupported(t *testing.T) {
+	versions := &APIVersions{Initial: "2026-01-01", Default: "2026-01-01"}
+	handler := APIVersioning(versions)(func(context.Context, interface{}) (interface{}, error) {
+		t.Fatal("handler called")
+		return nil, nil
+	})
+	tr := &httpReplyTransport{reply: &mockHeader{data: map[string][]string{}}}
+	tr.On("RequestHeader").Return(&mockHeader{data: map[string][]string{APIVersionHeader: {"2025-12-31"}}})
+
+	_, err := handler(transport.NewServerContext(context.Background(), tr), nil)
+
+	assert.Equal(t, "UNSUPPORTED_API_VERSION", errors.Reason(err))
+}
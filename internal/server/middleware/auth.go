@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/cvele/employee-service/internal/biz"
 
@@ -15,43 +17,167 @@ import (
 
 // JWTClaims represents the claims in JWT token
 type JWTClaims struct {
-	TenantID string `json:"tenant_id"`
+	TenantID string   `json:"tenant_id"`
+	Roles    []string `json:"roles,omitempty"`
+	// Channel names the integration the token was issued for, used to decide
+	// whether employees it creates are held for review
+	Channel string `json:"channel,omitempty"`
+	// Scope is the space-delimited OAuth scopes the token grants, see
+	// RequireScopes
+	Scope *string `json:"scope,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// JWTAuth creates a JWT authentication middleware
-func JWTAuth(jwtSecret string) middleware.Middleware {
+// APIKeyAuthenticator authenticates the API keys of backend services, see
+// biz.APIKeyUsecase
+type APIKeyAuthenticator interface {
+	// Authenticate returns the key matching key, or biz.ErrInvalidAPIKey
+	Authenticate(ctx context.Context, key string) (*biz.APIKey, error)
+}
+
+// TokenRevocations tells revoked tokens apart, see biz.TokenRevocationUsecase
+type TokenRevocations interface {
+	// IsRevoked reports whether a token of tenantID is revoked by its jti,
+	// empty when it has none, or by a revocation of its subject's tokens
+	// issued up to issuedAt, nil when it has no iat claim
+	IsRevoked(ctx context.Context, tenantID, jti, subject string, issuedAt *time.Time) (bool, error)
+}
+
+// JWTAuth creates a JWT authentication middleware verifying tokens with
+// verifier and rejecting those revoked in revocations unless nil. Requests
+// without an Authorization header may instead send an X-API-Key header,
+// authenticated by apiKeys unless nil, or else be authenticated by their
+// client certificate with certs unless nil. Rejected requests are reported to
+// onFailure, if set, with the reason they were rejected for.
+func JWTAuth(verifier *TokenVerifier, revocations TokenRevocations, apiKeys APIKeyAuthenticator, certs *ClientCertIdentity, onFailure func(context.Context, biz.AuthFailure)) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			fail := func(reason, tenantID string, err error) (interface{}, error) {
+				if onFailure != nil {
+					onFailure(ctx, authFailure(ctx, reason, tenantID))
+				}
+				return nil, err
+			}
+
 			// Extract token from metadata/headers
 			token, err := extractToken(ctx)
+			if errors.Is(err, errAuthHeaderNotFound) && apiKeys != nil {
+				if key := extractAPIKey(ctx); key != "" {
+					apiKey, err := apiKeys.Authenticate(ctx, key)
+					if errors.Is(err, biz.ErrInvalidAPIKey) {
+						return fail(biz.AuthFailureInvalidAPIKey, "", err)
+					}
+					if err != nil {
+						return nil, err
+					}
+
+					ctx = biz.WithTenantID(ctx, apiKey.TenantID)
+					ctx = biz.WithUserID(ctx, apiKey.UserID())
+					ctx = biz.WithRoles(ctx, apiKey.Roles)
+					ctx = biz.WithAPIKey(ctx, apiKey)
+					return handler(ctx, req)
+				}
+			}
+			if errors.Is(err, errAuthHeaderNotFound) && certs != nil {
+				if tenantID, userID, ok := certs.identify(ctx); ok {
+					ctx = biz.WithTenantID(ctx, tenantID)
+					ctx = biz.WithUserID(ctx, userID)
+					ctx = biz.WithRoles(ctx, certs.roles)
+					return handler(ctx, req)
+				}
+			}
 			if err != nil {
-				return nil, errors.Unauthorized("UNAUTHORIZED", "missing or invalid authorization header")
+				reason := biz.AuthFailureMalformedHeader
+				if errors.Is(err, errAuthHeaderNotFound) {
+					reason = biz.AuthFailureMissingToken
+				}
+				return fail(reason, "", errors.Unauthorized("UNAUTHORIZED", "missing or invalid authorization header"))
 			}
 
 			// Parse and validate token
-			claims, err := parseToken(token, jwtSecret)
+			claims, err := verifier.Parse(ctx, token)
 			if err != nil {
-				return nil, errors.Unauthorized("UNAUTHORIZED", fmt.Sprintf("invalid token: %v", err))
+				return fail(tokenFailureReason(err), unverifiedTenantID(token), errors.Unauthorized("UNAUTHORIZED", fmt.Sprintf("invalid token: %v", err)))
 			}
 
 			// Validate required claims
 			if claims.Subject == "" {
-				return nil, errors.Unauthorized("UNAUTHORIZED", "missing sub claim in token")
+				return fail(biz.AuthFailureMissingSubject, claims.TenantID, errors.Unauthorized("UNAUTHORIZED", "missing sub claim in token"))
 			}
 			if claims.TenantID == "" {
-				return nil, errors.Unauthorized("UNAUTHORIZED", "missing tenant_id claim in token")
+				return fail(biz.AuthFailureMissingTenant, "", errors.Unauthorized("UNAUTHORIZED", "missing tenant_id claim in token"))
+			}
+			if revocations != nil {
+				var issuedAt *time.Time
+				if claims.IssuedAt != nil {
+					issuedAt = &claims.IssuedAt.Time
+				}
+				revoked, err := revocations.IsRevoked(ctx, claims.TenantID, claims.ID, claims.Subject, issuedAt)
+				if err != nil {
+					return nil, err
+				}
+				if revoked {
+					return fail(biz.AuthFailureTokenRevoked, claims.TenantID, errors.Unauthorized("UNAUTHORIZED", "token has been revoked"))
+				}
 			}
 
-			// Inject tenant_id and user_id into context
+			// Inject tenant_id, user_id, roles, channel and scopes into context
 			ctx = biz.WithTenantID(ctx, claims.TenantID)
 			ctx = biz.WithUserID(ctx, claims.Subject)
+			ctx = biz.WithRoles(ctx, claims.Roles)
+			if claims.Channel != "" {
+				ctx = biz.WithChannel(ctx, claims.Channel)
+			}
+			if claims.Scope != nil {
+				ctx = biz.WithScopes(ctx, strings.Fields(*claims.Scope))
+			}
 
 			return handler(ctx, req)
 		}
 	}
 }
 
+// authFailure describes a request rejected for reason
+func authFailure(ctx context.Context, reason, tenantID string) biz.AuthFailure {
+	md := biz.GetRequestMetadata(ctx)
+	failure := biz.AuthFailure{Reason: reason, TenantID: tenantID, ClientIP: md.ClientIP, RequestID: md.RequestID}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		failure.Operation = tr.Operation()
+	}
+	return failure
+}
+
+// tokenFailureReason returns the failure reason of a token parseToken rejected
+func tokenFailureReason(err error) string {
+	switch {
+	case errors.Is(err, jwt.ErrTokenInvalidIssuer):
+		return biz.AuthFailureInvalidIssuer
+	case errors.Is(err, jwt.ErrTokenInvalidAudience):
+		return biz.AuthFailureInvalidAudience
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return biz.AuthFailureTokenExpired
+	case errors.Is(err, jwt.ErrTokenNotValidYet), errors.Is(err, jwt.ErrTokenUsedBeforeIssued):
+		return biz.AuthFailureTokenNotYetValid
+	case errors.Is(err, jwt.ErrTokenSignatureInvalid), errors.Is(err, jwt.ErrTokenUnverifiable):
+		return biz.AuthFailureInvalidSignature
+	default:
+		return biz.AuthFailureMalformedToken
+	}
+}
+
+// unverifiedTenantID returns the tenant_id claim of a rejected token without
+// verifying it, so that failures can be attributed to the tenant they claim
+func unverifiedTenantID(token string) string {
+	var claims JWTClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err != nil {
+		return ""
+	}
+	return claims.TenantID
+}
+
+// errAuthHeaderNotFound is a request without an Authorization header
+var errAuthHeaderNotFound = fmt.Errorf("authorization header not found")
+
 // extractToken extracts the JWT token from the context
 func extractToken(ctx context.Context) (string, error) {
 	// Get transport header from context (works for both HTTP and gRPC)
@@ -67,7 +193,18 @@ func extractToken(ctx context.Context) (string, error) {
 		}
 	}
 
-	return "", fmt.Errorf("authorization header not found")
+	return "", errAuthHeaderNotFound
+}
+
+// extractAPIKey returns the X-API-Key header, empty when not sent
+func extractAPIKey(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if key := tr.RequestHeader().Get("X-API-Key"); key != "" {
+			return key
+		}
+		return tr.RequestHeader().Get("x-api-key")
+	}
+	return ""
 }
 
 // parseAuthHeader parses the Authorization header value
@@ -85,15 +222,141 @@ func parseAuthHeader(header string) (string, error) {
 	return parts[1], nil
 }
 
-// parseToken parses and validates a JWT token
-func parseToken(tokenString string, secret string) (*JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// Validate signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+// TokenKeys are the keys the tokens of an issuer are verified with: HMAC
+// tokens with Secret and RSA tokens with the key of JWKS named by their "kid"
+// header. An empty Secret rejects HMAC tokens and a nil JWKS RSA tokens.
+type TokenKeys struct {
+	Secret string
+	JWKS   *JWKS
+}
+
+// tokenKeys are TokenKeys ready for verification, secret nil when unset
+type tokenKeys struct {
+	secret []byte
+	jwks   *JWKS
+	// previous is the secret rotated out by RotateSecret, still accepted
+	// until previousUntil
+	previous      []byte
+	previousUntil time.Time
+}
+
+// hmacKey returns the key of HMAC tokens, nil without secret
+func (k *tokenKeys) hmacKey() interface{} {
+	if k.secret == nil {
+		return nil
+	}
+	if k.previous != nil && time.Now().Before(k.previousUntil) {
+		return jwt.VerificationKeySet{Keys: []jwt.VerificationKey{k.secret, k.previous}}
+	}
+	return k.secret
+}
+
+func newTokenKeys(keys TokenKeys) *tokenKeys {
+	k := &tokenKeys{jwks: keys.JWKS}
+	if keys.Secret != "" {
+		k.secret = []byte(keys.Secret)
+	}
+	return k
+}
+
+// TokenVerifier verifies the signature, issuer and audience of tokens
+type TokenVerifier struct {
+	// keys is swapped by RotateSecret
+	keys atomic.Pointer[tokenKeys]
+	// issuers are the trusted issuers by "iss" claim, nil when any issuer is
+	// accepted
+	issuers   map[string]*tokenKeys
+	audiences []string
+}
+
+// NewTokenVerifier creates a verifier. Without issuers, tokens of any issuer
+// are verified with keys. Otherwise a token's "iss" claim must name one of
+// issuers and the token is verified with its keys; tokens without "iss" are
+// still verified with keys, e.g. those of a legacy issuer during a
+// migration. When audiences are set, a token's "aud" claim must contain one
+// of them.
+func NewTokenVerifier(keys TokenKeys, issuers map[string]TokenKeys, audiences []string) *TokenVerifier {
+	v := &TokenVerifier{audiences: audiences}
+	v.keys.Store(newTokenKeys(keys))
+	if len(issuers) > 0 {
+		v.issuers = make(map[string]*tokenKeys, len(issuers))
+		for issuer, keys := range issuers {
+			v.issuers[issuer] = newTokenKeys(keys)
+		}
+	}
+	return v
+}
+
+// keysOf returns the keys of the issuer of a token
+func (v *TokenVerifier) keysOf(token *jwt.Token) (*tokenKeys, error) {
+	issuer, err := token.Claims.GetIssuer()
+	if err != nil {
+		return nil, err
+	}
+	keys := v.keys.Load()
+	if v.issuers == nil {
+		return keys, nil
+	}
+	if issuer == "" {
+		if keys.secret == nil && keys.jwks == nil {
+			return nil, fmt.Errorf("%w: missing iss claim", jwt.ErrTokenInvalidIssuer)
 		}
-		return []byte(secret), nil
+		return keys, nil
+	}
+	issuerKeys, ok := v.issuers[issuer]
+	if !ok {
+		return nil, fmt.Errorf("%w: untrusted issuer %q", jwt.ErrTokenInvalidIssuer, issuer)
+	}
+	return issuerKeys, nil
+}
+
+// RotateSecret replaces the secret of HMAC tokens without "iss" claim, or of
+// any issuer without trusted issuers. Tokens signed with the replaced secret
+// are still accepted for grace. It reports whether the secret changed.
+func (v *TokenVerifier) RotateSecret(secret string, grace time.Duration) bool {
+	current := v.keys.Load()
+	if secret == "" || secret == string(current.secret) {
+		return false
+	}
+	v.keys.Store(&tokenKeys{
+		secret:        []byte(secret),
+		jwks:          current.jwks,
+		previous:      current.secret,
+		previousUntil: time.Now().Add(grace),
 	})
+	return true
+}
+
+// Parse parses and validates a JWT token
+func (v *TokenVerifier) Parse(ctx context.Context, tokenString string) (*JWTClaims, error) {
+	var opts []jwt.ParserOption
+	if len(v.audiences) > 0 {
+		opts = append(opts, jwt.WithAudience(v.audiences...))
+	}
+	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
+		keys, err := v.keysOf(token)
+		if err != nil {
+			return nil, err
+		}
+
+		// Validate signing method; the key type must match it so that e.g. an
+		// HS256 token cannot be verified with a public key
+		switch token.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			if key := keys.hmacKey(); key != nil {
+				return key, nil
+			}
+		case *jwt.SigningMethodRSA:
+			if keys.jwks != nil {
+				kid, _ := token.Header["kid"].(string)
+				if kid == "" {
+					return nil, fmt.Errorf("missing kid header")
+				}
+				return keys.jwks.Key(ctx, kid)
+			}
+		}
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}, opts...)
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// Authorize creates a role-based authorization middleware. permissions maps a
+// role to the operations it may call; an operation ending in "*" matches any
+// operation with that prefix. It must run after JWTAuth, which puts the
+// caller's roles into the context. An empty permissions map allows everything.
+func Authorize(permissions map[string][]string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		if len(permissions) == 0 {
+			return handler
+		}
+
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, errors.Forbidden("FORBIDDEN", "operation not permitted")
+			}
+
+			if !isAllowed(permissions, biz.GetRoles(ctx), tr.Operation()) {
+				return nil, errors.Forbidden("FORBIDDEN", "operation not permitted for the caller's roles")
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// isAllowed reports whether any of the roles grants the operation
+func isAllowed(permissions map[string][]string, roles []string, operation string) bool {
+	for _, role := range roles {
+		for _, pattern := range permissions[role] {
+			if matchOperation(pattern, operation) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchOperation matches an operation against an exact name or a "*" suffixed prefix
+func matchOperation(pattern, operation string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(operation, prefix)
+	}
+	return pattern == operation
+}
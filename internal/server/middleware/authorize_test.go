@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+// operationTransport is a transport with a configurable operation
+type operationTransport struct {
+	mockTransport
+	operation string
+}
+
+func (t *operationTransport) Operation() string {
+	return t.operation
+}
+
+func TestAuthorize(t *testing.T) {
+	permissions := map[string][]string{
+		"viewer": {
+			"/employee.v1.EmployeeService/GetEmployee",
+			"/employee.v1.EmployeeService/ListEmployees",
+		},
+		"admin": {"/employee.v1.EmployeeService/*", "/admin.v1.AdminService/*"},
+	}
+
+	tests := []struct {
+		name      string
+		roles     []string
+		operation string
+		wantErr   bool
+	}{
+		{
+			name:      "viewer can get",
+			roles:     []string{"viewer"},
+			operation: "/employee.v1.EmployeeService/GetEmployee",
+		},
+		{
+			name:      "viewer cannot delete",
+			roles:     []string{"viewer"},
+			operation: "/employee.v1.EmployeeService/DeleteEmployee",
+			wantErr:   true,
+		},
+		{
+			name:      "admin matches wildcard",
+			roles:     []string{"admin"},
+			operation: "/admin.v1.AdminService/PurgeTenant",
+		},
+		{
+			name:      "any role is enough",
+			roles:     []string{"viewer", "admin"},
+			operation: "/employee.v1.EmployeeService/MergeEmployees",
+		},
+		{
+			name:      "unknown role",
+			roles:     []string{"guest"},
+			operation: "/employee.v1.EmployeeService/GetEmployee",
+			wantErr:   true,
+		},
+		{
+			name:      "no roles",
+			operation: "/employee.v1.EmployeeService/GetEmployee",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := Authorize(permissions)(func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "success", nil
+			})
+
+			ctx := transport.NewServerContext(context.Background(), &operationTransport{operation: tt.operation})
+			ctx = biz.WithRoles(ctx, tt.roles)
+			_, err := handler(ctx, nil)
+
+			if tt.wantErr {
+				assert.True(t, errors.IsForbidden(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuthorize_Disabled(t *testing.T) {
+	handler := Authorize(nil)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "success", nil
+	})
+
+	_, err := handler(context.Background(), nil)
+	assert.NoError(t, err)
+}
+
+func TestMatchOperation(t *testing.T) {
+	assert.True(t, matchOperation("/a.B/C", "/a.B/C"))
+	assert.False(t, matchOperation("/a.B/C", "/a.B/CD"))
+	assert.True(t, matchOperation("/a.B/*", "/a.B/C"))
+	assert.True(t, matchOperation("*", "/a.B/C"))
+	assert.False(t, matchOperation("/a.B/*", "/a.X/C"))
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientCertIdentity authenticates requests by the verified client
+// certificate of their mutual TLS connection. The first URI SAN starting
+// with the prefix names the tenant in the path segment following it; the
+// whole URI is the user ID.
+type ClientCertIdentity struct {
+	uriPrefix string
+	roles     []string
+}
+
+// NewClientCertIdentity creates an identity of certificates with URI SANs
+// starting with uriPrefix, whose requests get roles.
+func NewClientCertIdentity(uriPrefix string, roles []string) *ClientCertIdentity {
+	return &ClientCertIdentity{uriPrefix: uriPrefix, roles: roles}
+}
+
+// identify returns the tenant and user ID of the client certificate of the
+// connection of ctx. Certificates the server did not verify are ignored.
+func (c *ClientCertIdentity) identify(ctx context.Context) (string, string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", "", false
+	}
+
+	for _, uri := range info.State.VerifiedChains[0][0].URIs {
+		rest, ok := strings.CutPrefix(uri.String(), c.uriPrefix)
+		if !ok {
+			continue
+		}
+		if tenantID, _, _ := strings.Cut(rest, "/"); tenantID != "" {
+			return tenantID, uri.String(), true
+		}
+	}
+	return "", "", false
+}
@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Deprecation describes a deprecated operation
+type Deprecation struct {
+	// Since is when the operation was deprecated
+	Since time.Time
+	// Sunset is when the operation stops working, zero while none is planned
+	Sunset time.Time
+	// Link points to the migration guide, empty when there is none
+	Link string
+}
+
+// DeprecationRegistry maps operations to their deprecation. Keys are full
+// gRPC method names or prefixes ending in "*"; an exact name wins over
+// prefixes, and the longest prefix over shorter ones.
+type DeprecationRegistry map[string]Deprecation
+
+// lookup returns the deprecation of an operation
+func (r DeprecationRegistry) lookup(operation string) (Deprecation, bool) {
+	if d, ok := r[operation]; ok {
+		return d, true
+	}
+	var (
+		found   Deprecation
+		longest = -1
+	)
+	for pattern, d := range r {
+		prefix, ok := strings.CutSuffix(pattern, "*")
+		if ok && len(prefix) > longest && strings.HasPrefix(operation, prefix) {
+			found, longest = d, len(prefix)
+		}
+	}
+	return found, longest >= 0
+}
+
+// headers returns the deprecation headers of d: Deprecation (RFC 9745),
+// Sunset (RFC 8594) and a Link to the migration guide
+func (d Deprecation) headers() [][2]string {
+	headers := [][2]string{{"Deprecation", fmt.Sprintf("@%d", d.Since.Unix())}}
+	if !d.Sunset.IsZero() {
+		headers = append(headers, [2]string{"Sunset", d.Sunset.UTC().Format(http.TimeFormat)})
+	}
+	if d.Link != "" {
+		headers = append(headers, [2]string{"Link", fmt.Sprintf(`<%s>; rel="deprecation"; type="text/html"`, d.Link)})
+	}
+	return headers
+}
+
+// Deprecations creates a middleware announcing deprecated operations to their
+// callers: HTTP responses get Deprecation, Sunset and Link headers, gRPC calls
+// the same keys as trailers. Every call of a deprecated operation is passed to
+// record with the caller's client, its channel claim. It must run after
+// JWTAuth, which puts the channel into the context.
+func Deprecations(registry DeprecationRegistry, record func(operation, client string)) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		if len(registry) == 0 {
+			return handler
+		}
+
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			d, ok := registry.lookup(tr.Operation())
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			// Headers are set before the handler runs, as streaming handlers
+			// such as the export write the response themselves
+			headers := d.headers()
+			if tr.Kind() == transport.KindGRPC {
+				md := metadata.MD{}
+				for _, h := range headers {
+					md.Append(strings.ToLower(h[0]), h[1])
+				}
+				// Fails only outside of a gRPC call
+				_ = grpc.SetTrailer(ctx, md)
+			} else {
+				for _, h := range headers {
+					tr.ReplyHeader().Set(h[0], h[1])
+				}
+			}
+			if record != nil {
+				record(tr.Operation(), biz.GetChannel(ctx))
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpReplyTransport is an HTTP transport recording its reply headers
+type httpReplyTransport struct {
+	operationTransport
+	reply *mockHeader
+}
+
+func (t *httpReplyTransport) Kind() transport.Kind {
+	return transport.KindHTTP
+}
+
+func (t *httpReplyTransport) ReplyHeader() transport.Header {
+	return t.reply
+}
+
+func TestDeprecationRegistry_Lookup(t *testing.T) {
+	exact := Deprecation{Link: "exact"}
+	service := Deprecation{Link: "service"}
+	all := Deprecation{Link: "all"}
+	registry := DeprecationRegistry{
+		"/employee.v1.EmployeeService/GetEmployeeByEmail": exact,
+		"/employee.v1.EmployeeService/*":                  service,
+		"/employee.v1.*":                                  all,
+	}
+
+	tests := []struct {
+		operation string
+		want      Deprecation
+		found     bool
+	}{
+		{operation: "/employee.v1.EmployeeService/GetEmployeeByEmail", want: exact, found: true},
+		{operation: "/employee.v1.EmployeeService/GetEmployee", want: service, found: true},
+		{operation: "/employee.v1.OtherService/Get", want: all, found: true},
+		{operation: "/team.v1.TeamService/CreateTeam", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.operation, func(t *testing.T) {
+			got, found := registry.lookup(tt.operation)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDeprecations(t *testing.T) {
+	registry := DeprecationRegistry{
+		"/employee.v1.EmployeeService/GetEmployeeByEmail": {
+			Since:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			Sunset: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+			Link:   "https://example.com/migrate",
+		},
+	}
+	type call struct{ operation, client string }
+	var calls []call
+	mw := Deprecations(registry, func(operation, client string) {
+		calls = append(calls, call{operation, client})
+	})
+	handler := mw(func(context.Context, interface{}) (interface{}, error) { return "ok", nil })
+
+	deprecated := &httpReplyTransport{
+		operationTransport: operationTransport{operation: "/employee.v1.EmployeeService/GetEmployeeByEmail"},
+		reply:              &mockHeader{data: map[string][]string{}},
+	}
+	ctx := biz.WithChannel(transport.NewServerContext(context.Background(), deprecated), "hris-sync")
+	_, err := handler(ctx, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "@1767225600", deprecated.reply.Get("Deprecation"))
+	assert.Equal(t, "Wed, 01 Jul 2026 00:00:00 GMT", deprecated.reply.Get("Sunset"))
+	assert.Equal(t, `<https://example.com/migrate>; rel="deprecation"; type="text/html"`, deprecated.reply.Get("Link"))
+
+	current := &httpReplyTransport{
+		operationTransport: operationTransport{operation: "/employee.v1.EmployeeService/GetEmployee"},
+		reply:              &mockHeader{data: map[string][]string{}},
+	}
+	_, err = handler(transport.NewServerContext(context.Background(), current), nil)
+	require.NoError(t, err)
+
+	assert.Empty(t, current.reply.data)
+	assert.Equal(t, []call{{"/employee.v1.EmployeeService/GetEmployeeByEmail", "hris-sync"}}, calls)
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// ExemptOperations runs m for every operation but those matching one of
+// operations, exact names or "*" suffixed prefixes as in Authorize, which go
+// straight to the handler. It lets public operations bypass authentication.
+func ExemptOperations(operations []string, m middleware.Middleware) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		next := m(handler)
+		if len(operations) == 0 {
+			return next
+		}
+
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				for _, pattern := range operations {
+					if matchOperation(pattern, tr.Operation()) {
+						return handler(ctx, req)
+					}
+				}
+			}
+			return next(ctx, req)
+		}
+	}
+}
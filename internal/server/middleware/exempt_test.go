@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExemptOperations(t *testing.T) {
+	deny := func(middleware.Handler) middleware.Handler {
+		return func(context.Context, interface{}) (interface{}, error) {
+			return nil, errors.Unauthorized("UNAUTHORIZED", "denied")
+		}
+	}
+	exempt := []string{"/employee.v1.EmployeeService/EmployeeExists", "/auth.v1.CallbackService/*"}
+
+	tests := []struct {
+		name       string
+		operations []string
+		operation  string
+		wantErr    bool
+	}{
+		{name: "exact match is exempt", operations: exempt, operation: "/employee.v1.EmployeeService/EmployeeExists"},
+		{name: "prefix match is exempt", operations: exempt, operation: "/auth.v1.CallbackService/Callback"},
+		{name: "other operations are not", operations: exempt, operation: "/employee.v1.EmployeeService/GetEmployee", wantErr: true},
+		{name: "nothing exempt", operation: "/employee.v1.EmployeeService/EmployeeExists", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := ExemptOperations(tt.operations, deny)(func(context.Context, interface{}) (interface{}, error) {
+				return "success", nil
+			})
+
+			ctx := transport.NewServerContext(context.Background(), &operationTransport{operation: tt.operation})
+			resp, err := handler(ctx, nil)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "success", resp)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// Headers naming the tenant and user an admin acts as
+const (
+	ActAsTenantHeader = "X-Act-As-Tenant"
+	ActAsUserHeader   = "X-Act-As-User"
+)
+
+// maxActAsLength bounds the tenant and user IDs of the act-as headers, as
+// long as the columns they are stored in
+const maxActAsLength = 255
+
+// ErrImpersonationForbidden is a request with an act-as header by a caller
+// without one of the impersonation roles
+var ErrImpersonationForbidden = errors.Forbidden("FORBIDDEN", "acting as another user or tenant is not permitted for the caller's roles")
+
+// Impersonation creates a middleware letting callers with one of roles act
+// as another tenant with X-Act-As-Tenant and another user with
+// X-Act-As-User; either header alone keeps the caller's user or tenant. The
+// tenant and user of the context are switched to those acted as, and the
+// caller is kept as the biz.Impersonator so that logs, events and audit
+// entries record both. It must run after JWTAuth and before the middleware
+// relying on the tenant; the caller keeps its own roles and scopes.
+// Requests with an act-as header are rejected without roles.
+func Impersonation(roles []string, logger log.Logger) middleware.Middleware {
+	logHelper := log.NewHelper(logger)
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			tenantID := strings.TrimSpace(tr.RequestHeader().Get(ActAsTenantHeader))
+			userID := strings.TrimSpace(tr.RequestHeader().Get(ActAsUserHeader))
+			if tenantID == "" && userID == "" {
+				return handler(ctx, req)
+			}
+			if len(tenantID) > maxActAsLength || len(userID) > maxActAsLength {
+				return nil, errors.BadRequest("INVALID_ACT_AS", "act-as tenant and user IDs must be at most 255 characters")
+			}
+			if !slices.ContainsFunc(biz.GetRoles(ctx), func(role string) bool {
+				return slices.Contains(roles, role)
+			}) {
+				return nil, ErrImpersonationForbidden
+			}
+
+			var impersonator biz.Impersonator
+			impersonator.TenantID, _ = biz.GetTenantID(ctx)
+			impersonator.UserID, _ = biz.GetUserID(ctx)
+			if tenantID == "" {
+				tenantID = impersonator.TenantID
+			}
+			if userID == "" {
+				userID = impersonator.UserID
+			}
+
+			ctx = biz.WithTenantID(ctx, tenantID)
+			ctx = biz.WithUserID(ctx, userID)
+			ctx = biz.WithImpersonator(ctx, impersonator)
+			logHelper.WithContext(ctx).Infof("impersonation: user=%s tenant=%s acts as user=%s tenant=%s, operation=%s",
+				impersonator.UserID, impersonator.TenantID, userID, tenantID, tr.Operation())
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// ImpersonatorValuer returns a log valuer of the impersonator in context as
+// tenant/user, empty when the caller acts as itself
+func ImpersonatorValuer() log.Valuer {
+	return func(ctx context.Context) interface{} {
+		impersonator, ok := biz.GetImpersonator(ctx)
+		if !ok {
+			return ""
+		}
+		return impersonator.TenantID + "/" + impersonator.UserID
+	}
+}
@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// impersonationContext is a request of user admin-1 of tenant support with
+// roles and the given headers
+func impersonationContext(roles []string, headers map[string][]string) context.Context {
+	tr := &mockTransport{}
+	tr.On("RequestHeader").Return(&mockHeader{data: headers})
+	ctx := transport.NewServerContext(context.Background(), tr)
+	ctx = biz.WithTenantID(ctx, "support")
+	ctx = biz.WithUserID(ctx, "admin-1")
+	return biz.WithRoles(ctx, roles)
+}
+
+func TestImpersonation(t *testing.T) {
+	tests := []struct {
+		name             string
+		headers          map[string][]string
+		wantTenant       string
+		wantUser         string
+		wantImpersonated bool
+	}{
+		{
+			name:       "without headers",
+			headers:    map[string][]string{},
+			wantTenant: "support",
+			wantUser:   "admin-1",
+		},
+		{
+			name:             "tenant and user",
+			headers:          map[string][]string{ActAsTenantHeader: {"acme"}, ActAsUserHeader: {"user-7"}},
+			wantTenant:       "acme",
+			wantUser:         "user-7",
+			wantImpersonated: true,
+		},
+		{
+			name:             "tenant only keeps the user",
+			headers:          map[string][]string{ActAsTenantHeader: {" acme "}},
+			wantTenant:       "acme",
+			wantUser:         "admin-1",
+			wantImpersonated: true,
+		},
+		{
+			name:             "user only keeps the tenant",
+			headers:          map[string][]string{ActAsUserHeader: {"user-7"}},
+			wantTenant:       "support",
+			wantUser:         "user-7",
+			wantImpersonated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tenantID, userID string
+			var impersonator biz.Impersonator
+			var impersonated bool
+			handler := Impersonation([]string{"support"}, log.NewStdLogger(io.Discard))(func(ctx context.Context, _ interface{}) (interface{}, error) {
+				tenantID, _ = biz.GetTenantID(ctx)
+				userID, _ = biz.GetUserID(ctx)
+				impersonator, impersonated = biz.GetImpersonator(ctx)
+				return "ok", nil
+			})
+
+			_, err := handler(impersonationContext([]string{"viewer", "support"}, tt.headers), nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTenant, tenantID)
+			assert.Equal(t, tt.wantUser, userID)
+			assert.Equal(t, tt.wantImpersonated, impersonated)
+			if tt.wantImpersonated {
+				assert.Equal(t, biz.Impersonator{TenantID: "support", UserID: "admin-1"}, impersonator)
+			}
+		})
+	}
+}
+
+func TestImpersonation_Forbidden(t *testing.T) {
+	headers := map[string][]string{ActAsTenantHeader: {"acme"}}
+	tests := []struct {
+		name   string
+		roles  []string
+		caller []string
+	}{
+		{name: "caller without the role", roles: []string{"support"}, caller: []string{"admin"}},
+		{name: "impersonation disabled", roles: nil, caller: []string{"admin"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := Impersonation(tt.roles, log.NewStdLogger(io.Discard))(func(context.Context, interface{}) (interface{}, error) {
+				t.Fatal("handler called")
+				return nil, nil
+			})
+
+			_, err := handler(impersonationContext(tt.caller, headers), nil)
+
+			assert.True(t, errors.IsForbidden(err))
+		})
+	}
+}
+
+func TestImpersonation_TooLong(t *testing.T) {
+	handler := Impersonation([]string{"support"}, log.NewStdLogger(io.Discard))(func(context.Context, interface{}) (interface{}, error) {
+		t.Fatal("handler called")
+		return nil, nil
+	})
+	long := strings.Repeat("a", maxActAsLength+1)
+
+	_, err := handler(impersonationContext([]string{"support"}, map[string][]string{ActAsUserHeader: {long}}), nil)
+
+	assert.Equal(t, "INVALID_ACT_AS", errors.Reason(err))
+}
+
+func TestImpersonatorValuer(t *testing.T) {
+	valuer := ImpersonatorValuer()
+
+	assert.Equal(t, "", valuer(context.Background()))
+	ctx := biz.WithImpersonator(context.Background(), biz.Impersonator{TenantID: "support", UserID: "admin-1"})
+	assert.Equal(t, "support/admin-1", valuer(ctx))
+}
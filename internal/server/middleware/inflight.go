@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InFlight creates a middleware that registers each request in registry for
+// as long as it is served. It must run after JWTAuth, which puts the tenant
+// into the context; requests rejected before are not registered.
+func InFlight(registry *biz.InFlightRegistry) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			tenantID, _ := biz.GetTenantID(ctx)
+			var traceID string
+			if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+				traceID = sc.TraceID().String()
+			}
+			done := registry.Start(tr.Operation(), tenantID, traceID, biz.GetRequestID(ctx))
+			defer done()
+
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInFlight(t *testing.T) {
+	registry := biz.NewInFlightRegistry(biz.NewSystemClock(), &conf.Admin{})
+	mw := InFlight(registry)
+
+	tr := &operationTransport{operation: "/employee.v1.EmployeeService/GetEmployee"}
+	ctx := biz.WithRequestID(biz.WithTenantID(transport.NewServerContext(context.Background(), tr), "tenant-1"), "req-1")
+
+	var during []*biz.InFlightRequest
+	_, err := mw(func(ctx context.Context, _ interface{}) (interface{}, error) {
+		var err error
+		during, err = registry.List(ctx, false)
+		return nil, err
+	})(ctx, nil)
+	require.NoError(t, err)
+
+	require.Len(t, during, 1)
+	assert.Equal(t, tr.operation, during[0].Operation)
+	assert.Equal(t, "tenant-1", during[0].TenantID)
+	assert.Equal(t, "req-1", during[0].RequestID)
+	assert.GreaterOrEqual(t, during[0].Elapsed, time.Duration(0))
+
+	after, err := registry.List(ctx, false)
+	require.NoError(t, err)
+	assert.Empty(t, after, "requests are removed once served")
+}
@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// JWKS caches the RSA public keys of a JSON Web Key Set by key ID. Keys are
+// fetched on first use and again once older than the refresh interval. A key
+// ID not in the cache refetches the keys right away, at most once per minimum
+// refresh interval, so that keys the provider rotated in are picked up before
+// the next refresh. When a refetch fails the cached keys keep being used.
+type JWKS struct {
+	url        string
+	client     *http.Client
+	refresh    time.Duration
+	minRefresh time.Duration
+	now        func() time.Time
+
+	// mu is held during fetches, so that concurrent requests wait for one
+	// fetch instead of each starting their own
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+	triedAt   time.Time
+}
+
+// NewJWKS creates a key set fetched from url with client.
+func NewJWKS(url string, client *http.Client, refresh, minRefresh time.Duration) *JWKS {
+	return &JWKS{url: url, client: client, refresh: refresh, minRefresh: minRefresh, now: time.Now}
+}
+
+// Key returns the key with ID kid
+func (k *JWKS) Key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := k.now()
+	key, ok := k.keys[kid]
+	expired := k.keys == nil || now.Sub(k.fetchedAt) >= k.refresh
+	if (expired || !ok) && (k.triedAt.IsZero() || now.Sub(k.triedAt) >= k.minRefresh) {
+		k.triedAt = now
+		keys, err := k.fetch(ctx)
+		if err != nil && k.keys == nil {
+			return nil, fmt.Errorf("fetching JWKS: %w", err)
+		}
+		if err == nil {
+			k.keys, k.fetchedAt = keys, now
+			key, ok = keys[kid]
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown key ID %q", kid)
+	}
+	return key, nil
+}
+
+// jsonWebKey is a key of a JSON Web Key Set (RFC 7517)
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// fetch returns the RSA signing keys of the key set by key ID. Keys of other
+// types or uses are skipped.
+func (k *JWKS) fetch(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("decoding key set: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		key, err := rsaPublicKey(jwk)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+// rsaPublicKey decodes the base64url modulus and exponent of an RSA key
+func rsaPublicKey(jwk jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 2 || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("invalid RSA key")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
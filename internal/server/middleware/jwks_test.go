@@ -0,0 +1,190 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jwksServer serves the public keys of keys by key ID
+type jwksServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	keys    map[string]*rsa.PrivateKey
+	fetches int
+	fail    bool
+}
+
+func newJWKSServer(t *testing.T) *jwksServer {
+	s := &jwksServer{keys: map[string]*rsa.PrivateKey{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.fetches++
+		if s.fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		set := struct {
+			Keys []jsonWebKey `json:"keys"`
+		}{Keys: []jsonWebKey{{Kty: "EC", Kid: "ec-key"}}}
+		for kid, key := range s.keys {
+			set.Keys = append(set.Keys, jsonWebKey{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// rotate adds a new key with ID kid
+func (s *jwksServer) rotate(t *testing.T, kid string) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[kid] = key
+	return key
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, JWTClaims{TenantID: "tenant-1", RegisteredClaims: jwt.RegisteredClaims{Subject: "user-1"}})
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestTokenVerifier_RS256(t *testing.T) {
+	server := newJWKSServer(t)
+	key := server.rotate(t, "key-1")
+	now := time.Now()
+	jwks := NewJWKS(server.URL, server.Client(), time.Hour, time.Minute)
+	jwks.now = func() time.Time { return now }
+	verifier := NewTokenVerifier(TokenKeys{Secret: "test-secret-key", JWKS: jwks}, nil, nil)
+
+	t.Run("valid", func(t *testing.T) {
+		claims, err := verifier.Parse(context.Background(), signRS256(t, key, "key-1"))
+
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-1", claims.TenantID)
+		assert.Equal(t, "user-1", claims.Subject)
+	})
+
+	t.Run("missing kid", func(t *testing.T) {
+		_, err := verifier.Parse(context.Background(), signRS256(t, key, ""))
+
+		assert.ErrorContains(t, err, "missing kid header")
+	})
+
+	t.Run("signed with another key", func(t *testing.T) {
+		other, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		_, err = verifier.Parse(context.Background(), signRS256(t, other, "key-1"))
+
+		assert.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
+	})
+
+	t.Run("HMAC fallback", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, JWTClaims{TenantID: "tenant-1"}).SignedString([]byte("test-secret-key"))
+		require.NoError(t, err)
+
+		_, err = verifier.Parse(context.Background(), token)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestTokenVerifier_Methods(t *testing.T) {
+	server := newJWKSServer(t)
+	key := server.rotate(t, "key-1")
+	hmac, err := jwt.NewWithClaims(jwt.SigningMethodHS256, JWTClaims{TenantID: "tenant-1"}).SignedString([]byte("test-secret-key"))
+	require.NoError(t, err)
+
+	t.Run("RSA without JWKS", func(t *testing.T) {
+		_, err := NewTokenVerifier(TokenKeys{Secret: "test-secret-key"}, nil, nil).Parse(context.Background(), signRS256(t, key, "key-1"))
+
+		assert.ErrorContains(t, err, "unexpected signing method")
+	})
+
+	t.Run("HMAC without secret", func(t *testing.T) {
+		jwks := NewJWKS(server.URL, server.Client(), time.Hour, time.Minute)
+
+		_, err := NewTokenVerifier(TokenKeys{JWKS: jwks}, nil, nil).Parse(context.Background(), hmac)
+
+		assert.ErrorContains(t, err, "unexpected signing method")
+	})
+}
+
+func TestJWKS_Rotation(t *testing.T) {
+	server := newJWKSServer(t)
+	server.rotate(t, "key-1")
+	now := time.Now()
+	jwks := NewJWKS(server.URL, server.Client(), time.Hour, time.Minute)
+	jwks.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, err := jwks.Key(ctx, "key-1")
+	require.NoError(t, err)
+	_, err = jwks.Key(ctx, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, server.fetches, "cached keys are reused")
+
+	// A key rotated in is fetched on first use, once the minimum interval
+	// since the last fetch passed
+	server.rotate(t, "key-2")
+	now = now.Add(30 * time.Second)
+	_, err = jwks.Key(ctx, "key-2")
+	assert.ErrorContains(t, err, `unknown key ID "key-2"`)
+	assert.Equal(t, 1, server.fetches)
+
+	now = now.Add(time.Minute)
+	_, err = jwks.Key(ctx, "key-2")
+	require.NoError(t, err)
+	assert.Equal(t, 2, server.fetches)
+
+	// Keys outlive a failed refresh
+	server.fail = true
+	now = now.Add(2 * time.Hour)
+	_, err = jwks.Key(ctx, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, server.fetches)
+
+	// Non-RSA keys are skipped
+	now = now.Add(time.Hour)
+	server.fail = false
+	_, err = jwks.Key(ctx, "ec-key")
+	assert.ErrorContains(t, err, "unknown key ID")
+	assert.Equal(t, 4, server.fetches)
+}
+
+func TestJWKS_Unavailable(t *testing.T) {
+	server := newJWKSServer(t)
+	server.fail = true
+	jwks := NewJWKS(server.URL, server.Client(), time.Hour, time.Minute)
+
+	_, err := jwks.Key(context.Background(), "key-1")
+
+	assert.ErrorContains(t, err, "fetching JWKS: unexpected status 503")
+}
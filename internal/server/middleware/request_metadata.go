@@ -0,0 +1,161 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/peer"
+)
+
+// RequestIDHeader is the header carrying the request ID for both HTTP and gRPC
+const RequestIDHeader = "X-Request-ID"
+
+const (
+	// maxRequestIDLength bounds caller supplied request IDs
+	maxRequestIDLength = 128
+	// maxUserAgentLength bounds the user agent kept in the metadata
+	maxUserAgentLength = 256
+	// maxLocaleLength is the longest language tag accepted (RFC 5646)
+	maxLocaleLength = 35
+)
+
+// RequestMetadata creates a middleware that collects the request metadata
+// (request ID, client IP, user agent, API version and locale) into the
+// context once, see biz.RequestMetadata, and marks the operation as coming
+// from the API. The caller's X-Request-ID is propagated, or one is generated,
+// and echoed in the reply header. The client IP is forwarded by proxies, so
+// it is only read from headers sent by the trustedProxies.
+func RequestMetadata(trustedProxies []netip.Prefix) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			var md biz.RequestMetadata
+			tr, ok := transport.FromServerContext(ctx)
+			if ok {
+				md = requestMetadata(ctx, tr, trustedProxies)
+			}
+			if md.RequestID == "" || len(md.RequestID) > maxRequestIDLength {
+				md.RequestID = uuid.New().String()
+			}
+			if ok {
+				tr.ReplyHeader().Set(RequestIDHeader, md.RequestID)
+			}
+
+			ctx = biz.WithSource(biz.WithRequestMetadata(ctx, md), biz.SourceAPI)
+			return handler(ctx, req)
+		}
+	}
+}
+
+// requestMetadata reads the request metadata from the transport headers
+func requestMetadata(ctx context.Context, tr transport.Transporter, trustedProxies []netip.Prefix) biz.RequestMetadata {
+	header := tr.RequestHeader()
+	userAgent := header.Get("User-Agent")
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+
+	return biz.RequestMetadata{
+		RequestID:  header.Get(RequestIDHeader),
+		ClientIP:   clientIP(ctx, header, trustedProxies),
+		UserAgent:  userAgent,
+		APIVersion: apiVersion(tr.Operation()),
+		Locale:     preferredLocale(header.Get("Accept-Language")),
+	}
+}
+
+// clientIP returns the peer address of the connection or, when the peer is a
+// trusted proxy, the address the proxies forwarded: the rightmost address of
+// X-Forwarded-For that is not a trusted proxy, or X-Real-IP. Other callers
+// could claim any address in these headers.
+func clientIP(ctx context.Context, header transport.Header, trustedProxies []netip.Prefix) string {
+	var addr string
+	if r, ok := khttp.RequestFromServerContext(ctx); ok {
+		addr = r.RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+
+	client, err := netip.ParseAddr(addr)
+	if err != nil || !trustedProxy(client, trustedProxies) {
+		return addr
+	}
+	if forwarded := header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		hops := strings.Split(strings.Join(forwarded, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+			if err != nil {
+				break
+			}
+			client = hop.Unmap()
+			if !trustedProxy(client, trustedProxies) {
+				break
+			}
+		}
+		return client.String()
+	}
+	if ip, err := netip.ParseAddr(strings.TrimSpace(header.Get("X-Real-IP"))); err == nil {
+		return ip.Unmap().String()
+	}
+	return addr
+}
+
+// trustedProxy reports whether ip is the address of a trusted proxy
+func trustedProxy(ip netip.Addr, trustedProxies []netip.Prefix) bool {
+	ip = ip.Unmap()
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// apiVersion returns the version of the proto package of an operation, e.g.
+// v1 for /employee.v1.EmployeeService/GetEmployee
+func apiVersion(operation string) string {
+	service, _, _ := strings.Cut(strings.TrimPrefix(operation, "/"), "/")
+	parts := strings.Split(service, ".")
+	for i := len(parts) - 2; i >= 0; i-- {
+		if p := parts[i]; len(p) > 1 && p[0] == 'v' && p[1] >= '0' && p[1] <= '9' {
+			return p
+		}
+	}
+	return ""
+}
+
+// preferredLocale returns the first language tag of an Accept-Language
+// header, ignoring the wildcard and malformed tags
+func preferredLocale(acceptLanguage string) string {
+	first, _, _ := strings.Cut(acceptLanguage, ",")
+	tag, _, _ := strings.Cut(first, ";")
+	tag = strings.TrimSpace(tag)
+	if tag == "" || tag == "*" || len(tag) > maxLocaleLength {
+		return ""
+	}
+	for _, r := range tag {
+		if !(r == '-' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return ""
+		}
+	}
+	return tag
+}
+
+// RequestIDValuer returns a log valuer of the request ID in context, so that
+// every log line of a request can be correlated
+func RequestIDValuer() log.Valuer {
+	return func(ctx context.Context) interface{} {
+		return biz.GetRequestID(ctx)
+	}
+}
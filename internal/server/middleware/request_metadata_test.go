@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+)
+
+func TestRequestMetadata(t *testing.T) {
+	proxies := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+	tests := []struct {
+		name    string
+		peer    string
+		headers map[string][]string
+		want    biz.RequestMetadata
+	}{
+		{
+			name: "headers forwarded by a trusted proxy",
+			peer: "10.0.0.2",
+			headers: map[string][]string{
+				RequestIDHeader:   {"req-1"},
+				"X-Forwarded-For": {"198.51.100.9, 203.0.113.7, 10.0.0.1"},
+				"User-Agent":      {"client/1.0"},
+				"Accept-Language": {"de-CH;q=0.9, en;q=0.8"},
+			},
+			want: biz.RequestMetadata{RequestID: "req-1", ClientIP: "203.0.113.7", UserAgent: "client/1.0", APIVersion: "v1", Locale: "de-CH"},
+		},
+		{
+			name:    "forwarded only by trusted proxies",
+			peer:    "10.0.0.2",
+			headers: map[string][]string{RequestIDHeader: {"req-2"}, "X-Forwarded-For": {"10.0.0.3", "10.0.0.1"}},
+			want:    biz.RequestMetadata{RequestID: "req-2", ClientIP: "10.0.0.3", APIVersion: "v1"},
+		},
+		{
+			name:    "real ip forwarded by a trusted proxy",
+			peer:    "10.0.0.2",
+			headers: map[string][]string{RequestIDHeader: {"req-3"}, "X-Real-IP": {"2001:db8::1"}},
+			want:    biz.RequestMetadata{RequestID: "req-3", ClientIP: "2001:db8::1", APIVersion: "v1"},
+		},
+		{
+			name:    "headers of an untrusted peer",
+			peer:    "192.0.2.1",
+			headers: map[string][]string{RequestIDHeader: {"req-4"}, "X-Forwarded-For": {"203.0.113.7"}, "X-Real-IP": {"203.0.113.8"}},
+			want:    biz.RequestMetadata{RequestID: "req-4", ClientIP: "192.0.2.1", APIVersion: "v1"},
+		},
+		{
+			name:    "invalid headers",
+			peer:    "10.0.0.2",
+			headers: map[string][]string{RequestIDHeader: {"req-5"}, "X-Forwarded-For": {"unknown"}, "Accept-Language": {"*"}},
+			want:    biz.RequestMetadata{RequestID: "req-5", ClientIP: "10.0.0.2", APIVersion: "v1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reply := &mockHeader{data: map[string][]string{}}
+			tr := new(mockTransport)
+			tr.On("RequestHeader").Return(&mockHeader{data: tt.headers})
+			tr.On("ReplyHeader").Return(reply)
+			ctx := transport.NewServerContext(context.Background(), tr)
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(tt.peer), Port: 51234}})
+
+			var got biz.RequestMetadata
+			_, err := RequestMetadata(proxies)(func(ctx context.Context, req interface{}) (interface{}, error) {
+				got = biz.GetRequestMetadata(ctx)
+				return nil, nil
+			})(ctx, nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want.RequestID, reply.Get(RequestIDHeader))
+		})
+	}
+}
+
+func TestRequestMetadata_GeneratesRequestID(t *testing.T) {
+	reply := &mockHeader{data: map[string][]string{}}
+	tr := new(mockTransport)
+	tr.On("RequestHeader").Return(&mockHeader{data: map[string][]string{}})
+	tr.On("ReplyHeader").Return(reply)
+	ctx := transport.NewServerContext(context.Background(), tr)
+
+	var requestID, source string
+	_, err := RequestMetadata(nil)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		requestID = biz.GetRequestID(ctx)
+		source = biz.GetSource(ctx)
+		return nil, nil
+	})(ctx, nil)
+
+	require.NoError(t, err)
+	assert.Len(t, requestID, 36)
+	assert.Equal(t, requestID, reply.Get(RequestIDHeader))
+	assert.Equal(t, biz.SourceAPI, source)
+}
+
+func TestAPIVersion(t *testing.T) {
+	assert.Equal(t, "v1", apiVersion("/employee.v1.EmployeeService/GetEmployee"))
+	assert.Equal(t, "v1", apiVersion("/admin.v1.AdminService/PurgeTenant"))
+	assert.Equal(t, "", apiVersion(""))
+	assert.Equal(t, "", apiVersion("/healthz"))
+}
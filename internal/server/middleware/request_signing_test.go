@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	employee "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// claimedNonces remembers the nonces claimed in a test
+type claimedNonces map[string]bool
+
+func (n claimedNonces) Claim(_ context.Context, keyID uuid.UUID, nonce string, _ time.Duration) (bool, error) {
+	if n[keyID.String()+nonce] {
+		return false, nil
+	}
+	n[keyID.String()+nonce] = true
+	return true, nil
+}
+
+// httpTransport is an HTTP transport of a request
+type httpTransport struct {
+	*mockTransport
+	request *http.Request
+}
+
+func (t *httpTransport) Request() *http.Request { return t.request }
+func (t *httpTransport) PathTemplate() string   { return t.request.URL.Path }
+
+var signingNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestRequestSignatures() *biz.RequestSignatures {
+	return biz.NewRequestSignatures(&conf.Auth{}, claimedNonces{}, biz.ClockFunc(func() time.Time { return signingNow }))
+}
+
+// signedContext is a request over tr authenticated with key
+func signedContext(tr transport.Transporter, key *biz.APIKey) context.Context {
+	ctx := transport.NewServerContext(context.Background(), tr)
+	ctx = biz.WithTenantID(ctx, "tenant-123")
+	return biz.WithAPIKey(ctx, key)
+}
+
+func TestRequestSigning_HTTP(t *testing.T) {
+	key := &biz.APIKey{ID: uuid.New(), TenantID: "tenant-123", SigningSecret: "essig_secret", RequireSignature: true}
+	body := `{"emails":["jane@example.com"]}`
+	signature := biz.SignRequest(key.SigningSecret, signingNow, "n-1", http.MethodPost, "/api/v1/employees?dry_run=true", []byte(body))
+	var failures []biz.AuthFailure
+	m := RequestSigning(newTestRequestSignatures(), func(_ context.Context, f biz.AuthFailure) { failures = append(failures, f) })
+	calls := 0
+	handler := m(func(context.Context, interface{}) (interface{}, error) {
+		calls++
+		return "ok", nil
+	})
+	call := func() error {
+		tr := &mockTransport{}
+		tr.On("RequestHeader").Return(&mockHeader{data: map[string][]string{SignatureHeader: {signature}}})
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/employees?dry_run=true", strings.NewReader(body))
+		_, err := handler(signedContext(&httpTransport{mockTransport: tr, request: r}, key), nil)
+		return err
+	}
+
+	require.NoError(t, call())
+	assert.Equal(t, 1, calls)
+
+	// A replay of the same request is rejected
+	err := call()
+	assert.Equal(t, "REQUEST_REPLAYED", errors.Reason(err))
+	assert.Equal(t, 1, calls)
+	require.Len(t, failures, 1)
+	assert.Equal(t, biz.AuthFailureRequestReplayed, failures[0].Reason)
+	assert.Equal(t, "tenant-123", failures[0].TenantID)
+}
+
+func TestRequestSigning_GRPC(t *testing.T) {
+	key := &biz.APIKey{ID: uuid.New(), TenantID: "tenant-123", SigningSecret: "essig_secret"}
+	req := &employee.CreateEmployeeRequest{Emails: []string{"jane@example.com"}}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	require.NoError(t, err)
+	signature := biz.SignRequest(key.SigningSecret, signingNow, "n-1", http.MethodPost, "/employee.v1.EmployeeService/CreateEmployee", body)
+	handler := RequestSigning(newTestRequestSignatures(), nil)(func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	tr := &mockTransport{}
+	tr.On("RequestHeader").Return(&mockHeader{data: map[string][]string{SignatureHeader: {signature}}})
+	_, err = handler(signedContext(tr, key), req)
+	require.NoError(t, err)
+
+	// The signature covers the request
+	tr = &mockTransport{}
+	tr.On("RequestHeader").Return(&mockHeader{data: map[string][]string{SignatureHeader: {signature}}})
+	_, err = handler(signedContext(tr, key), &employee.CreateEmployeeRequest{Emails: []string{"john@example.com"}})
+	assert.Equal(t, "INVALID_REQUEST_SIGNATURE", errors.Reason(err))
+}
+
+func TestRequestSigning_Unsigned(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     func(tr transport.Transporter) context.Context
+		wantErr bool
+	}{
+		{
+			name: "not an API key",
+			ctx: func(tr transport.Transporter) context.Context {
+				return transport.NewServerContext(context.Background(), tr)
+			},
+		},
+		{
+			name: "key without required signature",
+			ctx: func(tr transport.Transporter) context.Context {
+				return signedContext(tr, &biz.APIKey{ID: uuid.New(), SigningSecret: "essig_secret"})
+			},
+		},
+		{
+			name: "key requiring a signature",
+			ctx: func(tr transport.Transporter) context.Context {
+				return signedContext(tr, &biz.APIKey{ID: uuid.New(), SigningSecret: "essig_secret", RequireSignature: true})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var failures []biz.AuthFailure
+			handler := RequestSigning(newTestRequestSignatures(), func(_ context.Context, f biz.AuthFailure) { failures = append(failures, f) })(func(context.Context, interface{}) (interface{}, error) {
+				return "ok", nil
+			})
+			tr := &mockTransport{}
+			tr.On("RequestHeader").Return(&mockHeader{data: map[string][]string{}})
+
+			_, err := handler(tt.ctx(tr), &employee.CreateEmployeeRequest{})
+
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				assert.Empty(t, failures)
+				return
+			}
+			assert.Equal(t, "INVALID_REQUEST_SIGNATURE", errors.Reason(err))
+			require.Len(t, failures, 1)
+			assert.Equal(t, biz.AuthFailureInvalidRequestSignature, failures[0].Reason)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// RequireScopes creates an OAuth scope authorization middleware. scopes maps
+// an operation to the scope a token must grant to call it. It must run after
+// JWTAuth, which puts the scopes of the token into the context. Callers
+// without a scope claim, e.g. tokens of issuers that do not issue scopes, API
+// keys and client certificates, are left to Authorize; callers with one
+// cannot call operations without a scope.
+func RequireScopes(scopes map[string]string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			granted, ok := biz.GetScopes(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, errors.Forbidden("FORBIDDEN", "operation not permitted")
+			}
+			scope, ok := scopes[tr.Operation()]
+			if !ok {
+				return nil, errors.Forbidden("FORBIDDEN", "operation not permitted for scoped tokens")
+			}
+			if !slices.Contains(granted, scope) {
+				return nil, errors.Forbidden("FORBIDDEN", fmt.Sprintf("token lacks the %s scope", scope))
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireScopes(t *testing.T) {
+	scopes := map[string]string{
+		"/employee.v1.EmployeeService/GetEmployee":    "employees:read",
+		"/employee.v1.EmployeeService/MergeEmployees": "employees:merge",
+	}
+
+	tests := []struct {
+		name      string
+		scopes    []string
+		scoped    bool
+		operation string
+		wantErr   bool
+	}{
+		{
+			name:      "unscoped caller",
+			operation: "/admin.v1.AdminService/PurgeTenant",
+		},
+		{
+			name:      "granted scope",
+			scopes:    []string{"employees:read"},
+			scoped:    true,
+			operation: "/employee.v1.EmployeeService/GetEmployee",
+		},
+		{
+			name:      "one of several scopes",
+			scopes:    []string{"employees:read", "employees:merge"},
+			scoped:    true,
+			operation: "/employee.v1.EmployeeService/MergeEmployees",
+		},
+		{
+			name:      "missing scope",
+			scopes:    []string{"employees:read"},
+			scoped:    true,
+			operation: "/employee.v1.EmployeeService/MergeEmployees",
+			wantErr:   true,
+		},
+		{
+			name:      "empty scope claim",
+			scopes:    []string{},
+			scoped:    true,
+			operation: "/employee.v1.EmployeeService/GetEmployee",
+			wantErr:   true,
+		},
+		{
+			name:      "operation without scope",
+			scopes:    []string{"employees:read", "employees:merge"},
+			scoped:    true,
+			operation: "/admin.v1.AdminService/PurgeTenant",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := RequireScopes(scopes)(func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "success", nil
+			})
+
+			ctx := transport.NewServerContext(context.Background(), &operationTransport{operation: tt.operation})
+			if tt.scoped {
+				ctx = biz.WithScopes(ctx, tt.scopes)
+			}
+			_, err := handler(ctx, nil)
+
+			if tt.wantErr {
+				assert.True(t, errors.IsForbidden(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
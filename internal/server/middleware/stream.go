@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/grpc"
+)
+
+// StreamServerInterceptor applies Kratos middleware to streaming RPCs, which
+// Kratos only runs around unary handlers.
+//
+// The chain runs for every received message, after it has been decoded, so
+// validation sees the actual request. The context produced by the chain (e.g.
+// carrying the tenant and roles from the JWT) becomes the stream's context.
+func StreamServerInterceptor(m ...middleware.Middleware) grpc.StreamServerInterceptor {
+	chain := middleware.Chain(m...)
+
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ss.Context(), chain: chain})
+	}
+}
+
+// serverStream runs the middleware chain on received messages
+type serverStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	chain middleware.Middleware
+}
+
+// Context returns the context produced by the middleware chain
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg receives a message and passes it through the middleware chain
+func (s *serverStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	_, err := s.chain(func(ctx context.Context, req interface{}) (interface{}, error) {
+		s.ctx = ctx
+		return req, nil
+	})(s.ctx, m)
+	return err
+}
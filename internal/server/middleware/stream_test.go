@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type streamKey struct{}
+
+// fakeServerStream delivers a single message on RecvMsg
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	msg string
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	*(m.(*string)) = s.msg
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	// withValue puts the received message into the context, rejecting "bad"
+	withValue := func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			msg := *(req.(*string))
+			if msg == "bad" {
+				return nil, errors.BadRequest("VALIDATOR", "bad message")
+			}
+			return handler(context.WithValue(ctx, streamKey{}, msg), req)
+		}
+	}
+	interceptor := StreamServerInterceptor(withValue)
+
+	t.Run("context from chain", func(t *testing.T) {
+		ss := &fakeServerStream{ctx: context.Background(), msg: "hello"}
+
+		err := interceptor(nil, ss, &grpc.StreamServerInfo{}, func(_ interface{}, stream grpc.ServerStream) error {
+			var msg string
+			if err := stream.RecvMsg(&msg); err != nil {
+				return err
+			}
+			assert.Equal(t, "hello", stream.Context().Value(streamKey{}))
+			return nil
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("chain error", func(t *testing.T) {
+		ss := &fakeServerStream{ctx: context.Background(), msg: "bad"}
+
+		err := interceptor(nil, ss, &grpc.StreamServerInfo{}, func(_ interface{}, stream grpc.ServerStream) error {
+			var msg string
+			return stream.RecvMsg(&msg)
+		})
+
+		assert.True(t, errors.IsBadRequest(err))
+	})
+}
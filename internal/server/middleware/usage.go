@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// UsageTracking creates a middleware that counts each request of a tenant by
+// operation and response status. It must run after JWTAuth, which puts the
+// tenant into the context; requests without a tenant are not counted. Placed
+// before Authorize, it also counts the requests the caller's roles forbid.
+func UsageTracking(tracker *biz.UsageTracker) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+
+			tenantID, tenantErr := biz.GetTenantID(ctx)
+			tr, ok := transport.FromServerContext(ctx)
+			if tenantErr != nil || !ok {
+				return reply, err
+			}
+
+			status := int32(http.StatusOK)
+			if err != nil {
+				status = errors.FromError(err).Code
+			}
+			tracker.Record(tenantID, tr.Operation(), status)
+
+			return reply, err
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingUsageRepo keeps the records added to it
+type recordingUsageRepo struct {
+	records []*biz.UsageRecord
+}
+
+func (r *recordingUsageRepo) Add(_ context.Context, records []*biz.UsageRecord) error {
+	r.records = append(r.records, records...)
+	return nil
+}
+
+func (r *recordingUsageRepo) List(context.Context, string, time.Time, time.Time) ([]*biz.UsageRecord, error) {
+	return nil, nil
+}
+
+func TestUsageTracking(t *testing.T) {
+	repo := &recordingUsageRepo{}
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	tracker := biz.NewUsageTracker(repo, biz.ClockFunc(func() time.Time { return now }), log.NewStdLogger(io.Discard))
+	mw := UsageTracking(tracker)
+
+	tr := &operationTransport{operation: "/employee.v1.EmployeeService/GetEmployee"}
+	ctx := transport.NewServerContext(context.Background(), tr)
+	tenantCtx := biz.WithTenantID(ctx, "tenant-1")
+
+	ok := mw(func(context.Context, interface{}) (interface{}, error) { return "ok", nil })
+	notFound := mw(func(context.Context, interface{}) (interface{}, error) {
+		return nil, errors.NotFound("EMPLOYEE_NOT_FOUND", "employee not found")
+	})
+
+	_, err := ok(tenantCtx, nil)
+	require.NoError(t, err)
+	_, err = notFound(tenantCtx, nil)
+	require.Error(t, err)
+	_, err = ok(ctx, nil)
+	require.NoError(t, err, "requests without a tenant pass through")
+
+	_, err = tracker.Flush(context.Background())
+	require.NoError(t, err)
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	assert.ElementsMatch(t, []*biz.UsageRecord{
+		{TenantID: "tenant-1", Day: day, Operation: tr.operation, Status: 200, Count: 1},
+		{TenantID: "tenant-1", Day: day, Operation: tr.operation, Status: 404, Count: 1},
+	}, repo.records)
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"sort"
+
+	kratosMiddleware "github.com/go-kratos/kratos/v2/middleware"
+)
+
+// Position is where in the middleware chain of the servers an extra
+// middleware runs
+type Position int
+
+const (
+	// BeforeObservability runs right after panic recovery, before tracing,
+	// logging and metrics, e.g. to check WAF headers. It does not run for
+	// gRPC streams.
+	BeforeObservability Position = iota
+	// BeforeAuth runs after the request metadata, API versioning and
+	// validation, before authentication, e.g. to require a corporate auth
+	// header alongside the token
+	BeforeAuth
+	// AfterAuth runs after authentication and authorization, with the
+	// caller's tenant, user and roles in the context
+	AfterAuth
+)
+
+// ExtraMiddleware is a middleware a deployment adds to both servers
+type ExtraMiddleware struct {
+	// Name identifies the middleware in logs
+	Name     string
+	Position Position
+	// Order sorts the middlewares at a position, lowest first; ties keep
+	// the order of the slice
+	Order      int
+	Middleware kratosMiddleware.Middleware
+}
+
+// ExtraMiddlewares are the middlewares a deployment adds to both servers.
+// Deployments provide them through wire in place of NoExtraMiddlewares, so
+// that no server constructor has to change.
+type ExtraMiddlewares []ExtraMiddleware
+
+// NoExtraMiddlewares provides no extra middlewares
+func NoExtraMiddlewares() ExtraMiddlewares {
+	return nil
+}
+
+// at returns the middlewares at position p in order
+func (e ExtraMiddlewares) at(p Position) []kratosMiddleware.Middleware {
+	var extras []ExtraMiddleware
+	for _, extra := range e {
+		if extra.Position == p {
+			extras = append(extras, extra)
+		}
+	}
+	sort.SliceStable(extras, func(i, j int) bool { return extras[i].Order < extras[j].Order })
+
+	middlewares := make([]kratosMiddleware.Middleware, len(extras))
+	for i, extra := range extras {
+		middlewares[i] = extra.Middleware
+	}
+	return middlewares
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	kratosMiddleware "github.com/go-kratos/kratos/v2/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtraMiddlewares_At(t *testing.T) {
+	var calls []string
+	record := func(name string) kratosMiddleware.Middleware {
+		return func(next kratosMiddleware.Handler) kratosMiddleware.Handler {
+			return func(ctx context.Context, req interface{}) (interface{}, error) {
+				calls = append(calls, name)
+				return next(ctx, req)
+			}
+		}
+	}
+	extras := ExtraMiddlewares{
+		{Name: "corporate-auth", Position: BeforeAuth, Order: 10, Middleware: record("corporate-auth")},
+		{Name: "waf", Position: BeforeObservability, Middleware: record("waf")},
+		{Name: "tenant-header", Position: BeforeAuth, Middleware: record("tenant-header")},
+		{Name: "geo-block", Position: BeforeAuth, Middleware: record("geo-block")},
+	}
+
+	handler := kratosMiddleware.Chain(extras.at(BeforeAuth)...)(func(context.Context, interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	_, _ = handler(context.Background(), nil)
+
+	assert.Equal(t, []string{"tenant-header", "geo-block", "corporate-auth"}, calls)
+	assert.Len(t, extras.at(BeforeObservability), 1)
+	assert.Empty(t, extras.at(AfterAuth))
+	assert.Empty(t, NoExtraMiddlewares().at(AfterAuth))
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultReadAuditFlushInterval is how often queued employee reads are written
+const defaultReadAuditFlushInterval = time.Second
+
+// ReadAuditFlushJob writes the employee reads queued by the read audit writer,
+// every interval and whenever a full batch is queued
+type ReadAuditFlushJob struct {
+	writer   *data.ReadAuditWriter
+	interval time.Duration
+	log      *log.Helper
+}
+
+// NewReadAuditFlushJob creates the read audit flush job. It does nothing when
+// reads are not audited.
+func NewReadAuditFlushJob(c *conf.Data, writer *data.ReadAuditWriter, logger log.Logger) *ReadAuditFlushJob {
+	j := &ReadAuditFlushJob{
+		writer:   writer,
+		interval: defaultReadAuditFlushInterval,
+		log:      log.NewHelper(logger),
+	}
+	if interval := c.GetReadAudit().GetFlushInterval(); interval != nil && interval.AsDuration() > 0 {
+		j.interval = interval.AsDuration()
+	}
+	return j
+}
+
+// Start runs the job in the background until ctx is done. It is meant for kratos.AfterStart.
+func (j *ReadAuditFlushJob) Start(ctx context.Context) error {
+	if j.writer == nil {
+		return nil
+	}
+
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-j.writer.Ready():
+			}
+			j.Run(ctx)
+		}
+	}()
+	return nil
+}
+
+// Stop writes the reads still queued, so they are not lost on shutdown. It
+// is meant for kratos.BeforeStop.
+func (j *ReadAuditFlushJob) Stop(ctx context.Context) error {
+	if j.writer == nil {
+		return nil
+	}
+	j.Run(ctx)
+	return nil
+}
+
+// Run writes the queued reads once
+func (j *ReadAuditFlushJob) Run(ctx context.Context) {
+	if written, err := j.writer.Flush(ctx); err != nil {
+		j.log.Errorf("failed to write read audit after %d entries: %v", written, err)
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultSchedulePollInterval is how often the scheduler looks for due changes
+const defaultSchedulePollInterval = 10 * time.Second
+
+// ScheduleWorker applies scheduled creates and updates once they are due
+type ScheduleWorker struct {
+	uc       *biz.ScheduleUsecase
+	interval time.Duration
+	log      *log.Helper
+}
+
+// NewScheduleWorker creates the scheduler.
+func NewScheduleWorker(c *conf.Admin, uc *biz.ScheduleUsecase, logger log.Logger) *ScheduleWorker {
+	w := &ScheduleWorker{
+		uc:       uc,
+		interval: defaultSchedulePollInterval,
+		log:      log.NewHelper(logger),
+	}
+	if interval := c.GetSchedule().GetPollInterval(); interval != nil && interval.AsDuration() > 0 {
+		w.interval = interval.AsDuration()
+	}
+	return w
+}
+
+// Start runs the worker in the background until ctx is done. It is meant for kratos.AfterStart.
+func (w *ScheduleWorker) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			w.Run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Run applies due changes until none are left
+func (w *ScheduleWorker) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		found, err := w.uc.RunNext(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				w.log.Errorf("scheduled change failed: %v", err)
+			}
+			return
+		}
+		if !found {
+			return
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	admin "github.com/cvele/employee-service/api/admin/v1"
+	auth "github.com/cvele/employee-service/api/auth/v1"
+	department "github.com/cvele/employee-service/api/department/v1"
+	employee "github.com/cvele/employee-service/api/employee/v1"
+	team "github.com/cvele/employee-service/api/team/v1"
+	webhook "github.com/cvele/employee-service/api/webhook/v1"
+	"github.com/cvele/employee-service/internal/service"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// scopedFiles are the protos of the services the servers register
+var scopedFiles = []protoreflect.FileDescriptor{
+	employee.File_employee_v1_employee_proto,
+	admin.File_admin_v1_admin_proto,
+	webhook.File_webhook_v1_webhook_proto,
+	department.File_department_v1_department_proto,
+	team.File_team_v1_team_proto,
+}
+
+// operationScopes returns the operation -> scope map used by the scope
+// middleware, read from the auth.v1.scope option of the service methods so
+// that both servers enforce the same scopes. Methods without the option are
+// left out.
+func operationScopes() map[string]string {
+	scopes := make(map[string]string)
+	for _, file := range scopedFiles {
+		for i := 0; i < file.Services().Len(); i++ {
+			svc := file.Services().Get(i)
+			for j := 0; j < svc.Methods().Len(); j++ {
+				method := svc.Methods().Get(j)
+				scope, _ := proto.GetExtension(method.Options(), auth.E_Scope).(string)
+				if scope != "" {
+					scopes["/"+string(svc.FullName())+"/"+string(method.Name())] = scope
+				}
+			}
+		}
+	}
+
+	// The streaming export is not a method of the proto service
+	scopes[service.OperationEmployeeServiceExportEmployees] = "employees:read"
+	return scopes
+}
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationScopes(t *testing.T) {
+	scopes := operationScopes()
+
+	// Every method must declare a scope, or scoped tokens cannot call it
+	for _, file := range scopedFiles {
+		for i := 0; i < file.Services().Len(); i++ {
+			svc := file.Services().Get(i)
+			for j := 0; j < svc.Methods().Len(); j++ {
+				operation := "/" + string(svc.FullName()) + "/" + string(svc.Methods().Get(j).Name())
+				assert.Contains(t, scopes, operation, "%s has no auth.v1.scope option", operation)
+			}
+		}
+	}
+
+	assert.Equal(t, "employees:read", scopes["/employee.v1.EmployeeService/GetEmployee"])
+	assert.Equal(t, "employees:write", scopes["/employee.v1.EmployeeService/UpdateEmployee"])
+	assert.Equal(t, "employees:merge", scopes["/employee.v1.EmployeeService/MergeEmployees"])
+	assert.Equal(t, "employees:admin", scopes["/admin.v1.AdminService/PurgeTenant"])
+	assert.Equal(t, "employees:read", scopes["/employee.v1.EmployeeService/ExportEmployees"])
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/secrets"
+	"github.com/cvele/employee-service/internal/server/middleware"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SecretRotationJob periodically renews the Vault token and reloads the JWT
+// secret, rotating it in the token verifier when it changed
+type SecretRotationJob struct {
+	source   *secrets.Source
+	verifier *middleware.TokenVerifier
+	log      *log.Helper
+}
+
+// NewSecretRotationJob creates the secret rotation job. It does nothing
+// unless secrets come from Vault or a JWT secret file.
+func NewSecretRotationJob(source *secrets.Source, verifier *middleware.TokenVerifier, logger log.Logger) *SecretRotationJob {
+	return &SecretRotationJob{
+		source:   source,
+		verifier: verifier,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// NewSecretReloader reloads the secrets of the ReloadSecrets runbook
+// operation with the secret rotation job
+func NewSecretReloader(job *SecretRotationJob) biz.SecretReloader {
+	return job
+}
+
+// Start runs the job in the background until ctx is done. It is meant for kratos.AfterStart.
+func (j *SecretRotationJob) Start(ctx context.Context) error {
+	if !j.source.Refreshes() {
+		return nil
+	}
+
+	go func() {
+		ticker := time.NewTicker(j.source.RefreshInterval())
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				j.Run(ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+// Run renews the Vault token and reloads the JWT secret once. The current
+// secret is kept when it cannot be reloaded.
+func (j *SecretRotationJob) Run(ctx context.Context) {
+	if _, err := j.reload(ctx); err != nil {
+		j.log.Errorf("failed to reload the JWT secret (keeping the current one): %v", err)
+	}
+}
+
+// ReloadSecrets implements biz.SecretReloader for the ReloadSecrets runbook
+// operation.
+func (j *SecretRotationJob) ReloadSecrets(ctx context.Context) (bool, error) {
+	if !j.source.Refreshes() {
+		return false, biz.RunbookActionUnavailable("secrets are only configured statically")
+	}
+	return j.reload(ctx)
+}
+
+// reload renews the Vault token and rotates the JWT secret when it changed
+func (j *SecretRotationJob) reload(ctx context.Context) (bool, error) {
+	if err := j.source.Renew(ctx); err != nil {
+		j.log.Errorf("failed to renew the Vault token: %v", err)
+	}
+	secret, err := j.source.JWTSecret(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !j.verifier.RotateSecret(secret, j.source.JWTSecretGrace()) {
+		return false, nil
+	}
+	j.log.Infof("JWT secret rotated; tokens signed with the previous secret are accepted for %s", j.source.JWTSecretGrace())
+	return true, nil
+}
@@ -1,16 +1,125 @@
 package server
 
 import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"os"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
 	"github.com/cvele/employee-service/internal/data"
+	"github.com/cvele/employee-service/internal/server/middleware"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, ProvideHealthChecker)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, ProvideHealthChecker, ProvideTokenVerifier, NewWarmup, NewAuditArchiveJob, NewIdempotencyCleanupJob, NewWebhookWorker, NewImportWorker, NewScheduleWorker, NewCloneWorker, NewUsageFlushJob, NewReadAuditFlushJob, NewStaleJob, NewSecretRotationJob, NewEmployeeCountJob, NewSecretReloader)
+
+// ProvideTokenVerifier creates the verifier of the JWTs of both servers, so
+// that they share the cached keys of the JWKS. The JWT secret falls back to
+// the JWT_SECRET environment variable.
+func ProvideTokenVerifier(auth *conf.Auth) *middleware.TokenVerifier {
+	jwtSecret := auth.JwtSecret
+	if jwtSecret == "" {
+		jwtSecret = os.Getenv("JWT_SECRET")
+	}
+	keys := middleware.TokenKeys{Secret: jwtSecret, JWKS: newJWKS(auth.GetJwks())}
+
+	issuers := make(map[string]middleware.TokenKeys, len(auth.GetIssuers()))
+	for _, issuer := range auth.GetIssuers() {
+		if issuer.Issuer == "" || (issuer.JwtSecret == "" && issuer.GetJwks().GetUrl() == "") {
+			log.Fatalf("auth.issuers: issuer %q needs a jwt_secret or jwks.url", issuer.Issuer)
+		}
+		issuers[issuer.Issuer] = middleware.TokenKeys{Secret: issuer.JwtSecret, JWKS: newJWKS(issuer.GetJwks())}
+	}
+
+	if keys.Secret == "" && keys.JWKS == nil && len(issuers) == 0 {
+		log.Fatal("neither JWT_SECRET, auth.jwks.url nor auth.issuers is configured")
+	}
+	return middleware.NewTokenVerifier(keys, issuers, auth.GetAudiences())
+}
+
+// newJWKS creates the key set configured in c, nil without URL
+func newJWKS(c *conf.Auth_JWKS) *middleware.JWKS {
+	if c.GetUrl() == "" {
+		return nil
+	}
+	refresh, minRefresh, timeout := time.Hour, time.Minute, 5*time.Second
+	if d := c.GetRefreshInterval(); d != nil && d.AsDuration() > 0 {
+		refresh = d.AsDuration()
+	}
+	if d := c.GetMinRefreshInterval(); d != nil && d.AsDuration() > 0 {
+		minRefresh = d.AsDuration()
+	}
+	if d := c.GetTimeout(); d != nil && d.AsDuration() > 0 {
+		timeout = d.AsDuration()
+	}
+	return middleware.NewJWKS(c.Url, &http.Client{Timeout: timeout}, refresh, minRefresh)
+}
 
 // ProvideHealthChecker creates a health checker from the data layer
 func ProvideHealthChecker(d *data.Data, logger log.Logger) *HealthChecker {
 	return NewHealthChecker(d.GetDB(), d.GetNATS(), logger)
 }
+
+// rolePermissions converts the configured roles into the role -> operations
+// map used by the authorization middleware
+func rolePermissions(auth *conf.Auth) map[string][]string {
+	permissions := make(map[string][]string, len(auth.GetRoles()))
+	for role, r := range auth.GetRoles() {
+		permissions[role] = r.GetOperations()
+	}
+	return permissions
+}
+
+// exemptOperations returns the operations served without authentication,
+// rejecting a bare "*" that would exempt every operation
+func exemptOperations(auth *conf.Auth) ([]string, error) {
+	for _, operation := range auth.GetExemptOperations() {
+		if operation == "*" || operation == "/*" {
+			return nil, fmt.Errorf("auth.exempt_operations: %q would exempt every operation", operation)
+		}
+	}
+	return auth.GetExemptOperations(), nil
+}
+
+// deprecationRegistry converts the configured deprecations into the registry
+// used by the deprecation middleware
+func deprecationRegistry(c *conf.Server) (middleware.DeprecationRegistry, error) {
+	registry := make(middleware.DeprecationRegistry, len(c.GetDeprecations()))
+	for _, d := range c.GetDeprecations() {
+		since, err := time.Parse(time.RFC3339, d.GetSince())
+		if err != nil {
+			return nil, fmt.Errorf("deprecation of %s: invalid since: %w", d.GetOperation(), err)
+		}
+		deprecation := middleware.Deprecation{Since: since, Link: d.GetLink()}
+		if d.GetSunset() != "" {
+			if deprecation.Sunset, err = time.Parse(time.RFC3339, d.GetSunset()); err != nil {
+				return nil, fmt.Errorf("deprecation of %s: invalid sunset: %w", d.GetOperation(), err)
+			}
+		}
+		registry[d.GetOperation()] = deprecation
+	}
+	return registry, nil
+}
+
+// trustedProxies parses the networks of the trusted proxies; a single
+// address is a network of its own
+func trustedProxies(c *conf.Server) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(c.GetTrustedProxies()))
+	for _, proxy := range c.GetTrustedProxies() {
+		if addr, err := netip.ParseAddr(proxy); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("trusted_proxies: %w", err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
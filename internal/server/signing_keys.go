@@ -0,0 +1,23 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cvele/employee-service/pkg/eventcrypto"
+)
+
+// EventSigningKeysPath is where the public keys verifying event signatures
+// are published
+const EventSigningKeysPath = "/.well-known/event-signing-keys"
+
+// EventSigningKeysHandler serves the event signing keys as a JSON Web Key Set
+func EventSigningKeysHandler(keys *eventcrypto.JWKS) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/jwk-set+json")
+		// Consumers refetch at most every five minutes, which bounds how
+		// early a new key must be published before it signs events
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_ = json.NewEncoder(w).Encode(keys)
+	}
+}
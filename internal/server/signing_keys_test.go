@@ -0,0 +1,32 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cvele/employee-service/pkg/eventcrypto"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSigningKeysHandler(t *testing.T) {
+	signer, err := eventcrypto.NewSigner("sig-1", bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(EventSigningKeysHandler(&eventcrypto.JWKS{
+		Keys: []eventcrypto.JWK{eventcrypto.PublicJWK(signer.KeyID(), signer.PublicKey())},
+	}))
+	defer srv.Close()
+
+	// The published set is what consumers verify with
+	verifier, err := eventcrypto.FetchVerifier(context.Background(), srv.Client(), srv.URL+EventSigningKeysPath)
+	require.NoError(t, err)
+
+	msg := &nats.Msg{Subject: "employees.v1.created", Data: []byte("payload")}
+	signer.Sign(msg)
+	assert.NoError(t, verifier.Verify(msg))
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultStaleInterval is how often the stale-record job runs
+const defaultStaleInterval = time.Hour
+
+// StaleJob periodically flags stale employees
+type StaleJob struct {
+	uc       *biz.StaleUsecase
+	interval time.Duration
+	log      *log.Helper
+}
+
+// NewStaleJob creates the stale-record job. It does nothing when flagging is disabled.
+func NewStaleJob(c *conf.Admin, uc *biz.StaleUsecase, logger log.Logger) *StaleJob {
+	j := &StaleJob{
+		uc:       uc,
+		interval: defaultStaleInterval,
+		log:      log.NewHelper(logger),
+	}
+	if interval := c.GetStale().GetInterval(); interval != nil && interval.AsDuration() > 0 {
+		j.interval = interval.AsDuration()
+	}
+	return j
+}
+
+// Start runs the job in the background until ctx is done. It is meant for kratos.AfterStart.
+func (j *StaleJob) Start(ctx context.Context) error {
+	if !j.uc.Enabled() {
+		return nil
+	}
+
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.Run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Run flags stale employees batch by batch until none are left
+func (j *StaleJob) Run(ctx context.Context) {
+	total := 0
+	for ctx.Err() == nil {
+		flagged, more, err := j.uc.FlagNext(ctx)
+		total += flagged
+		if err != nil {
+			if ctx.Err() == nil {
+				j.log.Errorf("stale-record flagging failed after %d employees: %v", total, err)
+			}
+			return
+		}
+		if !more {
+			break
+		}
+	}
+	if total > 0 {
+		j.log.Infof("flagged %d stale employees", total)
+	}
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultUsageFlushInterval is how often the API usage counters are written
+const defaultUsageFlushInterval = time.Minute
+
+// UsageFlushJob periodically adds the in-memory API usage counters to the
+// daily usage table
+type UsageFlushJob struct {
+	tracker  *biz.UsageTracker
+	interval time.Duration
+	log      *log.Helper
+}
+
+// NewUsageFlushJob creates the usage flush job
+func NewUsageFlushJob(c *conf.Admin, tracker *biz.UsageTracker, logger log.Logger) *UsageFlushJob {
+	j := &UsageFlushJob{
+		tracker:  tracker,
+		interval: defaultUsageFlushInterval,
+		log:      log.NewHelper(logger),
+	}
+	if interval := c.GetUsage().GetFlushInterval(); interval != nil && interval.AsDuration() > 0 {
+		j.interval = interval.AsDuration()
+	}
+	return j
+}
+
+// Start runs the job in the background until ctx is done. It is meant for kratos.AfterStart.
+func (j *UsageFlushJob) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				j.Run(ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop writes the counts recorded since the last run, so they are not lost
+// on shutdown. It is meant for kratos.BeforeStop.
+func (j *UsageFlushJob) Stop(ctx context.Context) error {
+	j.Run(ctx)
+	return nil
+}
+
+// Run writes the recorded counts once
+func (j *UsageFlushJob) Run(ctx context.Context) {
+	if _, err := j.tracker.Flush(ctx); err != nil {
+		j.log.Errorf("failed to flush API usage: %v", err)
+	}
+}
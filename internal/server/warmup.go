@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// defaultWarmupTimeout bounds the whole warm-up phase
+	defaultWarmupTimeout = 30 * time.Second
+	// defaultWarmupDBConnections is the number of connections opened up front
+	defaultWarmupDBConnections = 4
+)
+
+// WarmupHook primes one dependency before the service reports ready
+type WarmupHook struct {
+	Name string
+	Fn   func(ctx context.Context) error
+}
+
+// Warmup runs the startup warm-up hooks and flips readiness once they are done
+type Warmup struct {
+	enabled bool
+	timeout time.Duration
+	hooks   []WarmupHook
+	health  *HealthChecker
+	log     *log.Helper
+}
+
+// NewWarmup creates the startup warm-up with the database priming hooks
+// registered. Readiness fails from now on until Start has run all hooks.
+func NewWarmup(c *conf.Server, health *HealthChecker, d *data.Data, repo biz.EmployeeRepo, logger log.Logger) *Warmup {
+	wc := c.GetWarmup()
+	w := &Warmup{
+		enabled: wc.GetEnabled(),
+		timeout: defaultWarmupTimeout,
+		health:  health,
+		log:     log.NewHelper(logger),
+	}
+	if wc.GetTimeout() != nil {
+		w.timeout = wc.GetTimeout().AsDuration()
+	}
+
+	connections := int(wc.GetDbConnections())
+	if connections <= 0 {
+		connections = defaultWarmupDBConnections
+	}
+
+	w.Register("database pool", func(ctx context.Context) error {
+		return d.WarmPool(ctx, connections)
+	})
+	w.Register("prepared statements", func(ctx context.Context) error {
+		return data.PrimeStatements(ctx, repo, connections)
+	})
+
+	if w.enabled {
+		health.SetWarmingUp(true)
+	}
+
+	return w
+}
+
+// Register adds a hook, run in registration order
+func (w *Warmup) Register(name string, fn func(ctx context.Context) error) {
+	w.hooks = append(w.hooks, WarmupHook{Name: name, Fn: fn})
+}
+
+// Start runs the warm-up in the background so that the servers keep
+// answering liveness probes meanwhile. It is meant for kratos.AfterStart.
+func (w *Warmup) Start(ctx context.Context) error {
+	if !w.enabled {
+		return nil
+	}
+
+	go w.Run(ctx)
+	return nil
+}
+
+// Run executes all hooks and marks the service ready. Warm-up is best
+// effort: failing hooks are logged and do not keep the service unready.
+func (w *Warmup) Run(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+
+	start := time.Now()
+	for _, hook := range w.hooks {
+		hookStart := time.Now()
+		if err := hook.Fn(ctx); err != nil {
+			w.log.Warnf("warm-up %s failed after %s: %v", hook.Name, time.Since(hookStart), err)
+			continue
+		}
+		w.log.Infof("warm-up %s done in %s", hook.Name, time.Since(hookStart))
+	}
+
+	w.health.SetWarmingUp(false)
+	w.log.Infof("warm-up finished in %s, service is ready", time.Since(start))
+}
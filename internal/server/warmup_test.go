@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestWarmup(enabled bool, health *HealthChecker) *Warmup {
+	health.SetWarmingUp(enabled)
+	return &Warmup{
+		enabled: enabled,
+		timeout: time.Second,
+		health:  health,
+		log:     log.NewHelper(newTestLogger()),
+	}
+}
+
+func TestWarmup_FlipsReadiness(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	health := NewHealthChecker(db, nil, newTestLogger())
+	w := newTestWarmup(true, health)
+
+	var order []string
+	w.Register("first", func(ctx context.Context) error {
+		order = append(order, "first")
+		return nil
+	})
+	w.Register("second", func(ctx context.Context) error {
+		order = append(order, "second")
+		return nil
+	})
+
+	err := health.CheckReadiness(context.Background())
+	assert.EqualError(t, err, "warming up")
+
+	w.Run(context.Background())
+
+	mock.ExpectPing()
+	assert.NoError(t, health.CheckReadiness(context.Background()))
+	assert.Equal(t, []string{"first", "second"}, order)
+}
+
+func TestWarmup_FailingHookIsBestEffort(t *testing.T) {
+	health := NewHealthChecker(nil, nil, newTestLogger())
+	w := newTestWarmup(true, health)
+
+	ran := false
+	w.Register("broken", func(ctx context.Context) error {
+		return errors.New("connection refused")
+	})
+	w.Register("next", func(ctx context.Context) error {
+		ran = true
+		return nil
+	})
+
+	w.Run(context.Background())
+
+	assert.True(t, ran)
+	assert.False(t, health.warmingUp.Load())
+}
+
+func TestWarmup_Disabled(t *testing.T) {
+	health := NewHealthChecker(nil, nil, newTestLogger())
+	w := newTestWarmup(false, health)
+
+	w.Register("hook", func(ctx context.Context) error {
+		t.Error("hook must not run when warm-up is disabled")
+		return nil
+	})
+
+	assert.NoError(t, w.Start(context.Background()))
+	assert.False(t, health.warmingUp.Load())
+}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cvele/employee-service/internal/conf"
+	"github.com/cvele/employee-service/internal/data"
+	"github.com/cvele/employee-service/internal/observability"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultWebhookPollInterval is how often the worker looks for new events and due deliveries
+const defaultWebhookPollInterval = time.Second
+
+// WebhookWorker periodically fans employee events out to webhooks and sends due deliveries
+type WebhookWorker struct {
+	dispatcher *data.WebhookDispatcher
+	interval   time.Duration
+	obs        *observability.Observability
+	log        *log.Helper
+}
+
+// NewWebhookWorker creates the webhook worker. It does nothing when webhooks are disabled.
+func NewWebhookWorker(c *conf.Data, dispatcher *data.WebhookDispatcher, obs *observability.Observability, logger log.Logger) *WebhookWorker {
+	w := &WebhookWorker{
+		dispatcher: dispatcher,
+		interval:   defaultWebhookPollInterval,
+		obs:        obs,
+		log:        log.NewHelper(logger),
+	}
+	if interval := c.GetWebhooks().GetPollInterval(); interval != nil && interval.AsDuration() > 0 {
+		w.interval = interval.AsDuration()
+	}
+	return w
+}
+
+// Start runs the worker in the background until ctx is done. It is meant for kratos.AfterStart.
+func (w *WebhookWorker) Start(ctx context.Context) error {
+	if w.dispatcher == nil {
+		return nil
+	}
+
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			w.Run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Run fans out new events, builds the digests of closed windows and sends
+// every due delivery once
+func (w *WebhookWorker) Run(ctx context.Context) {
+	if queued, err := w.dispatcher.FanOut(ctx); err != nil {
+		w.log.Errorf("webhook fan-out failed: %v", err)
+	} else if queued > 0 {
+		w.log.Debugf("queued %d webhook deliveries", queued)
+	}
+
+	if built, err := w.dispatcher.BuildDigests(ctx); err != nil {
+		w.log.Errorf("webhook digests failed: %v", err)
+	} else if built > 0 {
+		w.log.Debugf("queued %d webhook digests", built)
+	}
+
+	stats, err := w.dispatcher.DeliverDue(ctx)
+	if err != nil && ctx.Err() == nil {
+		w.log.Errorf("webhook delivery failed: %v", err)
+	}
+	w.obs.RecordWebhookDeliveries(stats.Succeeded, stats.Retried, stats.Failed)
+}
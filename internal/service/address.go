@@ -0,0 +1,53 @@
+package service
+
+import (
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// errConflictingAddress is an update both replacing and clearing the address
+var errConflictingAddress = errors.BadRequest(v1.ErrorReason_INVALID_ADDRESS.String(), "clear_address cannot be combined with address")
+
+// toBizAddress converts a proto postal address, nil meaning none
+func toBizAddress(a *v1.PostalAddress) *biz.Address {
+	if a == nil {
+		return nil
+	}
+	return &biz.Address{
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+	}
+}
+
+// toProtoAddress converts a biz address, nil meaning none
+func toProtoAddress(a *biz.Address) *v1.PostalAddress {
+	if a == nil {
+		return nil
+	}
+	return &v1.PostalAddress{
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+	}
+}
+
+// updateAddress returns the address of an update request, nil keeping it
+// and an empty address removing it
+func updateAddress(req *v1.UpdateEmployeeRequest) (*biz.Address, error) {
+	if req.ClearAddress {
+		if req.Address != nil {
+			return nil, errConflictingAddress
+		}
+		return &biz.Address{}, nil
+	}
+	return toBizAddress(req.Address), nil
+}
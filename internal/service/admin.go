@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/admin/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdminService is an admin service.
+type AdminService struct {
+	v1.UnimplementedAdminServiceServer
+
+	uc      *biz.AdminUsecase
+	audit   *biz.AuditUsecase
+	imports *biz.ImportUsecase
+	usage   *biz.UsageTracker
+	// inFlight is nil when requests are not tracked
+	inFlight    *biz.InFlightRegistry
+	settings    *biz.TenantSettingsUsecase
+	apiKeys     *biz.APIKeyUsecase
+	backfill    *biz.EventBackfillUsecase
+	revocations *biz.TokenRevocationUsecase
+	clones      *biz.TenantCloneUsecase
+	runbook     *biz.RunbookUsecase
+}
+
+// NewAdminService creates a new admin service.
+func NewAdminService(uc *biz.AdminUsecase, audit *biz.AuditUsecase, imports *biz.ImportUsecase, usage *biz.UsageTracker, inFlight *biz.InFlightRegistry, settings *biz.TenantSettingsUsecase, apiKeys *biz.APIKeyUsecase, backfill *biz.EventBackfillUsecase, revocations *biz.TokenRevocationUsecase, clones *biz.TenantCloneUsecase, runbook *biz.RunbookUsecase) *AdminService {
+	return &AdminService{uc: uc, audit: audit, imports: imports, usage: usage, inFlight: inFlight, settings: settings, apiKeys: apiKeys, backfill: backfill, revocations: revocations, clones: clones, runbook: runbook}
+}
+
+// toProtoChallenge converts biz.ConfirmationChallenge to proto ConfirmationChallenge
+func toProtoChallenge(c *biz.ConfirmationChallenge) *v1.ConfirmationChallenge {
+	if c == nil {
+		return nil
+	}
+
+	return &v1.ConfirmationChallenge{
+		Token:         c.Token,
+		ExpiresAt:     timestamppb.New(c.ExpiresAt),
+		AffectedCount: c.AffectedCount,
+		Summary:       c.Summary,
+	}
+}
+
+// toProtoSnapshot converts an audited biz.Employee to proto EmployeeSnapshot
+func toProtoSnapshot(e *biz.Employee) *v1.EmployeeSnapshot {
+	if e == nil {
+		return nil
+	}
+
+	emails := e.Emails
+	if emails == nil {
+		emails = []string{}
+	}
+
+	return &v1.EmployeeSnapshot{
+		Id:        e.ID.String(),
+		Emails:    emails,
+		FirstName: e.FirstName,
+		LastName:  e.LastName,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+		UpdatedAt: timestamppb.New(e.UpdatedAt),
+	}
+}
+
+// toProtoAuditEntry converts biz.AuditEntry to proto AuditEntry
+func toProtoAuditEntry(e *biz.AuditEntry) *v1.AuditEntry {
+	return &v1.AuditEntry{
+		Id:                   e.ID.String(),
+		EmployeeId:           e.EmployeeID.String(),
+		Action:               e.Action,
+		ActorId:              e.ActorID,
+		RequestId:            e.RequestID,
+		ClientIp:             e.ClientIP,
+		ImpersonatorId:       e.ImpersonatorID,
+		ImpersonatorTenantId: e.ImpersonatorTenantID,
+		Before:               toProtoSnapshot(e.Before),
+		After:                toProtoSnapshot(e.After),
+		CreatedAt:            timestamppb.New(e.CreatedAt),
+	}
+}
+
+// toProtoImport converts biz.ImportOperation to proto ImportOperation
+func toProtoImport(op *biz.ImportOperation) *v1.ImportOperation {
+	rowErrors := make([]*v1.ImportRowError, len(op.Errors))
+	for i, e := range op.Errors {
+		rowErrors[i] = &v1.ImportRowError{
+			Row:     e.Row,
+			Email:   e.Email,
+			Message: e.Message,
+		}
+	}
+
+	protoOp := &v1.ImportOperation{
+		Id:              op.ID.String(),
+		Status:          op.Status,
+		TotalRows:       op.TotalRows,
+		ProcessedRows:   op.ProcessedRows,
+		CreatedCount:    op.CreatedCount,
+		FailedCount:     op.FailedCount,
+		Errors:          rowErrors,
+		ErrorsTruncated: op.ErrorsTruncated(),
+		Error:           op.Error,
+		CreatedAt:       timestamppb.New(op.CreatedAt),
+		UpdatedAt:       timestamppb.New(op.UpdatedAt),
+	}
+	if op.CompletedAt != nil {
+		protoOp.CompletedAt = timestamppb.New(*op.CompletedAt)
+	}
+	return protoOp
+}
+
+// PurgeTenant deletes all employees of the caller's tenant after confirmation.
+func (s *AdminService) PurgeTenant(ctx context.Context, req *v1.PurgeTenantRequest) (*v1.PurgeTenantResponse, error) {
+	result, err := s.uc.PurgeTenant(ctx, req.ConfirmationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.PurgeTenantResponse{
+		Confirmation: toProtoChallenge(result.Challenge),
+		DeletedCount: result.Deleted,
+	}, nil
+}
+
+// BulkDeleteEmployees deletes a set of employees after confirmation.
+func (s *AdminService) BulkDeleteEmployees(ctx context.Context, req *v1.BulkDeleteEmployeesRequest) (*v1.BulkDeleteEmployeesResponse, error) {
+	ids := make([]uuid.UUID, len(req.Ids))
+	for i, raw := range req.Ids {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+		}
+		ids[i] = id
+	}
+
+	result, err := s.uc.BulkDeleteEmployees(ctx, ids, req.ConfirmationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.BulkDeleteEmployeesResponse{
+		Confirmation: toProtoChallenge(result.Challenge),
+		DeletedCount: result.Deleted,
+	}, nil
+}
+
+// ListAuditEntries lists the audit log of the caller's tenant.
+func (s *AdminService) ListAuditEntries(ctx context.Context, req *v1.ListAuditEntriesRequest) (*v1.ListAuditEntriesResponse, error) {
+	filter := &biz.AuditFilter{}
+
+	// Handle optional pagination fields (default to 0, business logic applies defaults)
+	if req.Page != nil {
+		filter.Page = *req.Page
+	}
+	if req.PageSize != nil {
+		filter.PageSize = *req.PageSize
+	}
+
+	if req.EmployeeId != nil {
+		id, err := uuid.Parse(*req.EmployeeId)
+		if err != nil {
+			return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+		}
+		filter.EmployeeID = &id
+	}
+	if req.Since != nil {
+		t := req.Since.AsTime()
+		filter.Since = &t
+	}
+
+	entries, total, err := s.audit.ListAuditEntries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	protoEntries := make([]*v1.AuditEntry, len(entries))
+	for i, e := range entries {
+		protoEntries[i] = toProtoAuditEntry(e)
+	}
+
+	return &v1.ListAuditEntriesResponse{
+		Entries:  protoEntries,
+		Total:    total,
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+	}, nil
+}
+
+// ImportEmployees queues a bulk import of employees from CSV.
+func (s *AdminService) ImportEmployees(ctx context.Context, req *v1.ImportEmployeesRequest) (*v1.ImportEmployeesResponse, error) {
+	op, err := s.imports.StartImport(ctx, []byte(req.GetCsv()), req.GetSourceUrl())
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ImportEmployeesResponse{Operation: toProtoImport(op)}, nil
+}
+
+// GetImportStatus returns the progress of a bulk import.
+func (s *AdminService) GetImportStatus(ctx context.Context, req *v1.GetImportStatusRequest) (*v1.GetImportStatusResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid import ID format")
+	}
+
+	op, err := s.imports.GetImportStatus(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetImportStatusResponse{Operation: toProtoImport(op)}, nil
+}
+
+// usageDayLayout is the format of the days of a usage report
+const usageDayLayout = "2006-01-02"
+
+// parseUsageDay parses an optional report day; empty yields the zero time
+func parseUsageDay(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	day, err := time.Parse(usageDayLayout, value)
+	if err != nil {
+		return time.Time{}, errors.BadRequest("INVALID_USAGE_RANGE", field+" is not a valid YYYY-MM-DD date")
+	}
+	return day, nil
+}
+
+// GetTenantAPIUsage returns the daily API usage of the caller's tenant.
+func (s *AdminService) GetTenantAPIUsage(ctx context.Context, req *v1.GetTenantAPIUsageRequest) (*v1.GetTenantAPIUsageResponse, error) {
+	from, err := parseUsageDay("from", req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseUsageDay("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	records, from, to, err := s.usage.GetTenantAPIUsage(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.GetTenantAPIUsageResponse{
+		Usage: make([]*v1.APIUsage, len(records)),
+		From:  from.Format(usageDayLayout),
+		To:    to.Format(usageDayLayout),
+	}
+	for i, r := range records {
+		resp.Usage[i] = &v1.APIUsage{
+			Day:       r.Day.Format(usageDayLayout),
+			Operation: r.Operation,
+			Status:    r.Status,
+			Count:     r.Count,
+		}
+		resp.Total += r.Count
+	}
+	return resp, nil
+}
+
+// ListInFlightRequests lists the requests this instance is serving.
+func (s *AdminService) ListInFlightRequests(ctx context.Context, req *v1.ListInFlightRequestsRequest) (*v1.ListInFlightRequestsResponse, error) {
+	if s.inFlight == nil {
+		return &v1.ListInFlightRequestsResponse{Requests: []*v1.InFlightRequest{}}, nil
+	}
+
+	requests, err := s.inFlight.List(ctx, req.AllTenants)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ListInFlightRequestsResponse{Requests: make([]*v1.InFlightRequest, len(requests))}
+	for i, r := range requests {
+		resp.Requests[i] = &v1.InFlightRequest{
+			Operation: r.Operation,
+			TenantId:  r.TenantID,
+			Elapsed:   durationpb.New(r.Elapsed),
+			TraceId:   r.TraceID,
+			RequestId: r.RequestID,
+			StartedAt: timestamppb.New(r.StartedAt),
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/admin/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoAPIKey converts biz.APIKey to proto APIKey
+func toProtoAPIKey(k *biz.APIKey) *v1.APIKey {
+	roles := k.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+
+	key := &v1.APIKey{
+		Id:               k.ID.String(),
+		Service:          k.Service,
+		Roles:            roles,
+		Prefix:           k.Prefix,
+		CreatedBy:        k.CreatedBy,
+		CreatedAt:        timestamppb.New(k.CreatedAt),
+		RequireSignature: k.RequireSignature,
+	}
+	if k.RevokedAt != nil {
+		key.RevokedAt = timestamppb.New(*k.RevokedAt)
+	}
+	return key
+}
+
+// CreateAPIKey creates an API key for a backend service.
+func (s *AdminService) CreateAPIKey(ctx context.Context, req *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyResponse, error) {
+	key, secret, err := s.apiKeys.CreateAPIKey(ctx, req.Service, req.Roles, req.RequireSignature)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateAPIKeyResponse{ApiKey: toProtoAPIKey(key), Key: secret, SigningSecret: key.SigningSecret}, nil
+}
+
+// ListAPIKeys lists the API keys of the caller's tenant.
+func (s *AdminService) ListAPIKeys(ctx context.Context, req *v1.ListAPIKeysRequest) (*v1.ListAPIKeysResponse, error) {
+	keys, err := s.apiKeys.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ListAPIKeysResponse{ApiKeys: make([]*v1.APIKey, len(keys))}
+	for i, key := range keys {
+		resp.ApiKeys[i] = toProtoAPIKey(key)
+	}
+	return resp, nil
+}
+
+// RevokeAPIKey revokes an API key.
+func (s *AdminService) RevokeAPIKey(ctx context.Context, req *v1.RevokeAPIKeyRequest) (*v1.APIKey, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid API key ID format")
+	}
+
+	key, err := s.apiKeys.RevokeAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoAPIKey(key), nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// DeactivateEmployee deactivates an employee.
+func (s *EmployeeService) DeactivateEmployee(ctx context.Context, req *v1.DeactivateEmployeeRequest) (*v1.DeactivateEmployeeResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	employee, changed, err := s.uc.DeactivateEmployee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	setEmployeeETag(ctx, employee)
+
+	return &v1.DeactivateEmployeeResponse{
+		Employee: toProtoEmployee(employee),
+		Changed:  changed,
+	}, nil
+}
+
+// ReactivateEmployee reactivates a deactivated employee.
+func (s *EmployeeService) ReactivateEmployee(ctx context.Context, req *v1.ReactivateEmployeeRequest) (*v1.ReactivateEmployeeResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	employee, changed, err := s.uc.ReactivateEmployee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	setEmployeeETag(ctx, employee)
+
+	return &v1.ReactivateEmployeeResponse{
+		Employee: toProtoEmployee(employee),
+		Changed:  changed,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/department/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DepartmentService is a department service.
+type DepartmentService struct {
+	v1.UnimplementedDepartmentServiceServer
+
+	uc *biz.DepartmentUsecase
+}
+
+// NewDepartmentService creates a new department service.
+func NewDepartmentService(uc *biz.DepartmentUsecase) *DepartmentService {
+	return &DepartmentService{uc: uc}
+}
+
+// toProtoDepartment converts biz.Department to proto Department
+func toProtoDepartment(d *biz.Department) *v1.Department {
+	return &v1.Department{
+		Id:          d.ID.String(),
+		Name:        d.Name,
+		Description: d.Description,
+		CreatedAt:   timestamppb.New(d.CreatedAt),
+		UpdatedAt:   timestamppb.New(d.UpdatedAt),
+	}
+}
+
+// parseDepartmentID parses a department ID from a request
+func parseDepartmentID(raw string) (uuid.UUID, error) {
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, errors.BadRequest("INVALID_UUID", "invalid department ID format")
+	}
+	return id, nil
+}
+
+// CreateDepartment creates a department.
+func (s *DepartmentService) CreateDepartment(ctx context.Context, req *v1.CreateDepartmentRequest) (*v1.CreateDepartmentResponse, error) {
+	department, err := s.uc.CreateDepartment(ctx, req.Name, req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateDepartmentResponse{Department: toProtoDepartment(department)}, nil
+}
+
+// GetDepartment retrieves a department by ID.
+func (s *DepartmentService) GetDepartment(ctx context.Context, req *v1.GetDepartmentRequest) (*v1.GetDepartmentResponse, error) {
+	id, err := parseDepartmentID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	department, err := s.uc.GetDepartment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetDepartmentResponse{Department: toProtoDepartment(department)}, nil
+}
+
+// ListDepartments lists the departments of the caller's tenant.
+func (s *DepartmentService) ListDepartments(ctx context.Context, req *v1.ListDepartmentsRequest) (*v1.ListDepartmentsResponse, error) {
+	// Handle optional pagination fields (default to 0, business logic applies defaults)
+	var page, pageSize int32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	departments, total, page, pageSize, err := s.uc.ListDepartments(ctx, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	protoDepartments := make([]*v1.Department, len(departments))
+	for i, d := range departments {
+		protoDepartments[i] = toProtoDepartment(d)
+	}
+
+	return &v1.ListDepartmentsResponse{
+		Departments: protoDepartments,
+		Total:       total,
+		Page:        page,
+		PageSize:    pageSize,
+	}, nil
+}
+
+// UpdateDepartment renames a department or changes its description.
+func (s *DepartmentService) UpdateDepartment(ctx context.Context, req *v1.UpdateDepartmentRequest) (*v1.UpdateDepartmentResponse, error) {
+	id, err := parseDepartmentID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	department, err := s.uc.UpdateDepartment(ctx, id, &biz.DepartmentUpdate{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateDepartmentResponse{Department: toProtoDepartment(department)}, nil
+}
+
+// DeleteDepartment deletes a department without employees.
+func (s *DepartmentService) DeleteDepartment(ctx context.Context, req *v1.DeleteDepartmentRequest) (*v1.DeleteDepartmentResponse, error) {
+	id, err := parseDepartmentID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.DeleteDepartment(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteDepartmentResponse{Success: true}, nil
+}
+
+// departmentRef parses the department_id of an employee request. An empty ID
+// is uuid.Nil, which removes the employee from its department in updates.
+func departmentRef(raw string) (*uuid.UUID, error) {
+	if raw == "" {
+		id := uuid.Nil
+		return &id, nil
+	}
+	id, err := parseDepartmentID(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+)
+
+// ReportEmailBounces marks bounced emails undeliverable.
+func (s *EmployeeService) ReportEmailBounces(ctx context.Context, req *v1.ReportEmailBouncesRequest) (*v1.ReportEmailBouncesResponse, error) {
+	bounces := make([]biz.EmailBounce, len(req.Bounces))
+	for i, bounce := range req.Bounces {
+		bounces[i] = biz.EmailBounce{Email: bounce.Email, Reason: bounce.Reason, OccurredAt: bounce.OccurredAt.AsTime()}
+	}
+
+	marked, err := s.uc.ReportEmailBounces(ctx, bounces)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ReportEmailBouncesResponse{MarkedEmails: marked}, nil
+}
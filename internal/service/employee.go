@@ -2,12 +2,16 @@ package service
 
 import (
 	"context"
+	"encoding/hex"
 
 	v1 "github.com/cvele/employee-service/api/employee/v1"
 	"github.com/cvele/employee-service/internal/biz"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -15,12 +19,21 @@ import (
 type EmployeeService struct {
 	v1.UnimplementedEmployeeServiceServer
 
-	uc *biz.EmployeeUsecase
+	uc          *biz.EmployeeUsecase
+	audit       *biz.AuditUsecase
+	schedules   *biz.ScheduleUsecase
+	history     *biz.EmploymentHistoryUsecase
+	photos      *biz.PhotoUsecase
+	quota       *biz.QuotaUsecase
+	subjects    *biz.SubjectAccessUsecase
+	approvals   *biz.MergeApprovalUsecase
+	diffs       *biz.EmployeeDiffUsecase
+	validations *biz.EmployeeValidationUsecase
 }
 
 // NewEmployeeService creates a new employee service.
-func NewEmployeeService(uc *biz.EmployeeUsecase) *EmployeeService {
-	return &EmployeeService{uc: uc}
+func NewEmployeeService(uc *biz.EmployeeUsecase, audit *biz.AuditUsecase, schedules *biz.ScheduleUsecase, history *biz.EmploymentHistoryUsecase, photos *biz.PhotoUsecase, quota *biz.QuotaUsecase, subjects *biz.SubjectAccessUsecase, approvals *biz.MergeApprovalUsecase, diffs *biz.EmployeeDiffUsecase, validations *biz.EmployeeValidationUsecase) *EmployeeService {
+	return &EmployeeService{uc: uc, audit: audit, schedules: schedules, history: history, photos: photos, quota: quota, subjects: subjects, approvals: approvals, diffs: diffs, validations: validations}
 }
 
 // toProtoEmployee converts biz.Employee to proto Employee
@@ -29,39 +42,197 @@ func toProtoEmployee(e *biz.Employee) *v1.Employee {
 		return nil
 	}
 
+	// Allocate the message and both timestamps together
+	a := new(employeeAlloc)
+	fillProtoEmployee(&a.employee, &a.createdAt, &a.updatedAt, e.ID.String(), e)
+	return &a.employee
+}
+
+// employeeAlloc groups a proto Employee with its timestamps so that a single
+// conversion needs one allocation instead of three.
+type employeeAlloc struct {
+	employee  v1.Employee
+	createdAt timestamppb.Timestamp
+	updatedAt timestamppb.Timestamp
+}
+
+// toProtoEmployees converts a list of biz.Employee to proto Employees.
+// Messages and timestamps are carved out of two backing arrays, keeping the
+// number of allocations constant regardless of the list size.
+func toProtoEmployees(employees []*biz.Employee) []*v1.Employee {
+	out := make([]*v1.Employee, len(employees))
+	messages := make([]v1.Employee, len(employees))
+	timestamps := make([]timestamppb.Timestamp, 2*len(employees))
+	ids := encodeUUIDs(employees)
+
+	for i, e := range employees {
+		if e == nil {
+			continue
+		}
+		id := ids[i*uuidStringLen : (i+1)*uuidStringLen]
+		fillProtoEmployee(&messages[i], &timestamps[2*i], &timestamps[2*i+1], id, e)
+		out[i] = &messages[i]
+	}
+
+	return out
+}
+
+// uuidStringLen is the length of the canonical textual UUID form
+const uuidStringLen = 36
+
+// encodeUUIDs renders the IDs of all employees into a single string so that
+// each proto ID can be a substring of it rather than a separate allocation.
+// Entries for nil employees are left blank.
+func encodeUUIDs(employees []*biz.Employee) string {
+	buf := make([]byte, uuidStringLen*len(employees))
+	for i, e := range employees {
+		if e == nil {
+			continue
+		}
+		dst := buf[i*uuidStringLen : (i+1)*uuidStringLen]
+		hex.Encode(dst[0:8], e.ID[0:4])
+		dst[8] = '-'
+		hex.Encode(dst[9:13], e.ID[4:6])
+		dst[13] = '-'
+		hex.Encode(dst[14:18], e.ID[6:8])
+		dst[18] = '-'
+		hex.Encode(dst[19:23], e.ID[8:10])
+		dst[23] = '-'
+		hex.Encode(dst[24:], e.ID[10:])
+	}
+	return string(buf)
+}
+
+// fillProtoEmployee populates pre-allocated proto messages from biz.Employee
+func fillProtoEmployee(dst *v1.Employee, createdAt, updatedAt *timestamppb.Timestamp, id string, e *biz.Employee) {
 	emails := e.Emails
 	if emails == nil {
 		emails = []string{}
 	}
 
-	return &v1.Employee{
-		Id:        e.ID.String(),
-		Emails:    emails,
-		FirstName: e.FirstName,
-		LastName:  e.LastName,
-		CreatedAt: timestamppb.New(e.CreatedAt),
-		UpdatedAt: timestamppb.New(e.UpdatedAt),
+	createdAt.Seconds, createdAt.Nanos = e.CreatedAt.Unix(), int32(e.CreatedAt.Nanosecond())
+	updatedAt.Seconds, updatedAt.Nanos = e.UpdatedAt.Unix(), int32(e.UpdatedAt.Nanosecond())
+
+	dst.Id = id
+	dst.Emails = emails
+	dst.PrimaryEmail = e.PrimaryEmail
+	dst.FirstName = e.FirstName
+	dst.LastName = e.LastName
+	dst.CreatedAt = createdAt
+	dst.UpdatedAt = updatedAt
+	dst.Version = e.Version
+	dst.ReviewStatus = e.ReviewStatus
+	if e.DepartmentID != nil {
+		dst.DepartmentId = e.DepartmentID.String()
+	}
+	if e.ManagerID != nil {
+		dst.ManagerId = e.ManagerID.String()
+	}
+	dst.Title = e.Title
+	dst.Tags = e.Tags
+	dst.PhoneNumbers = toProtoPhoneNumbers(e.PhoneNumbers)
+	dst.Address = toProtoAddress(e.Address)
+	dst.HasPhoto = e.PhotoKey != ""
+	dst.ExternalIds = e.ExternalIDs
+	dst.Active = e.IsActive()
+	if e.DeactivatedAt != nil {
+		dst.DeactivatedAt = timestamppb.New(*e.DeactivatedAt)
+	}
+	if e.IsStale() {
+		dst.Stale = true
+		dst.StaleSince = timestamppb.New(*e.StaleAt)
+	}
+	for _, u := range e.UndeliverableEmails {
+		dst.UndeliverableEmails = append(dst.UndeliverableEmails, &v1.UndeliverableEmail{Email: u.Email, Reason: u.Reason, Since: timestamppb.New(u.Since)})
 	}
 }
 
 // CreateEmployee creates a new employee.
 func (s *EmployeeService) CreateEmployee(ctx context.Context, req *v1.CreateEmployeeRequest) (*v1.CreateEmployeeResponse, error) {
-	employee := &biz.Employee{
-		Emails:    req.Emails,
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
+	employee := toBizNewEmployee(req)
+	if req.DepartmentId != "" {
+		departmentID, err := departmentRef(req.DepartmentId)
+		if err != nil {
+			return nil, err
+		}
+		employee.DepartmentID = departmentID
+	}
+	if req.ManagerId != "" {
+		managerID, err := managerRef(req.ManagerId)
+		if err != nil {
+			return nil, err
+		}
+		employee.ManagerID = managerID
+	}
+
+	ctx, err := withIdempotencyKey(ctx, req.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Creates effective in the future are applied by the scheduler
+	if req.EffectiveAt != nil {
+		change, err := s.schedules.ScheduleCreate(ctx, employee, req.EffectiveAt.AsTime())
+		if err != nil {
+			return nil, err
+		}
+		if change != nil {
+			return &v1.CreateEmployeeResponse{ScheduledChange: toProtoScheduledChange(change)}, nil
+		}
 	}
 
 	created, err := s.uc.CreateEmployee(ctx, employee)
 	if err != nil {
 		return nil, err
 	}
+	setEmployeeETag(ctx, created)
+	s.setQuotaHeaders(ctx)
 
 	return &v1.CreateEmployeeResponse{
 		Employee: toProtoEmployee(created),
 	}, nil
 }
 
+// toBizNewEmployee converts the employee of a create request, without its
+// department and manager
+func toBizNewEmployee(req *v1.CreateEmployeeRequest) *biz.Employee {
+	return &biz.Employee{
+		Emails:       req.Emails,
+		FirstName:    req.FirstName,
+		LastName:     req.LastName,
+		Title:        req.Title,
+		PhoneNumbers: toBizPhoneNumbers(req.PhoneNumbers),
+		Address:      toBizAddress(req.Address),
+		ExternalIDs:  toBizExternalIDs(req.ExternalIds),
+	}
+}
+
+// CreateOrUpdateEmployeeByEmail creates or updates the employee owning an
+// email.
+func (s *EmployeeService) CreateOrUpdateEmployeeByEmail(ctx context.Context, req *v1.CreateOrUpdateEmployeeByEmailRequest) (*v1.CreateOrUpdateEmployeeByEmailResponse, error) {
+	employee := &biz.Employee{}
+	if req.FirstName != nil {
+		employee.FirstName = *req.FirstName
+	}
+	if req.LastName != nil {
+		employee.LastName = *req.LastName
+	}
+
+	upserted, created, err := s.uc.CreateOrUpdateEmployeeByEmail(ctx, req.Email, employee)
+	if err != nil {
+		return nil, err
+	}
+	setEmployeeETag(ctx, upserted)
+	if created {
+		s.setQuotaHeaders(ctx)
+	}
+
+	return &v1.CreateOrUpdateEmployeeByEmailResponse{
+		Employee: toProtoEmployee(upserted),
+		Created:  created,
+	}, nil
+}
+
 // UpdateEmployee updates an existing employee.
 func (s *EmployeeService) UpdateEmployee(ctx context.Context, req *v1.UpdateEmployeeRequest) (*v1.UpdateEmployeeResponse, error) {
 	// Parse UUID from string
@@ -70,8 +241,17 @@ func (s *EmployeeService) UpdateEmployee(ctx context.Context, req *v1.UpdateEmpl
 		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
 	}
 
+	// The version comes from the request, or else from If-Match over HTTP
+	version := req.Version
+	if version == 0 {
+		if version, err = ifMatchVersion(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	employee := &biz.Employee{
-		ID: id,
+		ID:      id,
+		Version: version,
 	}
 
 	// Handle optional fields
@@ -84,11 +264,43 @@ func (s *EmployeeService) UpdateEmployee(ctx context.Context, req *v1.UpdateEmpl
 	if req.LastName != nil {
 		employee.LastName = *req.LastName
 	}
+	if req.Title != nil {
+		employee.Title = *req.Title
+	}
+	if req.DepartmentId != nil {
+		if employee.DepartmentID, err = departmentRef(*req.DepartmentId); err != nil {
+			return nil, err
+		}
+	}
+	if req.ManagerId != nil {
+		if employee.ManagerID, err = managerRef(*req.ManagerId); err != nil {
+			return nil, err
+		}
+	}
+	if employee.PhoneNumbers, err = updatePhoneNumbers(req); err != nil {
+		return nil, err
+	}
+	if employee.Address, err = updateAddress(req); err != nil {
+		return nil, err
+	}
+	employee.ExternalIDs = toBizExternalIDs(req.ExternalIds)
+
+	// Updates effective in the future are applied by the scheduler
+	if req.EffectiveAt != nil {
+		change, err := s.schedules.ScheduleUpdate(ctx, employee, req.EffectiveAt.AsTime())
+		if err != nil {
+			return nil, err
+		}
+		if change != nil {
+			return &v1.UpdateEmployeeResponse{ScheduledChange: toProtoScheduledChange(change)}, nil
+		}
+	}
 
 	updated, err := s.uc.UpdateEmployee(ctx, employee)
 	if err != nil {
 		return nil, err
 	}
+	setEmployeeETag(ctx, updated)
 
 	return &v1.UpdateEmployeeResponse{
 		Employee: toProtoEmployee(updated),
@@ -122,21 +334,41 @@ func (s *EmployeeService) GetEmployee(ctx context.Context, req *v1.GetEmployeeRe
 	}
 
 	employee, err := s.uc.GetEmployee(ctx, id)
+	if req.FollowMerges && errors.Is(err, biz.ErrEmployeeNotFound) {
+		return s.movedTo(ctx, id)
+	}
 	if err != nil {
 		return nil, err
 	}
+	setEmployeeETag(ctx, employee)
 
 	return &v1.GetEmployeeResponse{
 		Employee: toProtoEmployee(employee),
 	}, nil
 }
 
+// movedTo answers GetEmployee for a missing id with the employee it was
+// merged into, or EMPLOYEE_NOT_FOUND when it was not merged away
+func (s *EmployeeService) movedTo(ctx context.Context, id uuid.UUID) (*v1.GetEmployeeResponse, error) {
+	employee, redirects, err := s.uc.ResolveMergedEmployee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// Created again since GetEmployee, e.g. by an unmerge
+	if len(redirects) == 0 {
+		setEmployeeETag(ctx, employee)
+		return &v1.GetEmployeeResponse{Employee: toProtoEmployee(employee)}, nil
+	}
+	return &v1.GetEmployeeResponse{MovedTo: employee.ID.String()}, nil
+}
+
 // GetEmployeeByEmail gets an employee by email.
 func (s *EmployeeService) GetEmployeeByEmail(ctx context.Context, req *v1.GetEmployeeByEmailRequest) (*v1.GetEmployeeByEmailResponse, error) {
 	employee, err := s.uc.GetEmployeeByEmail(ctx, req.Email)
 	if err != nil {
 		return nil, err
 	}
+	setEmployeeETag(ctx, employee)
 
 	return &v1.GetEmployeeByEmailResponse{
 		Employee: toProtoEmployee(employee),
@@ -163,33 +395,286 @@ func (s *EmployeeService) ListEmployees(ctx context.Context, req *v1.ListEmploye
 		t := req.CreatedBefore.AsTime()
 		filter.CreatedBefore = &t
 	}
+	filter.NamePrefix = req.NamePrefix
+	filter.EmailDomain = req.EmailDomain
+	filter.EmailContains = req.EmailContains
+	if req.UpdatedSince != nil {
+		t := req.UpdatedSince.AsTime()
+		filter.UpdatedSince = &t
+	}
+	if req.DepartmentId != nil {
+		id, err := parseDepartmentID(*req.DepartmentId)
+		if err != nil {
+			return nil, err
+		}
+		filter.DepartmentID = &id
+	}
+	filter.Tags = req.Tags
+	// Deactivated employees are only listed when asked for
+	filter.ActiveOnly = req.ActiveOnly == nil || *req.ActiveOnly
+	filter.Stale = req.Stale
 
 	result, err := s.uc.ListEmployees(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	employees := make([]*v1.Employee, len(result.Employees))
-	for i, e := range result.Employees {
-		employees[i] = toProtoEmployee(e)
-	}
-
 	return &v1.ListEmployeesResponse{
-		Employees: employees,
+		Employees: toProtoEmployees(result.Employees),
 		Total:     result.Total,
 		Page:      filter.Page,     // Return actual page used (after defaults)
 		PageSize:  filter.PageSize, // Return actual page_size used (after defaults)
 	}, nil
 }
 
-// MergeEmployees merges two employees by email.
+// ListEmployeesAsOf lists the employees as they were at a point in time.
+func (s *EmployeeService) ListEmployeesAsOf(ctx context.Context, req *v1.ListEmployeesAsOfRequest) (*v1.ListEmployeesAsOfResponse, error) {
+	filter := &biz.ListFilter{Page: req.GetPage(), PageSize: req.GetPageSize(), Tags: req.Tags}
+	if req.DepartmentId != nil {
+		id, err := parseDepartmentID(*req.DepartmentId)
+		if err != nil {
+			return nil, err
+		}
+		filter.DepartmentID = &id
+	}
+
+	result, err := s.audit.ListEmployeesAsOf(ctx, req.AsOf.AsTime(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListEmployeesAsOfResponse{
+		Employees: toProtoEmployees(result.Employees),
+		Total:     result.Total,
+		Page:      filter.Page,
+		PageSize:  filter.PageSize,
+	}, nil
+}
+
+// EmployeeExists reports whether an email belongs to an employee.
+func (s *EmployeeService) EmployeeExists(ctx context.Context, req *v1.EmployeeExistsRequest) (*v1.EmployeeExistsResponse, error) {
+	exists, err := s.uc.EmployeeExists(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.EmployeeExistsResponse{Exists: exists}, nil
+}
+
+// CountEmployees counts the employees matching the list filters.
+func (s *EmployeeService) CountEmployees(ctx context.Context, req *v1.CountEmployeesRequest) (*v1.CountEmployeesResponse, error) {
+	filter := &biz.ListFilter{
+		NamePrefix:    req.NamePrefix,
+		EmailDomain:   req.EmailDomain,
+		EmailContains: req.EmailContains,
+	}
+	if req.CreatedAfter != nil {
+		t := req.CreatedAfter.AsTime()
+		filter.CreatedAfter = &t
+	}
+	if req.CreatedBefore != nil {
+		t := req.CreatedBefore.AsTime()
+		filter.CreatedBefore = &t
+	}
+	if req.UpdatedSince != nil {
+		t := req.UpdatedSince.AsTime()
+		filter.UpdatedSince = &t
+	}
+	if req.DepartmentId != nil {
+		id, err := parseDepartmentID(*req.DepartmentId)
+		if err != nil {
+			return nil, err
+		}
+		filter.DepartmentID = &id
+	}
+	filter.Tags = req.Tags
+	filter.ActiveOnly = req.ActiveOnly == nil || *req.ActiveOnly
+	filter.Stale = req.Stale
+
+	total, err := s.uc.CountEmployees(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CountEmployeesResponse{Total: total}, nil
+}
+
+// MergeEmployees merges two employees by email, or previews the merge.
 func (s *EmployeeService) MergeEmployees(ctx context.Context, req *v1.MergeEmployeesRequest) (*v1.MergeEmployeesResponse, error) {
-	employee, err := s.uc.MergeEmployees(ctx, req.PrimaryEmail, req.SecondaryEmail)
+	if req.ValidateOnly {
+		merge, err := s.uc.PreviewMerge(ctx, req.PrimaryEmail, req.SecondaryEmail)
+		if err != nil {
+			return nil, err
+		}
+		return toProtoMergePreview(merge), nil
+	}
+
+	approval, err := s.approvals.RequestMerge(ctx, req.PrimaryEmail, req.SecondaryEmail)
+	if err != nil {
+		return nil, err
+	}
+	if approval != nil {
+		return &v1.MergeEmployeesResponse{PendingApproval: toProtoMergeApproval(approval)}, nil
+	}
+
+	merge, err := s.uc.MergeEmployees(ctx, req.PrimaryEmail, req.SecondaryEmail)
 	if err != nil {
 		return nil, err
 	}
 
+	return toProtoMerge(merge), nil
+}
+
+// MergeEmployeesById merges two employees by ID, or previews the merge.
+func (s *EmployeeService) MergeEmployeesById(ctx context.Context, req *v1.MergeEmployeesByIdRequest) (*v1.MergeEmployeesResponse, error) {
+	primaryID, err := uuid.Parse(req.PrimaryId)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+	secondaryID, err := uuid.Parse(req.SecondaryId)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	if req.ValidateOnly {
+		merge, err := s.uc.PreviewMergeByID(ctx, primaryID, secondaryID)
+		if err != nil {
+			return nil, err
+		}
+		return toProtoMergePreview(merge), nil
+	}
+
+	approval, err := s.approvals.RequestMergeByID(ctx, primaryID, secondaryID)
+	if err != nil {
+		return nil, err
+	}
+	if approval != nil {
+		return &v1.MergeEmployeesResponse{PendingApproval: toProtoMergeApproval(approval)}, nil
+	}
+
+	merge, err := s.uc.MergeEmployeesByID(ctx, primaryID, secondaryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoMerge(merge), nil
+}
+
+// toProtoMerge converts a performed merge to the merge response
+func toProtoMerge(merge *biz.Merge) *v1.MergeEmployeesResponse {
+	resp := toProtoMergePreview(merge)
+	resp.MergeId = merge.ID.String()
+	return resp
+}
+
+// toProtoMergePreview converts a merge to the merge response without a
+// merge ID, as returned for validate_only
+func toProtoMergePreview(merge *biz.Merge) *v1.MergeEmployeesResponse {
 	return &v1.MergeEmployeesResponse{
-		Employee: toProtoEmployee(employee),
+		Employee:      toProtoEmployee(merge.Primary),
+		Secondary:     toProtoEmployee(merge.Secondary),
+		NameConflicts: merge.NameConflicts(),
+	}
+}
+
+// UnmergeEmployees undoes a merge.
+func (s *EmployeeService) UnmergeEmployees(ctx context.Context, req *v1.UnmergeEmployeesRequest) (*v1.UnmergeEmployeesResponse, error) {
+	id, err := uuid.Parse(req.MergeId)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid merge ID format")
+	}
+
+	merge, err := s.uc.UnmergeEmployees(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UnmergeEmployeesResponse{
+		Primary:   toProtoEmployee(merge.Primary),
+		Secondary: toProtoEmployee(merge.Secondary),
 	}, nil
 }
+
+// ResolveMergedEmployee returns the employee an ID survives as after merges.
+func (s *EmployeeService) ResolveMergedEmployee(ctx context.Context, req *v1.ResolveMergedEmployeeRequest) (*v1.ResolveMergedEmployeeResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	employee, redirects, err := s.uc.ResolveMergedEmployee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ResolveMergedEmployeeResponse{
+		Employee:  toProtoEmployee(employee),
+		Redirects: make([]*v1.MergeRedirect, len(redirects)),
+	}
+	for i, r := range redirects {
+		resp.Redirects[i] = &v1.MergeRedirect{
+			MergeId:  r.MergeID.String(),
+			FromId:   r.FromID.String(),
+			ToId:     r.ToID.String(),
+			MergedAt: timestamppb.New(r.MergedAt),
+		}
+	}
+	return resp, nil
+}
+
+// FindDuplicateCandidates returns likely duplicate employees of the tenant.
+func (s *EmployeeService) FindDuplicateCandidates(ctx context.Context, req *v1.FindDuplicateCandidatesRequest) (*v1.FindDuplicateCandidatesResponse, error) {
+	candidates, err := s.uc.FindDuplicateCandidates(ctx, &biz.DuplicateFilter{
+		MinScore: req.MinScore,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.FindDuplicateCandidatesResponse{
+		Candidates: make([]*v1.DuplicateCandidate, len(candidates)),
+	}
+	for i, c := range candidates {
+		resp.Candidates[i] = &v1.DuplicateCandidate{
+			Primary:   toProtoEmployee(c.Primary),
+			Secondary: toProtoEmployee(c.Secondary),
+			Score:     c.Score,
+			Reasons:   c.Reasons,
+		}
+	}
+	return resp, nil
+}
+
+// WatchEmployees streams changes to the tenant's employees, replaying missed
+// changes first when resuming from a token. An expired token fails with
+// FAILED_PRECONDITION, which Kratos errors cannot carry.
+func (s *EmployeeService) WatchEmployees(req *v1.WatchEmployeesRequest, stream v1.EmployeeService_WatchEmployeesServer) error {
+	err := s.audit.Watch(stream.Context(), req.GetResumeToken(), func(e *biz.AuditEntry) error {
+		return stream.Send(&v1.WatchEmployeesResponse{
+			ResumeToken: biz.EncodeResumeToken(e.Position()),
+			Action:      e.Action,
+			EmployeeId:  e.EmployeeID.String(),
+			Employee:    toProtoEmployee(e.After),
+			Previous:    toProtoEmployee(e.Before),
+			OccurredAt:  timestamppb.New(e.CreatedAt),
+		})
+	})
+	if errors.Is(err, biz.ErrResumeTokenExpired) {
+		return failedPrecondition(errors.FromError(err))
+	}
+	return err
+}
+
+// failedPrecondition returns err as a gRPC FAILED_PRECONDITION status with
+// the reason and metadata of err
+func failedPrecondition(err *errors.Error) error {
+	st, detailErr := status.New(codes.FailedPrecondition, err.Message).WithDetails(&errdetails.ErrorInfo{
+		Reason:   err.Reason,
+		Metadata: err.Metadata,
+	})
+	if detailErr != nil {
+		return err
+	}
+	return st.Err()
+}
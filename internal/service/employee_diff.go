@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DiffEmployees compares two employees for a merge decision.
+func (s *EmployeeService) DiffEmployees(ctx context.Context, req *v1.DiffEmployeesRequest) (*v1.DiffEmployeesResponse, error) {
+	idA, err := uuid.Parse(req.IdA)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid id_a format")
+	}
+	idB, err := uuid.Parse(req.IdB)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid id_b format")
+	}
+
+	diff, err := s.diffs.DiffEmployees(ctx, idA, idB)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.DiffEmployeesResponse{
+		EmployeeA: toProtoEmployee(diff.A),
+		EmployeeB: toProtoEmployee(diff.B),
+		Fields:    make([]*v1.EmployeeFieldDiff, len(diff.Fields)),
+		Emails: &v1.EmployeeEmailDiff{
+			Common: diff.Emails.Common,
+			OnlyA:  diff.Emails.OnlyA,
+			OnlyB:  diff.Emails.OnlyB,
+		},
+		HistoryA: toProtoHistorySummary(diff.HistoryA),
+		HistoryB: toProtoHistorySummary(diff.HistoryB),
+	}
+	for i, f := range diff.Fields {
+		resp.Fields[i] = &v1.EmployeeFieldDiff{Field: f.Field, ValueA: f.A, ValueB: f.B, Equal: f.Equal}
+	}
+	return resp, nil
+}
+
+// toProtoHistorySummary converts biz.HistorySummary to proto
+func toProtoHistorySummary(h biz.HistorySummary) *v1.EmployeeHistorySummary {
+	summary := &v1.EmployeeHistorySummary{
+		AuditEntries: h.AuditEntries,
+		LastAction:   h.LastAction,
+		LastActorId:  h.LastActorID,
+		Jobs:         h.Jobs,
+	}
+	if h.LastChangedAt != nil {
+		summary.LastChangedAt = timestamppb.New(*h.LastChangedAt)
+	}
+	return summary
+}
@@ -8,17 +8,22 @@ import (
 	v1 "github.com/cvele/employee-service/api/employee/v1"
 	"github.com/cvele/employee-service/internal/biz"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestNewEmployeeService(t *testing.T) {
 	// Create a minimal usecase (nil is ok for this test)
 	uc := &biz.EmployeeUsecase{}
-	service := NewEmployeeService(uc)
+	audit := &biz.AuditUsecase{}
+	service := NewEmployeeService(uc, audit, nil, nil, nil, nil, nil, nil, nil, nil)
 	
 	assert.NotNil(t, service)
 	assert.NotNil(t, service.uc)
+	assert.NotNil(t, service.audit)
 }
 
 func TestToProtoEmployee(t *testing.T) {
@@ -68,9 +73,74 @@ func TestToProtoEmployee(t *testing.T) {
 	})
 }
 
+func TestToProtoEmployees(t *testing.T) {
+	employees := benchmarkEmployees(3)
+	employees[1].Emails = nil
+
+	result := toProtoEmployees(employees)
+
+	assert.Len(t, result, 3)
+	for i, e := range employees {
+		assert.Equal(t, toProtoEmployee(e).String(), result[i].String())
+	}
+	assert.Equal(t, []string{}, result[1].Emails)
+	assert.Empty(t, toProtoEmployees(nil))
+}
+
+func TestToProtoEmployees_Allocations(t *testing.T) {
+	employees := benchmarkEmployees(1000)
+
+	// Backing arrays only, independent of the number of employees
+	allocs := testing.AllocsPerRun(10, func() {
+		_ = toProtoEmployees(employees)
+	})
+	assert.LessOrEqual(t, allocs, float64(5))
+}
+
+func benchmarkEmployees(n int) []*biz.Employee {
+	now := time.Now()
+	employees := make([]*biz.Employee, n)
+	for i := range employees {
+		employees[i] = &biz.Employee{
+			ID:        uuid.New(),
+			Emails:    []string{"test@example.com", "secondary@example.com"},
+			FirstName: "John",
+			LastName:  "Doe",
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	}
+	return employees
+}
+
+// BenchmarkToProtoEmployees_PerItem is the baseline of converting a list one
+// employee at a time
+func BenchmarkToProtoEmployees_PerItem(b *testing.B) {
+	employees := benchmarkEmployees(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		out := make([]*v1.Employee, len(employees))
+		for j, e := range employees {
+			out[j] = toProtoEmployee(e)
+		}
+	}
+}
+
+func BenchmarkToProtoEmployees(b *testing.B) {
+	employees := benchmarkEmployees(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = toProtoEmployees(employees)
+	}
+}
+
 func TestUpdateEmployee_UUIDValidation(t *testing.T) {
 	uc := &biz.EmployeeUsecase{}
-	service := NewEmployeeService(uc)
+	service := NewEmployeeService(uc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	firstName := "Jane"
 	
@@ -87,7 +157,7 @@ func TestUpdateEmployee_UUIDValidation(t *testing.T) {
 
 func TestDeleteEmployee_UUIDValidation(t *testing.T) {
 	uc := &biz.EmployeeUsecase{}
-	service := NewEmployeeService(uc)
+	service := NewEmployeeService(uc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	// Test invalid UUID
 	resp, err := service.DeleteEmployee(context.Background(), &v1.DeleteEmployeeRequest{
@@ -101,7 +171,7 @@ func TestDeleteEmployee_UUIDValidation(t *testing.T) {
 
 func TestGetEmployee_UUIDValidation(t *testing.T) {
 	uc := &biz.EmployeeUsecase{}
-	service := NewEmployeeService(uc)
+	service := NewEmployeeService(uc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	// Test invalid UUID
 	resp, err := service.GetEmployee(context.Background(), &v1.GetEmployeeRequest{
@@ -184,4 +254,21 @@ func TestEmployeeServiceMethods_ExistenceCheck(t *testing.T) {
 	// MergeEmployees
 	_, err = service.MergeEmployees(ctx, &v1.MergeEmployeesRequest{})
 	_ = err
+
+	// MergeEmployeesById
+	_, err = service.MergeEmployeesById(ctx, &v1.MergeEmployeesByIdRequest{})
+	_ = err
+
+	// UnmergeEmployees
+	_, err = service.UnmergeEmployees(ctx, &v1.UnmergeEmployeesRequest{})
+	_ = err
+}
+
+func TestFailedPrecondition(t *testing.T) {
+	err := failedPrecondition(errors.FromError(biz.ErrResumeTokenExpired))
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	// Kratos clients still see the reason
+	assert.True(t, errors.Is(errors.FromError(err), biz.ErrResumeTokenExpired))
+	assert.Equal(t, v1.ErrorReason_RESUME_TOKEN_EXPIRED.String(), errors.Reason(err))
 }
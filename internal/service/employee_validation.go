@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"buf.build/go/protovalidate"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// ValidateEmployee validates an employee payload without storing it.
+func (s *EmployeeService) ValidateEmployee(ctx context.Context, req *v1.ValidateEmployeeRequest) (*v1.ValidateEmployeeResponse, error) {
+	if req.Employee == nil {
+		return nil, errors.BadRequest("VALIDATOR", "employee is required")
+	}
+	var id *uuid.UUID
+	if req.Id != "" {
+		parsed, err := uuid.Parse(req.Id)
+		if err != nil {
+			return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+		}
+		id = &parsed
+	}
+
+	// Field constraints first; the later checks of a field violating one are
+	// left out, as they would only repeat the problem
+	resp := &v1.ValidateEmployeeResponse{}
+	var violated []string
+	var invalid *protovalidate.ValidationError
+	if err := protovalidate.Validate(req.Employee); errors.As(err, &invalid) {
+		for _, violation := range invalid.Violations {
+			field := protovalidate.FieldPathString(violation.Proto.GetField())
+			violated = append(violated, field)
+			resp.Violations = append(resp.Violations, &v1.ValidationIssue{
+				Field:   field,
+				Code:    violation.Proto.GetRuleId(),
+				Message: violation.Proto.GetMessage(),
+			})
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	// References violating their UUID pattern are already reported
+	employee := toBizNewEmployee(req.Employee)
+	if departmentID, err := uuid.Parse(req.Employee.DepartmentId); err == nil {
+		employee.DepartmentID = &departmentID
+	}
+	if managerID, err := uuid.Parse(req.Employee.ManagerId); err == nil {
+		employee.ManagerID = &managerID
+	}
+
+	validation, err := s.validations.ValidateEmployee(ctx, employee, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, issue := range validation.Violations {
+		if !containsField(violated, issue.Field) {
+			resp.Violations = append(resp.Violations, toProtoValidationIssue(issue))
+		}
+	}
+	for _, issue := range validation.Warnings {
+		resp.Warnings = append(resp.Warnings, toProtoValidationIssue(issue))
+	}
+	resp.Valid = len(resp.Violations) == 0
+	return resp, nil
+}
+
+// containsField reports whether fields contains field or one of its
+// elements or subfields
+func containsField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field || strings.HasPrefix(f, field+".") || strings.HasPrefix(f, field+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// toProtoValidationIssue converts a biz.ValidationIssue to proto
+func toProtoValidationIssue(issue biz.ValidationIssue) *v1.ValidationIssue {
+	return &v1.ValidationIssue{Field: issue.Field, Code: issue.Code, Message: issue.Message}
+}
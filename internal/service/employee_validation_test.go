@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateEmployee_BadRequest(t *testing.T) {
+	service := &EmployeeService{}
+
+	_, err := service.ValidateEmployee(context.Background(), &v1.ValidateEmployeeRequest{})
+	assert.True(t, errors.IsBadRequest(err))
+
+	_, err = service.ValidateEmployee(context.Background(), &v1.ValidateEmployeeRequest{Employee: &v1.CreateEmployeeRequest{}, Id: "invalid-uuid"})
+	assert.Equal(t, "INVALID_UUID", errors.Reason(err))
+}
+
+func TestContainsField(t *testing.T) {
+	fields := []string{"emails[1]", "phone_numbers[0].number", `external_ids["Workday"]`}
+
+	assert.True(t, containsField(fields, "emails[1]"))
+	assert.False(t, containsField(fields, "emails[0]"))
+	assert.True(t, containsField(fields, "phone_numbers"))
+	assert.True(t, containsField(fields, "external_ids"))
+	assert.False(t, containsField(fields, "address"))
+	assert.False(t, containsField(fields, "email"))
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoEmploymentHistoryEntry converts biz.EmploymentHistoryEntry to proto
+// EmploymentHistoryEntry
+func toProtoEmploymentHistoryEntry(e *biz.EmploymentHistoryEntry) *v1.EmploymentHistoryEntry {
+	entry := &v1.EmploymentHistoryEntry{
+		Id:            e.ID.String(),
+		EmployeeId:    e.EmployeeID.String(),
+		Title:         e.Title,
+		EffectiveFrom: timestamppb.New(e.EffectiveFrom),
+	}
+	if e.DepartmentID != nil {
+		entry.DepartmentId = e.DepartmentID.String()
+	}
+	if e.ManagerID != nil {
+		entry.ManagerId = e.ManagerID.String()
+	}
+	if e.EffectiveTo != nil {
+		entry.EffectiveTo = timestamppb.New(*e.EffectiveTo)
+	}
+	return entry
+}
+
+// ListEmploymentHistory lists the jobs an employee held.
+func (s *EmployeeService) ListEmploymentHistory(ctx context.Context, req *v1.ListEmploymentHistoryRequest) (*v1.ListEmploymentHistoryResponse, error) {
+	id, err := uuid.Parse(req.EmployeeId)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	filter := &biz.ListFilter{Page: req.GetPage(), PageSize: req.GetPageSize()}
+	entries, total, err := s.history.ListEmploymentHistory(ctx, id, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*v1.EmploymentHistoryEntry, len(entries))
+	for i, entry := range entries {
+		out[i] = toProtoEmploymentHistoryEntry(entry)
+	}
+	return &v1.ListEmploymentHistoryResponse{
+		Entries:  out,
+		Total:    total,
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+	}, nil
+}
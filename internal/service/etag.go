@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+// errInvalidIfMatch is an If-Match header that is not a single employee ETag
+var errInvalidIfMatch = errors.BadRequest(v1.ErrorReason_INVALID_IF_MATCH.String(), `If-Match must be a single ETag such as "3"`)
+
+// employeeETag returns the ETag of an employee version
+func employeeETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// setEmployeeETag sets the ETag response header of an HTTP request to the
+// employee's version
+func setEmployeeETag(ctx context.Context, e *biz.Employee) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok || tr.Kind() != transport.KindHTTP || e == nil {
+		return
+	}
+	tr.ReplyHeader().Set(headerETag, employeeETag(e.Version))
+}
+
+// ifMatchVersion returns the employee version of the If-Match header of an
+// HTTP request, or 0 when there is none
+func ifMatchVersion(ctx context.Context) (int64, error) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok || tr.Kind() != transport.KindHTTP {
+		return 0, nil
+	}
+	value := strings.TrimSpace(tr.RequestHeader().Get(headerIfMatch))
+	if value == "" {
+		return 0, nil
+	}
+
+	// Weak ETags compare equal to strong ones; versions are exact either way
+	value = strings.TrimPrefix(value, "W/")
+	unquoted, err := strconv.Unquote(value)
+	if err != nil {
+		return 0, errInvalidIfMatch
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, errInvalidIfMatch
+	}
+	return version, nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+// headerTransport is a transport of the given kind with HTTP headers
+type headerTransport struct {
+	kind   transport.Kind
+	header http.Header
+	reply  http.Header
+}
+
+func (t *headerTransport) Kind() transport.Kind            { return t.kind }
+func (t *headerTransport) Endpoint() string                { return "" }
+func (t *headerTransport) Operation() string               { return "" }
+func (t *headerTransport) RequestHeader() transport.Header { return headerCarrier(t.header) }
+func (t *headerTransport) ReplyHeader() transport.Header   { return headerCarrier(t.reply) }
+
+type headerCarrier http.Header
+
+func (h headerCarrier) Get(key string) string      { return http.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { http.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { http.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return http.Header(h).Values(key) }
+
+func headerContext(kind transport.Kind, ifMatch string) (context.Context, *headerTransport) {
+	tr := &headerTransport{kind: kind, header: http.Header{}, reply: http.Header{}}
+	if ifMatch != "" {
+		tr.header.Set(headerIfMatch, ifMatch)
+	}
+	return transport.NewServerContext(context.Background(), tr), tr
+}
+
+func TestIfMatchVersion(t *testing.T) {
+	tests := []struct {
+		ifMatch string
+		want    int64
+		wantErr bool
+	}{
+		{ifMatch: "", want: 0},
+		{ifMatch: `"3"`, want: 3},
+		{ifMatch: `W/"12"`, want: 12},
+		{ifMatch: "3", wantErr: true},
+		{ifMatch: `"0"`, wantErr: true},
+		{ifMatch: "*", wantErr: true},
+		{ifMatch: `"1", "2"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ifMatch, func(t *testing.T) {
+			ctx, _ := headerContext(transport.KindHTTP, tt.ifMatch)
+			got, err := ifMatchVersion(ctx)
+			if tt.wantErr {
+				assert.Equal(t, errInvalidIfMatch, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// If-Match is an HTTP header; gRPC sends version in the request
+	ctx, _ := headerContext(transport.KindGRPC, `"3"`)
+	got, err := ifMatchVersion(ctx)
+	assert.NoError(t, err)
+	assert.Zero(t, got)
+}
+
+func TestSetEmployeeETag(t *testing.T) {
+	ctx, tr := headerContext(transport.KindHTTP, "")
+	setEmployeeETag(ctx, &biz.Employee{Version: 7})
+	assert.Equal(t, `"7"`, tr.reply.Get(headerETag))
+
+	ctx, tr = headerContext(transport.KindGRPC, "")
+	setEmployeeETag(ctx, &biz.Employee{Version: 7})
+	assert.Empty(t, tr.reply.Get(headerETag))
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/admin/v1"
+	"github.com/cvele/employee-service/internal/biz"
+)
+
+// defaultBackfillBatchSize is the batch size of backfills not asking for one
+const defaultBackfillBatchSize = 100
+
+// BackfillEvents publishes created events for the next batch of existing
+// employees.
+func (s *AdminService) BackfillEvents(ctx context.Context, req *v1.BackfillEventsRequest) (*v1.BackfillEventsResponse, error) {
+	var after *biz.StreamCursor
+	if req.Cursor != "" {
+		cursor, err := biz.DecodeBackfillCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = cursor
+	}
+	batchSize := int(req.BatchSize)
+	if batchSize == 0 {
+		batchSize = defaultBackfillBatchSize
+	}
+
+	published, next, err := s.backfill.BackfillEvents(ctx, after, batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.BackfillEventsResponse{Published: int32(published)}
+	if next != nil {
+		resp.NextCursor = biz.EncodeBackfillCursor(*next)
+	}
+	return resp, nil
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// OperationEmployeeServiceExportEmployees names the HTTP export for
+// authorization, alongside the generated EmployeeService operations
+const OperationEmployeeServiceExportEmployees = "/employee.v1.EmployeeService/ExportEmployees"
+
+// Export formats
+const (
+	ExportFormatCSV     = "csv"
+	ExportFormatNDJSON  = "ndjson"
+	ExportFormatParquet = "parquet"
+	ExportFormatXLSX    = "xlsx"
+)
+
+// exportCSVHeader includes the columns read by ImportEmployees, so an export
+// can be imported again. The Parquet and XLSX exports use the same columns.
+var exportCSVHeader = []string{"id", "first_name", "last_name", "emails", "created_at", "updated_at"}
+
+// ExportEmployeesHandler returns the HTTP handler streaming the employees of
+// the caller's tenant in one of the exportFormats. Rows are written as they are read
+// from the database, so the export runs with flat memory and is slowed down
+// by a slow client. The server timeout does not apply; the export is bounded
+// by timeout instead and stops early when writing to the client fails.
+func (s *EmployeeService) ExportEmployeesHandler(timeout time.Duration) khttp.HandlerFunc {
+	return func(ctx khttp.Context) error {
+		var in v1.ExportEmployeesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		khttp.SetOperation(ctx, OperationEmployeeServiceExportEmployees)
+
+		w := newExportWriter(ctx.Response(), in.GetFormat())
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, s.exportEmployees(ctx, req.(*v1.ExportEmployeesRequest), w)
+		})
+
+		exportCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+
+		if _, err := h(exportCtx, &in); err != nil {
+			if !w.started {
+				return err
+			}
+			// The status line is already sent, abort the response so that the
+			// client sees a broken transfer rather than a short export
+			panic(http.ErrAbortHandler)
+		}
+		return w.finish()
+	}
+}
+
+// exportEmployees streams the employees matching req into w
+func (s *EmployeeService) exportEmployees(ctx context.Context, req *v1.ExportEmployeesRequest, w *exportWriter) error {
+	filter := &biz.StreamFilter{
+		NamePrefix:    req.NamePrefix,
+		EmailDomain:   req.EmailDomain,
+		EmailContains: req.EmailContains,
+		Tags:          req.Tags,
+		ActiveOnly:    req.ActiveOnly == nil || *req.ActiveOnly,
+		Stale:         req.Stale,
+	}
+	if req.CreatedAfter != nil {
+		t := req.CreatedAfter.AsTime()
+		filter.CreatedAfter = &t
+	}
+	if req.CreatedBefore != nil {
+		t := req.CreatedBefore.AsTime()
+		filter.CreatedBefore = &t
+	}
+	if req.UpdatedSince != nil {
+		t := req.UpdatedSince.AsTime()
+		filter.UpdatedSince = &t
+	}
+	if req.DepartmentId != nil {
+		id, err := parseDepartmentID(*req.DepartmentId)
+		if err != nil {
+			return err
+		}
+		filter.DepartmentID = &id
+	}
+
+	return s.uc.StreamEmployees(ctx, filter, w.write)
+}
+
+// ExportEncoder encodes employees in one export format. Encoders write
+// through to w as far as the format allows, so that an export is sent while
+// it is read.
+type ExportEncoder interface {
+	// Encode writes one employee
+	Encode(e *biz.Employee) error
+	// Close writes the rest of the export, like footers and buffered rows;
+	// it does not close the underlying writer
+	Close() error
+}
+
+// exportFormat is an export format selectable with the format parameter
+type exportFormat struct {
+	contentType string
+	extension   string
+	newEncoder  func(w io.Writer) (ExportEncoder, error)
+}
+
+// exportFormats are the supported export formats, keyed by the format
+// parameter. A format added here also needs to be allowed by the validation
+// of ExportEmployeesRequest.format.
+var exportFormats = map[string]exportFormat{
+	ExportFormatCSV: {
+		contentType: "text/csv; charset=utf-8",
+		extension:   "csv",
+		newEncoder:  newCSVExportEncoder,
+	},
+	ExportFormatNDJSON: {
+		contentType: "application/x-ndjson",
+		extension:   "ndjson",
+		newEncoder:  newNDJSONExportEncoder,
+	},
+	ExportFormatParquet: {
+		contentType: "application/vnd.apache.parquet",
+		extension:   "parquet",
+		newEncoder:  newParquetExportEncoder,
+	},
+	ExportFormatXLSX: {
+		contentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		extension:   "xlsx",
+		newEncoder:  newXLSXExportEncoder,
+	},
+}
+
+// exportWriter writes the export response. Headers are only sent with the
+// first employee so that errors raised before any row is read are still
+// returned as regular error responses.
+type exportWriter struct {
+	w       http.ResponseWriter
+	buf     *bufio.Writer
+	format  exportFormat
+	enc     ExportEncoder
+	started bool
+}
+
+// newExportWriter creates a writer for format, defaulting to CSV
+func newExportWriter(w http.ResponseWriter, format string) *exportWriter {
+	f, ok := exportFormats[format]
+	if !ok {
+		f = exportFormats[ExportFormatCSV]
+	}
+	return &exportWriter{w: w, buf: bufio.NewWriterSize(w, 32<<10), format: f}
+}
+
+// start sends the response headers and creates the encoder, which may write
+// a file header
+func (x *exportWriter) start() error {
+	x.started = true
+
+	x.w.Header().Set("Content-Type", x.format.contentType)
+	x.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="employees.%s"`, x.format.extension))
+	x.w.WriteHeader(http.StatusOK)
+
+	enc, err := x.format.newEncoder(x.buf)
+	if err != nil {
+		return err
+	}
+	x.enc = enc
+	return nil
+}
+
+// write encodes one employee
+func (x *exportWriter) write(e *biz.Employee) error {
+	if !x.started {
+		if err := x.start(); err != nil {
+			return err
+		}
+	}
+	return x.enc.Encode(e)
+}
+
+// finish sends whatever is buffered, starting the response for empty exports
+func (x *exportWriter) finish() error {
+	if !x.started {
+		if err := x.start(); err != nil {
+			return err
+		}
+	}
+	if err := x.enc.Close(); err != nil {
+		return err
+	}
+	return x.buf.Flush()
+}
+
+// csvExportEncoder writes the exportCSVHeader columns
+type csvExportEncoder struct {
+	csv *csv.Writer
+}
+
+func newCSVExportEncoder(w io.Writer) (ExportEncoder, error) {
+	enc := &csvExportEncoder{csv: csv.NewWriter(w)}
+	if err := enc.csv.Write(exportCSVHeader); err != nil {
+		return nil, err
+	}
+	return enc, nil
+}
+
+func (c *csvExportEncoder) Encode(e *biz.Employee) error {
+	// csv.Writer shares the response buffer, so a gone client fails the next
+	// row
+	return c.csv.Write([]string{
+		e.ID.String(),
+		e.FirstName,
+		e.LastName,
+		strings.Join(e.Emails, ";"),
+		e.CreatedAt.UTC().Format(time.RFC3339Nano),
+		e.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	})
+}
+
+func (c *csvExportEncoder) Close() error {
+	c.csv.Flush()
+	return c.csv.Error()
+}
+
+// ndjsonExportEncoder writes one employee per line in the ListEmployees JSON
+// form
+type ndjsonExportEncoder struct {
+	w    io.Writer
+	json encoding.Codec
+}
+
+func newNDJSONExportEncoder(w io.Writer) (ExportEncoder, error) {
+	return &ndjsonExportEncoder{w: w, json: encoding.GetCodec(json.Name)}, nil
+}
+
+func (n *ndjsonExportEncoder) Encode(e *biz.Employee) error {
+	line, err := n.json.Marshal(toProtoEmployee(e))
+	if err != nil {
+		return err
+	}
+	_, err = n.w.Write(append(line, '\n'))
+	return err
+}
+
+func (n *ndjsonExportEncoder) Close() error {
+	return nil
+}
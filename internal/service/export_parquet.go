@@ -0,0 +1,56 @@
+package service
+
+import (
+	"io"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// parquetRowGroupSize bounds the rows buffered before a row group is written
+// to the client. Parquet writes its footer last, so the file is only readable
+// once the export completes.
+const parquetRowGroupSize = 8 << 20
+
+// parquetEmployee is a row of the Parquet export, with the exportCSVHeader
+// columns typed: emails are a list and timestamps are UTC microseconds
+type parquetEmployee struct {
+	ID        string   `parquet:"name=id, type=UTF8"`
+	FirstName string   `parquet:"name=first_name, type=UTF8"`
+	LastName  string   `parquet:"name=last_name, type=UTF8"`
+	Emails    []string `parquet:"name=emails, type=LIST, valuetype=UTF8"`
+	CreatedAt int64    `parquet:"name=created_at, type=TIMESTAMP_MICROS"`
+	UpdatedAt int64    `parquet:"name=updated_at, type=TIMESTAMP_MICROS"`
+}
+
+// parquetExportEncoder writes a Snappy-compressed Parquet file
+type parquetExportEncoder struct {
+	pw *writer.ParquetWriter
+}
+
+func newParquetExportEncoder(w io.Writer) (ExportEncoder, error) {
+	pw, err := writer.NewParquetWriterFromWriter(w, new(parquetEmployee), 1)
+	if err != nil {
+		return nil, err
+	}
+	pw.RowGroupSize = parquetRowGroupSize
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+	return &parquetExportEncoder{pw: pw}, nil
+}
+
+func (p *parquetExportEncoder) Encode(e *biz.Employee) error {
+	return p.pw.Write(parquetEmployee{
+		ID:        e.ID.String(),
+		FirstName: e.FirstName,
+		LastName:  e.LastName,
+		Emails:    e.Emails,
+		CreatedAt: e.CreatedAt.UnixMicro(),
+		UpdatedAt: e.UpdatedAt.UnixMicro(),
+	})
+}
+
+func (p *parquetExportEncoder) Close() error {
+	return p.pw.WriteStop()
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xuri/excelize/v2"
+)
+
+func testExportEmployee() *biz.Employee {
+	created := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	return &biz.Employee{
+		ID:           uuid.MustParse("6f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f"),
+		Emails:       []string{"ada@example.com", "ada.l@example.com"},
+		PrimaryEmail: "ada@example.com",
+		FirstName:    "Ada",
+		LastName:     "Lovelace",
+		CreatedAt:    created,
+		UpdatedAt:    created.Add(time.Hour),
+		Version:      3,
+		ReviewStatus: biz.ReviewStatusApproved,
+	}
+}
+
+func TestExportWriter_CSV(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := newExportWriter(rec, "")
+
+	require.NoError(t, w.write(testExportEmployee()))
+	require.NoError(t, w.finish())
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "id,first_name,last_name,emails,created_at,updated_at\n"+
+		"6f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f,Ada,Lovelace,ada@example.com;ada.l@example.com,2024-03-01T09:30:00Z,2024-03-01T10:30:00Z\n",
+		rec.Body.String())
+}
+
+func TestExportWriter_NDJSON(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := newExportWriter(rec, ExportFormatNDJSON)
+
+	require.NoError(t, w.write(testExportEmployee()))
+	require.NoError(t, w.write(testExportEmployee()))
+	require.NoError(t, w.finish())
+
+	assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+	lines := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{
+		"id": "6f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f",
+		"emails": ["ada@example.com", "ada.l@example.com"],
+		"primaryEmail": "ada@example.com",
+		"firstName": "Ada",
+		"lastName": "Lovelace",
+		"createdAt": "2024-03-01T09:30:00Z",
+		"updatedAt": "2024-03-01T10:30:00Z",
+		"version": "3",
+		"reviewStatus": "approved",
+		"departmentId": "",
+		"managerId": "",
+		"title": "",
+		"tags": [],
+		"phoneNumbers": [],
+		"address": null,
+		"hasPhoto": false,
+		"externalIds": {},
+		"active": true,
+		"deactivatedAt": null,
+		"stale": false,
+		"staleSince": null,
+		"undeliverableEmails": []
+	}`, lines[0])
+}
+
+func TestExportWriter_Empty(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := newExportWriter(rec, ExportFormatCSV)
+
+	assert.False(t, w.started)
+	require.NoError(t, w.finish())
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "id,first_name,last_name,emails,created_at,updated_at\n", rec.Body.String())
+}
+
+func TestExportWriter_Parquet(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := newExportWriter(rec, ExportFormatParquet)
+
+	require.NoError(t, w.write(testExportEmployee()))
+	require.NoError(t, w.finish())
+
+	assert.Equal(t, "application/vnd.apache.parquet", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="employees.parquet"`, rec.Header().Get("Content-Disposition"))
+
+	file, err := buffer.NewBufferFile(rec.Body.Bytes())
+	require.NoError(t, err)
+	pr, err := reader.NewParquetReader(file, new(parquetEmployee), 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+
+	require.EqualValues(t, 1, pr.GetNumRows())
+	rows := make([]parquetEmployee, 1)
+	require.NoError(t, pr.Read(&rows))
+	assert.Equal(t, parquetEmployee{
+		ID:        "6f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f",
+		FirstName: "Ada",
+		LastName:  "Lovelace",
+		Emails:    []string{"ada@example.com", "ada.l@example.com"},
+		CreatedAt: time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC).UnixMicro(),
+		UpdatedAt: time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC).UnixMicro(),
+	}, rows[0])
+}
+
+func TestExportWriter_XLSX(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := newExportWriter(rec, ExportFormatXLSX)
+
+	require.NoError(t, w.write(testExportEmployee()))
+	require.NoError(t, w.finish())
+
+	assert.Equal(t, `attachment; filename="employees.xlsx"`, rec.Header().Get("Content-Disposition"))
+
+	file, err := excelize.OpenReader(rec.Body)
+	require.NoError(t, err)
+	defer file.Close()
+
+	rows, err := file.GetRows(xlsxSheet, excelize.Options{RawCellValue: true})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, exportCSVHeader, rows[0])
+	assert.Equal(t, []string{"6f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f", "Ada", "Lovelace", "ada@example.com;ada.l@example.com"}, rows[1][:4])
+
+	created, err := excelize.ExcelDateToTime(mustParseFloat(t, rows[1][4]), false)
+	require.NoError(t, err)
+	assert.True(t, created.Equal(time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)), "created_at is %s", created)
+}
+
+func mustParseFloat(t *testing.T, s string) float64 {
+	t.Helper()
+	f, err := strconv.ParseFloat(s, 64)
+	require.NoError(t, err)
+	return f
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"io"
+	"strings"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// xlsxSheet names the worksheet of the XLSX export
+const xlsxSheet = "Employees"
+
+// xlsxExportEncoder writes an XLSX workbook with one worksheet. XLSX is a zip
+// archive written at the end, so rows are kept by the excelize stream writer,
+// which moves them to a temporary file once they outgrow memory, and the
+// workbook is sent on Close.
+type xlsxExportEncoder struct {
+	w    io.Writer
+	file *excelize.File
+	sw   *excelize.StreamWriter
+	row  int
+}
+
+func newXLSXExportEncoder(w io.Writer) (ExportEncoder, error) {
+	file := excelize.NewFile()
+	if err := file.SetSheetName("Sheet1", xlsxSheet); err != nil {
+		file.Close()
+		return nil, err
+	}
+	sw, err := file.NewStreamWriter(xlsxSheet)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	x := &xlsxExportEncoder{w: w, file: file, sw: sw}
+	header := make([]interface{}, len(exportCSVHeader))
+	for i, column := range exportCSVHeader {
+		header[i] = column
+	}
+	if err := x.writeRow(header); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return x, nil
+}
+
+func (x *xlsxExportEncoder) Encode(e *biz.Employee) error {
+	return x.writeRow([]interface{}{
+		e.ID.String(),
+		e.FirstName,
+		e.LastName,
+		strings.Join(e.Emails, ";"),
+		e.CreatedAt.UTC(),
+		e.UpdatedAt.UTC(),
+	})
+}
+
+func (x *xlsxExportEncoder) writeRow(values []interface{}) error {
+	x.row++
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+	return x.sw.SetRow(cell, values)
+}
+
+func (x *xlsxExportEncoder) Close() error {
+	defer x.file.Close()
+
+	if err := x.sw.Flush(); err != nil {
+		return err
+	}
+	return x.file.Write(x.w)
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+)
+
+// toBizExternalIDs returns the external IDs of a request, nil for none so
+// that updates keep the external IDs
+func toBizExternalIDs(ids map[string]string) map[string]string {
+	if len(ids) == 0 {
+		return nil
+	}
+	return ids
+}
+
+// GetEmployeeByExternalID gets the employee with an ID in an external system.
+func (s *EmployeeService) GetEmployeeByExternalID(ctx context.Context, req *v1.GetEmployeeByExternalIDRequest) (*v1.GetEmployeeByExternalIDResponse, error) {
+	employee, err := s.uc.GetEmployeeByExternalID(ctx, req.System, req.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+	setEmployeeETag(ctx, employee)
+
+	return &v1.GetEmployeeByExternalIDResponse{
+		Employee: toProtoEmployee(employee),
+	}, nil
+}
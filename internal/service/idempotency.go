@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const headerIdempotencyKey = "Idempotency-Key"
+
+// errInvalidIdempotencyKey is an Idempotency-Key header that is too long
+var errInvalidIdempotencyKey = errors.BadRequest(v1.ErrorReason_INVALID_IDEMPOTENCY_KEY.String(),
+	fmt.Sprintf("Idempotency-Key must be at most %d characters", biz.MaxIdempotencyKeyLength))
+
+// withIdempotencyKey injects the idempotency key of a request into ctx. The
+// request field takes precedence over the Idempotency-Key header of an HTTP
+// request.
+func withIdempotencyKey(ctx context.Context, field string) (context.Context, error) {
+	key := field
+	if key == "" {
+		if tr, ok := transport.FromServerContext(ctx); ok && tr.Kind() == transport.KindHTTP {
+			key = strings.TrimSpace(tr.RequestHeader().Get(headerIdempotencyKey))
+		}
+	}
+	if key == "" {
+		return ctx, nil
+	}
+	if len(key) > biz.MaxIdempotencyKeyLength {
+		return nil, errInvalidIdempotencyKey
+	}
+	return biz.WithIdempotencyKey(ctx, key), nil
+}
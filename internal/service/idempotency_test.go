@@ -0,0 +1,35 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithIdempotencyKey(t *testing.T) {
+	ctx, tr := headerContext(transport.KindHTTP, "")
+	tr.header.Set(headerIdempotencyKey, " header-key ")
+
+	got, err := withIdempotencyKey(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, "header-key", biz.GetIdempotencyKey(got))
+
+	got, err = withIdempotencyKey(ctx, "field-key")
+	require.NoError(t, err)
+	assert.Equal(t, "field-key", biz.GetIdempotencyKey(got), "the request field wins")
+
+	grpcCtx, grpcTr := headerContext(transport.KindGRPC, "")
+	grpcTr.header.Set(headerIdempotencyKey, "header-key")
+	got, err = withIdempotencyKey(grpcCtx, "")
+	require.NoError(t, err)
+	assert.Empty(t, biz.GetIdempotencyKey(got), "gRPC callers use the request field")
+
+	tr.header.Set(headerIdempotencyKey, strings.Repeat("k", biz.MaxIdempotencyKeyLength+1))
+	_, err = withIdempotencyKey(ctx, "")
+	assert.Equal(t, errInvalidIdempotencyKey, err)
+}
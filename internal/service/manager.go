@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// managerRef parses the manager_id of an employee request. An empty ID is
+// uuid.Nil, which removes the employee's manager in updates.
+func managerRef(raw string) (*uuid.UUID, error) {
+	if raw == "" {
+		id := uuid.Nil
+		return &id, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid manager ID format")
+	}
+	return &id, nil
+}
+
+// ListDirectReports lists the employees reporting to a manager.
+func (s *EmployeeService) ListDirectReports(ctx context.Context, req *v1.ListDirectReportsRequest) (*v1.ListEmployeesResponse, error) {
+	managerID, err := uuid.Parse(req.ManagerId)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid manager ID format")
+	}
+
+	filter := &biz.ListFilter{Page: req.GetPage(), PageSize: req.GetPageSize()}
+	result, err := s.uc.ListDirectReports(ctx, managerID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListEmployeesResponse{
+		Employees: toProtoEmployees(result.Employees),
+		Total:     result.Total,
+		Page:      filter.Page,
+		PageSize:  filter.PageSize,
+	}, nil
+}
+
+// GetManagementChain returns the managers above an employee.
+func (s *EmployeeService) GetManagementChain(ctx context.Context, req *v1.GetManagementChainRequest) (*v1.GetManagementChainResponse, error) {
+	id, err := uuid.Parse(req.EmployeeId)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	managers, err := s.uc.GetManagementChain(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetManagementChainResponse{Managers: toProtoEmployees(managers)}, nil
+}
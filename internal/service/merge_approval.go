@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoMergeApproval converts biz.MergeApproval to proto MergeApproval
+func toProtoMergeApproval(a *biz.MergeApproval) *v1.MergeApproval {
+	approval := &v1.MergeApproval{
+		Id:             a.ID.String(),
+		PrimaryId:      a.PrimaryID.String(),
+		SecondaryId:    a.SecondaryID.String(),
+		Status:         a.Status,
+		Reasons:        a.Reasons,
+		HistoryEntries: a.HistoryEntries,
+		Emails:         int32(a.Emails),
+		RequestedBy:    a.RequestedBy,
+		CreatedAt:      timestamppb.New(a.CreatedAt),
+		DecidedBy:      a.DecidedBy,
+		Error:          a.Error,
+	}
+	if a.DecidedAt != nil {
+		approval.DecidedAt = timestamppb.New(*a.DecidedAt)
+	}
+	if a.MergeID != nil {
+		approval.MergeId = a.MergeID.String()
+	}
+	return approval
+}
+
+// ListMergeApprovals lists the merges of the tenant held for approval.
+func (s *EmployeeService) ListMergeApprovals(ctx context.Context, req *v1.ListMergeApprovalsRequest) (*v1.ListMergeApprovalsResponse, error) {
+	filter := &biz.MergeApprovalFilter{
+		Status:   req.Status,
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+	}
+
+	approvals, total, err := s.approvals.ListMergeApprovals(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*v1.MergeApproval, len(approvals))
+	for i, approval := range approvals {
+		out[i] = toProtoMergeApproval(approval)
+	}
+	return &v1.ListMergeApprovalsResponse{
+		MergeApprovals: out,
+		Total:          total,
+		Page:           filter.Page,
+		PageSize:       filter.PageSize,
+	}, nil
+}
+
+// ApproveMerge approves a merge held for approval and performs it.
+func (s *EmployeeService) ApproveMerge(ctx context.Context, req *v1.ApproveMergeRequest) (*v1.ApproveMergeResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid merge approval ID format")
+	}
+
+	approval, merge, err := s.approvals.ApproveMerge(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ApproveMergeResponse{
+		MergeApproval: toProtoMergeApproval(approval),
+		Merge:         toProtoMerge(merge),
+	}, nil
+}
+
+// RejectMerge rejects a merge held for approval.
+func (s *EmployeeService) RejectMerge(ctx context.Context, req *v1.RejectMergeRequest) (*v1.RejectMergeResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid merge approval ID format")
+	}
+
+	approval, err := s.approvals.RejectMerge(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RejectMergeResponse{
+		MergeApproval: toProtoMergeApproval(approval),
+	}, nil
+}
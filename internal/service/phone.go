@@ -0,0 +1,49 @@
+package service
+
+import (
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// errConflictingPhoneNumbers is an update both replacing and clearing the
+// phone numbers
+var errConflictingPhoneNumbers = errors.BadRequest(v1.ErrorReason_INVALID_PHONE_NUMBER.String(), "clear_phone_numbers cannot be combined with phone_numbers")
+
+// toBizPhoneNumbers converts proto phone numbers to biz phone numbers,
+// returning nil for none
+func toBizPhoneNumbers(phones []*v1.PhoneNumber) []biz.PhoneNumber {
+	if len(phones) == 0 {
+		return nil
+	}
+	converted := make([]biz.PhoneNumber, len(phones))
+	for i, phone := range phones {
+		converted[i] = biz.PhoneNumber{Type: phone.GetType(), Number: phone.GetNumber()}
+	}
+	return converted
+}
+
+// toProtoPhoneNumbers converts biz phone numbers to proto phone numbers
+func toProtoPhoneNumbers(phones []biz.PhoneNumber) []*v1.PhoneNumber {
+	if len(phones) == 0 {
+		return nil
+	}
+	converted := make([]*v1.PhoneNumber, len(phones))
+	for i, phone := range phones {
+		converted[i] = &v1.PhoneNumber{Type: phone.Type, Number: phone.Number}
+	}
+	return converted
+}
+
+// updatePhoneNumbers returns the phone numbers of an update request, nil
+// keeping them and an empty slice removing them
+func updatePhoneNumbers(req *v1.UpdateEmployeeRequest) ([]biz.PhoneNumber, error) {
+	if req.ClearPhoneNumbers {
+		if len(req.PhoneNumbers) > 0 {
+			return nil, errConflictingPhoneNumbers
+		}
+		return []biz.PhoneNumber{}, nil
+	}
+	return toBizPhoneNumbers(req.PhoneNumbers), nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UploadEmployeePhoto uploads the photo of an employee.
+func (s *EmployeeService) UploadEmployeePhoto(ctx context.Context, req *v1.UploadEmployeePhotoRequest) (*v1.UploadEmployeePhotoResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	employee, err := s.photos.UploadPhoto(ctx, id, req.Photo)
+	if err != nil {
+		return nil, err
+	}
+	setEmployeeETag(ctx, employee)
+
+	return &v1.UploadEmployeePhotoResponse{Employee: toProtoEmployee(employee)}, nil
+}
+
+// GetEmployeePhotoURL returns a pre-signed URL of the photo of an employee.
+func (s *EmployeeService) GetEmployeePhotoURL(ctx context.Context, req *v1.GetEmployeePhotoURLRequest) (*v1.GetEmployeePhotoURLResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	url, expiresAt, err := s.photos.GetPhotoURL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetEmployeePhotoURLResponse{Url: url, ExpiresAt: timestamppb.New(expiresAt)}, nil
+}
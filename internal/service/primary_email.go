@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// SetPrimaryEmail designates the primary email of an employee.
+func (s *EmployeeService) SetPrimaryEmail(ctx context.Context, req *v1.SetPrimaryEmailRequest) (*v1.SetPrimaryEmailResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	employee, changed, err := s.uc.SetPrimaryEmail(ctx, id, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	setEmployeeETag(ctx, employee)
+
+	return &v1.SetPrimaryEmailResponse{
+		Employee: toProtoEmployee(employee),
+		Changed:  changed,
+	}, nil
+}
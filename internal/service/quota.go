@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// Quota headers of create responses, set once the tenant reached a quota
+// threshold
+const (
+	headerQuotaLimit     = "X-Quota-Limit"
+	headerQuotaUsed      = "X-Quota-Used"
+	headerQuotaRemaining = "X-Quota-Remaining"
+	headerQuotaThreshold = "X-Quota-Threshold"
+)
+
+// setQuotaHeaders sets the quota headers of a create response when the
+// tenant's employee quota reached a threshold. The headers are
+// informational, so a failure to read the usage leaves them out.
+func (s *EmployeeService) setQuotaHeaders(ctx context.Context) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok || s.quota == nil {
+		return
+	}
+	usage, err := s.quota.EmployeeUsage(ctx)
+	if err != nil || usage == nil {
+		return
+	}
+	threshold := usage.Threshold()
+	if threshold == 0 {
+		return
+	}
+
+	header := tr.ReplyHeader()
+	header.Set(headerQuotaLimit, strconv.FormatInt(usage.Limit, 10))
+	header.Set(headerQuotaUsed, strconv.FormatInt(usage.Used, 10))
+	header.Set(headerQuotaRemaining, strconv.FormatInt(usage.Remaining(), 10))
+	header.Set(headerQuotaThreshold, strconv.Itoa(int(threshold)))
+}
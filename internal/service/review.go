@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// ListPendingEmployees lists the employees waiting for review.
+func (s *EmployeeService) ListPendingEmployees(ctx context.Context, req *v1.ListPendingEmployeesRequest) (*v1.ListEmployeesResponse, error) {
+	filter := &biz.ListFilter{Page: req.GetPage(), PageSize: req.GetPageSize()}
+
+	result, err := s.uc.ListPendingEmployees(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListEmployeesResponse{
+		Employees: toProtoEmployees(result.Employees),
+		Total:     result.Total,
+		Page:      filter.Page,
+		PageSize:  filter.PageSize,
+	}, nil
+}
+
+// ApproveEmployee approves an employee pending review.
+func (s *EmployeeService) ApproveEmployee(ctx context.Context, req *v1.ApproveEmployeeRequest) (*v1.ApproveEmployeeResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	employee, err := s.uc.ApproveEmployee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	setEmployeeETag(ctx, employee)
+
+	return &v1.ApproveEmployeeResponse{
+		Employee: toProtoEmployee(employee),
+	}, nil
+}
+
+// RejectEmployee rejects an employee pending review.
+func (s *EmployeeService) RejectEmployee(ctx context.Context, req *v1.RejectEmployeeRequest) (*v1.RejectEmployeeResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	if err := s.uc.RejectEmployee(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &v1.RejectEmployeeResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/admin/v1"
+)
+
+// FlushCaches flushes the employee cache of all tenants.
+func (s *AdminService) FlushCaches(ctx context.Context, req *v1.FlushCachesRequest) (*v1.FlushCachesResponse, error) {
+	flushed, err := s.runbook.FlushCaches(ctx, req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.FlushCachesResponse{FlushedEntries: flushed}, nil
+}
+
+// ReconnectNATS reconnects this replica to NATS.
+func (s *AdminService) ReconnectNATS(ctx context.Context, req *v1.ReconnectNATSRequest) (*v1.ReconnectNATSResponse, error) {
+	previous, err := s.runbook.ReconnectNATS(ctx, req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ReconnectNATSResponse{PreviousServer: previous}, nil
+}
+
+// RotateDatabasePool replaces the database connection pool of this replica.
+func (s *AdminService) RotateDatabasePool(ctx context.Context, req *v1.RotateDatabasePoolRequest) (*v1.RotateDatabasePoolResponse, error) {
+	retired, err := s.runbook.RotateDatabasePool(ctx, req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RotateDatabasePoolResponse{RetiredConnections: int32(retired)}, nil
+}
+
+// ReloadSecrets reloads the secrets of this replica.
+func (s *AdminService) ReloadSecrets(ctx context.Context, req *v1.ReloadSecretsRequest) (*v1.ReloadSecretsResponse, error) {
+	rotated, err := s.runbook.ReloadSecrets(ctx, req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ReloadSecretsResponse{JwtSecretRotated: rotated}, nil
+}
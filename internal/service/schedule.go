@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoScheduledChange converts biz.ScheduledChange to proto ScheduledChange
+func toProtoScheduledChange(c *biz.ScheduledChange) *v1.ScheduledChange {
+	change := &v1.ScheduledChange{
+		Id:           c.ID.String(),
+		Operation:    c.Operation,
+		EmployeeId:   c.EmployeeID.String(),
+		Emails:       c.Emails,
+		FirstName:    c.FirstName,
+		LastName:     c.LastName,
+		Title:        c.Title,
+		PhoneNumbers: toProtoPhoneNumbers(c.PhoneNumbers),
+		Address:      toProtoAddress(c.Address),
+		ExternalIds:  c.ExternalIDs,
+		EffectiveAt:  timestamppb.New(c.EffectiveAt),
+		Status:       c.Status,
+		Error:        c.Error,
+		CreatedBy:    c.CreatedBy,
+		CreatedAt:    timestamppb.New(c.CreatedAt),
+	}
+	if c.AppliedAt != nil {
+		change.AppliedAt = timestamppb.New(*c.AppliedAt)
+	}
+	if c.DepartmentID != nil {
+		departmentID := ""
+		if *c.DepartmentID != uuid.Nil {
+			departmentID = c.DepartmentID.String()
+		}
+		change.DepartmentId = &departmentID
+	}
+	if c.ManagerID != nil {
+		managerID := ""
+		if *c.ManagerID != uuid.Nil {
+			managerID = c.ManagerID.String()
+		}
+		change.ManagerId = &managerID
+	}
+	return change
+}
+
+// ListScheduledChanges lists the scheduled creates and updates of the tenant.
+func (s *EmployeeService) ListScheduledChanges(ctx context.Context, req *v1.ListScheduledChangesRequest) (*v1.ListScheduledChangesResponse, error) {
+	filter := &biz.ScheduledChangeFilter{
+		Status:   req.Status,
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+	}
+	if req.EmployeeId != nil {
+		id, err := uuid.Parse(*req.EmployeeId)
+		if err != nil {
+			return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+		}
+		filter.EmployeeID = &id
+	}
+
+	changes, total, err := s.schedules.ListScheduledChanges(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*v1.ScheduledChange, len(changes))
+	for i, change := range changes {
+		out[i] = toProtoScheduledChange(change)
+	}
+	return &v1.ListScheduledChangesResponse{
+		ScheduledChanges: out,
+		Total:            total,
+		Page:             filter.Page,
+		PageSize:         filter.PageSize,
+	}, nil
+}
+
+// CancelScheduledChange cancels a scheduled change before it is applied.
+func (s *EmployeeService) CancelScheduledChange(ctx context.Context, req *v1.CancelScheduledChangeRequest) (*v1.CancelScheduledChangeResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid scheduled change ID format")
+	}
+
+	change, err := s.schedules.CancelScheduledChange(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CancelScheduledChangeResponse{
+		ScheduledChange: toProtoScheduledChange(change),
+	}, nil
+}
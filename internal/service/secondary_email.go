@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// AddSecondaryEmail adds an email to an employee.
+func (s *EmployeeService) AddSecondaryEmail(ctx context.Context, req *v1.AddSecondaryEmailRequest) (*v1.AddSecondaryEmailResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	employee, added, err := s.uc.AddSecondaryEmail(ctx, id, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	setEmployeeETag(ctx, employee)
+
+	return &v1.AddSecondaryEmailResponse{
+		Employee: toProtoEmployee(employee),
+		Added:    added,
+	}, nil
+}
+
+// RemoveSecondaryEmail removes an email from an employee.
+func (s *EmployeeService) RemoveSecondaryEmail(ctx context.Context, req *v1.RemoveSecondaryEmailRequest) (*v1.RemoveSecondaryEmailResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	employee, removed, err := s.uc.RemoveSecondaryEmail(ctx, id, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	setEmployeeETag(ctx, employee)
+
+	return &v1.RemoveSecondaryEmailResponse{
+		Employee: toProtoEmployee(employee),
+		Removed:  removed,
+	}, nil
+}
@@ -3,4 +3,4 @@ package service
 import "github.com/google/wire"
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(NewEmployeeService)
+var ProviderSet = wire.NewSet(NewEmployeeService, NewAdminService, NewWebhookService, NewDepartmentService, NewTeamService)
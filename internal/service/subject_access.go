@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoEmployeeDataAuditEntry converts an audit entry of an exported employee
+func toProtoEmployeeDataAuditEntry(e *biz.AuditEntry) *v1.EmployeeDataAuditEntry {
+	return &v1.EmployeeDataAuditEntry{
+		Id:                   e.ID.String(),
+		Action:               e.Action,
+		ActorId:              e.ActorID,
+		RequestId:            e.RequestID,
+		ClientIp:             e.ClientIP,
+		ImpersonatorId:       e.ImpersonatorID,
+		ImpersonatorTenantId: e.ImpersonatorTenantID,
+		Before:               toProtoEmployee(e.Before),
+		After:                toProtoEmployee(e.After),
+		CreatedAt:            timestamppb.New(e.CreatedAt),
+	}
+}
+
+// toProtoEmployeeDataEvent converts a webhook event of an exported employee
+func toProtoEmployeeDataEvent(e *biz.SubjectEvent) *v1.EmployeeDataEvent {
+	event := &v1.EmployeeDataEvent{
+		EventId:   e.EventID.String(),
+		EventType: e.EventType,
+		WebhookId: e.WebhookID.String(),
+		Recipient: e.Recipient,
+		Status:    e.Status,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+	if e.DeliveredAt != nil {
+		event.DeliveredAt = timestamppb.New(*e.DeliveredAt)
+	}
+	return event
+}
+
+// ExportEmployeeData exports everything stored about an employee.
+func (s *EmployeeService) ExportEmployeeData(ctx context.Context, req *v1.ExportEmployeeDataRequest) (*v1.ExportEmployeeDataResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	export, err := s.subjects.ExportEmployeeData(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ExportEmployeeDataResponse{
+		EmployeeId:   export.EmployeeID.String(),
+		Employee:     toProtoEmployee(export.Employee),
+		AuditEntries: make([]*v1.EmployeeDataAuditEntry, len(export.Audit)),
+		Events:       make([]*v1.EmployeeDataEvent, len(export.Events)),
+		ExportedAt:   timestamppb.New(export.ExportedAt),
+	}
+	for i, e := range export.Audit {
+		resp.AuditEntries[i] = toProtoEmployeeDataAuditEntry(e)
+	}
+	for i, e := range export.Events {
+		resp.Events[i] = toProtoEmployeeDataEvent(e)
+	}
+	return resp, nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/employee/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// AddTag tags an employee.
+func (s *EmployeeService) AddTag(ctx context.Context, req *v1.AddTagRequest) (*v1.AddTagResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	employee, added, err := s.uc.AddTag(ctx, id, req.Tag)
+	if err != nil {
+		return nil, err
+	}
+	setEmployeeETag(ctx, employee)
+
+	return &v1.AddTagResponse{
+		Employee: toProtoEmployee(employee),
+		Added:    added,
+	}, nil
+}
+
+// RemoveTag removes a tag from an employee.
+func (s *EmployeeService) RemoveTag(ctx context.Context, req *v1.RemoveTagRequest) (*v1.RemoveTagResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+
+	employee, removed, err := s.uc.RemoveTag(ctx, id, req.Tag)
+	if err != nil {
+		return nil, err
+	}
+	setEmployeeETag(ctx, employee)
+
+	return &v1.RemoveTagResponse{
+		Employee: toProtoEmployee(employee),
+		Removed:  removed,
+	}, nil
+}
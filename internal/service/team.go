@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/team/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TeamService is a team service.
+type TeamService struct {
+	v1.UnimplementedTeamServiceServer
+
+	uc *biz.TeamUsecase
+}
+
+// NewTeamService creates a new team service.
+func NewTeamService(uc *biz.TeamUsecase) *TeamService {
+	return &TeamService{uc: uc}
+}
+
+// toProtoTeam converts biz.Team to proto Team
+func toProtoTeam(t *biz.Team) *v1.Team {
+	return &v1.Team{
+		Id:          t.ID.String(),
+		Name:        t.Name,
+		Description: t.Description,
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+		UpdatedAt:   timestamppb.New(t.UpdatedAt),
+	}
+}
+
+// toProtoTeamMember converts biz.TeamMember to proto TeamMember
+func toProtoTeamMember(m *biz.TeamMember) *v1.TeamMember {
+	return &v1.TeamMember{
+		EmployeeId: m.Employee.ID.String(),
+		Emails:     m.Employee.Emails,
+		FirstName:  m.Employee.FirstName,
+		LastName:   m.Employee.LastName,
+		JoinedAt:   timestamppb.New(m.JoinedAt),
+	}
+}
+
+// parseMembership parses the team and employee IDs of a membership request
+func parseMembership(teamID, employeeID string) (uuid.UUID, uuid.UUID, error) {
+	team, err := uuid.Parse(teamID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.BadRequest("INVALID_UUID", "invalid team ID format")
+	}
+	employee, err := uuid.Parse(employeeID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.BadRequest("INVALID_UUID", "invalid employee ID format")
+	}
+	return team, employee, nil
+}
+
+// CreateTeam creates a team.
+func (s *TeamService) CreateTeam(ctx context.Context, req *v1.CreateTeamRequest) (*v1.CreateTeamResponse, error) {
+	team, err := s.uc.CreateTeam(ctx, req.Name, req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateTeamResponse{Team: toProtoTeam(team)}, nil
+}
+
+// AddTeamMember adds an employee to a team.
+func (s *TeamService) AddTeamMember(ctx context.Context, req *v1.AddTeamMemberRequest) (*v1.AddTeamMemberResponse, error) {
+	teamID, employeeID, err := parseMembership(req.TeamId, req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := s.uc.AddTeamMember(ctx, teamID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.AddTeamMemberResponse{Added: added}, nil
+}
+
+// RemoveTeamMember removes an employee from a team.
+func (s *TeamService) RemoveTeamMember(ctx context.Context, req *v1.RemoveTeamMemberRequest) (*v1.RemoveTeamMemberResponse, error) {
+	teamID, employeeID, err := parseMembership(req.TeamId, req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.RemoveTeamMember(ctx, teamID, employeeID); err != nil {
+		return nil, err
+	}
+
+	return &v1.RemoveTeamMemberResponse{Success: true}, nil
+}
+
+// ListTeamMembers lists the members of a team.
+func (s *TeamService) ListTeamMembers(ctx context.Context, req *v1.ListTeamMembersRequest) (*v1.ListTeamMembersResponse, error) {
+	teamID, err := uuid.Parse(req.TeamId)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid team ID format")
+	}
+
+	// Handle optional pagination fields (default to 0, business logic applies defaults)
+	var page, pageSize int32
+	if req.Page != nil {
+		page = *req.Page
+	}
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	members, total, page, pageSize, err := s.uc.ListTeamMembers(ctx, teamID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	protoMembers := make([]*v1.TeamMember, len(members))
+	for i, m := range members {
+		protoMembers[i] = toProtoTeamMember(m)
+	}
+
+	return &v1.ListTeamMembersResponse{
+		Members:  protoMembers,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
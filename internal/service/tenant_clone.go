@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/admin/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoTenantClone converts biz.TenantClone to proto TenantClone
+func toProtoTenantClone(c *biz.TenantClone) *v1.TenantClone {
+	clone := &v1.TenantClone{
+		Id:              c.ID.String(),
+		TargetTenantId:  c.TargetTenantID,
+		Anonymize:       c.Anonymize,
+		Status:          c.Status,
+		Phase:           c.Phase,
+		CopiedEmployees: c.CopiedEmployees,
+		Error:           c.Error,
+		CreatedBy:       c.CreatedBy,
+		CreatedAt:       timestamppb.New(c.CreatedAt),
+		UpdatedAt:       timestamppb.New(c.UpdatedAt),
+	}
+	if c.CompletedAt != nil {
+		clone.CompletedAt = timestamppb.New(*c.CompletedAt)
+	}
+	return clone
+}
+
+// CloneTenant starts copying the caller's tenant into a sandbox tenant.
+func (s *AdminService) CloneTenant(ctx context.Context, req *v1.CloneTenantRequest) (*v1.TenantClone, error) {
+	anonymize := req.Anonymize == nil || req.GetAnonymize()
+	clone, err := s.clones.CloneTenant(ctx, req.TargetTenantId, anonymize)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoTenantClone(clone), nil
+}
+
+// GetTenantClone returns the progress of a clone of the caller's tenant.
+func (s *AdminService) GetTenantClone(ctx context.Context, req *v1.GetTenantCloneRequest) (*v1.TenantClone, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid clone ID format")
+	}
+
+	clone, err := s.clones.GetTenantClone(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoTenantClone(clone), nil
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/admin/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoTenantSettings converts biz.TenantSettings to proto TenantSettings
+func toProtoTenantSettings(s *biz.TenantSettings) *v1.TenantSettings {
+	domains := s.AllowedEmailDomains
+	if domains == nil {
+		domains = []string{}
+	}
+
+	settings := &v1.TenantSettings{
+		AllowedEmailDomains: domains,
+		UpdatedBy:           s.UpdatedBy,
+	}
+	if !s.UpdatedAt.IsZero() {
+		settings.UpdatedAt = timestamppb.New(s.UpdatedAt)
+	}
+	return settings
+}
+
+// GetTenantSettings returns the settings of the caller's tenant.
+func (s *AdminService) GetTenantSettings(ctx context.Context, req *v1.GetTenantSettingsRequest) (*v1.TenantSettings, error) {
+	settings, err := s.settings.GetTenantSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoTenantSettings(settings), nil
+}
+
+// UpdateTenantSettings replaces the settings of the caller's tenant.
+func (s *AdminService) UpdateTenantSettings(ctx context.Context, req *v1.UpdateTenantSettingsRequest) (*v1.TenantSettings, error) {
+	settings, err := s.settings.UpdateTenantSettings(ctx, &biz.TenantSettings{
+		AllowedEmailDomains: req.AllowedEmailDomains,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toProtoTenantSettings(settings), nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/cvele/employee-service/api/admin/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoTokenRevocation converts biz.TokenRevocation to proto TokenRevocation
+func toProtoTokenRevocation(r *biz.TokenRevocation) *v1.TokenRevocation {
+	revocation := &v1.TokenRevocation{
+		Jti:       r.JTI,
+		UserId:    r.UserID,
+		RevokedAt: timestamppb.New(r.RevokedAt),
+		RevokedBy: r.RevokedBy,
+	}
+	if r.ExpiresAt != nil {
+		revocation.ExpiresAt = timestamppb.New(*r.ExpiresAt)
+	}
+	return revocation
+}
+
+// RevokeTokens revokes a token or the tokens of a user.
+func (s *AdminService) RevokeTokens(ctx context.Context, req *v1.RevokeTokensRequest) (*v1.TokenRevocation, error) {
+	var revocation *biz.TokenRevocation
+	var err error
+	if req.GetUserId() != "" {
+		revocation, err = s.revocations.RevokeUserTokens(ctx, req.GetUserId())
+	} else {
+		var expiresAt *time.Time
+		if req.ExpiresAt != nil {
+			t := req.ExpiresAt.AsTime()
+			expiresAt = &t
+		}
+		revocation, err = s.revocations.RevokeToken(ctx, req.GetJti(), expiresAt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toProtoTokenRevocation(revocation), nil
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/cvele/employee-service/api/webhook/v1"
+	"github.com/cvele/employee-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WebhookService is a webhook service.
+type WebhookService struct {
+	v1.UnimplementedWebhookServiceServer
+
+	uc *biz.WebhookUsecase
+}
+
+// NewWebhookService creates a new webhook service.
+func NewWebhookService(uc *biz.WebhookUsecase) *WebhookService {
+	return &WebhookService{uc: uc}
+}
+
+// toProtoWebhook converts biz.Webhook to proto Webhook, leaving out the secret
+func toProtoWebhook(w *biz.Webhook) *v1.Webhook {
+	eventTypes := w.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	webhook := &v1.Webhook{
+		Id:              w.ID.String(),
+		Url:             w.URL,
+		EventTypes:      eventTypes,
+		Enabled:         w.Enabled,
+		CreatedAt:       timestamppb.New(w.CreatedAt),
+		UpdatedAt:       timestamppb.New(w.UpdatedAt),
+		DeliveryMode:    w.DeliveryMode,
+		PayloadTemplate: w.PayloadTemplate,
+	}
+	if w.DeliveryMode == biz.WebhookDeliveryModeDigest {
+		webhook.DigestInterval = durationpb.New(w.DigestInterval)
+	}
+	return webhook
+}
+
+// toProtoWebhookDelivery converts biz.WebhookDelivery to proto WebhookDelivery
+func toProtoWebhookDelivery(d *biz.WebhookDelivery) *v1.WebhookDelivery {
+	delivery := &v1.WebhookDelivery{
+		Id:             d.ID.String(),
+		EventId:        d.EventID.String(),
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+	}
+	if d.Status == biz.WebhookDeliveryPending {
+		delivery.NextAttemptAt = timestamppb.New(d.NextAttemptAt)
+	}
+	if d.DeliveredAt != nil {
+		delivery.DeliveredAt = timestamppb.New(*d.DeliveredAt)
+	}
+	if d.DigestID != nil {
+		delivery.DigestId = d.DigestID.String()
+	}
+	return delivery
+}
+
+// parseWebhookID parses a webhook ID from a request
+func parseWebhookID(raw string) (uuid.UUID, error) {
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, errors.BadRequest("INVALID_UUID", "invalid webhook ID format")
+	}
+	return id, nil
+}
+
+// CreateWebhook creates a webhook and returns its signing secret.
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *v1.CreateWebhookRequest) (*v1.CreateWebhookResponse, error) {
+	webhook, err := s.uc.CreateWebhook(ctx, req.Url, req.EventTypes, req.DeliveryMode, req.DigestInterval.AsDuration(), req.PayloadTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateWebhookResponse{
+		Webhook: toProtoWebhook(webhook),
+		Secret:  webhook.Secret,
+	}, nil
+}
+
+// GetWebhook retrieves a webhook by ID.
+func (s *WebhookService) GetWebhook(ctx context.Context, req *v1.GetWebhookRequest) (*v1.GetWebhookResponse, error) {
+	id, err := parseWebhookID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.uc.GetWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetWebhookResponse{Webhook: toProtoWebhook(webhook)}, nil
+}
+
+// ListWebhooks lists the webhooks of the caller's tenant.
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *v1.ListWebhooksRequest) (*v1.ListWebhooksResponse, error) {
+	webhooks, err := s.uc.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	protoWebhooks := make([]*v1.Webhook, len(webhooks))
+	for i, w := range webhooks {
+		protoWebhooks[i] = toProtoWebhook(w)
+	}
+
+	return &v1.ListWebhooksResponse{Webhooks: protoWebhooks}, nil
+}
+
+// UpdateWebhook updates a webhook, optionally rotating its secret.
+func (s *WebhookService) UpdateWebhook(ctx context.Context, req *v1.UpdateWebhookRequest) (*v1.UpdateWebhookResponse, error) {
+	id, err := parseWebhookID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &biz.WebhookUpdate{
+		URL:             req.Url,
+		EventTypes:      req.EventTypes,
+		Enabled:         req.Enabled,
+		RotateSecret:    req.RotateSecret,
+		DeliveryMode:    req.DeliveryMode,
+		PayloadTemplate: req.PayloadTemplate,
+	}
+	if req.DigestInterval != nil {
+		interval := req.DigestInterval.AsDuration()
+		update.DigestInterval = &interval
+	}
+
+	webhook, err := s.uc.UpdateWebhook(ctx, id, update)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.UpdateWebhookResponse{Webhook: toProtoWebhook(webhook)}
+	if req.RotateSecret {
+		resp.Secret = webhook.Secret
+	}
+	return resp, nil
+}
+
+// DeleteWebhook deletes a webhook and its delivery log.
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *v1.DeleteWebhookRequest) (*v1.DeleteWebhookResponse, error) {
+	id, err := parseWebhookID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.DeleteWebhook(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteWebhookResponse{Success: true}, nil
+}
+
+// ListWebhookDeliveries lists the delivery log of a webhook.
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *v1.ListWebhookDeliveriesRequest) (*v1.ListWebhookDeliveriesResponse, error) {
+	id, err := parseWebhookID(req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &biz.WebhookDeliveryFilter{WebhookID: id}
+
+	// Handle optional pagination fields (default to 0, business logic applies defaults)
+	if req.Page != nil {
+		filter.Page = *req.Page
+	}
+	if req.PageSize != nil {
+		filter.PageSize = *req.PageSize
+	}
+
+	deliveries, total, err := s.uc.ListWebhookDeliveries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	protoDeliveries := make([]*v1.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		protoDeliveries[i] = toProtoWebhookDelivery(d)
+	}
+
+	return &v1.ListWebhookDeliveriesResponse{
+		Deliveries: protoDeliveries,
+		Total:      total,
+		Page:       filter.Page,
+		PageSize:   filter.PageSize,
+	}, nil
+}
+
+// ReplayWebhookDelivery queues a failed delivery for new attempts.
+func (s *WebhookService) ReplayWebhookDelivery(ctx context.Context, req *v1.ReplayWebhookDeliveryRequest) (*v1.ReplayWebhookDeliveryResponse, error) {
+	webhookID, err := parseWebhookID(req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_UUID", "invalid delivery ID format")
+	}
+
+	delivery, err := s.uc.ReplayWebhookDelivery(ctx, webhookID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ReplayWebhookDeliveryResponse{Delivery: toProtoWebhookDelivery(delivery)}, nil
+}
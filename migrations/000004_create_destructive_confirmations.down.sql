@@ -0,0 +1,7 @@
+-- Rollback: Drop destructive operation confirmations
+
+BEGIN;
+
+DROP TABLE IF EXISTS destructive_confirmations;
+
+COMMIT;
@@ -0,0 +1,25 @@
+-- Migration: Confirmation tokens for destructive admin operations
+-- Purge and bulk delete return a token on the first call; the operation only
+-- executes when the token is echoed back before it expires.
+
+BEGIN;
+
+CREATE TABLE destructive_confirmations (
+    token_hash VARCHAR(64) PRIMARY KEY,
+    tenant_id VARCHAR(255) NOT NULL,
+    operation VARCHAR(64) NOT NULL,
+    params_digest VARCHAR(64) NOT NULL DEFAULT '',
+    affected_count BIGINT NOT NULL DEFAULT 0,
+    requested_by VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    consumed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_destructive_confirmations_tenant_id ON destructive_confirmations(tenant_id);
+
+COMMENT ON TABLE destructive_confirmations IS 'Single-use confirmation tokens for destructive admin operations';
+COMMENT ON COLUMN destructive_confirmations.token_hash IS 'SHA-256 of the token handed to the caller';
+COMMENT ON COLUMN destructive_confirmations.params_digest IS 'Digest of the request parameters the token was issued for';
+
+COMMIT;
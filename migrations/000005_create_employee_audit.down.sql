@@ -0,0 +1,7 @@
+-- Rollback: Drop employee audit log
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_audit;
+
+COMMIT;
@@ -0,0 +1,28 @@
+-- Migration: Audit log for employee mutations
+-- Every create/update/delete/merge writes a row in the same transaction as the
+-- change. employee_id deliberately has no foreign key so history survives deletes.
+
+BEGIN;
+
+CREATE TABLE employee_audit (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(255) NOT NULL,
+    employee_id UUID NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    actor_id VARCHAR(255) NOT NULL DEFAULT '',
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+    before JSONB,
+    after JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_employee_audit_tenant_employee ON employee_audit(tenant_id, employee_id, created_at);
+CREATE INDEX idx_employee_audit_tenant_created_at ON employee_audit(tenant_id, created_at);
+
+COMMENT ON TABLE employee_audit IS 'Append-only log of employee mutations';
+COMMENT ON COLUMN employee_audit.actor_id IS 'User ID of the caller that performed the mutation';
+COMMENT ON COLUMN employee_audit.request_id IS 'X-Request-ID of the request that performed the mutation';
+COMMENT ON COLUMN employee_audit.before IS 'Employee snapshot before the change, NULL for creates';
+COMMENT ON COLUMN employee_audit.after IS 'Employee snapshot after the change, NULL for deletes';
+
+COMMIT;
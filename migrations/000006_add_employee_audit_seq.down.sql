@@ -0,0 +1,9 @@
+-- Rollback: Drop audit log sequence
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employee_audit_tenant_seq;
+
+ALTER TABLE employee_audit DROP COLUMN IF EXISTS seq;
+
+COMMIT;
@@ -0,0 +1,13 @@
+-- Migration: Monotonic sequence on the audit log
+-- seq orders audit entries for resumable watches; resume tokens encode the
+-- last delivered seq.
+
+BEGIN;
+
+ALTER TABLE employee_audit ADD COLUMN seq BIGSERIAL NOT NULL;
+
+CREATE UNIQUE INDEX idx_employee_audit_tenant_seq ON employee_audit(tenant_id, seq);
+
+COMMENT ON COLUMN employee_audit.seq IS 'Monotonic position used as the Watch resume checkpoint';
+
+COMMIT;
@@ -0,0 +1,9 @@
+-- Rollback: Drop webhooks
+
+BEGIN;
+
+DROP TABLE IF EXISTS webhook_dispatch_cursor;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
+
+COMMIT;
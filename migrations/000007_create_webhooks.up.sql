@@ -0,0 +1,57 @@
+-- Migration: Webhook subscriptions and deliveries
+-- Deliveries are fanned out from employee_audit, so every committed mutation
+-- produces one delivery per matching webhook. webhook_dispatch_cursor tracks
+-- the last audit seq that was fanned out.
+
+BEGIN;
+
+CREATE TABLE webhooks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(255) NOT NULL,
+    url TEXT NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    event_types JSONB NOT NULL DEFAULT '[]',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_webhooks_tenant_id ON webhooks(tenant_id);
+
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(255) NOT NULL,
+    webhook_id UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    response_status INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_webhook_deliveries_webhook_event UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_webhook_created_at ON webhook_deliveries(webhook_id, created_at);
+
+CREATE TABLE webhook_dispatch_cursor (
+    id INTEGER PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    seq BIGINT NOT NULL
+);
+
+-- Only changes made after the migration are delivered
+INSERT INTO webhook_dispatch_cursor (id, seq) SELECT 1, COALESCE(MAX(seq), 0) FROM employee_audit;
+
+COMMENT ON TABLE webhooks IS 'Per-tenant HTTP callbacks for employee events';
+COMMENT ON COLUMN webhooks.secret IS 'HMAC-SHA256 key used to sign deliveries';
+COMMENT ON COLUMN webhooks.event_types IS 'JSON array of subscribed event types';
+COMMENT ON TABLE webhook_deliveries IS 'Delivery queue and log, one row per event and webhook';
+COMMENT ON COLUMN webhook_deliveries.event_id IS 'ID of the employee_audit entry the event was created from';
+COMMENT ON TABLE webhook_dispatch_cursor IS 'Single row holding the last employee_audit seq fanned out to webhooks';
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Rollback: Drop employee imports
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_imports;
+
+COMMIT;
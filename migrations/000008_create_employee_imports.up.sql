@@ -0,0 +1,35 @@
+-- Migration: Bulk employee imports
+-- Each row is a long-running import operation. The CSV is kept until the
+-- import completes so that an interrupted import can be resumed from
+-- processed_rows by another replica once locked_until has passed.
+
+BEGIN;
+
+CREATE TABLE employee_imports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(255) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    source_url TEXT NOT NULL DEFAULT '',
+    csv BYTEA,
+    total_rows INTEGER NOT NULL DEFAULT 0,
+    processed_rows INTEGER NOT NULL DEFAULT 0,
+    created_count INTEGER NOT NULL DEFAULT 0,
+    failed_count INTEGER NOT NULL DEFAULT 0,
+    errors JSONB NOT NULL DEFAULT '[]',
+    error TEXT NOT NULL DEFAULT '',
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    locked_until TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+CREATE INDEX idx_employee_imports_tenant_id ON employee_imports(tenant_id);
+CREATE INDEX idx_employee_imports_runnable ON employee_imports(created_at) WHERE status IN ('pending', 'running');
+
+COMMENT ON TABLE employee_imports IS 'Bulk CSV employee import operations';
+COMMENT ON COLUMN employee_imports.csv IS 'Inline CSV document, cleared when the import completes';
+COMMENT ON COLUMN employee_imports.errors IS 'JSON array of per-row errors, capped at 1000 entries';
+COMMENT ON COLUMN employee_imports.locked_until IS 'Lease of the worker running the import';
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Rollback: Drop employee merges
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_merges;
+
+COMMIT;
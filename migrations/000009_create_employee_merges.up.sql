@@ -0,0 +1,24 @@
+-- Migration: Employee merge records
+-- Each merge keeps a snapshot of the secondary employee as it was before the
+-- merge so that UnmergeEmployees can recreate it and move its emails back.
+
+BEGIN;
+
+CREATE TABLE employee_merges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(255) NOT NULL,
+    primary_id UUID NOT NULL,
+    secondary JSONB NOT NULL,
+    merged_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    unmerged_by VARCHAR(255) NOT NULL DEFAULT '',
+    unmerged_at TIMESTAMP
+);
+
+CREATE INDEX idx_employee_merges_tenant_primary ON employee_merges(tenant_id, primary_id);
+
+COMMENT ON TABLE employee_merges IS 'Merges of two employees, kept to allow undoing them';
+COMMENT ON COLUMN employee_merges.secondary IS 'Snapshot of the secondary employee before the merge';
+COMMENT ON COLUMN employee_merges.unmerged_at IS 'Set once the merge has been undone';
+
+COMMIT;
@@ -0,0 +1,12 @@
+-- Rollback: Drop the ListEmployees filter indexes
+-- The pg_trgm extension is left installed; other objects may depend on it.
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employee_emails_email_trgm;
+DROP INDEX IF EXISTS idx_employee_emails_tenant_domain;
+DROP INDEX IF EXISTS idx_employees_tenant_full_name_prefix;
+DROP INDEX IF EXISTS idx_employees_tenant_last_name_prefix;
+DROP INDEX IF EXISTS idx_employees_tenant_first_name_prefix;
+
+COMMIT;
@@ -0,0 +1,18 @@
+-- Migration: Indexes for the ListEmployees name and email filters
+-- name_prefix is served by text_pattern_ops indexes on the lowercased names,
+-- which support LIKE 'prefix%' regardless of the database collation.
+-- email_domain is served by an expression index on the lowercased domain and
+-- email_contains by a trigram index, which supports LIKE '%needle%'.
+
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_employees_tenant_first_name_prefix ON employees(tenant_id, lower(first_name) text_pattern_ops);
+CREATE INDEX idx_employees_tenant_last_name_prefix ON employees(tenant_id, lower(last_name) text_pattern_ops);
+CREATE INDEX idx_employees_tenant_full_name_prefix ON employees(tenant_id, lower(first_name || ' ' || last_name) text_pattern_ops);
+
+CREATE INDEX idx_employee_emails_tenant_domain ON employee_emails(tenant_id, lower(split_part(email, '@', 2)));
+CREATE INDEX idx_employee_emails_email_trgm ON employee_emails USING GIN (lower(email) gin_trgm_ops);
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Rollback: Drop the updated_since index
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employees_tenant_updated_at;
+
+COMMIT;
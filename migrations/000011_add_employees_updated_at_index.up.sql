@@ -0,0 +1,9 @@
+-- Migration: Index for the ListEmployees updated_since filter
+-- Incremental syncs poll each tenant for employees updated since their last
+-- poll, reading them oldest change first.
+
+BEGIN;
+
+CREATE INDEX idx_employees_tenant_updated_at ON employees(tenant_id, updated_at, id);
+
+COMMIT;
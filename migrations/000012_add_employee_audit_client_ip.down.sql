@@ -0,0 +1,7 @@
+-- Rollback: Drop the audit client IP
+
+BEGIN;
+
+ALTER TABLE employee_audit DROP COLUMN IF EXISTS client_ip;
+
+COMMIT;
@@ -0,0 +1,9 @@
+-- Migration: Record the client IP of audited mutations
+-- The request ID alone does not identify where a change came from once the
+-- request logs have expired.
+
+BEGIN;
+
+ALTER TABLE employee_audit ADD COLUMN client_ip VARCHAR(64) NOT NULL DEFAULT '';
+
+COMMIT;
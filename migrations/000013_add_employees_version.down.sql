@@ -0,0 +1,7 @@
+-- Rollback: Drop the employee version
+
+BEGIN;
+
+ALTER TABLE employees DROP COLUMN IF EXISTS version;
+
+COMMIT;
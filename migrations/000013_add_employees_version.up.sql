@@ -0,0 +1,9 @@
+-- Migration: Version employees for optimistic concurrency
+-- Every change increments version; UpdateEmployee only applies when the
+-- caller's version is still current.
+
+BEGIN;
+
+ALTER TABLE employees ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+COMMIT;
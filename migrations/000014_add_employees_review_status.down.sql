@@ -0,0 +1,9 @@
+-- Rollback: Drop the employee review status
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employees_tenant_pending_review;
+
+ALTER TABLE employees DROP COLUMN IF EXISTS review_status;
+
+COMMIT;
@@ -0,0 +1,13 @@
+-- Migration: Review status of employees
+-- Employees created through channels under review wait as pending until
+-- approved. Only a small share is ever pending, so the review queue gets a
+-- partial index.
+
+BEGIN;
+
+ALTER TABLE employees ADD COLUMN review_status VARCHAR(16) NOT NULL DEFAULT 'approved';
+
+CREATE INDEX idx_employees_tenant_pending_review ON employees(tenant_id, created_at, id)
+    WHERE review_status = 'pending';
+
+COMMIT;
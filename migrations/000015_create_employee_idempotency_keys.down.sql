@@ -0,0 +1,7 @@
+-- Rollback: Drop idempotency keys
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_idempotency_keys;
+
+COMMIT;
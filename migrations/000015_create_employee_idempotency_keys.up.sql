@@ -0,0 +1,24 @@
+-- Migration: Idempotency keys of create requests
+-- A create request with an Idempotency-Key stores the employee it created, so
+-- that a retry with the same key returns the original response. Keys expire
+-- and are deleted by a background job.
+
+BEGIN;
+
+CREATE TABLE employee_idempotency_keys (
+    tenant_id VARCHAR(255) NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    fingerprint VARCHAR(64) NOT NULL,
+    employee JSONB,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, idempotency_key)
+);
+
+CREATE INDEX idx_employee_idempotency_keys_expires_at ON employee_idempotency_keys(expires_at);
+
+COMMENT ON TABLE employee_idempotency_keys IS 'Idempotency keys of create requests and the employees they created';
+COMMENT ON COLUMN employee_idempotency_keys.fingerprint IS 'SHA-256 of the request the key was first used with';
+COMMENT ON COLUMN employee_idempotency_keys.employee IS 'Snapshot of the created employee returned on replay';
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Rollback: Drop scheduled changes
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_scheduled_changes;
+
+COMMIT;
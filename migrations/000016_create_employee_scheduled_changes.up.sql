@@ -0,0 +1,34 @@
+-- Migration: Scheduled creates and updates
+-- Creates and updates with a future effective_at are stored here and applied
+-- by the scheduler once due. Workers lease a due change with locked_until
+-- while applying it.
+
+BEGIN;
+
+CREATE TABLE employee_scheduled_changes (
+    id UUID PRIMARY KEY,
+    tenant_id VARCHAR(255) NOT NULL,
+    operation VARCHAR(16) NOT NULL,
+    employee_id UUID NOT NULL,
+    emails JSONB NOT NULL DEFAULT '[]',
+    first_name VARCHAR(100) NOT NULL DEFAULT '',
+    last_name VARCHAR(100) NOT NULL DEFAULT '',
+    channel VARCHAR(64) NOT NULL DEFAULT '',
+    effective_at TIMESTAMP NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    error TEXT NOT NULL DEFAULT '',
+    created_by VARCHAR(255) NOT NULL,
+    locked_until TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    applied_at TIMESTAMP
+);
+
+CREATE INDEX idx_employee_scheduled_changes_tenant_effective_at ON employee_scheduled_changes(tenant_id, status, effective_at);
+CREATE INDEX idx_employee_scheduled_changes_due ON employee_scheduled_changes(effective_at)
+    WHERE status = 'pending';
+
+COMMENT ON TABLE employee_scheduled_changes IS 'Employee creates and updates applied at a future effective time';
+COMMENT ON COLUMN employee_scheduled_changes.employee_id IS 'Employee to update, or the ID a scheduled create assigns';
+COMMENT ON COLUMN employee_scheduled_changes.channel IS 'Creation channel of the caller, deciding the review status of creates';
+
+COMMIT;
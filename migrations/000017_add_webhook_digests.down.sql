@@ -0,0 +1,13 @@
+-- Rollback: Remove webhook digest delivery mode
+-- Queued events are released as regular deliveries.
+
+BEGIN;
+
+UPDATE webhook_deliveries SET status = 'pending', next_attempt_at = CURRENT_TIMESTAMP WHERE status = 'queued';
+DROP INDEX IF EXISTS idx_webhook_deliveries_queued;
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS digest_id;
+ALTER TABLE webhooks
+    DROP COLUMN IF EXISTS digest_interval_seconds,
+    DROP COLUMN IF EXISTS delivery_mode;
+
+COMMIT;
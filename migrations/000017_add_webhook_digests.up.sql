@@ -0,0 +1,22 @@
+-- Migration: Webhook digest delivery mode
+-- Webhooks in digest mode receive the events of each digest interval as one
+-- employee.digest delivery. Their events are fanned out as queued deliveries
+-- and marked digested, pointing at the digest, once their window closes.
+
+BEGIN;
+
+ALTER TABLE webhooks
+    ADD COLUMN delivery_mode VARCHAR(16) NOT NULL DEFAULT 'immediate',
+    ADD COLUMN digest_interval_seconds BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE webhook_deliveries
+    ADD COLUMN digest_id UUID;
+
+CREATE INDEX idx_webhook_deliveries_queued ON webhook_deliveries(webhook_id, created_at)
+    WHERE status = 'queued';
+
+COMMENT ON COLUMN webhooks.delivery_mode IS 'immediate (one delivery per event) or digest';
+COMMENT ON COLUMN webhooks.digest_interval_seconds IS 'Length of the digest windows, 0 in immediate mode';
+COMMENT ON COLUMN webhook_deliveries.digest_id IS 'Digest delivery a digested event was sent in';
+
+COMMIT;
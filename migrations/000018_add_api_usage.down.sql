@@ -0,0 +1,7 @@
+-- Rollback: Drop daily API usage
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_api_usage;
+
+COMMIT;
@@ -0,0 +1,20 @@
+-- Migration: Daily API usage per tenant
+-- Requests are counted in memory by tenant, operation and response status, and
+-- added to this table periodically, giving one row per combination and UTC day.
+
+BEGIN;
+
+CREATE TABLE employee_api_usage (
+    tenant_id VARCHAR(255) NOT NULL,
+    day DATE NOT NULL,
+    operation VARCHAR(255) NOT NULL,
+    status INTEGER NOT NULL,
+    count BIGINT NOT NULL,
+    PRIMARY KEY (tenant_id, day, operation, status)
+);
+
+COMMENT ON TABLE employee_api_usage IS 'Daily request counts per tenant, operation and status';
+COMMENT ON COLUMN employee_api_usage.operation IS 'Full gRPC method name of the request, also for HTTP routes';
+COMMENT ON COLUMN employee_api_usage.status IS 'HTTP status code of the response';
+
+COMMIT;
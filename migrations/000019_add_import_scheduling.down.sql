@@ -0,0 +1,10 @@
+-- Rollback: Drop import scheduling columns
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employee_imports_tenant_turn_at;
+
+ALTER TABLE employee_imports DROP COLUMN IF EXISTS turn_at;
+ALTER TABLE employee_imports DROP COLUMN IF EXISTS ready_at;
+
+COMMIT;
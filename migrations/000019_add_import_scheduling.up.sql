@@ -0,0 +1,21 @@
+-- Migration: Fair scheduling of imports between tenants
+-- Imports run in turns and yield between them when other tenants' imports are
+-- waiting. The tenant whose last turn started the longest ago goes next,
+-- then its import runnable the longest.
+
+BEGIN;
+
+ALTER TABLE employee_imports ADD COLUMN ready_at TIMESTAMP;
+ALTER TABLE employee_imports ADD COLUMN turn_at TIMESTAMP;
+
+UPDATE employee_imports SET ready_at = created_at;
+
+ALTER TABLE employee_imports ALTER COLUMN ready_at SET NOT NULL;
+ALTER TABLE employee_imports ALTER COLUMN ready_at SET DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX idx_employee_imports_tenant_turn_at ON employee_imports(tenant_id, turn_at);
+
+COMMENT ON COLUMN employee_imports.ready_at IS 'When the import was created or last yielded its turn';
+COMMENT ON COLUMN employee_imports.turn_at IS 'When the latest turn of the import started';
+
+COMMIT;
@@ -0,0 +1,13 @@
+-- Rollback: Drop departments
+
+BEGIN;
+
+ALTER TABLE employee_scheduled_changes DROP COLUMN IF EXISTS department_id;
+
+DROP INDEX IF EXISTS idx_employees_tenant_department;
+ALTER TABLE employees DROP CONSTRAINT IF EXISTS fk_employees_department;
+ALTER TABLE employees DROP COLUMN IF EXISTS department_id;
+
+DROP TABLE IF EXISTS employee_departments;
+
+COMMIT;
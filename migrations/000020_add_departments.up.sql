@@ -0,0 +1,33 @@
+-- Migration: Departments
+-- Departments group the employees of a tenant. An employee belongs to at most
+-- one department of its own tenant, enforced by a foreign key on
+-- (tenant_id, department_id); departments with employees cannot be deleted.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS employee_departments (
+    id UUID PRIMARY KEY,
+    tenant_id VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_employee_departments_tenant_id UNIQUE (tenant_id, id)
+);
+
+CREATE UNIQUE INDEX idx_employee_departments_tenant_name ON employee_departments(tenant_id, lower(name));
+
+ALTER TABLE employees ADD COLUMN department_id UUID;
+ALTER TABLE employees ADD CONSTRAINT fk_employees_department
+    FOREIGN KEY (tenant_id, department_id) REFERENCES employee_departments(tenant_id, id);
+
+CREATE INDEX idx_employees_tenant_department ON employees(tenant_id, department_id) WHERE department_id IS NOT NULL;
+
+ALTER TABLE employee_scheduled_changes ADD COLUMN department_id UUID;
+
+COMMENT ON TABLE employee_departments IS 'Departments of a tenant that employees are assigned to';
+COMMENT ON COLUMN employee_departments.name IS 'Department name, unique within the tenant case-insensitively';
+COMMENT ON COLUMN employees.department_id IS 'Department of the employee within its tenant; NULL when unassigned';
+COMMENT ON COLUMN employee_scheduled_changes.department_id IS 'Requested department; NULL keeps the department, the nil UUID removes it';
+
+COMMIT;
@@ -0,0 +1,9 @@
+-- Rollback: Drop teams
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_team_members;
+ALTER TABLE employees DROP CONSTRAINT IF EXISTS uq_employees_tenant_id;
+DROP TABLE IF EXISTS employee_teams;
+
+COMMIT;
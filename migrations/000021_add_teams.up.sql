@@ -0,0 +1,43 @@
+-- Migration: Teams
+-- Teams group employees of a tenant across departments. An employee can be a
+-- member of any number of teams of its own tenant, enforced by foreign keys on
+-- (tenant_id, team_id) and (tenant_id, employee_id); memberships are deleted
+-- with their employee.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS employee_teams (
+    id UUID PRIMARY KEY,
+    tenant_id VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_employee_teams_tenant_id UNIQUE (tenant_id, id)
+);
+
+CREATE UNIQUE INDEX idx_employee_teams_tenant_name ON employee_teams(tenant_id, lower(name));
+
+ALTER TABLE employees ADD CONSTRAINT uq_employees_tenant_id UNIQUE (tenant_id, id);
+
+CREATE TABLE IF NOT EXISTS employee_team_members (
+    team_id UUID NOT NULL,
+    employee_id UUID NOT NULL,
+    tenant_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (team_id, employee_id),
+    CONSTRAINT fk_employee_team_members_team FOREIGN KEY (tenant_id, team_id)
+        REFERENCES employee_teams(tenant_id, id) ON DELETE CASCADE,
+    CONSTRAINT fk_employee_team_members_employee FOREIGN KEY (tenant_id, employee_id)
+        REFERENCES employees(tenant_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_employee_team_members_tenant_employee ON employee_team_members(tenant_id, employee_id);
+CREATE INDEX idx_employee_team_members_team_created ON employee_team_members(team_id, created_at, employee_id);
+
+COMMENT ON TABLE employee_teams IS 'Teams of a tenant that employees are members of';
+COMMENT ON COLUMN employee_teams.name IS 'Team name, unique within the tenant case-insensitively';
+COMMENT ON TABLE employee_team_members IS 'Membership of employees in teams of their tenant';
+COMMENT ON COLUMN employee_team_members.created_at IS 'When the employee joined the team';
+
+COMMIT;
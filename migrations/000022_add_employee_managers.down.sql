@@ -0,0 +1,12 @@
+-- Rollback: Drop employee managers
+
+BEGIN;
+
+ALTER TABLE employee_scheduled_changes DROP COLUMN IF EXISTS manager_id;
+
+DROP INDEX IF EXISTS idx_employees_tenant_manager;
+ALTER TABLE employees DROP CONSTRAINT IF EXISTS chk_employees_manager_not_self;
+ALTER TABLE employees DROP CONSTRAINT IF EXISTS fk_employees_manager;
+ALTER TABLE employees DROP COLUMN IF EXISTS manager_id;
+
+COMMIT;
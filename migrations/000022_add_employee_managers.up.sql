@@ -0,0 +1,20 @@
+-- Migration: Employee managers
+-- An employee reports to at most one manager, another employee of its own
+-- tenant, enforced by a foreign key on (tenant_id, manager_id). Deleting a
+-- manager leaves its reports without a manager.
+
+BEGIN;
+
+ALTER TABLE employees ADD COLUMN manager_id UUID;
+ALTER TABLE employees ADD CONSTRAINT fk_employees_manager
+    FOREIGN KEY (tenant_id, manager_id) REFERENCES employees(tenant_id, id) ON DELETE SET NULL (manager_id);
+ALTER TABLE employees ADD CONSTRAINT chk_employees_manager_not_self CHECK (manager_id <> id);
+
+CREATE INDEX idx_employees_tenant_manager ON employees(tenant_id, manager_id) WHERE manager_id IS NOT NULL;
+
+ALTER TABLE employee_scheduled_changes ADD COLUMN manager_id UUID;
+
+COMMENT ON COLUMN employees.manager_id IS 'Employee of the same tenant this employee reports to; NULL when it has none';
+COMMENT ON COLUMN employee_scheduled_changes.manager_id IS 'Requested manager; NULL keeps the manager, the nil UUID removes it';
+
+COMMIT;
@@ -0,0 +1,10 @@
+-- Rollback: Drop employment history
+
+BEGIN;
+
+DROP TABLE IF EXISTS employment_history;
+
+ALTER TABLE employee_scheduled_changes DROP COLUMN IF EXISTS title;
+ALTER TABLE employees DROP COLUMN IF EXISTS title;
+
+COMMIT;
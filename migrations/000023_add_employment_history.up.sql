@@ -0,0 +1,40 @@
+-- Migration: Employment history
+-- Adds the job title of employees and records the jobs they held: a job is
+-- the title, department and manager of an employee over a period, from
+-- effective_from until effective_to (NULL for the current job). History is
+-- deleted with its employee; department and manager IDs are kept as they
+-- were, without foreign keys, so that the history outlives them.
+
+BEGIN;
+
+ALTER TABLE employees ADD COLUMN title VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE employee_scheduled_changes ADD COLUMN title VARCHAR(100) NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS employment_history (
+    id UUID PRIMARY KEY,
+    tenant_id VARCHAR(255) NOT NULL,
+    employee_id UUID NOT NULL,
+    title VARCHAR(100) NOT NULL DEFAULT '',
+    department_id UUID,
+    manager_id UUID,
+    effective_from TIMESTAMP NOT NULL,
+    effective_to TIMESTAMP,
+    CONSTRAINT fk_employment_history_employee FOREIGN KEY (tenant_id, employee_id)
+        REFERENCES employees(tenant_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_employment_history_tenant_employee ON employment_history(tenant_id, employee_id, effective_from DESC);
+CREATE INDEX idx_employment_history_tenant_manager ON employment_history(tenant_id, manager_id)
+    WHERE effective_to IS NULL AND manager_id IS NOT NULL;
+
+-- Existing employees start with their current job
+INSERT INTO employment_history (id, tenant_id, employee_id, title, department_id, manager_id, effective_from)
+SELECT gen_random_uuid(), tenant_id, id, title, department_id, manager_id, created_at
+FROM employees;
+
+COMMENT ON COLUMN employees.title IS 'Job title; empty when unset';
+COMMENT ON COLUMN employee_scheduled_changes.title IS 'Requested job title; empty keeps the title';
+COMMENT ON TABLE employment_history IS 'Jobs held by employees: title, department and manager over a period';
+COMMENT ON COLUMN employment_history.effective_to IS 'When the job ended; NULL for the current job of the employee';
+
+COMMIT;
@@ -0,0 +1,9 @@
+-- Rollback: Drop employee tags
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employees_tags;
+
+ALTER TABLE employees DROP COLUMN IF EXISTS tags;
+
+COMMIT;
@@ -0,0 +1,14 @@
+-- Migration: Employee tags
+-- Tenants group employees (contractor, remote, alumni) with free-form
+-- lowercase tags, kept sorted in an array column. The GIN index serves the
+-- tags filter of listings (tags @> ARRAY[...]).
+
+BEGIN;
+
+ALTER TABLE employees ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_employees_tags ON employees USING GIN (tags);
+
+COMMENT ON COLUMN employees.tags IS 'Lowercase tags grouping the employee, sorted';
+
+COMMIT;
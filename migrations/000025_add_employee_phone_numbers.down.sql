@@ -0,0 +1,9 @@
+-- Rollback: Drop employee phone numbers
+
+BEGIN;
+
+ALTER TABLE employee_scheduled_changes DROP COLUMN IF EXISTS phone_numbers;
+
+DROP TABLE IF EXISTS employee_phone_numbers;
+
+COMMIT;
@@ -0,0 +1,32 @@
+-- Migration: Employee phone numbers
+-- Phone numbers are kept in a child table like employee_emails, normalized
+-- to E.164 by the service. Unlike emails they are not unique within a
+-- tenant: colleagues share office lines.
+
+BEGIN;
+
+CREATE TABLE employee_phone_numbers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    employee_id UUID NOT NULL,
+    tenant_id VARCHAR(255) NOT NULL,
+    position INTEGER NOT NULL,
+    type VARCHAR(16) NOT NULL,
+    number VARCHAR(16) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_employee_phone_numbers_employee FOREIGN KEY (employee_id)
+        REFERENCES employees(id) ON DELETE CASCADE,
+    CONSTRAINT chk_employee_phone_numbers_type CHECK (type IN ('mobile', 'work', 'home', 'other'))
+);
+
+-- An employee has a number once; also serves lookups by employee
+CREATE UNIQUE INDEX idx_employee_phone_numbers_employee_number ON employee_phone_numbers(employee_id, number);
+
+COMMENT ON TABLE employee_phone_numbers IS 'Employee phone numbers in E.164 format';
+COMMENT ON COLUMN employee_phone_numbers.tenant_id IS 'Denormalized tenant_id for efficient querying';
+COMMENT ON COLUMN employee_phone_numbers.position IS 'Order of the number on the employee';
+COMMENT ON COLUMN employee_phone_numbers.type IS 'mobile, work, home or other';
+
+-- Scheduled changes carry the requested phone numbers; NULL keeps them
+ALTER TABLE employee_scheduled_changes ADD COLUMN phone_numbers JSONB;
+
+COMMIT;
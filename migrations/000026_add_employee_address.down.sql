@@ -0,0 +1,15 @@
+-- Rollback: Drop employee postal address
+
+BEGIN;
+
+ALTER TABLE employee_scheduled_changes DROP COLUMN IF EXISTS address;
+
+ALTER TABLE employees
+    DROP COLUMN IF EXISTS address_line1,
+    DROP COLUMN IF EXISTS address_line2,
+    DROP COLUMN IF EXISTS address_city,
+    DROP COLUMN IF EXISTS address_region,
+    DROP COLUMN IF EXISTS address_postal_code,
+    DROP COLUMN IF EXISTS address_country;
+
+COMMIT;
@@ -0,0 +1,20 @@
+-- Migration: Employee postal address
+-- An employee has at most one address, kept in columns of employees; all
+-- columns are empty when the employee has none.
+
+BEGIN;
+
+ALTER TABLE employees
+    ADD COLUMN address_line1 VARCHAR(200) NOT NULL DEFAULT '',
+    ADD COLUMN address_line2 VARCHAR(200) NOT NULL DEFAULT '',
+    ADD COLUMN address_city VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN address_region VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN address_postal_code VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN address_country VARCHAR(2) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN employees.address_country IS 'ISO 3166-1 alpha-2 country code of the postal address, empty without address';
+
+-- Scheduled changes carry the requested address; NULL keeps it
+ALTER TABLE employee_scheduled_changes ADD COLUMN address JSONB;
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Rollback: Drop employee photos
+
+BEGIN;
+
+ALTER TABLE employees DROP COLUMN IF EXISTS photo_key;
+
+COMMIT;
@@ -0,0 +1,11 @@
+-- Migration: Employee photos
+-- Photos are stored in object storage; employees only keep the object key,
+-- empty when the employee has no photo.
+
+BEGIN;
+
+ALTER TABLE employees ADD COLUMN photo_key VARCHAR(255) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN employees.photo_key IS 'Object key of the employee photo in the photo bucket, empty without photo';
+
+COMMIT;
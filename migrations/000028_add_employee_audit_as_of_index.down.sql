@@ -0,0 +1,8 @@
+-- Rollback: Restore the audit index without seq
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employee_audit_tenant_employee;
+CREATE INDEX idx_employee_audit_tenant_employee ON employee_audit(tenant_id, employee_id, created_at);
+
+COMMIT;
@@ -0,0 +1,13 @@
+-- Migration: Index for point-in-time employee listings
+-- ListEmployeesAsOf reads the latest audit entry of every employee up to a
+-- point in time, ordered by (created_at, seq) per employee; entries written
+-- in one transaction share created_at. The archiver checks for newer entries
+-- of an employee through the same index. It replaces the index on
+-- (tenant_id, employee_id, created_at), which it extends.
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employee_audit_tenant_employee;
+CREATE INDEX idx_employee_audit_tenant_employee ON employee_audit(tenant_id, employee_id, created_at, seq);
+
+COMMIT;
@@ -0,0 +1,9 @@
+-- Rollback: Drop employee external IDs
+
+BEGIN;
+
+ALTER TABLE employee_scheduled_changes DROP COLUMN IF EXISTS external_ids;
+
+DROP TABLE IF EXISTS employee_external_ids;
+
+COMMIT;
@@ -0,0 +1,33 @@
+-- Migration: Employee external IDs
+-- Integrations correlate employees with their records in external systems
+-- such as an HRIS (workday, bamboohr) by the system's ID, kept in a child
+-- table like employee_emails. An ID belongs to at most one employee of a
+-- tenant per system.
+
+BEGIN;
+
+CREATE TABLE employee_external_ids (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    employee_id UUID NOT NULL,
+    tenant_id VARCHAR(255) NOT NULL,
+    system VARCHAR(32) NOT NULL,
+    external_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_employee_external_ids_employee FOREIGN KEY (employee_id)
+        REFERENCES employees(id) ON DELETE CASCADE
+);
+
+-- An ID identifies one employee of the tenant per system; also serves
+-- GetEmployeeByExternalID
+CREATE UNIQUE INDEX idx_employee_external_ids_tenant_system_id ON employee_external_ids(tenant_id, system, external_id);
+-- An employee has one ID per system; also serves lookups by employee
+CREATE UNIQUE INDEX idx_employee_external_ids_employee_system ON employee_external_ids(employee_id, system);
+
+COMMENT ON TABLE employee_external_ids IS 'IDs of employees in external systems such as an HRIS';
+COMMENT ON COLUMN employee_external_ids.tenant_id IS 'Denormalized tenant_id for the per-tenant uniqueness of IDs';
+COMMENT ON COLUMN employee_external_ids.system IS 'Lowercase name of the external system, e.g. workday';
+
+-- Scheduled changes carry the requested external IDs; NULL keeps them
+ALTER TABLE employee_scheduled_changes ADD COLUMN external_ids JSONB;
+
+COMMIT;
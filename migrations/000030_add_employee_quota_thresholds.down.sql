@@ -0,0 +1,7 @@
+-- Rollback: Drop quota thresholds
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_quota_thresholds;
+
+COMMIT;
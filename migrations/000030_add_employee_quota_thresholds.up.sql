@@ -0,0 +1,21 @@
+-- Migration: Quota thresholds
+-- Tenants are warned as they approach their soft quotas. The highest
+-- threshold (80, 90 or 100 percent) each quota reached is kept here, so that
+-- its crossing is published once across instances; it is lowered again when
+-- usage falls.
+
+BEGIN;
+
+CREATE TABLE employee_quota_thresholds (
+    tenant_id VARCHAR(255) NOT NULL,
+    resource VARCHAR(64) NOT NULL,
+    threshold INTEGER NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, resource)
+);
+
+COMMENT ON TABLE employee_quota_thresholds IS 'Highest quota threshold reached per tenant and quota';
+COMMENT ON COLUMN employee_quota_thresholds.resource IS 'Quota the threshold is of, e.g. employees';
+COMMENT ON COLUMN employee_quota_thresholds.threshold IS 'Percentage of the quota reached: 0, 80, 90 or 100';
+
+COMMIT;
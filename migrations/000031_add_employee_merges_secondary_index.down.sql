@@ -0,0 +1,7 @@
+-- Rollback: Drop the index of merges by secondary employee
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employee_merges_tenant_secondary;
+
+COMMIT;
@@ -0,0 +1,10 @@
+-- Migration: Index merges by secondary employee
+-- ResolveMergedEmployee follows the ID of a merged away employee to the
+-- employee it was merged into, looking up the merge by the ID in the
+-- snapshot of the secondary employee. Undone merges are not followed.
+
+BEGIN;
+
+CREATE INDEX idx_employee_merges_tenant_secondary ON employee_merges(tenant_id, (secondary->>'id')) WHERE unmerged_at IS NULL;
+
+COMMIT;
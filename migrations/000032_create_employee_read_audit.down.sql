@@ -0,0 +1,7 @@
+-- Rollback: Drop read audit
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_read_audit;
+
+COMMIT;
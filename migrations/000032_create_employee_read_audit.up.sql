@@ -0,0 +1,24 @@
+-- Migration: Read audit
+-- Reads of single employees are audited apart from changes: they are queued
+-- in memory and written here in batches, and may be sampled or dropped under
+-- pressure, so employee_audit keeps only complete records of changes.
+
+BEGIN;
+
+CREATE TABLE employee_read_audit (
+    id UUID PRIMARY KEY,
+    tenant_id VARCHAR(255) NOT NULL,
+    employee_id UUID NOT NULL,
+    operation VARCHAR(32) NOT NULL,
+    actor_id VARCHAR(255) NOT NULL DEFAULT '',
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+    client_ip VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_employee_read_audit_tenant_employee ON employee_read_audit(tenant_id, employee_id, created_at);
+
+COMMENT ON TABLE employee_read_audit IS 'Sampled reads of employees, written asynchronously';
+COMMENT ON COLUMN employee_read_audit.operation IS 'Read operation: get, get_by_email or get_by_external_id';
+
+COMMIT;
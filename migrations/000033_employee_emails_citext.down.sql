@@ -0,0 +1,9 @@
+-- Rollback: Case-sensitive employee emails
+
+BEGIN;
+
+ALTER TABLE employee_emails ALTER COLUMN email TYPE VARCHAR(255);
+
+COMMENT ON COLUMN employee_emails.email IS 'Email address - unique within tenant';
+
+COMMIT;
@@ -0,0 +1,39 @@
+-- Migration: Case-insensitive employee emails
+-- employee_emails.email becomes citext, so that the unique index on
+-- (tenant_id, email) and every lookup by email ignore case: Foo@X.com can no
+-- longer be created next to foo@x.com. Emails keep the spelling they were
+-- stored with.
+--
+-- Emails that already differ only in case within a tenant would violate the
+-- unique index; the migration stops and lists them. Merge or update those
+-- employees (GET /api/v1/employees/duplicates helps find them) and run it
+-- again.
+
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS citext;
+
+DO $$
+DECLARE
+    conflicts TEXT;
+BEGIN
+    SELECT string_agg(tenant_id || ': ' || lower(email), ', ')
+    INTO conflicts
+    FROM (
+        SELECT tenant_id, lower(email) AS email
+        FROM employee_emails
+        GROUP BY tenant_id, lower(email)
+        HAVING count(*) > 1
+        LIMIT 100
+    ) duplicates;
+
+    IF conflicts IS NOT NULL THEN
+        RAISE EXCEPTION 'employee emails differing only in case must be resolved first: %', conflicts;
+    END IF;
+END $$;
+
+ALTER TABLE employee_emails ALTER COLUMN email TYPE CITEXT;
+
+COMMENT ON COLUMN employee_emails.email IS 'Email address - unique within tenant, ignoring case';
+
+COMMIT;
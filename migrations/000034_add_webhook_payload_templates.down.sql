@@ -0,0 +1,8 @@
+-- Rollback: Remove webhook payload templates
+-- Webhooks go back to receiving events as is.
+
+BEGIN;
+
+ALTER TABLE webhooks DROP COLUMN IF EXISTS payload_template;
+
+COMMIT;
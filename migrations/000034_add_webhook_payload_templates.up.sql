@@ -0,0 +1,12 @@
+-- Migration: Webhook payload templates
+-- A webhook with a payload template receives the event rendered through it
+-- instead of the event itself. The delivery log keeps the event as is.
+
+BEGIN;
+
+ALTER TABLE webhooks
+    ADD COLUMN payload_template TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN webhooks.payload_template IS 'Go template rendering delivery bodies from events, empty to send events as is';
+
+COMMIT;
@@ -0,0 +1,9 @@
+-- Rollback: Remove primary employee emails
+-- Emails go back to being an unordered list.
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employee_emails_primary;
+ALTER TABLE employee_emails DROP COLUMN IF EXISTS is_primary;
+
+COMMIT;
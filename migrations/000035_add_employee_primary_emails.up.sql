@@ -0,0 +1,21 @@
+-- Migration: Primary employee emails
+-- One email of each employee is its primary email. Existing employees get
+-- their earliest email as primary.
+
+BEGIN;
+
+ALTER TABLE employee_emails
+    ADD COLUMN is_primary BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE employee_emails SET is_primary = true
+WHERE id IN (
+    SELECT DISTINCT ON (employee_id) id
+    FROM employee_emails
+    ORDER BY employee_id, created_at, id
+);
+
+CREATE UNIQUE INDEX idx_employee_emails_primary ON employee_emails(employee_id) WHERE is_primary;
+
+COMMENT ON COLUMN employee_emails.is_primary IS 'Whether this is the primary email of the employee, at most one per employee';
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Rollback: Drop merge approvals
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_merge_approvals;
+
+COMMIT;
@@ -0,0 +1,30 @@
+-- Migration: Merge approvals
+-- Merges of employees with large histories are held here until a second user
+-- approves them; the merge is performed in the same transaction as the
+-- approval.
+
+BEGIN;
+
+CREATE TABLE employee_merge_approvals (
+    id UUID PRIMARY KEY,
+    tenant_id VARCHAR(255) NOT NULL,
+    primary_id UUID NOT NULL,
+    secondary_id UUID NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    reasons JSONB NOT NULL DEFAULT '[]',
+    history_entries BIGINT NOT NULL DEFAULT 0,
+    emails INTEGER NOT NULL DEFAULT 0,
+    requested_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    decided_by VARCHAR(255) NOT NULL DEFAULT '',
+    decided_at TIMESTAMP,
+    merge_id UUID,
+    error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_employee_merge_approvals_tenant_status ON employee_merge_approvals(tenant_id, status, created_at);
+
+COMMENT ON TABLE employee_merge_approvals IS 'Merges held for the approval of a second user';
+COMMENT ON COLUMN employee_merge_approvals.merge_id IS 'Merge performed on approval, for unmerging';
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Rollback: Drop tenant settings
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_tenant_settings;
+
+COMMIT;
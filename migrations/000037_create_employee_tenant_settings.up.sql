@@ -0,0 +1,17 @@
+-- Migration: Tenant settings
+-- Settings each tenant manages itself through the admin API, one row per
+-- tenant. Tenants without a row use the defaults.
+
+BEGIN;
+
+CREATE TABLE employee_tenant_settings (
+    tenant_id VARCHAR(255) PRIMARY KEY,
+    allowed_email_domains JSONB NOT NULL DEFAULT '[]',
+    updated_by VARCHAR(255) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE employee_tenant_settings IS 'Settings managed by each tenant';
+COMMENT ON COLUMN employee_tenant_settings.allowed_email_domains IS 'Domains employee emails must be of, empty for any; *.example.com allows subdomains';
+
+COMMIT;
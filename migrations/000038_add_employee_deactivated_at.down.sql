@@ -0,0 +1,9 @@
+-- Rollback: Drop employee deactivation
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employees_tenant_active;
+
+ALTER TABLE employees DROP COLUMN IF EXISTS deactivated_at;
+
+COMMIT;
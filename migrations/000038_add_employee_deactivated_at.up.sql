@@ -0,0 +1,13 @@
+-- Migration: Employee deactivation
+-- Deactivated employees are kept with their history instead of being deleted
+-- and are left out of listings by default, which the partial index serves.
+
+BEGIN;
+
+ALTER TABLE employees ADD COLUMN deactivated_at TIMESTAMP;
+
+CREATE INDEX idx_employees_tenant_active ON employees (tenant_id) WHERE deactivated_at IS NULL;
+
+COMMENT ON COLUMN employees.deactivated_at IS 'When the employee was deactivated, NULL while active';
+
+COMMIT;
@@ -0,0 +1,9 @@
+-- Rollback: Drop stale employees
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employees_stale_candidates;
+
+ALTER TABLE employees DROP COLUMN IF EXISTS stale_at;
+
+COMMIT;
@@ -0,0 +1,14 @@
+-- Migration: Stale employees
+-- The stale-record job flags active employees untouched for a configured
+-- time. The flag lapses once the employee changes (updated_at > stale_at).
+
+BEGIN;
+
+ALTER TABLE employees ADD COLUMN stale_at TIMESTAMP;
+
+-- The job scans all tenants for the employees untouched the longest
+CREATE INDEX idx_employees_stale_candidates ON employees (updated_at) WHERE deactivated_at IS NULL;
+
+COMMENT ON COLUMN employees.stale_at IS 'When the stale-record job last flagged the employee; stale while not older than updated_at';
+
+COMMIT;
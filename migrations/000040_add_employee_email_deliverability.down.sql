@@ -0,0 +1,9 @@
+-- Rollback: Drop undeliverable employee emails
+
+BEGIN;
+
+ALTER TABLE employee_emails
+    DROP COLUMN IF EXISTS undeliverable_at,
+    DROP COLUMN IF EXISTS undeliverable_reason;
+
+COMMIT;
@@ -0,0 +1,14 @@
+-- Migration: Undeliverable employee emails
+-- Emails the mail provider reported as bounced or complained about are
+-- marked undeliverable. Updates keep the mark of the emails they keep.
+
+BEGIN;
+
+ALTER TABLE employee_emails
+    ADD COLUMN undeliverable_reason VARCHAR(16) NOT NULL DEFAULT '',
+    ADD COLUMN undeliverable_at TIMESTAMP;
+
+COMMENT ON COLUMN employee_emails.undeliverable_reason IS 'bounce or complaint; empty while deliverable';
+COMMENT ON COLUMN employee_emails.undeliverable_at IS 'When the first bounce or complaint of the email occurred';
+
+COMMIT;
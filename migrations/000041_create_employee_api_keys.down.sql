@@ -0,0 +1,7 @@
+-- Rollback: Drop API keys
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_api_keys;
+
+COMMIT;
@@ -0,0 +1,26 @@
+-- Migration: API keys
+-- Backend services of a tenant authenticate with an X-API-Key header instead
+-- of a JWT. Only the SHA-256 hash of a key is stored.
+
+BEGIN;
+
+CREATE TABLE employee_api_keys (
+    id UUID PRIMARY KEY,
+    tenant_id VARCHAR(255) NOT NULL,
+    service VARCHAR(100) NOT NULL,
+    roles JSONB NOT NULL DEFAULT '[]',
+    prefix VARCHAR(16) NOT NULL,
+    key_hash VARCHAR(64) NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_employee_api_keys_key_hash ON employee_api_keys(key_hash);
+CREATE INDEX idx_employee_api_keys_tenant_created ON employee_api_keys(tenant_id, created_at);
+
+COMMENT ON TABLE employee_api_keys IS 'API keys of backend services, looked up by hash on every request';
+COMMENT ON COLUMN employee_api_keys.prefix IS 'Start of the key, to tell keys apart';
+COMMENT ON COLUMN employee_api_keys.key_hash IS 'Hex SHA-256 of the key';
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Rollback: Drop token revocations
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_token_revocations;
+
+COMMIT;
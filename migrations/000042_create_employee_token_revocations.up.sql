@@ -0,0 +1,26 @@
+-- Migration: Token revocations
+-- JWTs are rejected before they expire when their jti, or all tokens of
+-- their subject issued up to a time, are revoked. A row revokes either one
+-- token (jti) or the tokens of one user (user_id).
+
+BEGIN;
+
+CREATE TABLE employee_token_revocations (
+    id UUID PRIMARY KEY,
+    tenant_id VARCHAR(255) NOT NULL,
+    jti VARCHAR(255),
+    user_id VARCHAR(255),
+    revoked_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP,
+    revoked_by VARCHAR(255) NOT NULL,
+    CHECK ((jti IS NULL) <> (user_id IS NULL))
+);
+
+CREATE UNIQUE INDEX idx_employee_token_revocations_jti ON employee_token_revocations(tenant_id, jti) WHERE jti IS NOT NULL;
+CREATE UNIQUE INDEX idx_employee_token_revocations_user ON employee_token_revocations(tenant_id, user_id) WHERE user_id IS NOT NULL;
+
+COMMENT ON TABLE employee_token_revocations IS 'Revoked JWTs, checked on every request authenticated with a token';
+COMMENT ON COLUMN employee_token_revocations.revoked_at IS 'For user_id, tokens issued (iat) up to this time are revoked';
+COMMENT ON COLUMN employee_token_revocations.expires_at IS 'Expiry of the token revoked by jti, after which the row is deleted';
+
+COMMIT;
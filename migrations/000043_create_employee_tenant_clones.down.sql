@@ -0,0 +1,7 @@
+-- Rollback: Drop tenant clones
+
+BEGIN;
+
+DROP TABLE IF EXISTS employee_tenant_clones;
+
+COMMIT;
@@ -0,0 +1,35 @@
+-- Migration: Tenant clones
+-- CloneTenant copies a tenant into a sandbox tenant in the background. The
+-- clone runs in phases, structure, employees and managers, the employee
+-- phases resuming after the employee at cursor. Workers lease a clone with
+-- locked_until while running a step of it.
+
+BEGIN;
+
+CREATE TABLE employee_tenant_clones (
+    id UUID PRIMARY KEY,
+    tenant_id VARCHAR(255) NOT NULL,
+    target_tenant_id VARCHAR(255) NOT NULL,
+    anonymize BOOLEAN NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    phase VARCHAR(16) NOT NULL DEFAULT 'structure',
+    cursor_created_at TIMESTAMP,
+    cursor_id UUID,
+    copied_employees INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    created_by VARCHAR(255) NOT NULL,
+    locked_until TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+CREATE INDEX idx_employee_tenant_clones_tenant ON employee_tenant_clones(tenant_id, created_at);
+CREATE UNIQUE INDEX idx_employee_tenant_clones_active_target ON employee_tenant_clones(target_tenant_id)
+    WHERE status IN ('pending', 'running');
+
+COMMENT ON TABLE employee_tenant_clones IS 'Copies of tenants into sandbox tenants, run by the clone worker';
+COMMENT ON COLUMN employee_tenant_clones.tenant_id IS 'Tenant copied, which started the clone';
+COMMENT ON COLUMN employee_tenant_clones.cursor_created_at IS 'Position of the last employee copied in the current phase';
+
+COMMIT;
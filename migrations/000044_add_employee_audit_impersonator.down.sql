@@ -0,0 +1,9 @@
+-- Rollback: Drop the impersonator of audited changes
+
+BEGIN;
+
+ALTER TABLE employee_audit
+    DROP COLUMN IF EXISTS impersonator_id,
+    DROP COLUMN IF EXISTS impersonator_tenant_id;
+
+COMMIT;
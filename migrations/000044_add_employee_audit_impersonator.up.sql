@@ -0,0 +1,14 @@
+-- Migration: Impersonator of audited changes
+-- Admins acting as another user or tenant with X-Act-As-User and
+-- X-Act-As-Tenant are recorded alongside the actor they act as.
+
+BEGIN;
+
+ALTER TABLE employee_audit
+    ADD COLUMN impersonator_id VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN impersonator_tenant_id VARCHAR(255) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN employee_audit.impersonator_id IS 'User ID of the admin acting as actor_id; empty unless impersonated';
+COMMENT ON COLUMN employee_audit.impersonator_tenant_id IS 'Tenant of the admin acting as actor_id; empty unless impersonated';
+
+COMMIT;
@@ -0,0 +1,9 @@
+-- Rollback: Drop the signing secrets of API keys
+
+BEGIN;
+
+ALTER TABLE employee_api_keys
+    DROP COLUMN IF EXISTS require_signature,
+    DROP COLUMN IF EXISTS signing_secret;
+
+COMMIT;
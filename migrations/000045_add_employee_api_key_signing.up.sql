@@ -0,0 +1,15 @@
+-- Migration: signed API key requests
+-- Every new API key gets a secret signing its requests in X-Signature, so
+-- that third-party callers' requests cannot be replayed. Keys created
+-- before have no secret and cannot sign.
+
+BEGIN;
+
+ALTER TABLE employee_api_keys
+    ADD COLUMN signing_secret VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN require_signature BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN employee_api_keys.signing_secret IS 'HMAC-SHA256 secret of signed requests, empty for keys created before signing';
+COMMENT ON COLUMN employee_api_keys.require_signature IS 'Whether requests made with the key must be signed';
+
+COMMIT;
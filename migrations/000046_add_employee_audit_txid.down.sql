@@ -0,0 +1,9 @@
+-- Rollback: Drop the transaction IDs of audit entries
+
+BEGIN;
+
+DROP INDEX IF EXISTS idx_employee_audit_position;
+DROP INDEX IF EXISTS idx_employee_audit_tenant_position;
+ALTER TABLE employee_audit DROP COLUMN IF EXISTS txid;
+
+COMMIT;
@@ -0,0 +1,21 @@
+-- Migration: Commit order of the audit log
+-- seq is taken when an entry is inserted, so a transaction can commit an
+-- entry after a later transaction committed a higher seq. Watches and the
+-- webhook fan-out order entries by the ID of the transaction that recorded
+-- them, then seq, and only read entries of transactions older than every
+-- transaction still in progress, so an entry is never ordered before one
+-- committed earlier. Entries recorded before the migration have no txid and
+-- come first.
+
+BEGIN;
+
+ALTER TABLE employee_audit ADD COLUMN txid BIGINT;
+-- Set apart from the column so that existing entries stay NULL
+ALTER TABLE employee_audit ALTER COLUMN txid SET DEFAULT (pg_current_xact_id()::text::bigint);
+
+CREATE INDEX idx_employee_audit_tenant_position ON employee_audit(tenant_id, (COALESCE(txid, 0)), seq);
+CREATE INDEX idx_employee_audit_position ON employee_audit((COALESCE(txid, 0)), seq);
+
+COMMENT ON COLUMN employee_audit.txid IS 'ID of the transaction that recorded the entry, NULL before 000046 and on CockroachDB';
+
+COMMIT;
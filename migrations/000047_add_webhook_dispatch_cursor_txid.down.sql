@@ -0,0 +1,9 @@
+-- Rollback: Drop the transaction ID of the webhook dispatch cursor
+
+BEGIN;
+
+ALTER TABLE webhook_dispatch_cursor DROP COLUMN IF EXISTS txid;
+
+COMMENT ON TABLE webhook_dispatch_cursor IS 'Single row holding the last employee_audit seq fanned out to webhooks';
+
+COMMIT;
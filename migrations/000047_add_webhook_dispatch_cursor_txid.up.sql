@@ -0,0 +1,14 @@
+-- Migration: Commit-ordered webhook dispatch cursor
+-- The fan-out follows employee_audit by position, the transaction ID and seq
+-- of an entry (see 000046), like employee watches. The cursor resumes after
+-- the entries recorded before 000046, which have no transaction ID; entries
+-- recorded since and already fanned out by seq are fanned out again, which
+-- queues no duplicate delivery.
+
+BEGIN;
+
+ALTER TABLE webhook_dispatch_cursor ADD COLUMN txid BIGINT NOT NULL DEFAULT 0;
+
+COMMENT ON TABLE webhook_dispatch_cursor IS 'Single row holding the position (txid, seq) of the last employee_audit entry fanned out to webhooks';
+
+COMMIT;
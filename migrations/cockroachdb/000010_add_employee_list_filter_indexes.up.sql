@@ -0,0 +1,16 @@
+-- Migration: Indexes for the ListEmployees name and email filters (CockroachDB)
+-- CockroachDB has neither operator classes such as text_pattern_ops nor the
+-- pg_trgm extension. Its indexes compare strings bytewise, so plain indexes
+-- on the lowercased names serve LIKE 'prefix%', and trigram indexes are
+-- built in.
+
+BEGIN;
+
+CREATE INDEX idx_employees_tenant_first_name_prefix ON employees(tenant_id, lower(first_name));
+CREATE INDEX idx_employees_tenant_last_name_prefix ON employees(tenant_id, lower(last_name));
+CREATE INDEX idx_employees_tenant_full_name_prefix ON employees(tenant_id, lower(first_name || ' ' || last_name));
+
+CREATE INDEX idx_employee_emails_tenant_domain ON employee_emails(tenant_id, lower(split_part(email, '@', 2)));
+CREATE INDEX idx_employee_emails_email_trgm ON employee_emails USING GIN (lower(email) gin_trgm_ops);
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Rollback: Case-sensitive employee emails (CockroachDB)
+
+SET enable_experimental_alter_column_type_general = true;
+
+ALTER TABLE employee_emails ALTER COLUMN email TYPE VARCHAR(255);
+
+COMMENT ON COLUMN employee_emails.email IS 'Email address - unique within tenant';
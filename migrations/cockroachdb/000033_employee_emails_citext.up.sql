@@ -0,0 +1,12 @@
+-- Migration: Case-insensitive employee emails (CockroachDB)
+-- CITEXT is built into CockroachDB (v24.3 and later), which has no
+-- extensions. Changing the type of a column is experimental and cannot run
+-- in an explicit transaction. Emails differing only in case within a tenant
+-- make the unique index on (tenant_id, email) fail to build, which aborts the
+-- change; resolve them first as described in the Postgres migration.
+
+SET enable_experimental_alter_column_type_general = true;
+
+ALTER TABLE employee_emails ALTER COLUMN email TYPE CITEXT;
+
+COMMENT ON COLUMN employee_emails.email IS 'Email address - unique within tenant, ignoring case';
@@ -0,0 +1,12 @@
+-- Migration: Commit order of the audit log (CockroachDB)
+-- CockroachDB has no transaction IDs to order entries by, so txid stays NULL
+-- and entries are ordered by seq, held back until they have settled (see
+-- data.database.audit_settle). The column and indexes keep the queries the
+-- same as on Postgres.
+
+ALTER TABLE employee_audit ADD COLUMN txid BIGINT;
+
+CREATE INDEX idx_employee_audit_tenant_position ON employee_audit(tenant_id, (COALESCE(txid, 0)), seq);
+CREATE INDEX idx_employee_audit_position ON employee_audit((COALESCE(txid, 0)), seq);
+
+COMMENT ON COLUMN employee_audit.txid IS 'ID of the transaction that recorded the entry, NULL before 000046 and on CockroachDB';
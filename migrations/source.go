@@ -0,0 +1,74 @@
+// Package migrations holds the SQL migrations of the service. They are
+// written for Postgres; a database driver whose SQL differs has its own
+// variant of some migrations in a directory named after it, e.g.
+// cockroachdb/000010_add_employee_list_filter_indexes.up.sql, replacing the
+// migration of the same version and direction.
+package migrations
+
+import (
+	"embed"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+// FS holds the migrations of every driver
+//
+//go:embed *.sql cockroachdb/*.sql
+var FS embed.FS
+
+// NewSource returns the migrations of driver found in fsys, laid out like FS:
+// the shared migrations, with those the driver has a variant of replaced.
+func NewSource(fsys fs.FS, driver string) (source.Driver, error) {
+	base, err := iofs.New(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fs.Stat(fsys, driver); err != nil {
+		return base, nil
+	}
+	variants, err := iofs.New(fsys, driver)
+	if err != nil {
+		_ = base.Close()
+		return nil, err
+	}
+	return &overlaySource{Driver: base, variants: variants}, nil
+}
+
+// overlaySource serves the migrations of its Driver, replaced by their
+// variants where there is one. Variants only replace migrations: the versions
+// are those of the shared migrations.
+type overlaySource struct {
+	source.Driver
+	variants source.Driver
+}
+
+// Open implements source.Driver; sources are created with NewSource
+func (s *overlaySource) Open(string) (source.Driver, error) {
+	return nil, errors.New("migrations: open the source with NewSource")
+}
+
+// Close implements source.Driver
+func (s *overlaySource) Close() error {
+	return errors.Join(s.Driver.Close(), s.variants.Close())
+}
+
+// ReadUp implements source.Driver
+func (s *overlaySource) ReadUp(version uint) (io.ReadCloser, string, error) {
+	if r, identifier, err := s.variants.ReadUp(version); !errors.Is(err, os.ErrNotExist) {
+		return r, identifier, err
+	}
+	return s.Driver.ReadUp(version)
+}
+
+// ReadDown implements source.Driver
+func (s *overlaySource) ReadDown(version uint) (io.ReadCloser, string, error) {
+	if r, identifier, err := s.variants.ReadDown(version); !errors.Is(err, os.ErrNotExist) {
+		return r, identifier, err
+	}
+	return s.Driver.ReadDown(version)
+}
@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"testing"
+	"testing/fstest"
+
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readUp(t *testing.T, src source.Driver, version uint) string {
+	r, _, err := src.ReadUp(version)
+	require.NoError(t, err)
+	defer r.Close()
+	body, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestNewSource(t *testing.T) {
+	fsys := fstest.MapFS{
+		"000001_create.up.sql":              {Data: []byte("CREATE")},
+		"000001_create.down.sql":            {Data: []byte("DROP")},
+		"000002_index.up.sql":               {Data: []byte("CREATE INDEX USING text_pattern_ops")},
+		"000002_index.down.sql":             {Data: []byte("DROP INDEX")},
+		"cockroachdb/000002_index.up.sql":   {Data: []byte("CREATE INDEX")},
+		"cockroachdb/000002_index.down.sql": {Data: []byte("DROP INDEX")},
+	}
+
+	postgres, err := NewSource(fsys, "postgres")
+	require.NoError(t, err)
+	assert.Equal(t, "CREATE INDEX USING text_pattern_ops", readUp(t, postgres, 2))
+
+	cockroach, err := NewSource(fsys, "cockroachdb")
+	require.NoError(t, err)
+	defer cockroach.Close()
+	assert.Equal(t, "CREATE", readUp(t, cockroach, 1))
+	assert.Equal(t, "CREATE INDEX", readUp(t, cockroach, 2))
+
+	next, err := cockroach.Next(1)
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), next)
+	_, err = cockroach.Next(2)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// TestVariants checks that every migration variant replaces a shared
+// migration
+func TestVariants(t *testing.T) {
+	shared := map[uint]map[source.Direction]bool{}
+	entries, err := fs.ReadDir(FS, ".")
+	require.NoError(t, err)
+	for _, e := range entries {
+		if m, err := source.DefaultParse(e.Name()); err == nil {
+			if shared[m.Version] == nil {
+				shared[m.Version] = map[source.Direction]bool{}
+			}
+			shared[m.Version][m.Direction] = true
+		}
+	}
+
+	variants, err := fs.Glob(FS, "*/*.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, variants)
+	for _, name := range variants {
+		m, err := source.DefaultParse(path.Base(name))
+		require.NoError(t, err, name)
+		assert.True(t, shared[m.Version][m.Direction], "%s has no shared migration", name)
+	}
+}
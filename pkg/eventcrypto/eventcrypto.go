@@ -0,0 +1,172 @@
+// Package eventcrypto implements the envelope used for encrypted employee
+// events and the verification of event signatures (see Verifier). The
+// payload is sealed with AES-256-GCM; the key ID is carried in the
+// Employee-Event-Key-Id message header so that consumers can pick the
+// matching key from a Keyring, and the subject is bound as additional data so
+// that a payload cannot be replayed on another subject.
+//
+// Consumers decrypt a message before unmarshaling it:
+//
+//	keys := eventcrypto.NewKeyring()
+//	if err := keys.AddBase64("tenant-a-2026", os.Getenv("EVENT_KEY")); err != nil {
+//		return err
+//	}
+//	data, err := keys.Decrypt(msg)
+//	if err != nil {
+//		return err
+//	}
+//	var event eventsv1.EmployeeCreatedEvent
+//	err = proto.Unmarshal(data, &event)
+package eventcrypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	// HeaderKeyID names the key an event payload is encrypted with
+	HeaderKeyID = "Employee-Event-Key-Id"
+	// HeaderEncryption names the encryption algorithm of an event payload
+	HeaderEncryption = "Employee-Event-Encryption"
+	// AlgorithmAES256GCM is the only supported encryption algorithm
+	AlgorithmAES256GCM = "AES-256-GCM"
+
+	// KeySize is the size of an encryption key in bytes
+	KeySize = 32
+	// Overhead is the number of bytes encryption adds to a payload
+	Overhead = 12 + 16
+)
+
+var (
+	// ErrUnknownKey is returned when a payload is encrypted with a key that
+	// is not in the keyring
+	ErrUnknownKey = errors.New("eventcrypto: unknown key")
+	// ErrUnsupportedAlgorithm is returned for payloads encrypted with an
+	// algorithm other than AES-256-GCM
+	ErrUnsupportedAlgorithm = errors.New("eventcrypto: unsupported algorithm")
+	// ErrMalformed is returned when a payload cannot be decrypted
+	ErrMalformed = errors.New("eventcrypto: malformed or tampered payload")
+)
+
+// Keyring holds encryption keys by ID. It is safe for concurrent use once
+// all keys have been added.
+type Keyring struct {
+	keys map[string]cipher.AEAD
+}
+
+// NewKeyring creates an empty keyring.
+func NewKeyring() *Keyring {
+	return &Keyring{keys: make(map[string]cipher.AEAD)}
+}
+
+// Add adds a 32-byte key under id.
+func (k *Keyring) Add(id string, key []byte) error {
+	if id == "" {
+		return errors.New("eventcrypto: key ID is empty")
+	}
+	if len(key) != KeySize {
+		return fmt.Errorf("eventcrypto: key %s must be %d bytes, got %d", id, KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+	k.keys[id] = aead
+	return nil
+}
+
+// AddBase64 adds a standard base64 encoded 32-byte key under id.
+func (k *Keyring) AddBase64(id, key string) error {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return fmt.Errorf("eventcrypto: key %s is not valid base64: %w", id, err)
+	}
+	return k.Add(id, raw)
+}
+
+// ParseKeyring builds a keyring from a comma separated list of
+// id=base64key pairs, as consumers take them from EVENT_ENCRYPTION_KEYS.
+func ParseKeyring(s string) (*Keyring, error) {
+	k := NewKeyring()
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		id, key, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("eventcrypto: invalid key %q, expected id=base64key", pair)
+		}
+		if err := k.AddBase64(id, key); err != nil {
+			return nil, err
+		}
+	}
+	return k, nil
+}
+
+// Has reports whether the keyring holds a key with the given ID.
+func (k *Keyring) Has(id string) bool {
+	_, ok := k.keys[id]
+	return ok
+}
+
+// Seal encrypts payload for subject with the key id, returning the nonce
+// followed by the ciphertext.
+func (k *Keyring) Seal(id, subject string, payload []byte) ([]byte, error) {
+	aead, ok := k.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownKey, id)
+	}
+
+	out := make([]byte, aead.NonceSize(), aead.NonceSize()+len(payload)+aead.Overhead())
+	if _, err := rand.Read(out); err != nil {
+		return nil, err
+	}
+	return aead.Seal(out, out, payload, []byte(subject)), nil
+}
+
+// Open decrypts a payload produced by Seal for the same key and subject.
+func (k *Keyring) Open(id, subject string, sealed []byte) ([]byte, error) {
+	aead, ok := k.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownKey, id)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrMalformed
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	payload, err := aead.Open(nil, nonce, ciphertext, []byte(subject))
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	return payload, nil
+}
+
+// Encrypted reports whether msg carries an encrypted payload.
+func Encrypted(msg *nats.Msg) bool {
+	return msg.Header.Get(HeaderEncryption) != ""
+}
+
+// Decrypt returns the protobuf payload of msg, decrypting it when the
+// message is encrypted. Plaintext messages are returned as is.
+func (k *Keyring) Decrypt(msg *nats.Msg) ([]byte, error) {
+	if !Encrypted(msg) {
+		return msg.Data, nil
+	}
+	if alg := msg.Header.Get(HeaderEncryption); alg != AlgorithmAES256GCM {
+		return nil, fmt.Errorf("%w %s", ErrUnsupportedAlgorithm, alg)
+	}
+	return k.Open(msg.Header.Get(HeaderKeyID), msg.Subject, msg.Data)
+}
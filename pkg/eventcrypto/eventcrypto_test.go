@@ -0,0 +1,83 @@
+package eventcrypto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = bytes.Repeat([]byte{7}, KeySize)
+
+func TestSealOpen(t *testing.T) {
+	keys := NewKeyring()
+	require.NoError(t, keys.Add("k1", testKey))
+
+	sealed, err := keys.Seal("k1", "employees.v1.created", []byte("payload"))
+	require.NoError(t, err)
+	assert.Len(t, sealed, len("payload")+Overhead)
+
+	payload, err := keys.Open("k1", "employees.v1.created", sealed)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("payload"), payload)
+
+	// The subject is authenticated
+	_, err = keys.Open("k1", "employees.v1.deleted", sealed)
+	assert.ErrorIs(t, err, ErrMalformed)
+
+	_, err = keys.Open("k2", "employees.v1.created", sealed)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestAddBase64(t *testing.T) {
+	keys := NewKeyring()
+
+	assert.NoError(t, keys.AddBase64("k1", base64.StdEncoding.EncodeToString(testKey)))
+	assert.True(t, keys.Has("k1"))
+	assert.ErrorContains(t, keys.AddBase64("k2", base64.StdEncoding.EncodeToString([]byte("short"))), "must be 32 bytes")
+	assert.ErrorContains(t, keys.AddBase64("k3", "not base64!"), "not valid base64")
+	assert.Error(t, keys.Add("", testKey))
+}
+
+func TestParseKeyring(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString(testKey)
+
+	keys, err := ParseKeyring("k1=" + key + ", k2=" + key + ",")
+	require.NoError(t, err)
+	assert.True(t, keys.Has("k1"))
+	assert.True(t, keys.Has("k2"))
+
+	_, err = ParseKeyring("k1")
+	assert.ErrorContains(t, err, "expected id=base64key")
+	_, err = ParseKeyring("k1=short")
+	assert.Error(t, err)
+}
+
+func TestDecrypt(t *testing.T) {
+	keys := NewKeyring()
+	require.NoError(t, keys.Add("k1", testKey))
+
+	sealed, err := keys.Seal("k1", "employees.v1.updated", []byte("payload"))
+	require.NoError(t, err)
+
+	msg := &nats.Msg{Subject: "employees.v1.updated", Data: sealed, Header: nats.Header{}}
+	msg.Header.Set(HeaderEncryption, AlgorithmAES256GCM)
+	msg.Header.Set(HeaderKeyID, "k1")
+
+	payload, err := keys.Decrypt(msg)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("payload"), payload)
+
+	msg.Header.Set(HeaderEncryption, "ROT13")
+	_, err = keys.Decrypt(msg)
+	assert.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+
+	// Cleartext events are passed through
+	plain := &nats.Msg{Subject: "employees.v1.updated", Data: []byte("payload")}
+	payload, err = keys.Decrypt(plain)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("payload"), payload)
+}
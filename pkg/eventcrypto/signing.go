@@ -0,0 +1,204 @@
+package eventcrypto
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Events are signed with Ed25519 over the subject, a newline and the payload
+// as sent, i.e. after encryption, so signatures can be verified without the
+// decryption keys. The signature and the ID of the signing key travel in
+// message headers; public keys are published as a JSON Web Key Set.
+//
+// Consumers verify a message before decrypting it:
+//
+//	keys, err := eventcrypto.FetchVerifier(ctx, http.DefaultClient,
+//		"https://employees.example.com/.well-known/event-signing-keys")
+//	if err != nil {
+//		return err
+//	}
+//	if err := keys.Verify(msg); err != nil {
+//		return err
+//	}
+
+const (
+	// HeaderSignature carries the base64 encoded Ed25519 signature
+	HeaderSignature = "Employee-Event-Signature"
+	// HeaderSignatureKeyID names the key the event is signed with
+	HeaderSignatureKeyID = "Employee-Event-Signature-Key-Id"
+)
+
+var (
+	// ErrUnsigned is returned when verifying a message without a signature
+	ErrUnsigned = errors.New("eventcrypto: event is not signed")
+	// ErrBadSignature is returned when a signature does not match the event
+	ErrBadSignature = errors.New("eventcrypto: invalid signature")
+)
+
+// signedData is the input of an event signature
+func signedData(subject string, payload []byte) []byte {
+	data := make([]byte, 0, len(subject)+1+len(payload))
+	data = append(data, subject...)
+	data = append(data, '\n')
+	return append(data, payload...)
+}
+
+// Signer signs events with an Ed25519 key.
+type Signer struct {
+	id  string
+	key ed25519.PrivateKey
+}
+
+// NewSigner creates a signer from a 32-byte Ed25519 seed.
+func NewSigner(id string, seed []byte) (*Signer, error) {
+	if id == "" {
+		return nil, errors.New("eventcrypto: signing key ID is empty")
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("eventcrypto: signing key %s must be a %d-byte seed, got %d", id, ed25519.SeedSize, len(seed))
+	}
+	return &Signer{id: id, key: ed25519.NewKeyFromSeed(seed)}, nil
+}
+
+// KeyID returns the ID of the signing key.
+func (s *Signer) KeyID() string {
+	return s.id
+}
+
+// PublicKey returns the public half of the signing key.
+func (s *Signer) PublicKey() ed25519.PublicKey {
+	return s.key.Public().(ed25519.PublicKey)
+}
+
+// Sign sets the signature headers of msg. The payload must not change
+// afterwards.
+func (s *Signer) Sign(msg *nats.Msg) {
+	if msg.Header == nil {
+		msg.Header = nats.Header{}
+	}
+	sig := ed25519.Sign(s.key, signedData(msg.Subject, msg.Data))
+	msg.Header.Set(HeaderSignature, base64.StdEncoding.EncodeToString(sig))
+	msg.Header.Set(HeaderSignatureKeyID, s.id)
+}
+
+// JWK is an Ed25519 public key in JSON Web Key form (RFC 8037).
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	X   string `json:"x"`
+}
+
+// JWKS is a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// PublicJWK returns the JSON Web Key of an Ed25519 public key.
+func PublicJWK(id string, key ed25519.PublicKey) JWK {
+	return JWK{
+		Kty: "OKP",
+		Crv: "Ed25519",
+		Kid: id,
+		Use: "sig",
+		Alg: "EdDSA",
+		X:   base64.RawURLEncoding.EncodeToString(key),
+	}
+}
+
+// Verifier checks event signatures against a set of public keys. It is safe
+// for concurrent use once all keys have been added.
+type Verifier struct {
+	keys map[string]ed25519.PublicKey
+}
+
+// NewVerifier creates a verifier without keys.
+func NewVerifier() *Verifier {
+	return &Verifier{keys: make(map[string]ed25519.PublicKey)}
+}
+
+// Add adds a public key under id.
+func (v *Verifier) Add(id string, key ed25519.PublicKey) error {
+	if id == "" {
+		return errors.New("eventcrypto: key ID is empty")
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("eventcrypto: public key %s must be %d bytes, got %d", id, ed25519.PublicKeySize, len(key))
+	}
+	v.keys[id] = key
+	return nil
+}
+
+// AddJWKS adds the Ed25519 keys of a key set, skipping other key types.
+func (v *Verifier) AddJWKS(set *JWKS) error {
+	for _, k := range set.Keys {
+		if k.Kty != "OKP" || k.Crv != "Ed25519" {
+			continue
+		}
+		key, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return fmt.Errorf("eventcrypto: public key %s is not valid base64url: %w", k.Kid, err)
+		}
+		if err := v.Add(k.Kid, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Verify checks the signature of msg.
+func (v *Verifier) Verify(msg *nats.Msg) error {
+	encoded := msg.Header.Get(HeaderSignature)
+	if encoded == "" {
+		return ErrUnsigned
+	}
+
+	id := msg.Header.Get(HeaderSignatureKeyID)
+	key, ok := v.keys[id]
+	if !ok {
+		return fmt.Errorf("%w %s", ErrUnknownKey, id)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || !ed25519.Verify(key, signedData(msg.Subject, msg.Data), sig) {
+		return ErrBadSignature
+	}
+	return nil
+}
+
+// FetchVerifier loads the published signing keys from url.
+func FetchVerifier(ctx context.Context, client *http.Client, url string) (*Verifier, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("eventcrypto: fetching signing keys: unexpected status %d", resp.StatusCode)
+	}
+
+	var set JWKS
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("eventcrypto: decoding signing keys: %w", err)
+	}
+
+	v := NewVerifier()
+	if err := v.AddJWKS(&set); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,78 @@
+package eventcrypto
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSigner(t *testing.T, id string) *Signer {
+	signer, err := NewSigner(id, bytes.Repeat([]byte{9}, 32))
+	require.NoError(t, err)
+	return signer
+}
+
+func TestSignVerify(t *testing.T) {
+	signer := newTestSigner(t, "sig-1")
+	verifier := NewVerifier()
+	require.NoError(t, verifier.Add("sig-1", signer.PublicKey()))
+
+	msg := &nats.Msg{Subject: "employees.v1.created", Data: []byte("payload")}
+	signer.Sign(msg)
+
+	assert.Equal(t, "sig-1", msg.Header.Get(HeaderSignatureKeyID))
+	assert.NoError(t, verifier.Verify(msg))
+
+	tampered := &nats.Msg{Subject: msg.Subject, Data: []byte("Payload"), Header: msg.Header}
+	assert.ErrorIs(t, verifier.Verify(tampered), ErrBadSignature)
+
+	moved := &nats.Msg{Subject: "employees.v1.deleted", Data: msg.Data, Header: msg.Header}
+	assert.ErrorIs(t, verifier.Verify(moved), ErrBadSignature)
+
+	assert.ErrorIs(t, verifier.Verify(&nats.Msg{Subject: msg.Subject, Data: msg.Data}), ErrUnsigned)
+
+	other := newTestSigner(t, "sig-2")
+	other.Sign(msg)
+	assert.ErrorIs(t, verifier.Verify(msg), ErrUnknownKey)
+}
+
+func TestNewSigner_Invalid(t *testing.T) {
+	_, err := NewSigner("", bytes.Repeat([]byte{9}, 32))
+	assert.Error(t, err)
+
+	_, err = NewSigner("sig-1", []byte("short"))
+	assert.ErrorContains(t, err, "32-byte seed")
+}
+
+func TestFetchVerifier(t *testing.T) {
+	signer := newTestSigner(t, "sig-1")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(&JWKS{Keys: []JWK{
+			PublicJWK(signer.KeyID(), signer.PublicKey()),
+			{Kty: "RSA", Kid: "rsa-1", X: "ignored"},
+		}})
+	}))
+	defer srv.Close()
+
+	verifier, err := FetchVerifier(context.Background(), srv.Client(), srv.URL)
+	require.NoError(t, err)
+
+	msg := &nats.Msg{Subject: "employees.v1.updated", Data: []byte("payload")}
+	signer.Sign(msg)
+	assert.NoError(t, verifier.Verify(msg))
+}
+
+func TestFetchVerifier_BadStatus(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	_, err := FetchVerifier(context.Background(), srv.Client(), srv.URL)
+	assert.ErrorContains(t, err, "unexpected status 404")
+}
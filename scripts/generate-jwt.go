@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -10,14 +11,15 @@ import (
 
 // JWTClaims represents the claims in JWT token
 type JWTClaims struct {
-	TenantID string `json:"tenant_id"`
+	TenantID string   `json:"tenant_id"`
+	Roles    []string `json:"roles,omitempty"`
 	jwt.RegisteredClaims
 }
 
 func main() {
 	if len(os.Args) < 4 {
-		fmt.Println("Usage: go run generate-jwt.go <secret> <user_id> <tenant_id>")
-		fmt.Println("Example: go run generate-jwt.go my-secret user-123 tenant-abc")
+		fmt.Println("Usage: go run generate-jwt.go <secret> <user_id> <tenant_id> [roles]")
+		fmt.Println("Example: go run generate-jwt.go my-secret user-123 tenant-abc viewer,editor")
 		os.Exit(1)
 	}
 
@@ -25,9 +27,16 @@ func main() {
 	userID := os.Args[2]
 	tenantID := os.Args[3]
 
+	// Roles default to admin so that scripts can exercise every endpoint
+	roles := []string{"admin"}
+	if len(os.Args) > 4 {
+		roles = strings.Split(os.Args[4], ",")
+	}
+
 	// Create claims
 	claims := JWTClaims{
 		TenantID: tenantID,
+		Roles:    roles,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   userID,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
@@ -51,6 +60,7 @@ func main() {
 	fmt.Println("Token Details:")
 	fmt.Printf("  User ID: %s\n", userID)
 	fmt.Printf("  Tenant ID: %s\n", tenantID)
+	fmt.Printf("  Roles: %s\n", strings.Join(roles, ","))
 	fmt.Printf("  Expires: %s\n", claims.ExpiresAt.Format(time.RFC3339))
 	fmt.Println()
 	fmt.Println("Example Usage:")